@@ -263,6 +263,13 @@ func New(
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, authzkeeper.StoreKey, consensusparamtypes.StoreKey,
 		consumertypes.StoreKey,
 	)
+
+	// register streaming services
+	if err := ccvtypes.RegisterStreamingListener(bApp, appOpts, consumertypes.ModuleName,
+		keys[consumertypes.StoreKey], appCodec, consumertypes.StorePrefixDecoders()); err != nil {
+		panic(err)
+	}
+
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
 
 	app := &App{
@@ -446,6 +453,11 @@ func New(
 	// Setting the standalone staking keeper is only needed for standalone to consumer changeover chains
 	app.ConsumerKeeper.SetStandaloneStakingKeeper(app.StakingKeeper)
 
+	// halt the node once evidence of the local validator double signing is committed (if configured)
+	if err := app.ConsumerKeeper.SetDoubleSignInterlock(cast.ToString(appOpts.Get(consumertypes.FlagDoubleSignInterlock))); err != nil {
+		panic(err)
+	}
+
 	// consumer keeper satisfies the staking keeper interface
 	// of the slashing module
 	app.SlashingKeeper = slashingkeeper.NewKeeper(
@@ -477,7 +489,7 @@ func New(
 	// create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcmodule)
-	ibcRouter.AddRoute(ccvtypes.ConsumerPortID, consumerModule)
+	ibcRouter.AddRoute(app.ConsumerKeeper.PortID(), consumerModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -733,6 +745,16 @@ func New(
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
+// Configurator returns the configurator for the app
+func (app *App) Configurator() module.Configurator {
+	return app.configurator
+}
+
+// GetModuleManager returns the module manager for the app
+func (app *App) GetModuleManager() *module.Manager {
+	return app.MM
+}
+
 func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 	return app.MM.PreBlock(ctx)
 }
@@ -754,6 +776,13 @@ func (app *App) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.
 		panic(err)
 	}
 
+	// provide the genesis hash to the consumer module, which verifies it on InitGenesis
+	genesisHash, err := consumertypes.ComputeGenesisHash(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	ctx = consumertypes.WithGenesisHash(ctx, genesisHash)
+
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.MM.GetVersionMap())
 	return app.MM.InitGenesis(ctx, app.appCodec, genesisState)
 }
@@ -9,6 +9,11 @@ import (
 	"path/filepath"
 
 	dbm "github.com/cosmos/cosmos-db"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -89,6 +94,7 @@ import (
 	tmos "github.com/cometbft/cometbft/libs/os"
 
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	consumerupgrades "github.com/cosmos/interchain-security/v7/app/upgrades/consumer"
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
 	ibcconsumer "github.com/cosmos/interchain-security/v7/x/ccv/consumer"
 	ibcconsumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
@@ -101,6 +107,10 @@ const (
 	upgradeName = "ics-v1-to-v2"
 
 	Bech32MainPrefix = "consumer"
+
+	// ProviderAdminOwner is the owner, on the provider chain, of the interchain account that is allowed
+	// to execute the authority-gated messages of the consumer module, i.e., the provider x/gov module account
+	ProviderAdminOwner = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
 )
 
 func init() {
@@ -132,6 +142,7 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
 		vesting.AppModuleBasic{},
 		ibctm.AppModuleBasic{},
 		// router.AppModuleBasic{},
@@ -144,6 +155,7 @@ var (
 		ibcconsumertypes.ConsumerRedistributeName:     nil,
 		ibcconsumertypes.ConsumerToSendToProviderName: nil,
 		ibctransfertypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:                           nil,
 	}
 )
 
@@ -180,6 +192,7 @@ type App struct { // nolint: golint
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
+	ICAHostKeeper         icahostkeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
 	AuthzKeeper           authzkeeper.Keeper
 	ConsumerKeeper        ibcconsumerkeeper.Keeper
@@ -225,9 +238,17 @@ func New(
 		authtypes.StoreKey, banktypes.StoreKey, slashingtypes.StoreKey, crisistypes.StoreKey,
 		paramstypes.StoreKey, ibchost.StoreKey, upgradetypes.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, feegrant.StoreKey, authzkeeper.StoreKey,
+		icahosttypes.StoreKey,
 		consensusparamtypes.StoreKey,
 		ibcconsumertypes.StoreKey,
 	)
+
+	// register streaming services
+	if err := ccvtypes.RegisterStreamingListener(bApp, appOpts, ibcconsumertypes.ModuleName,
+		keys[ibcconsumertypes.StoreKey], appCodec, ibcconsumertypes.StorePrefixDecoders()); err != nil {
+		panic(err)
+	}
+
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
 
 	app := &App{
@@ -344,6 +365,18 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icahosttypes.StoreKey]),
+		app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.AccountKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// initialize the actual consumer keeper
 	app.ConsumerKeeper = ibcconsumerkeeper.NewKeeper(
 		appCodec,
@@ -362,6 +395,17 @@ func New(
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 	)
 
+	// allow provider governance to update the consumer params through an interchain account
+	app.ConsumerKeeper.SetProviderAdmin(app.ICAHostKeeper, ProviderAdminOwner)
+
+	// validate the messages allowed by the ICA host
+	app.ConsumerKeeper.SetICAHost(app.ICAHostKeeper, app.MsgServiceRouter())
+
+	// halt the node once evidence of the local validator double signing is committed (if configured)
+	if err := app.ConsumerKeeper.SetDoubleSignInterlock(cast.ToString(appOpts.Get(ibcconsumertypes.FlagDoubleSignInterlock))); err != nil {
+		panic(err)
+	}
+
 	// register slashing module Slashing hooks to the consumer keeper
 	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
 	consumerModule := ibcconsumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(ibcconsumertypes.ModuleName))
@@ -380,10 +424,11 @@ func New(
 	transferModule := transfer.NewAppModule(app.TransferKeeper)
 	ibcmodule := transfer.NewIBCModule(app.TransferKeeper)
 
-	// create static IBC router, add transfer route, then set and seal it
+	// create static IBC router, add transfer, ICA host and consumer routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, ibcmodule)
-	ibcRouter.AddRoute(ccvtypes.ConsumerPortID, consumerModule)
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(app.ICAHostKeeper))
+	ibcRouter.AddRoute(app.ConsumerKeeper.PortID(), consumerModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -425,6 +470,7 @@ func New(
 		ibctm.NewAppModule(tmLightClientModule),
 		params.NewAppModule(app.ParamsKeeper),
 		transferModule,
+		ibcconsumer.NewICAHostAppModule(&app.ICAHostKeeper, app.ConsumerKeeper),
 		consumerModule,
 	)
 
@@ -447,6 +493,7 @@ func New(
 		crisistypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
+		icatypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -463,6 +510,7 @@ func New(
 		crisistypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibchost.ModuleName,
+		icatypes.ModuleName,
 		feegrant.ModuleName,
 		authz.ModuleName,
 		authtypes.ModuleName,
@@ -487,6 +535,7 @@ func New(
 		ibchost.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		feegrant.ModuleName,
 		authz.ModuleName,
 		genutiltypes.ModuleName,
@@ -503,6 +552,9 @@ func New(
 		panic(err)
 	}
 
+	// expose the CCV validator set through the staking queries, as the consumer chain has no x/staking
+	app.ConsumerKeeper.RegisterStakingQueryServer(app.GRPCQueryRouter())
+
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.MM.Modules))
 
 	reflectionSvc, err := runtimeservices.NewReflectionService()
@@ -560,7 +612,14 @@ func New(
 
 			app.Logger().Info("start to run module migrations...")
 
-			return app.MM.RunMigrations(ctx, app.configurator, fromVM)
+			vm, err := app.MM.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return vm, err
+			}
+
+			// set the CCV params introduced in the version upgraded to to their default values;
+			// the defaults of earlier versions must not be applied again
+			return vm, consumerupgrades.ApplyDefaults(sdkCtx, app.ConsumerKeeper, consumerupgrades.V8Defaults)
 		},
 	)
 
@@ -588,6 +647,16 @@ func New(
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
+// Configurator returns the configurator for the app
+func (app *App) Configurator() module.Configurator {
+	return app.configurator
+}
+
+// GetModuleManager returns the module manager for the app
+func (app *App) GetModuleManager() *module.Manager {
+	return app.MM
+}
+
 func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 	return app.MM.PreBlock(ctx)
 }
@@ -609,6 +678,13 @@ func (app *App) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.
 		panic(err)
 	}
 
+	// provide the genesis hash to the consumer module, which verifies it on InitGenesis
+	genesisHash, err := ibcconsumertypes.ComputeGenesisHash(genesisState)
+	if err != nil {
+		panic(err)
+	}
+	ctx = ibcconsumertypes.WithGenesisHash(ctx, genesisHash)
+
 	app.UpgradeKeeper.SetModuleVersionMap(ctx, app.MM.GetVersionMap())
 	return app.MM.InitGenesis(ctx, app.appCodec, genesisState)
 }
@@ -803,6 +879,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(slashingtypes.ModuleName)
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
 	paramsKeeper.Subspace(ibchost.ModuleName)
 	paramsKeeper.Subspace(ibcconsumertypes.ModuleName)
 
@@ -69,6 +69,24 @@ func removeConsumerID(genState map[string]json.RawMessage) (map[string]json.RawM
 	return genState, nil
 }
 
+// Transformation of consumer genesis content as it is exported by the current provider version
+// to a format supported by consumer chains that predate the 'client_expiry_warning_window',
+// 'consumer_redistribution_fraction_overrides' and 'reward_transmission_paused' parameters
+func removeUnsupportedParams(genState map[string]json.RawMessage) (map[string]json.RawMessage, error) {
+	params := genState["params"]
+	for _, param := range []string{"client_expiry_warning_window", "consumer_redistribution_fraction_overrides", "reward_transmission_paused"} {
+		var err error
+		params, err = removeParameterFromParams(params, param)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	genState["params"] = params
+
+	return genState, nil
+}
+
 func removeFieldsFromGenesisState(genState map[string]json.RawMessage, keysToRemove []string) (map[string]json.RawMessage, error) {
 	for _, key := range keysToRemove {
 		delete(genState, key) // Remove the key from the map if it exists
@@ -92,9 +110,17 @@ func transformGenesis(targetVersion IcsVersion, jsonRaw []byte) (json.RawMessage
 		if err != nil {
 			break
 		}
-		genState, err = removeFieldsFromGenesisState(genState, []string{"connection_id"})
+		genState, err = removeUnsupportedParams(genState)
+		if err != nil {
+			break
+		}
+		genState, err = removeFieldsFromGenesisState(genState, []string{"connection_id", "genesis_hash", "initial_height", "verify_genesis_hash"})
 	case v4_5_x, v6_x_x:
-		genState, err = removeFieldsFromGenesisState(genState, []string{"connection_id"})
+		genState, err = removeUnsupportedParams(genState)
+		if err != nil {
+			break
+		}
+		genState, err = removeFieldsFromGenesisState(genState, []string{"connection_id", "genesis_hash", "initial_height", "verify_genesis_hash"})
 	default:
 		err = fmt.Errorf("unsupported target version '%s'. Run %s --help",
 			targetVersion, version.AppName)
@@ -407,6 +407,13 @@ func CheckGenesisTransform(t *testing.T, sourceVersion, targetVersion string) {
 	_, consumerIdFound := params["consumer_id"]
 	require.Equal(t, shouldContainConsumerId(targetVersion), consumerIdFound)
 
+	_, clientExpiryWarningWindowFound := params["client_expiry_warning_window"]
+	require.False(t, clientExpiryWarningWindowFound)
+	_, redistributionFractionOverridesFound := params["consumer_redistribution_fraction_overrides"]
+	require.False(t, redistributionFractionOverridesFound)
+	_, rewardTransmissionPausedFound := params["reward_transmission_paused"]
+	require.False(t, rewardTransmissionPausedFound)
+
 	// Check for no connection_id
 	_, found = resultRaw["connection_id"]
 	require.Equal(t, shouldContainConnectionId(targetVersion), found)
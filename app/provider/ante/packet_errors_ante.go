@@ -0,0 +1,48 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+)
+
+type (
+	// ProviderKeeper defines the interface required by a provider module keeper.
+	ProviderKeeper interface {
+		StoreTxPacketErrors(ctx sdk.Context)
+	}
+
+	// PacketErrorsDecorator defines an AnteHandler decorator that sets up the context
+	// of a transaction to record the packets received from consumer chains that result
+	// in error acknowledgements.
+	PacketErrorsDecorator struct{}
+
+	// PacketErrorsPostDecorator defines a PostHandler decorator that stores the packet
+	// errors recorded during a transaction if all its messages succeeded.
+	PacketErrorsPostDecorator struct {
+		ProviderKeeper ProviderKeeper
+	}
+)
+
+func NewPacketErrorsDecorator() PacketErrorsDecorator {
+	return PacketErrorsDecorator{}
+}
+
+func (PacketErrorsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	return next(providerkeeper.WithTxPacketErrors(ctx), tx, simulate)
+}
+
+func NewPacketErrorsPostDecorator(k ProviderKeeper) PacketErrorsPostDecorator {
+	return PacketErrorsPostDecorator{
+		ProviderKeeper: k,
+	}
+}
+
+func (pd PacketErrorsPostDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (newCtx sdk.Context, err error) {
+	// the state changes of a failed transaction are discarded,
+	// including the packets it received
+	if success {
+		pd.ProviderKeeper.StoreTxPacketErrors(ctx)
+	}
+	return next(ctx, tx, simulate, success)
+}
@@ -0,0 +1,42 @@
+package ante_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/app/provider/ante"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestPacketErrorsDecorators(t *testing.T) {
+	testCases := []struct {
+		name      string
+		success   bool
+		expStored int
+	}{
+		{"transaction succeeds", true, 1},
+		{"transaction fails", false, 0},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		ctx = ctx.WithExecMode(sdk.ExecModeFinalize)
+
+		// the messages of the transaction record a packet error
+		anteHandler := sdk.ChainAnteDecorators(ante.NewPacketErrorsDecorator())
+		txCtx, err := anteHandler(ctx, nil, false)
+		require.NoError(t, err, tc.name)
+		providerKeeper.RecordPacketError(txCtx, channeltypes.Packet{DestinationChannel: "channel-0"}, ccv.ErrInvalidPacketData)
+
+		postHandler := sdk.ChainPostDecorators(ante.NewPacketErrorsPostDecorator(providerKeeper))
+		_, err = postHandler(txCtx, nil, false, tc.success)
+		require.NoError(t, err, tc.name)
+		require.Len(t, providerKeeper.GetRecentPacketErrors(ctx), tc.expStored, tc.name)
+		ctrl.Finish()
+	}
+}
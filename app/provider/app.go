@@ -10,6 +10,11 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -61,13 +66,15 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	txmodule "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -106,12 +113,15 @@ import (
 	tmos "github.com/cometbft/cometbft/libs/os"
 
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	providerante "github.com/cosmos/interchain-security/v7/app/provider/ante"
+	providerupgrades "github.com/cosmos/interchain-security/v7/app/upgrades/provider"
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
 	no_valupdates_genutil "github.com/cosmos/interchain-security/v7/x/ccv/no_valupdates_genutil"
 	no_valupdates_staking "github.com/cosmos/interchain-security/v7/x/ccv/no_valupdates_staking"
 	ibcprovider "github.com/cosmos/interchain-security/v7/x/ccv/provider"
 	ibcproviderkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 const (
@@ -132,6 +142,7 @@ var (
 		genutil.NewAppModuleBasic(genutiltypes.DefaultMessageValidator),
 		auth.AppModuleBasic{},
 		vesting.AppModuleBasic{},
+		authzmodule.AppModuleBasic{},
 		bank.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -151,6 +162,7 @@ var (
 		ibctm.AppModuleBasic{},
 		params.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
 		ibcprovider.AppModuleBasic{},
 	)
 
@@ -164,6 +176,7 @@ var (
 		govtypes.ModuleName:               {authtypes.Burner},
 		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
 		providertypes.ConsumerRewardsPool: nil,
+		providertypes.RelayerRebatePool:   nil,
 	}
 )
 
@@ -190,6 +203,7 @@ type App struct { // nolint: golint
 
 	// keepers
 	AccountKeeper  authkeeper.AccountKeeper
+	AuthzKeeper    authzkeeper.Keeper
 	BankKeeper     bankkeeper.Keeper
 	StakingKeeper  *stakingkeeper.Keeper
 	SlashingKeeper slashingkeeper.Keeper
@@ -207,6 +221,7 @@ type App struct { // nolint: golint
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	ProviderKeeper        ibcproviderkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
@@ -269,7 +284,8 @@ func New(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, crisistypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey,
-		evidencetypes.StoreKey, ibctransfertypes.StoreKey,
+		evidencetypes.StoreKey, ibctransfertypes.StoreKey, authzkeeper.StoreKey,
+		icacontrollertypes.StoreKey,
 		providertypes.StoreKey,
 		consensusparamtypes.StoreKey,
 	)
@@ -278,6 +294,10 @@ func New(
 	if err := bApp.RegisterStreamingServices(appOpts, keys); err != nil {
 		panic(err)
 	}
+	if err := ccvtypes.RegisterStreamingListener(bApp, appOpts, providertypes.ModuleName,
+		keys[providertypes.StoreKey], appCodec, providertypes.StorePrefixDecoders()); err != nil {
+		panic(err)
+	}
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey)
 
@@ -331,6 +351,13 @@ func New(
 		logger,
 	)
 
+	app.AuthzKeeper = authzkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[authzkeeper.StoreKey]),
+		appCodec,
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+	)
+
 	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[stakingtypes.StoreKey]),
@@ -449,6 +476,10 @@ func New(
 	// set the GovKeeper in the ProviderKeeper
 	app.ProviderKeeper.SetGovKeeper(*app.GovKeeper)
 
+	if budget := cast.ToDuration(appOpts.Get(ibcprovider.FlagBlockTimeBudget)); budget > 0 {
+		app.ProviderKeeper.SetBlockTimeBudget(budget, cast.ToString(appOpts.Get(ibcprovider.FlagBlockProfileDir)))
+	}
+
 	app.MintKeeper = mintkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[minttypes.StoreKey]),
@@ -492,15 +523,28 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// The ICA controller allows provider governance, i.e., the x/gov module account as owner,
+	// to control interchain accounts on the consumer chains, e.g., to execute consumer admin actions
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icacontrollertypes.StoreKey]),
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.MsgServiceRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Add an IBC middleware callback to track the consumer rewards
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ibcprovider.NewIBCMiddleware(transferStack, app.ProviderKeeper)
 
-	// create static IBC router, add transfer route, then set and seal it
+	// create static IBC router, add transfer, ICA controller and provider routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
-	ibcRouter.AddRoute(providertypes.ModuleName, providerModule)
+	ibcRouter.AddRoute(icacontrollertypes.SubModuleName, icacontroller.NewIBCMiddleware(app.ICAControllerKeeper))
+	ibcRouter.AddRoute(app.ProviderKeeper.PortID(), providerModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
@@ -519,6 +563,7 @@ func New(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)),
@@ -534,6 +579,7 @@ func New(
 		ibctm.NewAppModule(tmLightClientModule),
 		params.NewAppModule(app.ParamsKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, nil),
 		providerModule,
 	)
 
@@ -580,6 +626,7 @@ func New(
 		stakingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
+		icatypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -589,6 +636,7 @@ func New(
 		evidencetypes.ModuleName,
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
+		authz.ModuleName,
 		providertypes.ModuleName,
 	)
 
@@ -600,6 +648,7 @@ func New(
 		stakingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
+		icatypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -610,6 +659,7 @@ func New(
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
+		authz.ModuleName,
 		providertypes.ModuleName,
 	)
 
@@ -629,10 +679,12 @@ func New(
 		ibcexported.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		genutiltypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
+		authz.ModuleName,
 		providertypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		crisistypes.ModuleName, // crisis needs to be last so that the genesis state is consistent when it checks invariants
@@ -673,7 +725,14 @@ func New(
 
 			app.Logger().Info("start to run module migrations...")
 
-			return app.MM.RunMigrations(ctx, app.configurator, fromVM)
+			vm, err := app.MM.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return vm, err
+			}
+
+			// set the CCV params and fields introduced in the version upgraded to to their default values;
+			// the defaults of earlier versions must not be applied again
+			return vm, providerupgrades.ApplyDefaults(sdkCtx, app.ProviderKeeper, providerupgrades.V8Defaults)
 		},
 	)
 
@@ -683,7 +742,9 @@ func New(
 	}
 
 	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{}
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{authzkeeper.StoreKey},
+		}
 
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
@@ -723,6 +784,7 @@ func New(
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
+	app.SetPrepareCheckStater(app.PrepareCheckStater)
 	app.SetAnteHandler(anteHandler)
 
 	app.setPostHandler()
@@ -752,11 +814,14 @@ func New(
 // Computes the addresses that should be blocked by the Bank module.
 // We remove the ConsumerRewardsPool from the group of blocked recipient addresses in bank.
 // This is required for the provider chain to be able to receive tokens from
-// the consumer chain
+// the consumer chain. The RelayerRebatePool is removed as well, so that it can
+// also be funded, e.g., from the community pool.
 func ComputeBankBlockedAddrs(app *App) map[string]bool {
 	bankBlockedAddrs := app.ModuleAccountAddrs()
 	delete(bankBlockedAddrs, authtypes.NewModuleAddress(
 		providertypes.ConsumerRewardsPool).String())
+	delete(bankBlockedAddrs, authtypes.NewModuleAddress(
+		providertypes.RelayerRebatePool).String())
 	return bankBlockedAddrs
 }
 
@@ -768,13 +833,15 @@ func (app *App) Configurator() module.Configurator {
 	return app.configurator
 }
 
+// GetModuleManager returns the module manager for the app
+func (app *App) GetModuleManager() *module.Manager {
+	return app.MM
+}
+
 func (app *App) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
+	postHandler := sdk.ChainPostDecorators(
+		providerante.NewPacketErrorsPostDecorator(app.ProviderKeeper),
 	)
-	if err != nil {
-		panic(err)
-	}
 
 	app.SetPostHandler(postHandler)
 }
@@ -793,6 +860,13 @@ func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
 	return app.MM.EndBlock(ctx)
 }
 
+// PrepareCheckStater application updates after the block is committed
+func (app *App) PrepareCheckStater(ctx sdk.Context) {
+	if err := app.MM.PrepareCheckState(ctx); err != nil {
+		panic(err)
+	}
+}
+
 // InitChainer application update at chain initialization
 func (app *App) InitChainer(ctx sdk.Context, req *abci.RequestInitChain) (*abci.ResponseInitChain, error) {
 	var genesisState GenesisState
@@ -1018,6 +1092,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(govtypes.ModuleName).WithKeyTable(gov.ProvideKeyTable())
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName).WithKeyTable(icacontrollertypes.ParamKeyTable())
 	paramsKeeper.Subspace(ibcexported.ModuleName)
 	paramsKeeper.Subspace(providertypes.ModuleName)
 
@@ -0,0 +1,46 @@
+package consumer
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/app/upgrades"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Defaults declares the default values of the consumer params introduced in a version.
+// The upgrade handler of a version must only apply the defaults of this version: once a version is released,
+// the params it introduced may be deliberately set to their zero values, e.g., by governance.
+type Defaults struct {
+	// Version in which the params were introduced, used for logging
+	Version string
+	// ParamDefaults are the default values of the introduced consumer params
+	ParamDefaults []upgrades.ParamDefault[ccvtypes.ConsumerParams]
+}
+
+// V8Defaults are the default values of the consumer params introduced in v8
+var V8Defaults = Defaults{
+	Version: "v8",
+	ParamDefaults: []upgrades.ParamDefault[ccvtypes.ConsumerParams]{
+		upgrades.DefaultIfZero("ClientExpiryWarningWindow",
+			func(p *ccvtypes.ConsumerParams) *time.Duration { return &p.ClientExpiryWarningWindow },
+			ccvtypes.DefaultClientExpiryWarningWindow),
+	},
+}
+
+// ApplyDefaults sets the consumer params to the default values introduced in a version, if they are not set.
+// It is meant to be called by the upgrade handler of this version after the module migrations.
+func ApplyDefaults(ctx sdk.Context, k consumerkeeper.Keeper, defaults Defaults) error {
+	params := k.GetConsumerParams(ctx)
+	if applied := upgrades.ApplyParamDefaults(&params, defaults.ParamDefaults); len(applied) > 0 {
+		if err := params.Validate(); err != nil {
+			return err
+		}
+		k.SetParams(ctx, params)
+		k.Logger(ctx).Info("set consumer params to their default values", "version", defaults.Version, "params", applied)
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package consumer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/app/upgrades"
+	consumerupgrades "github.com/cosmos/interchain-security/v7/app/upgrades/consumer"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestApplyDefaults tests that the consumer params introduced in a version
+// that are not set are set to their default values
+func TestApplyDefaults(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// params as stored before the upgrade, i.e., without the introduced params
+	params := ccvtypes.DefaultParams()
+	params.ClientExpiryWarningWindow = 0
+	consumerKeeper.SetParams(ctx, params)
+
+	err := consumerupgrades.ApplyDefaults(ctx, consumerKeeper, consumerupgrades.V8Defaults)
+	require.NoError(t, err)
+	require.Equal(t, ccvtypes.DefaultParams(), consumerKeeper.GetConsumerParams(ctx))
+}
+
+// TestApplyDefaultsOfSetParams tests that the consumer params introduced in a version
+// that are already set, e.g., by governance, are not changed
+func TestApplyDefaultsOfSetParams(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccvtypes.DefaultParams()
+	params.ClientExpiryWarningWindow = time.Minute
+	consumerKeeper.SetParams(ctx, params)
+
+	err := consumerupgrades.ApplyDefaults(ctx, consumerKeeper, consumerupgrades.V8Defaults)
+	require.NoError(t, err)
+	require.Equal(t, params, consumerKeeper.GetConsumerParams(ctx))
+}
+
+// TestApplyDefaultsOfLaterVersion tests that the params introduced in an earlier version
+// and deliberately set to zero are not overwritten by the upgrade handler of a later version
+func TestApplyDefaultsOfLaterVersion(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// a param introduced in v8 and set to zero by governance after the upgrade to v8
+	params := ccvtypes.DefaultParams()
+	params.ClientExpiryWarningWindow = 0
+	consumerKeeper.SetParams(ctx, params)
+
+	// the upgrade handler of a later version only applies the defaults of this version
+	v9Defaults := consumerupgrades.Defaults{
+		Version: "v9",
+		ParamDefaults: []upgrades.ParamDefault[ccvtypes.ConsumerParams]{
+			upgrades.DefaultIfZero("CcvTimeoutPeriod",
+				func(p *ccvtypes.ConsumerParams) *time.Duration { return &p.CcvTimeoutPeriod },
+				ccvtypes.DefaultCCVTimeoutPeriod),
+		},
+	}
+	err := consumerupgrades.ApplyDefaults(ctx, consumerKeeper, v9Defaults)
+	require.NoError(t, err)
+	require.Equal(t, params, consumerKeeper.GetConsumerParams(ctx))
+}
@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/app/upgrades"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// ConsumerFieldDefault declares the default value of a per-consumer field introduced in a new version
+type ConsumerFieldDefault struct {
+	// Name of the field, used for logging
+	Name string
+	// Apply sets the field of the consumer chain with `consumerId` to its default value
+	// if it is not set, and returns true if so
+	Apply func(ctx sdk.Context, k providerkeeper.Keeper, consumerId string) (bool, error)
+}
+
+// Defaults declares the default values of the provider params and per-consumer fields introduced in a version.
+// The upgrade handler of a version must only apply the defaults of this version: once a version is released,
+// the params it introduced may be deliberately set to their zero values, e.g., by governance.
+type Defaults struct {
+	// Version in which the params and per-consumer fields were introduced, used for logging
+	Version string
+	// ParamDefaults are the default values of the introduced provider params
+	ParamDefaults []upgrades.ParamDefault[providertypes.Params]
+	// ConsumerFieldDefaults are the default values of the introduced per-consumer fields
+	ConsumerFieldDefaults []ConsumerFieldDefault
+}
+
+// V8Defaults are the default values of the provider params and per-consumer fields introduced in v8
+var V8Defaults = Defaults{
+	Version:               "v8",
+	ParamDefaults:         v8ParamDefaults,
+	ConsumerFieldDefaults: v8ConsumerFieldDefaults,
+}
+
+// v8ParamDefaults are the default values of the provider params introduced in v8
+var v8ParamDefaults = []upgrades.ParamDefault[providertypes.Params]{
+	upgrades.DefaultIfZero("RelayerStalenessThreshold",
+		func(p *providertypes.Params) *time.Duration { return &p.RelayerStalenessThreshold },
+		providertypes.DefaultRelayerStalenessThreshold),
+	upgrades.DefaultIfZero("ClientExpiryWarningWindow",
+		func(p *providertypes.Params) *time.Duration { return &p.ClientExpiryWarningWindow },
+		ccvtypes.DefaultClientExpiryWarningWindow),
+	upgrades.DefaultIfZero("ValsetHistorySize",
+		func(p *providertypes.Params) *int64 { return &p.ValsetHistorySize },
+		providertypes.DefaultValsetHistorySize),
+	upgrades.DefaultIfZero("MinConsumerCommissionRate",
+		func(p *providertypes.Params) *string { return &p.MinConsumerCommissionRate },
+		providertypes.DefaultMinConsumerCommissionRate),
+	upgrades.DefaultIfZero("ValsetUpdateHeightRetentionMargin",
+		func(p *providertypes.Params) *time.Duration { return &p.ValsetUpdateHeightRetentionMargin },
+		providertypes.DefaultValsetUpdateHeightRetentionMargin),
+	upgrades.DefaultIfZero("RelayerRebateFraction",
+		func(p *providertypes.Params) *string { return &p.RelayerRebateFraction },
+		providertypes.DefaultRelayerRebateFraction),
+	{
+		// by default, relayers are not rebated; the rebates are paid in the denom of the registration fee
+		Name: "RelayerRebatePerPacket",
+		Apply: func(p *providertypes.Params) bool {
+			if p.RelayerRebatePerPacket.Denom != "" {
+				return false
+			}
+			p.RelayerRebatePerPacket = sdk.NewCoin(p.ConsumerRewardDenomRegistrationFee.Denom, math.ZeroInt())
+			return true
+		},
+	},
+	upgrades.DefaultIfZero("MaxRelayerRebatesPerEpoch",
+		func(p *providertypes.Params) *int64 { return &p.MaxRelayerRebatesPerEpoch },
+		providertypes.DefaultMaxRelayerRebatesPerEpoch),
+}
+
+// v8ConsumerFieldDefaults are the default values of the per-consumer fields introduced in v8
+var v8ConsumerFieldDefaults = []ConsumerFieldDefault{
+	{
+		// consumer chains launched before the infraction parameters were introduced
+		// use the slashing and jailing parameters of the provider
+		Name: "InfractionParameters",
+		Apply: func(ctx sdk.Context, k providerkeeper.Keeper, consumerId string) (bool, error) {
+			if _, err := k.GetInfractionParameters(ctx, consumerId); err == nil {
+				return false, nil
+			}
+			return true, k.SetDefaultInfractionParameters(ctx, consumerId)
+		},
+	},
+}
+
+// ApplyDefaults sets the provider params and the per-consumer fields of all the consumer chains
+// that are not deleted to the default values introduced in a version, if they are not set.
+// It is meant to be called by the upgrade handler of this version after the module migrations.
+func ApplyDefaults(ctx sdk.Context, k providerkeeper.Keeper, defaults Defaults) error {
+	params := k.GetParams(ctx)
+	if applied := upgrades.ApplyParamDefaults(&params, defaults.ParamDefaults); len(applied) > 0 {
+		if err := params.Validate(); err != nil {
+			return err
+		}
+		k.SetParams(ctx, params)
+		k.Logger(ctx).Info("set provider params to their default values", "version", defaults.Version, "params", applied)
+	}
+
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) == providertypes.CONSUMER_PHASE_DELETED {
+			continue
+		}
+		for _, d := range defaults.ConsumerFieldDefaults {
+			applied, err := d.Apply(ctx, k, consumerId)
+			if err != nil {
+				return err
+			}
+			if applied {
+				k.Logger(ctx).Info("set consumer field to its default value",
+					"version", defaults.Version, "consumerId", consumerId, "field", d.Name)
+			}
+		}
+	}
+
+	return nil
+}
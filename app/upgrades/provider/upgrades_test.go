@@ -0,0 +1,95 @@
+package provider_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/app/upgrades"
+	providerupgrades "github.com/cosmos/interchain-security/v7/app/upgrades/provider"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestApplyDefaults tests that the provider params and the per-consumer fields
+// introduced in a version that are not set are set to their default values
+func TestApplyDefaults(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	// params as stored before the upgrade, i.e., without the introduced params
+	params := providertypes.DefaultParams()
+	params.RelayerStalenessThreshold = 0
+	params.ValsetHistorySize = 0
+	params.MinConsumerCommissionRate = ""
+	params.RelayerRebatePerPacket = sdk.Coin{}
+	params.MaxRelayerRebatesPerEpoch = 0
+	// an introduced param that is already set is not changed
+	params.ClientExpiryWarningWindow = time.Minute
+	providerKeeper.SetParams(ctx, params)
+
+	// a launched consumer chain without infraction parameters
+	launchedConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, launchedConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	// a deleted consumer chain is skipped
+	deletedConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, deletedConsumerId, providertypes.CONSUMER_PHASE_DELETED)
+	// a consumer chain with infraction parameters is not changed
+	otherConsumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, otherConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	infractionParameters := testkeeper.GetTestInfractionParameters()
+	err := providerKeeper.SetInfractionParameters(ctx, otherConsumerId, infractionParameters)
+	require.NoError(t, err)
+
+	err = providerupgrades.ApplyDefaults(ctx, providerKeeper, providerupgrades.V8Defaults)
+	require.NoError(t, err)
+
+	expectedParams := providertypes.DefaultParams()
+	expectedParams.ClientExpiryWarningWindow = time.Minute
+	require.Equal(t, expectedParams, providerKeeper.GetParams(ctx))
+
+	_, err = providerKeeper.GetInfractionParameters(ctx, launchedConsumerId)
+	require.NoError(t, err)
+	_, err = providerKeeper.GetInfractionParameters(ctx, deletedConsumerId)
+	require.Error(t, err)
+	actualInfractionParameters, err := providerKeeper.GetInfractionParameters(ctx, otherConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, infractionParameters, actualInfractionParameters)
+}
+
+// TestApplyDefaultsOfLaterVersion tests that the params introduced in an earlier version
+// and deliberately set to zero are not overwritten by the upgrade handler of a later version
+func TestApplyDefaultsOfLaterVersion(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// params introduced in v8 and set to zero by governance after the upgrade to v8
+	params := providertypes.DefaultParams()
+	params.RelayerStalenessThreshold = 0
+	params.ClientExpiryWarningWindow = 0
+	params.ValsetHistorySize = 0
+	params.MaxRelayerRebatesPerEpoch = 0
+	providerKeeper.SetParams(ctx, params)
+
+	// the upgrade handler of a later version only applies the defaults of this version
+	v9Defaults := providerupgrades.Defaults{
+		Version: "v9",
+		ParamDefaults: []upgrades.ParamDefault[providertypes.Params]{
+			upgrades.DefaultIfZero("MaxProviderConsensusValidators",
+				func(p *providertypes.Params) *int64 { return &p.MaxProviderConsensusValidators },
+				providertypes.DefaultMaxProviderConsensusValidators),
+		},
+	}
+	err := providerupgrades.ApplyDefaults(ctx, providerKeeper, v9Defaults)
+	require.NoError(t, err)
+	require.Equal(t, params, providerKeeper.GetParams(ctx))
+}
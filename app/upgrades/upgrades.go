@@ -0,0 +1,45 @@
+package upgrades
+
+import (
+	"reflect"
+)
+
+// ParamDefault declares the default value of a CCV param introduced in a new version.
+// The default values of the params introduced in a version are applied by the upgrade handler
+// of this version only, instead of writing the params to the store by hand.
+type ParamDefault[P any] struct {
+	// Name of the param, used for logging
+	Name string
+	// Apply sets the param to its default value if it is not set, and returns true if so
+	Apply func(params *P) bool
+}
+
+// DefaultIfZero returns a ParamDefault that sets the param returned by field to value
+// if the param has its zero value, i.e., it was not set before the upgrade.
+// Note that the default must only be applied by the upgrade handler of the version introducing the param,
+// as the zero value may have been deliberately set afterwards.
+func DefaultIfZero[P, V any](name string, field func(params *P) *V, value V) ParamDefault[P] {
+	return ParamDefault[P]{
+		Name: name,
+		Apply: func(params *P) bool {
+			f := field(params)
+			if !reflect.ValueOf(f).Elem().IsZero() {
+				return false
+			}
+			*f = value
+			return true
+		},
+	}
+}
+
+// ApplyParamDefaults sets the params that are not set to their default values
+// and returns the names of the params that were set
+func ApplyParamDefaults[P any](params *P, defaults []ParamDefault[P]) []string {
+	applied := []string{}
+	for _, d := range defaults {
+		if d.Apply(params) {
+			applied = append(applied, d.Name)
+		}
+	}
+	return applied
+}
@@ -0,0 +1,35 @@
+package upgrades_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/app/upgrades"
+)
+
+type testParams struct {
+	Period   time.Duration
+	Fraction string
+	Size     int64
+}
+
+func TestApplyParamDefaults(t *testing.T) {
+	defaults := []upgrades.ParamDefault[testParams]{
+		upgrades.DefaultIfZero("Period", func(p *testParams) *time.Duration { return &p.Period }, time.Hour),
+		upgrades.DefaultIfZero("Fraction", func(p *testParams) *string { return &p.Fraction }, "0.5"),
+		upgrades.DefaultIfZero("Size", func(p *testParams) *int64 { return &p.Size }, 10),
+	}
+
+	// params that are not set are set to their default values
+	params := testParams{Fraction: "0.1"}
+	applied := upgrades.ApplyParamDefaults(&params, defaults)
+	require.Equal(t, []string{"Period", "Size"}, applied)
+	require.Equal(t, testParams{Period: time.Hour, Fraction: "0.1", Size: 10}, params)
+
+	// applying the defaults again is a no-op
+	applied = upgrades.ApplyParamDefaults(&params, defaults)
+	require.Empty(t, applied)
+	require.Equal(t, testParams{Period: time.Hour, Fraction: "0.1", Size: 10}, params)
+}
@@ -35,6 +35,8 @@ import (
 
 	consumer "github.com/cosmos/interchain-security/v7/app/consumer"
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	ibcconsumer "github.com/cosmos/interchain-security/v7/x/ccv/consumer"
+	consumercli "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -204,9 +206,12 @@ lru_size = 0`
 }
 
 func initRootCmd(rootCmd *cobra.Command, encodingConfig appencoding.EncodingConfig) {
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(consumercli.NewStoreDumpCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(consumer.ModuleBasics, consumer.DefaultNodeHome),
-		debug.Cmd(),
+		debugCmd,
 		pruning.Cmd(newApp, consumer.DefaultNodeHome),
 		confixcmd.ConfigCommand(),
 	)
@@ -216,7 +221,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appencoding.EncodingConf
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(encodingConfig, consumer.GetConsumerGenesisTransformCmd()),
+		genesisCommand(encodingConfig, consumer.GetConsumerGenesisTransformCmd(), consumercli.NewGenesisHashCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -284,6 +289,7 @@ func appExport(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	ibcconsumer.AddModuleInitFlags(startCmd)
 }
 
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
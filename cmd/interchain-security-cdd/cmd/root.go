@@ -39,6 +39,8 @@ import (
 
 	cdd "github.com/cosmos/interchain-security/v7/app/consumer-democracy"
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	ibcconsumer "github.com/cosmos/interchain-security/v7/x/ccv/consumer"
+	consumercli "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -226,9 +228,12 @@ lru_size = 0`
 }
 
 func initRootCmd(rootCmd *cobra.Command, encodingConfig appencoding.EncodingConfig) {
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(consumercli.NewStoreDumpCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(cdd.ModuleBasics, cdd.DefaultNodeHome),
-		debug.Cmd(),
+		debugCmd,
 		pruning.Cmd(newApp, cdd.DefaultNodeHome),
 		confixcmd.ConfigCommand(),
 	)
@@ -238,7 +243,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appencoding.EncodingConf
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(encodingConfig),
+		genesisCommand(encodingConfig, consumercli.NewGenesisHashCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -306,6 +311,7 @@ func appExport(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	ibcconsumer.AddModuleInitFlags(startCmd)
 }
 
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
@@ -39,6 +39,8 @@ import (
 
 	appEncoding "github.com/cosmos/interchain-security/v7/app/encoding"
 	providerApp "github.com/cosmos/interchain-security/v7/app/provider"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider"
+	providercli "github.com/cosmos/interchain-security/v7/x/ccv/provider/client/cli"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -207,9 +209,12 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appEncoding.EncodingConf
 	cfg := sdk.GetConfig()
 	cfg.Seal()
 
+	debugCmd := debug.Cmd()
+	debugCmd.AddCommand(providercli.NewStoreDumpCmd())
+
 	rootCmd.AddCommand(
 		genutilcli.InitCmd(providerApp.ModuleBasics, providerApp.DefaultNodeHome),
-		debug.Cmd(),
+		debugCmd,
 		pruning.Cmd(newApp, providerApp.DefaultNodeHome),
 		confixcmd.ConfigCommand(),
 		server.QueryBlockResultsCmd(),
@@ -229,6 +234,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appEncoding.EncodingConf
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	provider.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -307,15 +307,19 @@ func (tr Chain) updateConsumerChain(action UpdateConsumerChainAction, verbose bo
 			DistributionTransmissionChannel:   action.InitParams.DistributionChannel,
 		}
 	}
-	powerShapingParams := types.PowerShapingParameters{
-		Top_N:              action.PowerShapingParams.TopN,
-		ValidatorsPowerCap: action.PowerShapingParams.ValidatorsPowerCap,
-		ValidatorSetCap:    action.PowerShapingParams.ValidatorSetCap,
-		Allowlist:          action.PowerShapingParams.Allowlist,
-		Denylist:           action.PowerShapingParams.Denylist,
-		MinStake:           action.PowerShapingParams.MinStake,
-		AllowInactiveVals:  action.PowerShapingParams.AllowInactiveVals,
-		Prioritylist:       action.PowerShapingParams.Prioritylist,
+	// the power-shaping parameters are only updated if they are set
+	var powerShapingParams *types.PowerShapingParameters
+	if action.PowerShapingParams != nil {
+		powerShapingParams = &types.PowerShapingParameters{
+			Top_N:              action.PowerShapingParams.TopN,
+			ValidatorsPowerCap: action.PowerShapingParams.ValidatorsPowerCap,
+			ValidatorSetCap:    action.PowerShapingParams.ValidatorSetCap,
+			Allowlist:          action.PowerShapingParams.Allowlist,
+			Denylist:           action.PowerShapingParams.Denylist,
+			MinStake:           action.PowerShapingParams.MinStake,
+			AllowInactiveVals:  action.PowerShapingParams.AllowInactiveVals,
+			Prioritylist:       action.PowerShapingParams.Prioritylist,
+		}
 	}
 
 	consumerId := tr.testConfig.ChainConfigs[action.ConsumerChain].ConsumerId
@@ -323,7 +327,7 @@ func (tr Chain) updateConsumerChain(action UpdateConsumerChainAction, verbose bo
 		ConsumerId:               string(consumerId),
 		NewOwnerAddress:          action.NewOwner,
 		InitializationParameters: initParams,
-		PowerShapingParameters:   &powerShapingParams,
+		PowerShapingParameters:   powerShapingParams,
 	}
 
 	bz, err := tr.target.UpdateConsumer(action.Chain, action.From, msg, verbose)
@@ -122,6 +122,9 @@ func getDockerImage(version string, cfg TargetConfig, noCache bool) (string, err
 		fmt.Println("Using docker image from registry: ", imgName)
 		return imgName, nil
 	}
+	if cfg.pullOnly {
+		return "", fmt.Errorf("no released docker image found for version '%s': %v", version, err)
+	}
 
 	imgName, err = buildDockerImage(version, cfg, noCache)
 	return imgName, err
@@ -0,0 +1,138 @@
+package main
+
+// The compatibility matrix runs test steps against every combination of released
+// provider and consumer versions, using the docker images published in the ICS registry,
+// to continuously verify the wire compatibility across versions.
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/mod/semver"
+)
+
+var (
+	matrixProviderVersions VersionSet = VersionSet{}
+	matrixConsumerVersions VersionSet = VersionSet{}
+)
+
+// compatibilityMatrixEnabled returns true if released provider or consumer versions were selected (see -matrix-pv and -matrix-cv)
+func compatibilityMatrixEnabled() bool {
+	return len(matrixProviderVersions) > 0 || len(matrixConsumerVersions) > 0
+}
+
+// validateCompatibilityMatrix checks that the versions selected for the compatibility matrix
+// are released versions and that the matrix is not combined with workspace versions
+func validateCompatibilityMatrix() error {
+	if !compatibilityMatrixEnabled() {
+		return nil
+	}
+	if len(providerVersions) > 0 || len(consumerVersions) > 0 {
+		return fmt.Errorf("compatibility matrix (-matrix-pv, -matrix-cv) cannot be combined with -pv and -cv")
+	}
+	if len(matrixProviderVersions) == 0 || len(matrixConsumerVersions) == 0 {
+		return fmt.Errorf("compatibility matrix requires at least one provider (-matrix-pv) and one consumer (-matrix-cv) version")
+	}
+	if *useGaia || *localSdkPath != "" || *useImage != "" {
+		return fmt.Errorf("compatibility matrix only supports released ICS docker images")
+	}
+	for _, versions := range []VersionSet{matrixProviderVersions, matrixConsumerVersions} {
+		for version := range versions {
+			if version != VLatest && !semver.IsValid(version) {
+				return fmt.Errorf("'%s' is not a released version; expected a semantic version tag or '%s'", version, VLatest)
+			}
+		}
+	}
+	return nil
+}
+
+// sortedVersions returns the versions of a version set, with semantic versions in ascending order and 'latest' last
+func sortedVersions(vs VersionSet) []string {
+	versions := []string{}
+	for v := range vs {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if versions[i] == VLatest || versions[j] == VLatest {
+			return versions[j] == VLatest && versions[i] != VLatest
+		}
+		return semver.Compare(versions[i], versions[j]) < 0
+	})
+	return versions
+}
+
+// createCompatibilityMatrixRunners creates test runners to run each test case against every combination
+// of the released provider and consumer versions. Unlike createTestRunners, the docker images of the
+// targets are only pulled from the ICS registry and never built, and identical versions are not skipped.
+func createCompatibilityMatrixRunners(testCases []testStepsWithConfig) []TestRunner {
+	runners := []TestRunner{}
+	targetCfg := TargetConfig{useCometMock: *useCometmock, pullOnly: true}
+
+	for _, tc := range testCases {
+		for _, provider := range sortedVersions(matrixProviderVersions) {
+			for _, consumer := range sortedVersions(matrixConsumerVersions) {
+				cfg := createTestConfig(tc.config, provider, consumer)
+				target, err := createTarget(cfg, targetCfg, "")
+				if err != nil {
+					// the combination is reported as not run in the compatibility matrix
+					fmt.Println("No test runner created:", err)
+					continue
+				}
+				fmt.Printf("Created test runner for '%s' with provider version=%s consumer version=%s\n",
+					cfg.Name, cfg.ProviderVersion, cfg.ConsumerVersion)
+				runners = append(runners, CreateTestRunner(cfg, tc.steps, &target, *verbose))
+			}
+		}
+	}
+	return runners
+}
+
+// printCompatibilityMatrix prints, for each test case, the results of the test runners as
+// a matrix of the released provider versions (rows) and consumer versions (columns)
+func printCompatibilityMatrix(runners []TestRunner) {
+	// all selected test cases are reported, including those without any test runner
+	testCases := []string{}
+	for _, tc := range selectedTests {
+		testCases = append(testCases, strings.Split(tc, "::")[0])
+	}
+	for _, testFile := range selectedTestfiles {
+		testCases = append(testCases, strings.Split(testFile, "::")[0])
+	}
+
+	providers := sortedVersions(matrixProviderVersions)
+	consumers := sortedVersions(matrixConsumerVersions)
+
+	results := map[string]string{}
+	for _, tr := range runners {
+		if tr.result.Result != "" {
+			results[tr.stepChoice.name+"/"+tr.config.ProviderVersion+"/"+tr.config.ConsumerVersion] = tr.result.Result
+		}
+	}
+
+	fmt.Print("\n\n=================================================\nCOMPATIBILITY MATRIX\n")
+	for _, name := range testCases {
+		fmt.Printf("-------------------------------------------------\nTest name: %s\n\n", name)
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprint(w, "provider \\ consumer")
+		for _, consumer := range consumers {
+			fmt.Fprintf(w, "\t%s", consumer)
+		}
+		fmt.Fprintln(w)
+		for _, provider := range providers {
+			fmt.Fprint(w, provider)
+			for _, consumer := range consumers {
+				result, ok := results[name+"/"+provider+"/"+consumer]
+				if !ok {
+					result = TEST_STATUS_NOTRUN
+				}
+				fmt.Fprintf(w, "\t%s", result)
+			}
+			fmt.Fprintln(w)
+		}
+		w.Flush()
+	}
+	fmt.Println("==================================================")
+}
@@ -712,7 +712,9 @@ func MultiConsumerTestConfig() TestConfig {
 					".app_state.slashing.params.downtime_jail_duration = \"60s\" | " +
 					".app_state.slashing.params.slash_fraction_downtime = \"0.010000000000000000\" | " +
 					".app_state.provider.params.slash_meter_replenish_fraction = \"1.0\" | " + // This disables slash packet throttling
-					".app_state.provider.params.blocks_per_epoch = 3",
+					".app_state.provider.params.blocks_per_epoch = 3 | " +
+					// validators using consumer keys assign the same key on both consumer chains
+					".app_state.provider.params.allow_consumer_key_reuse = true",
 			},
 			ChainID("consu"): {
 				ChainId:        ChainID("consu"),
@@ -151,6 +151,12 @@ var stepChoices = map[string]StepChoice{
 		description: `Minimal set of test steps to perform compatibility tests`,
 		testConfig:  CompatibilityTestCfg,
 	},
+	"compatibility-matrix": {
+		name:        "compatibility-matrix",
+		steps:       compatibilityMatrixSteps,
+		description: `Compatibility test steps, including the distribution of consumer rewards, run by the compatibility matrix (see -matrix-pv and -matrix-cv)`,
+		testConfig:  CompatibilityTestCfg,
+	},
 	"partial-set-security-opt-in": {
 		name:        "partial-set-security-opt-in",
 		steps:       stepsOptInChain(),
@@ -259,6 +265,12 @@ var stepChoices = map[string]StepChoice{
 		description: "test permissionless ics topN transformation",
 		testConfig:  PermissionlessTestCfg,
 	},
+	"consumer-lifecycle": {
+		name:        "consumer-lifecycle",
+		steps:       stepsConsumerLifecycle(),
+		description: "test the lifecycle of a permissionless consumer chain: creation, power-shaping updates, opt-in/opt-out, consumer commission rates and removal",
+		testConfig:  DefaultTestCfg,
+	},
 	"inactive-vals-outside-max-validators": {
 		name:        "inactive-vals-outside-max-validators",
 		steps:       stepsInactiveValsTopNReproduce(),
@@ -324,8 +336,15 @@ func parseArguments() (err error) {
 	flag.Var(&consumerVersions, "cv", "Version (git tag, revision, branch) of the consumer to be tested. Tests will be run against combinations of all defined provider versions (-pv) with this consumer version. Default: consumer implementation of local workspace")
 	flag.Var(&providerVersions, "pv", "Version (git tag, revision, branch) of the provider to be tested. Tests will be run against combinations of all defined consumer versions (-cv) with this provider version. Default: provider implementation of local workspace")
 
+	flag.Var(&matrixProviderVersions, "matrix-pv", "Released version (docker tag in the ICS registry) of the provider to be tested in the compatibility matrix. Tests will be run against every combination with the released consumer versions (-matrix-cv). Cannot be combined with -pv and -cv")
+	flag.Var(&matrixConsumerVersions, "matrix-cv", "Released version (docker tag in the ICS registry) of the consumer to be tested in the compatibility matrix. Tests will be run against every combination with the released provider versions (-matrix-pv). Cannot be combined with -pv and -cv")
+
 	flag.Parse()
 
+	if err = validateCompatibilityMatrix(); err != nil {
+		return err
+	}
+
 	// Enforce go-relayer in case of cometmock as hermes is not yet supported
 	if useCometmock != nil && *useCometmock && (useGorelayer == nil || !*useGorelayer) {
 		fmt.Println("Enforcing go-relayer as cometmock is requested")
@@ -369,6 +388,7 @@ func getTestCases(selectedPredefinedTests, selectedTestFiles TestSet, providerVe
 			"inactive-provider-validators-governance",
 			"min-stake",
 			"inactive-vals-mint",
+			"consumer-lifecycle",
 		}
 		if includeMultiConsumer != nil && *includeMultiConsumer {
 			selectedPredefinedTests = append(selectedPredefinedTests, "multiconsumer")
@@ -464,21 +484,27 @@ func createTestConfigs(cfgType TestConfigType, providerVersions, consumerVersion
 			if (len(consumerVersions) > 1 || len(providerVersions) > 1) && consumer == provider {
 				continue
 			}
-			config := GetTestConfig(cfgType, provider, consumer)
-			config.SetRelayerConfig(*useGorelayer)
-			config.SetCometMockConfig(*useCometmock)
-			config.TransformGenesis = *transformGenesis
-			config.UseGorelayer = *useGorelayer
-
-			runnerId++
-			config.ContainerConfig.ContainerName += fmt.Sprintf("-%d", runnerId)
-
-			configs = append(configs, config)
+			configs = append(configs, createTestConfig(cfgType, provider, consumer))
 		}
 	}
 	return configs
 }
 
+// createTestConfig creates the test config of the given type for a provider and a consumer version,
+// using a dedicated docker container
+func createTestConfig(cfgType TestConfigType, providerVersion, consumerVersion string) TestConfig {
+	config := GetTestConfig(cfgType, providerVersion, consumerVersion)
+	config.SetRelayerConfig(*useGorelayer)
+	config.SetCometMockConfig(*useCometmock)
+	config.TransformGenesis = *transformGenesis
+	config.UseGorelayer = *useGorelayer
+
+	runnerId++
+	config.ContainerConfig.ContainerName += fmt.Sprintf("-%d", runnerId)
+
+	return config
+}
+
 // createTestRunners creates test runners to run each test case on each target
 func createTestRunners(testCases []testStepsWithConfig) []TestRunner {
 	runners := []TestRunner{}
@@ -602,12 +628,25 @@ func main() {
 		log.Fatalf("Error parsing command arguments %s\n", err)
 	}
 
-	testCases := getTestCases(selectedTests, selectedTestfiles, providerVersions, consumerVersions)
-	testRunners := createTestRunners(testCases)
+	var testRunners []TestRunner
+	if compatibilityMatrixEnabled() {
+		// Run the compatibility matrix steps if no test cases were selected
+		if len(selectedTests) == 0 && len(selectedTestfiles) == 0 {
+			selectedTests = TestSet{"compatibility-matrix"}
+		}
+		testCases := getTestCases(selectedTests, selectedTestfiles, matrixProviderVersions, matrixConsumerVersions)
+		testRunners = createCompatibilityMatrixRunners(testCases)
+	} else {
+		testCases := getTestCases(selectedTests, selectedTestfiles, providerVersions, consumerVersions)
+		testRunners = createTestRunners(testCases)
+	}
 	defer deleteTargets(testRunners)
 
 	start := time.Now()
 	err := executeTests(testRunners)
+	if compatibilityMatrixEnabled() {
+		printCompatibilityMatrix(testRunners)
+	}
 	if err != nil {
 		log.Panicf("Test execution failed '%s'", err)
 	}
@@ -32,6 +32,21 @@ var compatibilitySteps = concatSteps(
 	stepsStopChain("consu", 3),                     // stop chain
 )
 
+// Compatibility steps run by the compatibility matrix, which additionally
+// check the distribution of consumer rewards on the provider
+var compatibilityMatrixSteps = concatSteps(
+	compstepsStartChains([]string{"consu"}, true),
+	stepsDelegate("consu"),
+	stepsUnbond("consu"),
+	stepsRedelegate("consu"),
+	stepsDowntime("consu"),
+	stepsDoubleSignOnProvider("consu"), // carol double signs on provider
+	stepsStartRelayer(),
+	compstepsConsumerRewards("consu", 2),
+	stepsConsumerRemovalPropNotPassing("consu", 3), // submit removal prop but vote no on it - chain should stay
+	stepsStopChain("consu", 4),                     // stop chain
+)
+
 var happyPathSteps = concatSteps(
 	stepsStartChains([]string{"consu"}, false),
 	stepsDelegate("consu"),
@@ -218,3 +218,74 @@ func compstepsStartChains(consumerNames []string, setupTransferChans bool) []Ste
 
 	return s
 }
+
+// compstepsConsumerRewards registers the consumer reward denom on the provider, sends consumer rewards
+// to the provider via the transfer channel-1 and checks that they are distributed to the validators
+func compstepsConsumerRewards(consumerName string, propNumber uint) []Step {
+	return []Step{
+		{
+			Action: SubmitChangeRewardDenomsProposalAction{
+				Chain:   ChainID("provi"),
+				Denoms:  consumerRewardDenoms[:1],
+				Deposit: 10000001,
+				From:    ValidatorID("bob"),
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					// Denom not yet registered, gov prop needs to pass first
+					RegisteredConsumerRewardDenoms: &[]string{},
+				},
+			},
+		},
+		{
+			Action: VoteGovProposalAction{
+				Chain:      ChainID("provi"),
+				From:       []ValidatorID{ValidatorID("alice"), ValidatorID("bob"), ValidatorID("carol")},
+				Vote:       []string{"yes", "yes", "yes"},
+				PropNumber: propNumber,
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					// Check that the denom is registered on provider chain
+					RegisteredConsumerRewardDenoms: &[]string{consumerRewardDenoms[0]},
+				},
+			},
+		},
+		// Transfer tokens from the consumer to the consumer reward pool
+		// of the provider via the transfer channel-1
+		{
+			Action: TransferIbcTokenAction{
+				Chain:   ChainID(consumerName),
+				From:    ValidatorID("alice"),
+				DstAddr: "cosmos1ap0mh6xzfn8943urr84q6ae7zfnar48am2erhd", // consumer reward pool address
+				Amount:  1000000,
+				Channel: 1,
+			},
+			State: State{},
+		},
+		// Relay the rewards and check that they are distributed to the validators,
+		// since transfer channel-1 is associated to the consumer;
+		// carol was tombstoned for double signing and is not rewarded
+		{
+			Action: RelayRewardPacketsToProviderAction{
+				ConsumerChain: ChainID(consumerName),
+				ProviderChain: ChainID("provi"),
+				Port:          "transfer",
+				Channel:       1,
+			},
+			State: State{
+				ChainID("provi"): ChainState{
+					Rewards: &Rewards{
+						IsRewarded: map[ValidatorID]bool{
+							ValidatorID("alice"): true,
+							ValidatorID("bob"):   true,
+							ValidatorID("carol"): false,
+						},
+						IsIncrementalReward: false,
+						Denom:               consumerRewardDenoms[0],
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,282 @@
+package main
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+)
+
+// ConsumerStepsBuilder builds the steps for the lifecycle of a permissionless consumer chain:
+// its creation and launch, the updates of its power-shaping parameters, the opt-ins and opt-outs
+// of validators, the consumer commission rates and its removal.
+// Every method appends a single step (or, for Start, the steps to start the chain)
+// that is checked against the given expected state.
+type ConsumerStepsBuilder struct {
+	consumer ChainID
+	owner    ValidatorID
+	steps    []Step
+}
+
+// NewConsumerStepsBuilder returns a builder for the steps of the consumer chain
+// with the given name, owned by the given validator
+func NewConsumerStepsBuilder(consumerName string, owner ValidatorID) *ConsumerStepsBuilder {
+	return &ConsumerStepsBuilder{
+		consumer: ChainID(consumerName),
+		owner:    owner,
+	}
+}
+
+// Create creates the consumer chain with the given spawn time (in milliseconds from now)
+// and power-shaping parameters
+func (b *ConsumerStepsBuilder) Create(spawnTime uint, powerShaping PowerShapingParameters, state State) *ConsumerStepsBuilder {
+	return b.add(CreateConsumerChainAction{
+		Chain:         ChainID("provi"),
+		From:          b.owner,
+		ConsumerChain: b.consumer,
+		InitParams: &InitializationParameters{
+			InitialHeight: clienttypes.Height{RevisionNumber: 0, RevisionHeight: 1},
+			SpawnTime:     spawnTime,
+		},
+		PowerShapingParams: &powerShaping,
+	}, state)
+}
+
+// Launch updates the spawn time of the consumer chain, so that it launches now
+func (b *ConsumerStepsBuilder) Launch(state State) *ConsumerStepsBuilder {
+	return b.add(UpdateConsumerChainAction{
+		Chain:         ChainID("provi"),
+		From:          b.owner,
+		ConsumerChain: b.consumer,
+		InitParams: &InitializationParameters{
+			InitialHeight: clienttypes.Height{RevisionNumber: 0, RevisionHeight: 1},
+			SpawnTime:     0, // launch now
+		},
+	}, state)
+}
+
+// Start starts the nodes of the launched consumer chain and establishes the CCV channel;
+// chainIndex is the index of the client to the consumer chain on the provider
+func (b *ConsumerStepsBuilder) Start(validators []StartChainValidator, chainIndex uint, state State) *ConsumerStepsBuilder {
+	b.add(StartConsumerChainAction{
+		ConsumerChain: b.consumer,
+		ProviderChain: ChainID("provi"),
+		Validators:    validators,
+	}, state)
+	b.add(AddIbcConnectionAction{
+		ChainA:  b.consumer,
+		ChainB:  ChainID("provi"),
+		ClientA: 0,
+		ClientB: chainIndex,
+	}, State{})
+	return b.add(AddIbcChannelAction{
+		ChainA:      b.consumer,
+		ChainB:      ChainID("provi"),
+		ConnectionA: 0,
+		PortA:       "consumer",
+		PortB:       "provider",
+		Order:       "ordered",
+	}, State{})
+}
+
+// UpdatePowerShaping replaces the power-shaping parameters of the consumer chain
+func (b *ConsumerStepsBuilder) UpdatePowerShaping(powerShaping PowerShapingParameters, state State) *ConsumerStepsBuilder {
+	return b.add(UpdateConsumerChainAction{
+		Chain:              ChainID("provi"),
+		From:               b.owner,
+		ConsumerChain:      b.consumer,
+		PowerShapingParams: &powerShaping,
+	}, state)
+}
+
+// OptIn opts the validator in to the consumer chain
+func (b *ConsumerStepsBuilder) OptIn(validator ValidatorID, state State) *ConsumerStepsBuilder {
+	return b.add(OptInAction{
+		Chain:     b.consumer,
+		Validator: validator,
+	}, state)
+}
+
+// OptOut opts the validator out of the consumer chain
+func (b *ConsumerStepsBuilder) OptOut(validator ValidatorID, state State) *ConsumerStepsBuilder {
+	return b.add(OptOutAction{
+		Chain:     b.consumer,
+		Validator: validator,
+	}, state)
+}
+
+// SetCommissionRate sets the commission rate of the validator on the consumer chain
+func (b *ConsumerStepsBuilder) SetCommissionRate(validator ValidatorID, rate float64, state State) *ConsumerStepsBuilder {
+	return b.add(SetConsumerCommissionRateAction{
+		Chain:          b.consumer,
+		Validator:      validator,
+		CommissionRate: rate,
+	}, state)
+}
+
+// RelayVSCPackets relays the pending VSC packets from the provider to the consumer chain
+func (b *ConsumerStepsBuilder) RelayVSCPackets(state State) *ConsumerStepsBuilder {
+	return b.add(RelayPacketsAction{
+		ChainA:  ChainID("provi"),
+		ChainB:  b.consumer,
+		Port:    "provider",
+		Channel: 0,
+	}, state)
+}
+
+// Remove removes the consumer chain
+func (b *ConsumerStepsBuilder) Remove(state State) *ConsumerStepsBuilder {
+	return b.add(RemoveConsumerChainAction{
+		Chain:         ChainID("provi"),
+		From:          b.owner,
+		ConsumerChain: b.consumer,
+	}, state)
+}
+
+// Steps returns the steps built so far
+func (b *ConsumerStepsBuilder) Steps() []Step {
+	return b.steps
+}
+
+func (b *ConsumerStepsBuilder) add(action interface{}, state State) *ConsumerStepsBuilder {
+	b.steps = append(b.steps, Step{Action: action, State: state})
+	return b
+}
+
+// stepsConsumerLifecycle tests the lifecycle of a permissionless consumer chain
+// - creating the consumer chain, opting in validators and setting a consumer commission rate
+// - launching and starting the consumer chain
+// - capping the validator set with MsgUpdateConsumer and removing the cap again
+// - opting out a validator
+// - removing the consumer chain
+func stepsConsumerLifecycle() []Step {
+	consumer := NewConsumerStepsBuilder("consu", ValidatorID("alice")).
+		Create(uint(time.Minute*10), PowerShapingParameters{TopN: 0}, State{
+			ChainID("provi"): ChainState{
+				ProposedConsumerChains: &[]string{"consu"},
+			},
+		}).
+		OptIn(ValidatorID("alice"), State{}).
+		OptIn(ValidatorID("bob"), State{
+			ChainID("provi"): ChainState{
+				HasToValidate: &map[ValidatorID][]ChainID{
+					ValidatorID("alice"): {}, // chain is not running yet
+					ValidatorID("bob"):   {},
+					ValidatorID("carol"): {},
+				},
+			},
+		}).
+		SetCommissionRate(ValidatorID("bob"), 0.123, State{
+			ChainID("consu"): ChainState{
+				ConsumerCommissionRates: &map[ValidatorID]float64{
+					ValidatorID("alice"): 0.1,
+					ValidatorID("bob"):   0.123,
+					ValidatorID("carol"): 0.1,
+				},
+			},
+		}).
+		Launch(State{}).
+		// all the validators are started, but only "alice" and "bob" have opted in
+		Start([]StartChainValidator{
+			{Id: ValidatorID("alice"), Stake: 100000000, Allocation: 10000000000},
+			{Id: ValidatorID("bob"), Stake: 200000000, Allocation: 10000000000},
+			{Id: ValidatorID("carol"), Stake: 300000000, Allocation: 10000000000},
+		}, 0, State{
+			ChainID("consu"): ChainState{
+				ValPowers: &map[ValidatorID]uint{
+					ValidatorID("alice"): 100,
+					ValidatorID("bob"):   200,
+					ValidatorID("carol"): 0,
+				},
+			},
+		}).
+		// cap the validator set to the largest opted-in validator
+		UpdatePowerShaping(PowerShapingParameters{TopN: 0, ValidatorSetCap: 1}, State{}).
+		RelayVSCPackets(State{
+			ChainID("consu"): ChainState{
+				ValPowers: &map[ValidatorID]uint{
+					ValidatorID("alice"): 0,
+					ValidatorID("bob"):   200,
+					ValidatorID("carol"): 0,
+				},
+			},
+			ChainID("provi"): ChainState{
+				HasToValidate: &map[ValidatorID][]ChainID{
+					ValidatorID("alice"): {},
+					ValidatorID("bob"):   {"consu"},
+					ValidatorID("carol"): {},
+				},
+			},
+		}).
+		// remove the cap
+		UpdatePowerShaping(PowerShapingParameters{TopN: 0}, State{}).
+		RelayVSCPackets(State{
+			ChainID("consu"): ChainState{
+				ValPowers: &map[ValidatorID]uint{
+					ValidatorID("alice"): 100,
+					ValidatorID("bob"):   200,
+					ValidatorID("carol"): 0,
+				},
+			},
+		}).
+		OptOut(ValidatorID("alice"), State{
+			ChainID("consu"): ChainState{
+				ValPowers: &map[ValidatorID]uint{
+					// "alice" has not yet opted out from the consumer chain because the VSCPacket has not yet been relayed
+					ValidatorID("alice"): 100,
+					ValidatorID("bob"):   200,
+					ValidatorID("carol"): 0,
+				},
+			},
+		}).
+		RelayVSCPackets(State{
+			ChainID("consu"): ChainState{
+				ValPowers: &map[ValidatorID]uint{
+					ValidatorID("alice"): 0,
+					ValidatorID("bob"):   200,
+					ValidatorID("carol"): 0,
+				},
+			},
+			ChainID("provi"): ChainState{
+				HasToValidate: &map[ValidatorID][]ChainID{
+					ValidatorID("alice"): {},
+					ValidatorID("bob"):   {"consu"},
+					ValidatorID("carol"): {},
+				},
+			},
+		}).
+		Remove(State{
+			ChainID("provi"): ChainState{
+				ConsumerChains: &map[ChainID]bool{}, // Consumer chain is now removed
+				HasToValidate: &map[ValidatorID][]ChainID{
+					ValidatorID("alice"): {},
+					ValidatorID("bob"):   {},
+					ValidatorID("carol"): {},
+				},
+			},
+		})
+
+	return concatSteps(
+		[]Step{
+			{
+				Action: StartChainAction{
+					Chain: ChainID("provi"),
+					Validators: []StartChainValidator{
+						{Id: ValidatorID("alice"), Stake: 100000000, Allocation: 10000000000},
+						{Id: ValidatorID("bob"), Stake: 200000000, Allocation: 10000000000},
+						{Id: ValidatorID("carol"), Stake: 300000000, Allocation: 10000000000},
+					},
+				},
+				State: State{
+					ChainID("provi"): ChainState{
+						ValPowers: &map[ValidatorID]uint{
+							ValidatorID("alice"): 100,
+							ValidatorID("bob"):   200,
+							ValidatorID("carol"): 300,
+						},
+					},
+				},
+			},
+		},
+		consumer.Steps(),
+	)
+}
@@ -32,6 +32,9 @@ type TargetConfig struct {
 	providerVersion string
 	consumerVersion string
 	useCometMock    bool
+	// pullOnly restricts the target to released docker images from the ICS registry,
+	// i.e., images are never built from the sources of a version
+	pullOnly bool
 }
 type DockerContainer struct {
 	targetConfig TargetConfig
@@ -0,0 +1,107 @@
+package integration
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestAuthzProviderMsgs tests that the provider messages signed by validators can be
+// executed by an ops key through x/authz grants.
+// @Long Description@
+// * Set up a CCV channel and turn the consumer chain into an opt-in chain.
+// * Verify that a grantee cannot execute the validator messages on behalf of a validator without a grant.
+// * Grant the grantee a generic authorization for every validator message.
+// * Execute MsgSetConsumerCommissionRate, MsgAssignConsumerKey, MsgOptOut and MsgOptIn
+// with MsgExec and verify the provider state is updated for the validator.
+// * Verify that the grantee cannot execute the messages on behalf of another validator.
+func (s *CCVTestSuite) TestAuthzProviderMsgs() {
+	s.SetupCCVChannel(s.path)
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	router := s.providerApp.GetBaseApp().MsgServiceRouter()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	// turn the consumer chain into an opt-in chain, so that validators can opt out
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	powerShapingParameters.Top_N = 0
+	err = providerKeeper.SetConsumerPowerShapingParameters(s.providerCtx(), consumerId, powerShapingParameters)
+	s.Require().NoError(err)
+
+	validator, valAddr := s.getValByIdx(0)
+	consAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	providerAddr := providertypes.NewProviderConsAddress(consAddr)
+	granter := sdk.AccAddress(valAddr)
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+
+	execMsg := func(grantee sdk.AccAddress, msg sdk.Msg) error {
+		msgExec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		_, err := router.Handler(&msgExec)(s.providerCtx(), &msgExec)
+		return err
+	}
+
+	commissionRate := math.LegacyNewDecWithPrec(5, 2)
+	msgSetCommissionRate := providertypes.NewMsgSetConsumerCommissionRate(consumerId, commissionRate, valAddr, granter.String())
+
+	consumerKey := fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`,
+		base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()))
+	msgAssignConsumerKey, err := providertypes.NewMsgAssignConsumerKey(consumerId, valAddr, consumerKey, granter.String())
+	s.Require().NoError(err)
+
+	msgOptOut, err := providertypes.NewMsgOptOut(consumerId, valAddr, granter.String())
+	s.Require().NoError(err)
+
+	msgOptIn, err := providertypes.NewMsgOptIn(consumerId, valAddr, "", granter.String())
+	s.Require().NoError(err)
+
+	msgs := []sdk.Msg{msgSetCommissionRate, msgAssignConsumerKey, msgOptOut, msgOptIn}
+
+	// the grantee cannot act on behalf of the validator without a grant
+	for _, msg := range msgs {
+		s.Require().ErrorIs(execMsg(grantee, msg), authz.ErrNoAuthorizationFound)
+	}
+
+	// grant the grantee a generic authorization for every validator message
+	for _, msg := range msgs {
+		msgGrant, err := authz.NewMsgGrant(granter, grantee, authz.NewGenericAuthorization(sdk.MsgTypeURL(msg)), nil)
+		s.Require().NoError(err)
+		_, err = router.Handler(msgGrant)(s.providerCtx(), msgGrant)
+		s.Require().NoError(err)
+	}
+
+	s.Require().NoError(execMsg(grantee, msgSetCommissionRate))
+	rate, found := providerKeeper.GetConsumerCommissionRate(s.providerCtx(), consumerId, providerAddr)
+	s.Require().True(found)
+	s.Require().Equal(commissionRate, rate)
+
+	s.Require().NoError(execMsg(grantee, msgAssignConsumerKey))
+	_, found = providerKeeper.GetValidatorConsumerPubKey(s.providerCtx(), consumerId, providerAddr)
+	s.Require().True(found)
+
+	s.Require().True(providerKeeper.IsOptedIn(s.providerCtx(), consumerId, providerAddr))
+	s.Require().NoError(execMsg(grantee, msgOptOut))
+	s.Require().False(providerKeeper.IsOptedIn(s.providerCtx(), consumerId, providerAddr))
+
+	s.Require().NoError(execMsg(grantee, msgOptIn))
+	s.Require().True(providerKeeper.IsOptedIn(s.providerCtx(), consumerId, providerAddr))
+
+	// the grants of a validator do not allow the grantee to act on behalf of another validator
+	_, otherValAddr := s.getValByIdx(1)
+	msgOptOut, err = providertypes.NewMsgOptOut(consumerId, otherValAddr, sdk.AccAddress(otherValAddr).String())
+	s.Require().NoError(err)
+	s.Require().ErrorIs(execMsg(grantee, msgOptOut), authz.ErrNoAuthorizationFound)
+
+	// the inner messages are validated, i.e., the signer must match the validator
+	msgOptOut, err = providertypes.NewMsgOptOut(consumerId, otherValAddr, granter.String())
+	s.Require().NoError(err)
+	s.Require().Error(execMsg(grantee, msgOptOut))
+}
@@ -2,6 +2,7 @@ package integration
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -231,6 +232,20 @@ func relayAllCommittedPackets(
 	expectedPackets int,
 	msgAndArgs ...interface{},
 ) {
+	packets := getAllCommittedPackets(s, srcChain, srcPortID, srcChannelID, expectedPackets, msgAndArgs...)
+	relayPackets(s, path, packets)
+}
+
+// getAllCommittedPackets returns all committed packets from `srcChain`
+// on the given channel, ordered by sequence
+func getAllCommittedPackets(
+	s *CCVTestSuite,
+	srcChain *ibctesting.TestChain,
+	srcPortID string,
+	srcChannelID string,
+	expectedPackets int,
+	msgAndArgs ...interface{},
+) []channeltypes.Packet {
 	// check that the packets are committed in  state
 	commitments := srcChain.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
 		srcChain.GetContext(),
@@ -243,20 +258,41 @@ func relayAllCommittedPackets(
 		fmt.Sprintf("actual number of packet commitments does not match expectation; expected: %d - got: %d", expectedPackets, len(commitments)),
 	)
 
-	// relay all packets from srcChain to counterparty
+	packets := []channeltypes.Packet{}
 	for _, commitment := range commitments {
-		// - get packets
 		packet, found := s.getSentPacket(srcChain, commitment.Sequence, srcChannelID)
 		s.Require().True(
 			found,
 			fmt.Sprintf("did not find sent packet; %s", msgAndArgs...),
 		)
-		// - relay the packet
+		packets = append(packets, packet)
+	}
+	// the commitments are ordered by the string representation of their sequence
+	sort.Slice(packets, func(i, j int) bool {
+		return packets[i].Sequence < packets[j].Sequence
+	})
+	return packets
+}
+
+// relayPackets relays the given packets on `path`, in order
+func relayPackets(s *CCVTestSuite, path *ibctesting.Path, packets []channeltypes.Packet) {
+	for _, packet := range packets {
 		err := path.RelayPacket(packet)
 		s.Require().NoError(
 			err,
 			fmt.Sprintf("error while relaying packets; %v", err),
 		)
+		checkConsumerValset(s, path, packet)
+	}
+}
+
+// relayAllCommittedPacketsToConsumers relays all committed packets from the provider chain
+// to each of the consumer chains, expecting `expectedPackets` packets on every CCV channel
+func relayAllCommittedPacketsToConsumers(s *CCVTestSuite, expectedPackets int) {
+	for consumerId, bundle := range s.consumerBundles {
+		relayAllCommittedPackets(s, s.providerChain, bundle.Path,
+			ccv.ProviderPortID, bundle.Path.EndpointB.ChannelID, expectedPackets,
+			fmt.Sprintf("consumer %s", consumerId))
 	}
 }
 
@@ -332,7 +368,16 @@ func (suite *CCVTestSuite) commitConsumerPacket(ctx sdk.Context, packetData ccv.
 	oldBlockTime := ctx.BlockTime()
 	timeout := uint64(oldBlockTime.Add(ccv.DefaultCCVTimeoutPeriod).UnixNano())
 
-	packet := suite.newPacketFromConsumer(packetData.GetBytes(), 1, suite.path, clienttypes.Height{}, timeout)
+	packetBytes := packetData.GetBytes()
+	// the consumer sends ConsumerPacketDataV2 envelopes if the provider supports them
+	consumerKeeper := suite.consumerApp.GetConsumerKeeper()
+	if consumerKeeper.IsProviderFeatureSupported(ctx, ccv.FeatureConsumerPacketDataV2) {
+		packetDataV2, err := packetData.ToV2()
+		suite.Require().NoError(err)
+		packetBytes = packetDataV2.GetBytes()
+	}
+
+	packet := suite.newPacketFromConsumer(packetBytes, 1, suite.path, clienttypes.Height{}, timeout)
 
 	return channeltypes.CommitPacket(packet)
 }
@@ -379,77 +424,6 @@ func (s *CCVTestSuite) constructSlashPacketFromConsumerWithData(bundle icstestin
 	), spdData
 }
 
-// incrementTime increments the overall time by jumpPeriod
-// while updating to not expire the clients
-func incrementTime(s *CCVTestSuite, jumpPeriod time.Duration) {
-	// get trusting period of client on provider endpoint
-	cs, ok := s.providerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.providerCtx(), s.path.EndpointB.ClientID)
-	s.Require().True(ok)
-	providerEndpointTP := cs.(*ibctm.ClientState).TrustingPeriod
-	// get trusting period of client on consumer endpoint
-	cs, ok = s.consumerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.consumerCtx(), s.path.EndpointA.ClientID)
-	s.Require().True(ok)
-	consumerEndpointTP := cs.(*ibctm.ClientState).TrustingPeriod
-	// find the minimum trusting period
-	var minTP time.Duration
-	if providerEndpointTP < consumerEndpointTP {
-		minTP = providerEndpointTP
-	} else {
-		minTP = consumerEndpointTP
-	}
-	// jumpStep is the maximum interval at which both clients are updated
-	jumpStep := minTP / 2
-	for jumpPeriod > 0 {
-		var step time.Duration
-		if jumpPeriod < jumpStep {
-			step = jumpPeriod
-		} else {
-			step = jumpStep
-		}
-		s.coordinator.IncrementTimeBy(step)
-		// update the provider client on the consumer
-		err := s.path.EndpointA.UpdateClient()
-		s.Require().NoError(err)
-		// update the consumer client on the provider
-		err = s.path.EndpointB.UpdateClient()
-		s.Require().NoError(err)
-		jumpPeriod -= step
-	}
-}
-
-// incrementTimeWithoutUpdate increments the overall time by jumpPeriod
-// without updating the client to the `noUpdate` chain
-func incrementTimeWithoutUpdate(s *CCVTestSuite, jumpPeriod time.Duration, noUpdate ChainType) {
-	var trustingPeriod time.Duration
-	var endpointToUpdate *ibctesting.Endpoint
-	if noUpdate == Consumer {
-		cs, ok := s.consumerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.consumerCtx(), s.path.EndpointA.ClientID)
-		s.Require().True(ok)
-		trustingPeriod = cs.(*ibctm.ClientState).TrustingPeriod
-		endpointToUpdate = s.path.EndpointA
-	} else {
-		cs, ok := s.providerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.providerCtx(), s.path.EndpointB.ClientID)
-		s.Require().True(ok)
-		trustingPeriod = cs.(*ibctm.ClientState).TrustingPeriod
-		endpointToUpdate = s.path.EndpointB
-	}
-	// jumpStep is the maximum interval at which the client on endpointToUpdate is updated
-	jumpStep := trustingPeriod / 2
-	for jumpPeriod > 0 {
-		var step time.Duration
-		if jumpPeriod < jumpStep {
-			step = jumpPeriod
-		} else {
-			step = jumpStep
-		}
-		s.coordinator.IncrementTimeBy(step)
-		// update the client
-		err := endpointToUpdate.UpdateClient()
-		s.Require().NoError(err)
-		jumpPeriod -= step
-	}
-}
-
 // CreateCustomClient creates an IBC client on the endpoint
 // using the given unbonding period.
 // It will update the clientID for the endpoint if the message
@@ -545,11 +519,92 @@ func (s *CCVTestSuite) mustGetStakingValFromTmVal(tmVal tmtypes.Validator) (stak
 	return stakingVal
 }
 
-// nextEpoch moves `chain` forward by an epoch
-func (s *CCVTestSuite) nextEpoch() {
+// AdvanceTime moves the clocks of the provider and all the consumer chains forward by jumpPeriod.
+// The time is advanced in steps of at most half the minimum trusting period of the CCV clients,
+// and after every step the active CCV clients of all the consumer chains are updated, which commits blocks
+// on every chain, so that the clients do not expire and the time-dependent logic of BeginBlock and
+// EndBlock runs along the way. Note that the client updates advance the clocks by a few extra seconds.
+func (s *CCVTestSuite) AdvanceTime(jumpPeriod time.Duration) {
+	s.advanceTime(jumpPeriod, nil)
+}
+
+// advanceTime moves the clocks of all the chains forward by jumpPeriod (see AdvanceTime), while updating
+// all the active CCV clients except for the client hosted on the `noUpdate` endpoint
+func (s *CCVTestSuite) advanceTime(jumpPeriod time.Duration, noUpdate *ibctesting.Endpoint) {
+	// collect the endpoints hosting the clients to update; expired clients cannot be updated
+	endpoints := []*ibctesting.Endpoint{}
+	for _, bundle := range s.sortedConsumerBundles() {
+		for _, endpoint := range []*ibctesting.Endpoint{bundle.Path.EndpointA, bundle.Path.EndpointB} {
+			status := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientStatus(
+				endpoint.Chain.GetContext(), endpoint.ClientID)
+			if endpoint != noUpdate && status == exported.Active {
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	// find the minimum trusting period of the clients to update
+	var minTP time.Duration
+	for _, endpoint := range endpoints {
+		cs, ok := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientState(
+			endpoint.Chain.GetContext(), endpoint.ClientID)
+		s.Require().True(ok)
+		if tp := cs.(*ibctm.ClientState).TrustingPeriod; minTP == 0 || tp < minTP {
+			minTP = tp
+		}
+	}
+	if minTP == 0 {
+		// no clients to keep alive
+		s.coordinator.IncrementTimeBy(jumpPeriod)
+		return
+	}
+
+	// jumpStep is the maximum interval at which the clients are updated
+	jumpStep := minTP / 2
+	for jumpPeriod > 0 {
+		step := min(jumpPeriod, jumpStep)
+		s.coordinator.IncrementTimeBy(step)
+		for _, endpoint := range endpoints {
+			err := endpoint.UpdateClient()
+			s.Require().NoError(err)
+		}
+		jumpPeriod -= step
+	}
+}
+
+// AdvanceEpochs moves the provider chain forward by numEpochs epochs. Every block is committed
+// through the coordinator, which moves the clocks of the provider and all the consumer chains forward.
+func (s *CCVTestSuite) AdvanceEpochs(numEpochs int) {
 	blocksPerEpoch := s.providerApp.GetProviderKeeper().GetParams(s.providerCtx()).BlocksPerEpoch
 
-	for i := int64(0); i < blocksPerEpoch; i++ {
-		s.providerChain.NextBlock()
+	for i := int64(0); i < int64(numEpochs)*blocksPerEpoch; i++ {
+		s.coordinator.CommitBlock(s.providerChain)
+	}
+}
+
+// AdvanceToSpawnTime moves the clocks of the provider and all the consumer chains forward to the spawn time
+// of the consumer chain with the given consumer id (see AdvanceTime) and then commits a block on the provider,
+// so that the consumer chain is launched in BeginBlock
+func (s *CCVTestSuite) AdvanceToSpawnTime(consumerId string) {
+	initializationParameters, err := s.providerApp.GetProviderKeeper().GetConsumerInitializationParameters(
+		s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+
+	if jumpPeriod := initializationParameters.SpawnTime.Sub(s.coordinator.CurrentTime); jumpPeriod > 0 {
+		s.AdvanceTime(jumpPeriod)
+	}
+	s.coordinator.CommitBlock(s.providerChain)
+}
+
+// sortedConsumerBundles returns the consumer bundles sorted by consumer id,
+// so that iterating over the consumer chains is deterministic
+func (s *CCVTestSuite) sortedConsumerBundles() []*icstestingutils.ConsumerBundle {
+	bundles := make([]*icstestingutils.ConsumerBundle, 0, len(s.consumerBundles))
+	for _, bundle := range s.consumerBundles {
+		bundles = append(bundles, bundle)
 	}
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].ConsumerId < bundles[j].ConsumerId
+	})
+	return bundles
 }
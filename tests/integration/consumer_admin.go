@@ -0,0 +1,109 @@
+package integration
+
+import (
+	"time"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// TestProviderGovernanceConsumerAdmin tests that provider governance can update the consumer parameters
+// through the interchain account owned by the provider authority.
+// @Long Description@
+// * Set up CCV channel and relay a VSC packet.
+// * Register the interchain account of the provider authority through a provider governance proposal.
+// * Complete the handshake of the interchain account channel and check that the consumer recognizes
+// the interchain account as an authority.
+// * Submit a provider governance proposal that sends a consumer MsgUpdateParams through the interchain account.
+// * Relay the interchain account packet and check that the consumer parameters are updated.
+func (s *CCVTestSuite) TestProviderGovernanceConsumerAdmin() {
+	s.SetupCCVChannel(s.path)
+	// establish the CCV channel on the consumer
+	s.SendEmptyVSCPacket()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	// the interchain account is not registered yet
+	_, found := consumerKeeper.GetProviderAdminAccount(s.consumerCtx())
+	s.Require().False(found)
+
+	govKeeper := s.providerApp.GetTestGovKeeper()
+	params, err := govKeeper.Params.Get(s.providerCtx())
+	s.Require().NoError(err)
+	votingPeriod := 3 * time.Second
+	params.VotingPeriod = &votingPeriod
+	s.Require().NoError(govKeeper.Params.Set(s.providerCtx(), params))
+
+	// execute the given messages through a provider governance proposal
+	executeProposal := func(msgs []sdk.Msg) {
+		err := submitProposalWithDepositAndVote(*govKeeper, s.providerCtx(), msgs,
+			s.providerChain.SenderAccounts, s.providerChain.SenderAccount.GetAddress(), params.MinDeposit, false)
+		s.Require().NoError(err)
+		s.providerChain.ProposedHeader.Time = s.providerChain.ProposedHeader.Time.Add(votingPeriod)
+		s.providerChain.NextBlock()
+	}
+
+	msgRegister, err := providerKeeper.NewMsgRegisterConsumerAdminAccount(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	executeProposal([]sdk.Msg{msgRegister})
+
+	// the proposal initialized the interchain account channel on the provider
+	controllerPortId, err := icatypes.NewControllerPortID(providerKeeper.GetAuthority())
+	s.Require().NoError(err)
+	channels := s.providerApp.GetIBCKeeper().ChannelKeeper.GetAllChannelsWithPortPrefix(s.providerCtx(), controllerPortId)
+	s.Require().Len(channels, 1)
+	s.Require().Equal(channeltypes.INIT, channels[0].State)
+
+	icaPath := ibctesting.NewPath(s.consumerChain, s.providerChain)
+	icaPath.EndpointA.ClientID = s.path.EndpointA.ClientID
+	icaPath.EndpointA.ConnectionID = s.path.EndpointA.ConnectionID
+	icaPath.EndpointA.ChannelConfig.PortID = icatypes.HostPortID
+	icaPath.EndpointA.ChannelConfig.Version = channels[0].Version
+	icaPath.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	icaPath.EndpointB.ClientID = s.path.EndpointB.ClientID
+	icaPath.EndpointB.ConnectionID = s.path.EndpointB.ConnectionID
+	icaPath.EndpointB.ChannelID = channels[0].ChannelId
+	icaPath.EndpointB.ChannelConfig.PortID = controllerPortId
+	icaPath.EndpointB.ChannelConfig.Version = channels[0].Version
+	icaPath.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+
+	s.Require().NoError(icaPath.EndpointA.ChanOpenTry())
+	s.Require().NoError(icaPath.EndpointB.ChanOpenAck())
+	s.Require().NoError(icaPath.EndpointA.ChanOpenConfirm())
+
+	// the interchain account is registered on the consumer
+	icaAddress, found := consumerKeeper.GetProviderAdminAccount(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().True(consumerKeeper.IsAuthority(s.consumerCtx(), icaAddress))
+	s.Require().False(consumerKeeper.IsAuthority(s.consumerCtx(), s.consumerChain.SenderAccount.GetAddress().String()))
+
+	oldParams := consumerKeeper.GetConsumerParams(s.consumerCtx())
+	modifiedParams := oldParams
+	modifiedParams.RetryDelayPeriod = 7200 * time.Second
+	s.Require().NotEqual(oldParams.RetryDelayPeriod, modifiedParams.RetryDelayPeriod)
+
+	msgSendTx, err := providerKeeper.NewMsgSendConsumerAdminTx(s.providerCtx(), consumerId, []sdk.Msg{
+		&consumertypes.MsgUpdateParams{
+			Authority: icaAddress,
+			Params:    modifiedParams,
+		},
+	}, time.Hour)
+	s.Require().NoError(err)
+	executeProposal([]sdk.Msg{msgSendTx})
+
+	// relay the interchain account packet to the consumer
+	relayAllCommittedPackets(s, s.providerChain, icaPath, controllerPortId, icaPath.EndpointB.ChannelID, 1)
+
+	s.Require().Equal(modifiedParams, consumerKeeper.GetConsumerParams(s.consumerCtx()))
+
+	// the packet was acknowledged
+	s.Require().Empty(s.providerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.providerCtx(), controllerPortId, icaPath.EndpointB.ChannelID))
+}
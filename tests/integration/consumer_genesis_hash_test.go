@@ -0,0 +1,120 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"maps"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	appConsumer "github.com/cosmos/interchain-security/v7/app/consumer"
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumercli "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client/cli"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerLaunchWithGenesisHash tests that a consumer chain that opted in to the genesis hash verification
+// starts with the genesis it committed to and refuses to start with any other genesis.
+// @Long Description@
+// * Compute the genesis hash of a consumer genesis file with the `genesis hash` command.
+// * Register a consumer chain on the provider that commits to this hash and requires its verification.
+// * Launch the consumer chain on the provider and add the consumer genesis produced by the provider to the consumer genesis.
+// * Check that the consumer chain starts with the genesis it committed to.
+// * Check that the consumer chain refuses to start with a modified genesis.
+func TestConsumerLaunchWithGenesisHash(t *testing.T) {
+	s := NewCCVTestSuite[*appProvider.App, *appConsumer.App](
+		icstestingutils.ProviderAppIniter, icstestingutils.ConsumerAppIniter, []string{}, WithNumConsumers(1))
+	s.SetT(t)
+	s.SetupTest()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	chainID := "genesis-hash-chain"
+
+	// compute the genesis hash of the consumer genesis, without the consumer CCV module genesis,
+	// which is produced by the provider chain at spawn time
+	_, appState := icstestingutils.ConsumerAppIniter(nil)()
+	appStateBz, err := json.Marshal(appState)
+	s.Require().NoError(err)
+	genFile := filepath.Join(t.TempDir(), "genesis.json")
+	s.Require().NoError(genutiltypes.NewAppGenesisWithVersion(chainID, appStateBz).SaveAs(genFile))
+
+	cmd := consumercli.NewGenesisHashCmd()
+	out := new(bytes.Buffer)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{genFile})
+	s.Require().NoError(cmd.Execute())
+	genesisHash, err := hex.DecodeString(strings.TrimSpace(out.String()))
+	s.Require().NoError(err)
+
+	// register and launch a consumer chain that commits to the genesis hash and requires its verification
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = s.coordinator.CurrentTime
+	initializationParameters.GenesisHash = genesisHash
+	initializationParameters.VerifyGenesisHash = true
+	s.Require().NoError(providertypes.ValidateInitializationParameters(initializationParameters))
+	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	powerShapingParameters.Top_N = 100
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(s.providerCtx())
+	providerKeeper.SetConsumerChainId(s.providerCtx(), consumerId, chainID)
+	s.Require().NoError(providerKeeper.SetConsumerMetadata(s.providerCtx(), consumerId, testkeeper.GetTestConsumerMetadata()))
+	s.Require().NoError(providerKeeper.SetConsumerInitializationParameters(s.providerCtx(), consumerId, initializationParameters))
+	s.Require().NoError(providerKeeper.SetConsumerPowerShapingParameters(s.providerCtx(), consumerId, powerShapingParameters))
+	s.Require().NoError(providerKeeper.SetInfractionParameters(s.providerCtx(), consumerId, testkeeper.GetTestInfractionParameters()))
+	providerKeeper.SetConsumerPhase(s.providerCtx(), consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	s.Require().NoError(providerKeeper.AppendConsumerToBeLaunched(s.providerCtx(), consumerId, initializationParameters.SpawnTime))
+
+	s.coordinator.CommitBlock(s.providerChain)
+	s.Require().Equal(providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+
+	consumerGenesis, found := providerKeeper.GetConsumerGenesis(s.providerCtx(), consumerId)
+	s.Require().True(found)
+	s.Require().Equal(genesisHash, consumerGenesis.GenesisHash)
+	s.Require().True(consumerGenesis.VerifyGenesisHash)
+
+	// initChain starts a new consumer chain with the given genesis
+	// patched with the consumer genesis produced by the provider chain
+	initChain := func(appState map[string]json.RawMessage) {
+		testApp, _ := icstestingutils.ConsumerAppIniter(nil)()
+		consumerApp := testApp.(*appConsumer.App)
+
+		appState = maps.Clone(appState)
+		appState[consumertypes.ModuleName] = consumerApp.AppCodec().MustMarshalJSON(&consumerGenesis)
+		stateBytes, err := json.Marshal(appState)
+		s.Require().NoError(err)
+
+		res, err := consumerApp.InitChain(&abci.RequestInitChain{
+			Validators:      []abci.ValidatorUpdate{},
+			AppStateBytes:   stateBytes,
+			ConsensusParams: simtestutil.DefaultConsensusParams,
+		})
+		s.Require().NoError(err)
+		// the consumer chain starts with the initial validator set computed by the provider chain
+		s.Require().Equal(consumerGenesis.Provider.InitialValSet, res.Validators)
+	}
+
+	// the consumer chain starts with the genesis it committed to
+	s.Require().NotPanics(func() { initChain(appState) })
+
+	// the consumer chain refuses to start with a modified genesis
+	cdc := appConsumer.MakeTestEncodingConfig().Codec
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(appState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Params.DefaultSendEnabled = !bankGenesis.Params.DefaultSendEnabled
+	modifiedAppState := maps.Clone(appState)
+	modifiedAppState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+	s.Require().Panics(func() { initChain(modifiedAppState) })
+
+	s.TearDownTest()
+}
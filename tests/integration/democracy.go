@@ -14,6 +14,7 @@ import (
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
@@ -215,7 +216,7 @@ func (s *ConsumerDemocracyTestSuite) TestDemocracyMsgUpdateParams() {
 		Params:    modifiedParams,
 	}
 
-	err = submitProposalWithDepositAndVote(govKeeper, s.consumerCtx(), []sdk.Msg{msg}, votingAccounts, proposer.GetAddress(), depositAmount)
+	err = submitProposalWithDepositAndVote(govKeeper, s.consumerCtx(), []sdk.Msg{msg}, votingAccounts, proposer.GetAddress(), depositAmount, false)
 	s.Assert().NoError(err)
 	// set current header time to be equal or later than voting end time in order to process proposal from active queue,
 	// once the proposal is added to the chain
@@ -233,6 +234,126 @@ func (s *ConsumerDemocracyTestSuite) TestDemocracyMsgUpdateParams() {
 	s.Assert().Equal(votersOldBalances, getAccountsBalances(s.consumerCtx(), bankKeeper, bondDenom, votingAccounts))
 }
 
+// TestDemocracyExpeditedProposal checks that expedited governance proposals are supported on democracy consumer chains.
+// @Long Description@
+// * Set up a democracy consumer chain with an expedited voting period shorter than the regular voting period.
+// * Submit an expedited proposal containing changes to the consumer module parameters and vote on it with the governators.
+// * Check that the proposal is executed once the expedited voting period elapses, and the parameters are updated.
+func (s *ConsumerDemocracyTestSuite) TestDemocracyExpeditedProposal() {
+	govKeeper := s.consumerApp.GetTestGovKeeper()
+	params, err := govKeeper.Params.Get(s.consumerCtx())
+	s.Require().NoError(err)
+
+	votingPeriod := time.Hour
+	expeditedVotingPeriod := 3 * time.Second
+	params.VotingPeriod = &votingPeriod
+	params.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	err = govKeeper.Params.Set(s.consumerCtx(), params)
+	s.Require().NoError(err)
+
+	proposer := s.consumerChain.SenderAccount
+	s.consumerChain.NextBlock()
+
+	oldParams := s.consumerApp.GetConsumerKeeper().GetConsumerParams(s.consumerCtx())
+	modifiedParams := oldParams
+	modifiedParams.RetryDelayPeriod = 7200 * time.Second
+	s.Require().NotEqual(oldParams.RetryDelayPeriod, modifiedParams.RetryDelayPeriod)
+
+	msg := &consumertypes.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    modifiedParams,
+	}
+
+	err = submitProposalWithDepositAndVote(govKeeper, s.consumerCtx(), []sdk.Msg{msg}, s.consumerChain.SenderAccounts,
+		proposer.GetAddress(), params.ExpeditedMinDeposit, true)
+	s.Require().NoError(err)
+
+	// the proposal is executed once the expedited voting period elapses, well before the regular voting period
+	s.consumerChain.ProposedHeader.Time = s.consumerChain.ProposedHeader.Time.Add(expeditedVotingPeriod)
+	s.consumerChain.NextBlock()
+
+	newParams := s.consumerApp.GetConsumerKeeper().GetConsumerParams(s.consumerCtx())
+	s.Require().Equal(modifiedParams.RetryDelayPeriod, newParams.RetryDelayPeriod)
+}
+
+// TestDemocracyWeightedVotes checks that weighted votes are tallied correctly on democracy consumer chains.
+// @Long Description@
+// * Set up a democracy consumer chain and have three accounts delegate to a consumer validator.
+// * Submit a proposal and vote on it with a mix of weighted (split) and plain ballots.
+// * Check that the weighted votes are stored with all their options.
+// * Check that the tally splits the voting power of every voter according to the weights of its ballot.
+func (s *ConsumerDemocracyTestSuite) TestDemocracyWeightedVotes() {
+	govKeeper := s.consumerApp.GetTestGovKeeper()
+	stakingKeeper := s.consumerApp.GetTestStakingKeeper()
+	msgServer := govkeeper.NewMsgServerImpl(&govKeeper)
+	queryServer := govkeeper.NewQueryServer(&govKeeper)
+	params, err := govKeeper.Params.Get(s.consumerCtx())
+	s.Require().NoError(err)
+
+	validators, err := stakingKeeper.GetAllValidators(s.consumerCtx())
+	s.Require().NoError(err)
+	s.Require().NotEmpty(validators)
+	valAddr, err := sdk.ValAddressFromBech32(validators[0].GetOperator())
+	s.Require().NoError(err)
+
+	// the voters delegate to a validator that does not vote itself,
+	// so that the voting power of every voter is exactly its delegation
+	voters := s.consumerChain.SenderAccounts[1:4]
+	delegations := []math.Int{math.NewInt(1000000), math.NewInt(2000000), math.NewInt(1000000)}
+	for i, voter := range voters {
+		validator, err := stakingKeeper.GetValidator(s.consumerCtx(), valAddr)
+		s.Require().NoError(err)
+		_, err = stakingKeeper.Delegate(s.consumerCtx(), voter.SenderAccount.GetAddress(), delegations[i], stakingtypes.Unbonded, validator, true)
+		s.Require().NoError(err)
+	}
+
+	proposer := s.consumerChain.SenderAccount
+	proposal, err := govKeeper.SubmitProposal(s.consumerCtx(), []sdk.Msg{}, "", "title", "summary", proposer.GetAddress(), false)
+	s.Require().NoError(err)
+	_, err = govKeeper.AddDeposit(s.consumerCtx(), proposal.Id, proposer.GetAddress(), params.MinDeposit) // proposal becomes active
+	s.Require().NoError(err)
+
+	ballots := []govv1.WeightedVoteOptions{
+		{
+			govv1.NewWeightedVoteOption(govv1.OptionYes, math.LegacyNewDecWithPrec(6, 1)),
+			govv1.NewWeightedVoteOption(govv1.OptionNo, math.LegacyNewDecWithPrec(4, 1)),
+		},
+		govv1.NewNonSplitVoteOption(govv1.OptionYes),
+		{
+			govv1.NewWeightedVoteOption(govv1.OptionNo, math.LegacyNewDecWithPrec(5, 1)),
+			govv1.NewWeightedVoteOption(govv1.OptionAbstain, math.LegacyNewDecWithPrec(5, 1)),
+		},
+	}
+	for i, voter := range voters {
+		if len(ballots[i]) == 1 {
+			_, err = msgServer.Vote(s.consumerCtx(), govv1.NewMsgVote(voter.SenderAccount.GetAddress(), proposal.Id, ballots[i][0].Option, ""))
+		} else {
+			_, err = msgServer.VoteWeighted(s.consumerCtx(), govv1.NewMsgVoteWeighted(voter.SenderAccount.GetAddress(), proposal.Id, ballots[i], ""))
+		}
+		s.Require().NoError(err)
+	}
+
+	// the weighted votes are stored with all their options
+	for i, voter := range voters {
+		res, err := queryServer.Vote(s.consumerCtx(), &govv1.QueryVoteRequest{ProposalId: proposal.Id, Voter: voter.SenderAccount.GetAddress().String()})
+		s.Require().NoError(err)
+		s.Require().Len(res.Vote.Options, len(ballots[i]))
+		for j, option := range res.Vote.Options {
+			s.Require().Equal(ballots[i][j].Option, option.Option)
+			s.Require().Equal(ballots[i][j].Weight, option.Weight)
+		}
+	}
+
+	proposal, err = govKeeper.Proposals.Get(s.consumerCtx(), proposal.Id)
+	s.Require().NoError(err)
+	_, _, tally, err := govKeeper.Tally(s.consumerCtx(), proposal)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(2600000).String(), tally.YesCount)
+	s.Require().Equal(math.NewInt(900000).String(), tally.NoCount)
+	s.Require().Equal(math.NewInt(500000).String(), tally.AbstainCount)
+	s.Require().Equal(math.ZeroInt().String(), tally.NoWithVetoCount)
+}
+
 // TestDemocracyValidatorUnjail checks that the consumer validator can be unjailed when there is a standalone staking keeper available.
 // @Long Description@
 // * Set up a democracy consumer chain.
@@ -280,9 +401,9 @@ func (s *ConsumerDemocracyTestSuite) TestDemocracyValidatorUnjail() {
 }
 
 func submitProposalWithDepositAndVote(govKeeper govkeeper.Keeper, ctx sdk.Context, msgs []sdk.Msg,
-	accounts []ibctesting.SenderAccount, proposer sdk.AccAddress, depositAmount sdk.Coins,
+	accounts []ibctesting.SenderAccount, proposer sdk.AccAddress, depositAmount sdk.Coins, expedited bool,
 ) error {
-	proposal, err := govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", proposer, false)
+	proposal, err := govKeeper.SubmitProposal(ctx, msgs, "", "title", "summary", proposer, expedited)
 	if err != nil {
 		return err
 	}
@@ -1,11 +1,13 @@
 package integration
 
 import (
+	"slices"
 	"strings"
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 
 	"cosmossdk.io/math"
 
@@ -36,7 +38,7 @@ func (s *CCVTestSuite) TestRewardsDistribution() {
 	bondAmt := math.NewInt(10000000)
 	delAddr := s.providerChain.SenderAccount.GetAddress()
 	delegate(s, delAddr, bondAmt)
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// register a consumer reward denom
 	params := s.consumerApp.GetConsumerKeeper().GetConsumerParams(s.consumerCtx())
@@ -196,7 +198,7 @@ func (s *CCVTestSuite) TestSendRewardsRetries() {
 	bondAmt := math.NewInt(10000000)
 	delAddr := s.providerChain.SenderAccount.GetAddress()
 	delegate(s, delAddr, bondAmt)
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// Register denom on consumer chain
 	params := s.consumerApp.GetConsumerKeeper().GetConsumerParams(s.consumerCtx())
@@ -330,7 +332,7 @@ func (s *CCVTestSuite) TestEndBlockRD() {
 		bondAmt := math.NewInt(10000000)
 		delAddr := s.providerChain.SenderAccount.GetAddress()
 		delegate(s, delAddr, bondAmt)
-		s.nextEpoch()
+		s.AdvanceEpochs(1)
 
 		if tc.denomRegistered {
 			params := s.consumerApp.GetConsumerKeeper().GetConsumerParams(s.consumerCtx())
@@ -872,6 +874,91 @@ func (s *CCVTestSuite) prepareRewardDist() {
 	s.coordinator.CommitNBlocks(s.consumerChain, uint64(blocksToGo))
 }
 
+// sendRewardsOverTransferPath makes the consumer chain with the given consumer id send the given rewards to the provider
+// chain over the transfer channel of `transferPath`, through the reward distribution sub-protocol, and relays the transfers.
+// The rewards are taken from the sender account of the consumer chain. It returns the rewards received by the provider,
+// i.e., in the IBC denoms of the transfer channel on the provider, which may include the block rewards of the consumer.
+func (s *CCVTestSuite) sendRewardsOverTransferPath(
+	consumerId string,
+	transferPath *ibctesting.Path,
+	rewards sdk.Coins,
+) sdk.Coins {
+	bundle := s.consumerBundles[consumerId]
+	consumerKeeper := bundle.GetKeeper()
+
+	// send the rewards over the given transfer channel during the next block
+	params := consumerKeeper.GetConsumerParams(bundle.GetCtx())
+	blocksPerDistributionTransmission := params.BlocksPerDistributionTransmission
+	params.DistributionTransmissionChannel = transferPath.EndpointA.ChannelID
+	params.RewardDenoms = rewards.Denoms()
+	params.BlocksPerDistributionTransmission = 1
+	consumerKeeper.SetParams(bundle.GetCtx(), params)
+
+	err := bundle.App.GetTestBankKeeper().SendCoinsFromAccountToModule(
+		bundle.GetCtx(),
+		bundle.Chain.SenderAccount.GetAddress(),
+		consumertypes.ConsumerToSendToProviderName,
+		rewards,
+	)
+	s.Require().NoError(err)
+	bundle.Chain.NextBlock()
+
+	// restore the transmission frequency, so that no other rewards are sent
+	params.BlocksPerDistributionTransmission = blocksPerDistributionTransmission
+	consumerKeeper.SetParams(bundle.GetCtx(), params)
+
+	// every reward denom is sent in a separate transfer
+	packets := getAllCommittedPackets(s, bundle.Chain, transfertypes.PortID, transferPath.EndpointA.ChannelID, len(rewards))
+	received := sdk.NewCoins()
+	for _, packet := range packets {
+		var data transfertypes.FungibleTokenPacketData
+		s.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+		amount, ok := math.NewIntFromString(data.Amount)
+		s.Require().True(ok)
+		prefixedDenom := ccv.GetPrefixedDenom(transfertypes.PortID, transferPath.EndpointB.ChannelID, data.Denom)
+		received = received.Add(sdk.NewCoin(ccv.ParseDenomTrace(prefixedDenom).IBCDenom(), amount))
+	}
+	relayPackets(s, transferPath, packets)
+	return received
+}
+
+// distributeConsumerRewards advances a block on the provider chain, so that the rewards received from a consumer chain
+// are distributed, and checks that the given rewards were distributed if and only if their denoms are allowlisted,
+// either for all the consumer chains or for the given consumer chain. For the distributed rewards, only the decimals
+// resulting from the distribution are expected to remain in the rewards allocations of the consumer chain.
+func (s *CCVTestSuite) distributeConsumerRewards(consumerId string, rewards sdk.Coins) {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	providerBankKeeper := s.providerApp.GetTestBankKeeper()
+	distrAcct := s.providerApp.GetTestDistributionKeeper().GetDistributionAccount(s.providerCtx())
+
+	consumerAllowlistedDenoms, err := providerKeeper.GetAllowlistedRewardDenoms(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	distrBalance := providerBankKeeper.GetAllBalances(s.providerCtx(), distrAcct.GetAddress())
+
+	// BeginBlockRD distributes the rewards
+	s.providerChain.NextBlock()
+
+	newDistrBalance := providerBankKeeper.GetAllBalances(s.providerCtx(), distrAcct.GetAddress())
+	for _, reward := range rewards {
+		rewardsAlloc, err := providerKeeper.GetConsumerRewardsAllocationByDenom(s.providerCtx(), consumerId, reward.Denom)
+		s.Require().NoError(err)
+		distributed := newDistrBalance.AmountOf(reward.Denom).Sub(distrBalance.AmountOf(reward.Denom))
+
+		if providerKeeper.ConsumerRewardDenomExists(s.providerCtx(), reward.Denom) ||
+			slices.Contains(consumerAllowlistedDenoms, reward.Denom) {
+			s.Require().True(rewardsAlloc.Rewards.AmountOf(reward.Denom).LTE(math.LegacyOneDec()),
+				"rewards in %s are not distributed", reward.Denom)
+			s.Require().True(distributed.IsPositive(), "rewards in %s are not distributed", reward.Denom)
+			s.Require().True(distributed.LTE(reward.Amount), "more rewards in %s are distributed than received", reward.Denom)
+		} else {
+			s.Require().Equal(math.LegacyNewDecFromInt(reward.Amount), rewardsAlloc.Rewards.AmountOf(reward.Denom),
+				"rewards in %s are distributed, although the denom is not allowlisted", reward.Denom)
+			s.Require().True(distributed.IsZero(),
+				"rewards in %s are distributed, although the denom is not allowlisted", reward.Denom)
+		}
+	}
+}
+
 // TestAllocateTokensToConsumerValidators tests the allocation of tokens to consumer validators.
 // @Long Description@
 // * The test exclusively uses the provider chain.
@@ -1227,3 +1314,59 @@ func (s *CCVTestSuite) TestMultiConsumerRewardsDistribution() {
 		s.Require().True(providerRewardsDelta.Amount.GTE(pool.AmountOf(provIBCDenom)))
 	}
 }
+
+// TestMultiChannelRewardsDistribution tests the distribution of the rewards sent by a consumer chain
+// in several denoms and over several transfer channels.
+// @Long Description@
+// * Set up the CCV channel, the transfer channel of the consumer chain and an additional transfer channel.
+// * Send rewards in two denoms over the transfer channel of the consumer chain,
+// and in one of these denoms over the additional transfer channel.
+// * Check that the provider receives the rewards sent over every channel in different IBC denoms,
+// and that the rewards are allocated to the consumer chain.
+// * Allowlist the denoms received over the transfer channel of the consumer chain for all the consumer chains,
+// and check that only the rewards in these denoms are distributed.
+// * Allowlist the denom received over the additional transfer channel for the consumer chain,
+// and check that the rewards in this denom are distributed.
+func (s *CCVTestSuite) TestMultiChannelRewardsDistribution() {
+	s.SetupCCVChannel(s.path)
+	s.SetupTransferChannel()
+	s.SendEmptyVSCPacket()
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	bundle := s.getFirstBundle()
+
+	additionalTransferPath := s.SetupAdditionalTransferChannel(bundle)
+	s.Require().NotEqual(s.transferPath.EndpointB.ChannelID, additionalTransferPath.EndpointB.ChannelID)
+
+	// send rewards over both transfer channels
+	rewards := s.sendRewardsOverTransferPath(bundle.ConsumerId, s.transferPath, sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(1000)),
+		sdk.NewCoin(ibctesting.SecondaryDenom, math.NewInt(500)),
+	))
+	s.Require().Len(rewards, 2)
+	additionalRewards := s.sendRewardsOverTransferPath(bundle.ConsumerId, additionalTransferPath, sdk.NewCoins(
+		sdk.NewCoin(ibctesting.SecondaryDenom, math.NewInt(300)),
+	))
+	s.Require().Len(additionalRewards, 1)
+
+	// the same denom received over different channels results in different IBC denoms
+	for _, reward := range rewards {
+		s.Require().True(additionalRewards.AmountOf(reward.Denom).IsZero())
+	}
+
+	// the rewards are allocated to the consumer chain, but not distributed
+	rewardPool := s.providerApp.GetTestAccountKeeper().GetModuleAccount(s.providerCtx(), providertypes.ConsumerRewardsPool).GetAddress()
+	rewardCoins := s.providerApp.GetTestBankKeeper().GetAllBalances(s.providerCtx(), rewardPool)
+	s.Require().True(rewardCoins.IsAllGTE(rewards.Add(additionalRewards...)))
+	s.distributeConsumerRewards(bundle.ConsumerId, rewards.Add(additionalRewards...))
+
+	// allowlist the rewards received over the transfer channel of the consumer chain for all the consumer chains
+	for _, reward := range rewards {
+		providerKeeper.SetConsumerRewardDenom(s.providerCtx(), reward.Denom)
+	}
+	s.distributeConsumerRewards(bundle.ConsumerId, rewards.Add(additionalRewards...))
+
+	// allowlist the rewards received over the additional transfer channel for the consumer chain only
+	err := providerKeeper.SetAllowlistedRewardDenoms(s.providerCtx(), bundle.ConsumerId, additionalRewards.Denoms())
+	s.Require().NoError(err)
+	s.distributeConsumerRewards(bundle.ConsumerId, additionalRewards)
+}
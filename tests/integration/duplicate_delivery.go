@@ -0,0 +1,138 @@
+package integration
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	"cosmossdk.io/math"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestDuplicateDeliveryRegression tests that the provider and consumer chains are not affected when every packet
+// is delivered twice, interleaved with the packets of the other channels, i.e., it guards the at-least-once
+// delivery semantics of relayers. Note that the duplicates are discarded by IBC core, as the receipts of
+// the ORDERED CCV channel and of the UNORDERED transfer channel are checked before the packets reach the application.
+// @Long Description@
+// * Set up the CCV and transfer channels, bond tokens on the provider and deliver the VSC packet twice.
+// * Fund the consumer fee pool and queue a downtime slash packet for one of the consumer validators,
+// so that the consumer sends the rewards and the slash packet to the provider in the same block.
+// * Deliver the slash packet twice and check that the validator is jailed once.
+// * Deliver the rewards twice and check that the provider receives the rewards once.
+// * Deliver the VSC packet removing the jailed validator twice and check that the consumer received every
+// VSC packet once, that its validator set matches the provider and that the slash packet was acknowledged.
+func (s *CCVTestSuite) TestDuplicateDeliveryRegression() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	providerStakingKeeper := s.providerApp.GetTestStakingKeeper()
+	providerSlashingKeeper := s.providerApp.GetTestSlashingKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerBankKeeper := s.consumerApp.GetTestBankKeeper()
+	consumerAccountKeeper := s.consumerApp.GetTestAccountKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+	validatorsPerChain := len(s.consumerChain.Vals.Validators)
+
+	s.SetupCCVChannel(s.path)
+	s.SetupTransferChannel()
+
+	// send a VSC packet and deliver it twice, which establishes the CCV channel on the consumer
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(10000000))
+	s.AdvanceEpochs(1)
+	undelivered := relayCommittedPacketsWithFault(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1, DuplicateRelayFault)
+	s.Require().Empty(undelivered)
+
+	// send rewards from the consumer to the provider
+	params := consumerKeeper.GetConsumerParams(s.consumerCtx())
+	params.RewardDenoms = []string{sdk.DefaultBondDenom}
+	consumerKeeper.SetParams(s.consumerCtx(), params)
+	consumerKeeper.SetBlocksPerDistributionTransmission(s.consumerCtx(), 2)
+	s.consumerChain.NextBlock()
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100)))
+	err := consumerBankKeeper.SendCoinsFromAccountToModule(s.consumerCtx(), s.consumerChain.SenderAccount.GetAddress(), authtypes.FeeCollectorName, fees)
+	s.Require().NoError(err)
+	s.consumerChain.NextBlock()
+	toSendToProviderAddr := consumerAccountKeeper.GetModuleAccount(s.consumerCtx(), consumertypes.ConsumerToSendToProviderName).GetAddress()
+	expectedRewards := consumerBankKeeper.GetBalance(s.consumerCtx(), toSendToProviderAddr, sdk.DefaultBondDenom).Amount
+	s.Require().True(expectedRewards.IsPositive())
+
+	// send a downtime slash packet from the consumer
+	tmVal := s.consumerChain.Vals.Validators[0]
+	consumerConsAddr := providertypes.NewConsumerConsAddress(sdk.ConsAddress(tmVal.Address))
+	providerConsAddr, found := providerKeeper.GetValidatorByConsumerAddr(s.providerCtx(), consumerId, consumerConsAddr)
+	s.Require().True(found)
+	stakingVal, err := providerStakingKeeper.GetValidatorByConsAddr(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().NoError(err)
+	pk, err := stakingVal.ConsPubKey()
+	s.Require().NoError(err)
+	tmPk, err := cryptocodec.ToCmtPubKeyInterface(pk)
+	s.Require().NoError(err)
+	s.setDefaultValSigningInfo(*tmtypes.NewValidator(tmPk, stakingVal.ConsensusPower(sdk.DefaultPowerReduction)))
+
+	vscId := consumerKeeper.GetHeightValsetUpdateID(s.consumerCtx(), uint64(s.consumerCtx().BlockHeight()))
+	consumerKeeper.QueueSlashPacket(s.consumerCtx(), abci.Validator{Address: tmVal.Address, Power: tmVal.VotingPower},
+		vscId, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+
+	// the rewards and the slash packet are sent in the same block
+	s.consumerChain.NextBlock()
+
+	// deliver the slash packet twice and check that the validator is jailed once
+	undelivered = relayCommittedPacketsWithFault(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1, DuplicateRelayFault)
+	s.Require().Empty(undelivered)
+	stakingValAfter, err := providerStakingKeeper.GetValidatorByConsAddr(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().NoError(err)
+	s.Require().True(stakingValAfter.Jailed)
+	s.Require().Equal(stakingVal.Tokens, stakingValAfter.Tokens)
+	signingInfo, err := providerSlashingKeeper.GetValidatorSigningInfo(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().NoError(err)
+	jailedUntil := signingInfo.JailedUntil
+	traces := providerKeeper.GetAllSlashPacketTraces(s.providerCtx(), consumerId)
+	s.Require().Len(traces, 1)
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_HANDLED, traces[0].Outcome)
+	s.Require().True(consumerKeeper.OutstandingDowntime(s.consumerCtx(), consumerConsAddr.ToSdkConsAddr()))
+
+	// deliver the rewards twice and check that the provider receives them once
+	undelivered = relayCommittedPacketsWithFault(s, s.consumerChain, s.transferPath, transfertypes.PortID, s.transferPath.EndpointA.ChannelID, 1, DuplicateRelayFault)
+	s.Require().Empty(undelivered)
+	s.Require().True(consumerBankKeeper.GetAllBalances(s.consumerCtx(), toSendToProviderAddr).IsZero())
+	rewardPool := s.providerApp.GetTestAccountKeeper().GetModuleAccount(s.providerCtx(), providertypes.ConsumerRewardsPool).GetAddress()
+	rewardsIBCDenom := ""
+	rewardCoins := s.providerApp.GetTestBankKeeper().GetAllBalances(s.providerCtx(), rewardPool)
+	for _, coin := range rewardCoins {
+		if strings.HasPrefix(coin.Denom, "ibc") {
+			rewardsIBCDenom = coin.Denom
+		}
+	}
+	s.Require().NotEmpty(rewardsIBCDenom)
+	s.Require().Equal(expectedRewards, rewardCoins.AmountOf(rewardsIBCDenom))
+
+	// deliver the VSC packet removing the jailed validator and acknowledging the slash packet twice
+	s.AdvanceEpochs(1)
+	undelivered = relayCommittedPacketsWithFault(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1, DuplicateRelayFault)
+	s.Require().Empty(undelivered)
+
+	// check that the consumer received every VSC packet once and that its validator set matches the provider
+	nextSeqRecv, found := s.consumerApp.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(s.consumerCtx(), ccv.ConsumerPortID, s.path.EndpointA.ChannelID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(3), nextSeqRecv)
+	lastVSC, found := consumerKeeper.GetLastVSC(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().NoError(verifyConsumerValset(s, consumerId, lastVSC.ValsetUpdateId))
+	s.Require().Len(s.consumerChain.Vals.Validators, validatorsPerChain-1)
+	s.Require().False(consumerKeeper.OutstandingDowntime(s.consumerCtx(), consumerConsAddr.ToSdkConsAddr()))
+
+	// check that the duplicates did not jail the validator again
+	signingInfo, err = providerSlashingKeeper.GetValidatorSigningInfo(s.providerCtx(), providerConsAddr.ToSdkConsAddr())
+	s.Require().NoError(err)
+	s.Require().Equal(jailedUntil, signingInfo.JailedUntil)
+	s.Require().Len(providerKeeper.GetAllSlashPacketTraces(s.providerCtx(), consumerId), 1)
+}
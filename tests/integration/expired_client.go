@@ -4,6 +4,7 @@ import (
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
@@ -15,6 +16,7 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -25,13 +27,13 @@ import (
 // * While the consumer client is expired (or inactive for some reason) all packets will be queued.
 // * The packet sending and checks are then repeated.
 // * More tokens are bonded on provider to change validator powers.
-// * Upgrade expired client to the consumer and all packets are cleared once the consumer client is established.
+// * Recover expired client to the consumer and all packets are cleared once the consumer client is established.
 func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	providerKeeper := s.providerApp.GetProviderKeeper()
 
 	s.SetupCCVChannel(s.path)
 
-	expireClient(s, Consumer)
+	s.ExpireClient(s.getFirstBundle().ConsumerId, Consumer)
 
 	// bond some tokens on provider to change validator powers
 	bondAmt := math.NewInt(1000000)
@@ -39,7 +41,7 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	delegate(s, delAddr, bondAmt)
 
 	// try to send CCV packet to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// check that the packet was added to the list of pending VSC packets
 	packets := providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.getFirstBundle().ConsumerId)
@@ -47,7 +49,7 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	s.Require().Equal(1, len(packets), "unexpected number of pending VSC packets")
 
 	// try again to send CCV packet to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// check that the packet is still in the list of pending VSC packets
 	packets = providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.getFirstBundle().ConsumerId)
@@ -58,18 +60,18 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	delegate(s, delAddr, bondAmt)
 
 	// try again to send CCV packets to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// check that the packets are still in the list of pending VSC packets
 	packets = providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.getFirstBundle().ConsumerId)
 	s.Require().NotEmpty(packets, "no pending VSC packets found")
 	s.Require().Equal(2, len(packets), "unexpected number of pending VSC packets")
 
-	// upgrade expired client to the consumer
-	upgradeExpiredClient(s, Consumer)
+	// recover expired client to the consumer
+	s.RecoverClient(s.getFirstBundle().ConsumerId, Consumer)
 
 	// go to next epoch
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// check that the packets are not in the list of pending VSC packets
 	packets = providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.getFirstBundle().ConsumerId)
@@ -79,7 +81,7 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 	// - bond more tokens on provider to change validator powers
 	delegate(s, delAddr, bondAmt)
 	// - send CCV packet to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 	// - relay all VSC packet from provider to consumer
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 3)
 }
@@ -91,7 +93,7 @@ func (s *CCVTestSuite) TestVSCPacketSendExpiredClient() {
 // * Check for pending VSC packets and relay all VSC packets to consumer.
 // * The provider client is then expired.
 // * Confirm that while the provider client is expired all packets will be queued and then cleared
-// once the provider client is upgraded.
+// once the provider client is recovered.
 func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	providerKeeper := s.providerApp.GetProviderKeeper()
 	consumerKeeper := s.consumerApp.GetConsumerKeeper()
@@ -104,13 +106,13 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	delegate(s, delAddr, bondAmt)
 
 	// send CCV packet to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// bond more tokens on provider to change validator powers
 	delegate(s, delAddr, bondAmt)
 
 	// send CCV packets to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// check that the packets are not in the list of pending VSC packets
 	providerPackets := providerKeeper.GetPendingVSCPackets(s.providerCtx(), s.getFirstBundle().ConsumerId)
@@ -120,15 +122,15 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 2)
 
 	// expire client to provider
-	expireClient(s, Provider)
+	s.ExpireClient(s.getFirstBundle().ConsumerId, Provider)
 
 	// check that the client to the consumer is active
-	checkClientExpired(s, Consumer, false)
+	s.checkClientExpired(s.getFirstBundle().ConsumerId, Consumer, false)
 
 	// increment time so that the unbonding period ends on the consumer;
 	// do not try to update the client to the provider since it's expired
 	consumerUnbondingPeriod := s.consumerApp.GetConsumerKeeper().GetUnbondingPeriod(s.consumerCtx())
-	incrementTimeWithoutUpdate(s, consumerUnbondingPeriod+time.Hour, Provider)
+	s.advanceTime(consumerUnbondingPeriod+time.Hour, s.path.EndpointA)
 
 	// check that no packets were added to the list of pending data packets
 	consumerPackets := consumerKeeper.GetPendingPackets(s.consumerCtx())
@@ -149,8 +151,8 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	// At this point we expect two trailing slash packets
 	s.Require().Len(consumerPackets, 2, "unexpected number of pending data packets")
 
-	// upgrade expired client to the consumer
-	upgradeExpiredClient(s, Provider)
+	// recover expired client to the provider
+	s.RecoverClient(s.getFirstBundle().ConsumerId, Provider)
 
 	// go to next block to trigger SendPendingPackets
 	s.consumerChain.NextBlock()
@@ -179,45 +181,142 @@ func (s *CCVTestSuite) TestConsumerPacketSendExpiredClient() {
 	// - bond more tokens on provider to change validator powers
 	delegate(s, delAddr, bondAmt)
 	// - send CCV packet to consumer
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 	// - relay 1 VSC packet from provider to consumer
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
 }
 
-// expireClient expires the client to the `clientTo` chain
-func expireClient(s *CCVTestSuite, clientTo ChainType) {
-	var hostEndpoint *ibctesting.Endpoint
-	var hostChain *ibctesting.TestChain
+// TestRecoverExpiredClients tests the recovery of the CCV channel when both CCV clients are expired.
+// @Long Description@
+// * Set up a CCV channel and relay a VSC packet.
+// * Expire both the client to the consumer and the client to the provider,
+// as happens when no relayer is running for longer than the trusting period.
+// * Bond tokens on provider and queue a slash packet on consumer, and check that both packets are pending.
+// * Recover both clients by substituting them with new clients.
+// * Check that the pending packets are sent and relayed in both directions.
+func (s *CCVTestSuite) TestRecoverExpiredClients() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	// establish the CCV channel on the consumer by relaying a VSC packet
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+	bondAmt := math.NewInt(1000000)
+	delegate(s, delAddr, bondAmt)
+	s.AdvanceEpochs(1)
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+
+	// expire both clients
+	s.ExpireClient(consumerId, Consumer)
+	s.ExpireClient(consumerId, Provider)
+	s.checkClientExpired(consumerId, Consumer, true)
+
+	// bond more tokens on provider to change validator powers
+	delegate(s, delAddr, bondAmt)
+	s.AdvanceEpochs(1)
+
+	// check that the VSC packet is pending on the provider
+	s.Require().Len(providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId), 1)
+
+	// queue a slash packet for downtime on the consumer
+	val := abci.Validator{Address: ed25519.GenPrivKey().PubKey().Address(), Power: 1}
+	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, 2, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	s.consumerChain.NextBlock()
+
+	// check that the slash packet is pending on the consumer
+	s.Require().Len(consumerKeeper.GetPendingPackets(s.consumerCtx()), 1)
+
+	// recover both clients
+	s.RecoverClient(consumerId, Consumer)
+	s.RecoverClient(consumerId, Provider)
+	s.checkClientExpired(consumerId, Consumer, false)
+	s.checkClientExpired(consumerId, Provider, false)
+
+	// the pending VSC packet is sent at the end of the next epoch
+	s.AdvanceEpochs(1)
+	s.Require().Empty(providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId))
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+
+	// the pending slash packet is sent at the end of the next block
+	s.consumerChain.NextBlock()
+	relayAllCommittedPackets(s, s.consumerChain, s.path, ccv.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
+	s.Require().Empty(consumerKeeper.GetPendingPackets(s.consumerCtx()))
+}
+
+// TestReopenCCVChannelAfterClientExpiry tests opening a new CCV channel after the client to the consumer
+// expired during the handshake of the CCV channel.
+// @Long Description@
+// * Create a connection between the provider and the consumer, and initialize the CCV channel handshake on the consumer.
+// * Expire the client to the consumer, so that the handshake cannot proceed.
+// * Recover the client to the consumer and open a new CCV channel on the existing connection.
+// * Check that VSC packets are relayed on the new channel and that the consumer uses it as provider channel.
+func (s *CCVTestSuite) TestReopenCCVChannelAfterClientExpiry() {
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.path.CreateConnections()
+	s.Require().NoError(s.path.EndpointA.ChanOpenInit())
+	abandonedChannelID := s.path.EndpointA.ChannelID
+
+	// expire the client to the consumer in the middle of the handshake
+	s.ExpireClient(consumerId, Consumer)
+	// the handshake cannot proceed since the client to the consumer cannot be updated
+	s.Require().Error(s.path.EndpointB.UpdateClient())
+
+	// recover the client and open a new CCV channel
+	s.RecoverClient(consumerId, Consumer)
+	s.ReopenCCVChannel(consumerId)
+	s.Require().NotEqual(abandonedChannelID, s.path.EndpointA.ChannelID)
+	abandonedChannel, found := s.consumerApp.GetIBCKeeper().ChannelKeeper.GetChannel(s.consumerCtx(), ccv.ConsumerPortID, abandonedChannelID)
+	s.Require().True(found)
+	s.Require().Equal(channeltypes.INIT, abandonedChannel.State)
+
+	// bond some tokens on provider to change validator powers and relay the VSC packet on the new channel
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+	delegate(s, delAddr, math.NewInt(1000000))
+	s.AdvanceEpochs(1)
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+
+	providerChannelID, found := consumerKeeper.GetProviderChannel(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointA.ChannelID, providerChannelID)
+}
+
+// clientEndpoint returns the endpoint hosting the client to the `clientTo` chain
+// on the CCV path of the consumer chain with the given consumer id
+func (s *CCVTestSuite) clientEndpoint(consumerId string, clientTo ChainType) *ibctesting.Endpoint {
+	bundle, found := s.consumerBundles[consumerId]
+	s.Require().True(found, "unknown consumer id %s", consumerId)
 	if clientTo == Consumer {
-		hostEndpoint = s.path.EndpointB
-		hostChain = s.providerChain
-	} else {
-		hostEndpoint = s.path.EndpointA
-		hostChain = s.consumerChain
+		return bundle.Path.EndpointB
 	}
-	cs, ok := hostChain.App.GetIBCKeeper().ClientKeeper.GetClientState(hostChain.GetContext(), hostEndpoint.ClientID)
+	return bundle.Path.EndpointA
+}
+
+// ExpireClient expires the client to the `clientTo` chain on the CCV path of the consumer chain
+// with the given consumer id, by moving the clocks of all the chains forward past its trusting period
+// while updating all the other CCV clients (see AdvanceTime)
+func (s *CCVTestSuite) ExpireClient(consumerId string, clientTo ChainType) {
+	hostEndpoint := s.clientEndpoint(consumerId, clientTo)
+	cs, ok := hostEndpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientState(
+		hostEndpoint.Chain.GetContext(), hostEndpoint.ClientID)
 	s.Require().True(ok)
 	trustingPeriod := cs.(*ibctm.ClientState).TrustingPeriod
 
 	// increment time without updating the `clientTo` client
-	incrementTimeWithoutUpdate(s, trustingPeriod+time.Hour, clientTo)
+	s.advanceTime(trustingPeriod+time.Hour, hostEndpoint)
 
 	// check that the client is not active
-	checkClientExpired(s, clientTo, true)
+	s.checkClientExpired(consumerId, clientTo, true)
 }
 
-// checkClientExpired checks whether the client to `clientTo` is expired
-func checkClientExpired(s *CCVTestSuite, clientTo ChainType, expectedExpired bool) {
-	var hostEndpoint *ibctesting.Endpoint
-	var hostChain *ibctesting.TestChain
-	if clientTo == Consumer {
-		hostEndpoint = s.path.EndpointB
-		hostChain = s.providerChain
-	} else {
-		hostEndpoint = s.path.EndpointA
-		hostChain = s.consumerChain
-	}
-	// check that the client to the consumer is not active
+// checkClientExpired checks whether the client to `clientTo` on the CCV path
+// of the consumer chain with the given consumer id is expired
+func (s *CCVTestSuite) checkClientExpired(consumerId string, clientTo ChainType, expectedExpired bool) {
+	hostEndpoint := s.clientEndpoint(consumerId, clientTo)
+	hostChain := hostEndpoint.Chain
 	_, ok := hostChain.App.GetIBCKeeper().ClientKeeper.GetClientState(hostChain.GetContext(), hostEndpoint.ClientID)
 	s.Require().True(ok)
 	lightClientModule := ibctm.NewLightClientModule(hostChain.App.AppCodec(), hostChain.App.GetIBCKeeper().ClientKeeper.GetStoreProvider())
@@ -229,10 +328,12 @@ func checkClientExpired(s *CCVTestSuite, clientTo ChainType, expectedExpired boo
 	}
 }
 
-// upgradeExpiredClient upgrades an expired client to `clientTo`
-func upgradeExpiredClient(s *CCVTestSuite, clientTo ChainType) {
-	subjectPath := s.path
-	substitutePath := ibctesting.NewPath(s.consumerChain, s.providerChain)
+// RecoverClient recovers the expired client to the `clientTo` chain on the CCV path of the consumer chain
+// with the given consumer id, by substituting it with a new client (i.e., MsgRecoverClient)
+func (s *CCVTestSuite) RecoverClient(consumerId string, clientTo ChainType) {
+	bundle := s.consumerBundles[consumerId]
+	subjectPath := bundle.Path
+	substitutePath := ibctesting.NewPath(bundle.Chain, s.providerChain)
 	var subject, subjectCounterparty string
 	var hostNewEndpoint, targetNewEndpoint *ibctesting.Endpoint
 	var hostChain *ibctesting.TestChain
@@ -243,13 +344,13 @@ func upgradeExpiredClient(s *CCVTestSuite, clientTo ChainType) {
 		hostNewEndpoint = substitutePath.EndpointB
 		targetNewEndpoint = substitutePath.EndpointA
 		hostChain = s.providerChain
-		targetChain = s.consumerChain
+		targetChain = bundle.Chain
 	} else {
 		subject = subjectPath.EndpointA.ClientID             // consumer endpoint client
 		subjectCounterparty = subjectPath.EndpointB.ClientID // provider endpoint client
 		hostNewEndpoint = substitutePath.EndpointA
 		targetNewEndpoint = substitutePath.EndpointB
-		hostChain = s.consumerChain
+		hostChain = bundle.Chain
 		targetChain = s.providerChain
 	}
 
@@ -296,3 +397,29 @@ func upgradeExpiredClient(s *CCVTestSuite, clientTo ChainType) {
 	s.Require().NoError(err)
 	s.Require().NotNil(res)
 }
+
+// ReopenCCVChannel opens a new CCV channel on the existing connection to the consumer chain with the given
+// consumer id, e.g., after the handshake of the previous CCV channel could not complete due to an expired client.
+// The new channel replaces the CCV path of the consumer chain.
+func (s *CCVTestSuite) ReopenCCVChannel(consumerId string) {
+	bundle := s.consumerBundles[consumerId]
+
+	path := ibctesting.NewPath(bundle.Chain, s.providerChain)
+	for _, endpoints := range [][2]*ibctesting.Endpoint{
+		{path.EndpointA, bundle.Path.EndpointA},
+		{path.EndpointB, bundle.Path.EndpointB},
+	} {
+		newEndpoint, oldEndpoint := endpoints[0], endpoints[1]
+		newEndpoint.ClientID = oldEndpoint.ClientID
+		newEndpoint.ConnectionID = oldEndpoint.ConnectionID
+		newEndpoint.ChannelConfig.PortID = oldEndpoint.ChannelConfig.PortID
+		newEndpoint.ChannelConfig.Version = ccv.Version
+		newEndpoint.ChannelConfig.Order = channeltypes.ORDERED
+	}
+	path.CreateChannels()
+
+	bundle.Path = path
+	if consumerId == icstestingutils.FirstConsumerID {
+		s.path = path
+	}
+}
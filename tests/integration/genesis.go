@@ -0,0 +1,213 @@
+package integration
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// EnvUpdateGoldenFiles is the environment variable that, if set to "true",
+// makes the genesis round-trip tests overwrite the golden files instead of checking against them
+const EnvUpdateGoldenFiles = "ICS_INTEGRATION_UPDATE_GOLDEN_FILES"
+
+// The coverage of the entries stored under a key prefix by the genesis export and import
+const (
+	// the entries are restored by the genesis import
+	genesisCoverageRestored = "restored"
+	// the entries are restored by the genesis import, but with different keys or values
+	genesisCoverageModified = "modified"
+	// the entries are not restored by the genesis import
+	genesisCoverageNotExported = "not exported"
+	// the entries are only created by the genesis import
+	genesisCoverageAdded = "added"
+)
+
+// TestGenesisRoundTrip tests that the state of the provider and consumer modules
+// survives exporting and re-importing the genesis of the modules.
+// @Long Description@
+// * Set up CCV channels, bond tokens on provider and relay the VSC packets to all consumers.
+// * Queue a slash packet and set an outstanding downtime on the first consumer.
+// * Export the provider and consumer genesis, and import it into the emptied module stores.
+// * Check the coverage of every key prefix of the module stores by the genesis against the golden files
+// in testdata, so that the key prefixes that are not covered by genesis are tracked explicitly.
+// * Check that the imported state is consistent with the state before export.
+func (s *CCVTestSuite) TestGenesisRoundTrip() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+
+	s.SetupAllCCVChannels()
+
+	// bond tokens on provider and relay the VSC packets to all consumers
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(1000000))
+	s.AdvanceEpochs(1)
+	relayAllCommittedPacketsToConsumers(s, 1)
+
+	// queue a slash packet on the first consumer
+	val := abci.Validator{Address: ed25519.GenPrivKey().PubKey().Address(), Power: 1}
+	consumerKeeper.QueueSlashPacket(s.consumerCtx(), val, 0, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.SetOutstandingDowntime(s.consumerCtx(), sdk.ConsAddress(val.Address))
+
+	// export and import the provider genesis
+	providerCtx := checkGenesisRoundTrip(s,
+		s.providerCtx(),
+		s.providerApp.AppCodec(),
+		s.providerApp.GetKey(providertypes.StoreKey),
+		providertypes.StorePrefixDecoders(),
+		providerKeeper.ExportGenesis,
+		func(ctx sdk.Context, genesis *providertypes.GenesisState) { providerKeeper.InitGenesis(ctx, genesis) },
+		"provider_genesis_coverage.json",
+	)
+	s.Require().Equal(providerKeeper.GetValidatorSetUpdateId(s.providerCtx()), providerKeeper.GetValidatorSetUpdateId(providerCtx))
+	for _, bundle := range s.sortedConsumerBundles() {
+		channelId, found := providerKeeper.GetConsumerIdToChannelId(providerCtx, bundle.ConsumerId)
+		s.Require().True(found)
+		s.Require().Equal(bundle.Path.EndpointB.ChannelID, channelId)
+		s.Require().Equal(providerKeeper.GetConsumerPhase(s.providerCtx(), bundle.ConsumerId), providerKeeper.GetConsumerPhase(providerCtx, bundle.ConsumerId))
+	}
+
+	// export and import the consumer genesis
+	consumerCtx := checkGenesisRoundTrip(s,
+		s.consumerCtx(),
+		s.consumerApp.AppCodec(),
+		s.consumerApp.GetKey(consumertypes.StoreKey),
+		consumertypes.StorePrefixDecoders(),
+		consumerKeeper.ExportGenesis,
+		func(ctx sdk.Context, genesis *consumertypes.GenesisState) { consumerKeeper.InitGenesis(ctx, genesis) },
+		"consumer_genesis_coverage.json",
+	)
+	channelId, found := consumerKeeper.GetProviderChannel(consumerCtx)
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointA.ChannelID, channelId)
+	s.Require().Equal(consumerKeeper.GetPendingPackets(s.consumerCtx()), consumerKeeper.GetPendingPackets(consumerCtx))
+	s.Require().True(consumerKeeper.OutstandingDowntime(consumerCtx, sdk.ConsAddress(val.Address)))
+}
+
+// checkGenesisRoundTrip exports the genesis of a CCV module to JSON and imports it back into the emptied module store.
+// The import happens in a cache context, which is returned so that the imported state can be checked, i.e.,
+// the state of ctx is not modified. It checks the coverage of every key prefix by the genesis against the golden file.
+func checkGenesisRoundTrip[G proto.Message](
+	s *CCVTestSuite,
+	ctx sdk.Context,
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	decoders map[byte]ccv.StorePrefixDecoder,
+	exportGenesis func(sdk.Context) G,
+	initGenesis func(sdk.Context, G),
+	goldenFile string,
+) sdk.Context {
+	cacheCtx, _ := ctx.CacheContext()
+	store := cacheCtx.KVStore(storeKey)
+	exportedEntries := getStoreEntriesByPrefix(store, decoders)
+
+	genesis := exportGenesis(cacheCtx)
+	bz, err := cdc.MarshalJSON(genesis)
+	s.Require().NoError(err)
+	genesis.Reset()
+
+	// import the genesis into the emptied module store
+	for _, entries := range exportedEntries {
+		for key := range entries {
+			store.Delete([]byte(key))
+		}
+	}
+	s.Require().NoError(cdc.UnmarshalJSON(bz, genesis))
+	initGenesis(cacheCtx, genesis)
+	importedEntries := getStoreEntriesByPrefix(store, decoders)
+
+	// compute the coverage of every key prefix
+	coverage := map[string]string{}
+	for prefix, entries := range exportedEntries {
+		switch imported, found := importedEntries[prefix]; {
+		case !found:
+			coverage[prefix] = genesisCoverageNotExported
+		case storeEntriesEqual(entries, imported):
+			coverage[prefix] = genesisCoverageRestored
+		default:
+			coverage[prefix] = genesisCoverageModified
+		}
+	}
+	for prefix := range importedEntries {
+		if _, found := exportedEntries[prefix]; !found {
+			coverage[prefix] = genesisCoverageAdded
+		}
+	}
+	checkGoldenFile(s, goldenFile, coverage)
+
+	return cacheCtx
+}
+
+// getStoreEntriesByPrefix returns all the entries of the store, as maps from keys to values, grouped by the name of their key prefix
+func getStoreEntriesByPrefix(store storetypes.KVStore, decoders map[byte]ccv.StorePrefixDecoder) map[string]map[string][]byte {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	entriesByPrefix := map[string]map[string][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		prefix := fmt.Sprintf("0x%02X", key[0])
+		if decoder, found := decoders[key[0]]; found {
+			prefix = decoder.Name
+		}
+		if _, found := entriesByPrefix[prefix]; !found {
+			entriesByPrefix[prefix] = map[string][]byte{}
+		}
+		entriesByPrefix[prefix][string(key)] = iterator.Value()
+	}
+	return entriesByPrefix
+}
+
+// storeEntriesEqual returns whether the two maps from keys to values are equal
+func storeEntriesEqual(a, b map[string][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for key, value := range a {
+		if other, found := b[key]; !found || !bytes.Equal(value, other) {
+			return false
+		}
+	}
+	return true
+}
+
+// checkGoldenFile checks that the JSON encoding of v matches the golden file with the given name in testdata;
+// if EnvUpdateGoldenFiles is set to "true", the golden file is overwritten instead
+func checkGoldenFile(s *CCVTestSuite, name string, v any) {
+	bz, err := json.MarshalIndent(v, "", "  ")
+	s.Require().NoError(err)
+	bz = append(bz, '\n')
+
+	// the golden files are located relative to this source file, independently of the package under test
+	_, file, _, ok := runtime.Caller(0)
+	s.Require().True(ok)
+	path := filepath.Join(filepath.Dir(file), "testdata", name)
+
+	if os.Getenv(EnvUpdateGoldenFiles) == "true" {
+		s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0o755))
+		s.Require().NoError(os.WriteFile(path, bz, 0o600))
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	s.Require().NoError(err, "cannot read golden file; run with %s=true to create it", EnvUpdateGoldenFiles)
+	s.Require().Equal(string(expected), string(bz),
+		"mismatch with golden file %s; if the change is expected, run with %s=true to update it", path, EnvUpdateGoldenFiles)
+}
@@ -15,7 +15,8 @@ import (
 // This file can be used as an example integration testing instance for any provider/consumer applications.
 // In the case of this repo, we're testing the dummy provider/consumer applications,
 // but to test any arbitrary app, one only needs to replicate this file and "specific_setup.go",
-// then pass in the appropriate types and parameters to the suite. Note that provider and consumer
+// then pass in the appropriate types and parameters to the suite. Consumer app initers, standard or democracy,
+// can be created with icstestingutils.NewConsumerAppIniter from a constructor of the app and its default genesis. Note that provider and consumer
 // applications types must implement the interfaces defined in /testutil/integration/interfaces.go to compile.
 
 // Executes the standard group of ccv tests against a consumer and provider app.go implementation.
@@ -25,7 +26,9 @@ func TestCCVTestSuite(t *testing.T) {
 	// concrete app types returned by the relevant app initers.
 	ccvSuite := intg.NewCCVTestSuite[*appProvider.App, *appConsumer.App](
 		// Pass in ibctesting.AppIniters for provider and consumer.
-		icstestingutils.ProviderAppIniter, icstestingutils.ConsumerAppIniter, []string{})
+		icstestingutils.ProviderAppIniter, icstestingutils.ConsumerAppIniter, []string{},
+		// Compare the validator sets of the consumer chains to the ones intended by the provider after every relayed packet.
+		intg.WithConsumerValsetVerification())
 
 	// Run tests
 	suite.Run(t, ccvSuite)
@@ -38,9 +41,13 @@ func TestConsumerDemocracyCCVTestSuite(t *testing.T) {
 	// concrete app types returned by the relevant app initers.
 	democSuite := intg.NewCCVTestSuite[*appProvider.App, *appConsumerDemocracy.App](
 		// Pass in ibctesting.AppIniter for provider and democracy consumer.
-		// TestRewardsDistribution needs to be skipped since the democracy specific distribution test is in ConsumerDemocracyTestSuite,
-		// while this one tests consumer app without minter
-		icstestingutils.ProviderAppIniter, icstestingutils.DemocracyConsumerAppIniter, []string{"TestRewardsDistribution"})
+		// TestRewardsDistribution and TestDuplicateDeliveryRegression need to be skipped since the democracy specific
+		// distribution test is in ConsumerDemocracyTestSuite, while these ones test consumer app without minter.
+		// TestProviderGovernanceConsumerAdmin needs to be skipped since the democracy consumer app
+		// does not wire the interchain accounts host module
+		icstestingutils.ProviderAppIniter, icstestingutils.DemocracyConsumerAppIniter,
+		[]string{"TestRewardsDistribution", "TestDuplicateDeliveryRegression", "TestProviderGovernanceConsumerAdmin"},
+		intg.WithConsumerValsetVerification())
 
 	// Run tests
 	suite.Run(t, democSuite)
@@ -48,7 +48,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 				}
 
 				// check that a VSCPacket is queued
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 				pendingPackets := pk.GetPendingVSCPackets(s.providerCtx(), s.getFirstBundle().ConsumerId)
 				s.Require().Len(pendingPackets, 1)
 
@@ -70,7 +70,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 					return err
 				}
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, false, 2,
@@ -92,7 +92,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 				delAddr := s.providerChain.SenderAccount.GetAddress()
 				delegate(s, delAddr, bondAmt)
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, false, 2,
@@ -115,7 +115,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 				if err != nil {
 					return err
 				}
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, true, 2,
@@ -137,7 +137,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 				if err != nil {
 					return err
 				}
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, true, 2,
@@ -161,7 +161,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 					return err
 				}
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, false, 2,
@@ -178,7 +178,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 					panic(err)
 				}
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				// same key assignment
 				validator2, _ := generateNewConsumerKey(s, 1)
@@ -195,7 +195,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 				// the key for the second validator should *not* be the one we just assigned to the first validator
 				s.Require().NotEqual(consumerKey, actualConsumerKey2)
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, true, 2,
@@ -212,14 +212,14 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 					return err
 				}
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				// same key assignment
 				err = pk.AssignConsumerKey(s.providerCtx(), s.getFirstBundle().ConsumerId, validator, consumerKey)
 				if err != nil {
 					return err
 				}
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, true, 2,
@@ -236,7 +236,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 					return err
 				}
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				// same key assignment
 				validator, consumerKey = generateNewConsumerKey(s, 0)
@@ -245,7 +245,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 					return err
 				}
 
-				s.nextEpoch()
+				s.AdvanceEpochs(1)
 
 				return nil
 			}, false, 3,
@@ -292,7 +292,7 @@ func (s *CCVTestSuite) TestKeyAssignment() {
 
 			// Send CCV packet to consumer
 			// s.providerChain.NextBlock()
-			s.nextEpoch()
+			s.AdvanceEpochs(1)
 
 			// Relay all VSC packets from provider to consumer
 			relayAllCommittedPackets(
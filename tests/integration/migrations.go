@@ -0,0 +1,146 @@
+package integration
+
+import (
+	"encoding/binary"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	v4 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v4"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// storeMigrationFixture describes the state of a CCV module at the previous consensus version,
+// i.e., the state the registered store migrations of the module start from
+type storeMigrationFixture struct {
+	// the previous consensus version of the module
+	fromVersion uint64
+	// downgrade rewrites the state of the module, initialized with the genesis of
+	// the current version, into the state of the previous consensus version
+	downgrade func(store storetypes.KVStore)
+}
+
+// providerMigrationFixture is the state of the provider module at consensus version 8,
+// which does not index the opted-in validators by their provider consensus address
+var providerMigrationFixture = storeMigrationFixture{
+	fromVersion: 8,
+	downgrade: func(store storetypes.KVStore) {
+		deleteKeysWithPrefix(store, providertypes.OptedInByValidatorKeyPrefix())
+	},
+}
+
+// consumerMigrationFixture is the state of the consumer module at consensus version 3,
+// which stores the maturity times of the received VSC packets
+var consumerMigrationFixture = storeMigrationFixture{
+	fromVersion: 3,
+	downgrade: func(store storetypes.KVStore) {
+		maturityTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		for vscId := uint64(1); vscId <= 3; vscId++ {
+			key := []byte{v4.LegacyPacketMaturityTimeKeyName}
+			key = binary.BigEndian.AppendUint64(key, vscId)
+			key = append(key, sdk.FormatTimeBytes(maturityTime)...)
+			store.Set(key, []byte{})
+		}
+	},
+}
+
+// migratableApp is an app that runs the registered store migrations of its modules
+type migratableApp interface {
+	GetModuleManager() *module.Manager
+	Configurator() module.Configurator
+}
+
+// TestStoreMigrations tests the registered store migrations of the provider and consumer modules.
+// @Long Description@
+// * Rewrite the provider and consumer stores into the state of the previous consensus versions.
+// * Check that the previous state is not valid for the current version.
+// * Run the registered store migrations.
+// * Check that the provider indexes the opted-in validators and that the consumer cleaned up the deprecated state.
+func (s *CCVTestSuite) TestStoreMigrations() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	optedInAddrs := providerKeeper.GetAllOptedIn(s.providerCtx(), consumerId)
+	s.Require().NotEmpty(optedInAddrs)
+	optedInConsumerIds := map[string][]string{}
+	for _, addr := range optedInAddrs {
+		optedInConsumerIds[addr.String()] = providerKeeper.GetOptedInConsumerIds(s.providerCtx(), addr)
+		s.Require().Contains(optedInConsumerIds[addr.String()], consumerId)
+	}
+
+	providerStore := s.providerCtx().KVStore(s.providerApp.GetKey(providertypes.StoreKey))
+	consumerStore := s.consumerCtx().KVStore(s.consumerApp.GetKey(consumertypes.StoreKey))
+	providerMigrationFixture.downgrade(providerStore)
+	consumerMigrationFixture.downgrade(consumerStore)
+
+	// check that the previous state is not valid for the current version
+	for _, addr := range optedInAddrs {
+		s.Require().Empty(providerKeeper.GetOptedInConsumerIds(s.providerCtx(), addr))
+	}
+	s.Require().True(hasKeysWithPrefix(consumerStore, v4.LegacyPacketMaturityTimeKeyName))
+
+	runStoreMigrations(s, s.providerApp, s.providerCtx(), providertypes.ModuleName, providerMigrationFixture.fromVersion)
+	runStoreMigrations(s, s.consumerApp, s.consumerCtx(), consumertypes.ModuleName, consumerMigrationFixture.fromVersion)
+
+	// check that the state was migrated
+	for _, addr := range optedInAddrs {
+		s.Require().Equal(optedInConsumerIds[addr.String()], providerKeeper.GetOptedInConsumerIds(s.providerCtx(), addr))
+	}
+	s.Require().False(hasKeysWithPrefix(consumerStore, v4.LegacyPacketMaturityTimeKeyName))
+}
+
+// MigrateStores rewrites the stores of the provider module and of the consumer modules of all the consumer chains
+// into the state of the previous consensus versions (see providerMigrationFixture and consumerMigrationFixture),
+// and then runs the registered store migrations. Running a test after MigrateStores checks that the test
+// scenario also holds on migrated state.
+func (s *CCVTestSuite) MigrateStores() {
+	providerCtx := s.providerCtx()
+	providerMigrationFixture.downgrade(providerCtx.KVStore(s.providerApp.GetKey(providertypes.StoreKey)))
+	runStoreMigrations(s, s.providerApp, providerCtx, providertypes.ModuleName, providerMigrationFixture.fromVersion)
+
+	for _, bundle := range s.sortedConsumerBundles() {
+		consumerCtx := bundle.GetCtx()
+		consumerMigrationFixture.downgrade(consumerCtx.KVStore(bundle.App.GetKey(consumertypes.StoreKey)))
+		runStoreMigrations(s, bundle.App, consumerCtx, consumertypes.ModuleName, consumerMigrationFixture.fromVersion)
+	}
+}
+
+// runStoreMigrations runs the registered store migrations of the module with the given name,
+// starting from the given consensus version
+func runStoreMigrations(s *CCVTestSuite, app migratableApp, ctx sdk.Context, moduleName string, fromVersion uint64) {
+	mm := app.GetModuleManager()
+	fromVM := mm.GetVersionMap()
+	s.Require().Contains(fromVM, moduleName)
+	s.Require().Less(fromVersion, fromVM[moduleName], "no store migrations from consensus version %d of %s", fromVersion, moduleName)
+	fromVM[moduleName] = fromVersion
+
+	toVM, err := mm.RunMigrations(ctx, app.Configurator(), fromVM)
+	s.Require().NoError(err)
+	s.Require().Equal(mm.GetVersionMap()[moduleName], toVM[moduleName])
+}
+
+// deleteKeysWithPrefix deletes all the keys with the given prefix from the store
+func deleteKeysWithPrefix(store storetypes.KVStore, prefix byte) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// hasKeysWithPrefix returns whether the store has any key with the given prefix
+func hasKeysWithPrefix(store storetypes.KVStore, prefix byte) bool {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
@@ -109,7 +109,7 @@ func TestMinStake(t *testing.T) {
 			}
 
 			// end the epoch to apply the updates
-			s.nextEpoch()
+			s.AdvanceEpochs(1)
 
 			// Relay 1 VSC packet from provider to consumer
 			relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
@@ -167,7 +167,7 @@ func TestMinStake(t *testing.T) {
 			delegateAndUndelegate(s, delegatorAccount.SenderAccount.GetAddress(), math.NewInt(1*stakeMultiplier), 1)
 
 			// end the epoch to apply the updates
-			s.nextEpoch()
+			s.AdvanceEpochs(1)
 
 			if slices.Equal(tc.stakedTokens, tc.expectedConsuValSet) {
 				// don't expect to relay a packet
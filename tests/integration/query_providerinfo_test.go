@@ -1,12 +1,18 @@
 package integration
 
-import "strings"
+import (
+	"strings"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+)
 
 // TestQueryProviderInfo tests the results of GetProviderInfo method.
 // @Long Description@
 // * Set up a CCV channel and send an empty VSC packet.
 // * Verify that the result of GetProviderInfo method is correct and it
-// provides expected information about the blockchain provider and consumer.
+// provides expected information about the blockchain provider and consumer,
+// as well as about the health of the client, the CCV channel and the packets.
 func (s *CCVTestSuite) TestQueryProviderInfo() {
 	s.SetupCCVChannel(s.path)
 	s.SendEmptyVSCPacket()
@@ -21,4 +27,13 @@ func (s *CCVTestSuite) TestQueryProviderInfo() {
 	s.Require().Equal(chainInfo.Consumer.ConnectionID, "connection-0")
 	s.Require().True(strings.HasPrefix(chainInfo.Provider.ChannelID, "channel-"))
 	s.Require().True(strings.HasPrefix(chainInfo.Consumer.ChannelID, "channel-"))
+
+	s.Require().False(chainInfo.ClientLatestHeight.IsZero())
+	s.Require().Equal(chainInfo.Consumer.ClientID, chainInfo.ClientExpiry.ClientId)
+	s.Require().Positive(chainInfo.ClientExpiry.Remaining)
+	s.Require().Equal(channeltypes.OPEN.String(), chainInfo.ChannelState)
+	s.Require().NotNil(chainInfo.LastVsc)
+	s.Require().GreaterOrEqual(chainInfo.TimeSinceLastVsc, time.Duration(0))
+	s.Require().Nil(chainInfo.SlashRecord)
+	s.Require().True(chainInfo.PacketSendingPermitted)
 }
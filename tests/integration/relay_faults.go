@@ -0,0 +1,213 @@
+package integration
+
+import (
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	"cosmossdk.io/math"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// RelayFault is a deviation from the correct relaying of packets, used to test
+// the resilience of the provider and consumer chains to imperfect relayers
+type RelayFault int
+
+const (
+	// NoRelayFault relays every packet once, in order
+	NoRelayFault RelayFault = iota
+	// DelayRelayFault withholds the packets, so that they can be relayed later (see relayPackets)
+	DelayRelayFault
+	// DropRelayFault withholds the packets, so that they are never relayed and eventually time out (see timeoutPackets)
+	DropRelayFault
+	// DuplicateRelayFault delivers every packet twice before relaying its acknowledgement
+	DuplicateRelayFault
+	// ReorderRelayFault relays the packets in reverse order; note that on ORDERED channels
+	// (such as the CCV channel), the receiver rejects every packet except the one with the lowest sequence
+	ReorderRelayFault
+)
+
+// TestRelayDelayedAndDuplicatedVSCPackets tests that the consumer chain applies VSC packets exactly once
+// when they are delayed and delivered more than once.
+// @Long Description@
+// * Set up a CCV channel and bond tokens on provider to send a VSC packet.
+// * Delay the relaying of the VSC packet and check that the CCV channel is not established on the consumer.
+// * Bond more tokens on provider to send a second VSC packet.
+// * Relay both VSC packets, delivering each of them twice.
+// * Check that the consumer received both VSC packets only once and that the provider recorded their acknowledgements.
+func (s *CCVTestSuite) TestRelayDelayedAndDuplicatedVSCPackets() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	// send a VSC packet and delay its relaying
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+	bondAmt := math.NewInt(1000000)
+	delegate(s, delAddr, bondAmt)
+	s.AdvanceEpochs(1)
+	delayed := relayCommittedPacketsWithFault(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1, DelayRelayFault)
+	s.Require().Len(delayed, 1)
+	_, found := consumerKeeper.GetProviderChannel(s.consumerCtx())
+	s.Require().False(found)
+
+	// send a second VSC packet
+	delegate(s, delAddr, bondAmt)
+	s.AdvanceEpochs(1)
+
+	// relay both VSC packets twice
+	undelivered := relayCommittedPacketsWithFault(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 2, DuplicateRelayFault)
+	s.Require().Empty(undelivered)
+
+	// check that the consumer received every VSC packet once
+	nextSeqRecv, found := s.consumerApp.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(s.consumerCtx(), ccv.ConsumerPortID, s.path.EndpointA.ChannelID)
+	s.Require().True(found)
+	s.Require().Equal(uint64(3), nextSeqRecv)
+	records := providerKeeper.GetAllVSCPacketRecords(s.providerCtx(), consumerId)
+	s.Require().Len(records, 2)
+	lastVSC, found := consumerKeeper.GetLastVSC(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(records[1].ValsetUpdateId, lastVSC.ValsetUpdateId)
+
+	// check that the provider recorded the acknowledgements
+	for _, record := range records {
+		s.Require().Equal(providertypes.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED, record.AckStatus)
+	}
+}
+
+// TestRelayReorderedVSCPackets tests that the consumer chain rejects VSC packets that are delivered out of order.
+// @Long Description@
+// * Set up a CCV channel and bond tokens on provider twice, to send two VSC packets.
+// * Relay the VSC packets in reverse order and check that the consumer only received the first one.
+// * Relay the rejected VSC packet again and check that the consumer received it.
+func (s *CCVTestSuite) TestRelayReorderedVSCPackets() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	// send two VSC packets
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+	bondAmt := math.NewInt(1000000)
+	for i := 0; i < 2; i++ {
+		delegate(s, delAddr, bondAmt)
+		s.AdvanceEpochs(1)
+	}
+	records := providerKeeper.GetAllVSCPacketRecords(s.providerCtx(), consumerId)
+	s.Require().Len(records, 2)
+
+	// relay the VSC packets in reverse order; the CCV channel is ORDERED,
+	// so the second VSC packet is rejected by the consumer
+	undelivered := relayCommittedPacketsWithFault(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 2, ReorderRelayFault)
+	s.Require().Len(undelivered, 1)
+	s.Require().Equal(records[1].Sequence, undelivered[0].Sequence)
+	lastVSC, found := consumerKeeper.GetLastVSC(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(records[0].ValsetUpdateId, lastVSC.ValsetUpdateId)
+
+	// relay the rejected VSC packet again
+	relayPackets(s, s.path, undelivered)
+	lastVSC, found = consumerKeeper.GetLastVSC(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(records[1].ValsetUpdateId, lastVSC.ValsetUpdateId)
+}
+
+// TestRelayDroppedVSCPacket tests that the provider chain stops the consumer chain when a VSC packet is dropped.
+// @Long Description@
+// * Set up a CCV channel and bond tokens on provider to send a VSC packet.
+// * Drop the VSC packet and move the chains forward past the CCV timeout period.
+// * Relay the timeout of the VSC packet to the provider.
+// * Check that the provider recorded the timeout and stopped the consumer chain.
+func (s *CCVTestSuite) TestRelayDroppedVSCPacket() {
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	s.SetupCCVChannel(s.path)
+
+	// send a VSC packet and drop it
+	delAddr := s.providerChain.SenderAccount.GetAddress()
+	delegate(s, delAddr, math.NewInt(1000000))
+	s.AdvanceEpochs(1)
+	dropped := relayCommittedPacketsWithFault(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1, DropRelayFault)
+	s.Require().Len(dropped, 1)
+
+	// let the VSC packet time out
+	s.AdvanceTime(providerKeeper.GetCCVTimeoutPeriodForConsumer(s.providerCtx(), consumerId) + time.Hour)
+	timeoutPackets(s, s.path, dropped)
+
+	// check that the provider recorded the timeout and stopped the consumer chain
+	records := providerKeeper.GetAllVSCPacketRecords(s.providerCtx(), consumerId)
+	s.Require().Len(records, 1)
+	s.Require().Equal(dropped[0].Sequence, records[0].Sequence)
+	s.Require().Equal(providertypes.VSC_PACKET_ACK_STATUS_TIMED_OUT, records[0].AckStatus)
+	s.Require().Equal(providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+}
+
+// relayCommittedPacketsWithFault relays all committed packets from `srcChain` on `path` (see relayAllCommittedPackets)
+// while applying the given relay fault. It returns the packets that were not delivered, ordered by sequence.
+func relayCommittedPacketsWithFault(
+	s *CCVTestSuite,
+	srcChain *ibctesting.TestChain,
+	path *ibctesting.Path,
+	srcPortID string,
+	srcChannelID string,
+	expectedPackets int,
+	fault RelayFault,
+) (undelivered []channeltypes.Packet) {
+	packets := getAllCommittedPackets(s, srcChain, srcPortID, srcChannelID, expectedPackets)
+
+	switch fault {
+	case NoRelayFault:
+		relayPackets(s, path, packets)
+	case DelayRelayFault, DropRelayFault:
+		undelivered = packets
+	case DuplicateRelayFault:
+		srcEndpoint, dstEndpoint := path.EndpointA, path.EndpointB
+		if srcChain == path.EndpointB.Chain {
+			srcEndpoint, dstEndpoint = path.EndpointB, path.EndpointA
+		}
+		for _, packet := range packets {
+			s.Require().NoError(dstEndpoint.UpdateClient())
+			res, err := dstEndpoint.RecvPacketWithResult(packet)
+			s.Require().NoError(err)
+			ack, err := ibctesting.ParseAckFromEvents(res.Events)
+			s.Require().NoError(err)
+
+			// the duplicate is a no-op on the receiver
+			s.Require().NoError(dstEndpoint.UpdateClient())
+			s.Require().NoError(dstEndpoint.RecvPacket(packet))
+
+			s.Require().NoError(srcEndpoint.AcknowledgePacket(packet, ack))
+			checkConsumerValset(s, path, packet)
+		}
+	case ReorderRelayFault:
+		for i := len(packets) - 1; i >= 0; i-- {
+			if err := path.RelayPacket(packets[i]); err != nil {
+				undelivered = append([]channeltypes.Packet{packets[i]}, undelivered...)
+				continue
+			}
+			checkConsumerValset(s, path, packets[i])
+		}
+	default:
+		s.FailNow("unknown relay fault", "fault: %d", fault)
+	}
+	return undelivered
+}
+
+// timeoutPackets relays the timeouts of the given packets back to their source on `path`.
+// Note that the timeout of a packet closes an ORDERED channel.
+func timeoutPackets(s *CCVTestSuite, path *ibctesting.Path, packets []channeltypes.Packet) {
+	for _, packet := range packets {
+		srcEndpoint := path.EndpointA
+		if packet.SourcePort == path.EndpointB.ChannelConfig.PortID && packet.SourceChannel == path.EndpointB.ChannelID {
+			srcEndpoint = path.EndpointB
+		}
+		s.Require().NoError(srcEndpoint.UpdateClient())
+		s.Require().NoError(srcEndpoint.TimeoutPacket(packet))
+	}
+}
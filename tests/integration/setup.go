@@ -17,7 +17,9 @@ import (
 
 	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -61,18 +63,31 @@ type CCVTestSuite struct {
 	consumerBundles map[string]*icstestingutils.ConsumerBundle
 	skippedTests    map[string]bool
 
+	// config defines the number of validators and consumer chains set up before every test
+	config SuiteConfig
+
 	// packetSniffers maps a chain and a packetSniffer
 	packetSniffers map[*ibctesting.TestChain]*packetSniffer
 }
 
 // NewCCVTestSuite returns a new instance of CCVTestSuite, ready to be tested against using suite.Run().
+// By default, the suite sets up a provider chain with icstestingutils.NumValidators validators and
+// icstestingutils.NumConsumers consumer chains, which can be changed through the environment
+// (see EnvNumValidators) or the given options.
 func NewCCVTestSuite[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 	providerAppIniter icstestingutils.AppIniter,
 	consumerAppIniter icstestingutils.ValSetAppIniter,
 	skippedTests []string,
+	opts ...SuiteOption,
 ) *CCVTestSuite {
 	ccvSuite := new(CCVTestSuite)
 
+	config, err := newSuiteConfig(opts...)
+	if err != nil {
+		panic(fmt.Sprintf("invalid CCV test suite config: %v", err))
+	}
+	ccvSuite.config = config
+
 	// Define callback to set up the provider chain
 	ccvSuite.setupProviderCallback = func(t *testing.T) (
 		*ibctesting.Coordinator,
@@ -85,7 +100,8 @@ func NewCCVTestSuite[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 
 		// Add provider to coordinator, store returned test chain and app.
 		// Concrete provider app type is passed to the generic function here.
-		provider, providerApp := icstestingutils.AddProvider[Tp](t, coordinator, providerAppIniter)
+		provider, providerApp := icstestingutils.AddProviderWithValidators[Tp](t, coordinator, providerAppIniter,
+			ccvSuite.config.NumValidators)
 
 		// Pass variables to suite.
 		return coordinator, provider, providerApp
@@ -140,9 +156,15 @@ func (suite *CCVTestSuite) SetupTest() {
 	params.BlocksPerEpoch = 10
 	providerKeeper.SetParams(suite.providerCtx(), params)
 
+	// set the validator powers before the consumer chains are started,
+	// so that they are part of the consumer genesis
+	if len(suite.config.ValidatorPowers) > 0 {
+		suite.setupValidatorPowers(suite.config.ValidatorPowers)
+	}
+
 	// start consumer chains
 	suite.consumerBundles = make(map[string]*icstestingutils.ConsumerBundle)
-	for i := 0; i < icstestingutils.NumConsumers; i++ {
+	for i := 0; i < suite.config.NumConsumers; i++ {
 		bundle := suite.setupConsumerCallback(&suite.Suite, suite.coordinator, i)
 		suite.consumerBundles[bundle.ConsumerId] = bundle
 		suite.registerPacketSniffer(bundle.Chain)
@@ -182,6 +204,21 @@ func (suite *CCVTestSuite) SetupTest() {
 	}
 }
 
+// TearDownTest checks that the invariants of the provider and consumer modules hold after every test
+func (suite *CCVTestSuite) TearDownTest() {
+	if suite.providerApp == nil {
+		return
+	}
+	providerKeeper := suite.providerApp.GetProviderKeeper()
+	msg, broken := providerkeeper.AllInvariants(&providerKeeper)(suite.providerCtx())
+	suite.Require().False(broken, msg)
+
+	for _, bundle := range suite.consumerBundles {
+		msg, broken := consumerkeeper.AllInvariants(bundle.GetKeeper())(bundle.GetCtx())
+		suite.Require().False(broken, msg)
+	}
+}
+
 func (s *CCVTestSuite) registerPacketSniffer(chain *ibctesting.TestChain) {
 	if s.packetSniffers == nil {
 		s.packetSniffers = make(map[*ibctesting.TestChain]*packetSniffer)
@@ -255,11 +292,7 @@ func initConsumerChain(
 	bundle.Path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
 
 	// create path for the transfer channel
-	bundle.TransferPath = ibctesting.NewPath(bundle.Chain, s.providerChain)
-	bundle.TransferPath.EndpointA.ChannelConfig.PortID = transfertypes.PortID
-	bundle.TransferPath.EndpointB.ChannelConfig.PortID = transfertypes.PortID
-	bundle.TransferPath.EndpointA.ChannelConfig.Version = transfertypes.V1
-	bundle.TransferPath.EndpointB.ChannelConfig.Version = transfertypes.V1
+	bundle.TransferPath = s.newTransferPath(bundle.Chain)
 
 	// commit state on this consumer chain
 	s.coordinator.CommitBlock(bundle.Chain)
@@ -336,6 +369,30 @@ func (suite *CCVTestSuite) setupTransferChannel(
 	suite.Require().NoError(err)
 }
 
+// SetupAdditionalTransferChannel opens a new transfer channel between the consumer chain of the given bundle
+// and the provider chain, on the same connection as the CCV channel, and returns its path.
+// Unlike the transfer channel set up by SetupTransferChannel, the handshake is initiated by the relayer,
+// as for any other transfer channel of a consumer chain.
+func (suite *CCVTestSuite) SetupAdditionalTransferChannel(bundle icstestingutils.ConsumerBundle) *ibctesting.Path {
+	transferPath := suite.newTransferPath(bundle.Chain)
+	transferPath.EndpointA.ClientID = bundle.Path.EndpointA.ClientID
+	transferPath.EndpointA.ConnectionID = bundle.Path.EndpointA.ConnectionID
+	transferPath.EndpointB.ClientID = bundle.Path.EndpointB.ClientID
+	transferPath.EndpointB.ConnectionID = bundle.Path.EndpointB.ConnectionID
+	transferPath.CreateChannels()
+	return transferPath
+}
+
+// newTransferPath returns a path for a transfer channel between the given consumer chain and the provider chain
+func (suite *CCVTestSuite) newTransferPath(consumerChain *ibctesting.TestChain) *ibctesting.Path {
+	transferPath := ibctesting.NewPath(consumerChain, suite.providerChain)
+	transferPath.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+	transferPath.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+	transferPath.EndpointA.ChannelConfig.Version = transfertypes.V1
+	transferPath.EndpointB.ChannelConfig.Version = transfertypes.V1
+	return transferPath
+}
+
 // SetupAllTransferChannel setup all consumer chains transfer channel
 func (suite *CCVTestSuite) SetupAllTransferChannels() {
 	// setup the first consumer transfer channel
@@ -110,10 +110,8 @@ func (s *CCVTestSuite) TestRelayAndApplyDowntimePacket() {
 	s.Require().NoError(err)
 	s.Require().Equal(heightBefore+2, heightAfter)
 
-	// this call was added starging cosmos-sdk v0.50.x
-	s.nextEpoch()
-
-	s.nextEpoch()
+	// advancing two epochs was added starting cosmos-sdk v0.50.x
+	s.AdvanceEpochs(2)
 
 	// Confirm the valset update Id was incremented twice on provider,
 	// since an epoch has passed.
@@ -655,3 +653,24 @@ func (suite *CCVTestSuite) TestCISBeforeCCVEstablished() {
 	_, found = consumerKeeper.GetSlashRecord(suite.consumerCtx())
 	suite.Require().True(found)
 }
+
+// TestRecvPacketErrorRecorded tests that a packet received by the provider chain
+// that results in an error acknowledgement is recorded.
+// @Long Description@
+// * Set up the CCV channels and send a packet with invalid data from the consumer chain.
+// * Receive the packet on the provider chain in a transaction.
+// * Check that the packet error is recorded, even though IBC discards the state changes of the packet.
+func (suite *CCVTestSuite) TestRecvPacketErrorRecorded() {
+	providerKeeper := suite.providerApp.GetProviderKeeper()
+
+	suite.SetupAllCCVChannels()
+	suite.Require().Empty(providerKeeper.GetRecentPacketErrors(suite.providerCtx()))
+
+	timeoutTimestamp := uint64(suite.consumerCtx().BlockTime().Add(ccv.DefaultCCVTimeoutPeriod).UnixNano())
+	packet := sendOnConsumerRecvOnProvider(suite, suite.path, clienttypes.Height{}, timeoutTimestamp, []byte("invalid data"))
+
+	packetErrors := providerKeeper.GetRecentPacketErrors(suite.providerCtx())
+	suite.Require().Len(packetErrors, 1)
+	suite.Require().Equal(packet.Sequence, packetErrors[0].Sequence)
+	suite.Require().Equal(suite.getFirstBundle().ConsumerId, packetErrors[0].ConsumerId)
+}
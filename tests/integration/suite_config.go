@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+)
+
+// Environment variables overriding the default configuration of CCVTestSuite,
+// e.g., ICS_INTEGRATION_NUM_VALIDATORS=1 ICS_INTEGRATION_NUM_CONSUMERS=10 go test ./tests/integration/...
+// Note that the options passed to NewCCVTestSuite take precedence over the environment.
+const (
+	EnvNumValidators   = "ICS_INTEGRATION_NUM_VALIDATORS"
+	EnvNumConsumers    = "ICS_INTEGRATION_NUM_CONSUMERS"
+	EnvValidatorPowers = "ICS_INTEGRATION_VALIDATOR_POWERS" // comma-separated list of powers, e.g., "10,20,30,40"
+	EnvVerifyValsets   = "ICS_INTEGRATION_VERIFY_VALSETS"   // boolean, e.g., "true"
+)
+
+// SuiteConfig defines the chains set up by CCVTestSuite before every test
+type SuiteConfig struct {
+	// NumValidators is the number of validators on the provider chain
+	NumValidators int
+	// NumConsumers is the number of consumer chains
+	NumConsumers int
+	// ValidatorPowers are the voting powers of the provider validators, sorted as the provider
+	// validator set. If empty, all the validators have a voting power of 1.
+	ValidatorPowers []int64
+	// VerifyConsumerValsets enables the differential test mode, in which the CometBFT validator set
+	// of a consumer chain is compared to the one intended by the provider after every relayed packet
+	VerifyConsumerValsets bool
+}
+
+// SuiteOption modifies the configuration of CCVTestSuite
+type SuiteOption func(*SuiteConfig)
+
+// WithNumValidators sets the number of validators on the provider chain,
+// dropping the validator powers if they are not as many
+func WithNumValidators(numValidators int) SuiteOption {
+	return func(cfg *SuiteConfig) {
+		cfg.NumValidators = numValidators
+		if len(cfg.ValidatorPowers) != numValidators {
+			cfg.ValidatorPowers = nil
+		}
+	}
+}
+
+// WithNumConsumers sets the number of consumer chains
+func WithNumConsumers(numConsumers int) SuiteOption {
+	return func(cfg *SuiteConfig) {
+		cfg.NumConsumers = numConsumers
+	}
+}
+
+// WithValidatorPowers sets the voting powers of the provider validators,
+// as well as the number of validators on the provider chain
+func WithValidatorPowers(powers ...int64) SuiteOption {
+	return func(cfg *SuiteConfig) {
+		cfg.NumValidators = len(powers)
+		cfg.ValidatorPowers = powers
+	}
+}
+
+// WithConsumerValsetVerification enables the differential test mode (see checkConsumerValset)
+func WithConsumerValsetVerification() SuiteOption {
+	return func(cfg *SuiteConfig) {
+		cfg.VerifyConsumerValsets = true
+	}
+}
+
+// DefaultSuiteConfig returns the default configuration of CCVTestSuite
+func DefaultSuiteConfig() SuiteConfig {
+	return SuiteConfig{
+		NumValidators: icstestingutils.NumValidators,
+		NumConsumers:  icstestingutils.NumConsumers,
+	}
+}
+
+// Validate checks that the configuration can be set up
+func (cfg SuiteConfig) Validate() error {
+	if cfg.NumValidators <= 0 {
+		return fmt.Errorf("number of validators must be positive: %d", cfg.NumValidators)
+	}
+	if cfg.NumConsumers <= 0 {
+		return fmt.Errorf("number of consumers must be positive: %d", cfg.NumConsumers)
+	}
+	if len(cfg.ValidatorPowers) == 0 {
+		return nil
+	}
+	if len(cfg.ValidatorPowers) != cfg.NumValidators {
+		return fmt.Errorf("number of validator powers (%d) does not match the number of validators (%d)",
+			len(cfg.ValidatorPowers), cfg.NumValidators)
+	}
+	for _, power := range cfg.ValidatorPowers {
+		if power <= 0 {
+			return fmt.Errorf("validator powers must be positive: %v", cfg.ValidatorPowers)
+		}
+	}
+	return nil
+}
+
+// newSuiteConfig returns the default configuration overridden by the environment and then by the given options
+func newSuiteConfig(opts ...SuiteOption) (SuiteConfig, error) {
+	cfg := DefaultSuiteConfig()
+
+	if value, ok := os.LookupEnv(EnvNumValidators); ok {
+		numValidators, err := strconv.Atoi(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", EnvNumValidators, err)
+		}
+		cfg.NumValidators = numValidators
+	}
+	if value, ok := os.LookupEnv(EnvNumConsumers); ok {
+		numConsumers, err := strconv.Atoi(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", EnvNumConsumers, err)
+		}
+		cfg.NumConsumers = numConsumers
+	}
+	if value, ok := os.LookupEnv(EnvValidatorPowers); ok {
+		powers := []int64{}
+		for _, field := range strings.Split(value, ",") {
+			power, err := strconv.ParseInt(strings.TrimSpace(field), 10, 64)
+			if err != nil {
+				return cfg, fmt.Errorf("invalid %s: %w", EnvValidatorPowers, err)
+			}
+			powers = append(powers, power)
+		}
+		WithValidatorPowers(powers...)(&cfg)
+	}
+	if value, ok := os.LookupEnv(EnvVerifyValsets); ok {
+		verify, err := strconv.ParseBool(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid %s: %w", EnvVerifyValsets, err)
+		}
+		cfg.VerifyConsumerValsets = verify
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg, cfg.Validate()
+}
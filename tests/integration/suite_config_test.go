@@ -0,0 +1,136 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appConsumer "github.com/cosmos/interchain-security/v7/app/consumer"
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+)
+
+// TestSuiteConfig tests that CCVTestSuite sets up the configured number of validators and consumer chains.
+// @Long Description@
+// * Set up a provider and consumer chains according to the test case.
+// * Check the number of provider validators and consumer chains, and the validator powers.
+// * Establish the CCV channels to all the consumer chains.
+// * Delegate to a validator and relay the resulting VSC packets to all the consumer chains.
+// * Check that the validator sets of all the consumer chains match the provider validator set.
+func TestSuiteConfig(t *testing.T) {
+	testCases := []struct {
+		name           string
+		opts           []SuiteOption
+		expNumVals     int
+		expNumConsumer int
+		expPowers      []int64
+	}{
+		{
+			name:           "default",
+			opts:           nil,
+			expNumVals:     icstestingutils.NumValidators,
+			expNumConsumer: icstestingutils.NumConsumers,
+			expPowers:      []int64{1, 1, 1, 1},
+		},
+		{
+			name:           "single validator provider",
+			opts:           []SuiteOption{WithNumValidators(1), WithNumConsumers(1)},
+			expNumVals:     1,
+			expNumConsumer: 1,
+			expPowers:      []int64{1},
+		},
+		{
+			name:           "ten consumers",
+			opts:           []SuiteOption{WithNumConsumers(10)},
+			expNumVals:     icstestingutils.NumValidators,
+			expNumConsumer: 10,
+			expPowers:      []int64{1, 1, 1, 1},
+		},
+		{
+			name:           "custom validator powers",
+			opts:           []SuiteOption{WithValidatorPowers(40, 30, 20, 10, 5), WithNumConsumers(2)},
+			expNumVals:     5,
+			expNumConsumer: 2,
+			expPowers:      []int64{40, 30, 20, 10, 5},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := NewCCVTestSuite[*appProvider.App, *appConsumer.App](
+				icstestingutils.ProviderAppIniter, icstestingutils.ConsumerAppIniter, []string{}, tc.opts...)
+			s.SetT(t)
+			s.SetupTest()
+
+			s.Require().Len(s.providerChain.Vals.Validators, tc.expNumVals)
+			s.Require().Len(s.consumerBundles, tc.expNumConsumer)
+			for idx, val := range s.providerChain.Vals.Validators {
+				s.Require().Equal(tc.expPowers[idx], val.VotingPower)
+			}
+
+			s.SetupAllCCVChannels()
+
+			// change the power of a validator and relay the VSC packets to all the consumers
+			delegateByIdx(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(3).Mul(sdk.DefaultPowerReduction), 0)
+			s.AdvanceEpochs(1)
+			relayAllCommittedPacketsToConsumers(s, 1)
+
+			expPowers := []int64{}
+			for _, val := range s.providerChain.Vals.Validators {
+				expPowers = append(expPowers, val.VotingPower)
+			}
+			for _, bundle := range s.consumerBundles {
+				// the validator updates are applied to the next validator set of the consumer
+				bundle.Chain.NextBlock()
+
+				powers := []int64{}
+				for _, val := range bundle.Chain.NextVals.Validators {
+					powers = append(powers, val.VotingPower)
+				}
+				require.ElementsMatch(t, expPowers, powers, "consumer %s", bundle.ConsumerId)
+			}
+
+			s.TearDownTest()
+		})
+	}
+}
+
+func TestNewSuiteConfig(t *testing.T) {
+	cfg, err := newSuiteConfig()
+	require.NoError(t, err)
+	require.Equal(t, DefaultSuiteConfig(), cfg)
+
+	// the environment overrides the defaults
+	t.Setenv(EnvNumConsumers, "10")
+	t.Setenv(EnvValidatorPowers, "3, 2,1")
+	cfg, err = newSuiteConfig()
+	require.NoError(t, err)
+	require.Equal(t, SuiteConfig{NumValidators: 3, NumConsumers: 10, ValidatorPowers: []int64{3, 2, 1}}, cfg)
+
+	// the options override the environment
+	cfg, err = newSuiteConfig(WithNumValidators(1), WithNumConsumers(2))
+	require.NoError(t, err)
+	require.Equal(t, SuiteConfig{NumValidators: 1, NumConsumers: 2}, cfg)
+
+	_, err = newSuiteConfig(WithNumConsumers(0))
+	require.Error(t, err)
+	_, err = newSuiteConfig(WithValidatorPowers(1, 0))
+	require.Error(t, err)
+
+	t.Setenv(EnvVerifyValsets, "true")
+	cfg, err = newSuiteConfig()
+	require.NoError(t, err)
+	require.True(t, cfg.VerifyConsumerValsets)
+
+	t.Setenv(EnvVerifyValsets, "maybe")
+	_, err = newSuiteConfig()
+	require.Error(t, err)
+	t.Setenv(EnvVerifyValsets, "false")
+
+	t.Setenv(EnvNumValidators, "four")
+	_, err = newSuiteConfig()
+	require.Error(t, err)
+}
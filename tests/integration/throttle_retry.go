@@ -7,6 +7,9 @@ import (
 
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -240,3 +243,180 @@ func (s *CCVTestSuite) TestSlashRetries() {
 	s.Require().Empty(consumerKeeper.GetPendingPackets(s.consumerCtx()))
 	s.Require().True(consumerKeeper.PacketSendingPermitted(s.consumerCtx()))
 }
+
+// TestSlashRetriesEndToEnd tests the full bounce-and-retry loop of throttling v2,
+// where the acknowledgements are relayed and the time is advanced on all the chains.
+// @Long Description@
+// * Set up the CCV channels and four validators with equal power.
+// * Queue slash packets for two validators on the consumer.
+// * Relay the first slash packet and check that the first validator is jailed, which drains the slash meter.
+// * Relay the second slash packet and check that it is bounced and that the consumer does not resend it
+// before the retry delay has elapsed.
+// * Advance the time by the retry delay and relay the resent slash packet, until the provider
+// replenishes the slash meter and handles it.
+// * Check that every slash packet was bounced if and only if the slash meter was negative, that the second
+// validator is jailed once the packet is handled and that the consumer cleared its slash record.
+func (s *CCVTestSuite) TestSlashRetriesEndToEnd() {
+	tmval1, tmval2 := s.setupSlashRetries()
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.getFirstBundle().App.GetConsumerKeeper()
+
+	// the handling of the first slash packet drains the slash meter
+	trace := s.relaySlashPacket()
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_HANDLED, trace.Outcome)
+	s.confirmValidatorJailed(*tmval1, false)
+	s.Require().True(providerKeeper.GetSlashMeter(s.providerCtx()).IsNegative())
+
+	// the second slash packet is sent once the first one is acknowledged, and is bounced
+	trace = s.relaySlashPacket()
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_BOUNCED, trace.Outcome)
+	s.confirmValidatorNotJailed(*tmval2, 1000)
+	slashRecord, found := consumerKeeper.GetSlashRecord(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().False(slashRecord.WaitingOnReply)
+	s.Require().Len(consumerKeeper.GetPendingPackets(s.consumerCtx()), 1)
+
+	// the consumer does not resend the slash packet before the retry delay has elapsed
+	s.consumerChain.NextBlock()
+	s.requireNoSlashPacketSent()
+
+	// the consumer resends the slash packet after every retry delay, until the provider
+	// replenishes the slash meter enough to handle it
+	retryDelay := consumerKeeper.GetRetryDelayPeriod(s.consumerCtx())
+	bounces := 1
+	for trace.Outcome == providertypes.SLASH_PACKET_OUTCOME_BOUNCED {
+		s.Require().Less(bounces, 10, "slash packet is never handled")
+		s.AdvanceTime(retryDelay)
+		trace = s.relaySlashPacket()
+		bounces++
+	}
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_HANDLED, trace.Outcome)
+	s.Require().Greater(bounces, 2)
+	s.confirmValidatorJailed(*tmval2, false)
+
+	// every attempt of the consumer to send the slash packet is traced on the provider
+	attempts := providerKeeper.GetSlashPacketAttempts(s.providerCtx(), s.getFirstBundle().ConsumerId, trace)
+	s.Require().Len(attempts, bounces)
+
+	// the consumer state is cleared
+	_, found = consumerKeeper.GetSlashRecord(s.consumerCtx())
+	s.Require().False(found)
+	s.Require().Empty(consumerKeeper.GetPendingPackets(s.consumerCtx()))
+	s.Require().True(consumerKeeper.PacketSendingPermitted(s.consumerCtx()))
+}
+
+// TestSlashRetriesWithParamChanges tests the bounce-and-retry loop of throttling v2
+// when the retry delay of the consumer and the slash meter parameters of the provider change mid-flight.
+// @Long Description@
+// * Set up the CCV channels and four validators with equal power.
+// * Queue slash packets for two validators on the consumer, relay them and check that the second one is bounced.
+// * Increase the retry delay on the consumer and check that the slash packet is not resent after the previous retry delay.
+// * Decrease the retry delay on the consumer and check that the slash packet is resent and bounced again.
+// * Increase the slash meter replenish fraction on the provider and check that the slash packet
+// is handled after the next replenishment of the slash meter.
+func (s *CCVTestSuite) TestSlashRetriesWithParamChanges() {
+	tmval1, tmval2 := s.setupSlashRetries()
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.getFirstBundle().App.GetConsumerKeeper()
+
+	// the first slash packet is handled and the second one is bounced
+	trace := s.relaySlashPacket()
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_HANDLED, trace.Outcome)
+	s.confirmValidatorJailed(*tmval1, false)
+	trace = s.relaySlashPacket()
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_BOUNCED, trace.Outcome)
+
+	// the increased retry delay applies to the bounced slash packet
+	retryDelay := consumerKeeper.GetRetryDelayPeriod(s.consumerCtx())
+	consumerParams := consumerKeeper.GetConsumerParams(s.consumerCtx())
+	consumerParams.RetryDelayPeriod = 2 * retryDelay
+	consumerKeeper.SetParams(s.consumerCtx(), consumerParams)
+	s.AdvanceTime(retryDelay + time.Minute)
+	s.requireNoSlashPacketSent()
+	slashRecord, found := consumerKeeper.GetSlashRecord(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().False(slashRecord.WaitingOnReply)
+
+	// the decreased retry delay applies to the bounced slash packet; the slash meter
+	// was replenished only once, so the resent slash packet is bounced again
+	consumerParams.RetryDelayPeriod = retryDelay / 2
+	consumerKeeper.SetParams(s.consumerCtx(), consumerParams)
+	s.consumerChain.NextBlock()
+	trace = s.relaySlashPacket()
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_BOUNCED, trace.Outcome)
+	s.confirmValidatorNotJailed(*tmval2, 1000)
+
+	// the increased replenish fraction applies from the next replenishment of the slash meter,
+	// which allows the slash packet to be handled
+	providerParams := providerKeeper.GetParams(s.providerCtx())
+	providerParams.SlashMeterReplenishFraction = "1.0"
+	providerKeeper.SetParams(s.providerCtx(), providerParams)
+	s.Require().True(providerKeeper.GetSlashMeter(s.providerCtx()).IsNegative())
+	replenishTime := providerKeeper.GetSlashMeterReplenishTimeCandidate(s.providerCtx())
+	for trace.Outcome == providertypes.SLASH_PACKET_OUTCOME_BOUNCED {
+		s.Require().True(s.providerCtx().BlockTime().Before(replenishTime), "slash packet bounced after the slash meter was replenished")
+		s.AdvanceTime(consumerParams.RetryDelayPeriod)
+		trace = s.relaySlashPacket()
+	}
+	s.Require().Equal(providertypes.SLASH_PACKET_OUTCOME_HANDLED, trace.Outcome)
+	s.confirmValidatorJailed(*tmval2, false)
+
+	_, found = consumerKeeper.GetSlashRecord(s.consumerCtx())
+	s.Require().False(found)
+	s.Require().Empty(consumerKeeper.GetPendingPackets(s.consumerCtx()))
+}
+
+// setupSlashRetries sets up the CCV channels, four validators with equal power and the slash meter,
+// and queues downtime slash packets on the first consumer chain for two of the validators, which are returned
+func (s *CCVTestSuite) setupSlashRetries() (tmval1, tmval2 *tmtypes.Validator) {
+	s.SetupAllCCVChannels()
+	s.SendEmptyVSCPacket() // Establish ccv channel
+	s.setupValidatorPowers([]int64{1000, 1000, 1000, 1000})
+	s.providerApp.GetProviderKeeper().InitializeSlashMeter(s.providerCtx())
+
+	// the validators are cached, since s.providerChain.Vals.Validators changes with the jailings
+	tmval1 = s.providerChain.Vals.Validators[1]
+	tmval2 = s.providerChain.Vals.Validators[2]
+	s.setDefaultValSigningInfo(*tmval1)
+	s.setDefaultValSigningInfo(*tmval2)
+
+	consumerKeeper := s.getFirstBundle().App.GetConsumerKeeper()
+	for _, tmval := range []*tmtypes.Validator{tmval1, tmval2} {
+		_, data := s.constructSlashPacketFromConsumerWithData(
+			s.getFirstBundle(), *tmval, stakingtypes.Infraction_INFRACTION_DOWNTIME, 1)
+		consumerKeeper.AppendPendingPacket(s.consumerCtx(), ccvtypes.SlashPacket,
+			&ccvtypes.ConsumerPacketData_SlashPacketData{
+				SlashPacketData: &data,
+			},
+		)
+	}
+
+	// Advance block on consumer to send the first pending packet
+	s.getFirstBundle().Chain.NextBlock()
+	return tmval1, tmval2
+}
+
+// relaySlashPacket relays the slash packet sent by the first consumer chain together with its acknowledgement,
+// and returns the trace recorded by the provider. It checks that the slash packet was bounced
+// if and only if the slash meter was negative when the packet was received.
+func (s *CCVTestSuite) relaySlashPacket() providertypes.SlashPacketTrace {
+	packets := getAllCommittedPackets(s, s.consumerChain, ccvtypes.ConsumerPortID, s.path.EndpointA.ChannelID, 1)
+	relayPackets(s, s.path, packets)
+
+	trace, found := s.providerApp.GetProviderKeeper().GetSlashPacketTrace(
+		s.providerCtx(), s.getFirstBundle().ConsumerId, packets[0].Sequence)
+	s.Require().True(found)
+	s.Require().Equal(trace.SlashMeter.IsNegative(), trace.Outcome == providertypes.SLASH_PACKET_OUTCOME_BOUNCED)
+
+	// Couple blocks pass on provider for provider staking keeper to process jailing
+	s.providerChain.NextBlock()
+	s.providerChain.NextBlock()
+	return trace
+}
+
+// requireNoSlashPacketSent checks that the first consumer chain has no slash packet in flight
+func (s *CCVTestSuite) requireNoSlashPacketSent() {
+	commitments := s.consumerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.consumerCtx(), ccvtypes.ConsumerPortID, s.path.EndpointA.ChannelID)
+	s.Require().Empty(commitments)
+}
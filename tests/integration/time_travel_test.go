@@ -0,0 +1,77 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	appConsumer "github.com/cosmos/interchain-security/v7/app/consumer"
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestTimeTravelHelpers tests the helpers moving the chains of CCVTestSuite forward in time.
+// @Long Description@
+// * Set up a provider and two consumer chains.
+// * Advance the time beyond the trusting period of the CCV clients and check that the clients did not expire
+// and that the clocks of all chains are in sync.
+// * Advance two epochs and check that the valset update id was incremented twice.
+// * Register a consumer chain with a spawn time in the future, advance to its spawn time
+// and check that the consumer chain is launched.
+func TestTimeTravelHelpers(t *testing.T) {
+	s := NewCCVTestSuite[*appProvider.App, *appConsumer.App](
+		icstestingutils.ProviderAppIniter, icstestingutils.ConsumerAppIniter, []string{}, WithNumConsumers(2))
+	s.SetT(t)
+	s.SetupTest()
+	s.SetupAllCCVChannels()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+
+	// AdvanceTime keeps the CCV clients alive
+	cs, ok := s.providerApp.GetIBCKeeper().ClientKeeper.GetClientState(s.providerCtx(), s.path.EndpointB.ClientID)
+	s.Require().True(ok)
+	trustingPeriod := cs.(*ibctm.ClientState).TrustingPeriod
+	startTime := s.coordinator.CurrentTime
+	s.AdvanceTime(trustingPeriod + time.Hour)
+	s.Require().True(s.coordinator.CurrentTime.Sub(startTime) >= trustingPeriod+time.Hour)
+	for _, bundle := range s.sortedConsumerBundles() {
+		s.Require().Equal(s.providerChain.ProposedHeader.Time, bundle.Chain.ProposedHeader.Time)
+		s.Require().Equal(exported.Active, s.providerApp.GetIBCKeeper().ClientKeeper.GetClientStatus(
+			s.providerCtx(), bundle.Path.EndpointB.ClientID))
+		s.Require().Equal(exported.Active, bundle.App.GetIBCKeeper().ClientKeeper.GetClientStatus(
+			bundle.GetCtx(), bundle.Path.EndpointA.ClientID))
+	}
+
+	// AdvanceEpochs ends the given number of epochs
+	valsetUpdateId := providerKeeper.GetValidatorSetUpdateId(s.providerCtx())
+	s.AdvanceEpochs(2)
+	s.Require().Equal(valsetUpdateId+2, providerKeeper.GetValidatorSetUpdateId(s.providerCtx()))
+
+	// AdvanceToSpawnTime launches the consumer chain
+	spawnTime := s.coordinator.CurrentTime.Add(24 * time.Hour)
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = spawnTime
+	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
+	powerShapingParameters.Top_N = 100
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(s.providerCtx())
+	providerKeeper.SetConsumerChainId(s.providerCtx(), consumerId, "spawning-chain")
+	s.Require().NoError(providerKeeper.SetConsumerMetadata(s.providerCtx(), consumerId, testkeeper.GetTestConsumerMetadata()))
+	s.Require().NoError(providerKeeper.SetConsumerInitializationParameters(s.providerCtx(), consumerId, initializationParameters))
+	s.Require().NoError(providerKeeper.SetConsumerPowerShapingParameters(s.providerCtx(), consumerId, powerShapingParameters))
+	s.Require().NoError(providerKeeper.SetInfractionParameters(s.providerCtx(), consumerId, testkeeper.GetTestInfractionParameters()))
+	providerKeeper.SetConsumerPhase(s.providerCtx(), consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	s.Require().NoError(providerKeeper.AppendConsumerToBeLaunched(s.providerCtx(), consumerId, spawnTime))
+
+	s.coordinator.CommitBlock(s.providerChain)
+	s.Require().Equal(providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+
+	s.AdvanceToSpawnTime(consumerId)
+	s.Require().Equal(providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+
+	s.TearDownTest()
+}
@@ -32,7 +32,7 @@ func (s *CCVTestSuite) TestUndelegationCompletion() {
 	stakingKeeper := s.providerApp.GetTestStakingKeeper()
 	unbondingPeriod, err := stakingKeeper.UnbondingTime(s.providerCtx())
 	s.Require().NoError(err)
-	incrementTime(s, unbondingPeriod)
+	s.AdvanceTime(unbondingPeriod)
 
 	// check that the unbonding operation completed
 	checkStakingUnbondingOps(s, valsetUpdateID, false)
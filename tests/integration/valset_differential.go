@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"bytes"
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	"cosmossdk.io/math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestConsumerValsetDifferential tests the consistency oracle of the differential test mode.
+// @Long Description@
+// * Set up CCV channel.
+// * Delegate tokens on the provider and cap the validator set of the consumer chain.
+// * Relay the VSC packet and check that the consumer validator set matches the one intended by the provider.
+// * Tamper with the consumer validator set and check that the divergence is detected.
+func (s *CCVTestSuite) TestConsumerValsetDifferential() {
+	s.SetupCCVChannel(s.path)
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	// change the validator set of the consumer chain according to its power-shaping parameters
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	validatorSetCap := uint32(len(s.consumerChain.NextVals.Validators))
+	if validatorSetCap > 1 {
+		validatorSetCap--
+	}
+	// the validator set cap only applies to opt-in chains
+	powerShapingParameters.Top_N = 0
+	powerShapingParameters.ValidatorSetCap = validatorSetCap
+	s.Require().NoError(providerKeeper.SetConsumerPowerShapingParameters(s.providerCtx(), consumerId, powerShapingParameters))
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(10000000))
+	s.AdvanceEpochs(1)
+
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+	lastVSC, found := s.consumerApp.GetConsumerKeeper().GetLastVSC(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().NoError(verifyConsumerValset(s, consumerId, lastVSC.ValsetUpdateId))
+	s.Require().Len(s.consumerChain.NextVals.Validators, int(validatorSetCap))
+
+	// tamper with the validator set of the consumer chain
+	nextVals := s.consumerChain.NextVals
+	consumerValSet, err := providerKeeper.GetConsumerValSet(s.providerCtx(), consumerId)
+	s.Require().NoError(err)
+	s.consumerChain.NextVals = ibctesting.ApplyValSetChanges(s.T(), nextVals, []abci.ValidatorUpdate{
+		{PubKey: *consumerValSet[0].PublicKey, Power: consumerValSet[0].Power + 1},
+	})
+	s.Require().Error(verifyConsumerValset(s, consumerId, lastVSC.ValsetUpdateId))
+
+	s.consumerChain.NextVals = nextVals
+	s.Require().NoError(verifyConsumerValset(s, consumerId, lastVSC.ValsetUpdateId))
+}
+
+// checkConsumerValset is the consistency oracle of the differential test mode enabled with
+// WithConsumerValsetVerification. It is called after a packet is relayed on `path` and, if the packet
+// is a VSC packet sent by the provider, it fails the test if the validator set of the consumer chain
+// diverges from the provider (see verifyConsumerValset).
+//
+// Note that the VSC packets that are not sent by the provider, e.g., the packets created by SendEmptyVSCPacket,
+// are received without the relaying helpers and hence are not checked.
+func checkConsumerValset(s *CCVTestSuite, path *ibctesting.Path, packet channeltypes.Packet) {
+	if !s.config.VerifyConsumerValsets || packet.GetSourcePort() != ccv.ProviderPortID {
+		return
+	}
+	var data ccv.ValidatorSetChangePacketData
+	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// the provider also sends other packets on the CCV channel
+		return
+	}
+
+	// the consumer chain is on the A side of CCV paths
+	for consumerId, bundle := range s.consumerBundles {
+		if bundle.Chain == path.EndpointA.Chain {
+			s.Require().NoError(verifyConsumerValset(s, consumerId, data.ValsetUpdateId))
+			return
+		}
+	}
+}
+
+// verifyConsumerValset checks that the CometBFT validator set of a consumer chain matches the validator set
+// that the provider intended for the VSC packet with the given VSC id, i.e., the validator set that the provider
+// computed according to the power-shaping parameters of the consumer chain when sending the VSC packet.
+// The validator sets are compared by their CometBFT hashes. Nothing is checked if the provider does not retain
+// the validator set sent with the VSC id, i.e., if the valset history is disabled or already pruned.
+func verifyConsumerValset(s *CCVTestSuite, consumerId string, vscId uint64) error {
+	bundle := s.consumerBundles[consumerId]
+	snapshot, found := s.providerApp.GetProviderKeeper().GetValsetSnapshotAtVsc(s.providerCtx(), consumerId, vscId)
+	if !found || snapshot.ValsetUpdateId != vscId {
+		return nil
+	}
+	lastVSC, found := bundle.GetKeeper().GetLastVSC(bundle.GetCtx())
+	if !found || lastVSC.ValsetUpdateId != vscId {
+		return fmt.Errorf("consumer %s did not apply the VSC packet with vscID %d", consumerId, vscId)
+	}
+
+	updates := make([]abci.ValidatorUpdate, 0, len(snapshot.Validators))
+	for _, val := range snapshot.Validators {
+		updates = append(updates, abci.ValidatorUpdate{PubKey: *val.PublicKey, Power: val.Power})
+	}
+	expectedHash, err := ccv.ComputeValsetHash(updates)
+	if err != nil {
+		return err
+	}
+
+	// the validator updates returned by the consumer chain when receiving the VSC packet
+	// are applied to the next validators of the chain
+	appliedHash := bundle.Chain.NextVals.Hash()
+	if !bytes.Equal(expectedHash, appliedHash) {
+		return fmt.Errorf("consumer %s diverges from the provider at vscID %d: expected valset hash %X, got %X\n"+
+			"provider validators: %s\nconsumer validators: %s",
+			consumerId, vscId, expectedHash, appliedHash,
+			formatConsensusValidators(snapshot.Validators), formatCometValidators(bundle.Chain.NextVals))
+	}
+	return nil
+}
+
+// formatConsensusValidators returns a human-readable representation of the given consumer validators
+func formatConsensusValidators(validators []providertypes.ConsensusValidator) string {
+	formatted := make([]string, 0, len(validators))
+	for _, val := range validators {
+		consAddr, err := ccv.TMCryptoPublicKeyToConsAddr(*val.PublicKey)
+		if err != nil {
+			formatted = append(formatted, fmt.Sprintf("<invalid key>:%d", val.Power))
+			continue
+		}
+		formatted = append(formatted, fmt.Sprintf("%X:%d", consAddr.Bytes(), val.Power))
+	}
+	return fmt.Sprint(formatted)
+}
+
+// formatCometValidators returns a human-readable representation of the given CometBFT validator set
+func formatCometValidators(valSet *tmtypes.ValidatorSet) string {
+	formatted := make([]string, 0, len(valSet.Validators))
+	for _, val := range valSet.Validators {
+		formatted = append(formatted, fmt.Sprintf("%X:%d", val.Address.Bytes(), val.VotingPower))
+	}
+	return fmt.Sprint(formatted)
+}
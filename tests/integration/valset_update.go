@@ -22,7 +22,7 @@ func (s *CCVTestSuite) TestPacketRoundtrip() {
 	delegate(s, delAddr, bondAmt)
 
 	// Send CCV packet to consumer at the end of the epoch
-	s.nextEpoch()
+	s.AdvanceEpochs(1)
 
 	// Relay 1 VSC packet from provider to consumer
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
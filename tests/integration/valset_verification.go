@@ -0,0 +1,91 @@
+package integration
+
+import (
+	"cosmossdk.io/math"
+
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestProviderValsetVerification tests that the consumer chain can verify its validator set against
+// the valset checkpoint stored on the provider chain, without relaying packets over the CCV channel.
+// @Long Description@
+// * Set up CCV channel.
+// * Delegate tokens on the provider, so that a VSC packet is sent to the consumer chain, but do not relay it.
+// * Submit a proof of the latest provider valset checkpoint to the consumer chain
+// and check that the divergence of the consumer validator set is recorded and an event is emitted.
+// * Check that proofs of stale provider states and proofs that do not match the submitted checkpoint are rejected.
+// * Relay the VSC packet, submit a new proof, and check that the consumer validator set matches the checkpoint.
+func (s *CCVTestSuite) TestProviderValsetVerification() {
+	s.SetupCCVChannel(s.path)
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+	s.Require().Equal(consumerId, consumerKeeper.GetConsumerId(s.consumerCtx()))
+	msgServer := consumerkeeper.NewMsgServerImpl(&consumerKeeper)
+
+	// delegate tokens to change the validator set, but do not relay the VSC packet
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(10000000))
+	s.AdvanceEpochs(1)
+
+	checkpoint, found := providerKeeper.GetConsumerValsetCheckpoint(s.providerCtx(), consumerId)
+	s.Require().True(found)
+
+	// build the message submitting the proof of the latest provider valset checkpoint
+	newMsg := func() *consumertypes.MsgSubmitProviderValsetProof {
+		s.Require().NoError(s.path.EndpointA.UpdateClient())
+		proof, proofHeight := s.providerChain.QueryProofForStore(ccv.ProviderStoreKey,
+			ccv.ProviderValsetCheckpointKey(consumerId), s.providerChain.App.LastBlockHeight())
+		return &consumertypes.MsgSubmitProviderValsetProof{
+			Submitter:      s.consumerChain.SenderAccount.GetAddress().String(),
+			ProofHeight:    proofHeight,
+			ValsetUpdateId: checkpoint.ValsetUpdateId,
+			ValsetHash:     checkpoint.ValsetHash,
+			Proof:          proof,
+		}
+	}
+
+	ctx := s.consumerCtx()
+	msg := newMsg()
+	res, err := msgServer.SubmitProviderValsetProof(ctx, msg)
+	s.Require().NoError(err)
+	s.Require().False(res.Match)
+
+	verification, found := consumerKeeper.GetProviderValsetVerification(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().False(verification.Match)
+	s.Require().Equal(checkpoint.ValsetUpdateId, verification.ValsetUpdateId)
+	s.Require().Equal(checkpoint.ValsetHash, verification.ProviderValsetHash)
+	mismatch := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == consumertypes.EventTypeProviderValsetMismatch {
+			mismatch = true
+		}
+	}
+	s.Require().True(mismatch)
+
+	// a proof of the same provider state is rejected
+	_, err = msgServer.SubmitProviderValsetProof(s.consumerCtx(), msg)
+	s.Require().ErrorIs(err, consumertypes.ErrStaleValsetProof)
+
+	// a proof that does not match the submitted checkpoint is rejected
+	msg = newMsg()
+	msg.ValsetHash = []byte{0x01}
+	_, err = msgServer.SubmitProviderValsetProof(s.consumerCtx(), msg)
+	s.Require().ErrorIs(err, consumertypes.ErrInvalidValsetProof)
+
+	// relay the VSC packet
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+	s.providerChain.NextBlock()
+
+	res, err = msgServer.SubmitProviderValsetProof(s.consumerCtx(), newMsg())
+	s.Require().NoError(err)
+	s.Require().True(res.Match)
+
+	verification, found = consumerKeeper.GetProviderValsetVerification(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().True(verification.Match)
+	s.Require().Equal(checkpoint.ValsetUpdateId, verification.LastReceivedValsetUpdateId)
+}
@@ -0,0 +1,56 @@
+package loadtest
+
+import (
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// The defaults keep the load test short enough to run with the other tests;
+// use `make test-load` or pass the flags after -args to run a larger load test.
+var (
+	numConsumers   = flag.Int("consumers", 5, "number of consumer chains")
+	numEpochs      = flag.Int("epochs", 3, "number of epochs")
+	blocksPerEpoch = flag.Int64("blocks-per-epoch", 2, "number of blocks per epoch")
+	maxEndBlock    = flag.Duration("max-end-block", 0, "maximum 95th percentile of the provider EndBlock latency (zero to disable)")
+)
+
+func TestLoad(t *testing.T) {
+	cfg := Config{
+		NumConsumers:   *numConsumers,
+		NumEpochs:      *numEpochs,
+		BlocksPerEpoch: *blocksPerEpoch,
+	}
+	report := Run(t, cfg)
+	t.Log("\n" + report.String())
+
+	// a VSC packet is sent to every consumer chain at the end of every epoch
+	require.Equal(t, float64(cfg.NumConsumers*cfg.NumEpochs), report.Counters[ccv.MetricKeyVSCPacketsSent])
+	require.Equal(t, cfg.NumEpochs*int(cfg.BlocksPerEpoch), report.HookLatencies[providerkeeper.BlockHookEndBlock].Count)
+
+	if *maxEndBlock > 0 {
+		p95 := report.HookLatencies[providerkeeper.BlockHookEndBlock].P95
+		require.LessOrEqual(t, p95, *maxEndBlock,
+			"the 95th percentile of the provider EndBlock latency (%s) exceeds %s", p95, *maxEndBlock)
+	}
+}
+
+func TestLatency(t *testing.T) {
+	require.Equal(t, Latency{}, newLatency(nil))
+
+	durations := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		durations = append(durations, time.Duration(i)*time.Millisecond)
+	}
+	require.Equal(t, Latency{
+		Count: 100,
+		Mean:  50500 * time.Microsecond,
+		P95:   95 * time.Millisecond,
+		Max:   100 * time.Millisecond,
+	}, newLatency(durations))
+}
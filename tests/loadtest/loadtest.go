@@ -0,0 +1,216 @@
+// Package loadtest contains a load test for the provider module. It registers and launches
+// many simulated consumer chains against an in-memory provider app, drives epochs, and reports
+// the BeginBlock and EndBlock latencies, the growth of the provider store, and the packet counts.
+package loadtest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Config is the configuration of a load test
+type Config struct {
+	// NumConsumers is the number of consumer chains registered and launched
+	NumConsumers int
+	// NumEpochs is the number of epochs driven after the consumer chains are launched
+	NumEpochs int
+	// BlocksPerEpoch is the number of blocks per epoch
+	BlocksPerEpoch int64
+}
+
+// Validate validates the configuration of a load test
+func (cfg Config) Validate() error {
+	if cfg.NumConsumers <= 0 {
+		return fmt.Errorf("number of consumers must be positive: %d", cfg.NumConsumers)
+	}
+	if cfg.NumEpochs <= 0 {
+		return fmt.Errorf("number of epochs must be positive: %d", cfg.NumEpochs)
+	}
+	if cfg.BlocksPerEpoch <= 0 {
+		return fmt.Errorf("blocks per epoch must be positive: %d", cfg.BlocksPerEpoch)
+	}
+	return nil
+}
+
+// Run runs a load test with the given configuration:
+//   - NumConsumers consumer chains are registered through MsgCreateConsumer, with all the provider validators opted in;
+//   - the consumer chains are launched in the next block and a CCV channel is simulated for each of them,
+//     i.e., the VSC packets are committed on the provider, but never relayed;
+//   - NumEpochs epochs of BlocksPerEpoch blocks are driven, with the validator powers changing every epoch,
+//     so that every consumer chain gets a VSC packet per epoch.
+//
+// Only the epochs are measured, i.e., the launch of the consumer chains is excluded from the latencies and packet counts.
+// Note that Run enables telemetry for the duration of the test.
+func Run(t *testing.T, cfg Config) Report {
+	t.Helper()
+	require.NoError(t, cfg.Validate())
+
+	sink := installSink(t)
+
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	providerChain, providerApp := icstestingutils.AddProvider[*appProvider.App](t, coordinator, icstestingutils.ProviderAppIniter)
+	providerKeeper := providerApp.GetProviderKeeper()
+
+	params := providerKeeper.GetParams(providerChain.GetContext())
+	params.BlocksPerEpoch = cfg.BlocksPerEpoch
+	providerKeeper.SetParams(providerChain.GetContext(), params)
+
+	consumerIds := registerConsumers(t, coordinator, providerChain, providerKeeper, cfg.NumConsumers)
+
+	// launch the consumer chains
+	coordinator.CommitBlock(providerChain)
+	for _, consumerId := range consumerIds {
+		require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED,
+			providerKeeper.GetConsumerPhase(providerChain.GetContext(), consumerId),
+			"consumer chain %s not launched", consumerId)
+		openCCVChannel(t, providerChain, providerApp, providerKeeper, consumerId)
+	}
+
+	storeBefore := getStoreUsage(providerChain.GetContext(), providerApp)
+	sink.reset()
+
+	var blockDurations []time.Duration
+	for epoch := 0; epoch < cfg.NumEpochs; epoch++ {
+		changeValidatorPowers(t, providerChain, providerApp, epoch)
+		for i := int64(0); i < cfg.BlocksPerEpoch; i++ {
+			start := time.Now()
+			coordinator.CommitBlock(providerChain)
+			blockDurations = append(blockDurations, time.Since(start))
+		}
+	}
+
+	return Report{
+		Config:         cfg,
+		BlockLatency:   newLatency(blockDurations),
+		HookLatencies:  sink.hookLatencies(),
+		PhaseLatencies: sink.phaseLatencies(),
+		Counters:       sink.counterTotals(),
+		StoreGrowth:    storeBefore.growth(getStoreUsage(providerChain.GetContext(), providerApp)),
+	}
+}
+
+// registerConsumers registers numConsumers consumer chains, to be launched in the next block,
+// and opts in all the provider validators. It returns the consumer ids.
+func registerConsumers(
+	t *testing.T,
+	coordinator *ibctesting.Coordinator,
+	providerChain *ibctesting.TestChain,
+	providerKeeper providerkeeper.Keeper,
+	numConsumers int,
+) []string {
+	t.Helper()
+	ctx := providerChain.GetContext()
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	// NOTE: the spawn time must be set relative to the hardcoded start time of the coordinator
+	initializationParameters.SpawnTime = coordinator.CurrentTime
+
+	lastVals, err := providerKeeper.GetLastBondedValidators(ctx)
+	require.NoError(t, err)
+
+	consumerIds := make([]string, 0, numConsumers)
+	for i := 0; i < numConsumers; i++ {
+		msg, err := providertypes.NewMsgCreateConsumer(
+			providerChain.SenderAccount.GetAddress().String(),
+			fmt.Sprintf("loadchain%d", i),
+			testkeeper.GetTestConsumerMetadata(),
+			&initializationParameters,
+			nil,
+			nil,
+			nil,
+		)
+		require.NoError(t, err)
+		require.NoError(t, msg.ValidateBasic())
+
+		resp, err := msgServer.CreateConsumer(ctx, msg)
+		require.NoError(t, err)
+
+		for _, val := range lastVals {
+			consAddr, err := val.GetConsAddr()
+			require.NoError(t, err)
+			providerKeeper.SetOptedIn(ctx, resp.ConsumerId, providertypes.NewProviderConsAddress(consAddr))
+		}
+		consumerIds = append(consumerIds, resp.ConsumerId)
+	}
+	return consumerIds
+}
+
+// openCCVChannel simulates the opening of the CCV channel to a launched consumer chain,
+// i.e., it sets an open connection and an open channel on top of the consumer client
+// created by the provider and establishes the channel as the CCV channel of the consumer chain
+func openCCVChannel(
+	t *testing.T,
+	providerChain *ibctesting.TestChain,
+	providerApp *appProvider.App,
+	providerKeeper providerkeeper.Keeper,
+	consumerId string,
+) {
+	t.Helper()
+	ctx := providerChain.GetContext()
+	ibcKeeper := providerApp.GetIBCKeeper()
+
+	clientId, found := providerKeeper.GetConsumerClientId(ctx, consumerId)
+	require.True(t, found, "client not found for consumer chain %s", consumerId)
+
+	connectionId := ibcKeeper.ConnectionKeeper.GenerateConnectionIdentifier(ctx)
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, connectionId, conntypes.NewConnectionEnd(
+		conntypes.OPEN,
+		clientId,
+		conntypes.NewCounterparty("07-tendermint-0", "connection-0", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		conntypes.GetCompatibleVersions(),
+		0,
+	))
+
+	channelId := ibcKeeper.ChannelKeeper.GenerateChannelIdentifier(ctx)
+	ibcKeeper.ChannelKeeper.SetChannel(ctx, ccv.ProviderPortID, channelId, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.ORDERED,
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "channel-0"),
+		[]string{connectionId},
+		ccv.Version,
+	))
+	ibcKeeper.ChannelKeeper.SetNextSequenceSend(ctx, ccv.ProviderPortID, channelId, 1)
+
+	require.NoError(t, providerKeeper.SetConsumerChain(ctx, channelId))
+}
+
+// changeValidatorPowers delegates to one of the provider validators (a different one every epoch),
+// so that a VSC packet is sent to every consumer chain at the end of the epoch
+func changeValidatorPowers(t *testing.T, providerChain *ibctesting.TestChain, providerApp *appProvider.App, epoch int) {
+	t.Helper()
+	ctx := providerChain.GetContext()
+	stakingKeeper := providerApp.GetTestStakingKeeper()
+
+	validators, err := stakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, validators)
+	validator := validators[epoch%len(validators)]
+
+	_, err = stakingKeeper.Delegate(
+		ctx,
+		providerChain.SenderAccount.GetAddress(),
+		sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction),
+		stakingtypes.Unbonded,
+		validator,
+		true,
+	)
+	require.NoError(t, err)
+}
@@ -0,0 +1,171 @@
+package loadtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// Report is the result of a load test
+type Report struct {
+	Config Config
+	// BlockLatency is the latency of the provider blocks, i.e., from FinalizeBlock to Commit
+	BlockLatency Latency
+	// HookLatencies are the latencies of the provider BeginBlock and EndBlock
+	HookLatencies map[string]Latency
+	// PhaseLatencies are the latencies of the phases of the provider BeginBlock and EndBlock
+	PhaseLatencies map[string]Latency
+	// Counters are the totals of the provider telemetry counters, e.g., vsc_packets_sent
+	Counters map[string]float64
+	// StoreGrowth is the growth of the provider store per key prefix
+	StoreGrowth []PrefixGrowth
+}
+
+// Latency summarizes the durations of a measured operation
+type Latency struct {
+	Count int
+	Mean  time.Duration
+	P95   time.Duration
+	Max   time.Duration
+}
+
+func newLatency(durations []time.Duration) Latency {
+	if len(durations) == 0 {
+		return Latency{}
+	}
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	return Latency{
+		Count: len(sorted),
+		Mean:  sum / time.Duration(len(sorted)),
+		P95:   sorted[(len(sorted)*95+99)/100-1],
+		Max:   sorted[len(sorted)-1],
+	}
+}
+
+func newLatencies(durations map[string][]time.Duration) map[string]Latency {
+	latencies := make(map[string]Latency, len(durations))
+	for name, d := range durations {
+		latencies[name] = newLatency(d)
+	}
+	return latencies
+}
+
+// PrefixGrowth is the growth of the entries stored under a key prefix of the provider store
+type PrefixGrowth struct {
+	Prefix        string
+	EntriesBefore int
+	EntriesAfter  int
+	BytesBefore   int
+	BytesAfter    int
+}
+
+type prefixUsage struct {
+	entries int
+	bytes   int
+}
+
+// storeUsage is the number of entries and bytes stored per key prefix
+type storeUsage map[string]prefixUsage
+
+// getStoreUsage returns the usage of the provider store
+func getStoreUsage(ctx sdk.Context, providerApp *appProvider.App) storeUsage {
+	decoders := providertypes.StorePrefixDecoders()
+	store := ctx.KVStore(providerApp.GetKey(providertypes.StoreKey))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	usage := storeUsage{}
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		prefix := fmt.Sprintf("0x%02X", key[0])
+		if decoder, found := decoders[key[0]]; found {
+			prefix = decoder.Name
+		}
+		u := usage[prefix]
+		u.entries++
+		u.bytes += len(key) + len(iterator.Value())
+		usage[prefix] = u
+	}
+	return usage
+}
+
+// growth returns the growth from this usage to the given one, sorted by prefix
+func (before storeUsage) growth(after storeUsage) []PrefixGrowth {
+	prefixes := map[string]struct{}{}
+	for prefix := range before {
+		prefixes[prefix] = struct{}{}
+	}
+	for prefix := range after {
+		prefixes[prefix] = struct{}{}
+	}
+
+	growth := make([]PrefixGrowth, 0, len(prefixes))
+	for prefix := range prefixes {
+		growth = append(growth, PrefixGrowth{
+			Prefix:        prefix,
+			EntriesBefore: before[prefix].entries,
+			EntriesAfter:  after[prefix].entries,
+			BytesBefore:   before[prefix].bytes,
+			BytesAfter:    after[prefix].bytes,
+		})
+	}
+	sort.Slice(growth, func(i, j int) bool { return growth[i].Prefix < growth[j].Prefix })
+	return growth
+}
+
+// String returns a human-readable report
+func (r Report) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "consumers: %d, epochs: %d, blocks per epoch: %d\n\n",
+		r.Config.NumConsumers, r.Config.NumEpochs, r.Config.BlocksPerEpoch)
+
+	w := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "LATENCY\tCOUNT\tMEAN\tP95\tMAX")
+	writeLatency := func(name string, l Latency) {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", name, l.Count, l.Mean, l.P95, l.Max)
+	}
+	writeLatency("block", r.BlockLatency)
+	for _, hook := range sortedKeys(r.HookLatencies) {
+		writeLatency(hook, r.HookLatencies[hook])
+	}
+	for _, phase := range sortedKeys(r.PhaseLatencies) {
+		writeLatency("  "+phase, r.PhaseLatencies[phase])
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "COUNTER\tTOTAL")
+	for _, key := range sortedKeys(r.Counters) {
+		fmt.Fprintf(w, "%s\t%.0f\n", key, r.Counters[key])
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "STORE PREFIX\tENTRIES BEFORE\tENTRIES AFTER\tBYTES BEFORE\tBYTES AFTER")
+	for _, g := range r.StoreGrowth {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\n", g.Prefix, g.EntriesBefore, g.EntriesAfter, g.BytesBefore, g.BytesAfter)
+	}
+	_ = w.Flush()
+	return sb.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
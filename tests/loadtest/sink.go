@@ -0,0 +1,145 @@
+package loadtest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+var _ metrics.MetricSink = &sink{}
+
+// sink is an in-memory metrics sink that collects the provider telemetry,
+// i.e., the durations of the provider block hooks and phases and the provider counters
+type sink struct {
+	mu       sync.Mutex
+	hooks    map[string][]time.Duration
+	phases   map[string][]time.Duration
+	counters map[string]float64
+}
+
+// installSink enables telemetry and sets a new sink as the global metrics sink
+// until the end of the test
+func installSink(t *testing.T) *sink {
+	t.Helper()
+	s := &sink{}
+	s.reset()
+
+	// enable the telemetry of the SDK, which also sets a global metrics sink
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	require.NoError(t, err)
+
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, s)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		require.NoError(t, err)
+		_, err = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+		require.NoError(t, err)
+	})
+	return s
+}
+
+// reset drops all the collected metrics
+func (s *sink) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks = map[string][]time.Duration{}
+	s.phases = map[string][]time.Duration{}
+	s.counters = map[string]float64{}
+}
+
+func (s *sink) hookLatencies() map[string]Latency {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return newLatencies(s.hooks)
+}
+
+func (s *sink) phaseLatencies() map[string]Latency {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return newLatencies(s.phases)
+}
+
+func (s *sink) counterTotals() map[string]float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	totals := make(map[string]float64, len(s.counters))
+	for key, total := range s.counters {
+		totals[key] = total
+	}
+	return totals
+}
+
+// isProviderKey returns true if the given metric key is the provider metric with the given name
+func isProviderKey(key []string, name string) bool {
+	return len(key) == 2 && key[0] == providertypes.ModuleName && key[1] == name
+}
+
+func getLabel(labels []metrics.Label, name string) string {
+	for _, label := range labels {
+		if label.Name == name {
+			return label.Value
+		}
+	}
+	return ""
+}
+
+// SetGauge implements metrics.MetricSink
+func (s *sink) SetGauge([]string, float32) {}
+
+// SetGaugeWithLabels implements metrics.MetricSink
+func (s *sink) SetGaugeWithLabels([]string, float32, []metrics.Label) {}
+
+// EmitKey implements metrics.MetricSink
+func (s *sink) EmitKey([]string, float32) {}
+
+// IncrCounter implements metrics.MetricSink
+func (s *sink) IncrCounter(key []string, val float32) {
+	s.IncrCounterWithLabels(key, val, nil)
+}
+
+// IncrCounterWithLabels implements metrics.MetricSink.
+// It sums the provider counters over all the consumer chains.
+func (s *sink) IncrCounterWithLabels(key []string, val float32, _ []metrics.Label) {
+	if len(key) != 2 || key[0] != providertypes.ModuleName {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.counters[key[1]] += float64(val)
+}
+
+// AddSample implements metrics.MetricSink
+func (s *sink) AddSample(key []string, val float32) {
+	s.AddSampleWithLabels(key, val, nil)
+}
+
+// AddSampleWithLabels implements metrics.MetricSink.
+// It collects the durations of the provider block hooks and phases.
+func (s *sink) AddSampleWithLabels(key []string, val float32, labels []metrics.Label) {
+	// timers are in milliseconds
+	duration := time.Duration(float64(val) * float64(time.Millisecond))
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case isProviderKey(key, ccv.MetricKeyBlockHookDuration):
+		hook := getLabel(labels, ccv.MetricLabelHook)
+		s.hooks[hook] = append(s.hooks[hook], duration)
+	case isProviderKey(key, ccv.MetricKeyBlockPhaseDuration):
+		phase := getLabel(labels, ccv.MetricLabelPhase)
+		s.phases[phase] = append(s.phases[phase], duration)
+	}
+}
@@ -0,0 +1,61 @@
+package coredriver
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/informalsystems/itf-go/itf"
+	"github.com/stretchr/testify/require"
+)
+
+// TestProviderCore runs the traces in testdata, as well as the traces generated
+// with generate_traces.sh in the traces folder, against the provider keepers
+func TestProviderCore(t *testing.T) {
+	paths := []string{}
+	for _, dir := range []string{"testdata", "traces"} {
+		err := filepath.WalkDir(dir, func(path string, entry os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !entry.IsDir() && filepath.Ext(path) == ".json" {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if os.IsNotExist(err) {
+			continue
+		}
+		require.NoError(t, err)
+	}
+	require.NotEmpty(t, paths)
+
+	for _, path := range paths {
+		t.Run(path, func(t *testing.T) {
+			RunTrace(t, path)
+		})
+	}
+}
+
+// RunTrace replays the trace at the given path and checks the provider state after every action
+func RunTrace(t *testing.T, path string) {
+	t.Helper()
+
+	trace := &itf.Trace{}
+	require.NoError(t, trace.LoadFromFile(path), "error loading trace file")
+	require.NotEmpty(t, trace.States)
+	for _, varName := range []string{"currentState", "params", "trace"} {
+		require.Contains(t, trace.Vars, varName)
+	}
+
+	params := ReadParams(trace.States[0].VarValues["params"].Value.(itf.MapExprType))
+	driver := NewDriver(t, params)
+
+	for index, state := range trace.States {
+		action := LastAction(trace.States[index].VarValues["trace"].Value.(itf.ListExprType))
+		t.Logf("step %d: %+v", index, action)
+
+		driver.ExecuteAction(action)
+		driver.CheckState(ReadState(state.VarValues["currentState"].Value.(itf.MapExprType)))
+	}
+}
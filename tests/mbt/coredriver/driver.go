@@ -0,0 +1,401 @@
+// Package coredriver replays the traces of the provider_core Quint model (see tests/mbt/model/provider_core.qnt)
+// against the provider keepers. The consumer lifecycle, the opt-in and Top N assignment of validators,
+// and the throttling of slash packets are driven through an in-memory provider app,
+// and the state of the app is compared with the state of the model after every action.
+package coredriver
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Driver runs the actions of a provider_core trace against an in-memory provider app
+type Driver struct {
+	t              *testing.T
+	coordinator    *ibctesting.Coordinator
+	providerChain  *ibctesting.TestChain
+	providerApp    *appProvider.App
+	providerKeeper providerkeeper.Keeper
+	msgServer      providertypes.MsgServer
+
+	// the provider validators of the model nodes
+	consAddrs map[string]providertypes.ProviderConsAddress
+	valAddrs  map[string]sdk.ValAddress
+	// the model nodes of the provider validators, indexed by consensus address
+	nodes map[string]string
+
+	// the time that corresponds to time zero in the model
+	startTime time.Time
+	// the sequence of the last slash packet received from every consumer chain
+	slashPacketSequences map[string]uint64
+}
+
+// NewDriver sets up a provider chain with a validator per model node, such that
+// the state of the provider chain matches the initial state of the model
+func NewDriver(t *testing.T, params ModelParams) *Driver {
+	t.Helper()
+
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	providerChain, providerApp := icstestingutils.AddProvider[*appProvider.App](t, coordinator, icstestingutils.ProviderAppIniter)
+	providerKeeper := providerApp.GetProviderKeeper()
+
+	d := &Driver{
+		t:                    t,
+		coordinator:          coordinator,
+		providerChain:        providerChain,
+		providerApp:          providerApp,
+		providerKeeper:       providerKeeper,
+		msgServer:            providerkeeper.NewMsgServerImpl(&providerKeeper),
+		consAddrs:            map[string]providertypes.ProviderConsAddress{},
+		valAddrs:             map[string]sdk.ValAddress{},
+		nodes:                map[string]string{},
+		slashPacketSequences: map[string]uint64{},
+	}
+
+	nodes := append([]string{}, params.Nodes...)
+	sort.Strings(nodes)
+	vals := providerChain.Vals.Validators
+	require.Len(t, vals, len(nodes), "the model needs as many nodes as the provider chain has validators")
+
+	ctx := d.ctx()
+	stakingKeeper := providerApp.StakingKeeper
+	for i, node := range nodes {
+		consAddr := sdk.ConsAddress(vals[i].Address)
+		validator, err := stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.NoError(t, err)
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		require.NoError(t, err)
+
+		d.consAddrs[node] = providertypes.NewProviderConsAddress(consAddr)
+		d.valAddrs[node] = valAddr
+		d.nodes[consAddr.String()] = node
+
+		// delegate to the validator to get its initial power
+		power := sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction)
+		require.Greater(t, params.InitialPowers[node], power, "initial power of %s too small", node)
+		d.delegate(node, params.InitialPowers[node]-power)
+	}
+
+	stakingParams, err := stakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	stakingParams.UnbondingTime = time.Duration(params.UnbondingPeriod) * time.Second
+	require.NoError(t, stakingKeeper.SetParams(ctx, stakingParams))
+
+	providerParams := providerKeeper.GetParams(ctx)
+	providerParams.SlashMeterReplenishFraction = math.LegacyNewDecWithPrec(params.SlashMeterReplenishFraction, 2).String()
+	providerParams.SlashMeterReplenishPeriod = time.Duration(params.SlashMeterReplenishPeriod) * time.Second
+	// the model computes the consumer validator sets in every block
+	providerParams.BlocksPerEpoch = 1
+	providerKeeper.SetParams(ctx, providerParams)
+
+	// update the validator powers
+	coordinator.CommitBlock(providerChain)
+
+	d.startTime = coordinator.CurrentTime
+	providerKeeper.InitializeSlashMeter(d.ctx())
+
+	return d
+}
+
+func (d *Driver) ctx() sdk.Context {
+	return d.providerChain.GetContext()
+}
+
+// ExecuteAction executes the given model action
+func (d *Driver) ExecuteAction(action ModelAction) {
+	d.t.Helper()
+
+	switch action.Kind {
+	case "init":
+		// nothing to do, the initial state is set up by NewDriver
+	case "CreateConsumer":
+		d.createConsumer(action.ConsumerId, action.TopN, action.SpawnDelay)
+	case "SetTopN":
+		d.requireSuccess(action, d.setTopN(action.ConsumerId, action.TopN))
+	case "OptIn":
+		d.requireSuccess(action, d.providerKeeper.HandleOptIn(d.ctx(), action.ConsumerId, d.consAddrs[action.Node], ""))
+	case "OptOut":
+		d.requireSuccess(action, d.providerKeeper.HandleOptOut(d.ctx(), action.ConsumerId, d.consAddrs[action.Node]))
+	case "RemoveConsumer":
+		_, err := d.msgServer.RemoveConsumer(d.ctx(), &providertypes.MsgRemoveConsumer{
+			ConsumerId: action.ConsumerId,
+			Owner:      d.providerKeeper.GetAuthority(),
+		})
+		d.requireSuccess(action, err)
+	case "Delegate":
+		d.delegate(action.Node, action.Amount)
+	case "SlashPacket":
+		d.receiveSlashPacket(action.ConsumerId, action.Node, action.Outcome)
+	case "NextBlock":
+		d.nextBlock(action.TimeAdvancement)
+	default:
+		d.t.Fatalf("unknown action kind: %s", action.Kind)
+	}
+}
+
+func (d *Driver) requireSuccess(action ModelAction, err error) {
+	d.t.Helper()
+	if action.Success {
+		require.NoError(d.t, err, "%s was expected to succeed", action.Kind)
+	} else {
+		require.Error(d.t, err, "%s was expected to fail", action.Kind)
+	}
+}
+
+// createConsumer creates a consumer chain owned by the gov module, such that its Top N can be set
+func (d *Driver) createConsumer(expectedConsumerId string, topN, spawnDelay int64) {
+	d.t.Helper()
+	ctx := d.ctx()
+
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Duration(spawnDelay) * time.Second)
+
+	msg, err := providertypes.NewMsgCreateConsumer(
+		d.providerKeeper.GetAuthority(),
+		fmt.Sprintf("consumer%s", expectedConsumerId),
+		testkeeper.GetTestConsumerMetadata(),
+		&initializationParameters,
+		nil,
+		nil,
+		nil,
+	)
+	require.NoError(d.t, err)
+	require.NoError(d.t, msg.ValidateBasic())
+
+	resp, err := d.msgServer.CreateConsumer(ctx, msg)
+	require.NoError(d.t, err)
+	require.Equal(d.t, expectedConsumerId, resp.ConsumerId, "unexpected consumer id")
+
+	if topN > 0 {
+		require.NoError(d.t, d.setTopN(resp.ConsumerId, topN))
+	}
+}
+
+func (d *Driver) setTopN(consumerId string, topN int64) error {
+	msg := &providertypes.MsgUpdateConsumer{
+		Owner:      d.providerKeeper.GetAuthority(),
+		ConsumerId: consumerId,
+		PowerShapingParameters: &providertypes.PowerShapingParameters{
+			Top_N: uint32(topN),
+		},
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	_, err := d.msgServer.UpdateConsumer(d.ctx(), msg)
+	return err
+}
+
+func (d *Driver) delegate(node string, amount int64) {
+	d.t.Helper()
+	ctx := d.ctx()
+	stakingKeeper := d.providerApp.StakingKeeper
+
+	validator, err := stakingKeeper.GetValidator(ctx, d.valAddrs[node])
+	require.NoError(d.t, err)
+	_, err = stakingKeeper.Delegate(
+		ctx,
+		d.providerChain.SenderAccount.GetAddress(),
+		sdk.TokensFromConsensusPower(amount, sdk.DefaultPowerReduction),
+		stakingtypes.Unbonded,
+		validator,
+		true,
+	)
+	require.NoError(d.t, err)
+}
+
+// receiveSlashPacket receives a downtime slash packet for node from a launched consumer chain
+func (d *Driver) receiveSlashPacket(consumerId, node, expectedOutcome string) {
+	d.t.Helper()
+	ctx := d.ctx()
+
+	channelId, found := d.providerKeeper.GetConsumerIdToChannelId(ctx, consumerId)
+	require.True(d.t, found, "no CCV channel to consumer chain %s", consumerId)
+
+	validator, err := d.providerApp.StakingKeeper.GetValidator(ctx, d.valAddrs[node])
+	require.NoError(d.t, err)
+
+	d.slashPacketSequences[consumerId]++
+	sequence := d.slashPacketSequences[consumerId]
+	packet := channeltypes.NewPacket(nil, sequence, ccv.ConsumerPortID, "channel-0", ccv.ProviderPortID, channelId,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(time.Hour).UnixNano()))
+	data := ccv.NewSlashPacketData(
+		abci.Validator{
+			Address: d.consAddrs[node].Address,
+			Power:   sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction),
+		},
+		0, // the infraction happened before the first VSC packet was received
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	)
+
+	_, err = d.providerKeeper.OnRecvSlashPacket(ctx, packet, *data)
+	require.NoError(d.t, err)
+
+	trace, found := d.providerKeeper.GetSlashPacketTrace(ctx, consumerId, sequence)
+	require.True(d.t, found, "no trace of slash packet %d from consumer chain %s", sequence, consumerId)
+	require.Equal(d.t, "SLASH_PACKET_OUTCOME_"+expectedOutcome, trace.Outcome.String(),
+		"unexpected outcome of the slash packet for %s from consumer chain %s", node, consumerId)
+}
+
+// nextBlock produces the next block, timeAdvancement seconds after the last one,
+// and opens a CCV channel to every consumer chain that launched in this block
+func (d *Driver) nextBlock(timeAdvancement int64) {
+	d.t.Helper()
+
+	d.coordinator.CurrentTime = d.coordinator.CurrentTime.Add(time.Duration(timeAdvancement) * time.Second)
+	d.coordinator.UpdateTime()
+	d.providerChain.NextBlock()
+
+	ctx := d.ctx()
+	for _, consumerId := range d.providerKeeper.GetAllConsumerIds(ctx) {
+		if d.providerKeeper.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		if _, found := d.providerKeeper.GetConsumerIdToChannelId(ctx, consumerId); !found {
+			d.openCCVChannel(consumerId)
+		}
+	}
+}
+
+// openCCVChannel simulates the opening of the CCV channel to a launched consumer chain,
+// i.e., it sets an open connection and an open channel on top of the consumer client
+// created by the provider and establishes the channel as the CCV channel of the consumer chain
+func (d *Driver) openCCVChannel(consumerId string) {
+	d.t.Helper()
+	ctx := d.ctx()
+	ibcKeeper := d.providerApp.GetIBCKeeper()
+
+	clientId, found := d.providerKeeper.GetConsumerClientId(ctx, consumerId)
+	require.True(d.t, found, "client not found for consumer chain %s", consumerId)
+
+	connectionId := ibcKeeper.ConnectionKeeper.GenerateConnectionIdentifier(ctx)
+	ibcKeeper.ConnectionKeeper.SetConnection(ctx, connectionId, conntypes.NewConnectionEnd(
+		conntypes.OPEN,
+		clientId,
+		conntypes.NewCounterparty("07-tendermint-0", "connection-0", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		conntypes.GetCompatibleVersions(),
+		0,
+	))
+
+	channelId := ibcKeeper.ChannelKeeper.GenerateChannelIdentifier(ctx)
+	ibcKeeper.ChannelKeeper.SetChannel(ctx, ccv.ProviderPortID, channelId, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.ORDERED,
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "channel-0"),
+		[]string{connectionId},
+		ccv.Version,
+	))
+	ibcKeeper.ChannelKeeper.SetNextSequenceSend(ctx, ccv.ProviderPortID, channelId, 1)
+
+	require.NoError(d.t, d.providerKeeper.SetConsumerChain(ctx, channelId))
+}
+
+// CheckState compares the state of the provider chain with the given model state
+func (d *Driver) CheckState(model ModelState) {
+	d.t.Helper()
+	ctx := d.ctx()
+	stakingKeeper := d.providerApp.StakingKeeper
+
+	require.Equal(d.t, d.startTime.Add(time.Duration(model.Time)*time.Second), ctx.BlockTime(), "time mismatch")
+
+	jailed := []string{}
+	for node, valAddr := range d.valAddrs {
+		validator, err := stakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(d.t, err)
+		require.Equal(d.t, model.Tokens[node], sdk.TokensToConsensusPower(validator.Tokens, sdk.DefaultPowerReduction),
+			"tokens mismatch for %s", node)
+		if validator.IsJailed() {
+			jailed = append(jailed, node)
+		}
+
+		lastPower, err := stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		require.NoError(d.t, err)
+		require.Equal(d.t, model.LastPowers[node], lastPower, "last power mismatch for %s", node)
+	}
+	sort.Strings(jailed)
+	require.Equal(d.t, model.Jailed, jailed, "jailed validators mismatch")
+
+	require.Equal(d.t, model.SlashMeter, d.providerKeeper.GetSlashMeter(ctx).Int64(), "slash meter mismatch")
+	require.Equal(d.t, d.startTime.Add(time.Duration(model.SlashMeterReplenishTimeCandidate)*time.Second),
+		d.providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx), "slash meter replenish time candidate mismatch")
+
+	consumerIds := d.providerKeeper.GetAllConsumerIds(ctx)
+	require.Len(d.t, consumerIds, len(model.Consumers), "number of consumer chains mismatch")
+	for _, consumerId := range consumerIds {
+		consumer, found := model.Consumers[consumerId]
+		require.True(d.t, found, "consumer chain %s not in the model", consumerId)
+		d.checkConsumer(consumerId, consumer)
+	}
+}
+
+func (d *Driver) checkConsumer(consumerId string, model ModelConsumer) {
+	d.t.Helper()
+	ctx := d.ctx()
+
+	require.Equal(d.t, "CONSUMER_PHASE_"+model.Phase, d.providerKeeper.GetConsumerPhase(ctx, consumerId).String(),
+		"phase mismatch for consumer chain %s", consumerId)
+
+	switch model.Phase {
+	case "INITIALIZED":
+		initializationParameters, err := d.providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+		require.NoError(d.t, err)
+		require.Equal(d.t, d.startTime.Add(time.Duration(model.SpawnTime)*time.Second), initializationParameters.SpawnTime,
+			"spawn time mismatch for consumer chain %s", consumerId)
+	case "STOPPED":
+		removalTime, err := d.providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+		require.NoError(d.t, err)
+		require.Equal(d.t, d.startTime.Add(time.Duration(model.RemovalTime)*time.Second), removalTime,
+			"removal time mismatch for consumer chain %s", consumerId)
+	}
+
+	optedIn := []string{}
+	for _, consAddr := range d.providerKeeper.GetAllOptedIn(ctx, consumerId) {
+		optedIn = append(optedIn, d.node(consAddr.ToSdkConsAddr()))
+	}
+	sort.Strings(optedIn)
+	require.Equal(d.t, model.OptedIn, optedIn, "opted-in validators mismatch for consumer chain %s", consumerId)
+
+	valSet, err := d.providerKeeper.GetConsumerValSet(ctx, consumerId)
+	require.NoError(d.t, err)
+	consumerValidators := []string{}
+	for _, val := range valSet {
+		consumerValidators = append(consumerValidators, d.node(val.ProviderConsAddr))
+	}
+	sort.Strings(consumerValidators)
+	require.Equal(d.t, model.ValSet, consumerValidators, "validator set mismatch for consumer chain %s", consumerId)
+
+	minPower, found := d.providerKeeper.GetMinimumPowerInTopN(ctx, consumerId)
+	if !found {
+		minPower = 0
+	}
+	require.Equal(d.t, model.MinPowerInTopN, minPower, "minimum power in top N mismatch for consumer chain %s", consumerId)
+}
+
+func (d *Driver) node(consAddr sdk.ConsAddress) string {
+	d.t.Helper()
+	node, found := d.nodes[consAddr.String()]
+	require.True(d.t, found, "unknown validator %s", consAddr)
+	return node
+}
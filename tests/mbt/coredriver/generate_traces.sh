@@ -0,0 +1,34 @@
+#!/bin/bash
+
+# Generates traces of the provider_core model in the traces folder.
+# Every family targets a sanity check of the model, so that its traces reach an interesting state.
+
+set -e
+
+MODEL=../model/provider_core.qnt
+NUM_TRACES=${NUM_TRACES:-2}
+
+generate() {
+  local family=$1
+  local invariant=$2
+  mkdir -p traces/$family
+  for i in $(seq 1 $NUM_TRACES); do
+    if [[ -z "$invariant" ]]; then
+      quint run --out-itf traces/$family/trace_$i.itf.json --max-steps=100 --max-samples=1 $MODEL || true
+    else
+      # the run fails when the invariant is violated, which is what we are looking for
+      quint run --out-itf traces/$family/trace_$i.itf.json --invariant $invariant --max-steps=100 --max-samples=200 $MODEL || true
+    fi
+  done
+}
+
+echo "Generating traces with launched Top N chains"
+generate topn CanLaunchTopNConsumer
+echo "Generating traces with deleted chains"
+generate deleted CanDeleteConsumer
+echo "Generating traces with bounced slash packets"
+generate bounced CanBounceSlashPacket
+echo "Generating traces with failed opt-outs"
+generate optout CanFailOptOut
+echo "Generating long traces without invariants"
+generate noinv
@@ -0,0 +1,130 @@
+package coredriver
+
+import (
+	"sort"
+
+	"github.com/informalsystems/itf-go/itf"
+)
+
+// This file contains logic to process
+// and access parts of the states of the provider_core Quint traces.
+
+// ModelParams are the parameters of the model, read from the params variable of a trace
+type ModelParams struct {
+	Nodes                       []string
+	ConsumerIds                 []string
+	InitialPowers               map[string]int64
+	SlashMeterReplenishFraction int64
+	SlashMeterReplenishPeriod   int64
+	UnbondingPeriod             int64
+}
+
+// ModelConsumer is the state of a consumer chain in the model
+type ModelConsumer struct {
+	Phase          string
+	TopN           int64
+	SpawnTime      int64
+	RemovalTime    int64
+	OptedIn        []string
+	ValSet         []string
+	MinPowerInTopN int64
+}
+
+// ModelState is the state of the provider chain in the model
+type ModelState struct {
+	Time                             int64
+	Tokens                           map[string]int64
+	LastPowers                       map[string]int64
+	Jailed                           []string
+	Consumers                        map[string]ModelConsumer
+	SlashMeter                       int64
+	SlashMeterReplenishTimeCandidate int64
+}
+
+// ModelAction is an action of the model, i.e., the last element of the trace variable
+type ModelAction struct {
+	Kind            string
+	ConsumerId      string
+	Node            string
+	TopN            int64
+	SpawnDelay      int64
+	Amount          int64
+	TimeAdvancement int64
+	Success         bool
+	Outcome         string
+}
+
+func ReadParams(expr itf.MapExprType) ModelParams {
+	return ModelParams{
+		Nodes:                       stringSet(expr["Nodes"]),
+		ConsumerIds:                 stringList(expr["ConsumerIds"]),
+		InitialPowers:               intMap(expr["InitialPowers"]),
+		SlashMeterReplenishFraction: expr["SlashMeterReplenishFraction"].Value.(int64),
+		SlashMeterReplenishPeriod:   expr["SlashMeterReplenishPeriod"].Value.(int64),
+		UnbondingPeriod:             expr["UnbondingPeriod"].Value.(int64),
+	}
+}
+
+func ReadState(expr itf.MapExprType) ModelState {
+	consumers := map[string]ModelConsumer{}
+	for consumerId, consumerExpr := range expr["consumers"].Value.(itf.MapExprType) {
+		consumer := consumerExpr.Value.(itf.MapExprType)
+		consumers[consumerId] = ModelConsumer{
+			Phase:          consumer["phase"].Value.(string),
+			TopN:           consumer["topN"].Value.(int64),
+			SpawnTime:      consumer["spawnTime"].Value.(int64),
+			RemovalTime:    consumer["removalTime"].Value.(int64),
+			OptedIn:        stringSet(consumer["optedIn"]),
+			ValSet:         stringSet(consumer["valSet"]),
+			MinPowerInTopN: consumer["minPowerInTopN"].Value.(int64),
+		}
+	}
+	return ModelState{
+		Time:                             expr["time"].Value.(int64),
+		Tokens:                           intMap(expr["tokens"]),
+		LastPowers:                       intMap(expr["lastPowers"]),
+		Jailed:                           stringSet(expr["jailed"]),
+		Consumers:                        consumers,
+		SlashMeter:                       expr["slashMeter"].Value.(int64),
+		SlashMeterReplenishTimeCandidate: expr["slashMeterReplenishTimeCandidate"].Value.(int64),
+	}
+}
+
+// LastAction returns the last action of the given trace variable
+func LastAction(traceExpr itf.ListExprType) ModelAction {
+	action := traceExpr[len(traceExpr)-1].Value.(itf.MapExprType)
+	return ModelAction{
+		Kind:            action["kind"].Value.(string),
+		ConsumerId:      action["consumerId"].Value.(string),
+		Node:            action["node"].Value.(string),
+		TopN:            action["topN"].Value.(int64),
+		SpawnDelay:      action["spawnDelay"].Value.(int64),
+		Amount:          action["amount"].Value.(int64),
+		TimeAdvancement: action["timeAdvancement"].Value.(int64),
+		Success:         action["success"].Value.(bool),
+		Outcome:         action["outcome"].Value.(string),
+	}
+}
+
+// stringSet returns the elements of a set of strings in sorted order
+func stringSet(expr itf.Expr) []string {
+	elems := stringList(expr)
+	sort.Strings(elems)
+	return elems
+}
+
+func stringList(expr itf.Expr) []string {
+	elems := []string{}
+	for _, elem := range expr.Value.(itf.ListExprType) {
+		elems = append(elems, elem.Value.(string))
+	}
+	return elems
+}
+
+func intMap(expr itf.Expr) map[string]int64 {
+	m := map[string]int64{}
+	for key, value := range expr.Value.(itf.MapExprType) {
+		m[key] = value.Value.(int64)
+	}
+	return m
+}
@@ -447,6 +447,8 @@ func (s *Driver) setupProvider(
 	providerParams.CcvTimeoutPeriod = params.CcvTimeout[ChainId(providerChain.ChainID)]
 	providerParams.SlashMeterReplenishFraction = "1"
 	providerParams.SlashMeterReplenishPeriod = time.Nanosecond
+	// the model only prevents the reuse of consumer keys on the same consumer chain
+	providerParams.AllowConsumerKeyReuse = true
 	s.providerKeeper().SetParams(s.ctx("provider"), providerParams)
 
 	// set the signing infos
@@ -505,6 +507,7 @@ func createConsumerGenesis(modelParams ModelParams, providerChain *ibctesting.Te
 		[]string{},
 		ccvtypes.DefaultRetryDelayPeriod,
 		"",
+		ccvtypes.DefaultClientExpiryWarningWindow,
 	)
 
 	return consumertypes.NewInitialGenesisState(consumerClientState, providerConsState, valUpdates, params)
@@ -7,6 +7,7 @@ quint test ccv_model.qnt
 quint test ccv_test.qnt
 quint run --invariant "all{ValidatorUpdatesArePropagatedInv,ValidatorSetHasExistedInv,SameVscPacketsInv,MatureOnTimeInv,EventuallyMatureOnProviderInv,WaitingForSlashPacketAckInv}" ccv_model.qnt --max-steps 200 --max-samples 200
 quint run --invariant "all{ValidatorUpdatesArePropagatedKeyAssignmentInv,ValidatorSetHasExistedKeyAssignmentInv,SameVscPacketsKeyAssignmentInv,MatureOnTimeInv,EventuallyMatureOnProviderInv,KeyAssignmentRulesInv,WaitingForSlashPacketAckInv}" ccv_model.qnt --step stepKeyAssignment --max-steps 200 --max-samples 200
+quint run --invariant "all{ValSetIsOptedInInv,SlashMeterBoundedInv}" provider_core.qnt --max-steps 200 --max-samples 200
 
 
 # do not stop on errors anymore, so we can give better output if we error
@@ -12,6 +12,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cometbft/cometbft/abci/types"
 	tmencoding "github.com/cometbft/cometbft/crypto/encoding"
@@ -20,8 +21,10 @@ import (
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 type (
@@ -36,6 +39,8 @@ type (
 const (
 	// Default number of consumer chains
 	NumConsumers = 5
+	// Default number of provider validators
+	NumValidators = 4
 )
 
 var (
@@ -79,13 +84,62 @@ func (cb ConsumerBundle) GetKeeper() consumerkeeper.Keeper {
 	return cb.App.GetConsumerKeeper()
 }
 
-// AddProvider adds a new provider chain to the coordinator and returns the test chain and app type
+// NewConsumerAppIniter returns a ValSetAppIniter for any consumer app, standard or democracy,
+// given an AppIniter that creates the app and returns its default genesis state.
+// The consumer genesis is fed with the provider validators and enabled, and a minimal staking
+// genesis is added if the app has none, since ibctesting.SetupWithGenesisValSet requires one.
+// The app must implement the ConsumerApp (or DemocConsumerApp) interface defined in
+// /testutil/integration/interfaces.go to be used with AddConsumer (or AddDemocracyConsumer).
+func NewConsumerAppIniter(appIniter AppIniter) ValSetAppIniter {
+	return func(initValPowers []types.ValidatorUpdate) AppIniter {
+		return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+			testApp, genesisState := appIniter()
+			cdc := testApp.AppCodec()
+
+			if _, ok := genesisState[stakingtypes.ModuleName]; !ok {
+				genesisState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(
+					&stakingtypes.GenesisState{
+						Params: stakingtypes.Params{BondDenom: sdk.DefaultBondDenom},
+					},
+				)
+			}
+
+			var consumerGenesis ccvtypes.ConsumerGenesisState
+			cdc.MustUnmarshalJSON(genesisState[consumertypes.ModuleName], &consumerGenesis)
+			consumerGenesis.Provider.InitialValSet = initValPowers
+			consumerGenesis.Params.Enabled = true
+			genesisState[consumertypes.ModuleName] = cdc.MustMarshalJSON(&consumerGenesis)
+
+			return testApp, genesisState
+		}
+	}
+}
+
+// AddProvider adds a new provider chain with NumValidators validators to the coordinator
+// and returns the test chain and app type
 func AddProvider[T testutil.ProviderApp](t *testing.T, coordinator *ibctesting.Coordinator, appIniter AppIniter) (
 	*ibctesting.TestChain, T,
 ) {
 	t.Helper()
+	return AddProviderWithValidators[T](t, coordinator, appIniter, NumValidators)
+}
+
+// AddProviderWithValidators adds a new provider chain with numValidators validators of equal power
+// to the coordinator and returns the test chain and app type
+func AddProviderWithValidators[T testutil.ProviderApp](
+	t *testing.T,
+	coordinator *ibctesting.Coordinator,
+	appIniter AppIniter,
+	numValidators int,
+) (*ibctesting.TestChain, T) {
+	t.Helper()
+
+	// generate validators private/public key
+	valSet, _, signers, err := testutil.CreateValidators(numValidators, provChainID)
+	require.NoError(t, err)
+
 	ibctesting.DefaultTestingAppInit = appIniter
-	provider := ibctesting.NewTestChain(t, coordinator, provChainID)
+	provider := ibctesting.NewTestChainWithValSet(t, coordinator, provChainID, valSet, signers)
 	coordinator.Chains[provChainID] = provider
 
 	providerToReturn, ok := provider.App.(T)
@@ -125,15 +179,15 @@ func AddDemocracyConsumer[T testutil.DemocConsumerApp](
 // consumer chain (see CreateConsumerClient). The new consumer is initialized with the
 // InitialValSet from the genesis state generated by the provider (see MakeConsumerGenesis).
 //
-// This method must be called after AddProvider.
+// This method must be called after AddProvider. Consumers can be added beyond NumConsumers,
+// in which case they are Top N chains with N = 100.
 func AddConsumer[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 	coordinator *ibctesting.Coordinator,
 	s *suite.Suite,
 	index int,
 	appIniter ValSetAppIniter,
 ) *ConsumerBundle {
-	// check index isn't bigger that the number of consumers
-	s.Require().LessOrEqual(index, NumConsumers)
+	s.Require().GreaterOrEqual(index, 0)
 
 	// consumer chain ID
 	chainID := ibctesting.GetChainID(index + 2)
@@ -154,7 +208,10 @@ func AddConsumer[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 	initializationParameters.InitialHeight = clienttypes.Height{RevisionNumber: 0, RevisionHeight: 2}
 
 	powerShapingParameters := testkeeper.GetTestPowerShapingParameters()
-	powerShapingParameters.Top_N = consumerTopNParams[index] // isn't used in CreateConsumerClient
+	powerShapingParameters.Top_N = 100 // isn't used in CreateConsumerClient
+	if index < NumConsumers {
+		powerShapingParameters.Top_N = consumerTopNParams[index]
+	}
 
 	infractionPrameters := testkeeper.GetTestInfractionParameters()
 
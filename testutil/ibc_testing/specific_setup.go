@@ -2,7 +2,8 @@ package ibc_testing
 
 // Contains example setup code for running integration tests against a provider, consumer,
 // and/or democracy consumer app.go implementation. This file is meant to be pattern matched
-// for apps running integration tests against their implementation.
+// for apps running integration tests against their implementation. Consumer apps only need
+// to provide a constructor returning the app and its default genesis state, see NewConsumerAppIniter.
 
 import (
 	"encoding/json"
@@ -13,16 +14,10 @@ import (
 	"cosmossdk.io/log"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
-
-	"github.com/cometbft/cometbft/abci/types"
 
 	appConsumer "github.com/cosmos/interchain-security/v7/app/consumer"
 	appConsumerDemocracy "github.com/cosmos/interchain-security/v7/app/consumer-democracy"
 	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
-	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
-	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 var (
@@ -38,44 +33,16 @@ func ProviderAppIniter() (ibctesting.TestingApp, map[string]json.RawMessage) {
 	return testApp, appProvider.NewDefaultGenesisState(encoding.Codec)
 }
 
-// ConsumerAppIniter returns a ibctesting.ValSetAppIniter for a consumer app
-func ConsumerAppIniter(initValPowers []types.ValidatorUpdate) AppIniter {
-	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
-		encoding := appConsumer.MakeTestEncodingConfig()
-		testApp := appConsumer.New(log.NewNopLogger(), db.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
-		genesisState := appConsumer.NewDefaultGenesisState(encoding.Codec)
-		// NOTE: starting from ibc-go/v7/testing.SetupWithGenesisValSet requires a staking module
-		// genesisState or it panics. Feed a minimum one.
-		genesisState[stakingtypes.ModuleName] = encoding.Codec.MustMarshalJSON(
-			&stakingtypes.GenesisState{
-				Params: stakingtypes.Params{BondDenom: sdk.DefaultBondDenom},
-			},
-		)
-		// Feed consumer genesis with provider validators
-		var consumerGenesis ccvtypes.ConsumerGenesisState
-		encoding.Codec.MustUnmarshalJSON(genesisState[consumertypes.ModuleName], &consumerGenesis)
-		consumerGenesis.Provider.InitialValSet = initValPowers
-		consumerGenesis.Params.Enabled = true
-		genesisState[consumertypes.ModuleName] = encoding.Codec.MustMarshalJSON(&consumerGenesis)
-
-		return testApp, genesisState
-	}
-}
+// ConsumerAppIniter implements ibctesting.ValSetAppIniter for a consumer app
+var ConsumerAppIniter = NewConsumerAppIniter(func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := appConsumer.MakeTestEncodingConfig()
+	testApp := appConsumer.New(log.NewNopLogger(), db.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	return testApp, appConsumer.NewDefaultGenesisState(encoding.Codec)
+})
 
 // DemocracyConsumerAppIniter implements ibctesting.ValSetAppIniter for a democracy consumer app
-func DemocracyConsumerAppIniter(initValPowers []types.ValidatorUpdate) AppIniter {
-	return func() (ibctesting.TestingApp, map[string]json.RawMessage) {
-		encoding := appConsumerDemocracy.MakeTestEncodingConfig()
-		testApp := appConsumerDemocracy.New(log.NewNopLogger(), db.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
-		genesisState := appConsumerDemocracy.NewDefaultGenesisState(encoding.Codec)
-		// Feed consumer genesis with provider validators
-		// TODO See if useful for democracy
-		var consumerGenesis ccvtypes.ConsumerGenesisState
-		encoding.Codec.MustUnmarshalJSON(genesisState[consumertypes.ModuleName], &consumerGenesis)
-		consumerGenesis.Provider.InitialValSet = initValPowers
-		consumerGenesis.Params.Enabled = true
-		genesisState[consumertypes.ModuleName] = encoding.Codec.MustMarshalJSON(&consumerGenesis)
-
-		return testApp, genesisState
-	}
-}
+var DemocracyConsumerAppIniter = NewConsumerAppIniter(func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	encoding := appConsumerDemocracy.MakeTestEncodingConfig()
+	testApp := appConsumerDemocracy.New(log.NewNopLogger(), db.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+	return testApp, appConsumerDemocracy.NewDefaultGenesisState(encoding.Codec)
+})
@@ -25,6 +25,19 @@ func runCCVTestByName(t *testing.T, methodName string) {
 	findAndCallMethod(t, suite, methodName)
 }
 
+// runCCVTestAfterMigrationsByName runs a single CCV integration test by name, like runCCVTestByName,
+// after running the store migrations of the provider and consumer modules from their previous consensus versions.
+func runCCVTestAfterMigrationsByName(t *testing.T, methodName string) {
+	t.Helper()
+	suite := integr.NewCCVTestSuite[*appProvider.App, *appConsumer.App](
+		icstestingutils.ProviderAppIniter, icstestingutils.ConsumerAppIniter, []string{})
+	suite.SetT(t)
+	suite.SetupTest()
+	suite.MigrateStores()
+
+	findAndCallMethod(t, suite, methodName)
+}
+
 // runConsumerDemocracyTestByName runs a single consumer democracy integration test by name,
 // using a ConsumerDemocracyTestSuite initialized with the dummy
 // democracy consumer defined in this repo.
@@ -97,6 +110,14 @@ func TestConsumerPacketSendExpiredClient(t *testing.T) {
 	runCCVTestByName(t, "TestConsumerPacketSendExpiredClient")
 }
 
+func TestRecoverExpiredClients(t *testing.T) {
+	runCCVTestByName(t, "TestRecoverExpiredClients")
+}
+
+func TestReopenCCVChannelAfterClientExpiry(t *testing.T) {
+	runCCVTestByName(t, "TestReopenCCVChannelAfterClientExpiry")
+}
+
 //
 // Normal operations tests
 //
@@ -129,6 +150,10 @@ func TestOnRecvSlashPacketErrors(t *testing.T) {
 	runCCVTestByName(t, "TestOnRecvSlashPacketErrors")
 }
 
+func TestRecvPacketErrorRecorded(t *testing.T) {
+	runCCVTestByName(t, "TestRecvPacketErrorRecorded")
+}
+
 func TestValidatorDowntime(t *testing.T) {
 	runCCVTestByName(t, "TestValidatorDowntime")
 }
@@ -296,3 +321,51 @@ func TestMultiConsumerRewardsDistribution(t *testing.T) {
 func TestTooManyLastValidators(t *testing.T) {
 	runCCVTestByName(t, "TestTooManyLastValidators")
 }
+
+//
+// Relay fault tests
+//
+
+func TestRelayDelayedAndDuplicatedVSCPackets(t *testing.T) {
+	runCCVTestByName(t, "TestRelayDelayedAndDuplicatedVSCPackets")
+}
+
+func TestRelayReorderedVSCPackets(t *testing.T) {
+	runCCVTestByName(t, "TestRelayReorderedVSCPackets")
+}
+
+func TestRelayDroppedVSCPacket(t *testing.T) {
+	runCCVTestByName(t, "TestRelayDroppedVSCPacket")
+}
+
+//
+// Store migration tests
+//
+
+func TestStoreMigrations(t *testing.T) {
+	runCCVTestByName(t, "TestStoreMigrations")
+}
+
+func TestPacketRoundtripAfterMigrations(t *testing.T) {
+	runCCVTestAfterMigrationsByName(t, "TestPacketRoundtrip")
+}
+
+func TestRelayAndApplyDowntimePacketAfterMigrations(t *testing.T) {
+	runCCVTestAfterMigrationsByName(t, "TestRelayAndApplyDowntimePacket")
+}
+
+func TestRewardsDistributionAfterMigrations(t *testing.T) {
+	runCCVTestAfterMigrationsByName(t, "TestRewardsDistribution")
+}
+
+func TestKeyAssignmentAfterMigrations(t *testing.T) {
+	runCCVTestAfterMigrationsByName(t, "TestKeyAssignment")
+}
+
+//
+// Genesis tests
+//
+
+func TestGenesisRoundTrip(t *testing.T) {
+	runCCVTestByName(t, "TestGenesisRoundTrip")
+}
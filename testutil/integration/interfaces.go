@@ -8,10 +8,12 @@ import (
 
 	"cosmossdk.io/core/comet"
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	evidencekeeper "cosmossdk.io/x/evidence/keeper"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
@@ -52,6 +54,17 @@ type ProviderApp interface {
 	GetTestAccountKeeper() TestAccountKeeper
 
 	GetTestGovKeeper() *govkeeper.Keeper
+
+	//
+	// Store migrations
+	//
+
+	// Returns the KVStoreKey for the provided store key
+	GetKey(storeKey string) *storetypes.KVStoreKey
+	// Returns the module manager, used to run the registered store migrations
+	GetModuleManager() *module.Manager
+	// Returns the configurator with which the store migrations are registered
+	Configurator() module.Configurator
 }
 
 // The interface that any consumer app must implement to be compatible with integration tests
@@ -76,6 +89,17 @@ type ConsumerApp interface {
 	GetTestSlashingKeeper() TestSlashingKeeper
 	// Tests an evidence keeper interface with more capabilities than the expected_keepers interface
 	GetTestEvidenceKeeper() evidencekeeper.Keeper
+
+	//
+	// Store migrations
+	//
+
+	// Returns the KVStoreKey for the provided store key
+	GetKey(storeKey string) *storetypes.KVStoreKey
+	// Returns the module manager, used to run the registered store migrations
+	GetModuleManager() *module.Manager
+	// Returns the configurator with which the store migrations are registered
+	Configurator() module.Configurator
 }
 
 type DemocConsumerApp interface {
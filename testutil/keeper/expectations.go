@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"context"
 	time "time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
@@ -199,11 +200,11 @@ func GetMocksForSlashValidator(
 	}
 }
 
-// SetupMocksForLastBondedValidatorsExpectation sets up the expectation for the `GetBondedValidatorsByPower` and `MaxValidators` methods of the `mockStakingKeeper` object.
+// SetupMocksForLastBondedValidatorsExpectation sets up the expectation for the `IterateBondedValidatorsByPower` and `MaxValidators` methods of the `mockStakingKeeper` object.
 // These are needed in particular when calling `GetLastBondedValidators` from the provider keeper.
 // Times is the number of times the expectation should be called. Provide -1 for `AnyTimes“.
 func SetupMocksForLastBondedValidatorsExpectation(mockStakingKeeper *MockStakingKeeper, maxValidators uint32, vals []stakingtypes.Validator, times int) {
-	validatorsCall := mockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(vals, nil)
+	validatorsCall := MockIterateBondedValidatorsByPower(mockStakingKeeper, vals)
 	maxValidatorsCall := mockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(maxValidators, nil)
 
 	if times == -1 {
@@ -214,3 +215,17 @@ func SetupMocksForLastBondedValidatorsExpectation(mockStakingKeeper *MockStaking
 		maxValidatorsCall.Times(times)
 	}
 }
+
+// MockIterateBondedValidatorsByPower sets up the expectation for the `IterateBondedValidatorsByPower` method
+// of the `mockStakingKeeper` object to iterate over `vals`, which are expected to be sorted by descending power.
+func MockIterateBondedValidatorsByPower(mockStakingKeeper *MockStakingKeeper, vals []stakingtypes.Validator) *gomock.Call {
+	return mockStakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) error {
+			for i, val := range vals {
+				if fn(int64(i), val) {
+					break
+				}
+			}
+			return nil
+		})
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// QueryCase defines how a gRPC query is exercised by RunQueryCoverage
+type QueryCase struct {
+	// Valid is a valid request, which is expected to succeed against the populated keeper.
+	// For paginated queries, the valid request is expected to return at least two results.
+	Valid proto.Message
+	// EmptyCode is the expected code of the query for an empty request, i.e., a request without any field set
+	EmptyCode codes.Code
+	// Malformed are requests with malformed fields, which are expected to fail with codes.InvalidArgument
+	Malformed []proto.Message
+}
+
+// queryServiceCapture captures the gRPC service registered by a RegisterQueryServer function
+type queryServiceCapture struct {
+	desc *grpc.ServiceDesc
+	impl interface{}
+}
+
+// RegisterService implements the gogoproto grpc.Server interface
+func (c *queryServiceCapture) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	c.desc = desc
+	c.impl = impl
+}
+
+// RunQueryCoverage exercises every query of the gRPC service registered by `register` against the given context,
+// which is expected to be populated so that the valid requests of `cases` succeed. Every query is called with
+// a nil request, an empty request, the valid request and the malformed requests of its case,
+// and the paginated queries are paged through one result at a time.
+//
+// The test fails if a query of the service has no case, so that a new query cannot be added without coverage,
+// or if a case does not correspond to a query of the service.
+func RunQueryCoverage(t *testing.T, ctx sdk.Context, register func(grpc.ServiceRegistrar), cases map[string]QueryCase) {
+	t.Helper()
+	service := &queryServiceCapture{}
+	register(service)
+	require.NotNil(t, service.desc, "no gRPC service registered")
+
+	methods := map[string]grpc.MethodDesc{}
+	for _, method := range service.desc.Methods {
+		methods[method.MethodName] = method
+		_, found := cases[method.MethodName]
+		require.True(t, found, "query %s of service %s has no test case", method.MethodName, service.desc.ServiceName)
+	}
+	names := make([]string, 0, len(cases))
+	for name := range cases {
+		_, found := methods[name]
+		require.True(t, found, "test case %s is not a query of service %s", name, service.desc.ServiceName)
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		method, tc := methods[name], cases[name]
+		t.Run(name, func(t *testing.T) {
+			// nil request
+			reqType := queryRequestType(t, service.impl, method)
+			_, err := callQueryWithNilRequest(ctx, service.impl, name, reqType)
+			requireQueryCode(t, codes.InvalidArgument, err, "nil request")
+
+			// empty request
+			_, err = callQuery(t, ctx, service.impl, method, nil)
+			requireQueryCode(t, tc.EmptyCode, err, "empty request")
+
+			// valid request
+			require.NotNil(t, tc.Valid, "no valid request")
+			res, err := callQuery(t, ctx, service.impl, method, tc.Valid)
+			requireQueryCode(t, codes.OK, err, "valid request")
+			require.NotNil(t, res)
+
+			// malformed requests
+			for _, req := range tc.Malformed {
+				_, err := callQuery(t, ctx, service.impl, method, req)
+				requireQueryCode(t, codes.InvalidArgument, err, fmt.Sprintf("malformed request %v", req))
+			}
+
+			if _, paginated := reqType.Elem().FieldByName("Pagination"); paginated {
+				checkQueryPagination(t, ctx, service.impl, method, tc.Valid)
+			}
+		})
+	}
+}
+
+// checkQueryPagination checks that paging through the results of the valid request one result at a time
+// returns the same results as the unpaginated request, and that malformed page requests are rejected
+func checkQueryPagination(t *testing.T, ctx sdk.Context, impl interface{}, method grpc.MethodDesc, valid proto.Message) {
+	t.Helper()
+	withPagination := func(pageReq *query.PageRequest) proto.Message {
+		req := proto.Clone(valid)
+		reflect.ValueOf(req).Elem().FieldByName("Pagination").Set(reflect.ValueOf(pageReq))
+		return req
+	}
+
+	res, err := callQuery(t, ctx, impl, method, withPagination(nil))
+	require.NoError(t, err)
+	all := queryResults(t, res)
+	require.GreaterOrEqual(t, all.Len(), 2, "the valid request returns less than two results")
+
+	var (
+		nextKey []byte
+		paged   []interface{}
+	)
+	for page := 0; page == 0 || len(nextKey) > 0; page++ {
+		require.LessOrEqual(t, page, all.Len(), "pagination does not terminate")
+		res, err := callQuery(t, ctx, impl, method, withPagination(&query.PageRequest{Key: nextKey, Limit: 1, CountTotal: page == 0}))
+		require.NoError(t, err)
+		results := queryResults(t, res)
+		require.LessOrEqual(t, results.Len(), 1)
+		for i := 0; i < results.Len(); i++ {
+			paged = append(paged, results.Index(i).Interface())
+		}
+
+		pageRes, ok := reflect.ValueOf(res).Elem().FieldByName("Pagination").Interface().(*query.PageResponse)
+		require.True(t, ok)
+		require.NotNil(t, pageRes)
+		if page == 0 {
+			require.Equal(t, uint64(all.Len()), pageRes.Total)
+		}
+		nextKey = pageRes.NextKey
+	}
+	require.Len(t, paged, all.Len())
+	for i, result := range paged {
+		require.Equal(t, all.Index(i).Interface(), result)
+	}
+
+	// the offset and the key of a page request are mutually exclusive
+	_, err = callQuery(t, ctx, impl, method, withPagination(&query.PageRequest{Key: []byte{1}, Offset: 1}))
+	requireQueryCode(t, codes.InvalidArgument, err, "page request with both key and offset")
+}
+
+// queryResults returns the results of a paginated query response, i.e., its only repeated field
+func queryResults(t *testing.T, res interface{}) reflect.Value {
+	t.Helper()
+	var results []reflect.Value
+	v := reflect.ValueOf(res).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() == reflect.Slice && v.Type().Field(i).Type.Elem().Kind() != reflect.Uint8 {
+			results = append(results, v.Field(i))
+		}
+	}
+	require.Len(t, results, 1, "paginated query response %T must have exactly one repeated field", res)
+	return results[0]
+}
+
+// queryRequestType returns the type of the request of the given query
+func queryRequestType(t *testing.T, impl interface{}, method grpc.MethodDesc) (reqType reflect.Type) {
+	t.Helper()
+	_, _ = method.Handler(impl, context.Background(), func(in interface{}) error {
+		reqType = reflect.TypeOf(in)
+		return status.Error(codes.Canceled, "request type captured")
+	}, nil)
+	require.NotNil(t, reqType)
+	return reqType
+}
+
+// callQuery calls the given query with a copy of the given request, or with an empty request if req is nil
+func callQuery(t *testing.T, ctx sdk.Context, impl interface{}, method grpc.MethodDesc, req proto.Message) (interface{}, error) {
+	t.Helper()
+	return method.Handler(impl, ctx, func(in interface{}) error {
+		if req != nil {
+			require.IsType(t, in, req, "invalid request type for query %s", method.MethodName)
+			proto.Merge(in.(proto.Message), req)
+		}
+		return nil
+	}, nil)
+}
+
+// callQueryWithNilRequest calls the given query with a nil request of the given type
+func callQueryWithNilRequest(ctx sdk.Context, impl interface{}, name string, reqType reflect.Type) (interface{}, error) {
+	out := reflect.ValueOf(impl).MethodByName(name).Call([]reflect.Value{reflect.ValueOf(context.Context(ctx)), reflect.Zero(reqType)})
+	err, _ := out[1].Interface().(error)
+	return out[0].Interface(), err
+}
+
+// requireQueryCode checks that the error returned by a query has the expected gRPC code
+func requireQueryCode(t *testing.T, expected codes.Code, err error, request string) {
+	t.Helper()
+	require.Equal(t, expected.String(), status.Code(err).String(), "%s: %v", request, err)
+}
@@ -14,12 +14,14 @@ import (
 	types "cosmossdk.io/store/types"
 	types0 "github.com/cometbft/cometbft/abci/types"
 	types1 "github.com/cosmos/cosmos-sdk/types"
-	types2 "github.com/cosmos/cosmos-sdk/x/slashing/types"
-	types3 "github.com/cosmos/cosmos-sdk/x/staking/types"
-	types4 "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
-	types5 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
-	types6 "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
-	types7 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	types2 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	types3 "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	types4 "github.com/cosmos/cosmos-sdk/x/staking/types"
+	types5 "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	types6 "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	types7 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	types8 "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	types9 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	exported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -78,10 +80,10 @@ func (mr *MockStakingKeeperMockRecorder) BondedRatio(ctx interface{}) *gomock.Ca
 }
 
 // Delegation mocks base method.
-func (m *MockStakingKeeper) Delegation(ctx context.Context, addr types1.AccAddress, valAddr types1.ValAddress) (types3.DelegationI, error) {
+func (m *MockStakingKeeper) Delegation(ctx context.Context, addr types1.AccAddress, valAddr types1.ValAddress) (types4.DelegationI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Delegation", ctx, addr, valAddr)
-	ret0, _ := ret[0].(types3.DelegationI)
+	ret0, _ := ret[0].(types4.DelegationI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -93,10 +95,10 @@ func (mr *MockStakingKeeperMockRecorder) Delegation(ctx, addr, valAddr interface
 }
 
 // GetBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]types3.Validator, error) {
+func (m *MockStakingKeeper) GetBondedValidatorsByPower(ctx context.Context) ([]types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBondedValidatorsByPower", ctx)
-	ret0, _ := ret[0].([]types3.Validator)
+	ret0, _ := ret[0].([]types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -108,10 +110,10 @@ func (mr *MockStakingKeeperMockRecorder) GetBondedValidatorsByPower(ctx interfac
 }
 
 // GetHistoricalInfo mocks base method.
-func (m *MockStakingKeeper) GetHistoricalInfo(ctx context.Context, height int64) (types3.HistoricalInfo, error) {
+func (m *MockStakingKeeper) GetHistoricalInfo(ctx context.Context, height int64) (types4.HistoricalInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetHistoricalInfo", ctx, height)
-	ret0, _ := ret[0].(types3.HistoricalInfo)
+	ret0, _ := ret[0].(types4.HistoricalInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -153,10 +155,10 @@ func (mr *MockStakingKeeperMockRecorder) GetLastValidatorPower(ctx, operator int
 }
 
 // GetRedelegationByUnbondingID mocks base method.
-func (m *MockStakingKeeper) GetRedelegationByUnbondingID(ctx context.Context, id uint64) (types3.Redelegation, error) {
+func (m *MockStakingKeeper) GetRedelegationByUnbondingID(ctx context.Context, id uint64) (types4.Redelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRedelegationByUnbondingID", ctx, id)
-	ret0, _ := ret[0].(types3.Redelegation)
+	ret0, _ := ret[0].(types4.Redelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -168,10 +170,10 @@ func (mr *MockStakingKeeperMockRecorder) GetRedelegationByUnbondingID(ctx, id in
 }
 
 // GetRedelegationsFromSrcValidator mocks base method.
-func (m *MockStakingKeeper) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr types1.ValAddress) ([]types3.Redelegation, error) {
+func (m *MockStakingKeeper) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr types1.ValAddress) ([]types4.Redelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRedelegationsFromSrcValidator", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.Redelegation)
+	ret0, _ := ret[0].([]types4.Redelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -183,10 +185,10 @@ func (mr *MockStakingKeeperMockRecorder) GetRedelegationsFromSrcValidator(ctx, v
 }
 
 // GetUnbondingDelegationByUnbondingID mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegationByUnbondingID(ctx context.Context, id uint64) (types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegationByUnbondingID(ctx context.Context, id uint64) (types4.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegationByUnbondingID", ctx, id)
-	ret0, _ := ret[0].(types3.UnbondingDelegation)
+	ret0, _ := ret[0].(types4.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -198,10 +200,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegationByUnbondingID(ctx
 }
 
 // GetUnbondingDelegationsFromValidator mocks base method.
-func (m *MockStakingKeeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr types1.ValAddress) ([]types3.UnbondingDelegation, error) {
+func (m *MockStakingKeeper) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr types1.ValAddress) ([]types4.UnbondingDelegation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingDelegationsFromValidator", ctx, valAddr)
-	ret0, _ := ret[0].([]types3.UnbondingDelegation)
+	ret0, _ := ret[0].([]types4.UnbondingDelegation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -213,10 +215,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingDelegationsFromValidator(ct
 }
 
 // GetUnbondingType mocks base method.
-func (m *MockStakingKeeper) GetUnbondingType(ctx context.Context, id uint64) (types3.UnbondingType, error) {
+func (m *MockStakingKeeper) GetUnbondingType(ctx context.Context, id uint64) (types4.UnbondingType, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetUnbondingType", ctx, id)
-	ret0, _ := ret[0].(types3.UnbondingType)
+	ret0, _ := ret[0].(types4.UnbondingType)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -228,10 +230,10 @@ func (mr *MockStakingKeeperMockRecorder) GetUnbondingType(ctx, id interface{}) *
 }
 
 // GetValidator mocks base method.
-func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidator(ctx context.Context, addr types1.ValAddress) (types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidator", ctx, addr)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -243,10 +245,10 @@ func (mr *MockStakingKeeperMockRecorder) GetValidator(ctx, addr interface{}) *go
 }
 
 // GetValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorByConsAddr", ctx, consAddr)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -258,10 +260,10 @@ func (mr *MockStakingKeeperMockRecorder) GetValidatorByConsAddr(ctx, consAddr in
 }
 
 // GetValidatorByUnbondingID mocks base method.
-func (m *MockStakingKeeper) GetValidatorByUnbondingID(ctx context.Context, id uint64) (types3.Validator, error) {
+func (m *MockStakingKeeper) GetValidatorByUnbondingID(ctx context.Context, id uint64) (types4.Validator, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorByUnbondingID", ctx, id)
-	ret0, _ := ret[0].(types3.Validator)
+	ret0, _ := ret[0].(types4.Validator)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -303,7 +305,7 @@ func (mr *MockStakingKeeperMockRecorder) IsValidatorJailed(ctx, addr interface{}
 }
 
 // IterateBondedValidatorsByPower mocks base method.
-func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types3.ValidatorI) bool) error {
+func (m *MockStakingKeeper) IterateBondedValidatorsByPower(arg0 context.Context, arg1 func(int64, types4.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateBondedValidatorsByPower", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -317,7 +319,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateBondedValidatorsByPower(arg0, ar
 }
 
 // IterateDelegations mocks base method.
-func (m *MockStakingKeeper) IterateDelegations(ctx context.Context, delegator types1.AccAddress, fn func(int64, types3.DelegationI) bool) error {
+func (m *MockStakingKeeper) IterateDelegations(ctx context.Context, delegator types1.AccAddress, fn func(int64, types4.DelegationI) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateDelegations", ctx, delegator, fn)
 	ret0, _ := ret[0].(error)
@@ -345,7 +347,7 @@ func (mr *MockStakingKeeperMockRecorder) IterateLastValidatorPowers(ctx, cb inte
 }
 
 // IterateValidators mocks base method.
-func (m *MockStakingKeeper) IterateValidators(ctx context.Context, f func(int64, types3.ValidatorI) bool) error {
+func (m *MockStakingKeeper) IterateValidators(ctx context.Context, f func(int64, types4.ValidatorI) bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "IterateValidators", ctx, f)
 	ret0, _ := ret[0].(error)
@@ -446,7 +448,7 @@ func (mr *MockStakingKeeperMockRecorder) Slash(ctx, consAddr, infractionHeight,
 }
 
 // SlashRedelegation mocks base method.
-func (m *MockStakingKeeper) SlashRedelegation(ctx context.Context, srcValidator types3.Validator, redelegation types3.Redelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
+func (m *MockStakingKeeper) SlashRedelegation(ctx context.Context, srcValidator types4.Validator, redelegation types4.Redelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SlashRedelegation", ctx, srcValidator, redelegation, infractionHeight, slashFactor)
 	ret0, _ := ret[0].(math.Int)
@@ -461,7 +463,7 @@ func (mr *MockStakingKeeperMockRecorder) SlashRedelegation(ctx, srcValidator, re
 }
 
 // SlashUnbondingDelegation mocks base method.
-func (m *MockStakingKeeper) SlashUnbondingDelegation(ctx context.Context, unbondingDelegation types3.UnbondingDelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
+func (m *MockStakingKeeper) SlashUnbondingDelegation(ctx context.Context, unbondingDelegation types4.UnbondingDelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SlashUnbondingDelegation", ctx, unbondingDelegation, infractionHeight, slashFactor)
 	ret0, _ := ret[0].(math.Int)
@@ -476,7 +478,7 @@ func (mr *MockStakingKeeperMockRecorder) SlashUnbondingDelegation(ctx, unbonding
 }
 
 // SlashWithInfractionReason mocks base method.
-func (m *MockStakingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types1.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction types3.Infraction) (math.Int, error) {
+func (m *MockStakingKeeper) SlashWithInfractionReason(ctx context.Context, consAddr types1.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction types4.Infraction) (math.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SlashWithInfractionReason", ctx, consAddr, infractionHeight, power, slashFactor, infraction)
 	ret0, _ := ret[0].(math.Int)
@@ -564,10 +566,10 @@ func (mr *MockStakingKeeperMockRecorder) Unjail(ctx, addr interface{}) *gomock.C
 }
 
 // Validator mocks base method.
-func (m *MockStakingKeeper) Validator(ctx context.Context, addr types1.ValAddress) (types3.ValidatorI, error) {
+func (m *MockStakingKeeper) Validator(ctx context.Context, addr types1.ValAddress) (types4.ValidatorI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Validator", ctx, addr)
-	ret0, _ := ret[0].(types3.ValidatorI)
+	ret0, _ := ret[0].(types4.ValidatorI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -593,10 +595,10 @@ func (mr *MockStakingKeeperMockRecorder) ValidatorAddressCodec() *gomock.Call {
 }
 
 // ValidatorByConsAddr mocks base method.
-func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types3.ValidatorI, error) {
+func (m *MockStakingKeeper) ValidatorByConsAddr(ctx context.Context, consAddr types1.ConsAddress) (types4.ValidatorI, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ValidatorByConsAddr", ctx, consAddr)
-	ret0, _ := ret[0].(types3.ValidatorI)
+	ret0, _ := ret[0].(types4.ValidatorI)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -645,11 +647,26 @@ func (mr *MockSlashingKeeperMockRecorder) DowntimeJailDuration(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DowntimeJailDuration", reflect.TypeOf((*MockSlashingKeeper)(nil).DowntimeJailDuration), arg0)
 }
 
+// GetParams mocks base method.
+func (m *MockSlashingKeeper) GetParams(arg0 context.Context) (types3.Params, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", arg0)
+	ret0, _ := ret[0].(types3.Params)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockSlashingKeeperMockRecorder) GetParams(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockSlashingKeeper)(nil).GetParams), arg0)
+}
+
 // GetValidatorSigningInfo mocks base method.
-func (m *MockSlashingKeeper) GetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress) (types2.ValidatorSigningInfo, error) {
+func (m *MockSlashingKeeper) GetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress) (types3.ValidatorSigningInfo, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetValidatorSigningInfo", arg0, arg1)
-	ret0, _ := ret[0].(types2.ValidatorSigningInfo)
+	ret0, _ := ret[0].(types3.ValidatorSigningInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -688,8 +705,22 @@ func (mr *MockSlashingKeeperMockRecorder) JailUntil(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JailUntil", reflect.TypeOf((*MockSlashingKeeper)(nil).JailUntil), arg0, arg1, arg2)
 }
 
+// SetParams mocks base method.
+func (m *MockSlashingKeeper) SetParams(arg0 context.Context, arg1 types3.Params) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetParams", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetParams indicates an expected call of SetParams.
+func (mr *MockSlashingKeeperMockRecorder) SetParams(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetParams", reflect.TypeOf((*MockSlashingKeeper)(nil).SetParams), arg0, arg1)
+}
+
 // SetValidatorSigningInfo mocks base method.
-func (m *MockSlashingKeeper) SetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress, arg2 types2.ValidatorSigningInfo) error {
+func (m *MockSlashingKeeper) SetValidatorSigningInfo(arg0 context.Context, arg1 types1.ConsAddress, arg2 types3.ValidatorSigningInfo) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetValidatorSigningInfo", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -783,11 +814,25 @@ func (mr *MockChannelKeeperMockRecorder) ChanCloseInit(ctx, portID, channelID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChanCloseInit", reflect.TypeOf((*MockChannelKeeper)(nil).ChanCloseInit), ctx, portID, channelID)
 }
 
+// GetAllPacketCommitmentsAtChannel mocks base method.
+func (m *MockChannelKeeper) GetAllPacketCommitmentsAtChannel(ctx types1.Context, portID, channelID string) []types9.PacketState {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPacketCommitmentsAtChannel", ctx, portID, channelID)
+	ret0, _ := ret[0].([]types9.PacketState)
+	return ret0
+}
+
+// GetAllPacketCommitmentsAtChannel indicates an expected call of GetAllPacketCommitmentsAtChannel.
+func (mr *MockChannelKeeperMockRecorder) GetAllPacketCommitmentsAtChannel(ctx, portID, channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPacketCommitmentsAtChannel", reflect.TypeOf((*MockChannelKeeper)(nil).GetAllPacketCommitmentsAtChannel), ctx, portID, channelID)
+}
+
 // GetChannel mocks base method.
-func (m *MockChannelKeeper) GetChannel(ctx types1.Context, srcPort, srcChan string) (types7.Channel, bool) {
+func (m *MockChannelKeeper) GetChannel(ctx types1.Context, srcPort, srcChan string) (types9.Channel, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannel", ctx, srcPort, srcChan)
-	ret0, _ := ret[0].(types7.Channel)
+	ret0, _ := ret[0].(types9.Channel)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -799,11 +844,11 @@ func (mr *MockChannelKeeperMockRecorder) GetChannel(ctx, srcPort, srcChan interf
 }
 
 // GetChannelConnection mocks base method.
-func (m *MockChannelKeeper) GetChannelConnection(ctx types1.Context, portID, channelID string) (string, types6.ConnectionEnd, error) {
+func (m *MockChannelKeeper) GetChannelConnection(ctx types1.Context, portID, channelID string) (string, types8.ConnectionEnd, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetChannelConnection", ctx, portID, channelID)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(types6.ConnectionEnd)
+	ret1, _ := ret[1].(types8.ConnectionEnd)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
@@ -830,7 +875,7 @@ func (mr *MockChannelKeeperMockRecorder) GetNextSequenceSend(ctx, portID, channe
 }
 
 // SendPacket mocks base method.
-func (m *MockChannelKeeper) SendPacket(ctx types1.Context, sourcePort, sourceChannel string, timeoutHeight types5.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
+func (m *MockChannelKeeper) SendPacket(ctx types1.Context, sourcePort, sourceChannel string, timeoutHeight types7.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendPacket", ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	ret0, _ := ret[0].(uint64)
@@ -882,10 +927,10 @@ func (m *MockConnectionKeeper) EXPECT() *MockConnectionKeeperMockRecorder {
 }
 
 // GetConnection mocks base method.
-func (m *MockConnectionKeeper) GetConnection(ctx types1.Context, connectionID string) (types6.ConnectionEnd, bool) {
+func (m *MockConnectionKeeper) GetConnection(ctx types1.Context, connectionID string) (types8.ConnectionEnd, bool) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetConnection", ctx, connectionID)
-	ret0, _ := ret[0].(types6.ConnectionEnd)
+	ret0, _ := ret[0].(types8.ConnectionEnd)
 	ret1, _ := ret[1].(bool)
 	return ret0, ret1
 }
@@ -994,10 +1039,10 @@ func (mr *MockClientKeeperMockRecorder) GetLatestClientConsensusState(ctx, clien
 }
 
 // GetStoreProvider mocks base method.
-func (m *MockClientKeeper) GetStoreProvider() types5.StoreProvider {
+func (m *MockClientKeeper) GetStoreProvider() types7.StoreProvider {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetStoreProvider")
-	ret0, _ := ret[0].(types5.StoreProvider)
+	ret0, _ := ret[0].(types7.StoreProvider)
 	return ret0
 }
 
@@ -1019,6 +1064,20 @@ func (mr *MockClientKeeperMockRecorder) SetClientState(ctx, clientID, clientStat
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClientState", reflect.TypeOf((*MockClientKeeper)(nil).SetClientState), ctx, clientID, clientState)
 }
 
+// VerifyMembership mocks base method.
+func (m *MockClientKeeper) VerifyMembership(ctx types1.Context, clientID string, height exported.Height, delayTimePeriod, delayBlockPeriod uint64, proof []byte, path exported.Path, value []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMembership", ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyMembership indicates an expected call of VerifyMembership.
+func (mr *MockClientKeeperMockRecorder) VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMembership", reflect.TypeOf((*MockClientKeeper)(nil).VerifyMembership), ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
 // MockDistributionKeeper is a mock of DistributionKeeper interface.
 type MockDistributionKeeper struct {
 	ctrl     *gomock.Controller
@@ -1043,7 +1102,7 @@ func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
 }
 
 // AllocateTokensToValidator mocks base method.
-func (m *MockDistributionKeeper) AllocateTokensToValidator(ctx context.Context, validator types3.ValidatorI, reward types1.DecCoins) error {
+func (m *MockDistributionKeeper) AllocateTokensToValidator(ctx context.Context, validator types4.ValidatorI, reward types1.DecCoins) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AllocateTokensToValidator", ctx, validator, reward)
 	ret0, _ := ret[0].(error)
@@ -1173,6 +1232,35 @@ func (mr *MockBankKeeperMockRecorder) GetBalance(ctx, addr, denom interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockBankKeeper)(nil).GetBalance), ctx, addr, denom)
 }
 
+// GetDenomMetaData mocks base method.
+func (m *MockBankKeeper) GetDenomMetaData(ctx context.Context, denom string) (types2.Metadata, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDenomMetaData", ctx, denom)
+	ret0, _ := ret[0].(types2.Metadata)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetDenomMetaData indicates an expected call of GetDenomMetaData.
+func (mr *MockBankKeeperMockRecorder) GetDenomMetaData(ctx, denom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).GetDenomMetaData), ctx, denom)
+}
+
+// SendCoinsFromModuleToAccount mocks base method.
+func (m *MockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr types1.AccAddress, amt types1.Coins) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendCoinsFromModuleToAccount", ctx, senderModule, recipientAddr, amt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendCoinsFromModuleToAccount indicates an expected call of SendCoinsFromModuleToAccount.
+func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
+}
+
 // SendCoinsFromModuleToModule mocks base method.
 func (m *MockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt types1.Coins) error {
 	m.ctrl.T.Helper()
@@ -1187,6 +1275,18 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToModule(ctx, senderMod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToModule", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToModule), ctx, senderModule, recipientModule, amt)
 }
 
+// SetDenomMetaData mocks base method.
+func (m *MockBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData types2.Metadata) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetDenomMetaData", ctx, denomMetaData)
+}
+
+// SetDenomMetaData indicates an expected call of SetDenomMetaData.
+func (mr *MockBankKeeperMockRecorder) SetDenomMetaData(ctx, denomMetaData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomMetaData", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomMetaData), ctx, denomMetaData)
+}
+
 // MockAccountKeeper is a mock of AccountKeeper interface.
 type MockAccountKeeper struct {
 	ctrl     *gomock.Controller
@@ -1262,10 +1362,10 @@ func (m *MockIBCTransferKeeper) EXPECT() *MockIBCTransferKeeperMockRecorder {
 }
 
 // Transfer mocks base method.
-func (m *MockIBCTransferKeeper) Transfer(arg0 context.Context, arg1 *types4.MsgTransfer) (*types4.MsgTransferResponse, error) {
+func (m *MockIBCTransferKeeper) Transfer(arg0 context.Context, arg1 *types6.MsgTransfer) (*types6.MsgTransferResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Transfer", arg0, arg1)
-	ret0, _ := ret[0].(*types4.MsgTransferResponse)
+	ret0, _ := ret[0].(*types6.MsgTransferResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1276,6 +1376,58 @@ func (mr *MockIBCTransferKeeperMockRecorder) Transfer(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockIBCTransferKeeper)(nil).Transfer), arg0, arg1)
 }
 
+// MockICAHostKeeper is a mock of ICAHostKeeper interface.
+type MockICAHostKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockICAHostKeeperMockRecorder
+}
+
+// MockICAHostKeeperMockRecorder is the mock recorder for MockICAHostKeeper.
+type MockICAHostKeeperMockRecorder struct {
+	mock *MockICAHostKeeper
+}
+
+// NewMockICAHostKeeper creates a new mock instance.
+func NewMockICAHostKeeper(ctrl *gomock.Controller) *MockICAHostKeeper {
+	mock := &MockICAHostKeeper{ctrl: ctrl}
+	mock.recorder = &MockICAHostKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICAHostKeeper) EXPECT() *MockICAHostKeeperMockRecorder {
+	return m.recorder
+}
+
+// GetInterchainAccountAddress mocks base method.
+func (m *MockICAHostKeeper) GetInterchainAccountAddress(ctx types1.Context, connectionID, portID string) (string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInterchainAccountAddress", ctx, connectionID, portID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetInterchainAccountAddress indicates an expected call of GetInterchainAccountAddress.
+func (mr *MockICAHostKeeperMockRecorder) GetInterchainAccountAddress(ctx, connectionID, portID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInterchainAccountAddress", reflect.TypeOf((*MockICAHostKeeper)(nil).GetInterchainAccountAddress), ctx, connectionID, portID)
+}
+
+// GetParams mocks base method.
+func (m *MockICAHostKeeper) GetParams(ctx types1.Context) types5.Params {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParams", ctx)
+	ret0, _ := ret[0].(types5.Params)
+	return ret0
+}
+
+// GetParams indicates an expected call of GetParams.
+func (mr *MockICAHostKeeperMockRecorder) GetParams(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParams", reflect.TypeOf((*MockICAHostKeeper)(nil).GetParams), ctx)
+}
+
 // MockIBCCoreKeeper is a mock of IBCCoreKeeper interface.
 type MockIBCCoreKeeper struct {
 	ctrl     *gomock.Controller
@@ -1300,10 +1452,10 @@ func (m *MockIBCCoreKeeper) EXPECT() *MockIBCCoreKeeperMockRecorder {
 }
 
 // ChannelOpenInit mocks base method.
-func (m *MockIBCCoreKeeper) ChannelOpenInit(goCtx context.Context, msg *types7.MsgChannelOpenInit) (*types7.MsgChannelOpenInitResponse, error) {
+func (m *MockIBCCoreKeeper) ChannelOpenInit(goCtx context.Context, msg *types9.MsgChannelOpenInit) (*types9.MsgChannelOpenInitResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ChannelOpenInit", goCtx, msg)
-	ret0, _ := ret[0].(*types7.MsgChannelOpenInitResponse)
+	ret0, _ := ret[0].(*types9.MsgChannelOpenInitResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// InMemStakingKeepers holds real in-memory keepers of the x/auth, x/bank, x/staking and x/slashing modules.
+// They can be used instead of the mocked staking and slashing keepers to unit test logic
+// that spans many calls to the staking module, e.g., the computation of validator sets or jailing.
+type InMemStakingKeepers struct {
+	AccountKeeper  authkeeper.AccountKeeper
+	BankKeeper     bankkeeper.BaseKeeper
+	StakingKeeper  *stakingkeeper.Keeper
+	SlashingKeeper slashingkeeper.Keeper
+}
+
+// NewInMemKeeperParamsWithStakingKeepers instantiates in-memory keeper params with default values,
+// together with in-memory staking keepers whose stores are mounted on the same context
+func NewInMemKeeperParamsWithStakingKeepers(tb testing.TB) (InMemKeeperParams, InMemStakingKeepers) {
+	tb.Helper()
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	authStoreKey := storetypes.NewKVStoreKey(authtypes.StoreKey)
+	bankStoreKey := storetypes.NewKVStoreKey(banktypes.StoreKey)
+	stakingStoreKey := storetypes.NewKVStoreKey(stakingtypes.StoreKey)
+	slashingStoreKey := storetypes.NewKVStoreKey(slashingtypes.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	for _, key := range []*storetypes.KVStoreKey{storeKey, authStoreKey, bankStoreKey, stakingStoreKey, slashingStoreKey} {
+		// stores are mounted on the multistore db, i.e., under a prefix of their key, so that the state can be committed
+		stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	}
+	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	require.NoError(tb, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry) // Public key implementation registered here
+	authtypes.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	slashingtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	paramsSubspace := paramstypes.NewSubspace(cdc,
+		codec.NewLegacyAmino(),
+		storeKey,
+		memStoreKey,
+		paramstypes.ModuleName,
+	)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	params := InMemKeeperParams{
+		Cdc:            cdc,
+		StoreKey:       storeKey,
+		ParamsSubspace: &paramsSubspace,
+		Ctx:            ctx,
+
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		ConsensusAddressCodec: address.NewBech32Codec("cosmosvalcons"),
+	}
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	maccPerms := map[string][]string{
+		minttypes.ModuleName:           {authtypes.Minter},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+	}
+
+	accountKeeper := authkeeper.NewAccountKeeper(
+		cdc,
+		runtime.NewKVStoreService(authStoreKey),
+		authtypes.ProtoBaseAccount,
+		maccPerms,
+		address.NewBech32Codec(sdk.Bech32MainPrefix),
+		sdk.Bech32MainPrefix,
+		authority,
+	)
+	bankKeeper := bankkeeper.NewBaseKeeper(
+		cdc,
+		runtime.NewKVStoreService(bankStoreKey),
+		accountKeeper,
+		map[string]bool{},
+		authority,
+		log.NewNopLogger(),
+	)
+	stakingKeeper := stakingkeeper.NewKeeper(
+		cdc,
+		runtime.NewKVStoreService(stakingStoreKey),
+		accountKeeper,
+		bankKeeper,
+		authority,
+		params.ValidatorAddressCodec,
+		params.ConsensusAddressCodec,
+	)
+	slashingKeeper := slashingkeeper.NewKeeper(
+		cdc,
+		codec.NewLegacyAmino(),
+		runtime.NewKVStoreService(slashingStoreKey),
+		stakingKeeper,
+		authority,
+	)
+
+	require.NoError(tb, stakingKeeper.SetParams(ctx, stakingtypes.DefaultParams()))
+	require.NoError(tb, slashingKeeper.SetParams(ctx, slashingtypes.DefaultParams()))
+
+	return params, InMemStakingKeepers{
+		AccountKeeper:  accountKeeper,
+		BankKeeper:     bankKeeper,
+		StakingKeeper:  stakingKeeper,
+		SlashingKeeper: slashingKeeper,
+	}
+}
+
+// NewInMemProviderKeeperWithStakingKeepers instantiates an in-mem provider keeper from params,
+// the in-memory staking keepers and mocked keepers for the remaining external keepers
+func NewInMemProviderKeeperWithStakingKeepers(params InMemKeeperParams, stakingKeepers InMemStakingKeepers, mocks MockedKeepers) providerkeeper.Keeper {
+	return providerkeeper.NewKeeper(
+		params.Cdc,
+		params.StoreKey,
+		*params.ParamsSubspace,
+		mocks.MockChannelKeeper,
+		mocks.MockConnectionKeeper,
+		mocks.MockClientKeeper,
+		stakingKeepers.StakingKeeper,
+		stakingKeepers.SlashingKeeper,
+		stakingKeepers.AccountKeeper,
+		mocks.MockDistributionKeeper,
+		stakingKeepers.BankKeeper,
+		govkeeper.Keeper{}, // HACK: to make parts of the test work
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		params.ValidatorAddressCodec,
+		params.ConsensusAddressCodec,
+		authtypes.FeeCollectorName,
+	)
+}
+
+// Returns an in-memory provider keeper that uses real in-memory staking and slashing keepers,
+// together with the context, controller, mocks of the remaining external keepers and the staking keepers.
+// The slashing and provider hooks are registered on the staking keeper, as in the provider app.
+//
+// Note: Calling ctrl.Finish() at the end of a test function ensures that
+// no unexpected calls to the mocked external keepers are made.
+func GetProviderKeeperAndCtxWithStakingKeepers(t testing.TB) (
+	providerkeeper.Keeper, sdk.Context, *gomock.Controller, MockedKeepers, InMemStakingKeepers,
+) {
+	t.Helper()
+	params, stakingKeepers := NewInMemKeeperParamsWithStakingKeepers(t)
+	ctrl := gomock.NewController(t)
+	mocks := NewMockedKeepers(ctrl)
+	providerKeeper := NewInMemProviderKeeperWithStakingKeepers(params, stakingKeepers, mocks)
+	stakingKeepers.StakingKeeper.SetHooks(stakingtypes.NewMultiStakingHooks(
+		stakingKeepers.SlashingKeeper.Hooks(),
+		providerKeeper.Hooks(),
+	))
+	return providerKeeper, params.Ctx, ctrl, mocks, stakingKeepers
+}
+
+// CreateValidator creates a validator with a new consensus key and self-delegates
+// the tokens corresponding to the given power; the validator is bonded by the next EndBlock
+func (sk InMemStakingKeepers) CreateValidator(t testing.TB, ctx sdk.Context, power int64) stakingtypes.Validator {
+	t.Helper()
+	bondDenom, err := sk.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	tokens := sdk.NewCoin(bondDenom, sk.StakingKeeper.TokensFromConsensusPower(ctx, power))
+
+	// fund the validator operator account
+	privKey := ed25519.GenPrivKey()
+	accAddr := sdk.AccAddress(privKey.PubKey().Address())
+	require.NoError(t, sk.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(tokens)))
+	require.NoError(t, sk.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, sdk.NewCoins(tokens)))
+
+	valAddr := sdk.ValAddress(accAddr)
+	valAddrStr, err := sk.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddrStr,
+		ed25519.GenPrivKey().PubKey(),
+		tokens,
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		math.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(sk.StakingKeeper).CreateValidator(ctx, msg)
+	require.NoError(t, err)
+
+	validator, err := sk.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	return validator
+}
+
+// EndBlock runs the end blocker of the staking module, i.e., it applies the pending
+// changes of the validator set, and returns the resulting validator updates
+func (sk InMemStakingKeepers) EndBlock(t testing.TB, ctx sdk.Context) []abci.ValidatorUpdate {
+	t.Helper()
+	updates, err := sk.StakingKeeper.EndBlocker(ctx)
+	require.NoError(t, err)
+	return updates
+}
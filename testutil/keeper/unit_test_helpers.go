@@ -11,6 +11,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	addresscodec "cosmossdk.io/core/address"
 	"cosmossdk.io/log"
 	math "cosmossdk.io/math"
 	"cosmossdk.io/store"
@@ -46,6 +47,9 @@ type InMemKeeperParams struct {
 	StoreKey       *storetypes.KVStoreKey
 	ParamsSubspace *paramstypes.Subspace
 	Ctx            sdk.Context
+	// address codecs of the app, which default to the cosmos prefixes
+	ValidatorAddressCodec addresscodec.Codec
+	ConsensusAddressCodec addresscodec.Codec
 }
 
 // NewInMemKeeperParams instantiates in-memory keeper params with default values
@@ -77,6 +81,9 @@ func NewInMemKeeperParams(tb testing.TB) InMemKeeperParams {
 		StoreKey:       storeKey,
 		ParamsSubspace: &paramsSubspace,
 		Ctx:            ctx,
+
+		ValidatorAddressCodec: address.NewBech32Codec("cosmosvaloper"),
+		ConsensusAddressCodec: address.NewBech32Codec("cosmosvalcons"),
 	}
 }
 
@@ -128,8 +135,8 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		// mocks.MockGovKeeper,
 		govkeeper.Keeper{}, // HACK: to make parts of the test work
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		address.NewBech32Codec("cosmosvaloper"),
-		address.NewBech32Codec("cosmosvalcons"),
+		params.ValidatorAddressCodec,
+		params.ConsensusAddressCodec,
 		authtypes.FeeCollectorName,
 	)
 }
@@ -149,8 +156,8 @@ func NewInMemConsumerKeeper(params InMemKeeperParams, mocks MockedKeepers) consu
 		mocks.MockIBCCoreKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		address.NewBech32Codec("cosmosvaloper"),
-		address.NewBech32Codec("cosmosvalcons"),
+		params.ValidatorAddressCodec,
+		params.ConsensusAddressCodec,
 	)
 }
 
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// NewGenesisHashCmd returns a command that prints the genesis hash of a consumer chain,
+// i.e., the hash to commit to in the initialization parameters of the consumer chain
+func NewGenesisHashCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hash [genesis-file]",
+		Short: "Print the hex encoded genesis hash of the consumer chain",
+		Long: `Print the genesis hash of the consumer chain, i.e., the SHA256 hash of the sorted JSON encoding
+of the application genesis state without the consumer CCV module genesis (` + types.ModuleName + `).
+The consumer CCV module genesis is excluded, as it is produced by the provider chain at spawn time.
+If the verification of the genesis hash is required in the initialization parameters of the consumer chain,
+the consumer chain refuses to start unless the committed genesis hash matches this hash.
+The genesis file defaults to the genesis file of the node.`,
+		Example: "interchain-security-cd genesis hash ~/.interchain-security-c/config/genesis.json",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var genFile string
+			if len(args) > 0 {
+				genFile = args[0]
+			} else {
+				genFile = server.GetServerContextFromCmd(cmd).Config.GenesisFile()
+			}
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(genFile)
+			if err != nil {
+				return fmt.Errorf("reading genesis file %s: %w", genFile, err)
+			}
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("unmarshalling app state: %w", err)
+			}
+
+			genesisHash, err := types.ComputeGenesisHash(appState)
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), hex.EncodeToString(genesisHash))
+			return err
+		},
+	}
+
+	return cmd
+}
@@ -24,6 +24,10 @@ func NewQueryCmd() *cobra.Command {
 		CmdProviderInfo(),
 		CmdThrottleState(),
 		CmdParams(),
+		CmdProviderClientExpiry(),
+		CmdProviderIbcIds(),
+		CmdRedistributionFractions(),
+		CmdProviderValsetVerification(),
 	)
 
 	return cmd
@@ -136,3 +140,111 @@ func CmdParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdProviderClientExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-client-expiry",
+		Short: "Query when the client to the provider chain expires",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderClientExpiryRequest{}
+			res, err := queryClient.QueryProviderClientExpiry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProviderIbcIds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-ibc-ids",
+		Short: "Query the client id of the provider chain and the CCV channel id",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderIbcIdsRequest{}
+			res, err := queryClient.QueryProviderIbcIds(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRedistributionFractions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redistribution-fractions",
+		Short: "Query the effective split of the fees between the consumer chain and the provider chain per denom",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRedistributionFractionsRequest{}
+			res, err := queryClient.QueryRedistributionFractions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProviderValsetVerification() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-valset-verification",
+		Short: "Query the last verification of the consumer validator set against the provider state",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderValsetVerificationRequest{}
+			res, err := queryClient.QueryProviderValsetVerification(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
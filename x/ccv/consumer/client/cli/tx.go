@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// NewTxCmd returns the transaction commands for the ccv consumer module
+func NewTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewSubmitProviderValsetProofCmd())
+
+	return cmd
+}
+
+func NewSubmitProviderValsetProofCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-provider-valset-proof [proof-height] [valset-update-id] [valset-hash] [proof]",
+		Short: "submit a proof of the latest valset checkpoint stored on the provider chain for this consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submits the latest valset checkpoint stored on the provider chain for this consumer chain,
+i.e., the value stored under the ProviderValsetCheckpointKey of the consumer ID in the provider store,
+together with its merkle proof at the given provider height (revision-height format, e.g., 1-100).
+The consumer chain verifies the proof against its provider client and compares the checkpoint with
+its validator set. The consumer validator set is not updated.
+The valset hash and the proof are hex-encoded.
+Example:
+%s tx %s submit-provider-valset-proof 1-100 42 [valset-hash] [proof]
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			proofHeight, err := clienttypes.ParseHeight(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid proof height: %w", err)
+			}
+			valsetUpdateId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid valset update id: %w", err)
+			}
+			valsetHash, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid valset hash: %w", err)
+			}
+			proof, err := hex.DecodeString(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid proof: %w", err)
+			}
+
+			msg := &types.MsgSubmitProviderValsetProof{
+				Submitter:      clientCtx.GetFromAddress().String(),
+				ProofHeight:    proofHeight,
+				ValsetUpdateId: valsetUpdateId,
+				ValsetHash:     valsetHash,
+				Proof:          proof,
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -51,9 +51,9 @@ func (am AppModule) OnChanOpenInit(
 	}
 
 	// ensure the counterparty port ID matches the expected provider port ID
-	if counterparty.PortId != types.ProviderPortID {
+	if counterparty.PortId != am.keeper.CounterpartyPortID() {
 		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort,
-			"invalid counterparty port: %s, expected %s", counterparty.PortId, types.ProviderPortID)
+			"invalid counterparty port: %s, expected %s", counterparty.PortId, am.keeper.CounterpartyPortID())
 	}
 
 	if err := am.keeper.VerifyProviderChain(ctx, connectionHops); err != nil {
@@ -128,6 +128,9 @@ func (am AppModule) OnChanOpenAck(
 	}
 
 	am.keeper.SetProviderFeePoolAddrStr(ctx, md.ProviderFeePoolAddr)
+	am.keeper.SetDeclaredProviderFeePoolAddr(ctx, md.ProviderFeePoolAddr)
+	// providers that predate feature negotiation do not advertise any feature
+	am.keeper.SetProviderFeatures(ctx, md.Features)
 
 	///////////////////////////////////////////////////
 	// Initialize distribution token transfer channel
@@ -220,29 +223,64 @@ func (am AppModule) OnRecvPacket(
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
 	var data types.ValidatorSetChangePacketData
+	var checkpoint types.ValsetCheckpointPacketData
+	var upgrade types.ConsumerUpgradePacketData
+	var downtimeParams types.DowntimeParamsPacketData
+	var feePoolAddr types.ProviderFeePoolAddrPacketData
+	var isCheckpoint, isUpgrade, isDowntimeParams, isFeePoolAddr bool
 	var ackErr error
 	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
-		ackErr = errorsmod.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal VSCPacket data")
-		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		// the provider also sends valset checkpoint, consumer upgrade, downtime params
+		// and provider fee pool address packets on the CCV channel
+		switch {
+		case types.ModuleCdc.UnmarshalJSON(packet.GetData(), &checkpoint) == nil:
+			isCheckpoint = true
+		case types.ModuleCdc.UnmarshalJSON(packet.GetData(), &upgrade) == nil:
+			isUpgrade = true
+		case types.ModuleCdc.UnmarshalJSON(packet.GetData(), &downtimeParams) == nil:
+			isDowntimeParams = true
+		case types.ModuleCdc.UnmarshalJSON(packet.GetData(), &feePoolAddr) == nil:
+			isFeePoolAddr = true
+		default:
+			ackErr = errorsmod.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal VSCPacket data")
+			logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
+			ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		}
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
+	vscID := data.ValsetUpdateId
 	if ack.Success() {
-		err := am.keeper.OnRecvVSCPacket(ctx, packet, data)
+		var err error
+		switch {
+		case isCheckpoint:
+			vscID = checkpoint.ValsetUpdateId
+			err = am.keeper.OnRecvValsetCheckpointPacket(ctx, packet, checkpoint)
+		case isUpgrade:
+			err = am.keeper.OnRecvConsumerUpgradePacket(ctx, packet, upgrade)
+		case isDowntimeParams:
+			err = am.keeper.OnRecvDowntimeParamsPacket(ctx, packet, downtimeParams)
+		case isFeePoolAddr:
+			err = am.keeper.OnRecvProviderFeePoolAddrPacket(ctx, packet, feePoolAddr)
+		default:
+			err = am.keeper.OnRecvVSCPacket(ctx, packet, data)
+			if err == nil {
+				ack = am.vscPacketAck(ctx, data.ValsetUpdateId)
+			}
+		}
 		if err != nil {
 			ack = channeltypes.NewErrorAcknowledgement(err)
 			ackErr = err
 			logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		} else {
-			logger.Info("successfully handled VSCPacket", "sequence", packet.Sequence)
+			logger.Info("successfully handled VSCPacket", "sequence", packet.Sequence, "checkpoint", isCheckpoint)
 		}
 	}
 
 	eventAttributes := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-		sdk.NewAttribute(types.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
+		sdk.NewAttribute(types.AttributeValSetUpdateID, strconv.Itoa(int(vscID))),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
 	}
 
@@ -261,6 +299,18 @@ func (am AppModule) OnRecvPacket(
 	return ack
 }
 
+// vscPacketAck returns the acknowledgement of a successfully handled VSC packet,
+// which confirms the validator set the consumer chain applies as a result
+func (am AppModule) vscPacketAck(ctx sdk.Context, vscID uint64) channeltypes.Acknowledgement {
+	valsetHash, err := am.keeper.GetNextValsetHash(ctx)
+	if err != nil {
+		// fall back to the legacy result ack that does not confirm the applied validator set
+		am.keeper.Logger(ctx).Error("cannot compute the valset hash", "vscID", vscID, "error", err)
+		return channeltypes.NewResultAcknowledgement(types.V1Result)
+	}
+	return channeltypes.NewResultAcknowledgement(types.NewValidatorSetChangePacketAck(vscID, valsetHash).GetBytes())
+}
+
 // OnAcknowledgementPacket implements the IBCModule interface
 func (am AppModule) OnAcknowledgementPacket(
 	ctx sdk.Context,
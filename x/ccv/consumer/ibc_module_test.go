@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
@@ -11,6 +12,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer"
 	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
@@ -387,3 +391,53 @@ func TestOnChanCloseConfirm(t *testing.T) {
 	err := consumerModule.OnChanCloseConfirm(ctx, "portID", "channelID")
 	require.NoError(t, err)
 }
+
+// TestOnRecvPacketInvalidVSCPacket tests that the consumer rejects invalid VSC packets
+// with error acknowledgements carrying the validation error code
+func TestOnRecvPacketInvalidVSCPacket(t *testing.T) {
+	cId := crypto.NewCryptoIdentityFromIntSeed(1)
+
+	testCases := []struct {
+		name       string
+		valUpdates []abci.ValidatorUpdate
+		expErr     error
+	}{
+		{
+			"negative power",
+			[]abci.ValidatorUpdate{{PubKey: cId.TMProtoCryptoPublicKey(), Power: -1}},
+			ccv.ErrInvalidValidatorPower,
+		},
+		{
+			"duplicate pubkeys",
+			[]abci.ValidatorUpdate{
+				{PubKey: cId.TMProtoCryptoPublicKey(), Power: 0},
+				{PubKey: cId.TMProtoCryptoPublicKey(), Power: 1},
+			},
+			ccv.ErrDuplicateValidatorPubKey,
+		},
+		{
+			"too many updates",
+			make([]abci.ValidatorUpdate, ccv.MaxValidatorUpdatesPerPacket+1),
+			ccv.ErrTooManyValidatorUpdates,
+		},
+	}
+
+	for _, tc := range testCases {
+		keeperParams := testkeeper.NewInMemKeeperParams(t)
+		consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+		consumerModule := consumer.NewAppModule(consumerKeeper, *keeperParams.ParamsSubspace)
+		consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+
+		data := ccv.NewValidatorSetChangePacketData(tc.valUpdates, 1, nil)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, ccv.ProviderPortID, "providerCCVChannelID",
+			ccv.ConsumerPortID, "consumerCCVChannelID", clienttypes.NewHeight(1, 0), 0)
+
+		ack := consumerModule.OnRecvPacket(ctx, "", packet, nil)
+		require.False(t, ack.Success(), tc.name)
+		require.Equal(t, channeltypes.NewErrorAcknowledgement(tc.expErr), ack, tc.name)
+
+		_, found := consumerKeeper.GetPendingChanges(ctx)
+		require.False(t, found, tc.name)
+		ctrl.Finish()
+	}
+}
@@ -0,0 +1,50 @@
+package consumer
+
+import (
+	"fmt"
+
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+)
+
+var _ module.HasServices = ICAHostAppModule{}
+
+// ICAHostAppModule is the interchain accounts module of a consumer chain running only the host,
+// whose msg server validates the messages allowed by the host when its params are updated
+// (see keeper.NewICAHostMsgServer)
+type ICAHostAppModule struct {
+	ica.AppModule
+	hostKeeper *icahostkeeper.Keeper
+	keeper     keeper.Keeper
+}
+
+// NewICAHostAppModule creates a new interchain accounts module running only the host.
+// The host must be set on the consumer keeper beforehand (see keeper.SetICAHost).
+func NewICAHostAppModule(hostKeeper *icahostkeeper.Keeper, k keeper.Keeper) ICAHostAppModule {
+	return ICAHostAppModule{
+		AppModule:  ica.NewAppModule(nil, hostKeeper),
+		hostKeeper: hostKeeper,
+		keeper:     k,
+	}
+}
+
+// RegisterServices registers the services of the interchain accounts host,
+// replacing the msg server of the host with the one of the consumer module
+func (am ICAHostAppModule) RegisterServices(cfg module.Configurator) {
+	icahosttypes.RegisterMsgServer(cfg.MsgServer(), keeper.NewICAHostMsgServer(am.keeper, icahostkeeper.NewMsgServerImpl(am.hostKeeper)))
+	icahosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
+
+	hostMigrator := icahostkeeper.NewMigrator(am.hostKeeper)
+	if err := cfg.RegisterMigration(icatypes.ModuleName, 2, func(ctx sdk.Context) error {
+		return hostMigrator.MigrateParams(ctx)
+	}); err != nil {
+		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 2 to 3 (self-managed params migration): %v", err))
+	}
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetProviderClientExpiry returns when the client to the provider chain expires
+func (k Keeper) GetProviderClientExpiry(ctx sdk.Context) (ccv.ClientExpiry, error) {
+	clientId, found := k.GetProviderClientID(ctx)
+	if !found {
+		return ccv.ClientExpiry{}, errorsmod.Wrap(clienttypes.ErrClientNotFound, "no client to the provider chain")
+	}
+	return ccv.GetClientExpiry(ctx, k.clientKeeper, clientId, k.GetClientExpiryWarningWindow(ctx))
+}
+
+// GetProviderClientExpirySeverity returns the severity of the last expiry warning of the client to the provider chain
+func (k Keeper) GetProviderClientExpirySeverity(ctx sdk.Context) ccv.ClientExpirySeverity {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderClientExpirySeverityKey())
+	if bz == nil {
+		return ccv.CLIENT_EXPIRY_SEVERITY_NONE
+	}
+	return ccv.ClientExpirySeverity(sdk.BigEndianToUint64(bz))
+}
+
+// SetProviderClientExpirySeverity sets the severity of the last expiry warning of the client to the provider chain
+func (k Keeper) SetProviderClientExpirySeverity(ctx sdk.Context, severity ccv.ClientExpirySeverity) {
+	store := ctx.KVStore(k.storeKey)
+	if severity == ccv.CLIENT_EXPIRY_SEVERITY_NONE {
+		store.Delete(types.ProviderClientExpirySeverityKey())
+		return
+	}
+	store.Set(types.ProviderClientExpirySeverityKey(), sdk.Uint64ToBigEndian(uint64(severity)))
+}
+
+// EndBlockClientExpiry sets the gauge of the seconds until the client to the provider chain expires
+// and emits a client expiry warning whenever the severity escalates. It is a no-op until
+// the client to the provider chain is established.
+func (k Keeper) EndBlockClientExpiry(ctx sdk.Context) {
+	if _, found := k.GetProviderClientID(ctx); !found {
+		return
+	}
+
+	expiry, err := k.GetProviderClientExpiry(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("cannot get provider client expiry", "error", err)
+		return
+	}
+	ccv.SetConsumerGauge(types.ModuleName, ccv.MetricKeySecondsUntilClientExpiry, k.GetConsumerId(ctx),
+		float32(expiry.Remaining.Seconds()))
+
+	previous := k.GetProviderClientExpirySeverity(ctx)
+	if expiry.Severity == previous {
+		return
+	}
+	k.SetProviderClientExpirySeverity(ctx, expiry.Severity)
+	if expiry.Severity < previous {
+		return
+	}
+
+	k.Logger(ctx).Error("provider client is close to expiry",
+		"clientId", expiry.ClientId,
+		"severity", expiry.Severity,
+		"remaining", expiry.Remaining,
+	)
+	ccv.EmitClientExpiryWarningEvent(ctx, types.ModuleName, expiry)
+}
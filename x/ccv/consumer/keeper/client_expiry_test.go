@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestEndBlockClientExpiry tests that a client expiry warning is emitted
+// whenever the severity of the expiry of the provider client escalates
+func TestEndBlockClientExpiry(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccv.DefaultParams()
+	params.ClientExpiryWarningWindow = 4 * time.Hour
+	consumerKeeper.SetParams(ctx, params)
+
+	// no-op until the client to the provider chain is established
+	consumerKeeper.EndBlockClientExpiry(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	_, err := consumerKeeper.QueryProviderClientExpiry(ctx, &consumertypes.QueryProviderClientExpiryRequest{})
+	require.Error(t, err)
+
+	consumerKeeper.SetProviderClientID(ctx, "clientID")
+	latestConsensusTime := time.Unix(1000, 0).UTC()
+	trustingPeriod := 10 * time.Hour
+	expiryTime := latestConsensusTime.Add(trustingPeriod)
+
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		expSeverity ccv.ClientExpirySeverity
+		expEvent    bool
+	}{
+		{"outside of the warning window", expiryTime.Add(-5 * time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_NONE, false},
+		{"within a quarter of the warning window", expiryTime.Add(-time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_CRITICAL, true},
+		{"back within the warning window", expiryTime.Add(-3 * time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_WARNING, false},
+		{"expired", expiryTime.Add(time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_EXPIRED, true},
+	}
+
+	for _, tc := range testCases {
+		ctx := ctx.WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+			&ibctmtypes.ClientState{TrustingPeriod: trustingPeriod}, true).AnyTimes()
+		mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(ctx, "clientID").Return(
+			&ibctmtypes.ConsensusState{Timestamp: latestConsensusTime}, true).AnyTimes()
+
+		res, err := consumerKeeper.QueryProviderClientExpiry(ctx, &consumertypes.QueryProviderClientExpiryRequest{})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expSeverity, res.ClientExpiry.Severity, tc.name)
+
+		consumerKeeper.EndBlockClientExpiry(ctx)
+		require.Equal(t, tc.expSeverity, consumerKeeper.GetProviderClientExpirySeverity(ctx), tc.name)
+		if tc.expEvent {
+			require.Len(t, ctx.EventManager().Events(), 1, tc.name)
+			require.Equal(t, ccv.EventTypeClientExpiryWarning, ctx.EventManager().Events()[0].Type, tc.name)
+		} else {
+			require.Empty(t, ctx.EventManager().Events(), tc.name)
+		}
+	}
+}
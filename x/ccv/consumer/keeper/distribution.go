@@ -26,7 +26,9 @@ func (k Keeper) EndBlockRD(ctx sdk.Context) {
 	// if sending coins between module accounts fails.
 	k.DistributeRewardsInternally(ctx)
 
-	if !k.shouldSendRewardsToProvider(ctx) {
+	// while the transmission is paused, the rewards allocated for the provider accumulate
+	// and are sent in the first block after the transmission is resumed
+	if k.GetRewardTransmissionPaused(ctx) || !k.shouldSendRewardsToProvider(ctx) {
 		return
 	}
 
@@ -47,22 +49,17 @@ func (k Keeper) EndBlockRD(ctx sdk.Context) {
 }
 
 // DistributeRewardsInternally splits the block rewards according to the
-// ConsumerRedistributionFrac param.
-// Returns true if it's time to send rewards to provider
+// ConsumerRedistributionFrac param and its per-denom overrides.
 func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	consumerFeePoolAddr := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
 	fpTokens := k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr)
+	if fpTokens.IsZero() {
+		return
+	}
 
 	// split the fee pool, send the consumer's fraction to the consumer redistribution address
-	frac, err := math.LegacyNewDecFromStr(k.GetConsumerRedistributionFrac(ctx))
-	if err != nil {
-		// ConsumerRedistributionFrac was already validated when set as a param
-		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
-	}
-	decFPTokens := sdk.NewDecCoinsFromCoins(fpTokens...)
-	// NOTE the truncated decimal remainder will be sent to the provider fee pool
-	consRedistrTokens, _ := decFPTokens.MulDec(frac).TruncateDecimal()
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
+	consRedistrTokens, remainingTokens := k.SplitFees(ctx, fpTokens)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
 		types.ConsumerRedistributeName, consRedistrTokens)
 	if err != nil {
 		// SendCoinsFromModuleToModule will panic if either module account does not exist,
@@ -77,7 +74,6 @@ func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	// tokens do not go through the consumer redistribute split twice in the
 	// event that the transfer fails the tokens are returned to the consumer
 	// chain.
-	remainingTokens := fpTokens.Sub(consRedistrTokens...)
 	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
 		types.ConsumerToSendToProviderName, remainingTokens)
 	if err != nil {
@@ -87,6 +83,58 @@ func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 		// returns error.
 		panic(err)
 	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFeeRedistribution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeDistributionFraction, k.GetConsumerRedistributionFrac(ctx)),
+			sdk.NewAttribute(types.AttributeDistributionTotal, fpTokens.String()),
+			sdk.NewAttribute(types.AttributeDistributionToConsumer, consRedistrTokens.String()),
+			sdk.NewAttribute(types.AttributeDistributionToProvider, remainingTokens.String()),
+		),
+	)
+}
+
+// GetRedistributionFractions returns the fraction of tokens allocated to the consumer
+// redistribution address for the denoms without an override, together with the per-denom overrides
+func (k Keeper) GetRedistributionFractions(ctx sdk.Context) (math.LegacyDec, map[string]math.LegacyDec) {
+	frac, err := math.LegacyNewDecFromStr(k.GetConsumerRedistributionFrac(ctx))
+	if err != nil {
+		// ConsumerRedistributionFrac was already validated when set as a param
+		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
+	}
+
+	overrides := make(map[string]math.LegacyDec)
+	for _, override := range k.GetConsumerRedistributionFracOverrides(ctx) {
+		overrideFrac, err := math.LegacyNewDecFromStr(override.Fraction)
+		if err != nil {
+			// ConsumerRedistributionFractionOverrides were already validated when set as a param
+			panic(fmt.Errorf("ConsumerRedistributionFractionOverrides are invalid: %w", err))
+		}
+		overrides[override.Denom] = overrideFrac
+	}
+
+	return frac, overrides
+}
+
+// SplitFees splits the given fees between the consumer redistribution address and the provider
+// chain according to the ConsumerRedistributionFrac param and its per-denom overrides.
+// NOTE the truncated decimal remainder of the consumer's fraction is allocated to the provider.
+func (k Keeper) SplitFees(ctx sdk.Context, fees sdk.Coins) (toConsumer, toProvider sdk.Coins) {
+	frac, overrides := k.GetRedistributionFractions(ctx)
+
+	toConsumer = sdk.NewCoins()
+	for _, fee := range fees {
+		denomFrac, found := overrides[fee.Denom]
+		if !found {
+			denomFrac = frac
+		}
+		amount := math.LegacyNewDecFromInt(fee.Amount).Mul(denomFrac).TruncateInt()
+		toConsumer = toConsumer.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	return toConsumer, fees.Sub(toConsumer...)
 }
 
 // Check whether it's time to send rewards to provider
@@ -256,16 +304,9 @@ func (k Keeper) GetEstimatedNextFeeDistribution(ctx sdk.Context) types.NextFeeDi
 	total := k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr)
 
 	fracParam := k.GetConsumerRedistributionFrac(ctx)
-	frac, err := math.LegacyNewDecFromStr(fracParam)
-	if err != nil {
-		// ConsumerRedistributionFrac was already validated when set as a param
-		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
-	}
-
-	totalTokens := sdk.NewDecCoinsFromCoins(total...)
 	// truncated decimals are implicitly added to provider
-	consumerTokens, _ := totalTokens.MulDec(frac).TruncateDecimal()
-	providerTokens := total.Sub(consumerTokens...)
+	consumerTokens, providerTokens := k.SplitFees(ctx, total)
+	totalTokens := sdk.NewDecCoinsFromCoins(total...)
 
 	return types.NextFeeDistributionEstimate{
 		CurrentHeight:        ctx.BlockHeight(),
@@ -92,3 +92,111 @@ func TestAllowedRewardDenoms(t *testing.T) {
 	require.Equal(t, allowedDenoms[0], "ustake")
 	require.True(t, strings.HasPrefix(allowedDenoms[1], "ibc/"))
 }
+
+// TestDistributeRewardsInternallyWithOverrides tests that the fees are split between the consumer
+// redistribution address and the provider according to the per-denom redistribution fraction overrides
+func TestDistributeRewardsInternallyWithOverrides(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctx := keeperParams.Ctx
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	consumerKeeper := testkeeper.NewInMemConsumerKeeper(keeperParams, mocks)
+	params := ccvtypes.DefaultParams()
+	params.ConsumerRedistributionFraction = "0.75"
+	params.ConsumerRedistributionFractionOverrides = []ccvtypes.RedistributionFractionOverride{
+		{Denom: "uatom", Fraction: "0.1"},
+		{Denom: "untrn", Fraction: "0"},
+	}
+	consumerKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("ustake", 101),
+		sdk.NewInt64Coin("uatom", 55),
+		sdk.NewInt64Coin("untrn", 10),
+	)
+	toConsumer, toProvider := consumerKeeper.SplitFees(ctx, fees)
+	// NOTE the truncated remainders are allocated to the provider
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ustake", 75), sdk.NewInt64Coin("uatom", 5)), toConsumer)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ustake", 26), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("untrn", 10)), toProvider)
+
+	mAcc := authTypes.NewModuleAccount(&authTypes.BaseAccount{}, "", "auth")
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authTypes.FeeCollectorName).Return(mAcc).AnyTimes()
+	mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, mAcc.GetAddress()).Return(fees).AnyTimes()
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName,
+			types.ConsumerRedistributeName, toConsumer).Return(nil).Times(1),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName,
+			types.ConsumerToSendToProviderName, toProvider).Return(nil).Times(1),
+	)
+
+	consumerKeeper.DistributeRewardsInternally(ctx)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeFeeRedistribution, events[0].Type)
+	attr, found := events[0].GetAttribute(types.AttributeDistributionToConsumer)
+	require.True(t, found)
+	require.Equal(t, toConsumer.String(), attr.Value)
+	attr, found = events[0].GetAttribute(types.AttributeDistributionToProvider)
+	require.True(t, found)
+	require.Equal(t, toProvider.String(), attr.Value)
+
+	// the estimate of the next fee distribution takes the overrides into account
+	estimate := consumerKeeper.GetEstimatedNextFeeDistribution(ctx)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(toConsumer...).String(), estimate.ToConsumer)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(toProvider...).String(), estimate.ToProvider)
+
+	// the query returns the effective split of every denom
+	res, err := consumerKeeper.QueryRedistributionFractions(ctx, &types.QueryRedistributionFractionsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.75").String(), res.DefaultFraction)
+	require.Equal(t, []types.RedistributionSplit{
+		{Denom: "uatom", ConsumerFraction: math.LegacyMustNewDecFromStr("0.1").String(), ProviderFraction: math.LegacyMustNewDecFromStr("0.9").String(), Overridden: true},
+		{Denom: "untrn", ConsumerFraction: math.LegacyZeroDec().String(), ProviderFraction: math.LegacyOneDec().String(), Overridden: true},
+		{Denom: "ustake", ConsumerFraction: math.LegacyMustNewDecFromStr("0.75").String(), ProviderFraction: math.LegacyMustNewDecFromStr("0.25").String(), Overridden: false},
+	}, res.Splits)
+}
+
+// TestRewardTransmissionPaused tests that no rewards are sent to the provider while the transmission is paused
+// and that events are emitted when the transmission is paused and resumed
+func TestRewardTransmissionPaused(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	ctx := keeperParams.Ctx.WithBlockHeight(100)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	consumerKeeper := testkeeper.NewInMemConsumerKeeper(keeperParams, mocks)
+	params := ccvtypes.DefaultParams()
+	params.BlocksPerDistributionTransmission = 10
+	consumerKeeper.SetParams(ctx, params)
+	require.Empty(t, ctx.EventManager().Events())
+
+	params.RewardTransmissionPaused = true
+	consumerKeeper.SetParams(ctx, params)
+	require.True(t, consumerKeeper.GetRewardTransmissionPaused(ctx))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeRewardTransmissionPaused, events[0].Type)
+
+	// the rewards are still split, but not sent to the provider
+	mAcc := authTypes.NewModuleAccount(&authTypes.BaseAccount{}, "", "auth")
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authTypes.FeeCollectorName).Return(mAcc).Times(1)
+	mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, mAcc.GetAddress()).Return(sdk.NewCoins()).Times(1)
+	consumerKeeper.EndBlockRD(ctx)
+	require.Equal(t, int64(0), consumerKeeper.GetLastTransmissionBlockHeight(ctx).Height)
+
+	// setting the params without resuming the transmission does not emit an event
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	consumerKeeper.SetParams(ctx, params)
+	require.Empty(t, ctx.EventManager().Events())
+
+	params.RewardTransmissionPaused = false
+	consumerKeeper.SetParams(ctx, params)
+	require.False(t, consumerKeeper.GetRewardTransmissionPaused(ctx))
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeRewardTransmissionResumed, events[0].Type)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// doubleSignInterlock is the local state of the double-sign interlock of a node,
+// i.e., the consensus address of the validator the node is signing for on the consumer chain
+// and whether evidence of this validator double signing was committed on the consumer chain
+type doubleSignInterlock struct {
+	consAddr sdk.ConsAddress
+	tripped  atomic.Bool
+}
+
+// SetDoubleSignInterlock enables the double-sign interlock for the validator with the given consensus address
+// on the consumer chain (see the x-ccv-double-sign-interlock start flag), which is expected to be the validator
+// the node is signing for. Once evidence of this validator double signing is committed on the consumer chain,
+// the node halts and hence, it stops signing. This is a safety net for operators running redundant setups,
+// as it prevents the validator from double signing again, e.g., until it is tombstoned on the provider chain.
+// Note that this is a post-commit halt: the double signing is only detected once the evidence is committed,
+// as the CometBFT evidence pool is not exposed to the application.
+// The interlock is disabled if the address is empty. This method must be called in app.go.
+func (k *Keeper) SetDoubleSignInterlock(consAddr string) error {
+	if strings.TrimSpace(consAddr) == "" {
+		k.doubleSignInterlock = nil
+		return nil
+	}
+	addr, err := k.consensusAddressCodec.StringToBytes(consAddr)
+	if err != nil {
+		return fmt.Errorf("invalid double-sign interlock address %s: %w", consAddr, err)
+	}
+	k.doubleSignInterlock = &doubleSignInterlock{consAddr: addr}
+	return nil
+}
+
+// checkDoubleSignInterlock trips the double-sign interlock if it is enabled
+// and the double-signing validator with address `addr` is the validator of the node
+func (k Keeper) checkDoubleSignInterlock(ctx sdk.Context, addr sdk.ConsAddress) {
+	if k.doubleSignInterlock == nil || !k.doubleSignInterlock.consAddr.Equals(addr) {
+		return
+	}
+	k.doubleSignInterlock.tripped.Store(true)
+	k.Logger(ctx).Error("double-sign interlock tripped: evidence of the local validator double signing was committed",
+		"validator", addr.String(),
+		"height", ctx.BlockHeight(),
+	)
+}
+
+// IsDoubleSignInterlockTripped returns true if the double-sign interlock is enabled
+// and evidence of the validator of the node double signing was committed on the consumer chain
+func (k Keeper) IsDoubleSignInterlockTripped() bool {
+	return k.doubleSignInterlock != nil && k.doubleSignInterlock.tripped.Load()
+}
+
+// EndBlockDoubleSignInterlock returns an error, which halts the node before it signs any vote for the next height,
+// if the double-sign interlock is tripped. Note that the node halts again when restarted, as the evidence is processed
+// again while replaying the block, unless the interlock is disabled.
+func (k Keeper) EndBlockDoubleSignInterlock(ctx sdk.Context) error {
+	if !k.IsDoubleSignInterlockTripped() {
+		return nil
+	}
+	return fmt.Errorf("halting the node, as evidence of the local validator %s double signing was committed at height %d; "+
+		"stop all the signers of the validator and investigate before restarting the node without the double-sign interlock",
+		k.doubleSignInterlock.consAddr.String(), ctx.BlockHeight())
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+)
+
+// TestDoubleSignInterlock tests that the double-sign interlock halts the node
+// only once evidence of the local validator double signing is committed
+func TestDoubleSignInterlock(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	localAddr := crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress()
+	otherAddr := crypto.NewCryptoIdentityFromIntSeed(2).SDKValConsAddress()
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 5, 6)
+
+	// the interlock is disabled by default
+	consumerKeeper.SlashWithInfractionReason(ctx, localAddr, 5, 6, math.LegacyNewDec(1), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.False(t, consumerKeeper.IsDoubleSignInterlockTripped())
+	require.NoError(t, consumerKeeper.EndBlockDoubleSignInterlock(ctx))
+
+	// invalid addresses are rejected
+	require.Error(t, consumerKeeper.SetDoubleSignInterlock("invalid"))
+	require.Error(t, consumerKeeper.SetDoubleSignInterlock(sdk.AccAddress(localAddr).String()))
+
+	require.NoError(t, consumerKeeper.SetDoubleSignInterlock(localAddr.String()))
+
+	// the interlock is not tripped by downtime or by other validators double signing
+	consumerKeeper.SlashWithInfractionReason(ctx, localAddr, 5, 6, math.LegacyNewDec(1), stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.SlashWithInfractionReason(ctx, otherAddr, 5, 6, math.LegacyNewDec(1), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.False(t, consumerKeeper.IsDoubleSignInterlockTripped())
+	require.NoError(t, consumerKeeper.EndBlockDoubleSignInterlock(ctx))
+
+	// the interlock is tripped by the local validator double signing, which is still reported to the provider chain
+	consumerKeeper.SlashWithInfractionReason(ctx, localAddr, 5, 6, math.LegacyNewDec(1), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.True(t, consumerKeeper.IsDoubleSignInterlockTripped())
+	require.Error(t, consumerKeeper.EndBlockDoubleSignInterlock(ctx))
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx), 4)
+
+	// disabling the interlock, i.e., restarting the node without the flag, resumes the node
+	require.NoError(t, consumerKeeper.SetDoubleSignInterlock(""))
+	require.False(t, consumerKeeper.IsDoubleSignInterlockTripped())
+	require.NoError(t, consumerKeeper.EndBlockDoubleSignInterlock(ctx))
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// OnRecvDowntimeParamsPacket handles a downtime params packet received from the provider,
+// i.e., it sets the downtime detection parameters of the slashing module to the ones
+// defined for the consumer chain on the provider chain.
+func (k Keeper) OnRecvDowntimeParamsPacket(ctx sdk.Context, packet channeltypes.Packet, data ccv.DowntimeParamsPacketData) error {
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating downtime params packet data")
+	}
+
+	// get the provider channel
+	providerChannel, found := k.GetProviderChannel(ctx)
+	if found && providerChannel != packet.DestinationChannel {
+		// downtime params packet was sent on a channel different than the provider channel;
+		// this should never happen
+		panic(fmt.Errorf("downtime params packet received on unknown channel %s; expected: %s",
+			packet.DestinationChannel, providerChannel))
+	}
+
+	params, err := k.slashingKeeper.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "cannot get slashing params")
+	}
+	params.SignedBlocksWindow = data.DowntimeParams.SignedBlocksWindow
+	params.MinSignedPerWindow = data.DowntimeParams.MinSignedPerWindow
+	if err := k.slashingKeeper.SetParams(ctx, params); err != nil {
+		return errorsmod.Wrapf(err, "cannot set slashing params")
+	}
+
+	k.Logger(ctx).Info("finished receiving/handling downtime params packet",
+		"signedBlocksWindow", params.SignedBlocksWindow,
+		"minSignedPerWindow", params.MinSignedPerWindow,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDowntimeParamsUpdate,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			}, data.DowntimeParams.EventAttributes()...)...,
+		),
+	)
+	return nil
+}
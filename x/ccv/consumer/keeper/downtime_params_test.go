@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestOnRecvDowntimeParamsPacket tests that the downtime params received from the provider
+// are applied to the slashing params, leaving the other slashing params unchanged
+func TestOnRecvDowntimeParamsPacket(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+
+	packet := channeltypes.Packet{DestinationChannel: "consumerCCVChannelID"}
+	downtimeParams := ccv.DowntimeParams{
+		SignedBlocksWindow: 10000,
+		MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2),
+	}
+
+	// invalid downtime params are rejected before the slashing params are read
+	err := consumerKeeper.OnRecvDowntimeParamsPacket(ctx, packet,
+		ccv.NewDowntimeParamsPacketData(ccv.DowntimeParams{MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2)}))
+	require.Error(t, err)
+
+	slashingParams := slashingtypes.DefaultParams()
+	expectedParams := slashingParams
+	expectedParams.SignedBlocksWindow = downtimeParams.SignedBlocksWindow
+	expectedParams.MinSignedPerWindow = downtimeParams.MinSignedPerWindow
+	gomock.InOrder(
+		mocks.MockSlashingKeeper.EXPECT().GetParams(ctx).Return(slashingParams, nil),
+		mocks.MockSlashingKeeper.EXPECT().SetParams(ctx, expectedParams).Return(nil),
+	)
+	err = consumerKeeper.OnRecvDowntimeParamsPacket(ctx, packet, ccv.NewDowntimeParamsPacketData(downtimeParams))
+	require.NoError(t, err)
+
+	// packets received on a channel other than the provider channel panic
+	require.Panics(t, func() {
+		_ = consumerKeeper.OnRecvDowntimeParamsPacket(ctx, channeltypes.Packet{DestinationChannel: "other"},
+			ccv.NewDowntimeParamsPacketData(downtimeParams))
+	})
+}
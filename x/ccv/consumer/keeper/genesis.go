@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
@@ -35,6 +37,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 	}
 	k.SetInitGenesisHeight(ctx, ctx.BlockHeight()) // Usually 0, but not the case for changeover chains
 
+	// If the consumer chain opted in to the genesis hash verification,
+	// it MUST NOT start unless the hash matches its actual genesis.
+	if state.VerifyGenesisHash {
+		genesisHash, found := types.GetGenesisHash(ctx)
+		if !found {
+			panic(errorsmod.Wrap(ccv.ErrInvalidGenesis, "cannot verify genesis hash: genesis hash not provided by the application"))
+		}
+		if !bytes.Equal(genesisHash, state.GenesisHash) {
+			panic(errorsmod.Wrapf(ccv.ErrInvalidGenesis, "genesis hash mismatch: expected %X, got %X", state.GenesisHash, genesisHash))
+		}
+	}
+
 	k.SetParams(ctx, state.Params)
 	// TODO: Remove enabled flag and find a better way to setup integration tests
 	// See: https://github.com/cosmos/interchain-security/issues/339
@@ -42,7 +56,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		return nil
 	}
 
-	k.SetPort(ctx, ccv.ConsumerPortID)
+	k.SetPort(ctx, k.portID)
 
 	// initialValSet is checked in NewChain case by ValidateGenesis
 	// start a new chain
@@ -93,11 +107,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		if state.ConnectionId != "" {
 			// initiate CCV channel handshake
 			ccvChannelOpenInitMsg := channeltypes.NewMsgChannelOpenInit(
-				ccv.ConsumerPortID,
+				k.portID,
 				ccv.Version,
 				channeltypes.ORDERED,
 				[]string{state.ConnectionId},
-				ccv.ProviderPortID,
+				k.counterpartyPortID,
 				"", // signer unused
 			)
 			_, err := k.ChannelOpenInit(ctx, ccvChannelOpenInitMsg)
@@ -115,24 +129,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		if state.ProviderChannelId != "" {
 			// set provider channel ID
 			k.SetProviderChannel(ctx, state.ProviderChannelId)
-			// set outstanding downtime slashing requests
-			for _, od := range state.OutstandingDowntimeSlashing {
-				consAddr, err := sdk.ConsAddressFromBech32(od.ValidatorConsensusAddress)
-				if err != nil {
-					panic(err)
-				}
-				k.SetOutstandingDowntime(ctx, consAddr)
-			}
 
 			// set last transmission block height
 			k.SetLastTransmissionBlockHeight(ctx, state.LastTransmissionBlockHeight)
+
+			// set the slash record, so that the retries of bounced slash packets remain throttled
+			if state.SlashRecord != nil {
+				k.SetSlashRecord(ctx, *state.SlashRecord)
+			}
 		}
 
 		// Set pending consumer packets, using the depreciated ConsumerPacketDataList type
 		// that exists for genesis.
 		// note that the list includes pending mature VSC packet only if the handshake is completed
-		for _, packet := range state.PendingConsumerPackets.List {
-			k.AppendPendingPacket(ctx, packet.Type, packet.Data)
+		for i, packet := range state.PendingConsumerPackets.List {
+			if len(state.PendingPacketEnqueueRecords) == len(state.PendingConsumerPackets.List) {
+				// keep the records of when the packets were enqueued
+				k.appendPendingPacket(ctx, packet.Type, packet.Data, state.PendingPacketEnqueueRecords[i])
+			} else {
+				k.AppendPendingPacket(ctx, packet.Type, packet.Data)
+			}
 		}
 
 		// set height to valset update id mapping
@@ -150,6 +166,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 
 	// populate cross chain validators states with initial valset
 	k.ApplyCCValidatorChanges(ctx, state.Provider.InitialValSet)
+
+	// set outstanding downtime slashing requests (empty for a new chain); note that
+	// they are set after applying the initial valset, which clears the outstanding downtime
+	// of the validators it adds, and that the slash packets are queued also while
+	// the handshake is still in progress
+	for _, od := range state.OutstandingDowntimeSlashing {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(od.ValidatorConsensusAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.SetOutstandingDowntime(ctx, consAddr)
+	}
+
 	return state.Provider.InitialValSet
 }
 
@@ -201,11 +230,17 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *types.GenesisState) {
 			valset,
 			k.GetAllHeightToValsetUpdateIDs(ctx),
 			pendingPacketsDepreciated,
-			nil,
+			k.GetAllOutstandingDowntimes(ctx),
 			types.LastTransmissionBlockHeight{},
 			params,
 		)
 	}
 
+	// export the retry state of slash packets and when the pending packets were enqueued
+	if record, found := k.GetSlashRecord(ctx); found {
+		genesis.SlashRecord = &record
+	}
+	genesis.PendingPacketEnqueueRecords = k.GetAllPendingPacketEnqueueRecords(ctx)
+
 	return genesis
 }
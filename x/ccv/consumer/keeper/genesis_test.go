@@ -255,6 +255,7 @@ func TestExportGenesis(t *testing.T) {
 		consumertypes.HeightToValsetUpdateID{ValsetUpdateId: vscID + 1, Height: blockHeight + 1},
 	)
 	ltbh := consumertypes.LastTransmissionBlockHeight{Height: int64(1000)}
+	slashRecord := consumertypes.NewSlashRecord(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), true)
 	// create default parameters for a new chain
 	params := ccv.DefaultParams()
 	params.Enabled = true
@@ -280,6 +281,8 @@ func TestExportGenesis(t *testing.T) {
 				for _, packet := range consPackets.List {
 					ck.AppendPendingPacket(ctx, packet.Type, packet.Data)
 				}
+				// the slash packet is queued while the handshake is still in progress
+				ck.SetOutstandingDowntime(ctx, sdk.ConsAddress(validator.Address.Bytes()))
 
 				ck.SetHeightValsetUpdateID(ctx, defaultHeightValsetUpdateIDs[0].Height, defaultHeightValsetUpdateIDs[0].ValsetUpdateId)
 			},
@@ -289,7 +292,9 @@ func TestExportGenesis(t *testing.T) {
 				valset,
 				defaultHeightValsetUpdateIDs,
 				consPackets,
-				nil,
+				[]consumertypes.OutstandingDowntime{
+					{ValidatorConsensusAddress: sdk.ConsAddress(validator.Address.Bytes()).String()},
+				},
 				consumertypes.LastTransmissionBlockHeight{},
 				params,
 			),
@@ -316,6 +321,7 @@ func TestExportGenesis(t *testing.T) {
 				// populate the required states for an established CCV channel
 				ck.SetOutstandingDowntime(ctx, sdk.ConsAddress(validator.Address.Bytes()))
 				ck.SetLastTransmissionBlockHeight(ctx, ltbh)
+				ck.SetSlashRecord(ctx, slashRecord)
 			},
 			consumertypes.NewRestartGenesisState(
 				provClientID,
@@ -345,12 +351,159 @@ func TestExportGenesis(t *testing.T) {
 			// export states to genesis
 			gotGen := consumerKeeper.ExportGenesis(ctx)
 
+			// the pending packets were enqueued in the current block
+			tc.expGenesis.PendingPacketEnqueueRecords = []consumertypes.PendingPacketEnqueueRecord{}
+			for range consPackets.List {
+				tc.expGenesis.PendingPacketEnqueueRecords = append(tc.expGenesis.PendingPacketEnqueueRecords,
+					consumertypes.PendingPacketEnqueueRecord{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+			}
+			if tc.expGenesis.ProviderChannelId != "" {
+				tc.expGenesis.SlashRecord = &slashRecord
+			}
+
 			// check obtained genesis
 			require.EqualValues(t, tc.expGenesis, gotGen)
 		})
 	}
 }
 
+// TestExportAndInitGenesisRoundTrip tests that a consumer chain restarted from an export
+// resumes with the same pending packets, slash record, outstanding downtimes and retry state
+func TestExportAndInitGenesisRoundTrip(t *testing.T) {
+	cId := crypto.NewCryptoIdentityFromIntSeed(234234)
+	validator := tmtypes.NewValidator(cId.TMCryptoPubKey(), 1)
+	abciValidator := abci.Validator{Address: validator.Address, Power: int64(1)}
+	params := ccv.DefaultParams()
+	params.Enabled = true
+
+	for _, channelEstablished := range []bool{false, true} {
+		keeperParams := testkeeper.NewInMemKeeperParams(t)
+		consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+		defer ctrl.Finish()
+		ctx = ctx.WithBlockHeight(10).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+		consumerKeeper.SetParams(ctx, params)
+		consumerKeeper.SetProviderClientID(ctx, "tendermint-07")
+		consumerKeeper.ApplyCCValidatorChanges(ctx, []abci.ValidatorUpdate{tmtypes.TM2PB.ValidatorUpdate(validator)})
+		consumerKeeper.SetHeightValsetUpdateID(ctx, 10, 1)
+		if channelEstablished {
+			consumerKeeper.SetProviderChannel(ctx, "channel-0")
+			consumerKeeper.SetLastTransmissionBlockHeight(ctx, consumertypes.LastTransmissionBlockHeight{Height: 5})
+			// a slash packet bounced and waits for a retry
+			consumerKeeper.SetSlashRecord(ctx, consumertypes.NewSlashRecord(ctx.BlockTime(), false))
+		}
+		consumerKeeper.QueueSlashPacket(ctx, abciValidator, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+		consumerKeeper.QueueSlashPacket(ctx.WithBlockHeight(11), abciValidator, 1, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+
+		exported := consumerKeeper.ExportGenesis(ctx)
+		require.NoError(t, exported.Validate())
+		require.Len(t, exported.PendingConsumerPackets.List, 2)
+		require.Len(t, exported.OutstandingDowntimeSlashing, 1)
+		require.Equal(t, channelEstablished, exported.SlashRecord != nil)
+
+		// restart the chain from the exported genesis at a later block
+		restartedKeeper, restartedCtx, restartedCtrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		defer restartedCtrl.Finish()
+		restartedCtx = restartedCtx.WithBlockHeight(100).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+		restartedKeeper.InitGenesis(restartedCtx, exported)
+
+		// the enqueue records of the pending packets are kept
+		packets := restartedKeeper.GetAllPendingPacketsWithIdx(restartedCtx)
+		require.Len(t, packets, 2)
+		record, found := restartedKeeper.GetPendingPacketEnqueueRecord(restartedCtx, packets[1].Idx)
+		require.True(t, found)
+		require.Equal(t, int64(11), record.Height)
+
+		// the outstanding downtime prevents queueing another downtime slash packet
+		require.True(t, restartedKeeper.OutstandingDowntime(restartedCtx, sdk.ConsAddress(validator.Address)))
+
+		// the retry of the bounced slash packet is still throttled
+		_, found = restartedKeeper.GetSlashRecord(restartedCtx)
+		require.Equal(t, channelEstablished, found)
+
+		require.Equal(t, exported, restartedKeeper.ExportGenesis(restartedCtx))
+	}
+}
+
+// TestInitGenesisVerifiesGenesisHash tests that a consumer chain that opted in to the genesis hash
+// verification refuses to start if the genesis hash it committed to does not match its genesis
+func TestInitGenesisVerifiesGenesisHash(t *testing.T) {
+	genesisHash := []byte("genesis_hash")
+
+	// the CCV module is disabled, so that InitGenesis only sets the params
+	params := ccv.DefaultParams()
+	params.Enabled = false
+
+	testCases := []struct {
+		name        string
+		genesisHash []byte
+		verify      bool
+		setup       func(sdk.Context) sdk.Context
+		expPanic    bool
+	}{
+		{
+			"no genesis hash committed to",
+			nil,
+			false,
+			func(ctx sdk.Context) sdk.Context { return ctx },
+			false,
+		},
+		{
+			"genesis hash not verified",
+			genesisHash,
+			false,
+			func(ctx sdk.Context) sdk.Context {
+				return consumertypes.WithGenesisHash(ctx, []byte("other_hash"))
+			},
+			false,
+		},
+		{
+			"genesis hash not provided by the application",
+			genesisHash,
+			true,
+			func(ctx sdk.Context) sdk.Context { return ctx },
+			true,
+		},
+		{
+			"genesis hash mismatch",
+			genesisHash,
+			true,
+			func(ctx sdk.Context) sdk.Context {
+				return consumertypes.WithGenesisHash(ctx, []byte("other_hash"))
+			},
+			true,
+		},
+		{
+			"genesis hash match",
+			genesisHash,
+			true,
+			func(ctx sdk.Context) sdk.Context {
+				return consumertypes.WithGenesisHash(ctx, genesisHash)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			genesis := consumertypes.DefaultGenesisState()
+			genesis.Params = params
+			genesis.GenesisHash = tc.genesisHash
+			genesis.VerifyGenesisHash = tc.verify
+
+			ctx = tc.setup(ctx)
+			if tc.expPanic {
+				require.Panics(t, func() { consumerKeeper.InitGenesis(ctx, genesis) })
+			} else {
+				require.NotPanics(t, func() { consumerKeeper.InitGenesis(ctx, genesis) })
+			}
+		})
+	}
+}
+
 // assert that the given client ID matches the provider client ID in the store
 func assertProviderClientID(t *testing.T, ctx sdk.Context, ck *consumerkeeper.Keeper, clientID string) {
 	t.Helper()
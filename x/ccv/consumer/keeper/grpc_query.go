@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
@@ -56,6 +60,10 @@ func (k Keeper) QueryProviderInfo(c context.Context, //nolint:golint
 func (k Keeper) QueryThrottleState(c context.Context,
 	req *types.QueryThrottleStateRequest,
 ) (*types.QueryThrottleStateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(c)
 
 	resp := types.QueryThrottleStateResponse{}
@@ -68,9 +76,138 @@ func (k Keeper) QueryThrottleState(c context.Context,
 	}
 
 	resp.PacketDataQueue = make([]ccvtypes.ConsumerPacketData, 0)
+	resp.PendingPackets = make([]types.PendingPacketInfo, 0)
 	pendingPackets := k.GetAllPendingPacketsWithIdx(ctx)
 	for _, packet := range pendingPackets {
 		resp.PacketDataQueue = append(resp.PacketDataQueue, packet.ConsumerPacketData)
+		resp.PendingPackets = append(resp.PendingPackets, k.getPendingPacketInfo(ctx, packet))
+	}
+
+	resp.RetryDelayPeriod = k.GetRetryDelayPeriod(ctx)
+	resp.PacketSendingPermitted = k.PacketSendingPermitted(ctx)
+	if !resp.PacketSendingPermitted {
+		// sending is only blocked while there is a slash record
+		if slashRecord.WaitingOnReply {
+			resp.BlockedReason = fmt.Sprintf("waiting on the reply of the provider to the slash packet sent at %s", slashRecord.SendTime)
+		} else {
+			nextSendTime := slashRecord.SendTime.Add(resp.RetryDelayPeriod)
+			resp.NextSendTime = &nextSendTime
+			resp.BlockedReason = fmt.Sprintf("the slash packet sent at %s bounced and is retried after %s", slashRecord.SendTime, nextSendTime)
+		}
 	}
 	return &resp, nil
 }
+
+// getPendingPacketInfo returns the details of the given packet in the pending packets queue
+func (k Keeper) getPendingPacketInfo(ctx sdk.Context, packet ConsumerPacketDataWithIdx) types.PendingPacketInfo {
+	info := types.PendingPacketInfo{
+		Index: packet.Idx,
+		Type:  packet.Type,
+	}
+	switch packet.Type {
+	case ccvtypes.SlashPacket:
+		data := packet.GetSlashPacketData()
+		info.ValidatorAddress = k.ConsAddressToString(data.Validator.Address)
+		info.Infraction = data.Infraction
+		info.ValsetUpdateId = data.ValsetUpdateId
+	case ccvtypes.VscMaturedPacket:
+		info.ValsetUpdateId = packet.GetVscMaturedPacketData().ValsetUpdateId
+	}
+
+	if record, found := k.GetPendingPacketEnqueueRecord(ctx, packet.Idx); found {
+		info.EnqueueHeight = record.Height
+		info.EnqueueTime = &record.Time
+		age := ctx.BlockTime().Sub(record.Time)
+		info.Age = &age
+	}
+	return info
+}
+
+func (k Keeper) QueryProviderClientExpiry(c context.Context,
+	req *types.QueryProviderClientExpiryRequest,
+) (*types.QueryProviderClientExpiryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	expiry, err := k.GetProviderClientExpiry(ctx)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &types.QueryProviderClientExpiryResponse{ClientExpiry: expiry}, nil
+}
+
+func (k Keeper) QueryProviderIbcIds(c context.Context,
+	req *types.QueryProviderIbcIdsRequest,
+) (*types.QueryProviderIbcIdsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	clientId, found := k.GetProviderClientID(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, ccvtypes.ErrClientNotFound.Error())
+	}
+	channelId, _ := k.GetProviderChannel(ctx)
+	return &types.QueryProviderIbcIdsResponse{ClientId: clientId, ChannelId: channelId}, nil
+}
+
+func (k Keeper) QueryRedistributionFractions(c context.Context,
+	req *types.QueryRedistributionFractionsRequest,
+) (*types.QueryRedistributionFractionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	frac, overrides := k.GetRedistributionFractions(ctx)
+
+	// the denoms with an override or with a balance in the fee pool
+	denoms := make([]string, 0, len(overrides))
+	for denom := range overrides {
+		denoms = append(denoms, denom)
+	}
+	consumerFeePoolAddr := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
+	for _, balance := range k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr) {
+		if _, found := overrides[balance.Denom]; !found {
+			denoms = append(denoms, balance.Denom)
+		}
+	}
+	sort.Strings(denoms)
+
+	splits := make([]types.RedistributionSplit, 0, len(denoms))
+	for _, denom := range denoms {
+		denomFrac, overridden := overrides[denom]
+		if !overridden {
+			denomFrac = frac
+		}
+		splits = append(splits, types.RedistributionSplit{
+			Denom:            denom,
+			ConsumerFraction: denomFrac.String(),
+			ProviderFraction: math.LegacyOneDec().Sub(denomFrac).String(),
+			Overridden:       overridden,
+		})
+	}
+
+	return &types.QueryRedistributionFractionsResponse{
+		DefaultFraction: frac.String(),
+		Splits:          splits,
+	}, nil
+}
+
+func (k Keeper) QueryProviderValsetVerification(c context.Context,
+	req *types.QueryProviderValsetVerificationRequest,
+) (*types.QueryProviderValsetVerificationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	verification, found := k.GetProviderValsetVerification(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no provider valset verification")
+	}
+	return &types.QueryProviderValsetVerificationResponse{Verification: verification}, nil
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestQueryCoverage exercises every query of the consumer gRPC service with valid, empty and malformed requests
+// against a populated keeper. A new query fails this test until a case is added for it.
+func TestQueryCoverage(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+
+	// the CCV channel is established and the provider client is alive
+	consumerKeeper.SetProviderClientID(ctx, "07-tendermint-0")
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+	clientState := ibctm.NewClientState("provider", ibctm.DefaultTrustLevel, 14*24*time.Hour, 21*24*time.Hour,
+		time.Minute, clienttypes.NewHeight(0, 1), nil, nil)
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ConsumerPortID, "channel-0").Return(channeltypes.Channel{
+		State:          channeltypes.OPEN,
+		Ordering:       channeltypes.ORDERED,
+		Counterparty:   channeltypes.NewCounterparty(ccv.ProviderPortID, "channel-1"),
+		ConnectionHops: []string{"connection-0"},
+	}, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetChannelConnection(gomock.Any(), ccv.ConsumerPortID, "channel-0").Return("connection-0", conntypes.ConnectionEnd{
+		ClientId:     "07-tendermint-0",
+		Counterparty: conntypes.Counterparty{ClientId: "07-tendermint-1", ConnectionId: "connection-1"},
+	}, nil).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "07-tendermint-0").Return(clientState, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(gomock.Any(), "07-tendermint-0").Return(
+		&ibctm.ConsensusState{Timestamp: now}, true).AnyTimes()
+
+	// the fee pool holds rewards
+	feePool := authtypes.NewEmptyModuleAccount(authtypes.FeeCollectorName)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(gomock.Any(), authtypes.FeeCollectorName).Return(feePool).AnyTimes()
+	mocks.MockBankKeeper.EXPECT().GetAllBalances(gomock.Any(), feePool.GetAddress()).Return(
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).AnyTimes()
+
+	// a slash packet is pending and the provider valset was verified
+	consumerKeeper.QueueSlashPacket(ctx, abci.Validator{Address: []byte{0x01}, Power: 1}, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.SetProviderValsetVerification(ctx, types.ProviderValsetVerification{
+		ValsetUpdateId:             1,
+		LastReceivedValsetUpdateId: 1,
+		Match:                      true,
+	})
+
+	testkeeper.RunQueryCoverage(t, ctx, func(s grpc.ServiceRegistrar) { types.RegisterQueryServer(s, consumerKeeper) }, map[string]testkeeper.QueryCase{
+		"QueryNextFeeDistribution": {
+			Valid:     &types.QueryNextFeeDistributionEstimateRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryParams": {
+			Valid:     &types.QueryParamsRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryProviderInfo": {
+			Valid:     &types.QueryProviderInfoRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryThrottleState": {
+			Valid:     &types.QueryThrottleStateRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryProviderClientExpiry": {
+			Valid:     &types.QueryProviderClientExpiryRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryProviderIbcIds": {
+			Valid:     &types.QueryProviderIbcIdsRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryRedistributionFractions": {
+			Valid:     &types.QueryRedistributionFractionsRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryProviderValsetVerification": {
+			Valid:     &types.QueryProviderValsetVerificationRequest{},
+			EmptyCode: codes.OK,
+		},
+	})
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -20,6 +22,38 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
+// AfterVSCApplied calls the AfterVSCApplied CCV hook, if set. The hook is executed
+// in a cached context, i.e., its state changes are discarded if it fails,
+// as a failing hook must not prevent the validator set changes from being applied.
+func (k Keeper) AfterVSCApplied(ctx sdk.Context, valsetUpdateId uint64, updates []abci.ValidatorUpdate) {
+	if k.ccvHooks == nil {
+		return
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := k.ccvHooks.AfterVSCApplied(cachedCtx, valsetUpdateId, updates); err != nil {
+		k.Logger(ctx).Error("AfterVSCApplied hook failed", "vscID", valsetUpdateId, "error", err)
+		return
+	}
+	writeCache()
+}
+
+// AfterSlashPacketAcked calls the AfterSlashPacketAcked CCV hook, if set. The hook is executed
+// in a cached context, i.e., its state changes are discarded if it fails,
+// as a failing hook must not prevent the acknowledgement from being handled.
+func (k Keeper) AfterSlashPacketAcked(ctx sdk.Context, data ccv.SlashPacketData) {
+	if k.ccvHooks == nil {
+		return
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := k.ccvHooks.AfterSlashPacketAcked(cachedCtx, data); err != nil {
+		k.Logger(ctx).Error("AfterSlashPacketAcked hook failed", "vscID", data.ValsetUpdateId, "error", err)
+		return
+	}
+	writeCache()
+}
+
 func (k Keeper) AfterValidatorBonded(ctx context.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	if k.hooks != nil {
 		err := k.hooks.AfterValidatorBonded(ctx, consAddr, nil)
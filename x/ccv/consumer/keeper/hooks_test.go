@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// recordingCCVHooks records the calls of the CCV hooks and emits an event for every call,
+// failing after emitting the event if err is set
+type recordingCCVHooks struct {
+	vscIds      []uint64
+	slashedData []types.SlashPacketData
+	err         error
+}
+
+func (h *recordingCCVHooks) AfterVSCApplied(ctx sdk.Context, valsetUpdateId uint64, _ []abci.ValidatorUpdate) error {
+	h.vscIds = append(h.vscIds, valsetUpdateId)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("after_vsc_applied"))
+	return h.err
+}
+
+func (h *recordingCCVHooks) AfterSlashPacketAcked(ctx sdk.Context, data types.SlashPacketData) error {
+	h.slashedData = append(h.slashedData, data)
+	ctx.EventManager().EmitEvent(sdk.NewEvent("after_slash_packet_acked"))
+	return h.err
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
+
+// TestAfterSlashPacketAckedHook tests that the AfterSlashPacketAcked hook is only called
+// for the slash packets handled by the provider chain
+func TestAfterSlashPacketAckedHook(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	hooks := &recordingCCVHooks{}
+	consumerKeeper.SetCCVHooks(consumertypes.NewMultiCCVHooks(hooks))
+
+	// a bounced slash packet is not confirmed by the provider chain
+	setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+	packet := channeltypes.Packet{Data: consumerKeeper.GetPendingPackets(ctx)[0].GetBytes()}
+	err := consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(types.SlashPacketBouncedResult))
+	require.NoError(t, err)
+	require.Empty(t, hooks.slashedData)
+
+	// a handled slash packet calls the hook
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(types.SlashPacketHandledResult))
+	require.NoError(t, err)
+	require.Len(t, hooks.slashedData, 1)
+	require.Equal(t, uint64(88), hooks.slashedData[0].ValsetUpdateId)
+	require.Equal(t, stakingtypes.Infraction_INFRACTION_DOWNTIME, hooks.slashedData[0].Infraction)
+	require.Equal(t, 1, countEvents(ctx, "after_slash_packet_acked"))
+
+	// a v1 result calls the hook
+	setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+	packet = channeltypes.Packet{Data: consumerKeeper.GetPendingPackets(ctx)[0].GetBytes()}
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(types.V1Result))
+	require.NoError(t, err)
+	require.Len(t, hooks.slashedData, 2)
+
+	// a failing hook neither fails the acknowledgement nor commits its state changes
+	hooks.err = fmt.Errorf("hook error")
+	setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+	packet = channeltypes.Packet{Data: consumerKeeper.GetPendingPackets(ctx)[0].GetBytes()}
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(types.SlashPacketHandledResult))
+	require.NoError(t, err)
+	require.Len(t, hooks.slashedData, 3)
+	require.Equal(t, 2, countEvents(ctx, "after_slash_packet_acked"))
+	_, found := consumerKeeper.GetSlashRecord(ctx)
+	require.False(t, found)
+}
+
+// TestAfterVSCAppliedHook tests that the AfterVSCApplied hook is called, if set,
+// and that the state changes of a failing hook are discarded
+func TestAfterVSCAppliedHook(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// no hooks are set
+	consumerKeeper.AfterVSCApplied(ctx, 1, []abci.ValidatorUpdate{})
+
+	hooks := &recordingCCVHooks{}
+	consumerKeeper.SetCCVHooks(hooks)
+	require.Panics(t, func() { consumerKeeper.SetCCVHooks(hooks) })
+
+	consumerKeeper.AfterVSCApplied(ctx, 2, []abci.ValidatorUpdate{})
+	require.Equal(t, []uint64{2}, hooks.vscIds)
+	require.Equal(t, 1, countEvents(ctx, "after_vsc_applied"))
+
+	hooks.err = fmt.Errorf("hook error")
+	consumerKeeper.AfterVSCApplied(ctx, 3, []abci.ValidatorUpdate{})
+	require.Equal(t, []uint64{2, 3}, hooks.vscIds)
+	require.Equal(t, 1, countEvents(ctx, "after_vsc_applied"))
+}
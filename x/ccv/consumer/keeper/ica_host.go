@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetICAHost integrates the interchain accounts host of the consumer chain with the consumer module,
+// i.e., the messages allowed by the host are validated against msgRouter, both when the host params
+// are updated (see NewICAHostMsgServer) and by the ICAHostAllowMessagesInvariant.
+//
+// This method is optional and must be called in app.go, before the consumer module is created.
+func (k *Keeper) SetICAHost(icaHostKeeper ccv.ICAHostKeeper, msgRouter baseapp.MessageRouter) {
+	k.icaHostKeeper = icaHostKeeper
+	k.icaHostMsgRouter = msgRouter
+}
+
+// RegisterStakingQueryServer registers the staking queries on queryRouter, unless x/staking registered them,
+// so that the modules relying on the staking queries, e.g., the interchain accounts host, can be used
+// on a consumer chain without x/staking. The queries expose the CCV validator set (see NewStakingQueryServer).
+//
+// This method is optional and must be called in app.go, after the services of the modules are registered,
+// as otherwise the staking queries registered by x/staking cannot be detected.
+func (k Keeper) RegisterStakingQueryServer(queryRouter *baseapp.GRPCQueryRouter) {
+	if queryRouter.Route("/cosmos.staking.v1beta1.Query/Validators") == nil {
+		stakingtypes.RegisterQueryServer(queryRouter, NewStakingQueryServer(k))
+	}
+}
+
+// ValidateICAHostAllowMessages validates the messages allowed by the interchain accounts host, if it is set
+func (k Keeper) ValidateICAHostAllowMessages(ctx sdk.Context) error {
+	if k.icaHostKeeper == nil || k.icaHostMsgRouter == nil {
+		return nil
+	}
+	params, found := k.getICAHostParams(ctx)
+	if !found {
+		return nil
+	}
+	return types.ValidateICAHostAllowMessages(params.AllowMessages, k.icaHostMsgRouter)
+}
+
+// getICAHostParams returns the params of the interchain accounts host and false if they are not set,
+// e.g., when the invariants are asserted before the genesis of the host is initialized
+func (k Keeper) getICAHostParams(ctx sdk.Context) (params icahosttypes.Params, found bool) {
+	defer func() {
+		// the host keeper panics on unset params
+		if r := recover(); r != nil {
+			found = false
+		}
+	}()
+	return k.icaHostKeeper.GetParams(ctx), true
+}
+
+var _ icahosttypes.MsgServer = icaHostMsgServer{}
+
+// icaHostMsgServer wraps the msg server of the interchain accounts host
+// to validate the messages allowed by the host when its params are updated
+type icaHostMsgServer struct {
+	icahosttypes.MsgServer
+	k Keeper
+}
+
+// NewICAHostMsgServer returns the msg server of the interchain accounts host wrapping msgServer,
+// which rejects the params updates allowing messages that are invalid on a consumer chain
+// (see ValidateICAHostAllowMessages). It must be registered instead of the msg server of the host,
+// once the host is set (see SetICAHost).
+func NewICAHostMsgServer(k Keeper, msgServer icahosttypes.MsgServer) icahosttypes.MsgServer {
+	return icaHostMsgServer{MsgServer: msgServer, k: k}
+}
+
+// UpdateParams validates the messages allowed by the new params of the host before updating them
+func (s icaHostMsgServer) UpdateParams(goCtx context.Context, msg *icahosttypes.MsgUpdateParams) (*icahosttypes.MsgUpdateParamsResponse, error) {
+	if s.k.icaHostMsgRouter != nil {
+		if err := types.ValidateICAHostAllowMessages(msg.Params.AllowMessages, s.k.icaHostMsgRouter); err != nil {
+			return nil, err
+		}
+	}
+	return s.MsgServer.UpdateParams(goCtx, msg)
+}
+
+var _ stakingtypes.QueryServer = &stakingQueryServer{}
+
+// stakingQueryServer exposes the CCV validator set through the staking queries
+// on consumer chains without x/staking; the queries that are not meaningful
+// on a consumer chain, e.g., the delegation queries, are unimplemented
+type stakingQueryServer struct {
+	stakingtypes.UnimplementedQueryServer
+	k Keeper
+}
+
+// NewStakingQueryServer returns a staking query server that exposes the CCV validator set
+func NewStakingQueryServer(k Keeper) stakingtypes.QueryServer {
+	return &stakingQueryServer{k: k}
+}
+
+// Validators returns the CCV validators as bonded validators
+func (s stakingQueryServer) Validators(goCtx context.Context, req *stakingtypes.QueryValidatorsRequest) (*stakingtypes.QueryValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validators := []stakingtypes.Validator{}
+	if req.Status == "" || req.Status == stakingtypes.Bonded.String() {
+		validators = s.k.getCCValidatorsAsStakingValidators(ctx)
+	}
+
+	return &stakingtypes.QueryValidatorsResponse{
+		Validators: validators,
+		Pagination: &query.PageResponse{Total: uint64(len(validators))},
+	}, nil
+}
+
+// Validator returns the CCV validator with the given operator address
+func (s stakingQueryServer) Validator(goCtx context.Context, req *stakingtypes.QueryValidatorRequest) (*stakingtypes.QueryValidatorResponse, error) {
+	if req == nil || req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, val := range s.k.getCCValidatorsAsStakingValidators(ctx) {
+		if val.OperatorAddress == req.ValidatorAddr {
+			return &stakingtypes.QueryValidatorResponse{Validator: val}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+}
+
+// HistoricalInfo returns the CCV validator set at the given height
+func (s stakingQueryServer) HistoricalInfo(goCtx context.Context, req *stakingtypes.QueryHistoricalInfoRequest) (*stakingtypes.QueryHistoricalInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be negative")
+	}
+
+	hi, err := s.k.GetHistoricalInfo(goCtx, req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "historical info for height %d not found", req.Height)
+	}
+
+	return &stakingtypes.QueryHistoricalInfoResponse{Hist: &hi}, nil
+}
+
+// Params returns the staking params that are meaningful on a consumer chain,
+// i.e., the unbonding period and the number of historical entries of the consumer chain
+func (s stakingQueryServer) Params(goCtx context.Context, req *stakingtypes.QueryParamsRequest) (*stakingtypes.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &stakingtypes.QueryParamsResponse{
+		Params: stakingtypes.Params{
+			UnbondingTime:     s.k.GetUnbondingPeriod(ctx),
+			HistoricalEntries: uint32(s.k.GetHistoricalEntries(ctx)),
+		},
+	}, nil
+}
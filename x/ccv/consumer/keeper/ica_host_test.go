@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// TestStakingQueryServer tests that the staking queries expose the CCV validator set
+func TestStakingQueryServer(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerParams := consumerKeeper.GetConsumerParams(ctx)
+	consumerParams.UnbondingPeriod = 2 * time.Hour
+	consumerParams.HistoricalEntries = 10
+	consumerKeeper.SetParams(ctx, consumerParams)
+
+	val1, val2 := testkeeper.GetNewCrossChainValidator(t), testkeeper.GetNewCrossChainValidator(t)
+	consumerKeeper.SetCCValidator(ctx, val1)
+	consumerKeeper.SetCCValidator(ctx, val2)
+
+	server := keeper.NewStakingQueryServer(consumerKeeper)
+
+	// all the CCV validators are bonded
+	validatorsResp, err := server.Validators(ctx, &stakingtypes.QueryValidatorsRequest{})
+	require.NoError(t, err)
+	require.Len(t, validatorsResp.Validators, 2)
+	for _, val := range validatorsResp.Validators {
+		require.Equal(t, stakingtypes.Bonded, val.Status)
+	}
+	validatorsResp, err = server.Validators(ctx, &stakingtypes.QueryValidatorsRequest{Status: stakingtypes.Unbonded.String()})
+	require.NoError(t, err)
+	require.Empty(t, validatorsResp.Validators)
+
+	// a CCV validator can be queried by its operator address
+	operatorAddr := sdk.ValAddress(val1.Address).String()
+	validatorResp, err := server.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: operatorAddr})
+	require.NoError(t, err)
+	require.Equal(t, operatorAddr, validatorResp.Validator.OperatorAddress)
+	require.Equal(t, sdk.TokensFromConsensusPower(val1.Power, sdk.DefaultPowerReduction), validatorResp.Validator.Tokens)
+	_, err = server.Validator(ctx, &stakingtypes.QueryValidatorRequest{ValidatorAddr: sdk.ValAddress([]byte("unknown")).String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// the historical info is the one tracked by the consumer keeper
+	ctx = ctx.WithBlockHeight(5)
+	err = consumerKeeper.TrackHistoricalInfo(ctx)
+	require.NoError(t, err)
+	historicalInfoResp, err := server.HistoricalInfo(ctx, &stakingtypes.QueryHistoricalInfoRequest{Height: 5})
+	require.NoError(t, err)
+	require.Len(t, historicalInfoResp.Hist.Valset, 2)
+	_, err = server.HistoricalInfo(ctx, &stakingtypes.QueryHistoricalInfoRequest{Height: 4})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	paramsResp, err := server.Params(ctx, &stakingtypes.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2*time.Hour, paramsResp.Params.UnbondingTime)
+	require.Equal(t, uint32(10), paramsResp.Params.HistoricalEntries)
+}
+
+// TestSetICAHost tests that setting the ICA host validates the messages allowed by the host
+func TestSetICAHost(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// without ICA host, there is nothing to validate
+	require.NoError(t, consumerKeeper.ValidateICAHostAllowMessages(ctx))
+
+	icaHostKeeper := testkeeper.NewMockICAHostKeeper(ctrl)
+	consumerKeeper.SetICAHost(icaHostKeeper, baseapp.NewMsgServiceRouter())
+
+	// the host params are not set before the genesis of the host is initialized
+	icaHostKeeper.EXPECT().GetParams(gomock.Any()).Do(func(sdk.Context) {
+		panic("ica/host params are not set in store")
+	})
+	require.NoError(t, consumerKeeper.ValidateICAHostAllowMessages(ctx))
+
+	icaHostKeeper.EXPECT().GetParams(gomock.Any()).Return(
+		icahosttypes.NewParams(true, []string{types.AllowAllICAHostMessages}))
+	require.NoError(t, consumerKeeper.ValidateICAHostAllowMessages(ctx))
+
+	// the msg router has no handler for the bank messages
+	icaHostKeeper.EXPECT().GetParams(gomock.Any()).Return(
+		icahosttypes.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"})).Times(2)
+	require.ErrorIs(t, consumerKeeper.ValidateICAHostAllowMessages(ctx), types.ErrInvalidICAHostAllowMessages)
+	_, broken := keeper.ICAHostAllowMessagesInvariant(consumerKeeper)(ctx)
+	require.True(t, broken)
+}
+
+// TestRegisterStakingQueryServer tests that the staking queries are registered
+// unless they are already registered, e.g., by x/staking
+func TestRegisterStakingQueryServer(t *testing.T) {
+	consumerKeeper, _, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	queryRouter := baseapp.NewGRPCQueryRouter()
+	queryRouter.SetInterfaceRegistry(codectypes.NewInterfaceRegistry())
+	require.Nil(t, queryRouter.Route("/cosmos.staking.v1beta1.Query/Validators"))
+
+	consumerKeeper.RegisterStakingQueryServer(queryRouter)
+	require.NotNil(t, queryRouter.Route("/cosmos.staking.v1beta1.Query/Validators"))
+
+	// registering the queries again would panic
+	require.NotPanics(t, func() { consumerKeeper.RegisterStakingQueryServer(queryRouter) })
+}
+
+// mockICAHostMsgServer records the params updates of the ICA host
+type mockICAHostMsgServer struct {
+	icahosttypes.MsgServer
+	updates []icahosttypes.Params
+}
+
+func (s *mockICAHostMsgServer) UpdateParams(_ context.Context, msg *icahosttypes.MsgUpdateParams) (*icahosttypes.MsgUpdateParamsResponse, error) {
+	s.updates = append(s.updates, msg.Params)
+	return &icahosttypes.MsgUpdateParamsResponse{}, nil
+}
+
+// TestICAHostMsgServer tests that the params updates of the ICA host
+// allowing invalid messages on a consumer chain are rejected
+func TestICAHostMsgServer(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	hostMsgServer := &mockICAHostMsgServer{}
+
+	// without ICA host, the updates are not validated
+	msgServer := keeper.NewICAHostMsgServer(consumerKeeper, hostMsgServer)
+	_, err := msgServer.UpdateParams(ctx, &icahosttypes.MsgUpdateParams{
+		Params: icahosttypes.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}),
+	})
+	require.NoError(t, err)
+	require.Len(t, hostMsgServer.updates, 1)
+
+	consumerKeeper.SetICAHost(testkeeper.NewMockICAHostKeeper(ctrl), baseapp.NewMsgServiceRouter())
+	msgServer = keeper.NewICAHostMsgServer(consumerKeeper, hostMsgServer)
+
+	// the msg router has no handler for the bank messages
+	_, err = msgServer.UpdateParams(ctx, &icahosttypes.MsgUpdateParams{
+		Params: icahosttypes.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidICAHostAllowMessages)
+
+	// the staking messages are unsafe on a consumer chain
+	_, err = msgServer.UpdateParams(ctx, &icahosttypes.MsgUpdateParams{
+		Params: icahosttypes.NewParams(true, []string{"/cosmos.staking.v1beta1.MsgDelegate"}),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidICAHostAllowMessages)
+	require.Len(t, hostMsgServer.updates, 1)
+
+	_, err = msgServer.UpdateParams(ctx, &icahosttypes.MsgUpdateParams{
+		Params: icahosttypes.NewParams(true, []string{types.AllowAllICAHostMessages}),
+	})
+	require.NoError(t, err)
+	require.Len(t, hostMsgServer.updates, 2)
+}
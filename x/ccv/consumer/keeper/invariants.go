@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// RegisterInvariants registers all consumer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "pending-packets",
+		PendingPacketsInvariant(k))
+
+	ir.RegisterRoute(types.ModuleName, "height-to-valset-update-id",
+		HeightToValsetUpdateIDInvariant(k))
+
+	ir.RegisterRoute(types.ModuleName, "ica-host-allow-messages",
+		ICAHostAllowMessagesInvariant(k))
+}
+
+// AllInvariants runs all consumer invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := PendingPacketsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = HeightToValsetUpdateIDInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ICAHostAllowMessagesInvariant(k)(ctx)
+	}
+}
+
+// PendingPacketsInvariant checks that the pending packets are consistent with the slash record,
+// i.e., if a slash record exists, then the slash packet it refers to is at the head of the
+// pending packets queue, as it is only removed from the queue once the provider handles it
+func PendingPacketsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if _, found := k.GetSlashRecord(ctx); !found {
+			return "", false
+		}
+
+		pendingPackets := k.GetPendingPackets(ctx)
+		if len(pendingPackets) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "pending-packets",
+				"slash record exists, but there are no pending packets"), true
+		}
+		if pendingPackets[0].Type != ccv.SlashPacket {
+			return sdk.FormatInvariant(types.ModuleName, "pending-packets",
+				fmt.Sprintf("slash record exists, but the head of the pending packets is a %s", pendingPackets[0].Type)), true
+		}
+
+		return "", false
+	}
+}
+
+// HeightToValsetUpdateIDInvariant checks that the block height to valset update ID mapping is monotonic,
+// i.e., a block height is never mapped to a valset update ID smaller than the one of a previous block height
+func HeightToValsetUpdateIDInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// the mappings are returned in ascending order of heights
+		heightToValsetUpdateIDs := k.GetAllHeightToValsetUpdateIDs(ctx)
+		for i := 1; i < len(heightToValsetUpdateIDs); i++ {
+			prev, curr := heightToValsetUpdateIDs[i-1], heightToValsetUpdateIDs[i]
+			if curr.ValsetUpdateId < prev.ValsetUpdateId {
+				return sdk.FormatInvariant(types.ModuleName, "height-to-valset-update-id",
+					fmt.Sprintf("height %d is mapped to valset update ID %d, which is smaller than %d of height %d",
+						curr.Height, curr.ValsetUpdateId, prev.ValsetUpdateId, prev.Height)), true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ICAHostAllowMessagesInvariant checks that the messages allowed by the interchain accounts host,
+// if it is set (see SetICAHost), have a handler and are safe on a consumer chain
+func ICAHostAllowMessagesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if err := k.ValidateICAHostAllowMessages(ctx); err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "ica-host-allow-messages", err.Error()), true
+		}
+
+		return "", false
+	}
+}
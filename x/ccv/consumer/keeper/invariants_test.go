@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestPendingPacketsInvariant(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	invariant := keeper.PendingPacketsInvariant(consumerKeeper)
+
+	slashPacketData := &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: ccv.NewSlashPacketData(
+			abci.Validator{Address: []byte("validator"), Power: 1},
+			1,
+			stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		),
+	}
+	vscMaturedPacketData := &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(1),
+	}
+
+	// no slash record and no pending packets
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// slash record, but no pending packets
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// slash record and a slash packet at the head of the pending packets
+	consumerKeeper.AppendPendingPacket(ctx, ccv.SlashPacket, slashPacketData)
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, vscMaturedPacketData)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// slash record, but a VSCMatured packet at the head of the pending packets
+	consumerKeeper.DeleteHeadOfPendingPackets(ctx)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// no slash record
+	consumerKeeper.ClearSlashRecord(ctx)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestHeightToValsetUpdateIDInvariant(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	invariant := keeper.HeightToValsetUpdateIDInvariant(consumerKeeper)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 10, 1)
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 11, 1)
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 15, 3)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 20, 2)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
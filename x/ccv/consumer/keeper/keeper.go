@@ -4,7 +4,10 @@ import (
 	"encoding/binary"
 	"fmt"
 	"reflect"
+	"strconv"
+	"strings"
 
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
@@ -15,6 +18,7 @@ import (
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -42,6 +46,7 @@ type Keeper struct {
 	standaloneStakingKeeper ccv.StakingKeeper
 	slashingKeeper          ccv.SlashingKeeper
 	hooks                   ccv.ConsumerHooks
+	ccvHooks                types.CCVHooks
 	bankKeeper              ccv.BankKeeper
 	authKeeper              ccv.AccountKeeper
 	ibcTransferKeeper       ccv.IBCTransferKeeper
@@ -50,6 +55,26 @@ type Keeper struct {
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+
+	// portID is the port the consumer CCV module binds to and
+	// counterpartyPortID is the port the provider CCV module is expected to bind to.
+	// Both default to the CCV port IDs and can be changed via SetPortIDs.
+	portID             string
+	counterpartyPortID string
+
+	// icaHostKeeper and providerAdminOwner are optionally set after the constructor
+	// to allow the interchain account owned by providerAdminOwner on the provider chain,
+	// e.g., the provider x/gov module account, to execute the authority-gated messages.
+	icaHostKeeper      ccv.ICAHostKeeper
+	providerAdminOwner string
+
+	// icaHostMsgRouter is the msg router of the interchain accounts host, which is optionally
+	// set after the constructor to validate the messages allowed by the host (see SetICAHost)
+	icaHostMsgRouter baseapp.MessageRouter
+
+	// doubleSignInterlock is the local (i.e., non-consensus) state of the double-sign interlock,
+	// which is optionally set after the constructor; it is a pointer as it is shared by all the copies of the keeper
+	doubleSignInterlock *doubleSignInterlock
 }
 
 // NewKeeper creates a new Consumer Keeper instance
@@ -80,6 +105,8 @@ func NewKeeper(
 		standaloneStakingKeeper: nil,
 		validatorAddressCodec:   validatorAddressCodec,
 		consensusAddressCodec:   consensusAddressCodec,
+		portID:                  ccv.ConsumerPortID,
+		counterpartyPortID:      ccv.ProviderPortID,
 	}
 
 	k.mustValidateFields()
@@ -110,13 +137,16 @@ func (k *Keeper) SetStandaloneStakingKeeper(sk ccv.StakingKeeper) {
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 16 {
-		panic("number of fields in consumer keeper is not 16")
+	if reflect.ValueOf(k).NumField() != 23 {
+		panic("number of fields in consumer keeper is not 23")
 	}
 
-	// Note 116 / 16 fields will be validated,
-	// hooks are explicitly set after the constructor,
+	// Note 16 / 23 fields will be validated,
+	// hooks and ccvHooks are explicitly set after the constructor,
 	// stakingKeeper is optionally set after the constructor,
+	// icaHostKeeper and providerAdminOwner are optionally set after the constructor,
+	// icaHostMsgRouter is optionally set after the constructor,
+	// doubleSignInterlock is optionally set after the constructor,
 
 	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                           // 1
 	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                                     // 2
@@ -132,6 +162,76 @@ func (k Keeper) mustValidateFields() {
 	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 14
 	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 15
 	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 16
+	ccv.PanicIfZeroOrNil(k.portID, "portID")                               // 17
+	ccv.PanicIfZeroOrNil(k.counterpartyPortID, "counterpartyPortID")       // 18
+}
+
+// SetPortIDs sets the port the consumer CCV module binds to and the port
+// the provider CCV module is expected to bind to. This method allows a single binary
+// to host multiple independent CCV instances and must be called in app.go,
+// before the IBC router is sealed. It panics if any of the port IDs is invalid.
+func (k *Keeper) SetPortIDs(portID, counterpartyPortID string) {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		panic(fmt.Errorf("invalid consumer port ID: %w", err))
+	}
+	if err := host.PortIdentifierValidator(counterpartyPortID); err != nil {
+		panic(fmt.Errorf("invalid provider port ID: %w", err))
+	}
+	k.portID = portID
+	k.counterpartyPortID = counterpartyPortID
+}
+
+// SetProviderAdmin allows the interchain account owned by owner on the provider chain
+// over the CCV connection, e.g., the account controlled by the provider x/gov module account,
+// to execute the authority-gated messages of the consumer module, in addition to the authority.
+// This method is optional and must be called in app.go, before the consumer module is created.
+func (k *Keeper) SetProviderAdmin(icaHostKeeper ccv.ICAHostKeeper, owner string) {
+	if strings.TrimSpace(owner) == "" {
+		panic("provider admin owner cannot be empty")
+	}
+	k.icaHostKeeper = icaHostKeeper
+	k.providerAdminOwner = owner
+}
+
+// GetProviderAdminAccount returns the address of the interchain account owned by the provider admin owner
+// over the CCV connection, if the provider admin is set and the interchain account is registered
+func (k Keeper) GetProviderAdminAccount(ctx sdk.Context) (string, bool) {
+	if k.icaHostKeeper == nil {
+		return "", false
+	}
+	channelID, found := k.GetProviderChannel(ctx)
+	if !found {
+		return "", false
+	}
+	connectionHops, err := k.GetConnectionHops(ctx, k.portID, channelID)
+	if err != nil || len(connectionHops) != 1 {
+		return "", false
+	}
+	portID, err := icatypes.NewControllerPortID(k.providerAdminOwner)
+	if err != nil {
+		return "", false
+	}
+	return k.icaHostKeeper.GetInterchainAccountAddress(ctx, connectionHops[0], portID)
+}
+
+// IsAuthority returns whether the address is allowed to execute the authority-gated messages,
+// i.e., whether it is the authority or the interchain account of the provider admin
+func (k Keeper) IsAuthority(ctx sdk.Context, address string) bool {
+	if address == k.authority {
+		return true
+	}
+	adminAccount, found := k.GetProviderAdminAccount(ctx)
+	return found && address == adminAccount
+}
+
+// PortID returns the port the consumer CCV module binds to
+func (k Keeper) PortID() string {
+	return k.portID
+}
+
+// CounterpartyPortID returns the port the provider CCV module is expected to bind to
+func (k Keeper) CounterpartyPortID() string {
+	return k.counterpartyPortID
 }
 
 // ValidatorAddressCodec returns the app validator address codec.
@@ -144,6 +244,12 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 	return k.consensusAddressCodec
 }
 
+// ConsAddressToString returns the string representation of the given consensus address,
+// encoded with the app consensus address codec.
+func (k Keeper) ConsAddressToString(addr []byte) string {
+	return ccv.MustAddressToString(k.consensusAddressCodec, addr)
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+host.SubModuleName+"-"+types.ModuleName)
@@ -161,6 +267,21 @@ func (k *Keeper) SetHooks(sh ccv.ConsumerHooks) *Keeper {
 	return k
 }
 
+// SetCCVHooks sets the hooks that other modules of the consumer app can register
+// to react to the validator set changes and the slashes confirmed by the provider chain.
+// Multiple hooks can be combined with types.NewMultiCCVHooks.
+func (k *Keeper) SetCCVHooks(hooks types.CCVHooks) *Keeper {
+	if k.ccvHooks != nil {
+		// This should never happen as SetCCVHooks is expected
+		// to be called only once in app.go
+		panic("cannot set CCV hooks twice")
+	}
+
+	k.ccvHooks = hooks
+
+	return k
+}
+
 // ChanCloseInit defines a wrapper function for the channel Keeper's function
 // Following ICS 004: https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics#closing-handshake
 func (k Keeper) ChanCloseInit(ctx sdk.Context, portID, channelID string) error {
@@ -218,6 +339,40 @@ func (k Keeper) DeleteProviderChannel(ctx sdk.Context) {
 	store.Delete(types.ProviderChannelIDKey())
 }
 
+// SetProviderFeatures sets the features supported by the provider chain,
+// replacing any previously set features
+func (k Keeper) SetProviderFeatures(ctx sdk.Context, features []string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, feature := range k.GetProviderFeatures(ctx) {
+		store.Delete(types.ProviderFeatureKey(feature))
+	}
+	for _, feature := range features {
+		store.Set(types.ProviderFeatureKey(feature), []byte{})
+	}
+}
+
+// GetProviderFeatures returns the features supported by the provider chain
+//
+// Note that the features are stored under keys prefixed with ProviderFeatureKeyPrefix.
+// Thus, the returned array is in ascending order of features.
+func (k Keeper) GetProviderFeatures(ctx sdk.Context) (features []string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ProviderFeatureKeyPrefix())
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		features = append(features, string(iterator.Key()[1:]))
+	}
+
+	return features
+}
+
+// IsProviderFeatureSupported returns true if the provider chain supports the given feature
+func (k Keeper) IsProviderFeatureSupported(ctx sdk.Context, feature string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ProviderFeatureKey(feature))
+}
+
 // SetPendingChanges sets the pending validator set change packet that haven't been flushed to ABCI
 func (k Keeper) SetPendingChanges(ctx sdk.Context, updates ccv.ValidatorSetChangePacketData) {
 	store := ctx.KVStore(k.storeKey)
@@ -382,6 +537,31 @@ func (k Keeper) GetAllHeightToValsetUpdateIDs(ctx sdk.Context) (heightToValsetUp
 	return heightToValsetUpdateIDs
 }
 
+// SetLastVSC sets the last VSC packet received from the provider chain
+func (k Keeper) SetLastVSC(ctx sdk.Context, lastVSC types.LastVSC) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := lastVSC.Marshal()
+	if err != nil {
+		// This should never happen
+		panic(fmt.Sprintf("could not marshal last VSC: %v", err))
+	}
+	store.Set(types.LastVSCKey(), bz)
+}
+
+// GetLastVSC gets the last VSC packet received from the provider chain
+func (k Keeper) GetLastVSC(ctx sdk.Context) (lastVSC types.LastVSC, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastVSCKey())
+	if bz == nil {
+		return lastVSC, false
+	}
+	if err := lastVSC.Unmarshal(bz); err != nil {
+		// This should never happen
+		panic(fmt.Sprintf("could not unmarshal last VSC: %v", err))
+	}
+	return lastVSC, true
+}
+
 // OutstandingDowntime returns the outstanding downtime flag for a given validator
 func (k Keeper) OutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -413,7 +593,7 @@ func (k Keeper) GetAllOutstandingDowntimes(ctx sdk.Context) (downtimes []types.O
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		addrBytes := iterator.Key()[1:]
-		addr := sdk.ConsAddress(addrBytes).String()
+		addr := k.ConsAddressToString(addrBytes)
 
 		downtimes = append(downtimes, types.OutstandingDowntime{
 			ValidatorConsensusAddress: addr,
@@ -490,6 +670,8 @@ func (k Keeper) DeleteHeadOfPendingPackets(ctx sdk.Context) {
 	if !iterator.Valid() {
 		return
 	}
+	// index stored in key after prefix, see PendingDataPacketsV1Key()
+	store.Delete(types.PendingPacketEnqueueRecordKey(sdk.BigEndianToUint64(iterator.Key()[1:])))
 	store.Delete(iterator.Key())
 }
 
@@ -542,6 +724,7 @@ func (k Keeper) DeletePendingDataPackets(ctx sdk.Context, idxs ...uint64) {
 	store := ctx.KVStore(k.storeKey)
 	for _, idx := range idxs {
 		store.Delete(types.PendingDataPacketsV1Key(idx))
+		store.Delete(types.PendingPacketEnqueueRecordKey(idx))
 	}
 }
 
@@ -555,11 +738,24 @@ func (k Keeper) DeleteAllPendingDataPackets(ctx sdk.Context) {
 	}
 	for _, key := range keysToDel {
 		store.Delete(key)
+		// index stored in key after prefix, see PendingDataPacketsV1Key()
+		store.Delete(types.PendingPacketEnqueueRecordKey(sdk.BigEndianToUint64(key[1:])))
 	}
 }
 
 // AppendPendingPacket enqueues the given data packet to the end of the pending data packets queue
 func (k Keeper) AppendPendingPacket(ctx sdk.Context, packetType ccv.ConsumerPacketDataType, data ccv.ExportedIsConsumerPacketData_Data) {
+	k.appendPendingPacket(ctx, packetType, data, types.PendingPacketEnqueueRecord{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+}
+
+// appendPendingPacket enqueues the given data packet to the end of the pending data packets queue
+// together with the record of when it was enqueued
+func (k Keeper) appendPendingPacket(
+	ctx sdk.Context,
+	packetType ccv.ConsumerPacketDataType,
+	data ccv.ExportedIsConsumerPacketData_Data,
+	record types.PendingPacketEnqueueRecord,
+) {
 	idx := k.getAndIncrementPendingPacketsIdx(ctx) // for FIFO queue
 	key := types.PendingDataPacketsV1Key(idx)
 	store := ctx.KVStore(k.storeKey)
@@ -570,6 +766,53 @@ func (k Keeper) AppendPendingPacket(ctx sdk.Context, packetType ccv.ConsumerPack
 		panic(fmt.Errorf("failed to marshal ConsumerPacketData: %w", err))
 	}
 	store.Set(key, bz)
+
+	bz, err = record.Marshal()
+	if err != nil {
+		// This should never happen
+		panic(fmt.Errorf("failed to marshal PendingPacketEnqueueRecord: %w", err))
+	}
+	store.Set(types.PendingPacketEnqueueRecordKey(idx), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketQueued,
+			append(packetEventAttributes(packetType, packetValsetUpdateId(cpd)),
+				sdk.NewAttribute(types.AttributePacketIndex, strconv.FormatUint(idx, 10)),
+			)...,
+		),
+	)
+}
+
+// GetPendingPacketEnqueueRecord returns when the packet with the given index
+// in the pending packets queue was added to the queue
+func (k Keeper) GetPendingPacketEnqueueRecord(ctx sdk.Context, idx uint64) (record types.PendingPacketEnqueueRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingPacketEnqueueRecordKey(idx))
+	if bz == nil {
+		return record, false
+	}
+	if err := record.Unmarshal(bz); err != nil {
+		// This should never happen
+		panic(fmt.Errorf("failed to unmarshal PendingPacketEnqueueRecord: %w", err))
+	}
+	return record, true
+}
+
+// GetAllPendingPacketEnqueueRecords returns the records of when the pending packets were enqueued,
+// in the order of the pending packets queue. It returns nil if the record of any pending packet is missing,
+// e.g., for packets enqueued before the records were introduced.
+func (k Keeper) GetAllPendingPacketEnqueueRecords(ctx sdk.Context) []types.PendingPacketEnqueueRecord {
+	packets := k.GetAllPendingPacketsWithIdx(ctx)
+	records := make([]types.PendingPacketEnqueueRecord, 0, len(packets))
+	for _, packet := range packets {
+		record, found := k.GetPendingPacketEnqueueRecord(ctx, packet.Idx)
+		if !found {
+			return nil
+		}
+		records = append(records, record)
+	}
+	return records
 }
 
 func (k Keeper) MarkAsPrevStandaloneChain(ctx sdk.Context) {
@@ -35,6 +35,43 @@ func TestProviderClientID(t *testing.T) {
 	require.Equal(t, "someClientID", clientID)
 }
 
+// TestPortIDs tests the port IDs the consumer keeper binds to and expects from the provider
+func TestPortIDs(t *testing.T) {
+	consumerKeeper, _, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// default port IDs
+	require.Equal(t, ccv.ConsumerPortID, consumerKeeper.PortID())
+	require.Equal(t, ccv.ProviderPortID, consumerKeeper.CounterpartyPortID())
+
+	// custom port IDs
+	consumerKeeper.SetPortIDs("consumer-1", "provider-1")
+	require.Equal(t, "consumer-1", consumerKeeper.PortID())
+	require.Equal(t, "provider-1", consumerKeeper.CounterpartyPortID())
+
+	// invalid port IDs
+	require.Panics(t, func() { consumerKeeper.SetPortIDs("", "provider-1") })
+	require.Panics(t, func() { consumerKeeper.SetPortIDs("consumer-1", "bad port") })
+}
+
+// TestProviderFeatures tests getter and setter functionality for the provider features stored on consumer keeper
+func TestProviderFeatures(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Empty(t, consumerKeeper.GetProviderFeatures(ctx))
+	require.False(t, consumerKeeper.IsProviderFeatureSupported(ctx, ccv.FeatureConsumerPacketDataV2))
+
+	consumerKeeper.SetProviderFeatures(ctx, []string{"featureB", ccv.FeatureConsumerPacketDataV2})
+	require.Equal(t, []string{ccv.FeatureConsumerPacketDataV2, "featureB"}, consumerKeeper.GetProviderFeatures(ctx))
+	require.True(t, consumerKeeper.IsProviderFeatureSupported(ctx, ccv.FeatureConsumerPacketDataV2))
+
+	// setting the features replaces the previous ones
+	consumerKeeper.SetProviderFeatures(ctx, []string{"featureB"})
+	require.Equal(t, []string{"featureB"}, consumerKeeper.GetProviderFeatures(ctx))
+	require.False(t, consumerKeeper.IsProviderFeatureSupported(ctx, ccv.FeatureConsumerPacketDataV2))
+}
+
 // TestProviderChannel tests getter and setter functionality for the channel ID stored on consumer keeper
 func TestProviderChannel(t *testing.T) {
 	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -48,6 +85,29 @@ func TestProviderChannel(t *testing.T) {
 	require.Equal(t, "channelID", channelID)
 }
 
+// TestQueryProviderIbcIds tests the query of the provider client ID and of the CCV channel ID
+func TestQueryProviderIbcIds(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := consumerKeeper.QueryProviderIbcIds(ctx, &types.QueryProviderIbcIdsRequest{})
+	require.Error(t, err)
+
+	// the channel ID is empty until the CCV channel is established
+	consumerKeeper.SetProviderClientID(ctx, "clientID")
+	res, err := consumerKeeper.QueryProviderIbcIds(ctx, &types.QueryProviderIbcIdsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryProviderIbcIdsResponse{ClientId: "clientID"}, res)
+
+	consumerKeeper.SetProviderChannel(ctx, "channelID")
+	res, err = consumerKeeper.QueryProviderIbcIds(ctx, &types.QueryProviderIbcIdsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryProviderIbcIdsResponse{ClientId: "clientID", ChannelId: "channelID"}, res)
+
+	_, err = consumerKeeper.QueryProviderIbcIds(ctx, nil)
+	require.Error(t, err)
+}
+
 // TestPendingChanges tests getter, setter, and delete functionality for pending VSCs on a consumer chain
 func TestPendingChanges(t *testing.T) {
 	pk1, err := cryptocodec.ToCmtProtoPublicKey(ed25519.GenPrivKey().PubKey())
@@ -543,8 +603,14 @@ func TestDeleteHeadOfPendingPackets(t *testing.T) {
 	require.Len(t, pp, 3)
 	require.Equal(t, pp[0].Type, ccv.VscMaturedPacket)
 
+	// Check the enqueue records of the pending packets
+	_, found := consumerKeeper.GetPendingPacketEnqueueRecord(ctx, 0)
+	require.True(t, found)
+
 	// Delete the head, confirm slash packet is now at head
 	consumerKeeper.DeleteHeadOfPendingPackets(ctx)
+	_, found = consumerKeeper.GetPendingPacketEnqueueRecord(ctx, 0)
+	require.False(t, found)
 	pp = consumerKeeper.GetPendingPackets(ctx)
 	require.Len(t, pp, 2)
 	require.Equal(t, pp[0].Type, ccv.SlashPacket)
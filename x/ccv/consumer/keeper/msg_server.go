@@ -6,9 +6,11 @@ import (
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 type msgServer struct {
@@ -25,7 +27,8 @@ var _ types.MsgServer = msgServer{}
 
 // UpdateParams updates the params.
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	if k.GetAuthority() != msg.Authority {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.IsAuthority(ctx, msg.Authority) {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
 	}
 
@@ -33,8 +36,28 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 		return nil, err
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ValidateProviderFeePoolAddrUpdate(ctx, msg.Params.ProviderFeePoolAddrStr); err != nil {
+		return nil, err
+	}
+
 	k.Keeper.SetParams(ctx, msg.Params)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SubmitProviderValsetProof verifies the submitted proof of the latest valset checkpoint
+// stored on the provider chain and compares it with the consumer validator set
+func (k msgServer) SubmitProviderValsetProof(goCtx context.Context, msg *types.MsgSubmitProviderValsetProof) (*types.MsgSubmitProviderValsetProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Submitter); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid submitter address: %s", err.Error())
+	}
+
+	verification, err := k.Keeper.VerifyProviderValset(ctx, msg.ProofHeight,
+		ccv.NewValsetCheckpointPacketData(msg.ValsetUpdateId, msg.ValsetHash), msg.Proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitProviderValsetProofResponse{Match: verification.Match}, nil
+}
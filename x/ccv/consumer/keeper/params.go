@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,11 +22,29 @@ func (k Keeper) GetConsumerParams(ctx sdk.Context) ccvtypes.ConsumerParams {
 	return params
 }
 
-// SetParams sets the paramset for the consumer module
+// SetParams sets the paramset for the consumer module and emits an event
+// if the transmission of rewards to the provider chain is paused or resumed
 func (k Keeper) SetParams(ctx sdk.Context, params ccvtypes.ConsumerParams) {
+	paused := k.GetRewardTransmissionPaused(ctx)
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&params)
 	store.Set(types.ParametersKey(), bz)
+
+	if params.RewardTransmissionPaused == paused {
+		return
+	}
+	eventType := types.EventTypeRewardTransmissionResumed
+	if params.RewardTransmissionPaused {
+		eventType = types.EventTypeRewardTransmissionPaused
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeDistributionCurrentHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+		),
+	)
 }
 
 // GetParams implements StakingKeeper GetParams interface method
@@ -95,12 +114,32 @@ func (k Keeper) GetConsumerRedistributionFrac(ctx sdk.Context) string {
 	return params.ConsumerRedistributionFraction
 }
 
+// GetConsumerRedistributionFracOverrides returns the per-denom overrides of the fraction
+// of tokens allocated to the consumer redistribution address during distribution events
+func (k Keeper) GetConsumerRedistributionFracOverrides(ctx sdk.Context) []ccvtypes.RedistributionFractionOverride {
+	params := k.GetConsumerParams(ctx)
+	return params.ConsumerRedistributionFractionOverrides
+}
+
+// GetRewardTransmissionPaused returns true if the transmission of rewards to the provider chain is paused
+func (k Keeper) GetRewardTransmissionPaused(ctx sdk.Context) bool {
+	params := k.GetConsumerParams(ctx)
+	return params.RewardTransmissionPaused
+}
+
 // GetHistoricalEntries returns the number of historical info entries to persist in store
 func (k Keeper) GetHistoricalEntries(ctx sdk.Context) int64 {
 	params := k.GetConsumerParams(ctx)
 	return params.HistoricalEntries
 }
 
+// GetClientExpiryWarningWindow returns the window before the expiry of the client
+// to the provider chain in which client expiry warnings are emitted
+func (k Keeper) GetClientExpiryWarningWindow(ctx sdk.Context) time.Duration {
+	params := k.GetConsumerParams(ctx)
+	return params.ClientExpiryWarningWindow
+}
+
 // Only used to set an unbonding period in diff tests
 func (k Keeper) SetUnbondingPeriod(ctx sdk.Context, period time.Duration) {
 	params := k.GetConsumerParams(ctx)
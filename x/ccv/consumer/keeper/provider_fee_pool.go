@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetDeclaredProviderFeePoolAddr sets the address of the provider fee pool
+// declared by the provider chain, either in the handshake or in a provider fee pool address packet
+func (k Keeper) SetDeclaredProviderFeePoolAddr(ctx sdk.Context, addr string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProviderFeePoolAddrKey(), []byte(addr))
+}
+
+// GetDeclaredProviderFeePoolAddr returns the address of the provider fee pool
+// declared by the provider chain, if any
+func (k Keeper) GetDeclaredProviderFeePoolAddr(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderFeePoolAddrKey())
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// ValidateProviderFeePoolAddrUpdate checks that the provider fee pool address can be updated
+// to the given address by governance, i.e., that the new address matches the address declared
+// by the provider chain. If the provider chain did not declare any address yet,
+// e.g., because the CCV channel was established before the address was recorded,
+// any address is accepted.
+func (k Keeper) ValidateProviderFeePoolAddrUpdate(ctx sdk.Context, addr string) error {
+	if addr == k.GetProviderFeePoolAddrStr(ctx) {
+		return nil
+	}
+
+	declaredAddr, found := k.GetDeclaredProviderFeePoolAddr(ctx)
+	if found && addr != declaredAddr {
+		return errorsmod.Wrapf(types.ErrInvalidProviderFeePoolAddr,
+			"expected the address declared by the provider chain %s, got %s", declaredAddr, addr)
+	}
+	return nil
+}
+
+// OnRecvProviderFeePoolAddrPacket handles a provider fee pool address packet received from the provider,
+// i.e., it records the address declared by the provider chain and sends the next ICS rewards to it.
+func (k Keeper) OnRecvProviderFeePoolAddrPacket(ctx sdk.Context, packet channeltypes.Packet, data ccv.ProviderFeePoolAddrPacketData) error {
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating provider fee pool address packet data")
+	}
+
+	// get the provider channel
+	providerChannel, found := k.GetProviderChannel(ctx)
+	if found && providerChannel != packet.DestinationChannel {
+		// provider fee pool address packet was sent on a channel different than the provider channel;
+		// this should never happen
+		panic(fmt.Errorf("provider fee pool address packet received on unknown channel %s; expected: %s",
+			packet.DestinationChannel, providerChannel))
+	}
+
+	previousAddr := k.GetProviderFeePoolAddrStr(ctx)
+	k.SetDeclaredProviderFeePoolAddr(ctx, data.ProviderFeePoolAddr)
+	k.SetProviderFeePoolAddrStr(ctx, data.ProviderFeePoolAddr)
+
+	k.Logger(ctx).Info("finished receiving/handling provider fee pool address packet",
+		"previousAddress", previousAddr,
+		"address", data.ProviderFeePoolAddr,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderFeePoolAddrUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributePreviousFeePoolAddr, previousAddr),
+			sdk.NewAttribute(ccv.AttributeProviderFeePoolAddr, data.ProviderFeePoolAddr),
+		),
+	)
+	return nil
+}
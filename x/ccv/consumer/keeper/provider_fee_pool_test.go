@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const (
+	providerFeePoolAddr         = "cosmos1ap0mh6xzfn8943urr84q6ae7zfnar48am2erhd"
+	migratedProviderFeePoolAddr = "cosmos1d45kwunpw3jkghmxv4j47ur0dak97h6lahd6kw"
+)
+
+// TestOnRecvProviderFeePoolAddrPacket tests that the address declared by the provider chain
+// replaces the provider fee pool address
+func TestOnRecvProviderFeePoolAddrPacket(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	consumerKeeper.SetProviderFeePoolAddrStr(ctx, providerFeePoolAddr)
+
+	packet := channeltypes.Packet{DestinationChannel: "consumerCCVChannelID"}
+
+	// invalid addresses are rejected
+	err := consumerKeeper.OnRecvProviderFeePoolAddrPacket(ctx, packet, ccv.NewProviderFeePoolAddrPacketData("invalid"))
+	require.Error(t, err)
+	require.Equal(t, providerFeePoolAddr, consumerKeeper.GetProviderFeePoolAddrStr(ctx))
+
+	err = consumerKeeper.OnRecvProviderFeePoolAddrPacket(ctx, packet, ccv.NewProviderFeePoolAddrPacketData(migratedProviderFeePoolAddr))
+	require.NoError(t, err)
+	require.Equal(t, migratedProviderFeePoolAddr, consumerKeeper.GetProviderFeePoolAddrStr(ctx))
+	declaredAddr, found := consumerKeeper.GetDeclaredProviderFeePoolAddr(ctx)
+	require.True(t, found)
+	require.Equal(t, migratedProviderFeePoolAddr, declaredAddr)
+
+	// packets received on a channel other than the provider channel panic
+	require.Panics(t, func() {
+		_ = consumerKeeper.OnRecvProviderFeePoolAddrPacket(ctx, channeltypes.Packet{DestinationChannel: "other"},
+			ccv.NewProviderFeePoolAddrPacketData(providerFeePoolAddr))
+	})
+}
+
+// TestValidateProviderFeePoolAddrUpdate tests that governance can only update the provider fee pool
+// address to the address declared by the provider chain
+func TestValidateProviderFeePoolAddrUpdate(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderFeePoolAddrStr(ctx, providerFeePoolAddr)
+
+	// without a declared address, e.g., for channels established before the address was recorded,
+	// any address is accepted
+	require.NoError(t, consumerKeeper.ValidateProviderFeePoolAddrUpdate(ctx, migratedProviderFeePoolAddr))
+
+	consumerKeeper.SetDeclaredProviderFeePoolAddr(ctx, migratedProviderFeePoolAddr)
+	require.NoError(t, consumerKeeper.ValidateProviderFeePoolAddrUpdate(ctx, migratedProviderFeePoolAddr))
+	// keeping the current address is always accepted
+	require.NoError(t, consumerKeeper.ValidateProviderFeePoolAddrUpdate(ctx, providerFeePoolAddr))
+
+	err := consumerKeeper.ValidateProviderFeePoolAddrUpdate(ctx, "cosmos1da6xsetjtaskgerjv4ehxh6lta047h6l3cc9z2")
+	require.ErrorIs(t, err, consumertypes.ErrInvalidProviderFeePoolAddr)
+}
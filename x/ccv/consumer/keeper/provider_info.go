@@ -14,13 +14,13 @@ func (k Keeper) GetProviderInfo(ctx sdk.Context) (*types.QueryProviderInfoRespon
 	if !found {
 		return nil, ccvtypes.ErrChannelNotFound
 	}
-	consumerChannel, found := k.channelKeeper.GetChannel(ctx, ccvtypes.ConsumerPortID, consumerChannelID)
+	consumerChannel, found := k.channelKeeper.GetChannel(ctx, k.portID, consumerChannelID)
 	if !found {
 		return nil, ccvtypes.ErrChannelNotFound
 	}
 
 	// from channel get connection
-	consumerConnectionID, consumerConnection, err := k.channelKeeper.GetChannelConnection(ctx, ccvtypes.ConsumerPortID, consumerChannelID)
+	consumerConnectionID, consumerConnection, err := k.channelKeeper.GetChannelConnection(ctx, k.portID, consumerChannelID)
 	if err != nil {
 		return nil, err
 	}
@@ -32,7 +32,13 @@ func (k Keeper) GetProviderInfo(ctx sdk.Context) (*types.QueryProviderInfoRespon
 	if !found {
 		return nil, ccvtypes.ErrClientNotFound
 	}
-	providerChainID := consumerClientState.(*ibctm.ClientState).ChainId
+	tmClientState := consumerClientState.(*ibctm.ClientState)
+	providerChainID := tmClientState.ChainId
+
+	clientExpiry, err := ccvtypes.GetClientExpiry(ctx, k.clientKeeper, consumerConnection.ClientId, k.GetClientExpiryWarningWindow(ctx))
+	if err != nil {
+		return nil, err
+	}
 
 	resp := types.QueryProviderInfoResponse{
 		Consumer: types.ChainInfo{
@@ -48,6 +54,19 @@ func (k Keeper) GetProviderInfo(ctx sdk.Context) (*types.QueryProviderInfoRespon
 			ConnectionID: providerConnection.ConnectionId,
 			ChannelID:    providerChannelID,
 		},
+
+		ClientLatestHeight:     tmClientState.LatestHeight,
+		ClientExpiry:           clientExpiry,
+		ChannelState:           consumerChannel.State.String(),
+		PendingPackets:         uint64(len(k.GetAllPendingPacketsWithIdx(ctx))),
+		PacketSendingPermitted: k.PacketSendingPermitted(ctx),
+	}
+	if lastVSC, found := k.GetLastVSC(ctx); found {
+		resp.LastVsc = &lastVSC
+		resp.TimeSinceLastVsc = ctx.BlockTime().Sub(lastVSC.RecvTime)
+	}
+	if slashRecord, found := k.GetSlashRecord(ctx); found {
+		resp.SlashRecord = &slashRecord
 	}
 
 	return &resp, nil
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -71,6 +73,11 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	k.SetHeightValsetUpdateID(ctx, blockHeight, newChanges.ValsetUpdateId)
 	k.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
+	k.SetLastVSC(ctx, types.LastVSC{
+		ValsetUpdateId: newChanges.ValsetUpdateId,
+		RecvHeight:     ctx.BlockHeight(),
+		RecvTime:       ctx.BlockTime(),
+	})
 
 	// remove outstanding slashing flags of the validators
 	// for which the slashing was acknowledged by the provider chain
@@ -89,6 +96,8 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 		k.DeleteOutstandingDowntime(ctx, consAddr)
 	}
 
+	k.incrCounter(ctx, ccv.MetricKeyVSCPacketsReceived)
+
 	k.Logger(ctx).Info("finished receiving/handling VSCPacket",
 		"vscID", newChanges.ValsetUpdateId,
 		"len updates", len(newChanges.ValidatorUpdates),
@@ -97,6 +106,67 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 	return nil
 }
 
+// GetNextValsetHash returns the hash of the validator set the consumer chain
+// will have once the pending changes are applied, i.e., the current validators
+// updated with the changes received from the provider that are not yet applied
+func (k Keeper) GetNextValsetHash(ctx sdk.Context) ([]byte, error) {
+	nextValSet := k.MustGetCurrentValidatorsAsABCIUpdates(ctx)
+	if pendingChanges, exists := k.GetPendingChanges(ctx); exists {
+		nextValSet = ccv.AccumulateChanges(nextValSet, pendingChanges.ValidatorUpdates)
+	}
+	return ccv.ComputeValsetHash(nextValSet)
+}
+
+// OnRecvValsetCheckpointPacket handles a valset checkpoint packet received from the provider.
+// It compares the hash of the validator set the consumer will have once all the received
+// VSC packets are applied with the hash computed by the provider and emits an event on mismatch.
+// Note that a mismatch does not result in an error acknowledgement, as the provider would
+// then remove the consumer chain.
+func (k Keeper) OnRecvValsetCheckpointPacket(ctx sdk.Context, packet channeltypes.Packet, checkpoint ccv.ValsetCheckpointPacketData) error {
+	// validate packet data upon receiving
+	if err := checkpoint.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating valset checkpoint packet data")
+	}
+
+	// get the provider channel
+	providerChannel, found := k.GetProviderChannel(ctx)
+	if found && providerChannel != packet.DestinationChannel {
+		// checkpoint packet was sent on a channel different than the provider channel;
+		// this should never happen
+		panic(fmt.Errorf("valset checkpoint packet received on unknown channel %s; expected: %s",
+			packet.DestinationChannel, providerChannel))
+	}
+
+	valsetHash, err := k.GetNextValsetHash(ctx)
+	if err != nil {
+		// this should never happen as the validator set was already applied
+		return errorsmod.Wrapf(err, "error computing the valset hash")
+	}
+
+	if !bytes.Equal(valsetHash, checkpoint.ValsetHash) {
+		k.Logger(ctx).Error("valset checkpoint mismatch",
+			"vscID", checkpoint.ValsetUpdateId,
+			"expected hash", hex.EncodeToString(checkpoint.ValsetHash),
+			"actual hash", hex.EncodeToString(valsetHash),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeValsetCheckpointMismatch,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(checkpoint.ValsetUpdateId, 10)),
+				sdk.NewAttribute(types.AttributeExpectedValsetHash, hex.EncodeToString(checkpoint.ValsetHash)),
+				sdk.NewAttribute(types.AttributeActualValsetHash, hex.EncodeToString(valsetHash)),
+			),
+		)
+		return nil
+	}
+
+	k.Logger(ctx).Info("finished receiving/handling valset checkpoint packet",
+		"vscID", checkpoint.ValsetUpdateId,
+	)
+	return nil
+}
+
 // QueueSlashPacket appends a slash packet containing the given validator data and slashing info to queue.
 func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, valsetUpdateID uint64, infraction stakingtypes.Infraction) {
 	consAddr := sdk.ConsAddress(validator.Address)
@@ -135,7 +205,7 @@ func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, vals
 		sdk.NewEvent(
 			types.EventTypeConsumerSlashRequest,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(ccv.AttributeValidatorAddress, sdk.ConsAddress(validator.Address).String()),
+			sdk.NewAttribute(ccv.AttributeValidatorAddress, k.ConsAddressToString(validator.Address)),
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(valsetUpdateID))),
 			sdk.NewAttribute(ccv.AttributeInfractionType, infraction.String()),
 		),
@@ -157,19 +227,34 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 	}
 
 	pending := k.GetAllPendingPacketsWithIdx(ctx)
+
+	// send the packets in ConsumerPacketDataV2 envelopes only if the provider supports them
+	useV2 := k.IsProviderFeatureSupported(ctx, ccv.FeatureConsumerPacketDataV2)
+
 	idxsForDeletion := []uint64{}
 	for _, p := range pending {
 		if !k.PacketSendingPermitted(ctx) {
 			break
 		}
 
+		packetBytes := p.GetBytes()
+		if useV2 {
+			v2Packet, err := p.ToV2()
+			if err != nil {
+				// this should never happen; fall back to the v1 format
+				k.Logger(ctx).Error("cannot convert packet data to v2 envelope", "type", p.Type.String(), "err", err.Error())
+			} else {
+				packetBytes = v2Packet.GetBytes()
+			}
+		}
+
 		// Send packet over IBC
-		err := ccv.SendIBCPacket(
+		sequence, err := ccv.SendIBCPacket(
 			ctx,
 			k.channelKeeper,
-			channelID,          // source channel id
-			ccv.ConsumerPortID, // source port id
-			p.GetBytes(),
+			channelID, // source channel id
+			k.portID,  // source port id
+			packetBytes,
 			k.GetCCVTimeoutPeriod(ctx),
 		)
 		if err != nil {
@@ -186,10 +271,20 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 			k.Logger(ctx).Error("cannot send IBC packet; leaving packet data stored:", "type", p.Type.String(), "err", err.Error())
 			break
 		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacketSent,
+				append(packetEventAttributes(p.Type, packetValsetUpdateId(p.ConsumerPacketData)),
+					sdk.NewAttribute(types.AttributePacketIndex, strconv.FormatUint(p.Idx, 10)),
+					sdk.NewAttribute(types.AttributePacketSequence, strconv.FormatUint(sequence, 10)),
+				)...,
+			),
+		)
 		// If the packet that was just sent was a Slash packet, set the waiting on slash reply flag.
 		// This flag will be toggled false again when consumer hears back from provider. See OnAcknowledgementPacket below.
 		if p.Type == ccv.SlashPacket {
 			k.UpdateSlashRecordOnSend(ctx)
+			k.incrCounter(ctx, ccv.MetricKeySlashPacketsSent)
 			// Break so slash stays at head of queue.
 			// This blocks the sending of any other packet until the leading slash packet is handled.
 			// Also see OnAcknowledgementPacket below which will eventually delete the leading slash packet.
@@ -214,30 +309,67 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		// Unmarshal into V1 consumer packet data type. We trust data is formed correctly
 		// as it was originally marshalled by this module, and consumers must trust the provider
 		// did not tamper with the data. Note ConsumerPacketData.GetBytes() always JSON marshals to the
-		// ConsumerPacketDataV1 type which is sent over the wire.
+		// ConsumerPacketDataV1 type which is sent over the wire, unless the packet was sent
+		// in a ConsumerPacketDataV2 envelope.
+		var packetType ccv.ConsumerPacketDataType
+		var valsetUpdateId uint64
+		var slashPacketData *ccv.SlashPacketData
 		var consumerPacket ccv.ConsumerPacketDataV1
-		ccv.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &consumerPacket)
+		if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &consumerPacket); err == nil {
+			packetType = consumerPacket.Type
+			if packetType == ccv.SlashPacket {
+				valsetUpdateId = consumerPacket.GetSlashPacketData().GetValsetUpdateId()
+				slashPacketData = consumerPacket.GetSlashPacketData().FromV1()
+			} else {
+				valsetUpdateId = consumerPacket.GetVscMaturedPacketData().GetValsetUpdateId()
+			}
+		} else {
+			var v2Packet ccv.ConsumerPacketDataV2
+			ccv.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &v2Packet)
+			consumerPacketData, err := v2Packet.ToConsumerPacketData()
+			if err != nil {
+				return err
+			}
+			packetType = consumerPacketData.Type
+			valsetUpdateId = packetValsetUpdateId(consumerPacketData)
+			slashPacketData = consumerPacketData.GetSlashPacketData()
+		}
 		// If this ack is regarding a provider handling a vsc matured packet, there's nothing to do.
 		// As vsc matured packets are popped from the consumer pending packets queue on send.
-		if consumerPacket.Type == ccv.VscMaturedPacket {
+		if packetType == ccv.VscMaturedPacket {
+			k.emitPacketAcknowledgedEvent(ctx, packet, packetType, valsetUpdateId, types.AckResultReceived)
 			return nil
 		}
 
 		// Otherwise we handle the result of the slash packet acknowledgement.
+		ackResult := ""
 		switch res[0] {
 		// We treat a v1 result as the provider successfully queuing the slash packet w/o need for retry.
 		case ccv.V1Result[0]:
 			k.ClearSlashRecord(ctx)           // Clears slash record state, unblocks sending of pending packets.
 			k.DeleteHeadOfPendingPackets(ctx) // Remove slash from head of queue. It's been handled.
+			k.incrCounter(ctx, ccv.MetricKeySlashPacketsHandled)
+			ackResult = types.AckResultReceived
+			if slashPacketData != nil {
+				k.AfterSlashPacketAcked(ctx, *slashPacketData)
+			}
 		case ccv.SlashPacketHandledResult[0]:
 			k.ClearSlashRecord(ctx)           // Clears slash record state, unblocks sending of pending packets.
 			k.DeleteHeadOfPendingPackets(ctx) // Remove slash from head of queue. It's been handled.
+			k.incrCounter(ctx, ccv.MetricKeySlashPacketsHandled)
+			ackResult = types.AckResultHandled
+			if slashPacketData != nil {
+				k.AfterSlashPacketAcked(ctx, *slashPacketData)
+			}
 		case ccv.SlashPacketBouncedResult[0]:
 			k.UpdateSlashRecordOnBounce(ctx)
+			k.incrCounter(ctx, ccv.MetricKeySlashPacketsBounced)
 			// Note slash is still at head of queue and will now be retried after appropriate delay period.
+			ackResult = types.AckResultBounced
 		default:
 			return fmt.Errorf("unrecognized acknowledgement result: %c", res[0])
 		}
+		k.emitPacketAcknowledgedEvent(ctx, packet, packetType, valsetUpdateId, ackResult)
 	}
 
 	if err := ack.GetError(); err != "" {
@@ -250,6 +382,15 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			"channel", packet.SourceChannel,
 			"error", err,
 		)
+		// The packet data is not decoded, as it may be the reason of the error
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacketAcknowledged,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributePacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeAckResult, types.AckResultError),
+			),
+		)
 		// Initiate ChanCloseInit using packet source (non-counterparty) port and channel
 		err := k.ChanCloseInit(ctx, packet.SourcePort, packet.SourceChannel)
 		if err != nil {
@@ -262,15 +403,53 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 		}
 		if channelID != packet.SourceChannel {
 			// Close the established CCV channel as well
-			return k.ChanCloseInit(ctx, ccv.ConsumerPortID, channelID)
+			return k.ChanCloseInit(ctx, k.portID, channelID)
 		}
 	}
 	return nil
 }
 
+// emitPacketAcknowledgedEvent emits an event signaling that a packet sent to the provider chain
+// was acknowledged with a result
+func (k Keeper) emitPacketAcknowledgedEvent(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	packetType ccv.ConsumerPacketDataType,
+	valsetUpdateId uint64,
+	ackResult string,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePacketAcknowledged,
+			append(packetEventAttributes(packetType, valsetUpdateId),
+				sdk.NewAttribute(types.AttributePacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+				sdk.NewAttribute(types.AttributeAckResult, ackResult),
+			)...,
+		),
+	)
+}
+
+// packetEventAttributes returns the attributes shared by the events of the packets sent
+// to the provider chain, i.e., the packet type and the valset update id of the packet
+func packetEventAttributes(packetType ccv.ConsumerPacketDataType, valsetUpdateId uint64) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributePacketType, packetType.String()),
+		sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(valsetUpdateId, 10)),
+	}
+}
+
+// packetValsetUpdateId returns the valset update id of a packet sent to the provider chain
+func packetValsetUpdateId(packet ccv.ConsumerPacketData) uint64 {
+	if packet.Type == ccv.SlashPacket {
+		return packet.GetSlashPacketData().GetValsetUpdateId()
+	}
+	return packet.GetVscMaturedPacketData().GetValsetUpdateId()
+}
+
 // IsChannelClosed returns a boolean whether a given channel is in the CLOSED state
 func (k Keeper) IsChannelClosed(ctx sdk.Context, channelID string) bool {
-	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ConsumerPortID, channelID)
+	channel, found := k.channelKeeper.GetChannel(ctx, k.portID, channelID)
 	if !found || channel.State == channeltypes.CLOSED {
 		return true
 	}
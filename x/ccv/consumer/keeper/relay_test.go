@@ -175,10 +175,16 @@ func TestOnRecvVSCPacket(t *testing.T) {
 			return tc.expectedPendingChanges.ValidatorUpdates[i].PubKey.Compare(tc.expectedPendingChanges.ValidatorUpdates[j].PubKey) == -1
 		})
 		require.Equal(t, tc.expectedPendingChanges, *actualPendingChanges, "pending changes not equal to expected changes after successful packet receive. case: %s", tc.name)
+
+		// Check that the packet is recorded as the last VSC packet received
+		lastVSC, ok := consumerKeeper.GetLastVSC(ctx)
+		require.True(t, ok)
+		require.Equal(t, newChanges.ValsetUpdateId, lastVSC.ValsetUpdateId)
+		require.Equal(t, ctx.BlockHeight(), lastVSC.RecvHeight)
 	}
 }
 
-// TestOnRecvVSCPacketDuplicateUpdates tests that the consumer can correctly handle a single VSC packet
+// TestOnRecvVSCPacketDuplicateUpdates tests that the consumer rejects a single VSC packet
 // with duplicate valUpdates for the same pub key.
 //
 // Note: This scenario shouldn't usually happen, ie. the provider shouldn't send duplicate val updates
@@ -214,21 +220,73 @@ func TestOnRecvVSCPacketDuplicateUpdates(t *testing.T) {
 	packet := channeltypes.NewPacket(vscData.GetBytes(), 2, types.ProviderPortID,
 		providerCCVChannelID, types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
 
-	// Confirm no pending changes exist before OnRecvVSCPacket
+	// Execute OnRecvVSCPacket
+	err := consumerKeeper.OnRecvVSCPacket(ctx, packet, vscData)
+	require.ErrorIs(t, err, types.ErrDuplicateValidatorPubKey)
+
+	// Confirm no pending changes are queued by OnRecvVSCPacket
 	_, ok := consumerKeeper.GetPendingChanges(ctx)
 	require.False(t, ok)
+}
 
-	// Execute OnRecvVSCPacket
-	err := consumerKeeper.OnRecvVSCPacket(ctx, packet, vscData)
-	require.Nil(t, err)
+// TestOnRecvValsetCheckpointPacket tests that a valset checkpoint is verified against
+// the current validator set updated with the pending changes
+func TestOnRecvValsetCheckpointPacket(t *testing.T) {
+	consumerCCVChannelID := "consumerCCVChannelID"
+	providerCCVChannelID := "providerCCVChannelID"
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, consumerCCVChannelID)
+
+	cId1 := crypto.NewCryptoIdentityFromIntSeed(7842)
+	cId2 := crypto.NewCryptoIdentityFromIntSeed(7843)
+
+	// current validator set
+	ccVal, err := consumertypes.NewCCValidator(cId1.SDKValConsAddress(), 10, cId1.ConsensusSDKPubKey())
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, ccVal)
+	// pending changes
+	pendingChanges := []abci.ValidatorUpdate{{PubKey: cId2.TMProtoCryptoPublicKey(), Power: 20}}
+	consumerKeeper.SetPendingChanges(ctx, types.ValidatorSetChangePacketData{ValidatorUpdates: pendingChanges})
+
+	expectedHash, err := types.ComputeValsetHash([]abci.ValidatorUpdate{
+		{PubKey: cId1.TMProtoCryptoPublicKey(), Power: 10},
+		{PubKey: cId2.TMProtoCryptoPublicKey(), Power: 20},
+	})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		data        types.ValsetCheckpointPacketData
+		expErr      bool
+		expMismatch bool
+	}{
+		{"invalid checkpoint", types.NewValsetCheckpointPacketData(0, expectedHash), true, false},
+		{"matching checkpoint", types.NewValsetCheckpointPacketData(5, expectedHash), false, false},
+		{"mismatching checkpoint", types.NewValsetCheckpointPacketData(5, []byte{0x01}), false, true},
+	}
 
-	// Confirm pending changes are queued by OnRecvVSCPacket
-	gotPendingChanges, ok := consumerKeeper.GetPendingChanges(ctx)
-	require.True(t, ok)
+	for _, tc := range testCases {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		packet := channeltypes.NewPacket(tc.data.GetBytes(), 1, types.ProviderPortID,
+			providerCCVChannelID, types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
 
-	// Confirm that only the latest update is kept, duplicate update is discarded
-	require.Equal(t, 1, len(gotPendingChanges.ValidatorUpdates))
-	require.Equal(t, valUpdates[1], gotPendingChanges.ValidatorUpdates[0]) // Only latest update should be kept
+		err := consumerKeeper.OnRecvValsetCheckpointPacket(ctx, packet, tc.data)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+			continue
+		}
+		require.NoError(t, err, tc.name)
+
+		mismatch := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == consumertypes.EventTypeValsetCheckpointMismatch {
+				mismatch = true
+			}
+		}
+		require.Equal(t, tc.expMismatch, mismatch, tc.name)
+	}
 }
 
 // TestSendPackets tests the SendPackets method failing
@@ -453,6 +511,23 @@ func TestOnAcknowledgementPacketResult(t *testing.T) {
 	require.False(t, slashRecordAfter.WaitingOnReply) // waiting on reply toggled false
 	require.Equal(t, slashRecordAfter.SendTime.UnixNano(),
 		slashRecordBefore.SendTime.UnixNano()) // send time NOT updated. Bounce result shouldn't affect that
+
+	// refresh state
+	setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+	pendingPackets = consumerKeeper.GetPendingPackets(ctx)
+	slashPacketDataV2, err := pendingPackets[0].ToV2()
+	require.NoError(t, err)
+	packet = channeltypes.Packet{Data: slashPacketDataV2.GetBytes()}
+
+	// Slash packet handled result for a slash packet sent in a v2 envelope
+	// should delete slash record and head of pending packets
+	ack = channeltypes.NewResultAcknowledgement(types.SlashPacketHandledResult)
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, ack)
+	require.Nil(t, err)
+	_, found = consumerKeeper.GetSlashRecord(ctx)
+	require.False(t, found)
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx), 1)
+	require.Equal(t, types.VscMaturedPacket, consumerKeeper.GetPendingPackets(ctx)[0].Type)
 }
 
 func setupSlashBeforeVscMatured(ctx sdk.Context, k *consumerkeeper.Keeper) {
@@ -477,6 +552,82 @@ func setupSlashBeforeVscMatured(ctx sdk.Context, k *consumerkeeper.Keeper) {
 	})
 }
 
+// TestPendingPacketEvents tests that events are emitted when packets to the provider
+// are queued, sent, and acknowledged
+func TestPendingPacketEvents(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	consumerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// attributes returns the attributes of an event as a map
+	attributes := func(event sdk.Event) map[string]string {
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		return attrs
+	}
+
+	// queuing a packet emits a queued event
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	consumerKeeper.AppendPendingPacket(ctx, types.VscMaturedPacket, &types.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: &types.VSCMaturedPacketData{
+			ValsetUpdateId: 77,
+		},
+	})
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypePacketQueued, events[0].Type)
+	attrs := attributes(events[0])
+	require.Equal(t, consumertypes.ModuleName, attrs[sdk.AttributeKeyModule])
+	require.Equal(t, types.VscMaturedPacket.String(), attrs[consumertypes.AttributePacketType])
+	require.Equal(t, "77", attrs[types.AttributeValSetUpdateID])
+	require.Equal(t, "0", attrs[consumertypes.AttributePacketIndex])
+
+	// sending a packet emits a sent event with the IBC sequence
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	gomock.InAnyOrder(
+		testkeeper.GetMocksForSendIBCPacket(ctx, mocks, "consumerCCVChannelID", 1),
+	)
+	consumerKeeper.SendPackets(ctx)
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypePacketSent, events[0].Type)
+	attrs = attributes(events[0])
+	require.Equal(t, types.VscMaturedPacket.String(), attrs[consumertypes.AttributePacketType])
+	require.Equal(t, "77", attrs[types.AttributeValSetUpdateID])
+	require.Equal(t, "0", attrs[consumertypes.AttributePacketIndex])
+	require.Equal(t, "888", attrs[consumertypes.AttributePacketSequence])
+
+	// acknowledging a slash packet emits an acknowledged event with the ack result
+	testCases := []struct {
+		ack          []byte
+		expAckResult string
+	}{
+		{types.V1Result, consumertypes.AckResultReceived},
+		{types.SlashPacketHandledResult, consumertypes.AckResultHandled},
+		{types.SlashPacketBouncedResult, consumertypes.AckResultBounced},
+	}
+	for _, tc := range testCases {
+		setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+		pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+		packet := channeltypes.Packet{Sequence: 5, Data: pendingPackets[0].GetBytes()}
+
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err := consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(tc.ack))
+		require.NoError(t, err)
+		events = ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, consumertypes.EventTypePacketAcknowledged, events[0].Type)
+		attrs = attributes(events[0])
+		require.Equal(t, types.SlashPacket.String(), attrs[consumertypes.AttributePacketType])
+		require.Equal(t, "88", attrs[types.AttributeValSetUpdateID])
+		require.Equal(t, "5", attrs[consumertypes.AttributePacketSequence])
+		require.Equal(t, tc.expAckResult, attrs[consumertypes.AttributeAckResult])
+	}
+}
+
 // Regression test for https://github.com/cosmos/interchain-security/issues/1145
 func TestSendPacketsDeletion(t *testing.T) {
 	// Keeper setup
@@ -514,3 +665,39 @@ func TestSendPacketsDeletion(t *testing.T) {
 	// Expect the slash packet to remain
 	require.Equal(t, types.SlashPacket, consumerKeeper.GetPendingPackets(ctx)[0].Type)
 }
+
+// TestSendPacketsV2 tests that packets are sent in ConsumerPacketDataV2 envelopes
+// only if the provider chain supports them
+func TestSendPacketsV2(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	consumerKeeper.SetParams(ctx, types.DefaultParams())
+
+	packetData := types.NewConsumerPacketData(types.VscMaturedPacket, &types.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: &types.VSCMaturedPacketData{ValsetUpdateId: 77},
+	})
+	packetDataV2, err := packetData.ToV2()
+	require.NoError(t, err)
+
+	// the provider does not support v2 envelopes
+	consumerKeeper.AppendPendingPacket(ctx, packetData.Type, packetData.Data)
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, types.ConsumerPortID, "consumerCCVChannelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, types.ConsumerPortID, "consumerCCVChannelID", gomock.Any(), gomock.Any(),
+			packetData.GetBytes()).Return(uint64(1), nil).Times(1),
+	)
+	consumerKeeper.SendPackets(ctx)
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+
+	// the provider supports v2 envelopes
+	consumerKeeper.SetProviderFeatures(ctx, []string{types.FeatureConsumerPacketDataV2})
+	consumerKeeper.AppendPendingPacket(ctx, packetData.Type, packetData.Data)
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, types.ConsumerPortID, "consumerCCVChannelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, types.ConsumerPortID, "consumerCCVChannelID", gomock.Any(), gomock.Any(),
+			packetDataV2.GetBytes()).Return(uint64(2), nil).Times(1),
+	)
+	consumerKeeper.SendPackets(ctx)
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+}
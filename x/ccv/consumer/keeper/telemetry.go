@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// incrCounter increments the consumer counter with the given key,
+// labeled with the consumer id of the chain. Note that the counters are
+// not incremented in CheckTx, as the IBC ante handler also executes
+// packet acknowledgements in CheckTx.
+func (k Keeper) incrCounter(ctx sdk.Context, key string) {
+	if !telemetry.IsTelemetryEnabled() || ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return
+	}
+	ccv.IncrConsumerCounter(types.ModuleName, key, k.GetConsumerId(ctx), 1)
+}
+
+// EndBlockTelemetry sets the consumer telemetry gauges, i.e.,
+// the number of packets pending to be sent to the provider chain
+func (k Keeper) EndBlockTelemetry(ctx sdk.Context) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	ccv.SetConsumerGauge(types.ModuleName, ccv.MetricKeyPendingPackets, k.GetConsumerId(ctx),
+		float32(len(k.GetPendingPackets(ctx))))
+}
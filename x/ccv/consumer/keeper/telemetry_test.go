@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestEndBlockTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccv.DefaultParams()
+	params.ConsumerId = "13"
+	consumerKeeper.SetParams(ctx, params)
+
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(1),
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(2),
+	})
+
+	consumerKeeper.EndBlockTelemetry(ctx)
+
+	res, err := m.Gather(telemetry.FormatDefault)
+	require.NoError(t, err)
+	var metrics struct {
+		Gauges []struct {
+			Name   string
+			Value  float32
+			Labels map[string]string
+		}
+	}
+	require.NoError(t, json.Unmarshal(res.Metrics, &metrics))
+
+	found := false
+	for _, gauge := range metrics.Gauges {
+		if gauge.Name == "test.ccvconsumer.pending_packets" {
+			found = true
+			require.Equal(t, float32(2), gauge.Value)
+			require.Equal(t, "13", gauge.Labels[ccv.MetricLabelConsumerId])
+		}
+	}
+	require.True(t, found)
+}
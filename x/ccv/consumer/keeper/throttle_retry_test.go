@@ -6,6 +6,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
 	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -92,3 +97,84 @@ func TestThrottleRetryCRUD(t *testing.T) {
 	require.False(t, found)
 	require.Zero(t, slashRecord)
 }
+
+func TestQueryThrottleState(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerKeeper.SetParams(ctx, ccvtypes.DefaultParams())
+	retryDelay := consumerKeeper.GetRetryDelayPeriod(ctx)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(5)
+
+	// No pending packets and no slash record, send is permitted
+	res, err := consumerKeeper.QueryThrottleState(ctx, &consumertypes.QueryThrottleStateRequest{})
+	require.NoError(t, err)
+	require.Nil(t, res.SlashRecord)
+	require.Empty(t, res.PendingPackets)
+	require.True(t, res.PacketSendingPermitted)
+	require.Empty(t, res.BlockedReason)
+	require.Nil(t, res.NextSendTime)
+	require.Equal(t, retryDelay, res.RetryDelayPeriod)
+
+	valAddr := ed25519.GenPrivKey().PubKey().Address()
+	consumerKeeper.AppendPendingPacket(ctx, ccvtypes.SlashPacket, &ccvtypes.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: ccvtypes.NewSlashPacketData(
+			abci.Validator{Address: valAddr, Power: 1},
+			3,
+			stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		),
+	})
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+
+	ctx = ctx.WithBlockTime(now.Add(time.Minute)).WithBlockHeight(6)
+	consumerKeeper.AppendPendingPacket(ctx, ccvtypes.VscMaturedPacket, &ccvtypes.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccvtypes.NewVSCMaturedPacketData(4),
+	})
+
+	// Waiting on the reply of the provider
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Minute))
+	res, err = consumerKeeper.QueryThrottleState(ctx, &consumertypes.QueryThrottleStateRequest{})
+	require.NoError(t, err)
+	require.NotNil(t, res.SlashRecord)
+	require.False(t, res.PacketSendingPermitted)
+	require.Contains(t, res.BlockedReason, "waiting on the reply")
+	require.Nil(t, res.NextSendTime)
+	require.Len(t, res.PacketDataQueue, 2)
+	require.Len(t, res.PendingPackets, 2)
+
+	slashInfo := res.PendingPackets[0]
+	require.Equal(t, ccvtypes.SlashPacket, slashInfo.Type)
+	require.Equal(t, consumerKeeper.ConsAddressToString(valAddr), slashInfo.ValidatorAddress)
+	require.Equal(t, stakingtypes.Infraction_INFRACTION_DOWNTIME, slashInfo.Infraction)
+	require.Equal(t, uint64(3), slashInfo.ValsetUpdateId)
+	require.Equal(t, int64(5), slashInfo.EnqueueHeight)
+	require.Equal(t, now, *slashInfo.EnqueueTime)
+	require.Equal(t, 2*time.Minute, *slashInfo.Age)
+
+	vscMaturedInfo := res.PendingPackets[1]
+	require.Equal(t, ccvtypes.VscMaturedPacket, vscMaturedInfo.Type)
+	require.Empty(t, vscMaturedInfo.ValidatorAddress)
+	require.Equal(t, uint64(4), vscMaturedInfo.ValsetUpdateId)
+	require.Equal(t, int64(6), vscMaturedInfo.EnqueueHeight)
+	require.Equal(t, time.Minute, *vscMaturedInfo.Age)
+	require.Greater(t, vscMaturedInfo.Index, slashInfo.Index)
+
+	// The slash packet bounced, the next send time is computed from its send time and the retry delay period
+	consumerKeeper.UpdateSlashRecordOnBounce(ctx)
+	res, err = consumerKeeper.QueryThrottleState(ctx, &consumertypes.QueryThrottleStateRequest{})
+	require.NoError(t, err)
+	require.False(t, res.PacketSendingPermitted)
+	require.Contains(t, res.BlockedReason, "bounced")
+	require.NotNil(t, res.NextSendTime)
+	require.Equal(t, now.Add(retryDelay), *res.NextSendTime)
+
+	// The retry delay period elapsed
+	ctx = ctx.WithBlockTime(res.NextSendTime.Add(time.Second))
+	res, err = consumerKeeper.QueryThrottleState(ctx, &consumertypes.QueryThrottleStateRequest{})
+	require.NoError(t, err)
+	require.True(t, res.PacketSendingPermitted)
+	require.Empty(t, res.BlockedReason)
+	require.Nil(t, res.NextSendTime)
+}
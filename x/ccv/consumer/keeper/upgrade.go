@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const (
+	// UpgradeNoticeBlocks is the number of blocks ahead of the halt height of
+	// a planned upgrade during which upgrade notice events are emitted
+	UpgradeNoticeBlocks = 100
+	// UpgradeNoticePeriod is the period ahead of the halt time of
+	// a planned upgrade during which upgrade notice events are emitted
+	UpgradeNoticePeriod = 10 * time.Minute
+)
+
+// SetUpgradePlan sets the upgrade of the consumer chain planned on the provider chain
+func (k Keeper) SetUpgradePlan(ctx sdk.Context, plan ccv.ConsumerUpgradePlan) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := plan.Marshal()
+	if err != nil {
+		// This should never happen
+		panic(fmt.Sprintf("could not marshal upgrade plan: %v", err))
+	}
+	store.Set(types.UpgradePlanKey(), bz)
+}
+
+// GetUpgradePlan gets the upgrade of the consumer chain planned on the provider chain
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan ccv.ConsumerUpgradePlan, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UpgradePlanKey())
+	if bz == nil {
+		return plan, false
+	}
+	if err := plan.Unmarshal(bz); err != nil {
+		// This should never happen
+		panic(fmt.Sprintf("could not unmarshal upgrade plan: %v", err))
+	}
+	return plan, true
+}
+
+// DeleteUpgradePlan deletes the upgrade of the consumer chain planned on the provider chain
+func (k Keeper) DeleteUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpgradePlanKey())
+}
+
+// OnRecvConsumerUpgradePacket handles a consumer upgrade packet received from the provider,
+// i.e., it stores the upgrade planned by the owner of the consumer chain, or deletes it if
+// the planned upgrade was cancelled.
+func (k Keeper) OnRecvConsumerUpgradePacket(ctx sdk.Context, packet channeltypes.Packet, data ccv.ConsumerUpgradePacketData) error {
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating consumer upgrade packet data")
+	}
+
+	// get the provider channel
+	providerChannel, found := k.GetProviderChannel(ctx)
+	if found && providerChannel != packet.DestinationChannel {
+		// upgrade packet was sent on a channel different than the provider channel;
+		// this should never happen
+		panic(fmt.Errorf("consumer upgrade packet received on unknown channel %s; expected: %s",
+			packet.DestinationChannel, providerChannel))
+	}
+
+	plan := data.UpgradePlan
+	if data.Cancelled {
+		if current, found := k.GetUpgradePlan(ctx); found && current.Name == plan.Name {
+			k.DeleteUpgradePlan(ctx)
+		}
+	} else {
+		k.SetUpgradePlan(ctx, plan)
+	}
+
+	k.Logger(ctx).Info("finished receiving/handling consumer upgrade packet",
+		"name", plan.Name,
+		"haltHeight", plan.HaltHeight,
+		"haltTime", plan.HaltTime,
+		"cancelled", data.Cancelled,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerUpgradePlan,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeUpgradeCancelled, strconv.FormatBool(data.Cancelled)),
+			}, plan.EventAttributes()...)...,
+		),
+	)
+	return nil
+}
+
+// EmitUpgradeNotice emits an upgrade notice event if the consumer chain is
+// within UpgradeNoticeBlocks of the halt height, or within UpgradeNoticePeriod
+// of the halt time, of the planned upgrade. Once the halt is reached, the planned
+// upgrade is deleted.
+func (k Keeper) EmitUpgradeNotice(ctx sdk.Context) {
+	plan, found := k.GetUpgradePlan(ctx)
+	if !found {
+		return
+	}
+
+	var remaining string
+	if plan.HaltHeight > 0 {
+		blocks := plan.HaltHeight - ctx.BlockHeight()
+		if blocks > UpgradeNoticeBlocks {
+			return
+		}
+		remaining = strconv.FormatInt(max(blocks, 0), 10)
+	} else {
+		period := plan.HaltTime.Sub(ctx.BlockTime())
+		if period > UpgradeNoticePeriod {
+			return
+		}
+		remaining = max(period, 0).String()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerUpgradeNotice,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeUpgradeRemaining, remaining),
+			}, plan.EventAttributes()...)...,
+		),
+	)
+
+	if plan.IsHaltReached(ctx) {
+		k.Logger(ctx).Info("halt of planned upgrade reached", "name", plan.Name)
+		k.DeleteUpgradePlan(ctx)
+	}
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestOnRecvConsumerUpgradePacket tests that planned upgrades received from the provider are stored and cancelled
+func TestOnRecvConsumerUpgradePacket(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+
+	packet := channeltypes.Packet{DestinationChannel: "consumerCCVChannelID"}
+	plan := ccv.ConsumerUpgradePlan{
+		Name:       "v2",
+		HaltHeight: 1000,
+		BinaryHash: bytes.Repeat([]byte{0x01}, 32),
+	}
+
+	// invalid upgrade plans are rejected
+	err := consumerKeeper.OnRecvConsumerUpgradePacket(ctx, packet,
+		ccv.NewConsumerUpgradePacketData(ccv.ConsumerUpgradePlan{Name: "v2"}, false))
+	require.Error(t, err)
+	_, found := consumerKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	err = consumerKeeper.OnRecvConsumerUpgradePacket(ctx, packet, ccv.NewConsumerUpgradePacketData(plan, false))
+	require.NoError(t, err)
+	stored, found := consumerKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, stored)
+
+	// cancelling an upgrade with a different name does not affect the planned upgrade
+	otherPlan := plan
+	otherPlan.Name = "v3"
+	err = consumerKeeper.OnRecvConsumerUpgradePacket(ctx, packet, ccv.NewConsumerUpgradePacketData(otherPlan, true))
+	require.NoError(t, err)
+	_, found = consumerKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+
+	err = consumerKeeper.OnRecvConsumerUpgradePacket(ctx, packet, ccv.NewConsumerUpgradePacketData(plan, true))
+	require.NoError(t, err)
+	_, found = consumerKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// packets received on a channel other than the provider channel panic
+	require.Panics(t, func() {
+		_ = consumerKeeper.OnRecvConsumerUpgradePacket(ctx, channeltypes.Packet{DestinationChannel: "other"},
+			ccv.NewConsumerUpgradePacketData(plan, false))
+	})
+}
+
+// TestEmitUpgradeNotice tests that upgrade notices are emitted ahead of the halt
+// of the planned upgrade and that the planned upgrade is deleted once the halt is reached
+func TestEmitUpgradeNotice(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	noticeEvents := func(ctx sdk.Context) (events []sdk.Event) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == consumertypes.EventTypeConsumerUpgradeNotice {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// halt height
+	consumerKeeper.SetUpgradePlan(ctx, ccv.ConsumerUpgradePlan{
+		Name:       "v2",
+		HaltHeight: 1000,
+		BinaryHash: bytes.Repeat([]byte{0x01}, 32),
+	})
+
+	ctx = ctx.WithBlockHeight(1000 - consumerkeeper.UpgradeNoticeBlocks - 1).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitUpgradeNotice(ctx)
+	require.Empty(t, noticeEvents(ctx))
+
+	ctx = ctx.WithBlockHeight(1000 - consumerkeeper.UpgradeNoticeBlocks).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitUpgradeNotice(ctx)
+	events := noticeEvents(ctx)
+	require.Len(t, events, 1)
+	attr, found := events[0].GetAttribute(ccv.AttributeUpgradeRemaining)
+	require.True(t, found)
+	require.Equal(t, "100", attr.Value)
+	_, found = consumerKeeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(1000).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitUpgradeNotice(ctx)
+	require.Len(t, noticeEvents(ctx), 1)
+	_, found = consumerKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+
+	// halt time
+	haltTime := time.Unix(10000, 0).UTC()
+	consumerKeeper.SetUpgradePlan(ctx, ccv.ConsumerUpgradePlan{
+		Name:       "v3",
+		HaltTime:   haltTime,
+		BinaryHash: bytes.Repeat([]byte{0x01}, 32),
+	})
+
+	ctx = ctx.WithBlockTime(haltTime.Add(-consumerkeeper.UpgradeNoticePeriod - time.Second)).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitUpgradeNotice(ctx)
+	require.Empty(t, noticeEvents(ctx))
+
+	ctx = ctx.WithBlockTime(haltTime.Add(-time.Minute)).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitUpgradeNotice(ctx)
+	events = noticeEvents(ctx)
+	require.Len(t, events, 1)
+	attr, found = events[0].GetAttribute(ccv.AttributeUpgradeRemaining)
+	require.True(t, found)
+	require.Equal(t, time.Minute.String(), attr.Value)
+
+	ctx = ctx.WithBlockTime(haltTime).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitUpgradeNotice(ctx)
+	require.Len(t, noticeEvents(ctx), 1)
+	_, found = consumerKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+}
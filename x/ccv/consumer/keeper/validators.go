@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/math"
@@ -14,6 +15,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 //
@@ -77,6 +79,26 @@ func (k Keeper) ApplyCCValidatorChanges(ctx sdk.Context, changes []abci.Validato
 	return ret
 }
 
+// EmitVSCAppliedEvent emits an event signaling that the validator set changes received from
+// the provider chain, up to the last received VSC packet, were applied to the validator set
+func (k Keeper) EmitVSCAppliedEvent(ctx sdk.Context, numUpdates int) {
+	lastVSC, _ := k.GetLastVSC(ctx)
+	totalPower := int64(0)
+	for _, val := range k.GetAllCCValidator(ctx) {
+		totalPower += val.Power
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVSCApplied,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(lastVSC.ValsetUpdateId, 10)),
+			sdk.NewAttribute(types.AttributeValidatorUpdates, strconv.Itoa(numUpdates)),
+			sdk.NewAttribute(types.AttributeTotalPower, strconv.FormatInt(totalPower, 10)),
+		),
+	)
+}
+
 // IterateValidators - unimplemented on CCV keeper but perform a no-op in order to pass the slashing module InitGenesis.
 // It is allowed since the condition verifying validator public keys in HandleValidatorSignature (x/slashing/keeper/infractions.go) is removed
 // therefore it isn't required to store any validator public keys to the slashing states during genesis.
@@ -139,6 +161,11 @@ func (k Keeper) SlashWithInfractionReason(goCtx context.Context, addr sdk.ConsAd
 		return math.ZeroInt(), nil
 	}
 
+	if infraction == stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN {
+		// evidence of double signing was committed, so the local validator must stop signing if it is the culprit
+		k.checkDoubleSignInterlock(ctx, addr)
+	}
+
 	// If this is a previously standalone chain and infraction happened before the changeover was completed,
 	// slash only on the standalone staking keeper.
 	if k.IsPrevStandaloneChain(ctx) && infractionHeight < k.FirstConsumerHeight(ctx) {
@@ -280,7 +307,20 @@ func (k Keeper) TrackHistoricalInfo(goCtx context.Context) error {
 	}
 
 	// Create HistoricalInfo struct
-	lastVals := []stakingtypes.Validator{}
+	lastVals := k.getCCValidatorsAsStakingValidators(ctx)
+
+	// Create historical info entry which sorts the validator set by voting power
+	historicalEntry := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), stakingtypes.Validators{Validators: lastVals, ValidatorCodec: k.validatorAddressCodec}, sdk.DefaultPowerReduction)
+
+	// Set latest HistoricalInfo at current height
+	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
+	return nil
+}
+
+// getCCValidatorsAsStakingValidators returns the cross-chain validators as bonded staking validators,
+// with the tokens computed from their voting power
+func (k Keeper) getCCValidatorsAsStakingValidators(ctx sdk.Context) []stakingtypes.Validator {
+	vals := []stakingtypes.Validator{}
 	for _, v := range k.GetAllCCValidator(ctx) {
 		pk, err := v.ConsPubKey()
 		if err != nil {
@@ -289,7 +329,14 @@ func (k Keeper) TrackHistoricalInfo(goCtx context.Context) error {
 			panic(err)
 		}
 
-		val, err := stakingtypes.NewValidator(sdk.ValAddress(pk.Address()).String(), pk, stakingtypes.Description{})
+		valAddr, err := k.validatorAddressCodec.BytesToString(pk.Address())
+		if err != nil {
+			// This should never happen as the address of the
+			// pubkey can always be encoded.
+			panic(err)
+		}
+
+		val, err := stakingtypes.NewValidator(valAddr, pk, stakingtypes.Description{})
 		if err != nil {
 			// This should never happen as the pubkey is assumed
 			// to be stored correctly in ApplyCCValidatorChanges.
@@ -300,15 +347,9 @@ func (k Keeper) TrackHistoricalInfo(goCtx context.Context) error {
 		val.Status = stakingtypes.Bonded
 		// Compute tokens from voting power
 		val.Tokens = sdk.TokensFromConsensusPower(v.Power, sdk.DefaultPowerReduction)
-		lastVals = append(lastVals, val)
+		vals = append(vals, val)
 	}
-
-	// Create historical info entry which sorts the validator set by voting power
-	historicalEntry := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), stakingtypes.Validators{Validators: lastVals, ValidatorCodec: k.validatorAddressCodec}, sdk.DefaultPowerReduction)
-
-	// Set latest HistoricalInfo at current height
-	k.SetHistoricalInfo(ctx, ctx.BlockHeight(), &historicalEntry)
-	return nil
+	return vals
 }
 
 // MustGetCurrentValidatorsAsABCIUpdates gets all cross-chain validators converted
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -19,6 +20,7 @@ import (
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // TestApplyCCValidatorChanges tests the ApplyCCValidatorChanges method for a consumer keeper
@@ -110,6 +112,39 @@ func TestApplyCCValidatorChanges(t *testing.T) {
 	}
 }
 
+// TestEmitVSCAppliedEvent tests that the event emitted when validator set changes are applied
+// contains the last valset update id, the number of updates, and the new total power
+func TestEmitVSCAppliedEvent(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	tcValidators := GenerateValidators(t)
+	changes := []abci.ValidatorUpdate{}
+	totalPower := int64(0)
+	for _, v := range tcValidators {
+		changes = append(changes, tmtypes.TM2PB.ValidatorUpdate(v))
+		totalPower += v.VotingPower
+	}
+
+	consumerKeeper.SetLastVSC(ctx, types.LastVSC{ValsetUpdateId: 12})
+	updates := consumerKeeper.ApplyCCValidatorChanges(ctx, changes)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	consumerKeeper.EmitVSCAppliedEvent(ctx, len(updates))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeVSCApplied, events[0].Type)
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, types.ModuleName, attrs[sdk.AttributeKeyModule])
+	require.Equal(t, "12", attrs[ccv.AttributeValSetUpdateID])
+	require.Equal(t, strconv.Itoa(len(changes)), attrs[types.AttributeValidatorUpdates])
+	require.Equal(t, strconv.FormatInt(totalPower, 10), attrs[types.AttributeTotalPower])
+}
+
 // TestIsValidatorJailed tests the IsValidatorJailed method for a consumer keeper
 func TestIsValidatorJailed(t *testing.T) {
 	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
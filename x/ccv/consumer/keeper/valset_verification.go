@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetProviderValsetVerification returns the last verification of the consumer validator set
+// against the valset checkpoint stored on the provider chain
+func (k Keeper) GetProviderValsetVerification(ctx sdk.Context) (types.ProviderValsetVerification, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderValsetVerificationKey())
+	if bz == nil {
+		return types.ProviderValsetVerification{}, false
+	}
+	var verification types.ProviderValsetVerification
+	if err := verification.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the verification is assumed to be correctly serialized in SetProviderValsetVerification.
+		panic(fmt.Errorf("failed to unmarshal provider valset verification: %w", err))
+	}
+	return verification, true
+}
+
+// SetProviderValsetVerification sets the last verification of the consumer validator set
+// against the valset checkpoint stored on the provider chain
+func (k Keeper) SetProviderValsetVerification(ctx sdk.Context, verification types.ProviderValsetVerification) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := verification.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// verification is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal provider valset verification: %w", err))
+	}
+	store.Set(types.ProviderValsetVerificationKey(), bz)
+}
+
+// VerifyProviderValset verifies the proof of the latest valset checkpoint stored on the provider chain
+// for this consumer chain against the consensus state of the provider client at the proof height.
+// It then compares the checkpoint with the validator set the consumer chain will have once all the
+// received VSC packets are applied, records the result and emits an event on mismatch.
+//
+// The verification only relies on the provider client, which makes it possible to detect that the consumer
+// validator set diverged from the provider state even if the CCV channel does not relay packets.
+// Note that the consumer validator set is not updated.
+func (k Keeper) VerifyProviderValset(
+	ctx sdk.Context,
+	proofHeight clienttypes.Height,
+	checkpoint ccv.ValsetCheckpointPacketData,
+	proof []byte,
+) (types.ProviderValsetVerification, error) {
+	// note that the valset update ID can be zero, i.e., the checkpoint of the initial validator set
+	if len(checkpoint.ValsetHash) == 0 {
+		return types.ProviderValsetVerification{}, errorsmod.Wrap(types.ErrInvalidValsetProof, "empty valset hash")
+	}
+	if len(proof) == 0 {
+		return types.ProviderValsetVerification{}, errorsmod.Wrap(types.ErrInvalidValsetProof, "empty proof")
+	}
+	// only accept proofs of more recent provider states
+	if last, found := k.GetProviderValsetVerification(ctx); found && !last.ProofHeight.LT(proofHeight) {
+		return types.ProviderValsetVerification{}, errorsmod.Wrapf(types.ErrStaleValsetProof,
+			"proof height %s is not greater than the last verified proof height %s", proofHeight, last.ProofHeight)
+	}
+
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		return types.ProviderValsetVerification{}, errorsmod.Wrap(clienttypes.ErrClientNotFound, "no client to the provider chain")
+	}
+	value, err := checkpoint.Marshal()
+	if err != nil {
+		return types.ProviderValsetVerification{}, err
+	}
+	path := commitmenttypesv2.NewMerklePath([]byte(ccv.ProviderStoreKey), ccv.ProviderValsetCheckpointKey(k.GetConsumerId(ctx)))
+	if err := k.clientKeeper.VerifyMembership(ctx, clientID, proofHeight, 0, 0, proof, path, value); err != nil {
+		return types.ProviderValsetVerification{}, errorsmod.Wrap(types.ErrInvalidValsetProof, err.Error())
+	}
+
+	valsetHash, err := k.GetNextValsetHash(ctx)
+	if err != nil {
+		return types.ProviderValsetVerification{}, errorsmod.Wrapf(err, "error computing the valset hash")
+	}
+	var lastReceivedVscId uint64
+	if lastVSC, found := k.GetLastVSC(ctx); found {
+		lastReceivedVscId = lastVSC.ValsetUpdateId
+	}
+
+	verification := types.ProviderValsetVerification{
+		ProofHeight:                proofHeight,
+		ValsetUpdateId:             checkpoint.ValsetUpdateId,
+		ProviderValsetHash:         checkpoint.ValsetHash,
+		ConsumerValsetHash:         valsetHash,
+		LastReceivedValsetUpdateId: lastReceivedVscId,
+		Match:                      bytes.Equal(valsetHash, checkpoint.ValsetHash),
+		VerificationHeight:         ctx.BlockHeight(),
+		VerificationTime:           ctx.BlockTime(),
+	}
+	k.SetProviderValsetVerification(ctx, verification)
+
+	if !verification.Match {
+		k.Logger(ctx).Error("provider valset mismatch",
+			"proof height", proofHeight.String(),
+			"vscID", checkpoint.ValsetUpdateId,
+			"last received vscID", lastReceivedVscId,
+			"expected hash", hex.EncodeToString(checkpoint.ValsetHash),
+			"actual hash", hex.EncodeToString(valsetHash),
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProviderValsetMismatch,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeProofHeight, proofHeight.String()),
+				sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(checkpoint.ValsetUpdateId, 10)),
+				sdk.NewAttribute(types.AttributeLastReceivedVSCID, strconv.FormatUint(lastReceivedVscId, 10)),
+				sdk.NewAttribute(types.AttributeExpectedValsetHash, hex.EncodeToString(checkpoint.ValsetHash)),
+				sdk.NewAttribute(types.AttributeActualValsetHash, hex.EncodeToString(valsetHash)),
+			),
+		)
+	}
+
+	return verification, nil
+}
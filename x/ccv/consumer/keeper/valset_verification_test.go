@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypesv2 "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestVerifyProviderValset tests the verification of the consumer validator set against
+// proofs of the valset checkpoints stored on the provider chain
+func TestVerifyProviderValset(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := consumerKeeper.GetConsumerParams(ctx)
+	params.ConsumerId = "13"
+	consumerKeeper.SetParams(ctx, params)
+
+	cId := crypto.NewCryptoIdentityFromIntSeed(7842)
+	ccVal, err := consumertypes.NewCCValidator(cId.SDKValConsAddress(), 10, cId.ConsensusSDKPubKey())
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, ccVal)
+	valsetHash, err := types.ComputeValsetHash([]abci.ValidatorUpdate{{PubKey: cId.TMProtoCryptoPublicKey(), Power: 10}})
+	require.NoError(t, err)
+
+	proof := []byte{0x01}
+	checkpoint := types.NewValsetCheckpointPacketData(5, valsetHash)
+
+	// no client to the provider chain
+	_, err = consumerKeeper.VerifyProviderValset(ctx, clienttypes.NewHeight(1, 10), checkpoint, proof)
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+
+	consumerKeeper.SetProviderClientID(ctx, "07-tendermint-0")
+	expectVerifyMembership := func(checkpoint types.ValsetCheckpointPacketData, proofHeight clienttypes.Height, err error) {
+		value, marshalErr := checkpoint.Marshal()
+		require.NoError(t, marshalErr)
+		path := commitmenttypesv2.NewMerklePath([]byte(types.ProviderStoreKey), types.ProviderValsetCheckpointKey("13"))
+		mocks.MockClientKeeper.EXPECT().VerifyMembership(gomock.Any(), "07-tendermint-0", proofHeight,
+			uint64(0), uint64(0), proof, path, value).Return(err).Times(1)
+	}
+
+	// invalid proof
+	expectVerifyMembership(checkpoint, clienttypes.NewHeight(1, 10), errors.New("invalid proof"))
+	_, err = consumerKeeper.VerifyProviderValset(ctx, clienttypes.NewHeight(1, 10), checkpoint, proof)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidValsetProof)
+	_, found := consumerKeeper.GetProviderValsetVerification(ctx)
+	require.False(t, found)
+
+	// empty proof
+	_, err = consumerKeeper.VerifyProviderValset(ctx, clienttypes.NewHeight(1, 10), checkpoint, []byte{})
+	require.ErrorIs(t, err, consumertypes.ErrInvalidValsetProof)
+
+	// matching checkpoint
+	expectVerifyMembership(checkpoint, clienttypes.NewHeight(1, 10), nil)
+	verification, err := consumerKeeper.VerifyProviderValset(ctx, clienttypes.NewHeight(1, 10), checkpoint, proof)
+	require.NoError(t, err)
+	require.True(t, verification.Match)
+	stored, found := consumerKeeper.GetProviderValsetVerification(ctx)
+	require.True(t, found)
+	require.Equal(t, verification, stored)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// stale proof
+	_, err = consumerKeeper.VerifyProviderValset(ctx, clienttypes.NewHeight(1, 10), checkpoint, proof)
+	require.ErrorIs(t, err, consumertypes.ErrStaleValsetProof)
+
+	// mismatching checkpoint
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	checkpoint = types.NewValsetCheckpointPacketData(6, []byte{0x01})
+	expectVerifyMembership(checkpoint, clienttypes.NewHeight(1, 11), nil)
+	verification, err = consumerKeeper.VerifyProviderValset(ctx, clienttypes.NewHeight(1, 11), checkpoint, proof)
+	require.NoError(t, err)
+	require.False(t, verification.Match)
+	require.Equal(t, uint64(6), verification.ValsetUpdateId)
+	require.Equal(t, valsetHash, verification.ConsumerValsetHash)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeProviderValsetMismatch, events[0].Type)
+}
@@ -25,6 +25,7 @@ func GetConsumerParamsLegacy(ctx sdk.Context, paramSpace ccvtypes.LegacyParamSub
 		getProviderRewardDenoms(ctx, paramSpace),
 		getRetryDelayPeriod(ctx, paramSpace),
 		"0",
+		ccvtypes.DefaultClientExpiryWarningWindow,
 	)
 }
 
@@ -22,6 +22,7 @@ import (
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/client/cli"
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/simulation"
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -91,7 +92,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd implements AppModuleBasic interface
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.NewTxCmd()
 }
 
 // GetQueryCmd implements AppModuleBasic interface
@@ -99,6 +100,13 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.NewQueryCmd()
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	ccvtypes.AddStreamingFlags(startCmd)
+	startCmd.Flags().String(consumertypes.FlagDoubleSignInterlock, "",
+		"consensus address of the local validator on the consumer chain; if set, the node halts after evidence of this validator double signing is committed (post-commit halt)")
+}
+
 // AppModule represents the AppModule for this module
 type AppModule struct {
 	AppModuleBasic
@@ -115,8 +123,8 @@ func NewAppModule(k keeper.Keeper, paramSpace paramtypes.Subspace) AppModule {
 }
 
 // RegisterInvariants implements the AppModule interface
-func (AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // RegisterServices registers module services.
@@ -180,6 +188,8 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 	if err != nil {
 		am.keeper.Logger(ctx).Warn("failed to track historical info", "error", err)
 	}
+
+	am.keeper.EmitUpgradeNotice(ctx)
 	return nil
 }
 
@@ -202,6 +212,13 @@ func (am AppModule) EndBlock(goCtx context.Context) ([]abci.ValidatorUpdate, err
 
 	// panics on invalid packets and unexpected send errors
 	am.keeper.SendPackets(ctx)
+	am.keeper.EndBlockClientExpiry(ctx)
+	am.keeper.EndBlockTelemetry(ctx)
+
+	// halts the node if evidence of the local validator double signing was committed
+	if err := am.keeper.EndBlockDoubleSignInterlock(ctx); err != nil {
+		return nil, err
+	}
 
 	data, ok := am.keeper.GetPendingChanges(ctx)
 	if !ok {
@@ -210,6 +227,9 @@ func (am AppModule) EndBlock(goCtx context.Context) ([]abci.ValidatorUpdate, err
 	// apply changes to cross-chain validator set
 	tendermintUpdates := am.keeper.ApplyCCValidatorChanges(ctx, data.ValidatorUpdates)
 	am.keeper.DeletePendingChanges(ctx)
+	am.keeper.EmitVSCAppliedEvent(ctx, len(tendermintUpdates))
+	lastVSC, _ := am.keeper.GetLastVSC(ctx)
+	am.keeper.AfterVSCApplied(ctx, lastVSC.ValsetUpdateId, tendermintUpdates)
 
 	am.keeper.Logger(ctx).Debug("sending validator updates to consensus engine", "len updates", len(tendermintUpdates))
 
@@ -218,9 +238,9 @@ func (am AppModule) EndBlock(goCtx context.Context) ([]abci.ValidatorUpdate, err
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the transfer module.
-// TODO
+// GenerateGenesisState creates a randomized GenState of the consumer module.
 func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
 // RegisterStoreDecoder registers a decoder for consumer module's types
@@ -229,6 +249,8 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 }
 
 // WeightedOperations returns the all the consumer module operations with their respective weights.
+// The consumer module has no operations, as its messages require either the governance
+// or proofs from the provider chain, which is not part of the simulation.
 func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
 	return nil
 }
@@ -0,0 +1,64 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// Simulation parameter constants
+const (
+	// only includes params that make sense without a provider chain
+	blocksPerDistributionTransmission = "blocks_per_distribution_transmission"
+	consumerRedistributionFraction    = "consumer_redistribution_fraction"
+	historicalEntries                 = "historical_entries"
+)
+
+// genBlocksPerDistributionTransmission returns randomized blocksPerDistributionTransmission
+func genBlocksPerDistributionTransmission(r *rand.Rand) int64 {
+	return int64(r.Intn(2000) + 1)
+}
+
+// genConsumerRedistributionFraction returns randomized consumerRedistributionFraction
+func genConsumerRedistributionFraction(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(101)), 2).String()
+}
+
+// genHistoricalEntries returns randomized historicalEntries
+func genHistoricalEntries(r *rand.Rand) int64 {
+	return int64(r.Intn(10000) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for the consumer module.
+// Note that the CCV channel cannot be established in the simulation,
+// i.e., the module is disabled as in the default genesis state.
+func RandomizedGenState(simState *module.SimulationState) {
+	// params
+	var (
+		blocksPerDistTransmission int64
+		redistributionFraction    string
+		numHistoricalEntries      int64
+	)
+
+	simState.AppParams.GetOrGenerate(blocksPerDistributionTransmission, &blocksPerDistTransmission, simState.Rand, func(r *rand.Rand) { blocksPerDistTransmission = genBlocksPerDistributionTransmission(r) })
+	simState.AppParams.GetOrGenerate(consumerRedistributionFraction, &redistributionFraction, simState.Rand, func(r *rand.Rand) { redistributionFraction = genConsumerRedistributionFraction(r) })
+	simState.AppParams.GetOrGenerate(historicalEntries, &numHistoricalEntries, simState.Rand, func(r *rand.Rand) { numHistoricalEntries = genHistoricalEntries(r) })
+
+	consumerGenesis := types.DefaultGenesisState()
+	consumerGenesis.Params.BlocksPerDistributionTransmission = blocksPerDistTransmission
+	consumerGenesis.Params.ConsumerRedistributionFraction = redistributionFraction
+	consumerGenesis.Params.HistoricalEntries = numHistoricalEntries
+
+	bz, err := json.MarshalIndent(&consumerGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated consumer parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(consumerGenesis)
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -163,9 +164,243 @@ func (m *SlashRecord) GetSendTime() time.Time {
 	return time.Time{}
 }
 
+// PendingPacketEnqueueRecord records when a packet was added to the pending
+// packets queue.
+//
+// Note this type is only used internally to the consumer CCV module.
+type PendingPacketEnqueueRecord struct {
+	Height int64     `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *PendingPacketEnqueueRecord) Reset()         { *m = PendingPacketEnqueueRecord{} }
+func (m *PendingPacketEnqueueRecord) String() string { return proto.CompactTextString(m) }
+func (*PendingPacketEnqueueRecord) ProtoMessage()    {}
+func (*PendingPacketEnqueueRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{2}
+}
+func (m *PendingPacketEnqueueRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPacketEnqueueRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPacketEnqueueRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPacketEnqueueRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacketEnqueueRecord.Merge(m, src)
+}
+func (m *PendingPacketEnqueueRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPacketEnqueueRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacketEnqueueRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPacketEnqueueRecord proto.InternalMessageInfo
+
+func (m *PendingPacketEnqueueRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingPacketEnqueueRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// LastVSC records the last VSC packet received from the provider chain.
+//
+// Note this type is only used internally to the consumer CCV module.
+type LastVSC struct {
+	ValsetUpdateId uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the height and time of the block in which the VSC packet was received
+	RecvHeight int64     `protobuf:"varint,2,opt,name=recv_height,json=recvHeight,proto3" json:"recv_height,omitempty"`
+	RecvTime   time.Time `protobuf:"bytes,3,opt,name=recv_time,json=recvTime,proto3,stdtime" json:"recv_time"`
+}
+
+func (m *LastVSC) Reset()         { *m = LastVSC{} }
+func (m *LastVSC) String() string { return proto.CompactTextString(m) }
+func (*LastVSC) ProtoMessage()    {}
+func (*LastVSC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{3}
+}
+func (m *LastVSC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastVSC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastVSC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastVSC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastVSC.Merge(m, src)
+}
+func (m *LastVSC) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastVSC) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastVSC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastVSC proto.InternalMessageInfo
+
+func (m *LastVSC) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *LastVSC) GetRecvHeight() int64 {
+	if m != nil {
+		return m.RecvHeight
+	}
+	return 0
+}
+
+func (m *LastVSC) GetRecvTime() time.Time {
+	if m != nil {
+		return m.RecvTime
+	}
+	return time.Time{}
+}
+
+// ProviderValsetVerification records the last verification of the validator set
+// of the consumer chain against the latest valset checkpoint stored on the provider chain.
+//
+// Note this type is only used internally to the consumer CCV module.
+type ProviderValsetVerification struct {
+	// the height of the provider chain at which the checkpoint was proven
+	ProofHeight types1.Height `protobuf:"bytes,1,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the valset update ID and the valset hash of the provider checkpoint
+	ValsetUpdateId     uint64 `protobuf:"varint,2,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	ProviderValsetHash []byte `protobuf:"bytes,3,opt,name=provider_valset_hash,json=providerValsetHash,proto3" json:"provider_valset_hash,omitempty"`
+	// the hash of the validator set the consumer chain will have
+	// once all the received VSC packets are applied
+	ConsumerValsetHash []byte `protobuf:"bytes,4,opt,name=consumer_valset_hash,json=consumerValsetHash,proto3" json:"consumer_valset_hash,omitempty"`
+	// the valset update ID of the last VSC packet received by the consumer chain
+	LastReceivedValsetUpdateId uint64 `protobuf:"varint,5,opt,name=last_received_valset_update_id,json=lastReceivedValsetUpdateId,proto3" json:"last_received_valset_update_id,omitempty"`
+	// whether the consumer valset hash matches the provider valset hash
+	Match bool `protobuf:"varint,6,opt,name=match,proto3" json:"match,omitempty"`
+	// the height and time of the block in which the verification was done
+	VerificationHeight int64     `protobuf:"varint,7,opt,name=verification_height,json=verificationHeight,proto3" json:"verification_height,omitempty"`
+	VerificationTime   time.Time `protobuf:"bytes,8,opt,name=verification_time,json=verificationTime,proto3,stdtime" json:"verification_time"`
+}
+
+func (m *ProviderValsetVerification) Reset()         { *m = ProviderValsetVerification{} }
+func (m *ProviderValsetVerification) String() string { return proto.CompactTextString(m) }
+func (*ProviderValsetVerification) ProtoMessage()    {}
+func (*ProviderValsetVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{4}
+}
+func (m *ProviderValsetVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderValsetVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderValsetVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderValsetVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderValsetVerification.Merge(m, src)
+}
+func (m *ProviderValsetVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderValsetVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderValsetVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderValsetVerification proto.InternalMessageInfo
+
+func (m *ProviderValsetVerification) GetProofHeight() types1.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types1.Height{}
+}
+
+func (m *ProviderValsetVerification) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ProviderValsetVerification) GetProviderValsetHash() []byte {
+	if m != nil {
+		return m.ProviderValsetHash
+	}
+	return nil
+}
+
+func (m *ProviderValsetVerification) GetConsumerValsetHash() []byte {
+	if m != nil {
+		return m.ConsumerValsetHash
+	}
+	return nil
+}
+
+func (m *ProviderValsetVerification) GetLastReceivedValsetUpdateId() uint64 {
+	if m != nil {
+		return m.LastReceivedValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ProviderValsetVerification) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
+}
+
+func (m *ProviderValsetVerification) GetVerificationHeight() int64 {
+	if m != nil {
+		return m.VerificationHeight
+	}
+	return 0
+}
+
+func (m *ProviderValsetVerification) GetVerificationTime() time.Time {
+	if m != nil {
+		return m.VerificationTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*CrossChainValidator)(nil), "interchain_security.ccv.consumer.v1.CrossChainValidator")
 	proto.RegisterType((*SlashRecord)(nil), "interchain_security.ccv.consumer.v1.SlashRecord")
+	proto.RegisterType((*PendingPacketEnqueueRecord)(nil), "interchain_security.ccv.consumer.v1.PendingPacketEnqueueRecord")
+	proto.RegisterType((*LastVSC)(nil), "interchain_security.ccv.consumer.v1.LastVSC")
+	proto.RegisterType((*ProviderValsetVerification)(nil), "interchain_security.ccv.consumer.v1.ProviderValsetVerification")
 }
 
 func init() {
@@ -173,35 +408,53 @@ func init() {
 }
 
 var fileDescriptor_5b27a82b276e7f93 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0xb6, 0x50, 0xdc, 0x0d, 0x42, 0xc8, 0x44, 0xc2, 0xcd, 0xc1, 0x8e, 0xc2, 0xc5, 0x97,
-	0xda, 0x6a, 0x7a, 0x40, 0x42, 0xe2, 0xd0, 0xf4, 0xc8, 0xa1, 0xc8, 0x20, 0x90, 0xb8, 0x58, 0xeb,
-	0xf5, 0xe2, 0x58, 0xd8, 0x3b, 0xab, 0xfd, 0x71, 0x59, 0x9e, 0xa2, 0x0f, 0xc3, 0x43, 0x14, 0x4e,
-	0x3d, 0x72, 0x2a, 0x28, 0x79, 0x03, 0x9e, 0x00, 0xf9, 0x27, 0x41, 0xfc, 0xdc, 0x66, 0xbe, 0xd9,
-	0x6f, 0xe6, 0x9b, 0xd9, 0x0f, 0x2f, 0x4a, 0xae, 0x99, 0xa4, 0x2b, 0x52, 0xf2, 0x54, 0x31, 0x6a,
-	0x64, 0xa9, 0x6d, 0x4c, 0x69, 0x13, 0x53, 0xe0, 0xca, 0xd4, 0x4c, 0xc6, 0xcd, 0xc9, 0x2e, 0x8e,
-	0x84, 0x04, 0x0d, 0xee, 0x93, 0xff, 0x70, 0x22, 0x4a, 0x9b, 0x68, 0xf7, 0xae, 0x39, 0x99, 0x1e,
-	0x15, 0x00, 0x45, 0xc5, 0xe2, 0x8e, 0x92, 0x99, 0xf7, 0x31, 0xe1, 0xb6, 0xe7, 0x4f, 0x27, 0x05,
-	0x14, 0xd0, 0x85, 0x71, 0x1b, 0x0d, 0xe8, 0x11, 0x05, 0x55, 0x83, 0x4a, 0xfb, 0x42, 0x9f, 0x0c,
-	0xa5, 0xe0, 0xef, 0x5e, 0xba, 0xac, 0x99, 0xd2, 0xa4, 0x16, 0xfd, 0x83, 0xf9, 0x17, 0x84, 0x1f,
-	0x9d, 0x4b, 0x50, 0xea, 0xbc, 0x15, 0xf5, 0x86, 0x54, 0x65, 0x4e, 0x34, 0x48, 0xd7, 0xc3, 0xf7,
-	0x48, 0x9e, 0x4b, 0xa6, 0x94, 0x87, 0x66, 0x28, 0xbc, 0x9f, 0x6c, 0x53, 0x77, 0x82, 0xef, 0x0a,
-	0xb8, 0x64, 0xd2, 0xdb, 0x9b, 0xa1, 0x70, 0x3f, 0xe9, 0x13, 0x97, 0xe0, 0x03, 0x61, 0xb2, 0x0f,
-	0xcc, 0x7a, 0xfb, 0x33, 0x14, 0x8e, 0x17, 0x93, 0xa8, 0x9f, 0x1c, 0x6d, 0x27, 0x47, 0x67, 0xdc,
-	0x2e, 0x4f, 0x7f, 0xde, 0x06, 0x8f, 0x2d, 0xa9, 0xab, 0x67, 0xf3, 0x76, 0x63, 0xc6, 0x95, 0x51,
-	0x69, 0xcf, 0x9b, 0x7f, 0xfd, 0x7c, 0x3c, 0x19, 0xb4, 0x53, 0x69, 0x85, 0x86, 0xe8, 0xa5, 0xc9,
-	0x5e, 0x30, 0x9b, 0x0c, 0x8d, 0xdd, 0x00, 0x1f, 0x82, 0xd0, 0x2c, 0x4f, 0xc1, 0x68, 0xef, 0xce,
-	0x0c, 0x85, 0xce, 0x72, 0xcf, 0x43, 0x89, 0xd3, 0x81, 0x17, 0x46, 0xcf, 0x3f, 0xe1, 0xf1, 0xab,
-	0x8a, 0xa8, 0x55, 0xc2, 0x28, 0xc8, 0xdc, 0x0d, 0xf1, 0xc3, 0x4b, 0x52, 0xea, 0x92, 0x17, 0x29,
-	0xf0, 0x54, 0x32, 0x51, 0xd9, 0x6e, 0x17, 0x27, 0x79, 0x30, 0xe0, 0x17, 0x3c, 0x69, 0x51, 0xf7,
-	0x0c, 0x1f, 0x2a, 0xc6, 0xf3, 0xb4, 0x3d, 0x4e, 0xb7, 0xd6, 0x78, 0x31, 0xfd, 0x47, 0xff, 0xeb,
-	0xed, 0xe5, 0x96, 0xce, 0xf5, 0x6d, 0x30, 0xba, 0xfa, 0x1e, 0xa0, 0xc4, 0x69, 0x69, 0x6d, 0x61,
-	0xf9, 0xf6, 0x7a, 0xed, 0xa3, 0x9b, 0xb5, 0x8f, 0x7e, 0xac, 0x7d, 0x74, 0xb5, 0xf1, 0x47, 0x37,
-	0x1b, 0x7f, 0xf4, 0x6d, 0xe3, 0x8f, 0xde, 0x3d, 0x2f, 0x4a, 0xbd, 0x32, 0x59, 0x44, 0xa1, 0x1e,
-	0xfe, 0x26, 0xfe, 0xed, 0x82, 0xe3, 0x9d, 0x73, 0x9a, 0xa7, 0xf1, 0xc7, 0x3f, 0xed, 0xa3, 0xad,
-	0x60, 0x2a, 0x3b, 0xe8, 0x04, 0x9c, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x93, 0x59, 0x99, 0x3a,
-	0x6f, 0x02, 0x00, 0x00,
+	// 722 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x41, 0x6f, 0xd3, 0x3c,
+	0x18, 0xc7, 0x9b, 0xb5, 0xeb, 0x3a, 0x77, 0x9a, 0xf6, 0x66, 0xd5, 0x4b, 0xd7, 0x43, 0x5b, 0x95,
+	0x4b, 0x2f, 0x4b, 0xd8, 0x76, 0x00, 0x21, 0x71, 0x58, 0x2b, 0xa4, 0x21, 0x90, 0x56, 0x32, 0x28,
+	0x12, 0x97, 0xc8, 0x75, 0xbc, 0xc4, 0x5a, 0x6a, 0x07, 0xdb, 0xc9, 0x08, 0x9f, 0x62, 0x37, 0xbe,
+	0x08, 0x1f, 0x62, 0x20, 0x21, 0xed, 0xc8, 0x69, 0xa0, 0xed, 0x1b, 0xf0, 0x09, 0x90, 0x1d, 0x77,
+	0xb4, 0x6c, 0x97, 0xdd, 0xec, 0xe7, 0x79, 0xfe, 0x79, 0x7e, 0xcf, 0xdf, 0x8e, 0xc1, 0x2e, 0xa1,
+	0x12, 0x73, 0x14, 0x41, 0x42, 0x7d, 0x81, 0x51, 0xca, 0x89, 0xcc, 0x5d, 0x84, 0x32, 0x17, 0x31,
+	0x2a, 0xd2, 0x29, 0xe6, 0x6e, 0xb6, 0x73, 0xb3, 0x76, 0x12, 0xce, 0x24, 0xb3, 0x1f, 0xde, 0xa1,
+	0x71, 0x10, 0xca, 0x9c, 0x9b, 0xba, 0x6c, 0xa7, 0xb5, 0x15, 0x32, 0x16, 0xc6, 0xd8, 0xd5, 0x92,
+	0x49, 0x7a, 0xec, 0x42, 0x9a, 0x17, 0xfa, 0x56, 0x23, 0x64, 0x21, 0xd3, 0x4b, 0x57, 0xad, 0x4c,
+	0x74, 0x0b, 0x31, 0x31, 0x65, 0xc2, 0x2f, 0x12, 0xc5, 0xc6, 0xa4, 0x3a, 0xff, 0x7e, 0x4b, 0x92,
+	0x29, 0x16, 0x12, 0x4e, 0x93, 0x59, 0x01, 0x99, 0x20, 0x17, 0x31, 0x8e, 0x5d, 0x14, 0x13, 0x4c,
+	0xa5, 0x86, 0xd6, 0xab, 0xa2, 0xa0, 0xf7, 0xd5, 0x02, 0x9b, 0x43, 0xce, 0x84, 0x18, 0x2a, 0xea,
+	0x31, 0x8c, 0x49, 0x00, 0x25, 0xe3, 0x76, 0x13, 0xac, 0xc0, 0x20, 0xe0, 0x58, 0x88, 0xa6, 0xd5,
+	0xb5, 0xfa, 0x6b, 0xde, 0x6c, 0x6b, 0x37, 0xc0, 0x72, 0xc2, 0x4e, 0x31, 0x6f, 0x2e, 0x75, 0xad,
+	0x7e, 0xd9, 0x2b, 0x36, 0x36, 0x04, 0xd5, 0x24, 0x9d, 0x9c, 0xe0, 0xbc, 0x59, 0xee, 0x5a, 0xfd,
+	0xfa, 0x6e, 0xc3, 0x29, 0xd0, 0x9c, 0x19, 0x9a, 0xb3, 0x4f, 0xf3, 0xc1, 0xde, 0xef, 0xcb, 0xce,
+	0x83, 0x1c, 0x4e, 0xe3, 0xa7, 0x3d, 0x65, 0x09, 0xa6, 0x22, 0x15, 0x7e, 0xa1, 0xeb, 0x7d, 0xfb,
+	0xb2, 0xdd, 0x30, 0xc3, 0x21, 0x9e, 0x27, 0x92, 0x39, 0xa3, 0x74, 0xf2, 0x12, 0xe7, 0x9e, 0xf9,
+	0xb0, 0xdd, 0x01, 0xab, 0x2c, 0x91, 0x38, 0xf0, 0x59, 0x2a, 0x9b, 0x95, 0xae, 0xd5, 0xaf, 0x0d,
+	0x96, 0x9a, 0x96, 0x57, 0xd3, 0xc1, 0xc3, 0x54, 0xf6, 0x3e, 0x81, 0xfa, 0x51, 0x0c, 0x45, 0xe4,
+	0x61, 0xc4, 0x78, 0x60, 0xf7, 0xc1, 0xc6, 0x29, 0x24, 0x92, 0xd0, 0xd0, 0x67, 0xd4, 0xe7, 0x38,
+	0x89, 0x73, 0x3d, 0x4b, 0xcd, 0x5b, 0x37, 0xf1, 0x43, 0xea, 0xa9, 0xa8, 0xbd, 0x0f, 0x56, 0x05,
+	0xa6, 0x81, 0xaf, 0xdc, 0xd3, 0x63, 0xd5, 0x77, 0x5b, 0xb7, 0xf8, 0xdf, 0xcc, 0xac, 0x1d, 0xd4,
+	0xce, 0x2f, 0x3b, 0xa5, 0xb3, 0x9f, 0x1d, 0xcb, 0xab, 0x29, 0x99, 0x4a, 0xf4, 0x28, 0x68, 0x8d,
+	0x30, 0x0d, 0x08, 0x0d, 0x47, 0x10, 0x9d, 0x60, 0xf9, 0x9c, 0x7e, 0x48, 0x71, 0x8a, 0x0d, 0xca,
+	0xff, 0xa0, 0x1a, 0x61, 0x12, 0x46, 0x52, 0x03, 0x94, 0x3d, 0xb3, 0xb3, 0x9f, 0x80, 0xca, 0xbd,
+	0x7b, 0x6a, 0x45, 0xef, 0xb3, 0x05, 0x56, 0x5e, 0x41, 0x21, 0xc7, 0x47, 0x43, 0x35, 0x68, 0x06,
+	0x63, 0x81, 0xa5, 0x9f, 0x26, 0x01, 0x94, 0xd8, 0x27, 0x81, 0xee, 0x53, 0xf1, 0xd6, 0x8b, 0xf8,
+	0x5b, 0x1d, 0x7e, 0x11, 0xd8, 0x1d, 0x50, 0xe7, 0x18, 0x65, 0xbe, 0x81, 0x29, 0x4e, 0x10, 0xa8,
+	0xd0, 0x41, 0x01, 0xb4, 0x0f, 0x56, 0x75, 0x81, 0xa6, 0x2a, 0xdf, 0xc7, 0x09, 0x25, 0xd3, 0x4e,
+	0x7c, 0x2f, 0x83, 0xd6, 0x88, 0xb3, 0x8c, 0x04, 0x98, 0x8f, 0x75, 0xfb, 0x31, 0xe6, 0xe4, 0x98,
+	0x20, 0x28, 0x09, 0xa3, 0xf6, 0x10, 0xac, 0x25, 0x9c, 0xb1, 0x63, 0x7f, 0xce, 0x10, 0xd5, 0x84,
+	0x4c, 0x90, 0xa3, 0x2e, 0xaa, 0x63, 0xae, 0x67, 0xb6, 0xe3, 0x14, 0x4c, 0x83, 0x8a, 0x6a, 0xe2,
+	0xd5, 0xb5, 0xca, 0x60, 0xde, 0x35, 0xf1, 0xd2, 0x9d, 0x13, 0x3f, 0x02, 0x8d, 0xc4, 0xc0, 0xf8,
+	0x46, 0x12, 0x41, 0x11, 0xe9, 0xd9, 0xd6, 0x3c, 0x3b, 0x59, 0x00, 0x3d, 0x80, 0x22, 0x52, 0x8a,
+	0xd9, 0xef, 0xba, 0xa0, 0xa8, 0x14, 0x8a, 0x59, 0x6e, 0x4e, 0x31, 0x00, 0xed, 0x18, 0x0a, 0xe9,
+	0x73, 0x8c, 0x30, 0xc9, 0x70, 0xe0, 0xdf, 0x62, 0x5b, 0xd6, 0x6c, 0x2d, 0x55, 0xe5, 0x99, 0xa2,
+	0xf1, 0x22, 0x67, 0x03, 0x2c, 0x4f, 0xa1, 0x44, 0x51, 0xb3, 0xaa, 0x6f, 0x68, 0xb1, 0xb1, 0x5d,
+	0xb0, 0x99, 0xcd, 0x99, 0x37, 0xf3, 0x6c, 0x45, 0x9f, 0x9b, 0x3d, 0x9f, 0x32, 0xc6, 0xbc, 0x06,
+	0xff, 0x2d, 0x08, 0xf4, 0x39, 0xd6, 0xee, 0x71, 0x8e, 0x1b, 0xf3, 0x72, 0x55, 0x30, 0x78, 0x77,
+	0x7e, 0xd5, 0xb6, 0x2e, 0xae, 0xda, 0xd6, 0xaf, 0xab, 0xb6, 0x75, 0x76, 0xdd, 0x2e, 0x5d, 0x5c,
+	0xb7, 0x4b, 0x3f, 0xae, 0xdb, 0xa5, 0xf7, 0xcf, 0x42, 0x22, 0xa3, 0x74, 0xe2, 0x20, 0x36, 0x35,
+	0xcf, 0x92, 0xfb, 0xf7, 0x01, 0xdc, 0xbe, 0x79, 0x34, 0xb3, 0xc7, 0xee, 0xc7, 0xc5, 0x97, 0x53,
+	0xe6, 0x09, 0x16, 0x93, 0xaa, 0x06, 0xd9, 0xfb, 0x33, 0x00, 0x3f, 0x49, 0x96, 0xf0, 0x6a, 0x05,
+	0x00, 0x00,
 }
 
 func (m *CrossChainValidator) Marshal() (dAtA []byte, err error) {
@@ -296,67 +549,652 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintConsumer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovConsumer(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *CrossChainValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovConsumer(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovConsumer(uint64(m.Power))
-	}
-	if m.Pubkey != nil {
-		l = m.Pubkey.Size()
-		n += 1 + l + sovConsumer(uint64(l))
-	}
-	if m.OptedOut {
-		n += 2
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPacketEnqueueRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPacketEnqueueRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPacketEnqueueRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintConsumer(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastVSC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastVSC) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastVSC) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintConsumer(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.RecvHeight != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.RecvHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderValsetVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderValsetVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderValsetVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VerificationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VerificationTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintConsumer(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x42
+	if m.VerificationHeight != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.VerificationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Match {
+		i--
+		if m.Match {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastReceivedValsetUpdateId != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.LastReceivedValsetUpdateId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ConsumerValsetHash) > 0 {
+		i -= len(m.ConsumerValsetHash)
+		copy(dAtA[i:], m.ConsumerValsetHash)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ConsumerValsetHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProviderValsetHash) > 0 {
+		i -= len(m.ProviderValsetHash)
+		copy(dAtA[i:], m.ProviderValsetHash)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ProviderValsetHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConsumer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConsumer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConsumer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CrossChainValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovConsumer(uint64(m.Power))
+	}
+	if m.Pubkey != nil {
+		l = m.Pubkey.Size()
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	if m.OptedOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WaitingOnReply {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovConsumer(uint64(l))
+	return n
+}
+
+func (m *PendingPacketEnqueueRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovConsumer(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovConsumer(uint64(l))
+	return n
+}
+
+func (m *LastVSC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovConsumer(uint64(m.ValsetUpdateId))
+	}
+	if m.RecvHeight != 0 {
+		n += 1 + sovConsumer(uint64(m.RecvHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
+	n += 1 + l + sovConsumer(uint64(l))
+	return n
+}
+
+func (m *ProviderValsetVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovConsumer(uint64(l))
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovConsumer(uint64(m.ValsetUpdateId))
+	}
+	l = len(m.ProviderValsetHash)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = len(m.ConsumerValsetHash)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	if m.LastReceivedValsetUpdateId != 0 {
+		n += 1 + sovConsumer(uint64(m.LastReceivedValsetUpdateId))
+	}
+	if m.Match {
+		n += 2
+	}
+	if m.VerificationHeight != 0 {
+		n += 1 + sovConsumer(uint64(m.VerificationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VerificationTime)
+	n += 1 + l + sovConsumer(uint64(l))
+	return n
+}
+
+func sovConsumer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConsumer(x uint64) (n int) {
+	return sovConsumer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptedOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingOnReply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitingOnReply = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPacketEnqueueRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPacketEnqueueRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPacketEnqueueRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *SlashRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WaitingOnReply {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
-	n += 1 + l + sovConsumer(uint64(l))
-	return n
-}
-
-func sovConsumer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozConsumer(x uint64) (n int) {
-	return sovConsumer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
+func (m *LastVSC) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -379,17 +1217,17 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CrossChainValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: LastVSC: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CrossChainValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LastVSC: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
 			}
-			var byteLen int
+			m.ValsetUpdateId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConsumer
@@ -399,31 +1237,16 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthConsumer
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthConsumer
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvHeight", wireType)
 			}
-			m.Power = 0
+			m.RecvHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConsumer
@@ -433,14 +1256,14 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.RecvHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -467,33 +1290,10 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &types.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowConsumer
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.OptedOut = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConsumer(dAtA[iNdEx:])
@@ -515,7 +1315,7 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+func (m *ProviderValsetVerification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -538,15 +1338,154 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProviderValsetVerification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProviderValsetVerification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitingOnReply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValsetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderValsetHash = append(m.ProviderValsetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderValsetHash == nil {
+				m.ProviderValsetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerValsetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerValsetHash = append(m.ConsumerValsetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsumerValsetHash == nil {
+				m.ConsumerValsetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReceivedValsetUpdateId", wireType)
+			}
+			m.LastReceivedValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastReceivedValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -563,10 +1502,29 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.WaitingOnReply = bool(v != 0)
-		case 2:
+			m.Match = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHeight", wireType)
+			}
+			m.VerificationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerificationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -593,7 +1551,7 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.VerificationTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
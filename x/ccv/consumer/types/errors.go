@@ -8,4 +8,8 @@ import (
 var (
 	ErrNoProposerChannelId                  = errorsmod.Register(ModuleName, 1, "no established CCV channel")
 	ErrConsumerRewardDenomAlreadyRegistered = errorsmod.Register(ModuleName, 2, "consumer reward denom already registered")
+	ErrInvalidValsetProof                   = errorsmod.Register(ModuleName, 3, "invalid provider valset proof")
+	ErrStaleValsetProof                     = errorsmod.Register(ModuleName, 4, "stale provider valset proof")
+	ErrInvalidProviderFeePoolAddr           = errorsmod.Register(ModuleName, 5, "invalid provider fee pool address")
+	ErrInvalidICAHostAllowMessages          = errorsmod.Register(ModuleName, 6, "invalid interchain accounts host allowed messages")
 )
@@ -4,10 +4,42 @@ const (
 	AttributeConsumerHeight = "consumer_height"
 	AttributeTimestamp      = "timestamp"
 
-	EventTypeFeeDistribution          = "fee_distribution"
-	EventTypeVSCMatured               = "vsc_matured"
-	EventTypeConsumerSlashRequest     = "consumer_slash_request"
-	EventTypeFeeTransferChannelOpened = "fee_transfer_channel_opened"
+	EventTypeFeeDistribution           = "fee_distribution"
+	EventTypeFeeRedistribution         = "fee_redistribution"
+	EventTypeVSCMatured                = "vsc_matured"
+	EventTypeConsumerSlashRequest      = "consumer_slash_request"
+	EventTypeFeeTransferChannelOpened  = "fee_transfer_channel_opened"
+	EventTypeValsetCheckpointMismatch  = "valset_checkpoint_mismatch"
+	EventTypeConsumerUpgradePlan       = "consumer_upgrade_plan"
+	EventTypeConsumerUpgradeNotice     = "consumer_upgrade_notice"
+	EventTypeProviderValsetMismatch    = "provider_valset_mismatch"
+	EventTypeDowntimeParamsUpdate      = "downtime_params_update"
+	EventTypeVSCApplied                = "vsc_applied"
+	EventTypePacketQueued              = "consumer_packet_queued"
+	EventTypePacketSent                = "consumer_packet_sent"
+	EventTypePacketAcknowledged        = "consumer_packet_acknowledged"
+	EventTypeProviderFeePoolAddrUpdate = "provider_fee_pool_addr_update"
+	EventTypeRewardTransmissionPaused  = "reward_transmission_paused"
+	EventTypeRewardTransmissionResumed = "reward_transmission_resumed"
+
+	AttributeExpectedValsetHash  = "expected_valset_hash"
+	AttributeActualValsetHash    = "actual_valset_hash"
+	AttributeUpgradeCancelled    = "upgrade_cancelled"
+	AttributeProofHeight         = "proof_height"
+	AttributeLastReceivedVSCID   = "last_received_valset_update_id"
+	AttributeValidatorUpdates    = "validator_updates"
+	AttributeTotalPower          = "total_power"
+	AttributePacketType          = "packet_type"
+	AttributePacketIndex         = "packet_index"
+	AttributePacketSequence      = "packet_sequence"
+	AttributeAckResult           = "ack_result"
+	AttributePreviousFeePoolAddr = "previous_provider_fee_pool_addr"
+
+	// the values of the ack_result attribute
+	AckResultReceived = "received"
+	AckResultHandled  = "handled"
+	AckResultBounced  = "bounced"
+	AckResultError    = "error"
 
 	AttributeDistributionCurrentHeight = "current_distribution_height"
 	//#nosec G101 -- (false positive) this is not a hardcoded credential
@@ -15,4 +47,5 @@ const (
 	AttributeDistributionFraction   = "distribution_fraction"
 	AttributeDistributionTotal      = "total"
 	AttributeDistributionToProvider = "provider_amount"
+	AttributeDistributionToConsumer = "consumer_amount"
 )
@@ -1,10 +1,15 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -74,11 +79,12 @@ func NewInitialGenesisState(cs *ibctmtypes.ClientState, consState *ibctmtypes.Co
 //
 // 2. Chain restarts with CCV handshake still in progress:
 //   - Params, InitialValset, ProviderID, HeightToValidatorSetUpdateID // mandatory
-//   - PendingConsumerPacket // optional
+//   - OutstandingDowntime, PendingConsumerPacket, PendingPacketEnqueueRecords // optional
 //
 // 3. Chain restarts with CCV handshake completed:
 //   - Params, InitialValset, ProviderID, channelID, HeightToValidatorSetUpdateID // mandatory
-//   - MaturingVSCPackets, OutstandingDowntime, PendingConsumerPacket, LastTransmissionBlockHeight // optional
+//   - MaturingVSCPackets, OutstandingDowntime, PendingConsumerPacket, PendingPacketEnqueueRecords,
+//     LastTransmissionBlockHeight, SlashRecord // optional
 func (gs GenesisState) Validate() error {
 	if !gs.Params.Enabled {
 		return nil
@@ -136,6 +142,15 @@ func (gs GenesisState) Validate() error {
 		if gs.LastTransmissionBlockHeight.Height != 0 {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "last transmission block height must be empty for new chain")
 		}
+		if gs.SlashRecord != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "slash record must be nil for new chain")
+		}
+		if len(gs.PendingPacketEnqueueRecords) != 0 {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "pending packet enqueue records must be empty for new chain")
+		}
+		if gs.VerifyGenesisHash && len(gs.GenesisHash) != sha256.Size {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "genesis hash must be a SHA256 hash when its verification is required; got: %d bytes", len(gs.GenesisHash))
+		}
 	} else {
 		// NOTE: For restart genesis, we will verify initial validator set in InitGenesis.
 		if gs.ProviderClientId == "" {
@@ -144,9 +159,9 @@ func (gs GenesisState) Validate() error {
 		// handshake is still in progress
 		handshakeInProgress := gs.ProviderChannelId == ""
 		if handshakeInProgress {
-			if len(gs.OutstandingDowntimeSlashing) != 0 {
+			if gs.SlashRecord != nil {
 				return errorsmod.Wrap(
-					ccv.ErrInvalidGenesis, "outstanding downtime must be empty when handshake in progress")
+					ccv.ErrInvalidGenesis, "slash record must be nil when handshake in progress")
 			}
 			if gs.LastTransmissionBlockHeight.Height != 0 {
 				return errorsmod.Wrap(
@@ -160,6 +175,10 @@ func (gs GenesisState) Validate() error {
 				}
 			}
 		}
+		if len(gs.PendingPacketEnqueueRecords) != 0 && len(gs.PendingPacketEnqueueRecords) != len(gs.PendingConsumerPackets.List) {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "the number of pending packet enqueue records (%d) must match the number of pending consumer packets (%d)",
+				len(gs.PendingPacketEnqueueRecords), len(gs.PendingConsumerPackets.List))
+		}
 		/* 		if gs.HeightToValsetUpdateId == nil {
 			return errorsmod.Wrap(
 				ccv.ErrInvalidGenesis,
@@ -172,3 +191,43 @@ func (gs GenesisState) Validate() error {
 	}
 	return nil
 }
+
+// genesisHashContextKey is the key of the context value carrying the genesis hash
+type genesisHashContextKey struct{}
+
+// ComputeGenesisHash returns the genesis hash of a consumer chain, i.e., the SHA256 hash of
+// the sorted JSON encoding of the application genesis state without the consumer CCV module
+// genesis. The latter is excluded as it is produced by the provider chain at spawn time,
+// i.e., after the genesis hash was committed to in the initialization parameters.
+func ComputeGenesisHash(appState map[string]json.RawMessage) ([]byte, error) {
+	state := make(map[string]json.RawMessage, len(appState))
+	for module, moduleState := range appState {
+		if module == ModuleName {
+			continue
+		}
+		state[module] = moduleState
+	}
+
+	bz, err := json.Marshal(state)
+	if err != nil {
+		return nil, err
+	}
+	sortedBz, err := sdk.SortJSON(bz)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(sortedBz)
+	return hash[:], nil
+}
+
+// WithGenesisHash returns a copy of the context carrying the genesis hash of the consumer chain,
+// which is then verified by the consumer CCV module on InitGenesis
+func WithGenesisHash(ctx sdk.Context, genesisHash []byte) sdk.Context {
+	return ctx.WithValue(genesisHashContextKey{}, genesisHash)
+}
+
+// GetGenesisHash returns the genesis hash of the consumer chain carried by the context, if any
+func GetGenesisHash(ctx sdk.Context) ([]byte, bool) {
+	genesisHash, ok := ctx.Value(genesisHashContextKey{}).([]byte)
+	return genesisHash, ok
+}
@@ -8,6 +8,7 @@ import (
 	_ "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	_ "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
@@ -56,6 +57,23 @@ type GenesisState struct {
 	// the provider chain and a new connection on top of this client are created.
 	// The new client is initialized using provider.client_state and provider.consensus_state.
 	ConnectionId string `protobuf:"bytes,15,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The genesis hash committed to in the initialization parameters of the
+	// consumer chain.
+	GenesisHash []byte `protobuf:"bytes,16,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// The initial height committed to in the initialization parameters of the
+	// consumer chain.
+	InitialHeight types1.Height `protobuf:"bytes,17,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height"`
+	// SlashRecord nil on new chain, filled in on restart if a slash packet
+	// was sent to the provider, i.e., it is used to throttle the retries of
+	// bounced slash packets.
+	SlashRecord *SlashRecord `protobuf:"bytes,18,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
+	// PendingPacketEnqueueRecords nil on new chain, filled in on restart with
+	// the records of when the pending consumer packets were enqueued, in the
+	// order of pending_consumer_packets.
+	PendingPacketEnqueueRecords []PendingPacketEnqueueRecord `protobuf:"bytes,19,rep,name=pending_packet_enqueue_records,json=pendingPacketEnqueueRecords,proto3" json:"pending_packet_enqueue_records"`
+	// If true, InitGenesis panics unless genesis_hash matches the hash
+	// of the consumer genesis.
+	VerifyGenesisHash bool `protobuf:"varint,20,opt,name=verify_genesis_hash,json=verifyGenesisHash,proto3" json:"verify_genesis_hash,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -168,6 +186,41 @@ func (m *GenesisState) GetConnectionId() string {
 	return ""
 }
 
+func (m *GenesisState) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInitialHeight() types1.Height {
+	if m != nil {
+		return m.InitialHeight
+	}
+	return types1.Height{}
+}
+
+func (m *GenesisState) GetSlashRecord() *SlashRecord {
+	if m != nil {
+		return m.SlashRecord
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPacketEnqueueRecords() []PendingPacketEnqueueRecord {
+	if m != nil {
+		return m.PendingPacketEnqueueRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVerifyGenesisHash() bool {
+	if m != nil {
+		return m.VerifyGenesisHash
+	}
+	return false
+}
+
 // HeightValsetUpdateID represents a mapping internal to the consumer CCV module
 // which links a block height to each recv valset update id.
 type HeightToValsetUpdateID struct {
@@ -377,55 +430,65 @@ func init() {
 }
 
 var fileDescriptor_2db73a6057a27482 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6b, 0xeb, 0x46,
-	0x14, 0xb5, 0x12, 0xd5, 0x91, 0x27, 0x1f, 0x75, 0x27, 0xc5, 0xa8, 0x31, 0x75, 0x8c, 0x43, 0xc1,
-	0x94, 0x56, 0xaa, 0x53, 0x4a, 0x0b, 0xa5, 0xa5, 0x8d, 0x03, 0x8d, 0x4d, 0xa0, 0xc1, 0xf9, 0x28,
-	0x64, 0x23, 0xc6, 0xa3, 0x89, 0x34, 0x44, 0x9a, 0x11, 0x9a, 0xb1, 0xdc, 0x50, 0xba, 0x69, 0x97,
-	0x6f, 0xf3, 0x7e, 0x56, 0x96, 0x59, 0xbe, 0xd5, 0xe3, 0x91, 0xfc, 0x91, 0x87, 0x46, 0x23, 0x3b,
-	0x21, 0x4e, 0xf0, 0xce, 0x57, 0xf7, 0xdc, 0x73, 0xef, 0x9c, 0x7b, 0x66, 0x0c, 0x7a, 0x94, 0x49,
-	0x92, 0xe2, 0x10, 0x51, 0xe6, 0x09, 0x82, 0x27, 0x29, 0x95, 0x37, 0x2e, 0xc6, 0x99, 0x8b, 0x39,
-	0x13, 0x93, 0x98, 0xa4, 0x6e, 0xd6, 0x73, 0x03, 0xc2, 0x88, 0xa0, 0xc2, 0x49, 0x52, 0x2e, 0x39,
-	0xdc, 0x5b, 0x50, 0xe2, 0x60, 0x9c, 0x39, 0x65, 0x89, 0x93, 0xf5, 0x76, 0xbe, 0x7b, 0x89, 0x37,
-	0xeb, 0xb9, 0x22, 0x44, 0x29, 0xf1, 0xbd, 0x19, 0x5c, 0xd1, 0xee, 0xb8, 0x74, 0x8c, 0xdd, 0x88,
-	0x06, 0xa1, 0xc4, 0x11, 0x25, 0x4c, 0x0a, 0x57, 0x12, 0xe6, 0x93, 0x34, 0xa6, 0x4c, 0xe6, 0x55,
-	0xf3, 0x48, 0x17, 0x7c, 0x1e, 0xf0, 0x80, 0xab, 0x9f, 0x6e, 0xfe, 0x4b, 0x7f, 0xfd, 0xea, 0x95,
-	0xc6, 0x53, 0x9a, 0x12, 0x0d, 0xdb, 0x0d, 0x38, 0x0f, 0x22, 0xe2, 0xaa, 0x68, 0x3c, 0xb9, 0x72,
-	0x25, 0x8d, 0x89, 0x90, 0x28, 0x4e, 0x34, 0xa0, 0xf9, 0xa8, 0x3b, 0x1a, 0x63, 0xea, 0xca, 0x9b,
-	0x84, 0x68, 0x09, 0x3a, 0xff, 0xaf, 0x81, 0x8d, 0x3f, 0x0a, 0x51, 0x4e, 0x25, 0x92, 0x04, 0x1e,
-	0x81, 0x6a, 0x82, 0x52, 0x14, 0x0b, 0xdb, 0x68, 0x1b, 0xdd, 0xf5, 0xfd, 0xaf, 0x9d, 0x97, 0x44,
-	0xca, 0x7a, 0x4e, 0x5f, 0x1f, 0xfc, 0x44, 0x55, 0x1c, 0x98, 0xb7, 0xef, 0x77, 0x2b, 0x23, 0x5d,
-	0x0f, 0xbf, 0x01, 0x30, 0x49, 0x79, 0x46, 0x7d, 0x92, 0x7a, 0x85, 0x10, 0x1e, 0xf5, 0xed, 0x95,
-	0xb6, 0xd1, 0xad, 0x8d, 0xea, 0x65, 0xa6, 0xaf, 0x12, 0x03, 0x1f, 0x3a, 0x60, 0x7b, 0x8e, 0x0e,
-	0x11, 0x63, 0x24, 0xca, 0xe1, 0xab, 0x0a, 0xfe, 0xd9, 0x0c, 0x5e, 0x64, 0x06, 0x3e, 0x6c, 0x82,
-	0x1a, 0x23, 0x53, 0x4f, 0xcd, 0x65, 0x9b, 0x6d, 0xa3, 0x6b, 0x8d, 0x2c, 0x46, 0xa6, 0xfd, 0x3c,
-	0x86, 0xff, 0x82, 0x9d, 0x90, 0xe4, 0x0b, 0xf0, 0x24, 0xf7, 0x32, 0x14, 0x09, 0x22, 0xbd, 0x49,
-	0xe2, 0x23, 0x49, 0x72, 0xce, 0x5a, 0x7b, 0xb5, 0xbb, 0xbe, 0xff, 0xb3, 0xb3, 0xc4, 0xf6, 0x9d,
-	0x23, 0x45, 0x73, 0xc6, 0x2f, 0x14, 0xc9, 0xb9, 0xe2, 0x18, 0x1c, 0xea, 0x93, 0x36, 0xc2, 0x45,
-	0x59, 0x1f, 0xfe, 0x67, 0x80, 0x2f, 0xf9, 0x44, 0x0a, 0x89, 0x98, 0x4f, 0x59, 0xe0, 0xf9, 0x7c,
-	0xca, 0xf2, 0xad, 0x78, 0x22, 0x42, 0x22, 0xa4, 0x2c, 0xb0, 0x81, 0x1a, 0xe1, 0xa7, 0xa5, 0x46,
-	0xf8, 0x73, 0xce, 0x74, 0xa8, 0x89, 0x74, 0xff, 0x26, 0x7f, 0x9e, 0x3a, 0xd5, 0x2d, 0xe0, 0x3f,
-	0xc0, 0x4e, 0x48, 0xd1, 0xbf, 0x64, 0xf3, 0x12, 0x84, 0xaf, 0x89, 0x14, 0xf6, 0xba, 0x5a, 0xed,
-	0x72, 0x0a, 0xcc, 0x77, 0x9c, 0xd7, 0x1e, 0x22, 0x89, 0x8e, 0xa9, 0x90, 0xa5, 0x02, 0xba, 0xc5,
-	0x53, 0x90, 0x80, 0x6f, 0x0c, 0xd0, 0x8a, 0x90, 0x90, 0x9e, 0x4c, 0x11, 0x13, 0x31, 0x15, 0x82,
-	0x72, 0xe6, 0x8d, 0x23, 0x8e, 0xaf, 0xbd, 0x42, 0x34, 0x7b, 0x43, 0xcd, 0xf0, 0xdb, 0x52, 0x33,
-	0x1c, 0x23, 0x21, 0xcf, 0x1e, 0x31, 0x1d, 0xe4, 0x44, 0xc5, 0x6a, 0x4a, 0x29, 0xa2, 0x97, 0x21,
-	0xb0, 0x01, 0xaa, 0x49, 0x4a, 0xfa, 0xfd, 0x0b, 0x7b, 0x53, 0x19, 0x45, 0x47, 0x70, 0x08, 0xac,
-	0xd2, 0x58, 0xf6, 0x96, 0x1a, 0xa7, 0xfb, 0x9a, 0xdb, 0x4f, 0x34, 0x76, 0xc0, 0xae, 0xb8, 0x6e,
-	0x3b, 0xab, 0x87, 0x7b, 0x60, 0x13, 0x73, 0xc6, 0x08, 0x96, 0xf9, 0x49, 0xa9, 0x6f, 0x7f, 0xaa,
-	0x9c, 0xbb, 0x31, 0xff, 0x38, 0xf0, 0x87, 0xa6, 0xf5, 0x49, 0xbd, 0x3a, 0x34, 0xad, 0x6a, 0x7d,
-	0x6d, 0x68, 0x5a, 0x6b, 0x75, 0x6b, 0x68, 0x5a, 0x56, 0xbd, 0xd6, 0xb9, 0x04, 0x8d, 0xc5, 0x46,
-	0xcb, 0x47, 0xd7, 0x7a, 0xe5, 0xd7, 0xd1, 0x1c, 0xe9, 0x08, 0x76, 0x41, 0xfd, 0x99, 0xaf, 0x57,
-	0x14, 0x62, 0x2b, 0x7b, 0x62, 0xc6, 0xce, 0x39, 0xd8, 0x5e, 0xe0, 0x20, 0xf8, 0x2b, 0x68, 0x66,
-	0x28, 0xa2, 0x3e, 0x92, 0x3c, 0x55, 0x06, 0x21, 0x4c, 0x4c, 0x84, 0x87, 0x7c, 0x3f, 0x25, 0xa2,
-	0xb8, 0xfc, 0xb5, 0xd1, 0x17, 0x33, 0x48, 0xbf, 0x44, 0xfc, 0x5e, 0x00, 0x3a, 0x3f, 0x80, 0xe6,
-	0xf1, 0xeb, 0x92, 0x3f, 0x9a, 0x7b, 0xb5, 0x9c, 0xbb, 0x33, 0x06, 0x8d, 0xc5, 0x86, 0x82, 0x47,
-	0xc0, 0x8c, 0xa8, 0xc8, 0xf1, 0xf9, 0xd5, 0x70, 0x96, 0x7b, 0x76, 0x4a, 0x06, 0xbd, 0x0e, 0xc5,
-	0x70, 0xf0, 0xd7, 0xed, 0x7d, 0xcb, 0xb8, 0xbb, 0x6f, 0x19, 0x1f, 0xee, 0x5b, 0xc6, 0xdb, 0x87,
-	0x56, 0xe5, 0xee, 0xa1, 0x55, 0x79, 0xf7, 0xd0, 0xaa, 0x5c, 0xfe, 0x12, 0x50, 0x19, 0x4e, 0xc6,
-	0x0e, 0xe6, 0xb1, 0x8b, 0xb9, 0x88, 0xb9, 0x70, 0xe7, 0x6d, 0xbe, 0x9d, 0x3d, 0xb2, 0xd9, 0x8f,
-	0xee, 0xdf, 0x4f, 0xff, 0x3a, 0xd4, 0x93, 0x39, 0xae, 0xaa, 0x37, 0xf3, 0xfb, 0x8f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd9, 0x14, 0xdc, 0x1c, 0x6b, 0x06, 0x00, 0x00,
+	// 917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcf, 0x6f, 0x23, 0x35,
+	0x14, 0xee, 0x6c, 0x43, 0x36, 0x71, 0xd2, 0x92, 0xba, 0xab, 0x6a, 0x68, 0x44, 0x1a, 0xb2, 0x42,
+	0x8a, 0x10, 0xcc, 0x6c, 0x8a, 0x10, 0x48, 0x88, 0x5f, 0x4d, 0x51, 0x9b, 0xa8, 0x12, 0x55, 0xba,
+	0xbb, 0x48, 0x7b, 0xb1, 0x1c, 0x8f, 0x9b, 0xb1, 0x76, 0x62, 0x0f, 0xb6, 0x33, 0xa5, 0x42, 0x1c,
+	0xe0, 0x08, 0x17, 0xfe, 0xac, 0x3d, 0xee, 0x91, 0x13, 0x42, 0xed, 0x3f, 0x82, 0xec, 0xf1, 0x24,
+	0x2d, 0x4d, 0xab, 0xdc, 0xc6, 0xe3, 0xef, 0x7d, 0xef, 0xf9, 0x7b, 0x9f, 0x9f, 0x41, 0x8f, 0x71,
+	0x4d, 0x25, 0x89, 0x31, 0xe3, 0x48, 0x51, 0x32, 0x93, 0x4c, 0x5f, 0x86, 0x84, 0x64, 0x21, 0x11,
+	0x5c, 0xcd, 0xa6, 0x54, 0x86, 0x59, 0x2f, 0x9c, 0x50, 0x4e, 0x15, 0x53, 0x41, 0x2a, 0x85, 0x16,
+	0xf0, 0xe9, 0x92, 0x90, 0x80, 0x90, 0x2c, 0x28, 0x42, 0x82, 0xac, 0xb7, 0xfb, 0xec, 0x3e, 0xde,
+	0xac, 0x17, 0xaa, 0x18, 0x4b, 0x1a, 0xa1, 0x39, 0xdc, 0xd2, 0xee, 0x86, 0x6c, 0x4c, 0xc2, 0x84,
+	0x4d, 0x62, 0x4d, 0x12, 0x46, 0xb9, 0x56, 0xa1, 0xa6, 0x3c, 0xa2, 0x72, 0xca, 0xb8, 0x36, 0x51,
+	0x8b, 0x95, 0x0b, 0xd8, 0x33, 0x01, 0x44, 0x48, 0x1a, 0xe6, 0x01, 0x06, 0x94, 0x7f, 0x39, 0xc0,
+	0x93, 0x89, 0x98, 0x08, 0xfb, 0x19, 0x9a, 0x2f, 0xf7, 0xf7, 0xc3, 0x07, 0x2a, 0xbb, 0x60, 0x92,
+	0x3a, 0xd8, 0xfe, 0x2a, 0xc2, 0xfc, 0xef, 0x08, 0x7b, 0x13, 0x21, 0x26, 0x09, 0x0d, 0xed, 0x6a,
+	0x3c, 0x3b, 0x0f, 0x35, 0x9b, 0x52, 0xa5, 0xf1, 0x34, 0x75, 0x80, 0xe6, 0x8d, 0x23, 0xe1, 0x31,
+	0x61, 0xa1, 0xbe, 0x4c, 0xa9, 0xd3, 0xb5, 0xf3, 0x1b, 0x00, 0xf5, 0xa3, 0x5c, 0xe9, 0x33, 0x8d,
+	0x35, 0x85, 0xc7, 0xa0, 0x9c, 0x62, 0x89, 0xa7, 0xca, 0xf7, 0xda, 0x5e, 0xb7, 0xb6, 0xff, 0x51,
+	0x70, 0x9f, 0xf2, 0x59, 0x2f, 0xe8, 0xbb, 0x52, 0x4e, 0x6d, 0xc4, 0x41, 0xe9, 0xcd, 0x3f, 0x7b,
+	0x6b, 0x23, 0x17, 0x0f, 0x3f, 0x06, 0x30, 0x95, 0x22, 0x63, 0x11, 0x95, 0x28, 0x97, 0x08, 0xb1,
+	0xc8, 0x7f, 0xd4, 0xf6, 0xba, 0xd5, 0x51, 0xa3, 0xd8, 0xe9, 0xdb, 0x8d, 0x41, 0x04, 0x03, 0xb0,
+	0xbd, 0x40, 0xc7, 0x98, 0x73, 0x9a, 0x18, 0xf8, 0xba, 0x85, 0x6f, 0xcd, 0xe1, 0xf9, 0xce, 0x20,
+	0x82, 0x4d, 0x50, 0xe5, 0xf4, 0x02, 0xd9, 0xba, 0xfc, 0x52, 0xdb, 0xeb, 0x56, 0x46, 0x15, 0x4e,
+	0x2f, 0xfa, 0x66, 0x0d, 0x7f, 0x05, 0xbb, 0x31, 0x35, 0x5d, 0x45, 0x5a, 0xa0, 0x0c, 0x27, 0x8a,
+	0x6a, 0x34, 0x4b, 0x23, 0xac, 0xa9, 0xe1, 0xac, 0xb6, 0xd7, 0xbb, 0xb5, 0xfd, 0x2f, 0x83, 0x15,
+	0x2c, 0x15, 0x1c, 0x5b, 0x9a, 0xe7, 0xe2, 0xa5, 0x25, 0x79, 0x61, 0x39, 0x06, 0x87, 0xee, 0xa4,
+	0x3b, 0xf1, 0xb2, 0xdd, 0x08, 0xfe, 0xee, 0x81, 0xf7, 0xc5, 0x4c, 0x2b, 0x8d, 0x79, 0xc4, 0xf8,
+	0x04, 0x45, 0xe2, 0x82, 0x9b, 0xae, 0x20, 0x95, 0x60, 0x15, 0x33, 0x3e, 0xf1, 0x81, 0x2d, 0xe1,
+	0x8b, 0x95, 0x4a, 0xf8, 0x61, 0xc1, 0x74, 0xe8, 0x88, 0x5c, 0xfe, 0xa6, 0xb8, 0xbb, 0x75, 0xe6,
+	0x52, 0xc0, 0x5f, 0x80, 0x9f, 0xd2, 0x3c, 0x7f, 0xc1, 0x86, 0x52, 0x4c, 0x5e, 0x53, 0xad, 0xfc,
+	0x5a, 0xdb, 0x5b, 0x59, 0x81, 0x45, 0x8f, 0x4d, 0xec, 0x21, 0xd6, 0xf8, 0x84, 0x29, 0x5d, 0x28,
+	0xe0, 0x52, 0xdc, 0x06, 0x29, 0xf8, 0xa7, 0x07, 0x5a, 0x09, 0x56, 0x1a, 0x69, 0x89, 0xb9, 0x9a,
+	0x32, 0xa5, 0x98, 0xe0, 0x68, 0x9c, 0x08, 0xf2, 0x1a, 0xe5, 0xa2, 0xf9, 0x75, 0x5b, 0xc3, 0xb7,
+	0x2b, 0xd5, 0x70, 0x82, 0x95, 0x7e, 0x7e, 0x83, 0xe9, 0xc0, 0x10, 0xe5, 0xad, 0x29, 0xa4, 0x48,
+	0xee, 0x87, 0xc0, 0x1d, 0x50, 0x4e, 0x25, 0xed, 0xf7, 0x5f, 0xfa, 0x1b, 0xd6, 0x28, 0x6e, 0x05,
+	0x87, 0xa0, 0x52, 0x18, 0xcb, 0xdf, 0xb4, 0xe5, 0x74, 0x1f, 0x72, 0xfb, 0xa9, 0xc3, 0x0e, 0xf8,
+	0xb9, 0x70, 0x69, 0xe7, 0xf1, 0xf0, 0x29, 0xd8, 0x20, 0x82, 0x73, 0x4a, 0xb4, 0x39, 0x29, 0x8b,
+	0xfc, 0x77, 0xad, 0x73, 0xeb, 0x8b, 0x9f, 0x83, 0x08, 0x7e, 0x00, 0xea, 0x6e, 0xac, 0xa1, 0x18,
+	0xab, 0xd8, 0x6f, 0xb4, 0xbd, 0x6e, 0x7d, 0x54, 0x73, 0xff, 0x8e, 0xb1, 0x8a, 0xe1, 0x11, 0xd8,
+	0x64, 0x9c, 0x69, 0x86, 0x93, 0x42, 0xa8, 0x2d, 0x5b, 0xd9, 0x6e, 0xc0, 0xc6, 0x24, 0x30, 0x93,
+	0x27, 0x70, 0xf3, 0x66, 0xee, 0x4e, 0x57, 0xcb, 0x86, 0x8b, 0x73, 0x87, 0x3e, 0x03, 0x75, 0x6b,
+	0x37, 0x24, 0x29, 0x11, 0x32, 0xf2, 0xa1, 0xa5, 0x79, 0xb6, 0x92, 0xde, 0xd6, 0x44, 0x23, 0x1b,
+	0x37, 0xaa, 0xa9, 0xc5, 0x02, 0xfe, 0xe1, 0x81, 0x56, 0xe1, 0xaa, 0xdc, 0x4c, 0x88, 0xf2, 0x9f,
+	0x66, 0x74, 0x46, 0x5d, 0x1a, 0xe5, 0x6f, 0x5b, 0x6b, 0x7f, 0xb3, 0x52, 0x9e, 0xd3, 0x9c, 0x2a,
+	0x77, 0xcd, 0xf7, 0x39, 0x51, 0x9e, 0xa9, 0x68, 0x6b, 0x7a, 0x2f, 0x42, 0x99, 0x91, 0x91, 0x51,
+	0xc9, 0xce, 0x2f, 0xd1, 0x2d, 0x51, 0x9f, 0xd8, 0x1e, 0x6f, 0xe5, 0x5b, 0x47, 0x0b, 0x69, 0x87,
+	0xa5, 0xca, 0x3b, 0x8d, 0xf2, 0xb0, 0x54, 0x29, 0x37, 0x1e, 0x0f, 0x4b, 0x95, 0xc7, 0x8d, 0xca,
+	0xb0, 0x54, 0xa9, 0x34, 0xaa, 0x9d, 0x57, 0x60, 0x67, 0xf9, 0x35, 0x37, 0xc6, 0x71, 0x4d, 0x30,
+	0xc3, 0xb0, 0x34, 0x72, 0x2b, 0xd8, 0x05, 0x8d, 0x3b, 0x53, 0xe5, 0x91, 0x45, 0x6c, 0x66, 0xb7,
+	0x46, 0x41, 0xe7, 0x05, 0xd8, 0x5e, 0x72, 0x7f, 0xe1, 0xd7, 0xa0, 0x99, 0xe1, 0x84, 0x45, 0x58,
+	0x0b, 0x69, 0xaf, 0x27, 0xe5, 0x6a, 0xa6, 0x10, 0x8e, 0x22, 0x49, 0x55, 0x3e, 0x7a, 0xab, 0xa3,
+	0xf7, 0xe6, 0x90, 0x7e, 0x81, 0xf8, 0x2e, 0x07, 0x74, 0x3e, 0x03, 0xcd, 0x93, 0x87, 0x0d, 0x7f,
+	0xa3, 0xee, 0xf5, 0xa2, 0xee, 0xce, 0x18, 0xec, 0x2c, 0xbf, 0xce, 0xf0, 0x18, 0x94, 0x12, 0xa6,
+	0x0c, 0xde, 0x74, 0x2f, 0x58, 0x6d, 0xe8, 0x17, 0x0c, 0xae, 0x59, 0x96, 0xe1, 0xe0, 0xc7, 0x37,
+	0x57, 0x2d, 0xef, 0xed, 0x55, 0xcb, 0xfb, 0xf7, 0xaa, 0xe5, 0xfd, 0x75, 0xdd, 0x5a, 0x7b, 0x7b,
+	0xdd, 0x5a, 0xfb, 0xfb, 0xba, 0xb5, 0xf6, 0xea, 0xab, 0x09, 0xd3, 0xf1, 0x6c, 0x1c, 0x10, 0x31,
+	0x0d, 0x89, 0x50, 0x53, 0xa1, 0xc2, 0x45, 0x9a, 0x4f, 0xe6, 0xef, 0x5d, 0xf6, 0x79, 0xf8, 0xf3,
+	0xed, 0x47, 0xcf, 0x3e, 0x58, 0xe3, 0xb2, 0x7d, 0xb1, 0x3e, 0xfd, 0x6f, 0x00, 0xa8, 0x96, 0xed,
+	0x86, 0x3e, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -448,6 +511,69 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VerifyGenesisHash {
+		i--
+		if m.VerifyGenesisHash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.PendingPacketEnqueueRecords) > 0 {
+		for iNdEx := len(m.PendingPacketEnqueueRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPacketEnqueueRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -743,6 +869,25 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PendingPacketEnqueueRecords) > 0 {
+		for _, e := range m.PendingPacketEnqueueRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.VerifyGenesisHash {
+		n += 3
+	}
 	return n
 }
 
@@ -1172,6 +1317,163 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPacketEnqueueRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPacketEnqueueRecords = append(m.PendingPacketEnqueueRecords, PendingPacketEnqueueRecord{})
+			if err := m.PendingPacketEnqueueRecords[len(m.PendingPacketEnqueueRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyGenesisHash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyGenesisHash = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -208,6 +209,7 @@ func TestValidateInitialGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					ccv.DefaultClientExpiryWarningWindow,
 				)),
 			true,
 		},
@@ -228,6 +230,7 @@ func TestValidateInitialGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					ccv.DefaultClientExpiryWarningWindow,
 				)),
 			true,
 		},
@@ -315,6 +318,52 @@ func TestValidateInitialGenesisState(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid new consumer genesis state: verified genesis hash",
+			&types.GenesisState{
+				Params:            params,
+				ProviderClientId:  "",
+				ProviderChannelId: "",
+				NewChain:          true,
+				Provider: ccv.ProviderInfo{
+					ClientState:    nil,
+					ConsensusState: nil,
+					InitialValSet:  valUpdates,
+				},
+				HeightToValsetUpdateId:      nil,
+				OutstandingDowntimeSlashing: nil,
+				PendingConsumerPackets:      types.ConsumerPacketDataList{},
+				LastTransmissionBlockHeight: types.LastTransmissionBlockHeight{},
+				PreCCV:                      false,
+				ConnectionId:                "connection-1",
+				GenesisHash:                 make([]byte, 32),
+				VerifyGenesisHash:           true,
+			},
+			false,
+		},
+		{
+			"invalid new consumer genesis state: verified genesis hash is not a SHA256 hash",
+			&types.GenesisState{
+				Params:            params,
+				ProviderClientId:  "",
+				ProviderChannelId: "",
+				NewChain:          true,
+				Provider: ccv.ProviderInfo{
+					ClientState:    nil,
+					ConsensusState: nil,
+					InitialValSet:  valUpdates,
+				},
+				HeightToValsetUpdateId:      nil,
+				OutstandingDowntimeSlashing: nil,
+				PendingConsumerPackets:      types.ConsumerPacketDataList{},
+				LastTransmissionBlockHeight: types.LastTransmissionBlockHeight{},
+				PreCCV:                      false,
+				ConnectionId:                "connection-1",
+				GenesisHash:                 []byte("gen_hash"),
+				VerifyGenesisHash:           true,
+			},
+			true,
+		},
 	}
 
 	for _, c := range cases {
@@ -423,10 +472,30 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 			true,
 		},
 		{
-			"invalid restart consumer genesis state: outstanding downtime defined when handshake is still in progress",
+			"valid restart consumer genesis state: outstanding downtime defined when handshake is still in progress",
 			types.NewRestartGenesisState("ccvclient", "",
 				valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{}, []types.OutstandingDowntime{{ValidatorConsensusAddress: "cosmosvalconsxxx"}},
 				types.LastTransmissionBlockHeight{}, params),
+			false,
+		},
+		{
+			"invalid restart consumer genesis state: slash record defined when handshake is still in progress",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "",
+					valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{}, params)
+				gs.SlashRecord = &types.SlashRecord{WaitingOnReply: true}
+				return gs
+			}(),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: pending packet enqueue records do not match the pending packets",
+			func() *types.GenesisState {
+				gs := types.NewRestartGenesisState("ccvclient", "ccvchannel",
+					valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{}, params)
+				gs.PendingPacketEnqueueRecords = []types.PendingPacketEnqueueRecord{{Height: 1}}
+				return gs
+			}(),
 			true,
 		},
 		{
@@ -452,6 +521,7 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					ccv.DefaultClientExpiryWarningWindow,
 				)),
 			true,
 		},
@@ -466,3 +536,33 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 		}
 	}
 }
+
+// TestComputeGenesisHash tests that the genesis hash does not depend on the
+// consumer CCV module genesis nor on the JSON formatting of the application genesis
+func TestComputeGenesisHash(t *testing.T) {
+	appState := map[string]json.RawMessage{
+		"bank":    json.RawMessage(`{"balances":[],"params":{"default_send_enabled":true}}`),
+		"staking": json.RawMessage(`{"params":{}}`),
+	}
+	genesisHash, err := types.ComputeGenesisHash(appState)
+	require.NoError(t, err)
+	require.Len(t, genesisHash, 32)
+
+	// the consumer CCV module genesis is excluded
+	appState[types.ModuleName] = json.RawMessage(`{"params":{"enabled":true}}`)
+	hash, err := types.ComputeGenesisHash(appState)
+	require.NoError(t, err)
+	require.Equal(t, genesisHash, hash)
+
+	// the formatting and the order of the fields is irrelevant
+	appState["bank"] = json.RawMessage(`{ "params": { "default_send_enabled": true }, "balances": [] }`)
+	hash, err = types.ComputeGenesisHash(appState)
+	require.NoError(t, err)
+	require.Equal(t, genesisHash, hash)
+
+	// any other change results in a different genesis hash
+	appState["staking"] = json.RawMessage(`{"params":{"bond_denom":"stake"}}`)
+	hash, err = types.ComputeGenesisHash(appState)
+	require.NoError(t, err)
+	require.NotEqual(t, genesisHash, hash)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// CCVHooks defines the hooks other modules of the consumer app can register
+// to react to the validator set changes and the slashes confirmed by the provider chain
+type CCVHooks interface {
+	// AfterVSCApplied is called in EndBlock once the validator set changes received
+	// from the provider chain, up to the VSC with id valsetUpdateId, are applied
+	AfterVSCApplied(ctx sdk.Context, valsetUpdateId uint64, updates []abci.ValidatorUpdate) error
+	// AfterSlashPacketAcked is called once the provider chain acknowledges
+	// that it handled a slash packet sent by the consumer chain
+	AfterSlashPacketAcked(ctx sdk.Context, data ccv.SlashPacketData) error
+}
+
+var _ CCVHooks = MultiCCVHooks{}
+
+// MultiCCVHooks combines multiple CCV hooks, all hook functions are run in array sequence
+type MultiCCVHooks []CCVHooks
+
+// NewMultiCCVHooks creates a new MultiCCVHooks instance
+func NewMultiCCVHooks(hooks ...CCVHooks) MultiCCVHooks {
+	return hooks
+}
+
+func (h MultiCCVHooks) AfterVSCApplied(ctx sdk.Context, valsetUpdateId uint64, updates []abci.ValidatorUpdate) error {
+	for i := range h {
+		if err := h[i].AfterVSCApplied(ctx, valsetUpdateId, updates); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiCCVHooks) AfterSlashPacketAcked(ctx sdk.Context, data ccv.SlashPacketData) error {
+	for i := range h {
+		if err := h[i].AfterSlashPacketAcked(ctx, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// ICAHostAllowMessages are the messages that are safe to be allowed by the interchain accounts host
+// of a consumer chain without x/staking, given that the corresponding modules are part of the app
+var ICAHostAllowMessages = []string{
+	"/cosmos.bank.v1beta1.MsgSend",
+	"/cosmos.bank.v1beta1.MsgMultiSend",
+	"/ibc.applications.transfer.v1.MsgTransfer",
+	"/cosmos.authz.v1beta1.MsgExec",
+	"/cosmos.authz.v1beta1.MsgGrant",
+	"/cosmos.authz.v1beta1.MsgRevoke",
+	"/cosmos.feegrant.v1beta1.MsgGrantAllowance",
+	"/cosmos.feegrant.v1beta1.MsgRevokeAllowance",
+	"/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe",
+	// allows the provider admin to update the consumer params (see SetProviderAdmin)
+	"/interchain_security.ccv.consumer.v1.MsgUpdateParams",
+}
+
+// ICAHostUnsafeMessages are the messages that must not be allowed by the interchain accounts host
+// of a consumer chain, as the validator set of a consumer chain is managed by the provider chain,
+// i.e., the messages have no effect on the CCV validator set
+var ICAHostUnsafeMessages = []string{
+	"/cosmos.staking.v1beta1.MsgCreateValidator",
+	"/cosmos.staking.v1beta1.MsgEditValidator",
+	"/cosmos.staking.v1beta1.MsgDelegate",
+	"/cosmos.staking.v1beta1.MsgUndelegate",
+	"/cosmos.staking.v1beta1.MsgBeginRedelegate",
+	"/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation",
+	"/cosmos.slashing.v1beta1.MsgUnjail",
+}
+
+// AllowAllICAHostMessages is the wildcard allowing all the messages on the interchain accounts host
+const AllowAllICAHostMessages = "*"
+
+// ValidateICAHostAllowMessages validates the messages allowed by the interchain accounts host of a consumer chain,
+// i.e., that every message has a handler registered on the msg router and is not unsafe on a consumer chain.
+// Note that the wildcard is valid as the host rejects the messages without handler when executing them.
+func ValidateICAHostAllowMessages(allowMessages []string, msgRouter baseapp.MessageRouter) error {
+	for _, typeURL := range allowMessages {
+		if typeURL == AllowAllICAHostMessages {
+			continue
+		}
+		for _, unsafeTypeURL := range ICAHostUnsafeMessages {
+			if typeURL == unsafeTypeURL {
+				return errorsmod.Wrapf(ErrInvalidICAHostAllowMessages, "message %s is unsafe on a consumer chain", typeURL)
+			}
+		}
+		if msgRouter.HandlerByTypeURL(typeURL) == nil {
+			return errorsmod.Wrapf(ErrInvalidICAHostAllowMessages, "message %s has no handler", typeURL)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// fakeMsgRouter is a msg router with handlers for a set of message type URLs
+type fakeMsgRouter map[string]bool
+
+func (r fakeMsgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.HandlerByTypeURL(sdk.MsgTypeURL(msg))
+}
+
+func (r fakeMsgRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	if !r[typeURL] {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) { return &sdk.Result{}, nil }
+}
+
+func TestValidateICAHostAllowMessages(t *testing.T) {
+	msgRouter := fakeMsgRouter{}
+	for _, typeURL := range types.ICAHostAllowMessages {
+		msgRouter[typeURL] = true
+	}
+	// e.g., a consumer chain with x/staking
+	for _, typeURL := range types.ICAHostUnsafeMessages {
+		msgRouter[typeURL] = true
+	}
+
+	testCases := []struct {
+		name          string
+		allowMessages []string
+		expPass       bool
+	}{
+		{"no messages", []string{}, true},
+		{"wildcard", []string{types.AllowAllICAHostMessages}, true},
+		{"safe messages", types.ICAHostAllowMessages, true},
+		{"unsafe message", []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.MsgDelegate"}, false},
+		{"message without handler", []string{"/cosmos.gov.v1.MsgVote"}, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateICAHostAllowMessages(tc.allowMessages, msgRouter)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidICAHostAllowMessages, tc.name)
+		}
+	}
+}
@@ -28,6 +28,10 @@ const (
 	//#nosec G101 -- (false positive) this is not a hardcoded credential
 	ConsumerToSendToProviderName = "cons_to_send_to_provider"
 
+	// FlagDoubleSignInterlock is the start flag setting the consensus address of the local validator
+	// on the consumer chain, which enables the double-sign interlock. Disabled if empty.
+	FlagDoubleSignInterlock = "x-ccv-double-sign-interlock"
+
 	// Names for the store keys.
 	// Used for storing the byte prefixes in the constant map.
 	// See getKeyPrefixes().
@@ -77,6 +81,20 @@ const (
 	SlashRecordKeyName = "SlashRecordKey"
 
 	ParametersKeyName = "ParametersKey"
+
+	ProviderFeatureKeyName = "ProviderFeatureKey"
+
+	ProviderClientExpirySeverityKeyName = "ProviderClientExpirySeverityKey"
+
+	LastVSCKeyName = "LastVSCKey"
+
+	UpgradePlanKeyName = "UpgradePlanKey"
+
+	ProviderValsetVerificationKeyName = "ProviderValsetVerificationKey"
+
+	PendingPacketEnqueueRecordKeyName = "PendingPacketEnqueueRecordKey"
+
+	ProviderFeePoolAddrKeyName = "ProviderFeePoolAddrKey"
 )
 
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
@@ -161,6 +179,32 @@ func getKeyPrefixes() map[string]byte {
 		// ParametersKey is the key for storing the consumer's parameters.
 		ParametersKeyName: 22,
 
+		// ProviderFeatureKey is the key for storing the features supported by the provider chain,
+		// as advertised during the CCV channel handshake
+		ProviderFeatureKeyName: 23,
+
+		// ProviderClientExpirySeverityKey is the key for storing the severity of the last
+		// expiry warning of the client to the provider chain
+		ProviderClientExpirySeverityKeyName: 24,
+
+		// LastVSCKey is the key for storing the last VSC packet received from the provider chain
+		LastVSCKeyName: 25,
+
+		// UpgradePlanKey is the key for storing the upgrade of the consumer chain planned on the provider chain
+		UpgradePlanKeyName: 26,
+
+		// ProviderValsetVerificationKey is the key for storing the last verification of the consumer
+		// validator set against the valset checkpoint stored on the provider chain
+		ProviderValsetVerificationKeyName: 27,
+
+		// PendingPacketEnqueueRecordKey is the key for storing when the packets
+		// in the pending packets queue were added to the queue
+		PendingPacketEnqueueRecordKeyName: 28,
+
+		// ProviderFeePoolAddrKey is the key for storing the address of the provider fee pool
+		// declared by the provider chain, either in the handshake or in a provider fee pool address packet
+		ProviderFeePoolAddrKeyName: 29,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -340,6 +384,56 @@ func ParametersKey() []byte {
 	return []byte{mustGetKeyPrefix(ParametersKeyName)}
 }
 
+// ProviderFeatureKeyPrefix returns the key prefix for storing the features supported by the provider chain
+func ProviderFeatureKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(ProviderFeatureKeyName)}
+}
+
+// ProviderFeatureKey returns the key for storing a feature supported by the provider chain
+func ProviderFeatureKey(feature string) []byte {
+	return append(ProviderFeatureKeyPrefix(), []byte(feature)...)
+}
+
+// ProviderClientExpirySeverityKey returns the key for storing the severity of the last
+// expiry warning of the client to the provider chain
+func ProviderClientExpirySeverityKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderClientExpirySeverityKeyName)}
+}
+
+// LastVSCKey returns the key for storing the last VSC packet received from the provider chain
+func LastVSCKey() []byte {
+	return []byte{mustGetKeyPrefix(LastVSCKeyName)}
+}
+
+// UpgradePlanKey returns the key for storing the upgrade of the consumer chain planned on the provider chain
+func UpgradePlanKey() []byte {
+	return []byte{mustGetKeyPrefix(UpgradePlanKeyName)}
+}
+
+// ProviderValsetVerificationKey returns the key for storing the last verification of the consumer
+// validator set against the valset checkpoint stored on the provider chain
+func ProviderValsetVerificationKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderValsetVerificationKeyName)}
+}
+
+// PendingPacketEnqueueRecordKeyPrefix returns the key prefix for storing when the packets
+// in the pending packets queue were added to the queue
+func PendingPacketEnqueueRecordKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(PendingPacketEnqueueRecordKeyName)}
+}
+
+// PendingPacketEnqueueRecordKey returns the key for storing when the packet with the given
+// index in the pending packets queue was added to the queue
+func PendingPacketEnqueueRecordKey(idx uint64) []byte {
+	return append(PendingPacketEnqueueRecordKeyPrefix(), sdk.Uint64ToBigEndian(idx)...)
+}
+
+// ProviderFeePoolAddrKey returns the key for storing the address of the provider fee pool
+// declared by the provider chain
+func ProviderFeePoolAddrKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderFeePoolAddrKeyName)}
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
@@ -71,6 +71,20 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(22), consumertypes.ParametersKey()[0])
 	i++
+	require.Equal(t, byte(23), consumertypes.ProviderFeatureKeyPrefix()[0])
+	i++
+	require.Equal(t, byte(24), consumertypes.ProviderClientExpirySeverityKey()[0])
+	i++
+	require.Equal(t, byte(25), consumertypes.LastVSCKey()[0])
+	i++
+	require.Equal(t, byte(26), consumertypes.UpgradePlanKey()[0])
+	i++
+	require.Equal(t, byte(27), consumertypes.ProviderValsetVerificationKey()[0])
+	i++
+	require.Equal(t, byte(28), consumertypes.PendingPacketEnqueueRecordKeyPrefix()[0])
+	i++
+	require.Equal(t, byte(29), consumertypes.ProviderFeePoolAddrKey()[0])
+	i++
 
 	prefixes := consumertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -120,5 +134,12 @@ func getAllFullyDefinedKeys() [][]byte {
 		consumertypes.PendingPacketsIndexKey(),
 		consumertypes.SlashRecordKey(),
 		consumertypes.ParametersKey(),
+		consumertypes.ProviderFeatureKey("feature"),
+		consumertypes.ProviderClientExpirySeverityKey(),
+		consumertypes.LastVSCKey(),
+		consumertypes.UpgradePlanKey(),
+		consumertypes.ProviderValsetVerificationKey(),
+		consumertypes.PendingPacketEnqueueRecordKey(0),
+		consumertypes.ProviderFeePoolAddrKey(),
 	}
 }
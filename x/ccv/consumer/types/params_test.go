@@ -13,6 +13,12 @@ import (
 func TestValidateParams(t *testing.T) {
 	consumerId := "13"
 
+	withOverrides := func(overrides ...ccvtypes.RedistributionFractionOverride) ccvtypes.ConsumerParams {
+		params := ccvtypes.DefaultParams()
+		params.ConsumerRedistributionFractionOverrides = overrides
+		return params
+	}
+
 	testCases := []struct {
 		name    string
 		params  ccvtypes.ConsumerParams
@@ -21,67 +27,87 @@ func TestValidateParams(t *testing.T) {
 		{"default params", ccvtypes.DefaultParams(), true},
 		{
 			"custom valid params",
-			ccvtypes.NewParams(true, 5, "", "", 1004, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), true,
+			ccvtypes.NewParams(true, 5, "", "", 1004, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), true,
 		},
 		{
 			"custom invalid params, block per dist transmission",
-			ccvtypes.NewParams(true, -5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, -5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, dist transmission channel",
-			ccvtypes.NewParams(true, 5, "badchannel/", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "badchannel/", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, ccv timeout",
-			ccvtypes.NewParams(true, 5, "", "", -5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", -5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, transfer timeout",
-			ccvtypes.NewParams(true, 5, "", "", 1004, -7, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 1004, -7, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, consumer redist fraction is negative",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "-0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "-0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, consumer redist fraction is over 1",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "1.2", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "1.2", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, bad consumer redist fraction ",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "notFrac", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "notFrac", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, negative num historical entries",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", -100, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", -100, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, negative unbonding period",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, -24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, -24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, invalid reward denom",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"u"}, []string{}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"u"}, []string{}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, invalid provider reward denom",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{"a"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{"a"}, 2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, retry delay period is negative",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, -2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, -2*time.Hour, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, retry delay period is zero",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0, consumerId, time.Hour), false,
 		},
 		{
 			"custom invalid params, consumer ID is blank",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, ""), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "", time.Hour), false,
 		},
 		{
 			"custom invalid params, consumer ID is not a uint64",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "consumerId"), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "consumerId", time.Hour), false,
+		},
+		{
+			"custom invalid params, negative client expiry warning window",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, -time.Hour), false,
+		},
+		{
+			"custom valid params, redistribution fraction overrides",
+			withOverrides(ccvtypes.RedistributionFractionOverride{Denom: "untrn", Fraction: "0.9"}, ccvtypes.RedistributionFractionOverride{Denom: "uatom", Fraction: "0"}), true,
+		},
+		{
+			"custom invalid params, redistribution fraction override with invalid denom",
+			withOverrides(ccvtypes.RedistributionFractionOverride{Denom: "u", Fraction: "0.5"}), false,
+		},
+		{
+			"custom invalid params, redistribution fraction override over 1",
+			withOverrides(ccvtypes.RedistributionFractionOverride{Denom: "untrn", Fraction: "1.5"}), false,
+		},
+		{
+			"custom invalid params, duplicate redistribution fraction overrides",
+			withOverrides(ccvtypes.RedistributionFractionOverride{Denom: "untrn", Fraction: "0.5"}, ccvtypes.RedistributionFractionOverride{Denom: "untrn", Fraction: "0.6"}), false,
 		},
 	}
 
@@ -6,23 +6,30 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types2 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -335,6 +342,27 @@ var xxx_messageInfo_QueryProviderInfoRequest proto.InternalMessageInfo
 type QueryProviderInfoResponse struct {
 	Consumer ChainInfo `protobuf:"bytes,1,opt,name=consumer,proto3" json:"consumer"`
 	Provider ChainInfo `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider"`
+	// the latest height of the client (on the consumer) tracking the provider chain
+	ClientLatestHeight types1.Height `protobuf:"bytes,3,opt,name=client_latest_height,json=clientLatestHeight,proto3" json:"client_latest_height"`
+	// the expiry of the client (on the consumer) tracking the provider chain,
+	// including the remaining trusting period
+	ClientExpiry types.ClientExpiry `protobuf:"bytes,4,opt,name=client_expiry,json=clientExpiry,proto3" json:"client_expiry"`
+	// the state of the CCV channel (on the consumer)
+	ChannelState string `protobuf:"bytes,5,opt,name=channel_state,json=channelState,proto3" json:"channel_state,omitempty"`
+	// the number of packets queued to be sent to the provider chain
+	PendingPackets uint64 `protobuf:"varint,6,opt,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets,omitempty"`
+	// the last VSC packet received from the provider chain;
+	// empty if no VSC packet was received since it is recorded
+	LastVsc *LastVSC `protobuf:"bytes,7,opt,name=last_vsc,json=lastVsc,proto3" json:"last_vsc,omitempty"`
+	// the time elapsed since the last VSC packet was received;
+	// zero if no VSC packet was received since it is recorded
+	TimeSinceLastVsc time.Duration `protobuf:"bytes,8,opt,name=time_since_last_vsc,json=timeSinceLastVsc,proto3,stdduration" json:"time_since_last_vsc"`
+	// the record of the last slash packet sent to the provider chain;
+	// empty if no slash packet is waiting to be handled by the provider chain
+	SlashRecord *SlashRecord `protobuf:"bytes,9,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
+	// whether the packets queued can be sent, i.e., no slash packet is waiting
+	// for a reply and the retry delay period elapsed since the last bounce
+	PacketSendingPermitted bool `protobuf:"varint,10,opt,name=packet_sending_permitted,json=packetSendingPermitted,proto3" json:"packet_sending_permitted,omitempty"`
 }
 
 func (m *QueryProviderInfoResponse) Reset()         { *m = QueryProviderInfoResponse{} }
@@ -384,6 +412,62 @@ func (m *QueryProviderInfoResponse) GetProvider() ChainInfo {
 	return ChainInfo{}
 }
 
+func (m *QueryProviderInfoResponse) GetClientLatestHeight() types1.Height {
+	if m != nil {
+		return m.ClientLatestHeight
+	}
+	return types1.Height{}
+}
+
+func (m *QueryProviderInfoResponse) GetClientExpiry() types.ClientExpiry {
+	if m != nil {
+		return m.ClientExpiry
+	}
+	return types.ClientExpiry{}
+}
+
+func (m *QueryProviderInfoResponse) GetChannelState() string {
+	if m != nil {
+		return m.ChannelState
+	}
+	return ""
+}
+
+func (m *QueryProviderInfoResponse) GetPendingPackets() uint64 {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return 0
+}
+
+func (m *QueryProviderInfoResponse) GetLastVsc() *LastVSC {
+	if m != nil {
+		return m.LastVsc
+	}
+	return nil
+}
+
+func (m *QueryProviderInfoResponse) GetTimeSinceLastVsc() time.Duration {
+	if m != nil {
+		return m.TimeSinceLastVsc
+	}
+	return 0
+}
+
+func (m *QueryProviderInfoResponse) GetSlashRecord() *SlashRecord {
+	if m != nil {
+		return m.SlashRecord
+	}
+	return nil
+}
+
+func (m *QueryProviderInfoResponse) GetPacketSendingPermitted() bool {
+	if m != nil {
+		return m.PacketSendingPermitted
+	}
+	return false
+}
+
 type QueryThrottleStateRequest struct {
 }
 
@@ -423,6 +507,17 @@ var xxx_messageInfo_QueryThrottleStateRequest proto.InternalMessageInfo
 type QueryThrottleStateResponse struct {
 	SlashRecord     *SlashRecord               `protobuf:"bytes,1,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
 	PacketDataQueue []types.ConsumerPacketData `protobuf:"bytes,2,rep,name=packet_data_queue,json=packetDataQueue,proto3" json:"packet_data_queue"`
+	// the packets in the pending packets queue, in the order they are sent
+	PendingPackets []PendingPacketInfo `protobuf:"bytes,3,rep,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets"`
+	// whether the consumer is allowed to send the packets in the pending packets queue
+	PacketSendingPermitted bool `protobuf:"varint,4,opt,name=packet_sending_permitted,json=packetSendingPermitted,proto3" json:"packet_sending_permitted,omitempty"`
+	// why the consumer is not allowed to send the pending packets; empty if sending is permitted
+	BlockedReason string `protobuf:"bytes,5,opt,name=blocked_reason,json=blockedReason,proto3" json:"blocked_reason,omitempty"`
+	// the time after which the consumer is allowed to retry sending a bounced slash packet;
+	// only set if a slash packet bounced and the retry delay period has not elapsed yet
+	NextSendTime *time.Time `protobuf:"bytes,6,opt,name=next_send_time,json=nextSendTime,proto3,stdtime" json:"next_send_time,omitempty"`
+	// the delay after which the consumer retries sending a bounced slash packet
+	RetryDelayPeriod time.Duration `protobuf:"bytes,7,opt,name=retry_delay_period,json=retryDelayPeriod,proto3,stdduration" json:"retry_delay_period"`
 }
 
 func (m *QueryThrottleStateResponse) Reset()         { *m = QueryThrottleStateResponse{} }
@@ -472,25 +567,73 @@ func (m *QueryThrottleStateResponse) GetPacketDataQueue() []types.ConsumerPacket
 	return nil
 }
 
-type ChainInfo struct {
-	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	ClientID     string `protobuf:"bytes,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
-	ConnectionID string `protobuf:"bytes,3,opt,name=connectionID,proto3" json:"connectionID,omitempty"`
-	ChannelID    string `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+func (m *QueryThrottleStateResponse) GetPendingPackets() []PendingPacketInfo {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return nil
 }
 
-func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
-func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
-func (*ChainInfo) ProtoMessage()    {}
-func (*ChainInfo) Descriptor() ([]byte, []int) {
+func (m *QueryThrottleStateResponse) GetPacketSendingPermitted() bool {
+	if m != nil {
+		return m.PacketSendingPermitted
+	}
+	return false
+}
+
+func (m *QueryThrottleStateResponse) GetBlockedReason() string {
+	if m != nil {
+		return m.BlockedReason
+	}
+	return ""
+}
+
+func (m *QueryThrottleStateResponse) GetNextSendTime() *time.Time {
+	if m != nil {
+		return m.NextSendTime
+	}
+	return nil
+}
+
+func (m *QueryThrottleStateResponse) GetRetryDelayPeriod() time.Duration {
+	if m != nil {
+		return m.RetryDelayPeriod
+	}
+	return 0
+}
+
+// PendingPacketInfo describes a packet in the pending packets queue
+type PendingPacketInfo struct {
+	// the index of the packet in the pending packets queue
+	Index uint64                       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Type  types.ConsumerPacketDataType `protobuf:"varint,2,opt,name=type,proto3,enum=interchain_security.ccv.v1.ConsumerPacketDataType" json:"type,omitempty"`
+	// the consensus address of the validator (for slash packets)
+	ValidatorAddress string `protobuf:"bytes,3,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// the infraction of the validator (for slash packets)
+	Infraction types2.Infraction `protobuf:"varint,4,opt,name=infraction,proto3,enum=cosmos.staking.v1beta1.Infraction" json:"infraction,omitempty"`
+	// the valset update id of the infraction (for slash packets)
+	// or of the matured VSC (for VSCMatured packets)
+	ValsetUpdateId uint64 `protobuf:"varint,5,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the height and time at which the packet was added to the queue;
+	// unset for the packets added to the queue before this was recorded
+	EnqueueHeight int64      `protobuf:"varint,6,opt,name=enqueue_height,json=enqueueHeight,proto3" json:"enqueue_height,omitempty"`
+	EnqueueTime   *time.Time `protobuf:"bytes,7,opt,name=enqueue_time,json=enqueueTime,proto3,stdtime" json:"enqueue_time,omitempty"`
+	// how long the packet has been in the queue
+	Age *time.Duration `protobuf:"bytes,8,opt,name=age,proto3,stdduration" json:"age,omitempty"`
+}
+
+func (m *PendingPacketInfo) Reset()         { *m = PendingPacketInfo{} }
+func (m *PendingPacketInfo) String() string { return proto.CompactTextString(m) }
+func (*PendingPacketInfo) ProtoMessage()    {}
+func (*PendingPacketInfo) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f627751d3cc10225, []int{9}
 }
-func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+func (m *PendingPacketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingPacketInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingPacketInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -500,853 +643,3522 @@ func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ChainInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChainInfo.Merge(m, src)
+func (m *PendingPacketInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacketInfo.Merge(m, src)
 }
-func (m *ChainInfo) XXX_Size() int {
+func (m *PendingPacketInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ChainInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
+func (m *PendingPacketInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacketInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+var xxx_messageInfo_PendingPacketInfo proto.InternalMessageInfo
 
-func (m *ChainInfo) GetChainID() string {
+func (m *PendingPacketInfo) GetIndex() uint64 {
 	if m != nil {
-		return m.ChainID
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *ChainInfo) GetClientID() string {
+func (m *PendingPacketInfo) GetType() types.ConsumerPacketDataType {
 	if m != nil {
-		return m.ClientID
+		return m.Type
 	}
-	return ""
+	return types.UnspecifiedPacket
 }
 
-func (m *ChainInfo) GetConnectionID() string {
+func (m *PendingPacketInfo) GetValidatorAddress() string {
 	if m != nil {
-		return m.ConnectionID
+		return m.ValidatorAddress
 	}
 	return ""
 }
 
-func (m *ChainInfo) GetChannelID() string {
+func (m *PendingPacketInfo) GetInfraction() types2.Infraction {
 	if m != nil {
-		return m.ChannelID
+		return m.Infraction
 	}
-	return ""
+	return types2.Infraction_INFRACTION_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
-	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
-	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryProviderInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoRequest")
-	proto.RegisterType((*QueryProviderInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoResponse")
-	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
-	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
-	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
+func (m *PendingPacketInfo) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/consumer/v1/query.proto", fileDescriptor_f627751d3cc10225)
+func (m *PendingPacketInfo) GetEnqueueHeight() int64 {
+	if m != nil {
+		return m.EnqueueHeight
+	}
+	return 0
 }
 
-var fileDescriptor_f627751d3cc10225 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xd3, 0x36, 0xbb, 0x99, 0x2e, 0x42, 0x3b, 0x04, 0xc9, 0x78, 0x57, 0xa1, 0x32, 0x20,
-	0xc2, 0x4a, 0xb1, 0x93, 0xec, 0x21, 0xcb, 0x61, 0x59, 0xb4, 0x1b, 0xaa, 0x46, 0x02, 0xd4, 0xba,
-	0x95, 0x10, 0x5c, 0xcc, 0x74, 0x32, 0x4d, 0x2c, 0x12, 0x8f, 0x3b, 0x33, 0x36, 0xed, 0x0d, 0xc1,
-	0x1d, 0x21, 0xf1, 0x4d, 0xf8, 0x02, 0x5c, 0x2b, 0x71, 0xa0, 0x12, 0x07, 0xe0, 0x82, 0x50, 0xcb,
-	0x87, 0xe0, 0x88, 0x66, 0x3c, 0x4e, 0x9d, 0x36, 0x4d, 0xdc, 0xb2, 0x37, 0xcf, 0xfb, 0xf3, 0x7b,
-	0xbf, 0xdf, 0x9b, 0x79, 0x2f, 0x01, 0x6e, 0x10, 0x0a, 0xc2, 0xf0, 0x08, 0x05, 0xa1, 0xcf, 0x09,
-	0x8e, 0x59, 0x20, 0x8e, 0x5d, 0x8c, 0x13, 0x17, 0xd3, 0x90, 0xc7, 0x13, 0xc2, 0xdc, 0xa4, 0xed,
-	0x1e, 0xc6, 0x84, 0x1d, 0x3b, 0x11, 0xa3, 0x82, 0xc2, 0xb7, 0xe6, 0x24, 0x38, 0x18, 0x27, 0x4e,
-	0x96, 0xe0, 0x24, 0x6d, 0xab, 0x75, 0x1d, 0x6a, 0xd2, 0x76, 0xf9, 0x08, 0x31, 0x32, 0xf0, 0xa7,
-	0xe1, 0x0a, 0xd6, 0xaa, 0x0d, 0xe9, 0x90, 0xaa, 0x4f, 0x57, 0x7e, 0x69, 0xeb, 0xc3, 0x21, 0xa5,
-	0xc3, 0x31, 0x71, 0x51, 0x14, 0xb8, 0x28, 0x0c, 0xa9, 0x40, 0x22, 0xa0, 0x21, 0xd7, 0xde, 0x4e,
-	0x11, 0xee, 0x97, 0xea, 0xbc, 0xb3, 0x80, 0xd9, 0xd7, 0x01, 0x23, 0x69, 0x98, 0xfd, 0x7d, 0x19,
-	0x3c, 0xf8, 0x94, 0x1c, 0x89, 0x4d, 0x42, 0x7a, 0x01, 0x17, 0x2c, 0xd8, 0x8f, 0x65, 0xe5, 0x8f,
-	0xb8, 0x08, 0x26, 0x48, 0x10, 0xf8, 0x36, 0x78, 0x05, 0xc7, 0x8c, 0x91, 0x50, 0x6c, 0x91, 0x60,
-	0x38, 0x12, 0xa6, 0xb1, 0x61, 0x34, 0x56, 0xbc, 0x59, 0x23, 0xac, 0x03, 0x30, 0x46, 0x3c, 0x0b,
-	0x29, 0xab, 0x90, 0x9c, 0x45, 0xfa, 0x43, 0x72, 0x94, 0xf9, 0x57, 0x52, 0xff, 0x85, 0x05, 0x3e,
-	0x06, 0xaf, 0x0f, 0x72, 0xd5, 0xfd, 0x03, 0x86, 0xb0, 0xfc, 0x30, 0x57, 0x37, 0x8c, 0x46, 0xd5,
-	0xab, 0xe5, 0x9d, 0x9b, 0xda, 0x07, 0x6b, 0x60, 0x4d, 0x50, 0x81, 0xc6, 0xe6, 0x9a, 0x0a, 0x4a,
-	0x0f, 0xb2, 0x94, 0xa0, 0xdb, 0x8c, 0x26, 0xc1, 0x80, 0x30, 0xb3, 0xa2, 0x5c, 0x39, 0x4b, 0xea,
-	0x7f, 0xa1, 0x7b, 0x65, 0xde, 0xc9, 0xfc, 0x99, 0xc5, 0x7e, 0x0f, 0xbc, 0xbb, 0x23, 0x5f, 0xc1,
-	0x82, 0xa6, 0x78, 0xe4, 0x30, 0x26, 0x5c, 0xd8, 0xdf, 0x18, 0xa0, 0xb1, 0x3c, 0x96, 0x47, 0x34,
-	0xe4, 0x04, 0xee, 0x81, 0xd5, 0x01, 0x12, 0x48, 0xf5, 0x6f, 0xbd, 0xf3, 0xa1, 0x53, 0xe0, 0x75,
-	0x39, 0x8b, 0x70, 0x15, 0x9a, 0x5d, 0x03, 0x50, 0x31, 0xd8, 0x46, 0x0c, 0x4d, 0x78, 0x46, 0xcc,
-	0x07, 0xaf, 0xcd, 0x58, 0x35, 0x85, 0x2d, 0x50, 0x89, 0x94, 0x45, 0x93, 0x78, 0x74, 0x2d, 0x89,
-	0xa4, 0xed, 0x64, 0x0d, 0x49, 0x31, 0x9e, 0xaf, 0x9e, 0xfc, 0xf5, 0x66, 0xc9, 0xd3, 0xf9, 0xb6,
-	0x05, 0xcc, 0xb4, 0x80, 0xee, 0x6a, 0x3f, 0x3c, 0xa0, 0x59, 0xf1, 0x9f, 0x0d, 0xf0, 0xc6, 0x1c,
-	0xa7, 0xe6, 0xb0, 0x0d, 0xee, 0x66, 0x0a, 0x35, 0x0b, 0xa7, 0x50, 0x2b, 0x5e, 0x48, 0xb7, 0x44,
-	0xd2, 0x4c, 0xa6, 0x28, 0x12, 0x31, 0xca, 0xae, 0xbb, 0xfc, 0x7f, 0x10, 0x33, 0x14, 0xfb, 0x81,
-	0x16, 0xb0, 0x37, 0x62, 0x54, 0x88, 0x31, 0xd9, 0x15, 0xb9, 0x4b, 0xff, 0xd3, 0x00, 0xd6, 0x3c,
-	0xaf, 0xd6, 0xf7, 0x39, 0xb8, 0xc7, 0xc7, 0x88, 0x8f, 0x7c, 0x46, 0x30, 0x65, 0x03, 0xad, 0xb1,
-	0x55, 0x88, 0xd1, 0xae, 0x4c, 0xf4, 0x54, 0x9e, 0xe2, 0x64, 0x78, 0xeb, 0xfc, 0xc2, 0x04, 0xbf,
-	0x04, 0xf7, 0x23, 0x84, 0xbf, 0x22, 0xc2, 0x97, 0x57, 0xef, 0x1f, 0xc6, 0x24, 0x26, 0x66, 0x79,
-	0x63, 0x65, 0xa1, 0xe2, 0x99, 0x9b, 0x94, 0xc9, 0x3d, 0x24, 0x90, 0x56, 0xfc, 0x6a, 0x34, 0xb5,
-	0xec, 0x48, 0x30, 0xfb, 0x3b, 0x03, 0x54, 0xa7, 0x6d, 0x81, 0x26, 0xb8, 0xa3, 0x00, 0xfb, 0x3d,
-	0xa5, 0xa2, 0xea, 0x65, 0x47, 0x68, 0x81, 0xbb, 0x78, 0x1c, 0x90, 0x50, 0xf4, 0x7b, 0xaa, 0xe5,
-	0x55, 0x6f, 0x7a, 0x86, 0x36, 0xb8, 0x87, 0x69, 0x18, 0x12, 0x35, 0xa3, 0xfd, 0x9e, 0x1a, 0xf6,
-	0xaa, 0x37, 0x63, 0x83, 0x0f, 0x41, 0x15, 0x8f, 0x50, 0x18, 0x92, 0x71, 0xbf, 0xa7, 0x47, 0xfc,
-	0xc2, 0xd0, 0xf9, 0xbd, 0x02, 0xd6, 0x54, 0x87, 0xe1, 0xbf, 0x86, 0x7e, 0x67, 0x73, 0x06, 0x01,
-	0x7e, 0x5c, 0xa8, 0xa7, 0x05, 0x67, 0xd9, 0xfa, 0xe4, 0x25, 0xa1, 0xa5, 0xcf, 0xc0, 0x7e, 0xf6,
-	0xed, 0x6f, 0xff, 0xfc, 0x58, 0x7e, 0x1f, 0x76, 0x97, 0xff, 0xec, 0xc8, 0x35, 0xd8, 0x3c, 0x20,
-	0xa4, 0x99, 0x5f, 0x72, 0xf0, 0x27, 0x03, 0xac, 0xe7, 0x66, 0x18, 0x76, 0x8b, 0xf3, 0x9b, 0xd9,
-	0x05, 0xd6, 0x93, 0x9b, 0x27, 0x6a, 0x0d, 0x2d, 0xa5, 0xe1, 0x11, 0x6c, 0x2c, 0xd7, 0x90, 0xae,
-	0x05, 0xf8, 0x8b, 0x01, 0xee, 0x5f, 0x19, 0x7d, 0xf8, 0xf4, 0x06, 0x0c, 0xae, 0xee, 0x13, 0xeb,
-	0x83, 0xdb, 0xa6, 0x6b, 0x19, 0x5d, 0x25, 0xa3, 0x0d, 0xdd, 0x02, 0x32, 0x74, 0x7e, 0x33, 0x90,
-	0xbc, 0x7f, 0x35, 0xf4, 0x72, 0x9d, 0x99, 0x74, 0x78, 0x03, 0x3e, 0xf3, 0x16, 0x88, 0xf5, 0xec,
-	0xd6, 0xf9, 0x5a, 0xd0, 0x13, 0x25, 0xa8, 0x03, 0x5b, 0xcb, 0x05, 0x09, 0x0d, 0xe0, 0x73, 0x89,
-	0xf0, 0xfc, 0xb3, 0x93, 0xb3, 0xba, 0x71, 0x7a, 0x56, 0x37, 0xfe, 0x3e, 0xab, 0x1b, 0x3f, 0x9c,
-	0xd7, 0x4b, 0xa7, 0xe7, 0xf5, 0xd2, 0x1f, 0xe7, 0xf5, 0xd2, 0x17, 0x4f, 0x87, 0x81, 0x18, 0xc5,
-	0xfb, 0x0e, 0xa6, 0x13, 0x17, 0x53, 0x3e, 0xa1, 0x3c, 0x07, 0xde, 0x9c, 0x82, 0x27, 0x5d, 0xf7,
-	0xe8, 0x52, 0x85, 0xe3, 0x88, 0xf0, 0xfd, 0x8a, 0xfa, 0x33, 0xf1, 0xf8, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2e, 0x98, 0xd9, 0x58, 0x65, 0x09, 0x00, 0x00,
+func (m *PendingPacketInfo) GetEnqueueTime() *time.Time {
+	if m != nil {
+		return m.EnqueueTime
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *PendingPacketInfo) GetAge() *time.Duration {
+	if m != nil {
+		return m.Age
+	}
+	return nil
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
-	// QueryParams queries the ccv/consumer module parameters.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
-	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
-	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+type QueryProviderClientExpiryRequest struct {
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryProviderClientExpiryRequest) Reset()         { *m = QueryProviderClientExpiryRequest{} }
+func (m *QueryProviderClientExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderClientExpiryRequest) ProtoMessage()    {}
+func (*QueryProviderClientExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{10}
+}
+func (m *QueryProviderClientExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderClientExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderClientExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProviderClientExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderClientExpiryRequest.Merge(m, src)
+}
+func (m *QueryProviderClientExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderClientExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderClientExpiryRequest.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryProviderClientExpiryRequest proto.InternalMessageInfo
+
+type QueryProviderClientExpiryResponse struct {
+	ClientExpiry types.ClientExpiry `protobuf:"bytes,1,opt,name=client_expiry,json=clientExpiry,proto3" json:"client_expiry"`
 }
 
-func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error) {
-	out := new(QueryNextFeeDistributionEstimateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProviderClientExpiryResponse) Reset()         { *m = QueryProviderClientExpiryResponse{} }
+func (m *QueryProviderClientExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderClientExpiryResponse) ProtoMessage()    {}
+func (*QueryProviderClientExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{11}
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderClientExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderClientExpiryResponse.Merge(m, src)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderClientExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderClientExpiryResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderClientExpiryResponse proto.InternalMessageInfo
+
+func (m *QueryProviderClientExpiryResponse) GetClientExpiry() types.ClientExpiry {
+	if m != nil {
+		return m.ClientExpiry
 	}
-	return out, nil
+	return types.ClientExpiry{}
 }
 
-func (c *queryClient) QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error) {
-	out := new(QueryProviderInfoResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryProviderIbcIdsRequest struct {
 }
 
-func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
-	out := new(QueryThrottleStateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryProviderIbcIdsRequest) Reset()         { *m = QueryProviderIbcIdsRequest{} }
+func (m *QueryProviderIbcIdsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderIbcIdsRequest) ProtoMessage()    {}
+func (*QueryProviderIbcIdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{12}
+}
+func (m *QueryProviderIbcIdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderIbcIdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderIbcIdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
-	// QueryParams queries the ccv/consumer module parameters.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
-	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
-	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+func (m *QueryProviderIbcIdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderIbcIdsRequest.Merge(m, src)
+}
+func (m *QueryProviderIbcIdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderIbcIdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderIbcIdsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryProviderIbcIdsRequest proto.InternalMessageInfo
+
+type QueryProviderIbcIdsResponse struct {
+	// the client id (on the consumer) that is tracking the provider chain
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the CCV channel id (on the consumer);
+	// empty if the CCV channel is not yet established
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
+func (m *QueryProviderIbcIdsResponse) Reset()         { *m = QueryProviderIbcIdsResponse{} }
+func (m *QueryProviderIbcIdsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderIbcIdsResponse) ProtoMessage()    {}
+func (*QueryProviderIbcIdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{13}
 }
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+func (m *QueryProviderIbcIdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderInfo not implemented")
+func (m *QueryProviderIbcIdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderIbcIdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+func (m *QueryProviderIbcIdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderIbcIdsResponse.Merge(m, src)
+}
+func (m *QueryProviderIbcIdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderIbcIdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderIbcIdsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryProviderIbcIdsResponse proto.InternalMessageInfo
+
+func (m *QueryProviderIbcIdsResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextFeeDistributionEstimateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryNextFeeDistribution(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryNextFeeDistribution(ctx, req.(*QueryNextFeeDistributionEstimateRequest))
+func (m *QueryProviderIbcIdsResponse) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryRedistributionFractionsRequest struct {
 }
 
-func _Query_QueryProviderInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryProviderInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryProviderInfo(ctx, req.(*QueryProviderInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryRedistributionFractionsRequest) Reset()         { *m = QueryRedistributionFractionsRequest{} }
+func (m *QueryRedistributionFractionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRedistributionFractionsRequest) ProtoMessage()    {}
+func (*QueryRedistributionFractionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{14}
 }
-
-func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryThrottleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryThrottleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+func (m *QueryRedistributionFractionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedistributionFractionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedistributionFractionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryRedistributionFractionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedistributionFractionsRequest.Merge(m, src)
+}
+func (m *QueryRedistributionFractionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedistributionFractionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedistributionFractionsRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.consumer.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryNextFeeDistribution",
-			Handler:    _Query_QueryNextFeeDistribution_Handler,
-		},
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryProviderInfo",
-			Handler:    _Query_QueryProviderInfo_Handler,
-		},
-		{
-			MethodName: "QueryThrottleState",
-			Handler:    _Query_QueryThrottleState_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
+var xxx_messageInfo_QueryRedistributionFractionsRequest proto.InternalMessageInfo
+
+type QueryRedistributionFractionsResponse struct {
+	// the fraction of the fees allocated to the consumer redistribution address
+	// for the denoms without an override
+	DefaultFraction string `protobuf:"bytes,1,opt,name=default_fraction,json=defaultFraction,proto3" json:"default_fraction,omitempty"`
+	// the effective split of the fees for every denom with an override
+	// or with a balance in the fee pool
+	Splits []RedistributionSplit `protobuf:"bytes,2,rep,name=splits,proto3" json:"splits"`
 }
 
-func (m *NextFeeDistributionEstimate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedistributionFractionsResponse) Reset()         { *m = QueryRedistributionFractionsResponse{} }
+func (m *QueryRedistributionFractionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRedistributionFractionsResponse) ProtoMessage()    {}
+func (*QueryRedistributionFractionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{15}
+}
+func (m *QueryRedistributionFractionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRedistributionFractionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRedistributionFractionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *NextFeeDistributionEstimate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRedistributionFractionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRedistributionFractionsResponse.Merge(m, src)
+}
+func (m *QueryRedistributionFractionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRedistributionFractionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRedistributionFractionsResponse.DiscardUnknown(m)
 }
 
-func (m *NextFeeDistributionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToConsumer) > 0 {
-		i -= len(m.ToConsumer)
-		copy(dAtA[i:], m.ToConsumer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToConsumer)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ToProvider) > 0 {
-		i -= len(m.ToProvider)
-		copy(dAtA[i:], m.ToProvider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToProvider)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DistributionFraction) > 0 {
-		i -= len(m.DistributionFraction)
-		copy(dAtA[i:], m.DistributionFraction)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionFraction)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NextHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LastHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CurrentHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryRedistributionFractionsResponse proto.InternalMessageInfo
+
+func (m *QueryRedistributionFractionsResponse) GetDefaultFraction() string {
+	if m != nil {
+		return m.DefaultFraction
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRedistributionFractionsResponse) GetSplits() []RedistributionSplit {
+	if m != nil {
+		return m.Splits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RedistributionSplit holds the effective split of the fees of a denom
+type RedistributionSplit struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the fraction of the fees allocated to the consumer redistribution address
+	ConsumerFraction string `protobuf:"bytes,2,opt,name=consumer_fraction,json=consumerFraction,proto3" json:"consumer_fraction,omitempty"`
+	// the fraction of the fees sent to the provider chain
+	ProviderFraction string `protobuf:"bytes,3,opt,name=provider_fraction,json=providerFraction,proto3" json:"provider_fraction,omitempty"`
+	// whether the consumer fraction is a per-denom override
+	Overridden bool `protobuf:"varint,4,opt,name=overridden,proto3" json:"overridden,omitempty"`
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *RedistributionSplit) Reset()         { *m = RedistributionSplit{} }
+func (m *RedistributionSplit) String() string { return proto.CompactTextString(m) }
+func (*RedistributionSplit) ProtoMessage()    {}
+func (*RedistributionSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{16}
+}
+func (m *RedistributionSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedistributionSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedistributionSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedistributionSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedistributionSplit.Merge(m, src)
+}
+func (m *RedistributionSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedistributionSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedistributionSplit.DiscardUnknown(m)
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RedistributionSplit proto.InternalMessageInfo
+
+func (m *RedistributionSplit) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RedistributionSplit) GetConsumerFraction() string {
+	if m != nil {
+		return m.ConsumerFraction
+	}
+	return ""
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *RedistributionSplit) GetProviderFraction() string {
+	if m != nil {
+		return m.ProviderFraction
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedistributionSplit) GetOverridden() bool {
+	if m != nil {
+		return m.Overridden
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryProviderValsetVerificationRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProviderValsetVerificationRequest) Reset() {
+	*m = QueryProviderValsetVerificationRequest{}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderValsetVerificationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderValsetVerificationRequest) ProtoMessage()    {}
+func (*QueryProviderValsetVerificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{17}
+}
+func (m *QueryProviderValsetVerificationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderValsetVerificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderValsetVerificationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProviderValsetVerificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderValsetVerificationRequest.Merge(m, src)
+}
+func (m *QueryProviderValsetVerificationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderValsetVerificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderValsetVerificationRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProviderValsetVerificationRequest proto.InternalMessageInfo
+
+type QueryProviderValsetVerificationResponse struct {
+	Verification ProviderValsetVerification `protobuf:"bytes,1,opt,name=verification,proto3" json:"verification"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryProviderValsetVerificationResponse) Reset() {
+	*m = QueryProviderValsetVerificationResponse{}
+}
+func (m *QueryProviderValsetVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderValsetVerificationResponse) ProtoMessage()    {}
+func (*QueryProviderValsetVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{18}
+}
+func (m *QueryProviderValsetVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderValsetVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderValsetVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProviderInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryProviderValsetVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderValsetVerificationResponse.Merge(m, src)
 }
-
-func (m *QueryProviderInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderValsetVerificationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryProviderInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProviderValsetVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderValsetVerificationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProviderInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProviderValsetVerificationResponse proto.InternalMessageInfo
+
+func (m *QueryProviderValsetVerificationResponse) GetVerification() ProviderValsetVerification {
+	if m != nil {
+		return m.Verification
 	}
-	return dAtA[:n], nil
+	return ProviderValsetVerification{}
 }
 
-func (m *QueryProviderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ChainInfo struct {
+	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	ClientID     string `protobuf:"bytes,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	ConnectionID string `protobuf:"bytes,3,opt,name=connectionID,proto3" json:"connectionID,omitempty"`
+	ChannelID    string `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
 }
 
-func (m *QueryProviderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
+func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
+func (*ChainInfo) ProtoMessage()    {}
+func (*ChainInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{19}
+}
+func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ChainInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainInfo.Merge(m, src)
+}
+func (m *ChainInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainInfo.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ChainInfo proto.InternalMessageInfo
+
+func (m *ChainInfo) GetChainID() string {
+	if m != nil {
+		return m.ChainID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ChainInfo) GetClientID() string {
+	if m != nil {
+		return m.ClientID
+	}
+	return ""
 }
 
-func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ChainInfo) GetConnectionID() string {
+	if m != nil {
+		return m.ConnectionID
+	}
+	return ""
 }
 
-func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ChainInfo) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
+	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
+	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryProviderInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoRequest")
+	proto.RegisterType((*QueryProviderInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoResponse")
+	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
+	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
+	proto.RegisterType((*PendingPacketInfo)(nil), "interchain_security.ccv.consumer.v1.PendingPacketInfo")
+	proto.RegisterType((*QueryProviderClientExpiryRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderClientExpiryRequest")
+	proto.RegisterType((*QueryProviderClientExpiryResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderClientExpiryResponse")
+	proto.RegisterType((*QueryProviderIbcIdsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderIbcIdsRequest")
+	proto.RegisterType((*QueryProviderIbcIdsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderIbcIdsResponse")
+	proto.RegisterType((*QueryRedistributionFractionsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryRedistributionFractionsRequest")
+	proto.RegisterType((*QueryRedistributionFractionsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryRedistributionFractionsResponse")
+	proto.RegisterType((*RedistributionSplit)(nil), "interchain_security.ccv.consumer.v1.RedistributionSplit")
+	proto.RegisterType((*QueryProviderValsetVerificationRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderValsetVerificationRequest")
+	proto.RegisterType((*QueryProviderValsetVerificationResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderValsetVerificationResponse")
+	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
 }
 
-func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketDataQueue) > 0 {
-		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketDataQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.SlashRecord != nil {
-		{
-			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("interchain_security/ccv/consumer/v1/query.proto", fileDescriptor_f627751d3cc10225)
 }
 
-func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_f627751d3cc10225 = []byte{
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x73, 0x1b, 0x49,
+	0x15, 0xcf, 0xd8, 0x8a, 0x3f, 0x9e, 0x1d, 0x27, 0xee, 0x18, 0x4a, 0xab, 0x04, 0xc5, 0x4c, 0x12,
+	0xa2, 0xdd, 0x25, 0x33, 0x91, 0x16, 0x88, 0x49, 0xb1, 0x9b, 0xe0, 0x28, 0xde, 0x08, 0x0c, 0xe5,
+	0x8c, 0x82, 0xa9, 0xe5, 0x32, 0xb4, 0x66, 0xda, 0x52, 0x57, 0xa4, 0x19, 0x65, 0xba, 0x25, 0xec,
+	0x1b, 0x05, 0x9c, 0xa9, 0xad, 0x82, 0x03, 0x27, 0x6e, 0x1c, 0xb8, 0xc2, 0x3f, 0xc0, 0x71, 0xab,
+	0x38, 0xb0, 0x55, 0x70, 0xe0, 0x04, 0x54, 0xc2, 0x91, 0x3b, 0x1c, 0xa9, 0xee, 0x79, 0x2d, 0x8d,
+	0x6c, 0x59, 0x96, 0x9c, 0xdc, 0x34, 0xef, 0xe3, 0xd7, 0xef, 0xb3, 0xdf, 0x6b, 0x81, 0xcb, 0x23,
+	0xc9, 0x92, 0xa0, 0x45, 0x79, 0xe4, 0x0b, 0x16, 0xf4, 0x12, 0x2e, 0x8f, 0xdc, 0x20, 0xe8, 0xbb,
+	0x41, 0x1c, 0x89, 0x5e, 0x87, 0x25, 0x6e, 0xbf, 0xec, 0xbe, 0xec, 0xb1, 0xe4, 0xc8, 0xe9, 0x26,
+	0xb1, 0x8c, 0xc9, 0xcd, 0x31, 0x0a, 0x4e, 0x10, 0xf4, 0x1d, 0xa3, 0xe0, 0xf4, 0xcb, 0x85, 0x7b,
+	0xa7, 0xa1, 0xf6, 0xcb, 0xae, 0x68, 0xd1, 0x84, 0x85, 0xfe, 0x40, 0x5c, 0xc3, 0x16, 0x36, 0x9a,
+	0x71, 0x33, 0xd6, 0x3f, 0x5d, 0xf5, 0x0b, 0xa9, 0xd7, 0x9b, 0x71, 0xdc, 0x6c, 0x33, 0x97, 0x76,
+	0xb9, 0x4b, 0xa3, 0x28, 0x96, 0x54, 0xf2, 0x38, 0x12, 0xc8, 0xad, 0x4c, 0x63, 0xfb, 0xb1, 0x73,
+	0x6e, 0x4f, 0xb0, 0xec, 0x27, 0x3c, 0x61, 0x28, 0x56, 0xc4, 0x83, 0xf5, 0x57, 0xa3, 0x77, 0xe0,
+	0x86, 0xbd, 0x44, 0x9f, 0x8d, 0xfc, 0x1b, 0xc7, 0xf9, 0x92, 0x77, 0x98, 0x90, 0xb4, 0xd3, 0x45,
+	0x81, 0x5b, 0x41, 0x2c, 0x3a, 0xb1, 0x70, 0x85, 0xa4, 0x2f, 0x78, 0xd4, 0x74, 0xfb, 0xe5, 0x06,
+	0x93, 0xb4, 0x6c, 0xbe, 0x0d, 0x0c, 0x6f, 0x04, 0x6e, 0x10, 0x27, 0xcc, 0x0d, 0xda, 0x9c, 0x45,
+	0x52, 0x1b, 0xac, 0x7f, 0xa5, 0x02, 0xf6, 0x2f, 0xe7, 0xe0, 0xda, 0xf7, 0xd9, 0xa1, 0xdc, 0x61,
+	0xac, 0xca, 0x85, 0x4c, 0x78, 0xa3, 0xa7, 0xac, 0x78, 0x22, 0x24, 0xef, 0x50, 0xc9, 0xc8, 0x2d,
+	0xb8, 0x14, 0xf4, 0x92, 0x84, 0x45, 0xf2, 0x29, 0xe3, 0xcd, 0x96, 0xcc, 0x5b, 0x9b, 0x56, 0x69,
+	0xde, 0x1b, 0x25, 0x92, 0x22, 0x40, 0x9b, 0x0a, 0x23, 0x32, 0xa7, 0x45, 0x32, 0x14, 0xc5, 0x8f,
+	0xd8, 0xa1, 0xe1, 0xcf, 0xa7, 0xfc, 0x21, 0x85, 0x7c, 0x00, 0x5f, 0x08, 0x33, 0xa7, 0xfb, 0x07,
+	0x09, 0x0d, 0xd4, 0x8f, 0x7c, 0x6e, 0xd3, 0x2a, 0x2d, 0x7b, 0x1b, 0x59, 0xe6, 0x0e, 0xf2, 0xc8,
+	0x06, 0x5c, 0x94, 0xb1, 0xa4, 0xed, 0xfc, 0x45, 0x2d, 0x94, 0x7e, 0xa8, 0xa3, 0x64, 0xbc, 0x97,
+	0xc4, 0x7d, 0x1e, 0xb2, 0x24, 0xbf, 0xa0, 0x59, 0x19, 0x4a, 0xca, 0x7f, 0x8c, 0x39, 0xcb, 0x2f,
+	0x1a, 0xbe, 0xa1, 0xd8, 0xef, 0xc2, 0x9d, 0x67, 0xaa, 0x1a, 0x27, 0x04, 0xc5, 0x63, 0x2f, 0x7b,
+	0x4c, 0x48, 0xfb, 0xa7, 0x16, 0x94, 0xce, 0x96, 0x15, 0xdd, 0x38, 0x12, 0x8c, 0x3c, 0x87, 0x5c,
+	0x48, 0x25, 0xd5, 0xf1, 0x5b, 0xa9, 0x3c, 0x72, 0xa6, 0xa8, 0x72, 0x67, 0x12, 0xae, 0x46, 0xb3,
+	0x37, 0x80, 0x68, 0x0b, 0xf6, 0x68, 0x42, 0x3b, 0xc2, 0x18, 0xe6, 0xc3, 0xd5, 0x11, 0x2a, 0x9a,
+	0xf0, 0x14, 0x16, 0xba, 0x9a, 0x82, 0x46, 0xbc, 0x77, 0xaa, 0x11, 0xfd, 0xb2, 0x63, 0x02, 0x92,
+	0x62, 0x6c, 0xe7, 0x3e, 0xfb, 0xc7, 0x8d, 0x0b, 0x1e, 0xea, 0xdb, 0x05, 0xc8, 0xa7, 0x07, 0x60,
+	0x54, 0x6b, 0xd1, 0x41, 0x6c, 0x0e, 0x7f, 0x75, 0x11, 0xde, 0x19, 0xc3, 0x44, 0x1b, 0xf6, 0x60,
+	0xc9, 0x78, 0x88, 0x56, 0x38, 0x53, 0x85, 0xe2, 0xb1, 0x62, 0x2b, 0x24, 0xb4, 0x64, 0x80, 0xa2,
+	0x10, 0xbb, 0x26, 0xdd, 0x73, 0x6f, 0x82, 0x68, 0x50, 0x88, 0x07, 0x1b, 0x69, 0x8f, 0xf8, 0x6d,
+	0x2a, 0x99, 0x90, 0x7e, 0x6b, 0x58, 0xb7, 0x2b, 0x95, 0x82, 0xc3, 0x1b, 0x81, 0xa3, 0x7a, 0xca,
+	0xc1, 0x4e, 0xea, 0x97, 0x9d, 0xb4, 0x8e, 0x11, 0x89, 0xa4, 0xf4, 0x5d, 0xad, 0x8c, 0x15, 0x5e,
+	0x87, 0x4b, 0x88, 0xc9, 0x0e, 0xbb, 0x3c, 0x39, 0xd2, 0x95, 0xbd, 0x52, 0x29, 0x4d, 0x4c, 0x81,
+	0x56, 0x78, 0xa2, 0xe5, 0x11, 0x7a, 0x35, 0xc8, 0xd0, 0xc8, 0x4d, 0xb8, 0x14, 0xb4, 0x68, 0x14,
+	0xb1, 0xb6, 0x2f, 0x24, 0x95, 0x0c, 0x3b, 0x61, 0x15, 0x89, 0x75, 0x45, 0x23, 0x77, 0xe0, 0x72,
+	0x97, 0x45, 0x21, 0x8f, 0x9a, 0x7e, 0x97, 0x06, 0x2f, 0x98, 0x14, 0xba, 0x2b, 0x72, 0xde, 0x1a,
+	0x92, 0xf7, 0x52, 0x2a, 0xf9, 0x18, 0x96, 0x54, 0xcb, 0xfa, 0x7d, 0x11, 0xe8, 0xbe, 0x58, 0xa9,
+	0x7c, 0x75, 0xaa, 0x40, 0xee, 0x52, 0x21, 0xf7, 0xeb, 0x8f, 0xbd, 0x45, 0xa5, 0xbd, 0x2f, 0x02,
+	0xe2, 0xc1, 0x55, 0x75, 0x5b, 0xf9, 0x82, 0x47, 0x01, 0xf3, 0x07, 0x98, 0x4b, 0x1a, 0xf3, 0x1d,
+	0x27, 0xbd, 0xd9, 0x1c, 0x73, 0xb3, 0x39, 0x55, 0xbc, 0xf9, 0xb6, 0x97, 0x94, 0x8b, 0xbf, 0xf9,
+	0xe7, 0x0d, 0xcb, 0xbb, 0xa2, 0xf4, 0xeb, 0x4a, 0x7d, 0x17, 0x31, 0xeb, 0xb0, 0x2a, 0xda, 0x54,
+	0xb4, 0xfc, 0x84, 0x05, 0x71, 0x12, 0xe6, 0x97, 0x35, 0xd8, 0xbd, 0xa9, 0x0c, 0xac, 0x2b, 0x45,
+	0x4f, 0xeb, 0x79, 0x2b, 0x62, 0xf8, 0x41, 0xb6, 0x20, 0x9f, 0x86, 0xc4, 0x17, 0x26, 0x42, 0x2c,
+	0xe9, 0x70, 0x29, 0x59, 0x98, 0x87, 0x4d, 0xab, 0xb4, 0xe4, 0x7d, 0x31, 0xe5, 0xd7, 0x31, 0x52,
+	0x86, 0x6b, 0x5f, 0xc3, 0x1a, 0x7f, 0xde, 0x4a, 0x62, 0x29, 0xdb, 0x4c, 0x87, 0xda, 0x74, 0xc0,
+	0x9f, 0x72, 0x50, 0x18, 0xc7, 0xc5, 0x16, 0xf8, 0xe4, 0x98, 0x2b, 0xd6, 0xf9, 0x5c, 0xd1, 0x15,
+	0x61, 0x8d, 0x3a, 0xf4, 0x63, 0x58, 0x47, 0x87, 0xd4, 0xed, 0xe0, 0xbf, 0xec, 0xb1, 0x1e, 0xcb,
+	0xcf, 0x6d, 0xce, 0x4f, 0x6c, 0x8a, 0x91, 0x66, 0x57, 0xca, 0x55, 0x2a, 0x29, 0xd6, 0xdb, 0xe5,
+	0xee, 0x80, 0xf2, 0x4c, 0x81, 0x11, 0x76, 0xb2, 0x9a, 0xe6, 0x35, 0xfe, 0x37, 0xa6, 0xb2, 0x7f,
+	0x2f, 0x5b, 0x72, 0x99, 0xe6, 0x3b, 0x5e, 0x8b, 0x93, 0x32, 0x93, 0x9b, 0x94, 0x19, 0x72, 0x1b,
+	0xd6, 0x1a, 0xed, 0x38, 0x78, 0xc1, 0x42, 0x3f, 0x61, 0x54, 0xc4, 0x11, 0x36, 0xc5, 0x25, 0xa4,
+	0x7a, 0x9a, 0x48, 0xbe, 0x03, 0x6b, 0x6a, 0xfe, 0x68, 0x78, 0x5f, 0x55, 0x5b, 0x7e, 0x01, 0xbb,
+	0xfb, 0x78, 0x79, 0x3e, 0x37, 0x83, 0x57, 0xd7, 0xa7, 0xf5, 0xa9, 0xaa, 0xcf, 0x55, 0xa5, 0xab,
+	0x8e, 0x56, 0x4c, 0xf2, 0x0c, 0x48, 0xc2, 0x64, 0x72, 0xe4, 0x87, 0xac, 0x4d, 0x8f, 0x94, 0xa5,
+	0x3c, 0x0e, 0xf3, 0x8b, 0x33, 0x94, 0xbb, 0x56, 0xaf, 0x2a, 0xed, 0x3d, 0xad, 0x6c, 0xff, 0x71,
+	0x1e, 0xd6, 0x4f, 0xc4, 0x4a, 0x4d, 0x3c, 0x1e, 0x85, 0xec, 0x50, 0x97, 0x4c, 0xce, 0x4b, 0x3f,
+	0xc8, 0x0e, 0xe4, 0xe4, 0x51, 0x97, 0xe9, 0xcb, 0x6f, 0xad, 0x52, 0x99, 0x2d, 0xcf, 0xcf, 0x8f,
+	0xba, 0xcc, 0xd3, 0xfa, 0xe4, 0x7d, 0x58, 0xef, 0xd3, 0x36, 0x0f, 0xa9, 0x8c, 0x13, 0x9f, 0x86,
+	0x61, 0xc2, 0x84, 0xd0, 0x77, 0xde, 0xb2, 0x77, 0x65, 0xc0, 0xf8, 0x76, 0x4a, 0x27, 0xdb, 0x00,
+	0x3c, 0x1a, 0x19, 0xd3, 0x6b, 0x15, 0xdb, 0x49, 0x77, 0x12, 0xc7, 0xec, 0x20, 0xb8, 0x93, 0x38,
+	0xb5, 0x81, 0xa4, 0x97, 0xd1, 0x22, 0x25, 0x50, 0xb8, 0x82, 0x49, 0xbf, 0xd7, 0x0d, 0xa9, 0x64,
+	0x3e, 0x0f, 0x75, 0xb2, 0x72, 0xde, 0x5a, 0x4a, 0xff, 0x81, 0x26, 0xd7, 0x74, 0x52, 0x59, 0xa4,
+	0xab, 0xd9, 0xdc, 0xc5, 0x0b, 0xe9, 0x1a, 0x82, 0x54, 0xbc, 0x64, 0x3f, 0x86, 0x55, 0x23, 0xa6,
+	0x53, 0xba, 0x38, 0x43, 0x4a, 0x57, 0x50, 0x53, 0x67, 0xf4, 0xeb, 0x30, 0x4f, 0x9b, 0x6c, 0xba,
+	0x1b, 0xcb, 0xd2, 0x29, 0x54, 0xf2, 0xb6, 0x0d, 0x9b, 0x23, 0x93, 0x2f, 0x7b, 0x81, 0x9b, 0xcb,
+	0xe1, 0x10, 0xbe, 0x3c, 0x41, 0x06, 0xaf, 0x88, 0x13, 0xd3, 0xc2, 0x7a, 0xf3, 0x69, 0x61, 0x5f,
+	0x87, 0xc2, 0xc8, 0xc9, 0xb5, 0x46, 0x50, 0x0b, 0x07, 0x3b, 0xc3, 0x27, 0x70, 0x6d, 0x2c, 0x17,
+	0x2d, 0xba, 0x06, 0xcb, 0x68, 0x11, 0x4f, 0x6f, 0xac, 0x65, 0x6f, 0x29, 0x25, 0xd4, 0x42, 0xf2,
+	0x25, 0x00, 0x33, 0x87, 0x78, 0xa8, 0xeb, 0x70, 0xd9, 0x5b, 0x46, 0x4a, 0x2d, 0xb4, 0x6f, 0xc3,
+	0x4d, 0x0d, 0xed, 0xb1, 0x71, 0x7b, 0xdc, 0xc0, 0x82, 0xdf, 0x5b, 0x70, 0x6b, 0xb2, 0x1c, 0xda,
+	0xf2, 0x2e, 0x5c, 0x09, 0xd9, 0x01, 0xed, 0xb5, 0xe5, 0x70, 0x51, 0x4c, 0x4d, 0xba, 0x8c, 0x74,
+	0xa3, 0x43, 0xf6, 0x61, 0x41, 0x74, 0xdb, 0x5c, 0x0a, 0xbc, 0x05, 0xb7, 0xa6, 0xba, 0xa5, 0x46,
+	0x0d, 0xa8, 0x2b, 0x00, 0xb3, 0x00, 0xa5, 0x68, 0xf6, 0xef, 0x2c, 0xb8, 0x3a, 0x46, 0x4a, 0x75,
+	0x68, 0xc8, 0xa2, 0xb8, 0x83, 0xf6, 0xa4, 0x1f, 0xaa, 0xb3, 0x0c, 0xfc, 0xd0, 0xe2, 0x34, 0x4c,
+	0x57, 0x0c, 0x63, 0x60, 0xf2, 0xfb, 0xb0, 0x6e, 0x36, 0x91, 0xa1, 0x30, 0xb6, 0xa1, 0x61, 0x0c,
+	0x84, 0x8b, 0x00, 0x71, 0x9f, 0x25, 0x09, 0x0f, 0x43, 0x16, 0xe1, 0xcd, 0x98, 0xa1, 0xd8, 0x25,
+	0xf8, 0xca, 0x48, 0x56, 0xf7, 0x75, 0x5f, 0xed, 0xb3, 0x84, 0x1f, 0xf0, 0x40, 0xd7, 0xb0, 0x89,
+	0xfe, 0xaf, 0x2d, 0xb8, 0x73, 0xa6, 0x28, 0x26, 0x80, 0xc3, 0x6a, 0x3f, 0x43, 0xc7, 0xea, 0x7c,
+	0x38, 0xdd, 0x04, 0x38, 0x15, 0xde, 0x14, 0x6d, 0x16, 0xda, 0xfe, 0xb9, 0x05, 0xcb, 0x83, 0x4d,
+	0x8d, 0xe4, 0x61, 0x51, 0xc3, 0xd7, 0xaa, 0x18, 0x60, 0xf3, 0x49, 0x0a, 0x60, 0xca, 0xb1, 0x8a,
+	0x91, 0x1d, 0x7c, 0x13, 0x1b, 0x56, 0x83, 0x38, 0x8a, 0x98, 0x0e, 0x59, 0xad, 0x8a, 0xc1, 0x1c,
+	0xa1, 0x91, 0xeb, 0x30, 0x28, 0xd8, 0x2a, 0xbe, 0x3a, 0x86, 0x84, 0xca, 0x6f, 0xd7, 0xe0, 0xa2,
+	0x0e, 0x0e, 0xf9, 0x9f, 0x85, 0xab, 0xef, 0x98, 0xdd, 0x9c, 0xec, 0x4e, 0x15, 0x81, 0x29, 0x9f,
+	0x17, 0x85, 0xef, 0xbd, 0x25, 0xb4, 0x34, 0x69, 0xf6, 0xc3, 0x9f, 0xfd, 0xf5, 0xdf, 0xbf, 0x9a,
+	0xfb, 0x26, 0xb9, 0x7f, 0xf6, 0x8b, 0x5c, 0x4d, 0xb7, 0xbb, 0x07, 0x8c, 0xdd, 0xcd, 0x16, 0x38,
+	0xf9, 0x83, 0x05, 0x2b, 0x99, 0x67, 0x05, 0xb9, 0x3f, 0xbd, 0x7d, 0x23, 0xcf, 0x93, 0xc2, 0xd6,
+	0xec, 0x8a, 0xe8, 0xc3, 0x3d, 0xed, 0xc3, 0x7b, 0xa4, 0x74, 0xb6, 0x0f, 0xe9, 0x4b, 0x85, 0xfc,
+	0xd9, 0x82, 0xf5, 0x13, 0xaf, 0x11, 0xf2, 0xe1, 0x0c, 0x16, 0x9c, 0x7c, 0xe2, 0x14, 0x3e, 0x3a,
+	0xaf, 0x3a, 0xba, 0x71, 0x5f, 0xbb, 0x51, 0x26, 0xee, 0x14, 0x6e, 0xa0, 0xfe, 0x5d, 0xae, 0xec,
+	0xfe, 0x8b, 0x05, 0xe4, 0xe4, 0x66, 0x49, 0x66, 0xb0, 0x67, 0xdc, 0xc2, 0x5a, 0x78, 0x78, 0x6e,
+	0x7d, 0x74, 0x68, 0x4b, 0x3b, 0x54, 0x21, 0xf7, 0xce, 0x76, 0x48, 0x22, 0x40, 0xfa, 0x62, 0x21,
+	0xff, 0xb1, 0x8e, 0xbd, 0x16, 0xb3, 0x63, 0x8c, 0x3c, 0x99, 0x3d, 0xd0, 0x63, 0x66, 0x6e, 0x61,
+	0xe7, 0x4d, 0x61, 0xd0, 0xcd, 0x47, 0xda, 0xcd, 0x07, 0x64, 0x6b, 0xfa, 0xbc, 0xf9, 0x23, 0x73,
+	0x9c, 0xfc, 0xcd, 0x32, 0x4f, 0xf3, 0x91, 0x31, 0x4b, 0x1e, 0x9e, 0xa3, 0xa2, 0xb2, 0xe3, 0xbb,
+	0xf0, 0xe8, 0xfc, 0x00, 0xe8, 0xdc, 0x03, 0xed, 0xdc, 0xd7, 0x48, 0x65, 0x06, 0xe7, 0x78, 0x23,
+	0xf0, 0x79, 0x28, 0xc8, 0x7f, 0x2d, 0xb8, 0x3e, 0x69, 0x74, 0x93, 0xa7, 0xd3, 0x9b, 0x37, 0x79,
+	0x4b, 0x28, 0xd4, 0xde, 0x02, 0x12, 0x7a, 0xbc, 0xad, 0x3d, 0xfe, 0x16, 0x79, 0x70, 0xb6, 0xc7,
+	0x09, 0x1b, 0xfb, 0xff, 0x94, 0x20, 0xbf, 0x98, 0x83, 0x1b, 0x67, 0x8c, 0x4d, 0xf2, 0xdd, 0xd9,
+	0x73, 0x73, 0xea, 0x9c, 0x2e, 0xec, 0xbe, 0x1d, 0x30, 0x0c, 0xc1, 0x8e, 0x0e, 0xc1, 0x23, 0xf2,
+	0xd1, 0x0c, 0x49, 0xc7, 0x9d, 0x3d, 0x3b, 0xa6, 0xb7, 0x7f, 0xf8, 0xd9, 0xab, 0xa2, 0xf5, 0xf9,
+	0xab, 0xa2, 0xf5, 0xaf, 0x57, 0x45, 0xeb, 0xd3, 0xd7, 0xc5, 0x0b, 0x9f, 0xbf, 0x2e, 0x5e, 0xf8,
+	0xfb, 0xeb, 0xe2, 0x85, 0x1f, 0x7d, 0xd8, 0xe4, 0xb2, 0xd5, 0x6b, 0x38, 0x41, 0xdc, 0x71, 0xf1,
+	0x2f, 0xcb, 0xe1, 0x51, 0x77, 0x07, 0x47, 0xf5, 0xef, 0xbb, 0x87, 0xa3, 0xe7, 0xa9, 0x37, 0x89,
+	0x68, 0x2c, 0xe8, 0xa5, 0xfb, 0x83, 0xff, 0x0f, 0x00, 0x6c, 0x8f, 0x6c, 0x22, 0x47, 0x16, 0x00,
+	0x00,
 }
 
-func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryParams queries the ccv/consumer module parameters.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
+	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
+	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QueryProviderClientExpiry returns when the client of the provider chain
+	// expires unless it is updated
+	QueryProviderClientExpiry(ctx context.Context, in *QueryProviderClientExpiryRequest, opts ...grpc.CallOption) (*QueryProviderClientExpiryResponse, error)
+	// QueryProviderIbcIds returns the client id (on the consumer) that is tracking
+	// the provider chain and the CCV channel id (on the consumer), if established
+	QueryProviderIbcIds(ctx context.Context, in *QueryProviderIbcIdsRequest, opts ...grpc.CallOption) (*QueryProviderIbcIdsResponse, error)
+	// QueryRedistributionFractions returns the effective split of the fees
+	// between the consumer redistribution address and the provider chain
+	// for every denom with an override or with a balance in the fee pool
+	QueryRedistributionFractions(ctx context.Context, in *QueryRedistributionFractionsRequest, opts ...grpc.CallOption) (*QueryRedistributionFractionsResponse, error)
+	// QueryProviderValsetVerification returns the last verification of the
+	// consumer validator set against the provider state
+	QueryProviderValsetVerification(ctx context.Context, in *QueryProviderValsetVerificationRequest, opts ...grpc.CallOption) (*QueryProviderValsetVerificationResponse, error)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+type queryClient struct {
+	cc grpc1.ClientConn
 }
-func (m *NextFeeDistributionEstimate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CurrentHeight != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentHeight))
-	}
-	if m.LastHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastHeight))
-	}
-	if m.NextHeight != 0 {
-		n += 1 + sovQuery(uint64(m.NextHeight))
-	}
-	l = len(m.DistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ToProvider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ToConsumer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error) {
+	out := new(QueryNextFeeDistributionEstimateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error) {
+	out := new(QueryProviderInfoResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
+	out := new(QueryThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderClientExpiry(ctx context.Context, in *QueryProviderClientExpiryRequest, opts ...grpc.CallOption) (*QueryProviderClientExpiryResponse, error) {
+	out := new(QueryProviderClientExpiryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderClientExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderIbcIds(ctx context.Context, in *QueryProviderIbcIdsRequest, opts ...grpc.CallOption) (*QueryProviderIbcIdsResponse, error) {
+	out := new(QueryProviderIbcIdsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderIbcIds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryProviderInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryRedistributionFractions(ctx context.Context, in *QueryRedistributionFractionsRequest, opts ...grpc.CallOption) (*QueryRedistributionFractionsResponse, error) {
+	out := new(QueryRedistributionFractionsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryRedistributionFractions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryProviderInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderValsetVerification(ctx context.Context, in *QueryProviderValsetVerificationRequest, opts ...grpc.CallOption) (*QueryProviderValsetVerificationResponse, error) {
+	out := new(QueryProviderValsetVerificationResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderValsetVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Consumer.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryThrottleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryParams queries the ccv/consumer module parameters.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
+	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
+	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QueryProviderClientExpiry returns when the client of the provider chain
+	// expires unless it is updated
+	QueryProviderClientExpiry(context.Context, *QueryProviderClientExpiryRequest) (*QueryProviderClientExpiryResponse, error)
+	// QueryProviderIbcIds returns the client id (on the consumer) that is tracking
+	// the provider chain and the CCV channel id (on the consumer), if established
+	QueryProviderIbcIds(context.Context, *QueryProviderIbcIdsRequest) (*QueryProviderIbcIdsResponse, error)
+	// QueryRedistributionFractions returns the effective split of the fees
+	// between the consumer redistribution address and the provider chain
+	// for every denom with an override or with a balance in the fee pool
+	QueryRedistributionFractions(context.Context, *QueryRedistributionFractionsRequest) (*QueryRedistributionFractionsResponse, error)
+	// QueryProviderValsetVerification returns the last verification of the
+	// consumer validator set against the provider state
+	QueryProviderValsetVerification(context.Context, *QueryProviderValsetVerificationRequest) (*QueryProviderValsetVerificationResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderClientExpiry(ctx context.Context, req *QueryProviderClientExpiryRequest) (*QueryProviderClientExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderClientExpiry not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderIbcIds(ctx context.Context, req *QueryProviderIbcIdsRequest) (*QueryProviderIbcIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderIbcIds not implemented")
+}
+func (*UnimplementedQueryServer) QueryRedistributionFractions(ctx context.Context, req *QueryRedistributionFractionsRequest) (*QueryRedistributionFractionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRedistributionFractions not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderValsetVerification(ctx context.Context, req *QueryProviderValsetVerificationRequest) (*QueryProviderValsetVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderValsetVerification not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextFeeDistributionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNextFeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNextFeeDistribution(ctx, req.(*QueryNextFeeDistributionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryThrottleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.SlashRecord != nil {
-		l = m.SlashRecord.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
 	}
-	if len(m.PacketDataQueue) > 0 {
-		for _, e := range m.PacketDataQueue {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderInfo(ctx, req.(*QueryProviderInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderClientExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderClientExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderClientExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderClientExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderClientExpiry(ctx, req.(*QueryProviderClientExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderIbcIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderIbcIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderIbcIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderIbcIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderIbcIds(ctx, req.(*QueryProviderIbcIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRedistributionFractions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedistributionFractionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRedistributionFractions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryRedistributionFractions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRedistributionFractions(ctx, req.(*QueryRedistributionFractionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderValsetVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderValsetVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderValsetVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderValsetVerification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderValsetVerification(ctx, req.(*QueryProviderValsetVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.consumer.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryNextFeeDistribution",
+			Handler:    _Query_QueryNextFeeDistribution_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryProviderInfo",
+			Handler:    _Query_QueryProviderInfo_Handler,
+		},
+		{
+			MethodName: "QueryThrottleState",
+			Handler:    _Query_QueryThrottleState_Handler,
+		},
+		{
+			MethodName: "QueryProviderClientExpiry",
+			Handler:    _Query_QueryProviderClientExpiry_Handler,
+		},
+		{
+			MethodName: "QueryProviderIbcIds",
+			Handler:    _Query_QueryProviderIbcIds_Handler,
+		},
+		{
+			MethodName: "QueryRedistributionFractions",
+			Handler:    _Query_QueryRedistributionFractions_Handler,
+		},
+		{
+			MethodName: "QueryProviderValsetVerification",
+			Handler:    _Query_QueryProviderValsetVerification_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
+}
+
+func (m *NextFeeDistributionEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextFeeDistributionEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextFeeDistributionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToConsumer) > 0 {
+		i -= len(m.ToConsumer)
+		copy(dAtA[i:], m.ToConsumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToConsumer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ToProvider) > 0 {
+		i -= len(m.ToProvider)
+		copy(dAtA[i:], m.ToProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToProvider)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DistributionFraction) > 0 {
+		i -= len(m.DistributionFraction)
+		copy(dAtA[i:], m.DistributionFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketSendingPermitted {
+		i--
+		if m.PacketSendingPermitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeSinceLastVsc, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeSinceLastVsc):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x42
+	if m.LastVsc != nil {
+		{
+			size, err := m.LastVsc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PendingPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ChannelState) > 0 {
+		i -= len(m.ChannelState)
+		copy(dAtA[i:], m.ChannelState)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelState)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.ClientExpiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ClientLatestHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	if m.NextSendTime != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextSendTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextSendTime):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintQuery(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.BlockedReason) > 0 {
+		i -= len(m.BlockedReason)
+		copy(dAtA[i:], m.BlockedReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockedReason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PacketSendingPermitted {
+		i--
+		if m.PacketSendingPermitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PendingPackets) > 0 {
+		for iNdEx := len(m.PendingPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PacketDataQueue) > 0 {
+		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketDataQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPacketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPacketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPacketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Age != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Age, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Age):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintQuery(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EnqueueTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EnqueueTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EnqueueTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintQuery(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EnqueueHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EnqueueHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Infraction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderClientExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderClientExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderClientExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderClientExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderClientExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderClientExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClientExpiry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderIbcIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderIbcIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderIbcIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderIbcIdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderIbcIdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderIbcIdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedistributionFractionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedistributionFractionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedistributionFractionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRedistributionFractionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRedistributionFractionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRedistributionFractionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DefaultFraction) > 0 {
+		i -= len(m.DefaultFraction)
+		copy(dAtA[i:], m.DefaultFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultFraction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedistributionSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedistributionSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedistributionSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Overridden {
+		i--
+		if m.Overridden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProviderFraction) > 0 {
+		i -= len(m.ProviderFraction)
+		copy(dAtA[i:], m.ProviderFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderFraction)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerFraction) > 0 {
+		i -= len(m.ConsumerFraction)
+		copy(dAtA[i:], m.ConsumerFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerFraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderValsetVerificationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderValsetVerificationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderValsetVerificationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderValsetVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderValsetVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderValsetVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NextFeeDistributionEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextHeight))
+	}
+	l = len(m.DistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToConsumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Consumer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClientLatestHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClientExpiry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ChannelState)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PendingPackets != 0 {
+		n += 1 + sovQuery(uint64(m.PendingPackets))
+	}
+	if m.LastVsc != nil {
+		l = m.LastVsc.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeSinceLastVsc)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PacketSendingPermitted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PacketDataQueue) > 0 {
+		for _, e := range m.PacketDataQueue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for _, e := range m.PendingPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.PacketSendingPermitted {
+		n += 2
+	}
+	l = len(m.BlockedReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NextSendTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextSendTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PendingPacketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovQuery(uint64(m.Infraction))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovQuery(uint64(m.ValsetUpdateId))
+	}
+	if m.EnqueueHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EnqueueHeight))
+	}
+	if m.EnqueueTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EnqueueTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Age != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Age)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProviderClientExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderClientExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClientExpiry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderIbcIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderIbcIdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRedistributionFractionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRedistributionFractionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DefaultFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RedistributionSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Overridden {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryProviderValsetVerificationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderValsetVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Verification.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextFeeDistributionEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextFeeDistributionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToConsumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToConsumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &NextFeeDistributionEstimate{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientLatestHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientLatestHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClientExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelState", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelState = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			m.PendingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastVsc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastVsc == nil {
+				m.LastVsc = &LastVSC{}
+			}
+			if err := m.LastVsc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeSinceLastVsc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeSinceLastVsc, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSendingPermitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketSendingPermitted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketDataQueue = append(m.PacketDataQueue, types.ConsumerPacketData{})
+			if err := m.PacketDataQueue[len(m.PacketDataQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPackets = append(m.PendingPackets, PendingPacketInfo{})
+			if err := m.PendingPackets[len(m.PendingPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSendingPermitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PacketSendingPermitted = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSendTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextSendTime == nil {
+				m.NextSendTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextSendTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDelayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RetryDelayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
+func (m *PendingPacketInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,17 +4181,17 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextFeeDistributionEstimate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingPacketInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextFeeDistributionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingPacketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			m.CurrentHeight = 0
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1389,16 +4201,16 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentHeight |= int64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.LastHeight = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1408,16 +4220,16 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
+				m.Type |= types.ConsumerPacketDataType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			m.NextHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1427,16 +4239,29 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFraction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
 			}
-			var stringLen uint64
+			m.Infraction = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1446,29 +4271,54 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Infraction |= types2.Infraction(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueHeight", wireType)
 			}
-			m.DistributionFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.EnqueueHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnqueueHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EnqueueTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1478,29 +4328,33 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = string(dAtA[iNdEx:postIndex])
+			if m.EnqueueTime == nil {
+				m.EnqueueTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EnqueueTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Age", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1510,29 +4364,133 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToProvider = string(dAtA[iNdEx:postIndex])
+			if m.Age == nil {
+				m.Age = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Age, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderClientExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderClientExpiryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToConsumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1542,23 +4500,24 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToConsumer = string(dAtA[iNdEx:postIndex])
+			if err := m.ClientExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1581,7 +4540,7 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderIbcIdsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,10 +4563,10 @@ func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderIbcIdsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderIbcIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1631,7 +4590,7 @@ func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderIbcIdsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1654,17 +4613,17 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderIbcIdsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderIbcIdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1674,27 +4633,55 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &NextFeeDistributionEstimate{}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1717,7 +4704,7 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRedistributionFractionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1740,10 +4727,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedistributionFractionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedistributionFractionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1767,7 +4754,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRedistributionFractionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,15 +4777,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRedistributionFractionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRedistributionFractionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1825,7 +4844,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Splits = append(m.Splits, RedistributionSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1850,7 +4870,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *RedistributionSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,67 +4893,49 @@ func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedistributionSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedistributionSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1943,30 +4945,29 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1976,25 +4977,44 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overridden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overridden = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2016,7 +5036,7 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderValsetVerificationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2039,10 +5059,10 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderValsetVerificationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderValsetVerificationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2066,7 +5086,7 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderValsetVerificationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,51 +5109,15 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderValsetVerificationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderValsetVerificationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SlashRecord == nil {
-				m.SlashRecord = &SlashRecord{}
-			}
-			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,8 +5144,7 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketDataQueue = append(m.PacketDataQueue, types.ConsumerPacketData{})
-			if err := m.PacketDataQueue[len(m.PacketDataQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
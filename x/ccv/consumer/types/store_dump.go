@@ -0,0 +1,47 @@
+package types
+
+import (
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// StorePrefixDecoders returns the decoders of all the consumer key prefixes,
+// used to dump the consumer store in a human-readable form.
+// Deprecated or unused prefixes only have a name, i.e., their values are hex encoded.
+func StorePrefixDecoders() map[byte]ccvtypes.StorePrefixDecoder {
+	decoders := map[string]ccvtypes.StorePrefixDecoder{
+		PortKeyName:                         {Value: ccvtypes.StringStoreValue},
+		LastDistributionTransmissionKeyName: {Value: ccvtypes.ProtoStoreValue[LastTransmissionBlockHeight]()},
+		ProviderClientIDKeyName:             {Value: ccvtypes.StringStoreValue},
+		ProviderChannelIDKeyName:            {Value: ccvtypes.StringStoreValue},
+		PendingChangesKeyName:               {Value: ccvtypes.ProtoStoreValue[ccvtypes.ValidatorSetChangePacketData]()},
+		PreCCVKeyName:                       {Value: ccvtypes.Uint64StoreValue},
+		InitialValSetKeyName:                {Value: ccvtypes.ProtoStoreValue[GenesisState]()},
+		HistoricalInfoKeyName:               {Value: ccvtypes.ProtoStoreValue[stakingtypes.HistoricalInfo]()},
+		HeightValsetUpdateIDKeyName:         {Value: ccvtypes.Uint64StoreValue},
+		OutstandingDowntimeKeyName:          {Value: ccvtypes.EmptyStoreValue},
+		PendingDataPacketsV1KeyName:         {Value: ccvtypes.ProtoStoreValue[ccvtypes.ConsumerPacketData]()},
+		CrossChainValidatorKeyName:          {Value: ccvtypes.ProtoStoreValue[CrossChainValidator]()},
+		InitGenesisHeightKeyName:            {Value: ccvtypes.Uint64StoreValue},
+		PrevStandaloneChainKeyName:          {Value: ccvtypes.EmptyStoreValue},
+		PendingPacketsIndexKeyName:          {Value: ccvtypes.Uint64StoreValue},
+		SlashRecordKeyName:                  {Value: ccvtypes.ProtoStoreValue[SlashRecord]()},
+		ParametersKeyName:                   {Value: ccvtypes.ProtoStoreValue[ccvtypes.ConsumerParams]()},
+		ProviderFeatureKeyName:              {Value: ccvtypes.EmptyStoreValue},
+		ProviderClientExpirySeverityKeyName: {Value: ccvtypes.Uint64StoreValue},
+		LastVSCKeyName:                      {Value: ccvtypes.ProtoStoreValue[LastVSC]()},
+		UpgradePlanKeyName:                  {Value: ccvtypes.ProtoStoreValue[ccvtypes.ConsumerUpgradePlan]()},
+		ProviderValsetVerificationKeyName:   {Value: ccvtypes.ProtoStoreValue[ProviderValsetVerification]()},
+		PendingPacketEnqueueRecordKeyName:   {Value: ccvtypes.ProtoStoreValue[PendingPacketEnqueueRecord]()},
+		ProviderFeePoolAddrKeyName:          {Value: ccvtypes.StringStoreValue},
+	}
+
+	prefixDecoders := make(map[byte]ccvtypes.StorePrefixDecoder, len(getKeyPrefixes()))
+	for name, prefix := range getKeyPrefixes() {
+		decoder := decoders[name]
+		decoder.Name = name
+		prefixDecoders[prefix] = decoder
+	}
+	return prefixDecoders
+}
@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types1 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -124,9 +125,140 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSubmitProviderValsetProof submits the latest valset checkpoint stored on the
+// provider chain for the consumer chain, together with a proof of it against
+// the consensus state of the provider client. The consumer validator set is
+// compared with the checkpoint, but it is not updated.
+type MsgSubmitProviderValsetProof struct {
+	// the address of the account submitting the proof
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// the height of the provider chain at which the checkpoint is proven
+	ProofHeight types1.Height `protobuf:"bytes,2,opt,name=proof_height,json=proofHeight,proto3" json:"proof_height"`
+	// the valset update ID and the valset hash of the provider checkpoint
+	ValsetUpdateId uint64 `protobuf:"varint,3,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	ValsetHash     []byte `protobuf:"bytes,4,opt,name=valset_hash,json=valsetHash,proto3" json:"valset_hash,omitempty"`
+	// the merkle proof of the checkpoint in the provider store
+	Proof []byte `protobuf:"bytes,5,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgSubmitProviderValsetProof) Reset()         { *m = MsgSubmitProviderValsetProof{} }
+func (m *MsgSubmitProviderValsetProof) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProviderValsetProof) ProtoMessage()    {}
+func (*MsgSubmitProviderValsetProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{2}
+}
+func (m *MsgSubmitProviderValsetProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProviderValsetProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProviderValsetProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitProviderValsetProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProviderValsetProof.Merge(m, src)
+}
+func (m *MsgSubmitProviderValsetProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProviderValsetProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProviderValsetProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitProviderValsetProof proto.InternalMessageInfo
+
+func (m *MsgSubmitProviderValsetProof) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgSubmitProviderValsetProof) GetProofHeight() types1.Height {
+	if m != nil {
+		return m.ProofHeight
+	}
+	return types1.Height{}
+}
+
+func (m *MsgSubmitProviderValsetProof) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *MsgSubmitProviderValsetProof) GetValsetHash() []byte {
+	if m != nil {
+		return m.ValsetHash
+	}
+	return nil
+}
+
+func (m *MsgSubmitProviderValsetProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type MsgSubmitProviderValsetProofResponse struct {
+	// whether the consumer valset hash matches the provider valset hash
+	Match bool `protobuf:"varint,1,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (m *MsgSubmitProviderValsetProofResponse) Reset()         { *m = MsgSubmitProviderValsetProofResponse{} }
+func (m *MsgSubmitProviderValsetProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProviderValsetProofResponse) ProtoMessage()    {}
+func (*MsgSubmitProviderValsetProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{3}
+}
+func (m *MsgSubmitProviderValsetProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProviderValsetProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProviderValsetProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitProviderValsetProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProviderValsetProofResponse.Merge(m, src)
+}
+func (m *MsgSubmitProviderValsetProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProviderValsetProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProviderValsetProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitProviderValsetProofResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitProviderValsetProofResponse) GetMatch() bool {
+	if m != nil {
+		return m.Match
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSubmitProviderValsetProof)(nil), "interchain_security.ccv.consumer.v1.MsgSubmitProviderValsetProof")
+	proto.RegisterType((*MsgSubmitProviderValsetProofResponse)(nil), "interchain_security.ccv.consumer.v1.MsgSubmitProviderValsetProofResponse")
 }
 
 func init() {
@@ -134,33 +266,44 @@ func init() {
 }
 
 var fileDescriptor_9d7049279494b73f = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0xcc, 0x2b, 0x49,
-	0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x2f, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9, 0xd4,
-	0x4f, 0x4e, 0x2e, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x2e, 0xcd, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0xc6, 0xa2, 0x5a, 0x2f, 0x39, 0xb9,
-	0x4c, 0x0f, 0xa6, 0x5a, 0xaf, 0xcc, 0x50, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c,
-	0x42, 0xf4, 0x49, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x65, 0x45, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f,
-	0x91, 0x80, 0x70, 0xa0, 0x52, 0xe2, 0x10, 0x9e, 0x7e, 0x6e, 0x71, 0x3a, 0xc8, 0x79, 0xb9, 0xc5,
-	0xe9, 0x50, 0x09, 0x03, 0x5c, 0xbe, 0x29, 0x33, 0xd4, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0x89,
-	0x87, 0xbb, 0x14, 0xac, 0x43, 0x69, 0x31, 0x23, 0x17, 0xbf, 0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a,
-	0x62, 0x49, 0x6a, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49,
-	0x46, 0x3e, 0x48, 0xb7, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22,
-	0x50, 0x37, 0x38, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07,
-	0x21, 0x94, 0x0a, 0x79, 0x70, 0xb1, 0x15, 0x80, 0x4d, 0x90, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36,
-	0xd2, 0xd2, 0xc3, 0x15, 0x5c, 0x65, 0x86, 0x7a, 0xce, 0x50, 0x77, 0x40, 0xec, 0x74, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xdf, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0xc9, 0x4a,
-	0x92, 0x5c, 0xe2, 0x68, 0x8e, 0x0c, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x9a, 0xc8,
-	0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0xd4, 0xc4, 0xc8, 0xc5, 0x83, 0xe2, 0x0b, 0x13, 0x3d, 0x22,
-	0x22, 0x4b, 0x0f, 0xcd, 0x58, 0x29, 0x1b, 0x72, 0x74, 0xc1, 0x1c, 0x23, 0xc5, 0xda, 0xf0, 0x7c,
-	0x83, 0x16, 0xa3, 0x53, 0xf8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xd9,
-	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0xa3, 0x54, 0x1f, 0x61, 0x9f,
-	0x2e, 0x3c, 0xca, 0xca, 0xcc, 0xf5, 0x2b, 0x50, 0x53, 0x61, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0x38, 0xd2, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x75, 0x15, 0xe9, 0xb6, 0x02,
-	0x00, 0x00,
+	// 585 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x4f, 0x14, 0x31,
+	0x14, 0xde, 0xf2, 0x2b, 0x52, 0x08, 0xea, 0x84, 0x84, 0x65, 0x43, 0x86, 0xcd, 0xea, 0x61, 0x43,
+	0xa4, 0x75, 0xd1, 0x68, 0x62, 0xf0, 0x00, 0x5c, 0xe0, 0x40, 0x42, 0x86, 0xa8, 0x89, 0x97, 0x49,
+	0xb7, 0x53, 0x3b, 0x4d, 0x98, 0xe9, 0xa4, 0xed, 0x4c, 0xe0, 0x66, 0x38, 0x7b, 0xf0, 0x7f, 0xf0,
+	0xe4, 0x8d, 0x83, 0x57, 0xef, 0x1c, 0x89, 0x27, 0x4f, 0xc6, 0xc0, 0x81, 0x7f, 0xc3, 0x4c, 0x5b,
+	0x76, 0x03, 0x71, 0x95, 0xe8, 0x65, 0xd3, 0xf7, 0xde, 0xf7, 0xbe, 0x7e, 0xef, 0xeb, 0xbe, 0x81,
+	0x8f, 0x44, 0x6e, 0x98, 0xa2, 0x29, 0x11, 0x79, 0xac, 0x19, 0x2d, 0x95, 0x30, 0x47, 0x98, 0xd2,
+	0x0a, 0x53, 0x99, 0xeb, 0x32, 0x63, 0x0a, 0x57, 0x3d, 0x6c, 0x0e, 0x51, 0xa1, 0xa4, 0x91, 0xc1,
+	0x83, 0xdf, 0xa0, 0x11, 0xa5, 0x15, 0xba, 0x42, 0xa3, 0xaa, 0xd7, 0xba, 0x4f, 0x32, 0x91, 0x4b,
+	0x6c, 0x7f, 0x5d, 0x5f, 0x6b, 0x89, 0x4b, 0xc9, 0x0f, 0x18, 0x26, 0x85, 0xc0, 0x24, 0xcf, 0xa5,
+	0x21, 0x46, 0xc8, 0x5c, 0xfb, 0xea, 0x3c, 0x97, 0x5c, 0xda, 0x23, 0xae, 0x4f, 0x3e, 0xbb, 0x48,
+	0xa5, 0xce, 0xa4, 0x8e, 0x5d, 0xc1, 0x05, 0xbe, 0xb4, 0xe0, 0x22, 0x9c, 0x69, 0x5e, 0xcb, 0xcb,
+	0x34, 0xf7, 0x85, 0xc7, 0xa3, 0xa6, 0xa9, 0x7a, 0x58, 0xa7, 0x44, 0xb1, 0x24, 0x1e, 0x28, 0x75,
+	0x1d, 0xcb, 0xa2, 0x4f, 0x31, 0x95, 0x8a, 0x61, 0x7a, 0x20, 0x58, 0x6e, 0x6a, 0xa4, 0x3b, 0x39,
+	0x40, 0xe7, 0x13, 0x80, 0x77, 0x77, 0x35, 0x7f, 0x55, 0x24, 0xc4, 0xb0, 0x3d, 0xa2, 0x48, 0xa6,
+	0x83, 0x67, 0x70, 0x9a, 0x94, 0x26, 0x95, 0x35, 0x7d, 0x13, 0xb4, 0x41, 0x77, 0x7a, 0xb3, 0xf9,
+	0xed, 0xcb, 0xea, 0xbc, 0x17, 0xb9, 0x91, 0x24, 0x8a, 0x69, 0xbd, 0x6f, 0x94, 0xc8, 0x79, 0x34,
+	0x84, 0x06, 0xdb, 0x70, 0xaa, 0xb0, 0x0c, 0xcd, 0xb1, 0x36, 0xe8, 0xce, 0xac, 0xad, 0xa0, 0x51,
+	0x7e, 0x56, 0x3d, 0xb4, 0xe5, 0x85, 0xba, 0x3b, 0x37, 0x27, 0x4e, 0x7f, 0x2c, 0x37, 0x22, 0xdf,
+	0xff, 0x62, 0xee, 0xf8, 0xf2, 0x64, 0x65, 0xc8, 0xdc, 0x59, 0x84, 0x0b, 0x37, 0x44, 0x46, 0x4c,
+	0x17, 0x32, 0xd7, 0xac, 0xf3, 0x61, 0x0c, 0x2e, 0xed, 0x6a, 0xbe, 0x5f, 0xf6, 0x33, 0x61, 0xf6,
+	0x94, 0xac, 0x44, 0xc2, 0xd4, 0x6b, 0x72, 0xa0, 0x59, 0x1d, 0xc9, 0x77, 0xf5, 0x34, 0xda, 0x16,
+	0x0d, 0x53, 0x7f, 0x9f, 0x66, 0x00, 0x0d, 0xb6, 0xe0, 0x6c, 0x51, 0x13, 0xc4, 0x29, 0x13, 0x3c,
+	0x35, 0x7e, 0xa6, 0x16, 0x12, 0x7d, 0x8a, 0x6a, 0x47, 0x91, 0xf7, 0xb1, 0xea, 0xa1, 0x6d, 0x8b,
+	0xf0, 0x33, 0xcc, 0xd8, 0x2e, 0x97, 0x0a, 0xba, 0xf0, 0x5e, 0x65, 0xb5, 0xc4, 0xa5, 0x15, 0x1f,
+	0x8b, 0xa4, 0x39, 0xde, 0x06, 0xdd, 0x89, 0x68, 0xce, 0xe5, 0xdd, 0x4c, 0x3b, 0x49, 0xb0, 0x0c,
+	0x67, 0x3c, 0x32, 0x25, 0x3a, 0x6d, 0x4e, 0xb4, 0x41, 0x77, 0x36, 0x82, 0x2e, 0xb5, 0x4d, 0x74,
+	0x1a, 0xcc, 0xc3, 0x49, 0xcb, 0xdc, 0x9c, 0xb4, 0x25, 0x17, 0x78, 0xa7, 0x06, 0xaa, 0x3b, 0xeb,
+	0xf0, 0xe1, 0x9f, 0xdc, 0xb8, 0xb2, 0xad, 0x66, 0xcb, 0x88, 0xa1, 0xa9, 0x75, 0xe4, 0x4e, 0xe4,
+	0x82, 0xb5, 0xaf, 0x63, 0x70, 0x7c, 0x57, 0xf3, 0xe0, 0x18, 0xc0, 0xd9, 0x6b, 0x7f, 0x89, 0xa7,
+	0xe8, 0x16, 0xab, 0x81, 0x6e, 0xbc, 0x51, 0x6b, 0xfd, 0x5f, 0xba, 0x06, 0x12, 0x3f, 0x03, 0xb8,
+	0x38, 0xfa, 0x59, 0x37, 0x6e, 0xcb, 0x3d, 0x92, 0xa2, 0xb5, 0xf3, 0xdf, 0x14, 0x57, 0x5a, 0x5b,
+	0x93, 0xef, 0x2f, 0x4f, 0x56, 0xc0, 0xe6, 0x9b, 0xd3, 0xf3, 0x10, 0x9c, 0x9d, 0x87, 0xe0, 0xe7,
+	0x79, 0x08, 0x3e, 0x5e, 0x84, 0x8d, 0xb3, 0x8b, 0xb0, 0xf1, 0xfd, 0x22, 0x6c, 0xbc, 0x7d, 0xc9,
+	0x85, 0x49, 0xcb, 0x3e, 0xa2, 0x32, 0xf3, 0xcb, 0x8e, 0x87, 0x97, 0xaf, 0x0e, 0x96, 0xb9, 0x7a,
+	0x8e, 0x0f, 0xaf, 0x7f, 0x9f, 0xcc, 0x51, 0xc1, 0x74, 0x7f, 0xca, 0x6e, 0xeb, 0x93, 0x5f, 0x03,
+	0x00, 0x2d, 0x98, 0x93, 0xeb, 0xd0, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,6 +319,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SubmitProviderValsetProof(ctx context.Context, in *MsgSubmitProviderValsetProof, opts ...grpc.CallOption) (*MsgSubmitProviderValsetProofResponse, error)
 }
 
 type msgClient struct {
@@ -195,9 +339,19 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SubmitProviderValsetProof(ctx context.Context, in *MsgSubmitProviderValsetProof, opts ...grpc.CallOption) (*MsgSubmitProviderValsetProofResponse, error) {
+	out := new(MsgSubmitProviderValsetProofResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/SubmitProviderValsetProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SubmitProviderValsetProof(context.Context, *MsgSubmitProviderValsetProof) (*MsgSubmitProviderValsetProofResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -207,6 +361,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SubmitProviderValsetProof(ctx context.Context, req *MsgSubmitProviderValsetProof) (*MsgSubmitProviderValsetProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProviderValsetProof not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -230,6 +387,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitProviderValsetProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProviderValsetProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProviderValsetProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/SubmitProviderValsetProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProviderValsetProof(ctx, req.(*MsgSubmitProviderValsetProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "interchain_security.ccv.consumer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -238,6 +413,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SubmitProviderValsetProof",
+			Handler:    _Msg_SubmitProviderValsetProof_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "interchain_security/ccv/consumer/v1/tx.proto",
@@ -306,6 +485,98 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitProviderValsetProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProviderValsetProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProviderValsetProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValsetHash) > 0 {
+		i -= len(m.ValsetHash)
+		copy(dAtA[i:], m.ValsetHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValsetHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.ProofHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProviderValsetProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProviderValsetProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProviderValsetProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Match {
+		i--
+		if m.Match {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -341,6 +612,44 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSubmitProviderValsetProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovTx(uint64(m.ValsetUpdateId))
+	}
+	l = len(m.ValsetHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProviderValsetProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Match {
+		n += 2
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -512,6 +821,278 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitProviderValsetProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProviderValsetProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProviderValsetProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetHash = append(m.ValsetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValsetHash == nil {
+				m.ValsetHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitProviderValsetProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitProviderValsetProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitProviderValsetProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Match", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Match = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// FlagDumpHeight is the flag for the height at which the store is dumped
+const FlagDumpHeight = "height"
+
+// NewStoreDumpCmd returns a debug command that prints the decoded entries of the provider store
+func NewStoreDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ccv-dump [prefix]",
+		Short: "Dump the decoded entries of the provider module store",
+		Long: fmt.Sprintf(`Iterate the provider module store of the application database and print one JSON object
+per entry, containing the name of the key prefix, the consumer id (if any), the hex encoded key and the decoded value.
+The optional prefix is either the name of a key prefix (e.g., %s) or a hex encoded key prefix.
+The node must be stopped, as the application database cannot be opened concurrently.`, types.ConsumerIdToPhaseKeyName),
+		Example: fmt.Sprintf("interchain-security-pd debug ccv-dump %s --height 100", types.ConsumerIdToPhaseKeyName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			decoders := types.StorePrefixDecoders()
+
+			var prefix []byte
+			if len(args) > 0 {
+				var err error
+				if prefix, err = ccvtypes.ParseStorePrefix(args[0], decoders); err != nil {
+					return err
+				}
+			}
+
+			height, err := cmd.Flags().GetInt64(FlagDumpHeight)
+			if err != nil {
+				return err
+			}
+
+			dataDir := filepath.Join(serverCtx.Config.RootDir, "data")
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			return ccvtypes.DumpStore(cmd.OutOrStdout(), db, types.StoreKey, height, prefix, clientCtx.Codec, decoders)
+		},
+	}
+
+	cmd.Flags().Int64(FlagDumpHeight, 0, "The height at which to dump the store; defaults to the latest height")
+
+	return cmd
+}
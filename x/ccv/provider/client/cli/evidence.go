@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// consumerEvidence is the evidence of an infraction committed on a consumer chain
+// as dumped by the evidence mode of hermes
+type consumerEvidence struct {
+	// the chain id of the consumer chain the evidence was detected on
+	ChainId string `json:"chain_id"`
+	// the duplicate vote evidence in the CometBFT JSON encoding,
+	// i.e., as returned by the RPC endpoints of the consumer chain
+	DuplicateVoteEvidence json.RawMessage `json:"duplicate_vote_evidence,omitempty"`
+	// the IBC header of the consumer chain at the infraction height in the proto JSON encoding,
+	// needed to verify the duplicate vote evidence
+	InfractionHeader json.RawMessage `json:"infraction_header,omitempty"`
+	// the IBC misbehaviour of the consumer chain in the proto JSON encoding
+	Misbehaviour json.RawMessage `json:"misbehaviour,omitempty"`
+}
+
+// parseConsumerEvidence parses a consumer evidence file and returns either
+// a MsgSubmitConsumerDoubleVoting or a MsgSubmitConsumerMisbehaviour
+func parseConsumerEvidence(cdc codec.Codec, bz []byte, consumerId string, submitter sdk.AccAddress) (string, sdk.Msg, error) {
+	var evidence consumerEvidence
+	if err := json.Unmarshal(bz, &evidence); err != nil {
+		return "", nil, fmt.Errorf("evidence unmarshalling failed: %w", err)
+	}
+
+	switch {
+	case len(evidence.DuplicateVoteEvidence) > 0 && len(evidence.Misbehaviour) > 0:
+		return "", nil, fmt.Errorf("evidence cannot contain both a duplicate vote evidence and a misbehaviour")
+
+	case len(evidence.DuplicateVoteEvidence) > 0:
+		// the CometBFT JSON encoding is used for the evidence, which
+		// encodes the validator addresses as hex and wraps the evidence with its type
+		var ev tmtypes.Evidence
+		if err := cmtjson.Unmarshal(evidence.DuplicateVoteEvidence, &ev); err != nil {
+			return "", nil, fmt.Errorf("duplicate vote evidence unmarshalling failed: %w", err)
+		}
+		dve, ok := ev.(*tmtypes.DuplicateVoteEvidence)
+		if !ok {
+			return "", nil, fmt.Errorf("expected duplicate vote evidence, got %T", ev)
+		}
+
+		if len(evidence.InfractionHeader) == 0 {
+			return "", nil, fmt.Errorf("duplicate vote evidence without infraction header")
+		}
+		header := ibctmtypes.Header{}
+		if err := cdc.UnmarshalJSON(evidence.InfractionHeader, &header); err != nil {
+			return "", nil, fmt.Errorf("infraction IBC header unmarshalling failed: %w", err)
+		}
+
+		msg, err := types.NewMsgSubmitConsumerDoubleVoting(consumerId, submitter, dve.ToProto(), &header)
+		if err != nil {
+			return "", nil, err
+		}
+		return evidence.ChainId, msg, nil
+
+	case len(evidence.Misbehaviour) > 0:
+		misbehaviour := ibctmtypes.Misbehaviour{}
+		if err := cdc.UnmarshalJSON(evidence.Misbehaviour, &misbehaviour); err != nil {
+			return "", nil, fmt.Errorf("misbehaviour unmarshalling failed: %w", err)
+		}
+
+		msg, err := types.NewMsgSubmitConsumerMisbehaviour(consumerId, submitter, &misbehaviour)
+		if err != nil {
+			return "", nil, err
+		}
+		return evidence.ChainId, msg, nil
+
+	default:
+		return "", nil, fmt.Errorf("evidence contains neither a duplicate vote evidence nor a misbehaviour")
+	}
+}
@@ -1,7 +1,9 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -41,6 +43,31 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdConsumerIdFromClientId())
 	cmd.AddCommand(CmdConsumerChain())
 	cmd.AddCommand(CmdConsumerGenesisTime())
+	cmd.AddCommand(CmdConsumerVscConfirmations())
+	cmd.AddCommand(CmdRecentPacketErrors())
+	cmd.AddCommand(CmdConsumerRelayerLiveness())
+	cmd.AddCommand(CmdConsumerClientExpiry())
+	cmd.AddCommand(CmdValidatorConsumerStatus())
+	cmd.AddCommand(CmdConsumerValidatorsAtVSC())
+	cmd.AddCommand(CmdSlashPacketTrace())
+	cmd.AddCommand(CmdNextEpoch())
+	cmd.AddCommand(CmdConsumerIdFromChannelId())
+	cmd.AddCommand(CmdConsumerIbcIds())
+	cmd.AddCommand(CmdUpcomingConsumerLaunches())
+	cmd.AddCommand(CmdConsumerUpdateHistory())
+	cmd.AddCommand(CmdSimulateLaunch())
+	cmd.AddCommand(CmdPowerShapingTemplates())
+	cmd.AddCommand(CmdPowerShapingTemplate())
+	cmd.AddCommand(CmdConsumerUpgradePlan())
+	cmd.AddCommand(CmdConsumerProjectedDropOffs())
+	cmd.AddCommand(CmdVSCHistory())
+	cmd.AddCommand(CmdOldestValsetUpdateHeight())
+	cmd.AddCommand(CmdValidatorConsumerChains())
+	cmd.AddCommand(CmdKeyAssignmentReplacements())
+	cmd.AddCommand(CmdConsumerKeyUsage())
+	cmd.AddCommand(CmdValidatorTopNObligations())
+	cmd.AddCommand(CmdPacketCommitmentReconciliation())
+	cmd.AddCommand(CmdConsumerRewardDenomsMetadata())
 	return cmd
 }
 
@@ -301,7 +328,17 @@ func CmdAllPairsValConsAddrByConsumer() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := types.QueryAllPairsValConsAddrByConsumerRequest{ConsumerId: args[0]}
+			fs, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(fs)
+			if err != nil {
+				return err
+			}
+
+			req := types.QueryAllPairsValConsAddrByConsumerRequest{ConsumerId: args[0], Pagination: pageReq}
 			res, err := queryClient.QueryAllPairsValConsAddrByConsumer(cmd.Context(), &req)
 			if err != nil {
 				return err
@@ -312,6 +349,7 @@ func CmdAllPairsValConsAddrByConsumer() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pairs of valconsensus addresses")
 
 	return cmd
 }
@@ -369,8 +407,18 @@ $ %s consumer-opted-in-validators 3
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			fs, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(fs)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.QueryConsumerChainOptedInValidators(cmd.Context(),
-				&types.QueryConsumerChainOptedInValidatorsRequest{ConsumerId: args[0]})
+				&types.QueryConsumerChainOptedInValidatorsRequest{ConsumerId: args[0], Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -380,6 +428,7 @@ $ %s consumer-opted-in-validators 3
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "opted-in validators")
 
 	return cmd
 }
@@ -404,8 +453,18 @@ $ %s consumer-validators 3
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			fs, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(fs)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.QueryConsumerValidators(cmd.Context(),
-				&types.QueryConsumerValidatorsRequest{ConsumerId: args[0]})
+				&types.QueryConsumerValidatorsRequest{ConsumerId: args[0], Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -415,6 +474,7 @@ $ %s consumer-validators 3
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer validators")
 
 	return cmd
 }
@@ -612,3 +672,937 @@ func CmdConsumerGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+func CmdConsumerVscConfirmations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-vsc-confirmations [consumer-id]",
+		Short: "Query the unconfirmed and mismatched VSC packets of the consumer chain associated with the consumer id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerVscConfirmationsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerVscConfirmations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRecentPacketErrors() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent-packet-errors [consumer-id]",
+		Short: "Query the most recent packets received from consumer chains that resulted in error acknowledgements",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the most recent packets received from consumer chains that resulted in error acknowledgements, newest first.
+If a consumer id is provided, only the packet errors of the associated consumer chain are returned.
+Example:
+$ %s query provider recent-packet-errors
+$ %s query provider recent-packet-errors 0
+`, version.AppName, version.AppName),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRecentPacketErrorsRequest{}
+			if len(args) == 1 {
+				req.ConsumerId = args[0]
+			}
+			res, err := queryClient.QueryRecentPacketErrors(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRelayerLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-relayer-liveness [consumer-id]",
+		Short: "Query the last packet received from and the last packet acknowledged by the consumer chain associated with the consumer id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerRelayerLivenessRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerRelayerLiveness(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-expiry [consumer-id]",
+		Short: "Query when the client of the consumer chain associated with the consumer id expires",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientExpiryRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerClientExpiry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorConsumerStatus() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-consumer-status [validator-address]",
+		Short: "Query the status of a validator on every consumer chain it is assigned to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, for every active consumer chain the validator opted in to or is in the validator set of,
+whether the validator opted in to it, whether the validator has to validate it, the consumer key, the consumer commission rate, whether the validator is in the consumer validator set,
+and whether a downtime infraction is outstanding.
+Example:
+$ %s query provider validator-consumer-status %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixValAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorConsumerStatusRequest{ValidatorAddress: addr.String()}
+			res, err := queryClient.QueryValidatorConsumerStatus(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerValidatorsAtVSC() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-validators-at-vsc [consumer-id] [vsc-id]",
+		Short: "Query the validator set used by a consumer chain at a given VSC id",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator set that the provider sent to the consumer chain associated with the consumer id
+and that the consumer chain used at the given VSC id, e.g., the VSC id of a slash packet.
+VSC id 0 refers to the initial validator set. Only the most recent validator sets are retained.
+Example:
+$ %s query provider consumer-validators-at-vsc 3 120
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			vscId, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("vsc-id %s is not a valid VSC id: %w", args[1], err)
+			}
+
+			req := &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: args[0], VscId: vscId}
+			res, err := queryClient.QueryConsumerValidatorsAtVSC(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSlashPacketTrace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-packet-trace [consumer-id] [sequence]",
+		Short: "Query how a slash packet received from a consumer chain was handled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query how the slash packet with the given sequence received from the consumer chain
+associated with the consumer id was handled, i.e., whether it was handled, bounced, dropped or only logged,
+as well as the other attempts of the consumer chain to send the same slash packet.
+Only the most recent slash packets are retained.
+Example:
+$ %s query provider slash-packet-trace 3 42
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("sequence %s is not a valid packet sequence: %w", args[1], err)
+			}
+
+			req := &types.QuerySlashPacketTraceRequest{ConsumerId: args[0], Sequence: sequence}
+			res, err := queryClient.QuerySlashPacketTrace(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdNextEpoch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-epoch",
+		Short: "Query the blocks and the estimated time until the next epoch and the VSC packets to be sent to consumer chains",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryNextEpochRequest{}
+			res, err := queryClient.QueryNextEpoch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerIdFromChannelId() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-id-from-channel-id [channel-id]",
+		Short: "Query the consumer id and the client id of the chain associated with the provided CCV channel id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerIdFromChannelIdRequest{ChannelId: args[0]}
+			res, err := queryClient.QueryConsumerIdFromChannelId(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerIbcIds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-ibc-ids [consumer-id]",
+		Short: "Query the client id and the CCV channel id of the chain associated with the consumer id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerIbcIdsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerIbcIds(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpcomingConsumerLaunches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upcoming-consumer-launches",
+		Short: "Query the consumer chains not yet launched ordered by spawn time and whether they are ready to launch",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryUpcomingConsumerLaunchesRequest{}
+			res, err := queryClient.QueryUpcomingConsumerLaunches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerUpdateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-update-history [consumer-id]",
+		Short: "Query the updates applied to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the updates applied with MsgUpdateConsumer to the consumer chain associated with the consumer id,
+i.e., the submitter, the height and the chain id, owner address, metadata, initialization and power-shaping
+parameters of the consumer chain before and after every update. Only the most recent updates are retained.
+Example:
+$ %s query provider consumer-update-history 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerUpdateHistoryRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerUpdateHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const FlagConsumerId = "consumer-id"
+
+func CmdSimulateLaunch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-launch [consumer-parameters]",
+		Short: "Simulate the launch of a consumer chain against the current provider state",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate the launch of a consumer chain with the given parameters against the current provider state,
+i.e., validate the parameters and compute the initial validator set as done when the consumer chain launches.
+The output contains the initial validator set with the consumer powers, the validators in the top N
+that have not assigned a consumer key and, if the consumer chain could not launch, the reason.
+
+The consumer parameters are read from a JSON file with the same structure as for the create-consumer command;
+only the chain id, the initialization and the power-shaping parameters are used.
+With the --%[2]s flag, the launch of an existing registered or initialized consumer chain is simulated,
+using its opted-in validators and assigned consumer keys; the parameters not set in the file are left unchanged.
+
+Example:
+$ %[1]s query provider simulate-launch [path/to/consumer.json]
+$ %[1]s query provider simulate-launch [path/to/consumer.json] --%[2]s 3
+`,
+				version.AppName, FlagConsumerId,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			consumerJson, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			consumer := types.MsgCreateConsumer{}
+			if err = json.Unmarshal(consumerJson, &consumer); err != nil {
+				return fmt.Errorf("consumer data unmarshalling failed: %w", err)
+			}
+
+			consumerId, _ := cmd.Flags().GetString(FlagConsumerId)
+			req := &types.QuerySimulateConsumerLaunchRequest{
+				ConsumerId:               consumerId,
+				ChainId:                  consumer.ChainId,
+				InitializationParameters: consumer.InitializationParameters,
+				PowerShapingParameters:   consumer.PowerShapingParameters,
+			}
+			res, err := queryClient.QuerySimulateConsumerLaunch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagConsumerId, "", "the consumer id of an existing consumer chain whose launch is simulated")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+const FlagOwner = "owner"
+
+func CmdPowerShapingTemplates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "power-shaping-templates",
+		Short: "Query the stored power-shaping templates",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the power-shaping templates stored with the store-shaping-template command,
+optionally only the templates of a given owner.
+
+Example:
+$ %[1]s query provider power-shaping-templates
+$ %[1]s query provider power-shaping-templates --%[2]s cosmos1...
+`,
+				version.AppName, FlagOwner,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			owner, _ := cmd.Flags().GetString(FlagOwner)
+			req := &types.QueryPowerShapingTemplatesRequest{Owner: owner}
+
+			fs, err := client.FlagSetWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req.Pagination, err = client.ReadPageRequest(fs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryPowerShapingTemplates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagOwner, "", "the owner of the power-shaping templates")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "power-shaping templates")
+
+	return cmd
+}
+
+func CmdPowerShapingTemplate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "power-shaping-template [template-id]",
+		Short: "Query a stored power-shaping template",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the power-shaping template with the given id.
+
+Example:
+$ %s query provider power-shaping-template 0
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryPowerShapingTemplate(cmd.Context(),
+				&types.QueryPowerShapingTemplateRequest{TemplateId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerUpgradePlan() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-upgrade-plan [consumer-id]",
+		Short: "Query the planned upgrade of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the upgrade registered by the owner of the consumer chain with the given consumer id.
+
+Example:
+$ %s query provider consumer-upgrade-plan 0
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerUpgradePlan(cmd.Context(),
+				&types.QueryConsumerUpgradePlanRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerProjectedDropOffs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "projected-drop-offs [consumer-id]",
+		Short: "Query the validators projected to drop out of the validator set of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators of the launched consumer chain with the given consumer id
+that are projected to drop out of its validator set at the next epoch, 
+based on the current opted-in validators and power-shaping parameters.
+
+Example:
+$ %s query provider projected-drop-offs 0
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryConsumerProjectedDropOffs(cmd.Context(),
+				&types.QueryConsumerProjectedDropOffsRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdVSCHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vsc-history [consumer-id]",
+		Short: "Query the VSC packets sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the VSC packets sent to the consumer chain with the given consumer id, i.e.,
+their valset update id, sequence, number of validator updates, send height and acknowledgement status.
+Only the most recent VSC packets are retained.
+Example:
+$ %s query provider vsc-history 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVSCHistoryRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryVSCHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdOldestValsetUpdateHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "oldest-valset-update-height",
+		Short: "Query the oldest retained mapping from a valset update id to a block height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the oldest retained mapping from a valset update id to a block height, together with
+the block time of the block height and the retention period of the mappings, i.e., the unbonding period
+plus the retention margin. Slash packets referring to older valset update ids cannot be handled.
+Example:
+$ %s query provider oldest-valset-update-height
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryOldestValsetUpdateHeightRequest{}
+			res, err := queryClient.QueryOldestValsetUpdateHeight(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorConsumerChains() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-consumer-chains [validator-address]",
+		Short: "Query the consumer chains a validator has to run a node for",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns every launched consumer chain the validator has to run a node for, together with
+the chain metadata and phase, the client and channel ids on the provider chain, and the consumer key to use.
+Example:
+$ %s query provider validator-consumer-chains %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+		`, version.AppName, bech32PrefixValAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryValidatorConsumerChainsRequest{ValidatorAddress: addr.String()}
+			res, err := queryClient.QueryValidatorConsumerChains(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdKeyAssignmentReplacements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-assignment-replacements [consumer-id]",
+		Short: "Query the pending key-assignment replacements of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer addresses of the replaced consumer keys of the consumer chain with the given
+consumer id, which are kept until they can be pruned, i.e., until the unbonding period after the replacement elapsed.
+Replacements that are stuck, i.e., whose prune time elapsed without being pruned, can be flushed with
+the flush-key-assignment-replacement transaction.
+
+Example:
+$ %s query provider key-assignment-replacements 0
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryKeyAssignmentReplacements(cmd.Context(),
+				&types.QueryKeyAssignmentReplacementsRequest{ConsumerId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerKeyUsage() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "consumer-key-usage [consumer-validator-address]",
+		Short: "Query the consumer chains on which a consumer key is assigned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the consumer chains on which the consumer key with the given consensus address
+is assigned, together with the provider consensus address of the validator that assigned it.
+Unless the allow_consumer_key_reuse param is enabled, a consumer key cannot be assigned on a
+consumer chain while it is assigned on another consumer chain.
+
+Example:
+$ %s query provider consumer-key-usage %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryConsumerKeyUsage(cmd.Context(),
+				&types.QueryConsumerKeyUsageRequest{ConsumerAddress: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorTopNObligations() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-top-n-obligations [provider-validator-address]",
+		Short: "Query whether a validator is in the top N of every Top N consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query, for every registered, initialized, or launched Top N consumer chain, whether the
+validator with the given provider consensus address is in the top N, and thus has to validate the
+consumer chain, together with the minimum power in the top N and the power margin of the validator,
+i.e., the difference between the validator power and the minimum power in the top N.
+
+Example:
+$ %s query provider validator-top-n-obligations %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryValidatorTopNObligations(cmd.Context(),
+				&types.QueryValidatorTopNObligationsRequest{ProviderAddress: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPacketCommitmentReconciliation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-commitment-reconciliation [consumer-id]",
+		Short: "Query the discrepancies between the packet commitments and the VSC packets sent to a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cross-reference the packet commitments on the CCV channel of the consumer chain with the given
+consumer id against the VSC packets recorded as sent by the provider and list the discrepancies, i.e.,
+packets committed but unknown, packets recorded as pending but uncommitted, and packets recorded as
+acknowledged or timed out but still committed.
+Example:
+$ %s query provider packet-commitment-reconciliation 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPacketCommitmentReconciliationRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryPacketCommitmentReconciliation(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRewardDenomsMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-reward-denoms-metadata [consumer-id]",
+		Short: "Query the bank metadata of the reward denoms allowlisted by a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the bank metadata of the reward denoms allowlisted by the consumer chain with the given consumer id.
+The metadata is registered from the consumer metadata once the reward denoms are allowlisted.
+Example:
+$ %s query provider consumer-reward-denoms-metadata 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerRewardDenomsMetadataRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerRewardDenomsMetadata(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
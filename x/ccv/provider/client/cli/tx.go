@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/spf13/cobra"
@@ -16,11 +18,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -36,10 +40,17 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewAssignConsumerKeyCmd())
 	cmd.AddCommand(NewSubmitConsumerMisbehaviourCmd())
 	cmd.AddCommand(NewSubmitConsumerDoubleVotingCmd())
+	cmd.AddCommand(NewSubmitConsumerEvidenceCmd())
 	cmd.AddCommand(NewCreateConsumerCmd())
 	cmd.AddCommand(NewUpdateConsumerCmd())
 	cmd.AddCommand(NewRemoveConsumerCmd())
+	cmd.AddCommand(NewStoreShapingTemplateCmd())
+	cmd.AddCommand(NewRegisterConsumerUpgradeCmd())
+	cmd.AddCommand(NewCancelConsumerUpgradeCmd())
+	cmd.AddCommand(NewFlushKeyAssignmentReplacementCmd())
+	cmd.AddCommand(NewSetConsumerCommitteeCmd())
 	cmd.AddCommand(NewOptInCmd())
+	cmd.AddCommand(NewOptInRequiredCmd())
 	cmd.AddCommand(NewOptOutCmd())
 	cmd.AddCommand(NewSetConsumerCommissionRateCmd())
 
@@ -211,6 +222,89 @@ Example:
 	return cmd
 }
 
+func NewSubmitConsumerEvidenceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-consumer-evidence [consumer-id] [evidence]",
+		Short: "submit an evidence file dumped by hermes for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit the evidence of an infraction committed on a consumer chain, as dumped by the evidence mode of hermes.
+Depending on the evidence, either a MsgSubmitConsumerDoubleVoting or a MsgSubmitConsumerMisbehaviour is submitted.
+
+The evidence file contains either a duplicate vote evidence, in the CometBFT JSON encoding used by the RPC endpoints
+of the consumer chain, together with the IBC header of the consumer chain at the infraction height,
+or an IBC misbehaviour. If the chain id is set, it must match the chain id of the consumer chain.
+
+Example:
+%s tx provider submit-consumer-evidence [consumer-id] [path/to/evidence.json]
+
+where evidence.json has one of the following structures:
+{
+  "chain_id": "consumer-1",
+  "duplicate_vote_evidence": {
+    "type": "tendermint/DuplicateVoteEvidence",
+    "value": {"vote_a": {...}, "vote_b": {...}, "TotalVotingPower": "...", "ValidatorPower": "...", "Timestamp": "..."}
+  },
+  "infraction_header": {"signed_header": {...}, "validator_set": {...}, "trusted_height": {...}, "trusted_validators": {...}}
+}
+{
+  "chain_id": "consumer-1",
+  "misbehaviour": {"client_id": "07-tendermint-0", "header_1": {...}, "header_2": {...}}
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			submitter := clientCtx.GetFromAddress()
+			evidenceJson, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+			chainId, msg, err := parseConsumerEvidence(cdc, evidenceJson, args[0], submitter)
+			if err != nil {
+				return err
+			}
+
+			if chainId != "" {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.QueryConsumerChain(cmd.Context(), &types.QueryConsumerChainRequest{ConsumerId: args[0]})
+				if err != nil {
+					return err
+				}
+				if res.ChainId != chainId {
+					return fmt.Errorf("evidence for chain id %s cannot be submitted for consumer id %s with chain id %s",
+						chainId, args[0], res.ChainId)
+				}
+			}
+
+			if m, ok := msg.(sdk.HasValidateBasic); ok {
+				if err := m.ValidateBasic(); err != nil {
+					return err
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func NewCreateConsumerCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-consumer [consumer-parameters]",
@@ -229,7 +323,12 @@ where create_consumer.json has the following structure:
   "metadata": {
     "name": "chain consumer",
     "description": "description",
-    "metadata": "{\"forge_json_url\": \"...\", \"stage\": \"mainnet\"}"
+    "metadata": "{\"forge_json_url\": \"...\", \"stage\": \"mainnet\"}",
+    "repo_url": "https://github.com/...",
+    "binary_checksum": "",
+    "genesis_hash": "",
+    "seeds": ["node_id@host:port"],
+    "docs_url": "https://..."
   },
   "initialization_parameters": {
     "initial_height": {
@@ -246,7 +345,9 @@ where create_consumer.json has the following structure:
     "blocks_per_distribution_transmission": 1000,
     "historical_entries": 10000,
     "distribution_transmission_channel": "",
-    "connection_id": ""
+    "connection_id": "",
+    "client_id": "",
+    "verify_genesis_hash": false
   },
   "power_shaping_parameters": {
     "top_N": 0,
@@ -255,6 +356,8 @@ where create_consumer.json has the following structure:
     "allowlist": ["cosmosvalcons..."],
     "denylist": ["cosmosvalcons..."],
     "min_stake": 0,
+    "min_uptime": 0,
+    "min_validator_power": 0,
     "allow_inactive_vals": false,
     "prioritylist": ["cosmosvalcons..."]
   },
@@ -278,6 +381,15 @@ where create_consumer.json has the following structure:
 Note that both 'chain_id' and 'metadata' are mandatory;
 and 'initialization_parameters', 'power_shaping_parameters' and 'allowlisted_reward_denoms' are optional. 
 The parameters not provided are set to their zero value. 
+Instead of 'power_shaping_parameters', the id of a power-shaping template stored with the
+store-shaping-template command can be provided as 'power_shaping_template_id'.
+The optional 'min_commission_rate' (e.g., "0.05") sets the minimum commission rate
+validators can set for the consumer chain.
+The optional 'downtime_params' (e.g., {"signed_blocks_window": "10000", "min_signed_per_window": "0.05"})
+sets the downtime detection parameters pushed to the consumer chain.
+The optional 'provider_ccv_timeout_period' (e.g., "1209600s") sets the timeout period
+of the CCV packets sent to the consumer chain, within [ccv_timeout_period / 2, ccv_timeout_period * 2]
+with ccv_timeout_period the provider param.
 `, version.AppName)),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -308,6 +420,10 @@ The parameters not provided are set to their zero value.
 			if err != nil {
 				return err
 			}
+			msg.PowerShapingTemplateId = consCreate.PowerShapingTemplateId
+			msg.MinCommissionRate = consCreate.MinCommissionRate
+			msg.DowntimeParams = consCreate.DowntimeParams
+			msg.ProviderCcvTimeoutPeriod = consCreate.ProviderCcvTimeoutPeriod
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -341,7 +457,12 @@ where update_consumer.json has the following structure:
    "metadata": {
     "name": "chain consumer",
     "description": "description",
-    "metadata": "{\"forge_json_url\": \"...\", \"stage\": \"mainnet\"}"
+    "metadata": "{\"forge_json_url\": \"...\", \"stage\": \"mainnet\"}",
+    "repo_url": "https://github.com/...",
+    "binary_checksum": "",
+    "genesis_hash": "",
+    "seeds": ["node_id@host:port"],
+    "docs_url": "https://..."
    },
    "initialization_parameters": {
     "initial_height": {
@@ -358,7 +479,8 @@ where update_consumer.json has the following structure:
     "blocks_per_distribution_transmission": 1000,
     "historical_entries": 10000,
     "distribution_transmission_channel": "",
-	"connection_id": ""
+	"connection_id": "",
+	"client_id": ""
    },
    "power_shaping_parameters": {
     "top_N": 0,
@@ -367,6 +489,8 @@ where update_consumer.json has the following structure:
     "allowlist": ["cosmosvalcons..."],
     "denylist": ["cosmosvalcons..."],
     "min_stake": 0,
+    "min_uptime": 0,
+    "min_validator_power": 0,
     "allow_inactive_vals": false,
     "prioritylist": ["cosmosvalcons..."]
    },
@@ -393,6 +517,15 @@ Not providing one of them will leave the existing values unchanged.
 Providing one of 'metadata', 'initialization_parameters', 'power_shaping_parameters', or 'allowlisted_reward_denoms' 
 will update all the containing fields. 
 If one of the fields is missing, it will be set to its zero value.
+Instead of 'power_shaping_parameters', the id of a power-shaping template stored with the
+store-shaping-template command can be provided as 'power_shaping_template_id'.
+The optional 'min_commission_rate' (e.g., "0.05") sets the minimum commission rate
+validators can set for the consumer chain.
+The optional 'downtime_params' (e.g., {"signed_blocks_window": "10000", "min_signed_per_window": "0.05"})
+sets the downtime detection parameters pushed to the consumer chain.
+The optional 'provider_ccv_timeout_period' (e.g., "1209600s") sets the timeout period
+of the CCV packets sent to the consumer chain, within [ccv_timeout_period / 2, ccv_timeout_period * 2]
+with ccv_timeout_period the provider param.
 `, version.AppName)),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -428,6 +561,10 @@ If one of the fields is missing, it will be set to its zero value.
 			if err != nil {
 				return err
 			}
+			msg.PowerShapingTemplateId = consUpdate.PowerShapingTemplateId
+			msg.MinCommissionRate = consUpdate.MinCommissionRate
+			msg.DowntimeParams = consUpdate.DowntimeParams
+			msg.ProviderCcvTimeoutPeriod = consUpdate.ProviderCcvTimeoutPeriod
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -487,6 +624,285 @@ Example:
 	return cmd
 }
 
+func NewStoreShapingTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-shaping-template [name] [power-shaping-parameters]",
+		Short: "store a named power-shaping template",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Store a named power-shaping template and get its assigned template id. The template id can be
+provided as 'power_shaping_template_id' instead of 'power_shaping_parameters' when creating or updating consumer chains.
+Note that the one that signs this message is the owner of the template.
+
+Example:
+%s tx provider store-shaping-template [name] [path/to/power_shaping_parameters.json]
+
+where power_shaping_parameters.json has the following structure:
+{
+  "top_N": 0,
+  "validators_power_cap": 10,
+  "validator_set_cap": 0,
+  "allowlist": ["cosmosvalcons..."],
+  "denylist": ["cosmosvalcons..."],
+  "min_stake": 0,
+  "min_uptime": 0,
+  "min_validator_power": 0,
+  "allow_inactive_vals": false,
+  "prioritylist": ["cosmosvalcons..."]
+}
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			submitter := clientCtx.GetFromAddress().String()
+
+			parametersJson, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			parameters := types.PowerShapingParameters{}
+			if err = json.Unmarshal(parametersJson, &parameters); err != nil {
+				return fmt.Errorf("power-shaping parameters unmarshalling failed: %w", err)
+			}
+
+			msg, err := types.NewMsgStoreShapingTemplate(submitter, args[0], parameters)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+const (
+	FlagHaltHeight = "halt-height"
+	FlagHaltTime   = "halt-time"
+)
+
+func NewRegisterConsumerUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-consumer-upgrade [consumer-id] [name] [binary-hash]",
+		Short: "register a planned upgrade of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Registers a planned upgrade of a launched consumer chain, which is pushed to the consumer chain.
+Note that only the owner of the chain can register an upgrade. The consumer chain halts for the upgrade
+either at the consumer chain height given by --%s or at the time given by --%s (RFC3339).
+The binary hash is the hex-encoded SHA256 hash of the binary the consumer chain is upgraded to.
+Example:
+%s tx provider register-consumer-upgrade [consumer-id] v2 [binary-hash] --%s 1000000
+`, FlagHaltHeight, FlagHaltTime, version.AppName, FlagHaltHeight)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			consumerId := args[0]
+
+			binaryHash, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid binary hash: %w", err)
+			}
+
+			plan := ccvtypes.ConsumerUpgradePlan{
+				Name:       args[1],
+				BinaryHash: binaryHash,
+			}
+			plan.HaltHeight, _ = cmd.Flags().GetInt64(FlagHaltHeight)
+			if haltTime, _ := cmd.Flags().GetString(FlagHaltTime); haltTime != "" {
+				plan.HaltTime, err = time.Parse(time.RFC3339, haltTime)
+				if err != nil {
+					return fmt.Errorf("invalid halt time: %w", err)
+				}
+			}
+
+			msg, err := types.NewMsgRegisterConsumerUpgrade(owner, consumerId, plan)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagHaltHeight, 0, "the consumer chain height at which the consumer chain halts for the upgrade")
+	cmd.Flags().String(FlagHaltTime, "", "the time (RFC3339) at which the consumer chain halts for the upgrade")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewCancelConsumerUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-consumer-upgrade [consumer-id]",
+		Short: "cancel the planned upgrade of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancels the planned upgrade of a launched consumer chain. Note that only the owner of the chain can cancel it.
+Example:
+%s tx provider cancel-consumer-upgrade [consumer-id]
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			consumerId := args[0]
+
+			msg, err := types.NewMsgCancelConsumerUpgrade(owner, consumerId)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewFlushKeyAssignmentReplacementCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flush-key-assignment-replacement [consumer-id] [consumer-address]",
+		Short: "prune a stuck key-assignment replacement of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Prunes the consumer address of a replaced consumer key whose prune time elapsed, but that was not pruned.
+The stuck replacements of a consumer chain are returned by the key-assignment-replacements query.
+Example:
+%s tx provider flush-key-assignment-replacement [consumer-id] [consumer-address]
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			submitter := clientCtx.GetFromAddress().String()
+			msg, err := types.NewMsgFlushKeyAssignmentReplacement(submitter, args[0], args[1])
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewSetConsumerCommitteeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-consumer-committee [consumer-id] [committee]",
+		Short: "set the committee of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the committee of a launched consumer chain, i.e., the provider consensus addresses of the validators
+that are kept in the consumer validator set after applying the power-shaping parameters.
+The committee is given as a comma-separated list of provider consensus addresses; an empty list removes the committee.
+Note that only the owner of the chain can set its committee.
+Example:
+%s tx provider set-consumer-committee [consumer-id] cosmosvalcons1l9qq4m300z8c5ez86ak2mp8znftewkwgjlxh88,cosmosvalcons1kswr5sq599365kcjmhgufevfps9njf43e4lwdk
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			committee := []string{}
+			for _, address := range strings.Split(args[1], ",") {
+				if address = strings.TrimSpace(address); address != "" {
+					committee = append(committee, address)
+				}
+			}
+
+			msg, err := types.NewMsgSetConsumerCommittee(owner, args[0], committee)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
 func NewOptInCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "opt-in [consumer-id] [consumer-pubkey]",
@@ -535,6 +951,140 @@ func NewOptInCmd() *cobra.Command {
 	return cmd
 }
 
+const (
+	FlagAllTopN      = "all-top-n"
+	FlagConsumerKeys = "consumer-keys"
+)
+
+func NewOptInRequiredCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "opt-in-required",
+		Short: "opts in validator to all the Top N consumer chains it has to validate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Opt in the validator to all the Top N consumer chains it has to validate, but did not opt in to yet,
+by broadcasting a single transaction containing one MsgOptIn per consumer chain.
+With the --%[2]s flag, the validator opts in to all the registered, initialized and launched Top N consumer chains instead.
+
+The consumer public keys to be used on the consumer chains can be provided with the --%[3]s flag,
+as a JSON file mapping consumer ids to consumer public keys. The consumer chains not in the file
+use the provider public key of the validator.
+
+Example:
+%[1]s tx provider opt-in-required --%[3]s [path/to/consumer_keys.json] --from validator
+
+where consumer_keys.json has the following structure:
+{
+  "0": "{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is=\"}",
+  "3": "{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"cRHYc1DPLgXp+F5NDw9FYNh0qD5f0hb8cuwwO4yS6ys=\"}"
+}
+`, version.AppName, FlagAllTopN, FlagConsumerKeys)),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			providerValAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			submitter := clientCtx.GetFromAddress().String()
+
+			consumerKeys := map[string]string{}
+			if keysFile, _ := cmd.Flags().GetString(FlagConsumerKeys); keysFile != "" {
+				keysJson, err := os.ReadFile(keysFile)
+				if err != nil {
+					return err
+				}
+				if err = json.Unmarshal(keysJson, &consumerKeys); err != nil {
+					return fmt.Errorf("consumer keys unmarshalling failed: %w", err)
+				}
+			}
+
+			allTopN, _ := cmd.Flags().GetBool(FlagAllTopN)
+			consumerIds, err := getRequiredOptIns(cmd, clientCtx, providerValAddr, allTopN)
+			if err != nil {
+				return err
+			}
+			if len(consumerIds) == 0 {
+				return fmt.Errorf("no Top N consumer chains to opt in to for validator %s", providerValAddr)
+			}
+
+			msgs := make([]sdk.Msg, 0, len(consumerIds))
+			for _, consumerId := range consumerIds {
+				msg, err := types.NewMsgOptIn(consumerId, providerValAddr, consumerKeys[consumerId], submitter)
+				if err != nil {
+					return err
+				}
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgs...)
+		},
+	}
+
+	cmd.Flags().Bool(FlagAllTopN, false, "opt in to all the Top N consumer chains instead of only the ones the validator has to validate")
+	cmd.Flags().String(FlagConsumerKeys, "", "path to a JSON file mapping consumer ids to the consumer public keys to be used")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// getRequiredOptIns returns the ids of the Top N consumer chains the validator did not opt in to yet
+// and has to validate or, if allTopN is set, that are in the registered, initialized or launched phase
+func getRequiredOptIns(cmd *cobra.Command, clientCtx client.Context, providerValAddr sdk.ValAddress, allTopN bool) ([]string, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+
+	res, err := queryClient.QueryValidatorConsumerStatus(cmd.Context(),
+		&types.QueryValidatorConsumerStatusRequest{ValidatorAddress: providerValAddr.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	optedIn := map[string]bool{}
+	consumerIds := []string{}
+	for _, consumer := range res.Consumers {
+		optedIn[consumer.ConsumerId] = consumer.OptedIn
+		if !allTopN && consumer.Top_N > 0 && consumer.HasToValidate && !consumer.OptedIn {
+			consumerIds = append(consumerIds, consumer.ConsumerId)
+		}
+	}
+	if !allTopN {
+		return consumerIds, nil
+	}
+
+	activePhases := map[string]bool{
+		types.CONSUMER_PHASE_REGISTERED.String():  true,
+		types.CONSUMER_PHASE_INITIALIZED.String(): true,
+		types.CONSUMER_PHASE_LAUNCHED.String():    true,
+	}
+	pageReq := &query.PageRequest{}
+	for {
+		chains, err := queryClient.QueryConsumerChains(cmd.Context(), &types.QueryConsumerChainsRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		for _, chain := range chains.Chains {
+			if chain.Top_N > 0 && activePhases[chain.Phase] && !optedIn[chain.ConsumerId] {
+				consumerIds = append(consumerIds, chain.ConsumerId)
+			}
+		}
+		if chains.Pagination == nil || len(chains.Pagination.NextKey) == 0 {
+			return consumerIds, nil
+		}
+		pageReq = &query.PageRequest{Key: chains.Pagination.NextKey}
+	}
+}
+
 func NewOptOutCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "opt-out [consumer-id]",
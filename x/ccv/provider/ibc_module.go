@@ -56,9 +56,9 @@ func (am AppModule) OnChanOpenTry(
 	}
 
 	// ensure the counterparty port ID matches the expected consumer port ID
-	if counterparty.PortId != ccv.ConsumerPortID {
+	if counterparty.PortId != am.keeper.CounterpartyPortID() {
 		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort,
-			"invalid counterparty port: %s, expected %s", counterparty.PortId, ccv.ConsumerPortID)
+			"invalid counterparty port: %s, expected %s", counterparty.PortId, am.keeper.CounterpartyPortID())
 	}
 
 	// ensure the counter party version matches the expected version
@@ -81,6 +81,7 @@ func (am AppModule) OnChanOpenTry(
 		// provider chain will fail
 		ProviderFeePoolAddr: am.keeper.GetConsumerRewardsPoolAddressStr(ctx),
 		Version:             ccv.Version,
+		Features:            ccv.SupportedFeatures(),
 	}
 	mdBz, err := (&md).Marshal()
 	if err != nil {
@@ -165,7 +166,7 @@ func (am AppModule) OnRecvPacket(
 	ctx sdk.Context,
 	_ string,
 	packet channeltypes.Packet,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	logger := am.keeper.Logger(ctx)
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
@@ -174,6 +175,9 @@ func (am AppModule) OnRecvPacket(
 	consumerPacket, err := UnmarshalConsumerPacket(packet)
 	if err != nil {
 		ackErr = errorsmod.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ConsumerPacket data")
+		if errors.Is(err, ccv.ErrUnsupportedFeature) {
+			ackErr = err
+		}
 		logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		ack = channeltypes.NewErrorAcknowledgement(ackErr)
 	}
@@ -192,6 +196,10 @@ func (am AppModule) OnRecvPacket(
 			err = nil
 		case ccv.SlashPacket:
 			// handle SlashPacket
+			if consumerPacket.GetSlashPacketData() == nil {
+				err = errorsmod.Wrap(ccv.ErrInvalidPacketData, "SlashPacketData data cannot be empty")
+				break
+			}
 			var ackResult ccv.PacketAckResult
 			data := *consumerPacket.GetSlashPacketData()
 			ackResult, err = am.keeper.OnRecvSlashPacket(ctx, packet, data)
@@ -213,6 +221,12 @@ func (am AppModule) OnRecvPacket(
 	eventAttributes = append(eventAttributes, sdk.NewAttribute(ccv.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())))
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(ccv.AttributeKeyAckError, ackErr.Error()))
+		am.keeper.RecordPacketError(ctx, packet, ackErr)
+	} else {
+		am.keeper.RecordPacketReceived(ctx, packet)
+		if consumerId, found := am.keeper.GetChannelIdToConsumerId(ctx, packet.DestinationChannel); found {
+			am.keeper.RebateRelayer(ctx, consumerId, relayer)
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -226,35 +240,14 @@ func (am AppModule) OnRecvPacket(
 	return ack
 }
 
+// UnmarshalConsumerPacket decodes the data of a packet received on a CCV channel, see ccv.UnmarshalConsumerPacketData
 func UnmarshalConsumerPacket(packet channeltypes.Packet) (consumerPacket ccv.ConsumerPacketData, err error) {
 	return UnmarshalConsumerPacketData(packet.GetData())
 }
 
+// UnmarshalConsumerPacketData decodes the data of a packet received on a CCV channel, see ccv.UnmarshalConsumerPacketData
 func UnmarshalConsumerPacketData(packetData []byte) (consumerPacket ccv.ConsumerPacketData, err error) {
-	// First try unmarshaling into ccv.ConsumerPacketData type
-	if err := ccv.ModuleCdc.UnmarshalJSON(packetData, &consumerPacket); err != nil {
-		// If failed, packet should be a v1 slash packet, retry for ConsumerPacketDataV1 packet type
-		var v1Packet ccv.ConsumerPacketDataV1
-		errV1 := ccv.ModuleCdc.UnmarshalJSON(packetData, &v1Packet)
-		if errV1 != nil {
-			// If neither worked, return error
-			return ccv.ConsumerPacketData{}, errV1
-		}
-
-		// VSC matured packets should not be unmarshaled as v1 packets
-		if v1Packet.Type == ccv.VscMaturedPacket {
-			return ccv.ConsumerPacketData{}, errors.New("VSC matured packets should be correctly unmarshaled")
-		}
-
-		// Convert from v1 packet type
-		consumerPacket = ccv.ConsumerPacketData{
-			Type: v1Packet.Type,
-			Data: &ccv.ConsumerPacketData_SlashPacketData{
-				SlashPacketData: v1Packet.GetSlashPacketData().FromV1(),
-			},
-		}
-	}
-	return consumerPacket, nil
+	return ccv.UnmarshalConsumerPacketData(packetData)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -263,7 +256,7 @@ func (am AppModule) OnAcknowledgementPacket(
 	_ string,
 	packet channeltypes.Packet,
 	acknowledgement []byte,
-	_ sdk.AccAddress,
+	relayer sdk.AccAddress,
 ) error {
 	var ack channeltypes.Acknowledgement
 	if err := ccv.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
@@ -290,6 +283,9 @@ func (am AppModule) OnAcknowledgementPacket(
 				sdk.NewAttribute(ccv.AttributeKeyAckSuccess, string(resp.Result)),
 			),
 		)
+		if consumerId, found := am.keeper.GetChannelIdToConsumerId(ctx, packet.SourceChannel); found {
+			am.keeper.RebateRelayer(ctx, consumerId, relayer)
+		}
 	case *channeltypes.Acknowledgement_Error:
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -83,6 +84,19 @@ func TestOnChanOpenTry(t *testing.T) {
 				params.counterparty.PortId = "bad port"
 			}, false,
 		},
+		{
+			"success with custom port IDs", func(params *params, keeper *providerkeeper.Keeper) {
+				keeper.SetPortIDs("provider-1", "consumer-1")
+				keeper.SetPort(params.ctx, "provider-1")
+				params.portID = "provider-1"
+				params.counterparty.PortId = "consumer-1"
+			}, true,
+		},
+		{
+			"counter party port ID does not match custom port ID", func(params *params, keeper *providerkeeper.Keeper) {
+				keeper.SetPortIDs(ccv.ProviderPortID, "consumer-1")
+			}, false,
+		},
 		{
 			"invalid counter party version", func(params *params, keeper *providerkeeper.Keeper) {
 				params.counterpartyVersion = "invalidVersion"
@@ -334,6 +348,17 @@ func TestOnChanOpenConfirm(t *testing.T) {
 }
 
 func TestUnmarshalConsumerPacket(t *testing.T) {
+	slashPacketData := ccv.NewConsumerPacketData(ccv.SlashPacket, &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: &ccv.SlashPacketData{ValsetUpdateId: 789},
+	})
+	slashPacketDataV2, err := slashPacketData.ToV2()
+	require.NoError(t, err)
+	vscMaturedPacketData := ccv.NewConsumerPacketData(ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: &ccv.VSCMaturedPacketData{ValsetUpdateId: 420},
+	})
+	vscMaturedPacketDataV2, err := vscMaturedPacketData.ToV2()
+	require.NoError(t, err)
+
 	testCases := []struct {
 		name               string
 		packet             channeltypes.Packet
@@ -383,11 +408,52 @@ func TestUnmarshalConsumerPacket(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "vsc matured in v2 envelope",
+			packet: channeltypes.NewPacket(vscMaturedPacketDataV2.GetBytes(),
+				342, "sourcePort", "sourceChannel", "destinationPort", "destinationChannel", types.Height{}, 0,
+			),
+			expectedPacketData: vscMaturedPacketData,
+		},
+		{
+			name: "slash packet in v2 envelope",
+			packet: channeltypes.NewPacket(slashPacketDataV2.GetBytes(),
+				342, "sourcePort", "sourceChannel", "destinationPort", "destinationChannel", types.Height{}, 0,
+			),
+			expectedPacketData: slashPacketData,
+		},
 	}
 
 	for _, tc := range testCases {
 		actualConsumerPacketData, err := provider.UnmarshalConsumerPacket(tc.packet)
-		require.NoError(t, err)
-		require.Equal(t, tc.expectedPacketData, actualConsumerPacketData)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expectedPacketData, actualConsumerPacketData, tc.name)
+	}
+
+	// v2 envelopes requiring unsupported features are rejected
+	slashPacketDataV2.Features = append(slashPacketDataV2.Features, "unsupported_feature")
+	_, err = provider.UnmarshalConsumerPacketData(slashPacketDataV2.GetBytes())
+	require.ErrorIs(t, err, ccv.ErrUnsupportedFeature)
+}
+
+// TestOnRecvPacketMalformedData tests that the provider acknowledges malformed consumer packets
+// with an error instead of panicking
+func TestOnRecvPacketMalformedData(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerModule := provider.NewAppModule(&providerKeeper, *keeperParams.ParamsSubspace, keeperParams.StoreKey)
+
+	for _, data := range [][]byte{
+		[]byte("not json"),
+		[]byte(`{"type":"CONSUMER_PACKET_TYPE_SLASH"}`),
+		[]byte(`{"type":"CONSUMER_PACKET_TYPE_UNSPECIFIED"}`),
+	} {
+		packet := channeltypes.NewPacket(data, 1, ccv.ConsumerPortID, "channel-1", ccv.ProviderPortID, "channel-1", types.Height{}, 0)
+		var ack ibcexported.Acknowledgement
+		require.NotPanics(t, func() {
+			ack = providerModule.OnRecvPacket(ctx, ccv.Version, packet, sdk.AccAddress{})
+		}, string(data))
+		require.False(t, ack.Success(), string(data))
 	}
 }
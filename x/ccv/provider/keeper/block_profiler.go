@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Provider block hooks measured by the block profiler
+const (
+	BlockHookBeginBlock = "begin_block"
+	BlockHookEndBlock   = "end_block"
+)
+
+// Phases of the provider BeginBlock and EndBlock measured by the block profiler
+const (
+	BlockPhaseLaunchConsumers      = "launch_consumers"
+	BlockPhaseRemoveConsumers      = "remove_consumers"
+	BlockPhaseInfractionParameters = "infraction_parameters"
+	BlockPhaseSlashMeter           = "slash_meter"
+	BlockPhaseRewardDistribution   = "reward_distribution"
+	BlockPhasePruning              = "pruning"
+	BlockPhaseRelayerLiveness      = "relayer_liveness"
+	BlockPhaseProviderValset       = "provider_valset"
+	BlockPhaseVSCPacketQueueing    = "vsc_packet_queueing"
+	BlockPhaseVSCPacketSending     = "vsc_packet_sending"
+	BlockPhaseValsetCheckpoints    = "valset_checkpoints"
+	BlockPhaseDowntimeParams       = "downtime_params"
+	BlockPhaseProviderFeePoolAddr  = "provider_fee_pool_addr"
+	BlockPhaseClientExpiry         = "client_expiry"
+	BlockPhaseDropOffWarnings      = "drop_off_warnings"
+	BlockPhaseCommissionRates      = "commission_rates"
+	BlockPhaseTelemetry            = "telemetry"
+)
+
+// blockProfiler measures the phases of the provider BeginBlock and EndBlock.
+// It is not part of the state, i.e., nodes can use different settings.
+type blockProfiler struct {
+	mu sync.Mutex
+	// timeBudget is the duration above which a BeginBlock or EndBlock is reported as slow;
+	// zero disables the reports
+	timeBudget time.Duration
+	// profileDir is the directory the CPU profiles of the slow BeginBlocks and EndBlocks
+	// are written to; empty disables the profiles
+	profileDir string
+
+	// phases holds the durations of the phases of the running BeginBlock or EndBlock
+	phases []blockPhaseDuration
+	// cpuProfile holds the CPU profile of the running BeginBlock or EndBlock
+	cpuProfile *bytes.Buffer
+}
+
+type blockPhaseDuration struct {
+	phase    string
+	duration time.Duration
+}
+
+func newBlockProfiler() *blockProfiler {
+	return &blockProfiler{}
+}
+
+func (p *blockProfiler) recordPhase(phase string, duration time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.timeBudget > 0 {
+		p.phases = append(p.phases, blockPhaseDuration{phase: phase, duration: duration})
+	}
+}
+
+// SetBlockTimeBudget sets the duration above which the provider BeginBlock or EndBlock
+// is reported as slow (zero to disable the reports) and the directory the CPU profiles
+// of the slow BeginBlocks and EndBlocks are written to (empty to disable the profiles).
+// Note that profiling the CPU of every BeginBlock and EndBlock adds some overhead.
+// This method must be called in app.go. It panics if timeBudget is negative.
+func (k *Keeper) SetBlockTimeBudget(timeBudget time.Duration, profileDir string) {
+	if timeBudget < 0 {
+		panic(fmt.Errorf("invalid block time budget: %s", timeBudget))
+	}
+	k.blockProfiler.mu.Lock()
+	defer k.blockProfiler.mu.Unlock()
+	k.blockProfiler.timeBudget = timeBudget
+	k.blockProfiler.profileDir = profileDir
+}
+
+// StartBlockHook starts measuring the given provider block hook, i.e., BeginBlock or EndBlock,
+// and returns the function that stops measuring it. The duration of the hook is recorded to telemetry.
+// If the hook exceeds the time budget, the durations of its phases are logged and,
+// if enabled, its CPU profile is written to the profile directory.
+func (k Keeper) StartBlockHook(ctx sdk.Context, hook string) func() {
+	p := k.blockProfiler
+	p.mu.Lock()
+	p.phases = nil
+	if p.timeBudget > 0 && p.profileDir != "" {
+		buf := &bytes.Buffer{}
+		// profiling fails if the CPU is already profiled, e.g., through the --cpu-profile flag
+		if err := pprof.StartCPUProfile(buf); err == nil {
+			p.cpuProfile = buf
+		} else {
+			k.Logger(ctx).Debug("cannot profile provider block hook", "hook", hook, "error", err.Error())
+		}
+	}
+	p.mu.Unlock()
+
+	start := time.Now()
+	return func() {
+		elapsed := time.Since(start)
+		ccv.MeasureSinceWithLabels(types.ModuleName, ccv.MetricKeyBlockHookDuration, start,
+			telemetry.NewLabel(ccv.MetricLabelHook, hook))
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		cpuProfile := p.cpuProfile
+		if cpuProfile != nil {
+			pprof.StopCPUProfile()
+			p.cpuProfile = nil
+		}
+		if p.timeBudget == 0 || elapsed <= p.timeBudget {
+			return
+		}
+
+		phases := make([]any, 0, 2*len(p.phases))
+		for _, phase := range p.phases {
+			phases = append(phases, phase.phase, phase.duration.String())
+		}
+		k.Logger(ctx).Error("provider block hook exceeded the time budget",
+			append([]any{
+				"hook", hook,
+				"height", ctx.BlockHeight(),
+				"duration", elapsed.String(),
+				"budget", p.timeBudget.String(),
+			}, phases...)...,
+		)
+
+		if cpuProfile != nil {
+			path := filepath.Join(p.profileDir, fmt.Sprintf("provider_%s_%d.pprof", hook, ctx.BlockHeight()))
+			if err := os.WriteFile(path, cpuProfile.Bytes(), 0o600); err != nil {
+				k.Logger(ctx).Error("cannot write provider block hook profile", "path", path, "error", err.Error())
+				return
+			}
+			k.Logger(ctx).Info("wrote provider block hook profile", "hook", hook, "path", path)
+		}
+	}
+}
+
+// BlockPhaseTimer measures the consecutive phases of a provider block hook
+type BlockPhaseTimer struct {
+	profiler *blockProfiler
+	start    time.Time
+}
+
+// NewBlockPhaseTimer returns a timer whose first phase starts now
+func (k Keeper) NewBlockPhaseTimer() *BlockPhaseTimer {
+	return &BlockPhaseTimer{profiler: k.blockProfiler, start: time.Now()}
+}
+
+// Lap records the duration of the given phase, i.e., the time since the previous phase ended,
+// to telemetry and starts the next phase
+func (t *BlockPhaseTimer) Lap(phase string) {
+	ccv.MeasureSinceWithLabels(types.ModuleName, ccv.MetricKeyBlockPhaseDuration, t.start,
+		telemetry.NewLabel(ccv.MetricLabelPhase, phase))
+	t.profiler.recordPhase(phase, time.Since(t.start))
+	t.start = time.Now()
+}
+
+// Skip starts the next phase without recording the time since the previous phase ended,
+// e.g., after a phase whose sub-phases are measured separately
+func (t *BlockPhaseTimer) Skip() {
+	t.start = time.Now()
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+)
+
+// TestBlockTimeBudget tests that a CPU profile is written only for the block hooks exceeding the time budget
+func TestBlockTimeBudget(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(10)
+
+	require.Panics(t, func() { providerKeeper.SetBlockTimeBudget(-time.Second, "") })
+
+	profileDir := t.TempDir()
+	profilePath := filepath.Join(profileDir, fmt.Sprintf("provider_%s_%d.pprof", providerkeeper.BlockHookEndBlock, 10))
+
+	// the block hook does not exceed the time budget
+	providerKeeper.SetBlockTimeBudget(time.Hour, profileDir)
+	stop := providerKeeper.StartBlockHook(ctx, providerkeeper.BlockHookEndBlock)
+	providerKeeper.NewBlockPhaseTimer().Lap(providerkeeper.BlockPhasePruning)
+	stop()
+	require.NoFileExists(t, profilePath)
+
+	// the block hook exceeds the time budget
+	providerKeeper.SetBlockTimeBudget(time.Nanosecond, profileDir)
+	stop = providerKeeper.StartBlockHook(ctx, providerkeeper.BlockHookEndBlock)
+	timer := providerKeeper.NewBlockPhaseTimer()
+	time.Sleep(10 * time.Millisecond)
+	timer.Lap(providerkeeper.BlockPhasePruning)
+	stop()
+	require.FileExists(t, profilePath)
+	bz, err := os.ReadFile(profilePath)
+	require.NoError(t, err)
+	require.NotEmpty(t, bz)
+
+	// no profile is written if the profile directory is not set
+	require.NoError(t, os.Remove(profilePath))
+	providerKeeper.SetBlockTimeBudget(time.Nanosecond, "")
+	stop = providerKeeper.StartBlockHook(ctx, providerkeeper.BlockHookEndBlock)
+	time.Sleep(time.Millisecond)
+	stop()
+	require.NoFileExists(t, profilePath)
+}
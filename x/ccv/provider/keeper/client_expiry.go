@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetConsumerClientExpiry returns when the client of the consumer chain with the given consumer id expires
+func (k Keeper) GetConsumerClientExpiry(ctx sdk.Context, consumerId string) (ccv.ClientExpiry, error) {
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return ccv.ClientExpiry{}, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "no client for consumer id: %s", consumerId)
+	}
+	return ccv.GetClientExpiry(ctx, k.clientKeeper, clientId, k.GetClientExpiryWarningWindow(ctx))
+}
+
+// GetConsumerClientExpirySeverity returns the severity of the last client expiry warning of a consumer chain
+func (k Keeper) GetConsumerClientExpirySeverity(ctx sdk.Context, consumerId string) ccv.ClientExpirySeverity {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToClientExpirySeverityKey(consumerId))
+	if bz == nil {
+		return ccv.CLIENT_EXPIRY_SEVERITY_NONE
+	}
+	return ccv.ClientExpirySeverity(sdk.BigEndianToUint64(bz))
+}
+
+// SetConsumerClientExpirySeverity sets the severity of the last client expiry warning of a consumer chain
+func (k Keeper) SetConsumerClientExpirySeverity(ctx sdk.Context, consumerId string, severity ccv.ClientExpirySeverity) {
+	if severity == ccv.CLIENT_EXPIRY_SEVERITY_NONE {
+		k.DeleteConsumerClientExpirySeverity(ctx, consumerId)
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToClientExpirySeverityKey(consumerId), sdk.Uint64ToBigEndian(uint64(severity)))
+}
+
+// DeleteConsumerClientExpirySeverity deletes the severity of the last client expiry warning of a consumer chain
+func (k Keeper) DeleteConsumerClientExpirySeverity(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToClientExpirySeverityKey(consumerId))
+}
+
+// EndBlockClientExpiry sets, for every launched consumer chain, the gauge of the seconds until
+// its client expires and emits a client expiry warning whenever the severity escalates,
+// i.e., once the client is within the warning window, within a quarter of it, and expired.
+// The severity is reset once the client is updated.
+func (k Keeper) EndBlockClientExpiry(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+
+		expiry, err := k.GetConsumerClientExpiry(ctx, consumerId)
+		if err != nil {
+			k.Logger(ctx).Error("cannot get consumer client expiry", "consumerId", consumerId, "error", err)
+			continue
+		}
+		ccv.SetConsumerGauge(types.ModuleName, ccv.MetricKeySecondsUntilClientExpiry, consumerId,
+			float32(expiry.Remaining.Seconds()))
+
+		previous := k.GetConsumerClientExpirySeverity(ctx, consumerId)
+		if expiry.Severity == previous {
+			continue
+		}
+		k.SetConsumerClientExpirySeverity(ctx, consumerId, expiry.Severity)
+		if expiry.Severity < previous {
+			continue
+		}
+
+		k.Logger(ctx).Error("consumer client is close to expiry",
+			"consumerId", consumerId,
+			"clientId", expiry.ClientId,
+			"severity", expiry.Severity,
+			"remaining", expiry.Remaining,
+		)
+		ccv.EmitClientExpiryWarningEvent(ctx, types.ModuleName, expiry,
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId))
+	}
+}
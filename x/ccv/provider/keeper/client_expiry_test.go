@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestEndBlockClientExpiry tests that a client expiry warning is emitted
+// whenever the severity of the expiry of a consumer client escalates
+func TestEndBlockClientExpiry(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.ClientExpiryWarningWindow = 4 * time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	latestConsensusTime := time.Unix(1000, 0).UTC()
+	trustingPeriod := 10 * time.Hour
+	expiryTime := latestConsensusTime.Add(trustingPeriod)
+
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		expSeverity ccv.ClientExpirySeverity
+		expEvent    bool
+	}{
+		{"outside of the warning window", expiryTime.Add(-5 * time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_NONE, false},
+		{"within the warning window", expiryTime.Add(-3 * time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_WARNING, true},
+		{"still within the warning window", expiryTime.Add(-2 * time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_WARNING, false},
+		{"within a quarter of the warning window", expiryTime.Add(-time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_CRITICAL, true},
+		{"expired", expiryTime, ccv.CLIENT_EXPIRY_SEVERITY_EXPIRED, true},
+		{"outside of the warning window again", expiryTime.Add(-6 * time.Hour), ccv.CLIENT_EXPIRY_SEVERITY_NONE, false},
+	}
+
+	for _, tc := range testCases {
+		ctx := ctx.WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientID").Return(
+			&ibctmtypes.ClientState{TrustingPeriod: trustingPeriod}, true).AnyTimes()
+		mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(ctx, "clientID").Return(
+			&ibctmtypes.ConsensusState{Timestamp: latestConsensusTime}, true).AnyTimes()
+
+		res, err := providerKeeper.QueryConsumerClientExpiry(ctx, &providertypes.QueryConsumerClientExpiryRequest{ConsumerId: CONSUMER_ID})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, expiryTime, res.ClientExpiry.ExpiryTime, tc.name)
+		require.Equal(t, expiryTime.Sub(tc.blockTime), res.ClientExpiry.Remaining, tc.name)
+		require.Equal(t, tc.expSeverity, res.ClientExpiry.Severity, tc.name)
+
+		providerKeeper.EndBlockClientExpiry(ctx)
+		require.Equal(t, tc.expSeverity, providerKeeper.GetConsumerClientExpirySeverity(ctx, CONSUMER_ID), tc.name)
+
+		events := ctx.EventManager().Events()
+		if !tc.expEvent {
+			require.Empty(t, events, tc.name)
+			continue
+		}
+		require.Len(t, events, 1, tc.name)
+		require.Equal(t, ccv.EventTypeClientExpiryWarning, events[0].Type, tc.name)
+		attr, found := events[0].GetAttribute(ccv.AttributeClientExpirySeverity)
+		require.True(t, found, tc.name)
+		require.Equal(t, tc.expSeverity.String(), attr.Value, tc.name)
+	}
+
+	_, err := providerKeeper.GetConsumerClientExpiry(ctx, "1")
+	require.ErrorIs(t, err, clienttypes.ErrClientNotFound)
+	_, err = providerKeeper.QueryConsumerClientExpiry(ctx, &providertypes.QueryConsumerClientExpiryRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerClientExpiry(ctx, nil)
+	require.Error(t, err)
+}
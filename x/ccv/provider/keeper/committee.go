@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// SetCommitteeMember adds the validator with `providerAddr` address to the committee of chain `consumerId`
+func (k Keeper) SetCommitteeMember(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CommitteeKey(consumerId, providerAddr), []byte{})
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+}
+
+// GetCommittee returns all the members of the committee of chain `consumerId`
+func (k Keeper) GetCommittee(
+	ctx sdk.Context,
+	consumerId string,
+) (providerConsAddresses []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.StringIdWithLenKey(types.CommitteeKeyPrefix(), consumerId)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerConsAddresses = append(providerConsAddresses, types.NewProviderConsAddress(iterator.Key()[len(key):]))
+	}
+
+	return providerConsAddresses
+}
+
+// IsCommitteeMember returns `true` if validator with `providerAddr` is a member of the committee of chain `consumerId`
+func (k Keeper) IsCommitteeMember(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.CommitteeKey(consumerId, providerAddr)) != nil
+}
+
+// DeleteCommittee deletes all the members of the committee of chain `consumerId`
+func (k Keeper) DeleteCommittee(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.CommitteeKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+}
+
+// IsCommitteeEmpty returns `true` if chain `consumerId` has no committee
+func (k Keeper) IsCommitteeEmpty(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.CommitteeKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	return !iterator.Valid()
+}
+
+// UpdateCommittee replaces the committee of the consumer chain with this consumer id
+func (k Keeper) UpdateCommittee(ctx sdk.Context, consumerId string, committee []string) {
+	k.DeleteCommittee(ctx, consumerId)
+	for _, address := range committee {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(address)
+		if err != nil {
+			continue
+		}
+
+		k.SetCommitteeMember(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+	}
+}
+
+// IntersectWithCommittee returns the `validators` that are members of the committee of chain `consumerId`,
+// or all the `validators` if the chain has no committee
+func (k Keeper) IntersectWithCommittee(
+	ctx sdk.Context,
+	consumerId string,
+	validators []types.ConsensusValidator,
+) []types.ConsensusValidator {
+	if k.IsCommitteeEmpty(ctx, consumerId) {
+		return validators
+	}
+
+	committeeValidators := []types.ConsensusValidator{}
+	for _, validator := range validators {
+		if k.IsCommitteeMember(ctx, consumerId, types.NewProviderConsAddress(validator.ProviderConsAddr)) {
+			committeeValidators = append(committeeValidators, validator)
+		}
+	}
+	return committeeValidators
+}
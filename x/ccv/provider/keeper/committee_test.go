@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestCommittee(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr1 := providertypes.NewProviderConsAddress([]byte("providerAddr1"))
+	providerAddr2 := providertypes.NewProviderConsAddress([]byte("providerAddr2"))
+
+	require.True(t, providerKeeper.IsCommitteeEmpty(ctx, CONSUMER_ID))
+	require.Empty(t, providerKeeper.GetCommittee(ctx, CONSUMER_ID))
+
+	providerKeeper.SetCommitteeMember(ctx, CONSUMER_ID, providerAddr1)
+	providerKeeper.SetCommitteeMember(ctx, CONSUMER_ID, providerAddr2)
+	require.False(t, providerKeeper.IsCommitteeEmpty(ctx, CONSUMER_ID))
+	require.True(t, providerKeeper.IsCommitteeMember(ctx, CONSUMER_ID, providerAddr1))
+	require.True(t, providerKeeper.IsCommitteeMember(ctx, CONSUMER_ID, providerAddr2))
+	require.ElementsMatch(t,
+		[]providertypes.ProviderConsAddress{providerAddr1, providerAddr2},
+		providerKeeper.GetCommittee(ctx, CONSUMER_ID))
+
+	// the committee of another consumer chain is not affected
+	require.True(t, providerKeeper.IsCommitteeEmpty(ctx, "1"))
+	require.False(t, providerKeeper.IsCommitteeMember(ctx, "1", providerAddr1))
+
+	// updating the committee replaces all its members and skips invalid addresses
+	providerKeeper.UpdateCommittee(ctx, CONSUMER_ID, []string{
+		providerKeeper.ConsAddressToString(providerAddr2.ToSdkConsAddr()),
+		"invalid",
+	})
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddr2}, providerKeeper.GetCommittee(ctx, CONSUMER_ID))
+
+	providerKeeper.DeleteCommittee(ctx, CONSUMER_ID)
+	require.True(t, providerKeeper.IsCommitteeEmpty(ctx, CONSUMER_ID))
+}
+
+// TestComputeNextValidatorsWithCommittee checks that the committee is intersected with the validators
+// selected by the validator-set cap, and that the power cap applies to the resulting validator set
+func TestComputeNextValidatorsWithCommittee(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 4
+	providerKeeper.SetParams(ctx, params)
+
+	vals, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 40, 30, 20, 10)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+
+	powerShapingParameters := providertypes.PowerShapingParameters{
+		ValidatorSetCap: 3,
+	}
+
+	// without a committee, the three validators with the most power are selected
+	nextVals, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
+	require.NoError(t, err)
+	require.Len(t, nextVals, 3)
+
+	// the committee cannot bring back the validator excluded by the validator-set cap
+	providerKeeper.SetCommitteeMember(ctx, CONSUMER_ID, consAddrs[1])
+	providerKeeper.SetCommitteeMember(ctx, CONSUMER_ID, consAddrs[2])
+	providerKeeper.SetCommitteeMember(ctx, CONSUMER_ID, consAddrs[3])
+	nextVals, err = providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
+	require.NoError(t, err)
+	require.Len(t, nextVals, 2)
+	require.Equal(t, consAddrs[1].ToSdkConsAddr().Bytes(), nextVals[0].ProviderConsAddr)
+	require.Equal(t, consAddrs[2].ToSdkConsAddr().Bytes(), nextVals[1].ProviderConsAddr)
+	require.Equal(t, int64(30), nextVals[0].Power)
+	require.Equal(t, int64(20), nextVals[1].Power)
+
+	// the power cap is computed over the committee members only
+	powerShapingParameters.ValidatorsPowerCap = 50
+	nextVals, err = providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, vals, powerShapingParameters, 0)
+	require.NoError(t, err)
+	require.Len(t, nextVals, 2)
+	require.Equal(t, nextVals[0].Power, nextVals[1].Power)
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"time"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetConsumerConnectionId returns the id of the connection (on the provider) underlying
+// the CCV channel of the consumer chain
+func (k Keeper) GetConsumerConnectionId(ctx sdk.Context, consumerId string) (string, error) {
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrUnknownConsumerChannelId, "no CCV channel found for consumer chain with id: %s", consumerId)
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, k.portID, channelId)
+	if !found {
+		return "", errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelId)
+	}
+	if len(channel.ConnectionHops) != 1 {
+		return "", errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to consumer chain")
+	}
+	return channel.ConnectionHops[0], nil
+}
+
+// NewMsgRegisterConsumerAdminAccount returns the message that registers, over the CCV connection of
+// the consumer chain, the interchain account owned by the provider authority (i.e., the x/gov module account).
+// Once the CCV channel is established, i.e., after the consumer chain launched or went through the changeover,
+// the message can be executed by a provider governance proposal. Consumer chains that allow the provider authority
+// as their admin (see the SetProviderAdmin method of the consumer keeper) accept the authority-gated messages
+// sent by this interchain account (see NewMsgSendConsumerAdminTx).
+func (k Keeper) NewMsgRegisterConsumerAdminAccount(
+	ctx sdk.Context,
+	consumerId string,
+) (*icacontrollertypes.MsgRegisterInterchainAccount, error) {
+	connectionId, err := k.GetConsumerConnectionId(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+	// the version is left empty for the controller to use the default metadata of the connection
+	return icacontrollertypes.NewMsgRegisterInterchainAccount(connectionId, k.GetAuthority(), "", channeltypes.ORDERED), nil
+}
+
+// NewMsgSendConsumerAdminTx returns the message that executes the given messages on the consumer chain
+// with the interchain account owned by the provider authority (see NewMsgRegisterConsumerAdminAccount).
+// The message can be executed by a provider governance proposal.
+func (k Keeper) NewMsgSendConsumerAdminTx(
+	ctx sdk.Context,
+	consumerId string,
+	msgs []sdk.Msg,
+	relativeTimeout time.Duration,
+) (*icacontrollertypes.MsgSendTx, error) {
+	connectionId, err := k.GetConsumerConnectionId(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+
+	msgAnys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		msgAnys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// the cosmos tx is encoded with protobuf, i.e., the default encoding of interchain accounts
+	cosmosTx := icatypes.CosmosTx{Messages: msgAnys}
+	data, err := cosmosTx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	if err := packetData.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return icacontrollertypes.NewMsgSendTx(k.GetAuthority(), connectionId, uint64(relativeTimeout.Nanoseconds()), packetData), nil
+}
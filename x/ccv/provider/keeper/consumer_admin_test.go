@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestConsumerAdminMsgs tests the messages executed by provider governance through the consumer admin account
+func TestConsumerAdminMsgs(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+
+	// no CCV channel
+	_, err := providerKeeper.NewMsgRegisterConsumerAdminAccount(ctx, consumerId)
+	require.Error(t, err)
+	_, err = providerKeeper.NewMsgSendConsumerAdminTx(ctx, consumerId, []sdk.Msg{}, time.Hour)
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channel-0")
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, gomock.Any(), "channel-0").Return(
+		channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true,
+	).AnyTimes()
+
+	msgRegister, err := providerKeeper.NewMsgRegisterConsumerAdminAccount(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "connection-0", msgRegister.ConnectionId)
+	require.Equal(t, providerKeeper.GetAuthority(), msgRegister.Owner)
+	require.Equal(t, channeltypes.ORDERED, msgRegister.Ordering)
+
+	msgUpdateParams := &consumertypes.MsgUpdateParams{
+		Authority: "cosmos1ica",
+		Params:    ccv.DefaultParams(),
+	}
+	msgSendTx, err := providerKeeper.NewMsgSendConsumerAdminTx(ctx, consumerId, []sdk.Msg{msgUpdateParams}, time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, "connection-0", msgSendTx.ConnectionId)
+	require.Equal(t, providerKeeper.GetAuthority(), msgSendTx.Owner)
+	require.Equal(t, uint64(time.Hour.Nanoseconds()), msgSendTx.RelativeTimeout)
+	require.Equal(t, icatypes.EXECUTE_TX, msgSendTx.PacketData.Type)
+
+	var cosmosTx icatypes.CosmosTx
+	require.NoError(t, cosmosTx.Unmarshal(msgSendTx.PacketData.Data))
+	require.Len(t, cosmosTx.Messages, 1)
+	require.Equal(t, sdk.MsgTypeURL(msgUpdateParams), cosmosTx.Messages[0].TypeUrl)
+}
@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetConsumerDowntimeJail records that the validator with `providerAddr` was jailed
+// due to a downtime slash packet received from chain `consumerId`
+func (k Keeper) SetConsumerDowntimeJail(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	jail types.ConsumerDowntimeJail,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := jail.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// jail is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal consumer downtime jail: %w", err))
+	}
+	store.Set(types.ConsumerDowntimeJailKey(consumerId, providerAddr), bz)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerDowntimeJailRecorded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(jail.ValsetUpdateId, 10)),
+			sdk.NewAttribute(types.AttributeInfractionHeight, strconv.FormatUint(jail.InfractionHeight, 10)),
+			sdk.NewAttribute(types.AttributeJailEndTime, jail.JailEndTime.String()),
+		),
+	)
+}
+
+// GetConsumerDowntimeJail returns the record of the validator with `providerAddr` being jailed
+// due to a downtime slash packet received from chain `consumerId`, if any
+func (k Keeper) GetConsumerDowntimeJail(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (types.ConsumerDowntimeJail, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerDowntimeJailKey(consumerId, providerAddr))
+	if bz == nil {
+		return types.ConsumerDowntimeJail{}, false
+	}
+	var jail types.ConsumerDowntimeJail
+	if err := jail.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the jail is assumed to be correctly serialized in SetConsumerDowntimeJail.
+		panic(fmt.Errorf("cannot unmarshal consumer downtime jail: %w", err))
+	}
+	return jail, true
+}
+
+// DeleteConsumerDowntimeJail deletes the record of the validator with `providerAddr` being jailed
+// due to a downtime slash packet received from chain `consumerId`
+func (k Keeper) DeleteConsumerDowntimeJail(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ConsumerDowntimeJailKey(consumerId, providerAddr)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerDowntimeJailRemoved,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+		),
+	)
+}
+
+// DeleteAllConsumerDowntimeJails deletes all the records of validators being jailed
+// due to downtime slash packets received from chain `consumerId`
+func (k Keeper) DeleteAllConsumerDowntimeJails(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerDowntimeJailKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// RevertConsumerSlash unjails the validator with `providerAddr` that was jailed due to a downtime slash packet
+// received from chain `consumerId` and deletes the record of the jailing. It fails if the validator is not jailed,
+// is tombstoned, if it was jailed again since (e.g., due to downtime on the provider chain),
+// or if its self-delegation is below its min self-delegation (as for unjailing through x/slashing).
+// Note that the tokens slashed when the validator was jailed are not restored.
+func (k Keeper) RevertConsumerSlash(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (types.ConsumerDowntimeJail, error) {
+	jail, found := k.GetConsumerDowntimeJail(ctx, consumerId, providerAddr)
+	if !found {
+		return types.ConsumerDowntimeJail{}, errorsmod.Wrapf(types.ErrNoConsumerDowntimeJail,
+			"no downtime jailing of validator %s by consumer chain %s", providerAddr.String(), consumerId)
+	}
+
+	consAddr := providerAddr.ToSdkConsAddr()
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return types.ConsumerDowntimeJail{}, err
+	}
+	if !validator.IsJailed() {
+		return types.ConsumerDowntimeJail{}, errorsmod.Wrapf(types.ErrNoConsumerDowntimeJail,
+			"validator %s is not jailed", providerAddr.String())
+	}
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return types.ConsumerDowntimeJail{}, errorsmod.Wrapf(types.ErrNoConsumerDowntimeJail,
+			"validator %s is tombstoned", providerAddr.String())
+	}
+
+	// the validator can only be unjailed if it is still jailed due to the consumer downtime
+	signingInfo, err := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return types.ConsumerDowntimeJail{}, err
+	}
+	if !signingInfo.JailedUntil.Equal(jail.JailEndTime) {
+		return types.ConsumerDowntimeJail{}, errorsmod.Wrapf(types.ErrNoConsumerDowntimeJail,
+			"validator %s is jailed until %s and not until %s", providerAddr.String(), signingInfo.JailedUntil, jail.JailEndTime)
+	}
+
+	// as in x/slashing, the validator can only be unjailed if it self-delegates at least its min self-delegation
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return types.ConsumerDowntimeJail{}, err
+	}
+	selfDelegation, err := k.stakingKeeper.Delegation(ctx, sdk.AccAddress(valAddr), valAddr)
+	if err != nil || selfDelegation == nil {
+		return types.ConsumerDowntimeJail{}, errorsmod.Wrapf(slashingtypes.ErrMissingSelfDelegation,
+			"validator %s", providerAddr.String())
+	}
+	selfBond := validator.TokensFromShares(selfDelegation.GetShares()).TruncateInt()
+	if selfBond.IsZero() || selfBond.LT(validator.MinSelfDelegation) {
+		return types.ConsumerDowntimeJail{}, errorsmod.Wrapf(slashingtypes.ErrSelfDelegationTooLowToUnjail,
+			"validator %s: %s less than %s", providerAddr.String(), selfBond, validator.MinSelfDelegation)
+	}
+
+	// end the jail period, so that the signing info is consistent with the validator being unjailed
+	if err := k.slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime()); err != nil {
+		return types.ConsumerDowntimeJail{}, err
+	}
+	if err := k.stakingKeeper.Unjail(ctx, consAddr); err != nil {
+		return types.ConsumerDowntimeJail{}, err
+	}
+
+	k.DeleteConsumerDowntimeJail(ctx, consumerId, providerAddr)
+
+	return jail, nil
+}
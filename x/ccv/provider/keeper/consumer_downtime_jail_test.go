@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestConsumerDowntimeJail(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddrA := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	providerAddrB := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ProviderConsAddress()
+
+	_, found := providerKeeper.GetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrA)
+	require.False(t, found)
+
+	jail := providertypes.ConsumerDowntimeJail{
+		ValsetUpdateId:   5,
+		InfractionHeight: 10,
+		JailTime:         time.Unix(100, 0).UTC(),
+		JailEndTime:      time.Unix(200, 0).UTC(),
+	}
+	providerKeeper.SetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrA, jail)
+	providerKeeper.SetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrB, jail)
+	providerKeeper.SetConsumerDowntimeJail(ctx, "1", providerAddrA, jail)
+
+	actualJail, found := providerKeeper.GetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrA)
+	require.True(t, found)
+	require.Equal(t, jail, actualJail)
+
+	providerKeeper.DeleteConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrA)
+	_, found = providerKeeper.GetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrA)
+	require.False(t, found)
+
+	// deleting the records of a consumer chain does not affect the other consumer chains
+	providerKeeper.DeleteAllConsumerDowntimeJails(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddrB)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerDowntimeJail(ctx, "1", providerAddrA)
+	require.True(t, found)
+}
+
+func TestRevertConsumerSlash(t *testing.T) {
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	consAddr := providerAddr.ToSdkConsAddr()
+	valAddr := sdk.ValAddress(consAddr)
+	jailEndTime := time.Unix(200, 0).UTC()
+	jailedValidator := stakingtypes.Validator{
+		OperatorAddress:   valAddr.String(),
+		Jailed:            true,
+		Tokens:            math.NewInt(100),
+		DelegatorShares:   math.LegacyNewDec(100),
+		MinSelfDelegation: math.NewInt(10),
+	}
+
+	testCases := []struct {
+		name          string
+		setup         func(mocks testkeeper.MockedKeepers)
+		expectedError error
+	}{
+		{
+			"validator not jailed",
+			func(mocks testkeeper.MockedKeepers) {
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).
+					Return(stakingtypes.Validator{Jailed: false}, nil)
+			},
+			providertypes.ErrNoConsumerDowntimeJail,
+		},
+		{
+			"validator tombstoned",
+			func(mocks testkeeper.MockedKeepers) {
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).
+					Return(stakingtypes.Validator{Jailed: true}, nil)
+				mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(true)
+			},
+			providertypes.ErrNoConsumerDowntimeJail,
+		},
+		{
+			"validator jailed again since",
+			func(mocks testkeeper.MockedKeepers) {
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).
+					Return(stakingtypes.Validator{Jailed: true}, nil)
+				mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddr).
+					Return(slashingtypes.ValidatorSigningInfo{JailedUntil: jailEndTime.Add(time.Hour)}, nil)
+			},
+			providertypes.ErrNoConsumerDowntimeJail,
+		},
+		{
+			"validator without self-delegation",
+			func(mocks testkeeper.MockedKeepers) {
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).Return(jailedValidator, nil)
+				mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddr).
+					Return(slashingtypes.ValidatorSigningInfo{JailedUntil: jailEndTime}, nil)
+				mocks.MockStakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).
+					Return(nil, stakingtypes.ErrNoDelegation)
+			},
+			slashingtypes.ErrMissingSelfDelegation,
+		},
+		{
+			"validator with self-delegation below min self-delegation",
+			func(mocks testkeeper.MockedKeepers) {
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).Return(jailedValidator, nil)
+				mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false)
+				mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddr).
+					Return(slashingtypes.ValidatorSigningInfo{JailedUntil: jailEndTime}, nil)
+				mocks.MockStakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).
+					Return(stakingtypes.Delegation{Shares: math.LegacyNewDec(9)}, nil)
+			},
+			slashingtypes.ErrSelfDelegationTooLowToUnjail,
+		},
+		{
+			"validator unjailed",
+			func(mocks testkeeper.MockedKeepers) {
+				gomock.InOrder(
+					mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).Return(jailedValidator, nil),
+					mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), consAddr).Return(false),
+					mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddr).
+						Return(slashingtypes.ValidatorSigningInfo{JailedUntil: jailEndTime}, nil),
+					mocks.MockStakingKeeper.EXPECT().Delegation(gomock.Any(), sdk.AccAddress(valAddr), valAddr).
+						Return(stakingtypes.Delegation{Shares: math.LegacyNewDec(10)}, nil),
+					mocks.MockSlashingKeeper.EXPECT().JailUntil(gomock.Any(), consAddr, gomock.Any()).Return(nil),
+					mocks.MockStakingKeeper.EXPECT().Unjail(gomock.Any(), consAddr).Return(nil),
+				)
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			// nothing to revert if the validator was not jailed by the consumer chain
+			_, err := providerKeeper.RevertConsumerSlash(ctx, CONSUMER_ID, providerAddr)
+			require.ErrorIs(t, err, providertypes.ErrNoConsumerDowntimeJail)
+
+			jail := providertypes.ConsumerDowntimeJail{ValsetUpdateId: 5, InfractionHeight: 10, JailEndTime: jailEndTime}
+			providerKeeper.SetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddr, jail)
+
+			tc.setup(mocks)
+			revertedJail, err := providerKeeper.RevertConsumerSlash(ctx, CONSUMER_ID, providerAddr)
+			_, found := providerKeeper.GetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddr)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.True(t, found)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, jail, revertedJail)
+				require.False(t, found)
+			}
+		})
+	}
+}
@@ -445,6 +445,45 @@ func TestJailAndTombstoneValidator(t *testing.T) {
 	}
 }
 
+// TestJailAndTombstoneValidatorWithStakingKeepers tests `JailAndTombstoneValidator`
+// against real in-memory staking and slashing keepers
+func TestJailAndTombstoneValidatorWithStakingKeepers(t *testing.T) {
+	providerKeeper, ctx, ctrl, _, stakingKeepers := testkeeper.GetProviderKeeperAndCtxWithStakingKeepers(t)
+	defer ctrl.Finish()
+
+	valA := stakingKeepers.CreateValidator(t, ctx, 30)
+	valB := stakingKeepers.CreateValidator(t, ctx, 20)
+	require.Len(t, stakingKeepers.EndBlock(t, ctx), 2)
+
+	valAConsAddr, err := valA.GetConsAddr()
+	require.NoError(t, err)
+	providerAddr := types.NewProviderConsAddress(valAConsAddr)
+
+	err = providerKeeper.JailAndTombstoneValidator(ctx, providerAddr, getTestInfractionParameters().DoubleSign)
+	require.NoError(t, err)
+
+	validator, err := stakingKeepers.StakingKeeper.GetValidatorByConsAddr(ctx, valAConsAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	require.True(t, stakingKeepers.SlashingKeeper.IsTombstoned(ctx, valAConsAddr))
+	signingInfo, err := stakingKeepers.SlashingKeeper.GetValidatorSigningInfo(ctx, valAConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(getTestInfractionParameters().DoubleSign.JailDuration), signingInfo.JailedUntil)
+
+	// the jailed validator is removed from the bonded validators
+	updates := stakingKeepers.EndBlock(t, ctx)
+	require.Len(t, updates, 1)
+	require.Zero(t, updates[0].Power)
+	bondedValidators, err := providerKeeper.GetLastBondedValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, bondedValidators, 1)
+	require.Equal(t, valB.OperatorAddress, bondedValidators[0].OperatorAddress)
+
+	// the validator cannot be jailed and tombstoned again
+	err = providerKeeper.JailAndTombstoneValidator(ctx, providerAddr, getTestInfractionParameters().DoubleSign)
+	require.Error(t, err)
+}
+
 // createUndelegation creates an undelegation with `len(initialBalances)` entries
 func createUndelegation(initialBalances []int64, completionTimes []time.Time) stakingtypes.UnbondingDelegation {
 	var entries []stakingtypes.UnbondingDelegationEntry
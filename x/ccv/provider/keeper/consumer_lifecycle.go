@@ -19,7 +19,6 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
-	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -80,16 +79,11 @@ func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to laumch: %s", err.Error())
 	}
 	if len(consumerIds) > 0 {
-		// get the bonded validators from the staking module
-		bondedValidators, err = k.GetLastBondedValidators(ctx)
+		// get the bonded and the provider active validators from the staking module
+		bondedValidators, activeValidators, err = k.GetLastBondedAndActiveValidators(ctx)
 		if err != nil {
 			return fmt.Errorf("getting last bonded validators: %w", err)
 		}
-		// get the provider active validators
-		activeValidators, err = k.GetLastProviderConsensusActiveValidators(ctx)
-		if err != nil {
-			return fmt.Errorf("getting last provider active validators: %w", err)
-		}
 	}
 
 	for _, consumerId := range consumerIds {
@@ -220,31 +214,27 @@ func (k Keeper) HasActiveConsumerValidator(ctx sdk.Context, consumerId string, a
 }
 
 // LaunchConsumer launches the chain with the provided consumer id by creating the consumer client and the respective
-// consumer genesis file
-//
-// TODO add unit test for LaunchConsumer
+// consumer genesis file. The launch consists of the following steps, which are exposed as separate methods
+// so that custom provider apps and modules can orchestrate launches programmatically:
+//   - ValidateConsumerInitialization checks that the consumer chain can be launched;
+//   - ComputeConsumerInitialValSet computes and validates the initial validator set of the consumer chain;
+//   - MakeConsumerGenesis creates the consumer genesis state, which is then stored with SetConsumerGenesis;
+//   - CreateConsumerClient creates the consumer client (unless an existing client or connection is used);
+//   - CompleteConsumerLaunch records the initial validator set and moves the consumer chain to the Launched phase.
 func (k Keeper) LaunchConsumer(
 	ctx sdk.Context,
 	bondedValidators []stakingtypes.Validator,
 	activeValidators []stakingtypes.Validator,
 	consumerId string,
 ) error {
-	// compute consumer initial validator set
-	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
-	if err != nil {
-		return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
-	}
-
-	if len(initialValUpdates) == 0 {
-		return fmt.Errorf("cannot launch consumer with no consumer validator, consumerId(%s)", consumerId)
+	if err := k.ValidateConsumerInitialization(ctx, consumerId); err != nil {
+		return err
 	}
 
-	hasActiveConsumerValidator, err := k.HasActiveConsumerValidator(ctx, consumerId, activeValidators)
+	// compute consumer initial validator set
+	initialValUpdates, err := k.ComputeConsumerInitialValSet(ctx, consumerId, bondedValidators, activeValidators)
 	if err != nil {
-		return fmt.Errorf("cannot check if chain has an active consumer validator, consumerId(%s): %w", consumerId, err)
-	}
-	if !hasActiveConsumerValidator {
-		return fmt.Errorf("cannot launch consumer with no active consumer validator, consumerId(%s)", consumerId)
+		return err
 	}
 
 	// create consumer genesis
@@ -258,11 +248,10 @@ func (k Keeper) LaunchConsumer(
 	}
 
 	// compute the hash of the consumer initial validator updates
-	updatesAsValSet, err := tmtypes.PB2TM.ValidatorUpdates(initialValUpdates)
+	valsetHash, err := ccv.ComputeValsetHash(initialValUpdates)
 	if err != nil {
 		return fmt.Errorf("unable to create initial validator set from initial validator updates: %w", err)
 	}
-	valsetHash := tmtypes.NewValidatorSet(updatesAsValSet).Hash()
 
 	// create the consumer client and the genesis
 	err = k.CreateConsumerClient(ctx, consumerId, valsetHash)
@@ -270,7 +259,9 @@ func (k Keeper) LaunchConsumer(
 		return fmt.Errorf("crating consumer client, consumerId(%s): %w", consumerId, err)
 	}
 
-	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	if err := k.CompleteConsumerLaunch(ctx, consumerId); err != nil {
+		return err
+	}
 
 	k.Logger(ctx).Info("consumer successfully launched",
 		"consumerId", consumerId,
@@ -281,6 +272,91 @@ func (k Keeper) LaunchConsumer(
 	return nil
 }
 
+// ValidateConsumerInitialization checks that the consumer chain with the provided consumer id can be launched,
+// i.e., that it is in the Initialized phase and that its initialization parameters are valid
+func (k Keeper) ValidateConsumerInitialization(ctx sdk.Context, consumerId string) error {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase != types.CONSUMER_PHASE_INITIALIZED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot launch consumer chain that is not in the Initialized phase but in phase %s: %s", phase, consumerId)
+	}
+
+	initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+	if err := types.ValidateInitializationParameters(initializationRecord); err != nil {
+		return err
+	}
+	if initializationRecord.SpawnTime.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
+			"cannot launch consumer chain without spawn time: %s", consumerId)
+	}
+	return nil
+}
+
+// ComputeConsumerInitialValSet computes the initial validator set of the consumer chain with the provided consumer id,
+// given the provider bonded and active validators, and returns the corresponding validator updates.
+// It returns an error if the consumer chain cannot launch with this validator set (see ValidateInitialValSet).
+func (k Keeper) ComputeConsumerInitialValSet(
+	ctx sdk.Context,
+	consumerId string,
+	bondedValidators []stakingtypes.Validator,
+	activeValidators []stakingtypes.Validator,
+) ([]abci.ValidatorUpdate, error) {
+	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
+	if err != nil {
+		return nil, fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	if err := k.ValidateInitialValSet(ctx, consumerId, initialValUpdates, activeValidators); err != nil {
+		return nil, err
+	}
+	return initialValUpdates, nil
+}
+
+// CompleteConsumerLaunch completes the launch of the consumer chain with the provided consumer id, once its
+// genesis state is stored and its client is created, by recording its initial validator set and
+// moving it to the Launched phase
+func (k Keeper) CompleteConsumerLaunch(ctx sdk.Context, consumerId string) error {
+	// record the initial validator set under VSC id 0, i.e.,
+	// the VSC id the consumer chain uses until it receives the first VSC packet
+	if err := k.RecordValsetSnapshot(ctx, consumerId, 0); err != nil {
+		return err
+	}
+	initialValsetHash, err := k.GetConsumerValsetHash(ctx, consumerId)
+	if err != nil {
+		return fmt.Errorf("computing consumer valset hash, consumerId(%s): %w", consumerId, err)
+	}
+	k.SetConsumerValsetCheckpoint(ctx, consumerId, ccv.NewValsetCheckpointPacketData(0, initialValsetHash))
+
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	return nil
+}
+
+// ValidateInitialValSet checks that a consumer chain can launch with the given initial validator updates,
+// i.e., that the initial validator set is not empty and contains at least one provider active validator
+func (k Keeper) ValidateInitialValSet(
+	ctx sdk.Context,
+	consumerId string,
+	initialValUpdates []abci.ValidatorUpdate,
+	activeValidators []stakingtypes.Validator,
+) error {
+	if len(initialValUpdates) == 0 {
+		return fmt.Errorf("cannot launch consumer with no consumer validator, consumerId(%s)", consumerId)
+	}
+
+	hasActiveConsumerValidator, err := k.HasActiveConsumerValidator(ctx, consumerId, activeValidators)
+	if err != nil {
+		return fmt.Errorf("cannot check if chain has an active consumer validator, consumerId(%s): %w", consumerId, err)
+	}
+	if !hasActiveConsumerValidator {
+		return fmt.Errorf("cannot launch consumer with no active consumer validator, consumerId(%s)", consumerId)
+	}
+	return nil
+}
+
 // CreateConsumerClient will create the CCV client for the given consumer chain. The CCV channel must be built
 // on top of the CCV client to ensure connection with the right consumer chain.
 func (k Keeper) CreateConsumerClient(
@@ -309,6 +385,27 @@ func (k Keeper) CreateConsumerClient(
 		return err
 	}
 
+	if initializationRecord.ClientId != "" {
+		// there is no need to create a client if the client ID is provided
+		// as the CCV connection and channel will be built on top of the existing client;
+		// the client is validated again as it might have changed since the consumer was initialized
+		tmClient, err := k.ValidateExistingConsumerClient(ctx, consumerId, chainId,
+			initializationRecord.ClientId, initializationRecord.UnbondingPeriod)
+		if err != nil {
+			return err
+		}
+		k.SetConsumerClientId(ctx, consumerId, initializationRecord.ClientId)
+
+		// Set minimum height for equivocation evidence from this consumer chain
+		k.SetEquivocationEvidenceMinHeight(ctx, consumerId, tmClient.LatestHeight.RevisionHeight)
+
+		k.Logger(ctx).Info("use existing client for consumer chain",
+			"consumer id", consumerId,
+			"client id", initializationRecord.ClientId,
+		)
+		return nil
+	}
+
 	// Set minimum height for equivocation evidence from this consumer chain
 	k.SetEquivocationEvidenceMinHeight(ctx, consumerId, initializationRecord.InitialHeight.RevisionHeight)
 
@@ -372,6 +469,52 @@ func (k Keeper) CreateConsumerClient(
 	return nil
 }
 
+// ValidateExistingConsumerClient validates that the existing client with the given id can be used
+// as the client of the consumer chain with the given id, i.e., that it is an active 07-tendermint client
+// that tracks the given chain ID with the given unbonding period and that is not used by another consumer chain
+func (k Keeper) ValidateExistingConsumerClient(
+	ctx sdk.Context,
+	consumerId string,
+	chainId string,
+	clientId string,
+	unbondingPeriod time.Duration,
+) (*ibctmtypes.ClientState, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientId)
+	if !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "could not find client(%s)", clientId)
+	}
+	tmClient, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClientType,
+			"invalid client type. expected %s, got %s",
+			ibchost.Tendermint, clientState.ClientType(),
+		)
+	}
+	if tmClient.ChainId != chainId {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClient,
+			"invalid client(%s): expected chain ID %s, got %s", clientId, chainId, tmClient.ChainId)
+	}
+	if tmClient.UnbondingPeriod != unbondingPeriod {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClient,
+			"invalid client(%s): expected unbonding period %s, got %s", clientId, unbondingPeriod, tmClient.UnbondingPeriod)
+	}
+	if !tmClient.FrozenHeight.IsZero() {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client(%s) is frozen", clientId)
+	}
+	expiry, err := ccv.GetClientExpiry(ctx, k.clientKeeper, clientId, 0)
+	if err != nil {
+		return nil, err
+	}
+	if expiry.Severity == ccv.CLIENT_EXPIRY_SEVERITY_EXPIRED {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client(%s) expired at %s", clientId, expiry.ExpiryTime)
+	}
+	if otherConsumerId, found := k.GetClientIdToConsumerId(ctx, clientId); found && otherConsumerId != consumerId {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClient,
+			"client(%s) is already used by consumer chain with id %s", clientId, otherConsumerId)
+	}
+	return tmClient, nil
+}
+
 // MakeConsumerGenesis returns the created consumer genesis state for consumer chain `consumerId`,
 // as well as the validator hash of the initial validator set of the consumer chain
 func (k Keeper) MakeConsumerGenesis(
@@ -400,6 +543,7 @@ func (k Keeper) MakeConsumerGenesis(
 		[]string{},
 		ccv.DefaultRetryDelayPeriod,
 		consumerId,
+		ccv.DefaultClientExpiryWarningWindow,
 	)
 
 	var clientState *ibctmtypes.ClientState = nil
@@ -500,6 +644,11 @@ func (k Keeper) MakeConsumerGenesis(
 		counterpartyConnectionId,
 		consumerGenesisParams,
 	)
+	// include the genesis hash and initial height the consumer chain committed to,
+	// so that the consumer CCV module can verify its genesis hash on InitGenesis, if required
+	gen.GenesisHash = initializationRecord.GenesisHash
+	gen.InitialHeight = initializationRecord.InitialHeight
+	gen.VerifyGenesisHash = initializationRecord.VerifyGenesisHash
 
 	return gen, nil
 }
@@ -562,17 +711,10 @@ func (k Keeper) BeginBlockRemoveConsumers(ctx sdk.Context) error {
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to stop: %s", err.Error())
 	}
 	for _, consumerId := range consumerIds {
-		// delete consumer chain in a cached context to abort deletion in case of errors
-		cachedCtx, writeFn := ctx.CacheContext()
-		err = k.DeleteConsumerChain(cachedCtx, consumerId)
-		if err != nil {
-			k.Logger(ctx).Error("consumer chain could not be removed",
-				"consumerId", consumerId,
-				"error", err.Error())
-			continue
-		}
-
-		writeFn()
+		// delete consumer chain in an isolated context to abort deletion in case of errors
+		k.ExecuteIsolated(ctx, consumerId, "remove consumer", func(ctx sdk.Context) error {
+			return k.DeleteConsumerChain(ctx, consumerId)
+		})
 	}
 	return nil
 }
@@ -590,13 +732,14 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	// Note: this call panics if the key assignment state is invalid
 	k.DeleteKeyAssignments(ctx, consumerId)
 	k.DeleteMinimumPowerInTopN(ctx, consumerId)
+	k.DeleteConsumerFailureHeight(ctx, consumerId)
 	k.DeleteEquivocationEvidenceMinHeight(ctx, consumerId)
 
 	// close channel and delete the mappings between chain ID and channel ID
 	if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
 		// Close the channel for the given channel ID on the condition
 		// that the channel exists and isn't already in the CLOSED state
-		channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID)
+		channel, found := k.channelKeeper.GetChannel(ctx, k.portID, channelID)
 		if found && channel.State != channeltypes.CLOSED {
 			err := k.chanCloseInit(ctx, channelID)
 			if err != nil {
@@ -616,16 +759,33 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	for _, addr := range provAddrs {
 		k.DeleteConsumerCommissionRate(ctx, consumerId, addr)
 	}
+	k.DeleteConsumerMinCommissionRate(ctx, consumerId)
+	k.DeleteConsumerDowntimeParams(ctx, consumerId)
+	k.DeleteConsumerCCVTimeoutPeriod(ctx, consumerId)
 
 	k.DeleteInitChainHeight(ctx, consumerId)
 	k.DeleteSlashAcks(ctx, consumerId)
 	k.DeletePendingVSCPackets(ctx, consumerId)
+	k.DeleteVscConfirmations(ctx, consumerId)
+	k.DeleteConsumerValsetCheckpoint(ctx, consumerId)
+	k.DeleteRelayerLiveness(ctx, consumerId)
+	k.DeleteConsumerClientExpirySeverity(ctx, consumerId)
+	k.DeleteAllProjectedDropOffs(ctx, consumerId)
+	k.DeleteValsetHistory(ctx, consumerId)
+	k.DeleteSlashPacketTraces(ctx, consumerId)
+	k.DeleteConsumerUpdateHistory(ctx, consumerId)
+	k.DeleteVSCHistory(ctx, consumerId)
+	k.DeleteConsumerUpgradePlan(ctx, consumerId)
 
 	k.DeleteAllowlist(ctx, consumerId)
 	k.DeleteDenylist(ctx, consumerId)
 	k.DeleteAllOptedIn(ctx, consumerId)
 	k.DeleteConsumerValSet(ctx, consumerId)
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+	k.DeleteConsumerValSetResync(ctx, consumerId)
 	k.DeletePrioritylist(ctx, consumerId)
+	k.DeleteCommittee(ctx, consumerId)
+	k.DeleteAllConsumerDowntimeJails(ctx, consumerId)
 
 	k.DeleteConsumerRemovalTime(ctx, consumerId)
 
@@ -483,7 +483,7 @@ func TestHasActiveValidatorOptedIn(t *testing.T) {
 		power := int64(NumberOfBondedValidators - i)
 		bondedValidators = append(bondedValidators, createStakingValidator(ctx, mocks, power, i))
 	}
-	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(bondedValidators, nil).AnyTimes()
+	testkeeper.MockIterateBondedValidatorsByPower(mocks.MockStakingKeeper, bondedValidators).AnyTimes()
 
 	// get the consensus addresses of the previously-set bonded validators
 	var consensusAddresses [][]byte
@@ -536,6 +536,44 @@ func TestHasActiveValidatorOptedIn(t *testing.T) {
 	require.True(t, hasActiveValidatorOptedIn)
 }
 
+// TestValidateConsumerInitialization tests that only initialized consumer chains
+// with valid initialization parameters can be launched
+func TestValidateConsumerInitialization(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// no initialization parameters
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	require.Error(t, providerKeeper.ValidateConsumerInitialization(ctx, CONSUMER_ID))
+
+	initializationParameters := testkeeper.GetTestInitializationParameters()
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters))
+	require.NoError(t, providerKeeper.ValidateConsumerInitialization(ctx, CONSUMER_ID))
+
+	// no spawn time
+	initializationParameters.SpawnTime = time.Time{}
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters))
+	require.Error(t, providerKeeper.ValidateConsumerInitialization(ctx, CONSUMER_ID))
+
+	// invalid initialization parameters
+	initializationParameters = testkeeper.GetTestInitializationParameters()
+	initializationParameters.UnbondingPeriod = 0
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters))
+	require.Error(t, providerKeeper.ValidateConsumerInitialization(ctx, CONSUMER_ID))
+
+	// not in the Initialized phase
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters()))
+	for _, phase := range []providertypes.ConsumerPhase{
+		providertypes.CONSUMER_PHASE_REGISTERED,
+		providertypes.CONSUMER_PHASE_LAUNCHED,
+		providertypes.CONSUMER_PHASE_STOPPED,
+	} {
+		providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, phase)
+		require.ErrorIs(t, providerKeeper.ValidateConsumerInitialization(ctx, CONSUMER_ID), providertypes.ErrInvalidPhase)
+	}
+}
+
 func TestCreateConsumerClient(t *testing.T) {
 	type testCase struct {
 		description string
@@ -599,6 +637,90 @@ func TestCreateConsumerClient(t *testing.T) {
 	}
 }
 
+// TestCreateConsumerClientWithExistingClient tests that CreateConsumerClient uses the existing client
+// referenced by the initialization parameters, provided that it can be used for the consumer chain
+func TestCreateConsumerClientWithExistingClient(t *testing.T) {
+	existingClientId := "07-tendermint-1"
+	unbondingPeriod := ccvtypes.DefaultConsumerUnbondingPeriod
+
+	testCases := []struct {
+		name string
+		// modifies the existing client state and the provider state
+		setup    func(*providerkeeper.Keeper, sdk.Context, *ibctmtypes.ClientState)
+		expError bool
+	}{
+		{
+			"valid existing client", func(*providerkeeper.Keeper, sdk.Context, *ibctmtypes.ClientState) {}, false,
+		},
+		{
+			"client of another chain", func(_ *providerkeeper.Keeper, _ sdk.Context, cs *ibctmtypes.ClientState) {
+				cs.ChainId = "other-chain"
+			}, true,
+		},
+		{
+			"client with another unbonding period", func(_ *providerkeeper.Keeper, _ sdk.Context, cs *ibctmtypes.ClientState) {
+				cs.UnbondingPeriod = unbondingPeriod + time.Hour
+			}, true,
+		},
+		{
+			"frozen client", func(_ *providerkeeper.Keeper, _ sdk.Context, cs *ibctmtypes.ClientState) {
+				cs.FrozenHeight = clienttypes.NewHeight(0, 1)
+			}, true,
+		},
+		{
+			"expired client", func(_ *providerkeeper.Keeper, _ sdk.Context, cs *ibctmtypes.ClientState) {
+				cs.TrustingPeriod = time.Second
+			}, true,
+		},
+		{
+			"client used by another consumer chain", func(pk *providerkeeper.Keeper, ctx sdk.Context, _ *ibctmtypes.ClientState) {
+				pk.SetConsumerClientId(ctx, "1", existingClientId)
+			}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		ctx = ctx.WithBlockTime(time.Now().UTC())
+
+		providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+		providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+		initializationParameters := testkeeper.GetTestInitializationParameters()
+		initializationParameters.ClientId = existingClientId
+		require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters))
+
+		clientState := &ibctmtypes.ClientState{
+			ChainId:         CONSUMER_CHAIN_ID,
+			TrustingPeriod:  unbondingPeriod / 2,
+			UnbondingPeriod: unbondingPeriod,
+			LatestHeight:    clienttypes.NewHeight(0, 10),
+		}
+		tc.setup(&providerKeeper, ctx, clientState)
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, existingClientId).Return(clientState, true).AnyTimes()
+		mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(ctx, existingClientId).Return(
+			&ibctmtypes.ConsensusState{Timestamp: ctx.BlockTime().Add(-time.Minute)}, true,
+		).AnyTimes()
+
+		// no client is created
+		mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		err := providerKeeper.CreateConsumerClient(ctx, CONSUMER_ID, []byte{})
+		if tc.expError {
+			require.Error(t, err, tc.name)
+			_, found := providerKeeper.GetConsumerClientId(ctx, CONSUMER_ID)
+			require.False(t, found, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			clientId, found := providerKeeper.GetConsumerClientId(ctx, CONSUMER_ID)
+			require.True(t, found, tc.name)
+			require.Equal(t, existingClientId, clientId, tc.name)
+			require.Equal(t, uint64(10), providerKeeper.GetEquivocationEvidenceMinHeight(ctx, CONSUMER_ID), tc.name)
+		}
+
+		ctrl.Finish()
+	}
+}
+
 // TestMakeConsumerGenesis tests the MakeConsumerGenesis keeper method.
 // An expected genesis state is hardcoded in json, unmarshaled, and compared
 // against an actual consumer genesis state constructed by a provider keeper.
@@ -678,6 +800,8 @@ func TestMakeConsumerGenesis(t *testing.T) {
 	providerRevisionHeight := int64(5)
 
 	initializationParameters := providertypes.ConsumerInitializationParameters{
+		InitialHeight:                     clienttypes.NewHeight(0, 4),
+		GenesisHash:                       []byte("gen_hash"),
 		BlocksPerDistributionTransmission: 1000,
 		CcvTimeoutPeriod:                  ccvTimeoutPeriod,
 		TransferTimeoutPeriod:             transferTimeoutPeriod,
@@ -724,7 +848,8 @@ func TestMakeConsumerGenesis(t *testing.T) {
 			"reward_denoms": [],
 			"provider_reward_denoms": [],
 			"retry_delay_period": %d,
-			"consumer_id": "%s"
+			"consumer_id": "%s",
+			"client_expiry_warning_window": %d
 		},
 		"new_chain": true,
 		"provider" : {
@@ -786,6 +911,11 @@ func TestMakeConsumerGenesis(t *testing.T) {
 				"next_validators_hash": "E30CE736441FB9101FADDAF7E578ABBE6DFDB67207112350A9A904D554E1F5BE"
 			},
 			"initial_val_set": [{}]
+		},
+		"genesis_hash": "Z2VuX2hhc2g=",
+		"initial_height": {
+			"revision_number": 0,
+			"revision_height": 4
 		}
 	}`,
 		initializationParameters.BlocksPerDistributionTransmission,
@@ -796,6 +926,7 @@ func TestMakeConsumerGenesis(t *testing.T) {
 		consumerUnbondingPeriod.Nanoseconds(),
 		ccvtypes.DefaultRetryDelayPeriod.Nanoseconds(),
 		CONSUMER_ID,
+		ccvtypes.DefaultClientExpiryWarningWindow.Nanoseconds(),
 		providerChainId,
 		trustingPeriod.Nanoseconds(),
 		providerUnbondingPeriod.Nanoseconds(),
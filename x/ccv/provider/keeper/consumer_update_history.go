@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MaxConsumerUpdateRecords is the maximum number of updates kept in the store per consumer chain;
+// when exceeded, the oldest updates are pruned
+const MaxConsumerUpdateRecords = 100
+
+// SetConsumerUpdateRecord sets an update applied to a consumer chain
+func (k Keeper) SetConsumerUpdateRecord(ctx sdk.Context, consumerId string, record types.ConsumerUpdateRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// record is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal consumer update record: %w", err))
+	}
+	store.Set(types.ConsumerIdToUpdateHistoryKey(consumerId, record.Sequence), bz)
+}
+
+// GetAllConsumerUpdateRecords returns the retained updates applied to a consumer chain ordered by sequence
+func (k Keeper) GetAllConsumerUpdateRecords(ctx sdk.Context, consumerId string) (records []types.ConsumerUpdateRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToUpdateHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ConsumerUpdateRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in SetConsumerUpdateRecord.
+			panic(fmt.Errorf("cannot unmarshal consumer update record: %w", err))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// DeleteConsumerUpdateHistory deletes all the retained updates applied to a consumer chain
+func (k Keeper) DeleteConsumerUpdateHistory(ctx sdk.Context, consumerId string) {
+	k.pruneConsumerUpdateHistory(ctx, consumerId, 0)
+}
+
+// GetConsumerUpdateFields returns the current values of the fields of a consumer chain
+// that can be updated with MsgUpdateConsumer. Fields that are not set are left empty.
+func (k Keeper) GetConsumerUpdateFields(ctx sdk.Context, consumerId string) types.ConsumerUpdateFields {
+	fields := types.ConsumerUpdateFields{}
+	fields.ChainId, _ = k.GetConsumerChainId(ctx, consumerId)
+	fields.OwnerAddress, _ = k.GetConsumerOwnerAddress(ctx, consumerId)
+	fields.Metadata, _ = k.GetConsumerMetadata(ctx, consumerId)
+	fields.InitializationParameters, _ = k.GetConsumerInitializationParameters(ctx, consumerId)
+	fields.PowerShapingParameters, _ = k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	return fields
+}
+
+// RecordConsumerUpdate records an update applied to a consumer chain by the given submitter,
+// i.e., the given fields before the update and the current fields, and prunes the oldest
+// updates exceeding MaxConsumerUpdateRecords
+func (k Keeper) RecordConsumerUpdate(ctx sdk.Context, consumerId, submitter string, before types.ConsumerUpdateFields) {
+	k.SetConsumerUpdateRecord(ctx, consumerId, types.ConsumerUpdateRecord{
+		Sequence:  k.getNextConsumerUpdateSequence(ctx, consumerId),
+		Submitter: submitter,
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+		Before:    before,
+		After:     k.GetConsumerUpdateFields(ctx, consumerId),
+	})
+	k.pruneConsumerUpdateHistory(ctx, consumerId, MaxConsumerUpdateRecords)
+}
+
+// getNextConsumerUpdateSequence returns the sequence of the next update applied to a consumer chain,
+// i.e., the sequence following the one of the last retained update
+func (k Keeper) getNextConsumerUpdateSequence(ctx sdk.Context, consumerId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToUpdateHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	_, sequence, err := types.ParseStringIdAndUintIdKey(types.ConsumerIdToUpdateHistoryKeyPrefix(), iterator.Key())
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the key is assumed to be correctly constructed in SetConsumerUpdateRecord.
+		panic(fmt.Errorf("cannot parse consumer update history key: %w", err))
+	}
+	return sequence + 1
+}
+
+// pruneConsumerUpdateHistory deletes the oldest updates applied to a consumer chain,
+// so that at most the given number of updates are retained
+func (k Keeper) pruneConsumerUpdateHistory(ctx sdk.Context, consumerId string, retained int) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToUpdateHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for i := 0; i < len(keys)-retained; i++ {
+		store.Delete(keys[i])
+	}
+}
@@ -0,0 +1,47 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerUpdateHistory tests that the updates applied to a consumer chain are recorded by sequence and pruned
+func TestConsumerUpdateHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "chain-0")
+	providerKeeper.SetConsumerOwnerAddress(ctx, CONSUMER_ID, "owner")
+
+	for i := 0; i < providerkeeper.MaxConsumerUpdateRecords+2; i++ {
+		before := providerKeeper.GetConsumerUpdateFields(ctx, CONSUMER_ID)
+		providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, fmt.Sprintf("chain-%d", i+1))
+		ctx = ctx.WithBlockHeight(int64(i + 1))
+		providerKeeper.RecordConsumerUpdate(ctx, CONSUMER_ID, "owner", before)
+	}
+
+	// only the most recent updates are retained
+	records := providerKeeper.GetAllConsumerUpdateRecords(ctx, CONSUMER_ID)
+	require.Len(t, records, providerkeeper.MaxConsumerUpdateRecords)
+	require.Equal(t, uint64(2), records[0].Sequence)
+	require.Equal(t, int64(3), records[0].Height)
+	require.Equal(t, "chain-2", records[0].Before.ChainId)
+	require.Equal(t, "chain-3", records[0].After.ChainId)
+	last := records[len(records)-1]
+	require.Equal(t, uint64(providerkeeper.MaxConsumerUpdateRecords+1), last.Sequence)
+	require.Equal(t, "owner", last.Submitter)
+	require.Equal(t, "owner", last.After.OwnerAddress)
+	require.Equal(t, providertypes.ConsumerMetadata{}, last.After.Metadata)
+
+	// the updates of other consumer chains are not affected
+	require.Empty(t, providerKeeper.GetAllConsumerUpdateRecords(ctx, "1"))
+
+	providerKeeper.DeleteConsumerUpdateHistory(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllConsumerUpdateRecords(ctx, CONSUMER_ID))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetConsumerUpgradePlan sets the upgrade planned for the given consumer chain
+func (k Keeper) SetConsumerUpgradePlan(ctx sdk.Context, consumerId string, plan ccv.ConsumerUpgradePlan) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := plan.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// plan is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal consumer upgrade plan: %w", err))
+	}
+	store.Set(types.ConsumerIdToUpgradePlanKey(consumerId), bz)
+}
+
+// GetConsumerUpgradePlan returns the upgrade planned for the given consumer chain
+func (k Keeper) GetConsumerUpgradePlan(ctx sdk.Context, consumerId string) (ccv.ConsumerUpgradePlan, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToUpgradePlanKey(consumerId))
+	if bz == nil {
+		return ccv.ConsumerUpgradePlan{}, false
+	}
+
+	var plan ccv.ConsumerUpgradePlan
+	if err := plan.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the plan is assumed to be correctly serialized in SetConsumerUpgradePlan.
+		panic(fmt.Errorf("cannot unmarshal consumer upgrade plan: %w", err))
+	}
+	return plan, true
+}
+
+// DeleteConsumerUpgradePlan deletes the upgrade planned for the given consumer chain
+func (k Keeper) DeleteConsumerUpgradePlan(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToUpgradePlanKey(consumerId))
+}
+
+// SendConsumerUpgradePacket sends a packet notifying the given consumer chain of
+// an upgrade planned (or cancelled) by the owner of the consumer chain
+func (k Keeper) SendConsumerUpgradePacket(ctx sdk.Context, consumerId string, plan ccv.ConsumerUpgradePlan, cancelled bool) error {
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"CCV channel not established for consumer chain with consumer id: %s", consumerId)
+	}
+
+	data := ccv.NewConsumerUpgradePacketData(plan, cancelled)
+	_, err := ccv.SendIBCPacket(
+		ctx,
+		k.channelKeeper,
+		channelId, // source channel id
+		k.portID,  // source port id
+		data.GetBytes(),
+		k.GetCCVTimeoutPeriodForConsumer(ctx, consumerId),
+	)
+	return err
+}
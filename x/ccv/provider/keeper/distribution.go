@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
@@ -10,6 +12,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -106,6 +109,53 @@ func (k Keeper) UpdateAllowlistedRewardDenoms(ctx sdk.Context, consumerId string
 	return k.SetAllowlistedRewardDenoms(ctx, consumerId, rewardDenoms)
 }
 
+// RegisterRewardDenomsMetadata registers the bank metadata of the reward denoms allowlisted by the consumer chain
+// with the provided consumer id, for which the chain provides metadata in its consumer metadata.
+// The metadata of a denom that is already registered is overwritten. As the bank metadata is global,
+// it must only be called when the metadata is approved by governance, as otherwise the owner of any
+// consumer chain could register, or squat, the metadata of any denom.
+func (k Keeper) RegisterRewardDenomsMetadata(ctx sdk.Context, consumerId string) error {
+	metadata, err := k.GetConsumerMetadata(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	allowlistedRewardDenoms, err := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+
+	for _, rewardDenomMetadata := range metadata.RewardDenomsMetadata {
+		if !slices.Contains(allowlistedRewardDenoms, rewardDenomMetadata.Denom) {
+			continue
+		}
+		k.bankKeeper.SetDenomMetaData(ctx, rewardDenomMetadata.ToBankMetadata())
+		k.Logger(ctx).Info("registered reward denom metadata",
+			"consumerId", consumerId,
+			"denom", rewardDenomMetadata.Denom,
+			"symbol", rewardDenomMetadata.Symbol,
+		)
+	}
+
+	return nil
+}
+
+// GetRewardDenomsMetadata returns the bank metadata of the reward denoms allowlisted by the consumer chain
+// with the provided consumer id, if registered
+func (k Keeper) GetRewardDenomsMetadata(ctx sdk.Context, consumerId string) ([]banktypes.Metadata, error) {
+	allowlistedRewardDenoms, err := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+
+	metadatas := []banktypes.Metadata{}
+	for _, denom := range allowlistedRewardDenoms {
+		if metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+			metadatas = append(metadatas, metadata)
+		}
+	}
+	return metadatas, nil
+}
+
 // GetConsumerRewardsAllocationByDenom returns the consumer rewards allocation for the given consumer id and denom
 func (k Keeper) GetConsumerRewardsAllocationByDenom(ctx sdk.Context, consumerId, denom string) (types.ConsumerRewardsAllocation, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -137,6 +187,37 @@ func (k Keeper) DeleteConsumerRewardsAllocationByDenom(ctx sdk.Context, consumer
 	store.Delete(types.ConsumerRewardsAllocationByDenomKey(consumerId, denom))
 }
 
+// GetAllConsumerRewardsAllocations returns the rewards allocations of all consumer chains in all denoms,
+// ordered by consumer id and denom
+func (k Keeper) GetAllConsumerRewardsAllocations(ctx sdk.Context) (allocations []types.ConsumerRewardsAllocationByDenom) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ConsumerRewardsAllocationByDenomKeyPrefix()})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(types.ConsumerRewardsAllocationByDenomKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the store key is assumed to be correctly serialized in SetConsumerRewardsAllocationByDenom.
+			panic(fmt.Errorf("failed to parse consumer id: %w", err))
+		}
+		denom := string(iterator.Key()[len(types.StringIdWithLenKey(types.ConsumerRewardsAllocationByDenomKeyPrefix(), consumerId)):])
+
+		var allocation types.ConsumerRewardsAllocation
+		if err := allocation.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the allocation is assumed to be correctly serialized in SetConsumerRewardsAllocationByDenom.
+			panic(fmt.Errorf("failed to unmarshal consumer rewards allocation: %w", err))
+		}
+		allocations = append(allocations, types.ConsumerRewardsAllocationByDenom{
+			ConsumerId: consumerId,
+			Denom:      denom,
+			Allocation: allocation,
+		})
+	}
+	return allocations
+}
+
 // AllocateConsumerRewards allocates the given rewards to provider consumer chain with the given consumer id
 func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, alloc types.ConsumerRewardsAllocation) (types.ConsumerRewardsAllocation, error) {
 	chainId, err := k.GetConsumerChainId(ctx, consumerId)
@@ -189,13 +270,25 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 		return types.ConsumerRewardsAllocation{}, err
 	}
 
-	// compute rewards for validators
+	// fund the relayer rebate pool with a fraction of the consumer rewards
 	consumerRewards := alloc.Rewards
+	alloc.Rewards, err = k.FundRelayerRebatePool(ctx, alloc.Rewards)
+	if err != nil {
+		k.Logger(ctx).Error(
+			"cannot fund relayer rebate pool while allocating ICS rewards",
+			"consumerId", consumerId,
+			"chainId", chainId,
+			"error", err.Error(),
+		)
+		return types.ConsumerRewardsAllocation{}, err
+	}
+
+	// compute rewards for validators
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
-	validatorsRewards := consumerRewards.MulDecTruncate(voteMultiplier)
+	validatorsRewards := alloc.Rewards.MulDecTruncate(voteMultiplier)
 
 	// compute remaining rewards for the community pool
-	remaining := consumerRewards.Sub(validatorsRewards)
+	remaining := alloc.Rewards.Sub(validatorsRewards)
 
 	// transfer validators rewards to distribution module account
 	validatorsRewardsTrunc, validatorsRewardsChange := validatorsRewards.TruncateDecimal()
@@ -284,53 +377,43 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 
 		allAllowlistedDenoms := append(allConsumerRewardDenoms, consumerAllowlistedRewardDenoms...)
 		for _, denom := range allAllowlistedDenoms {
-			// use a cached context to verify that the call to `AllocateConsumerRewards` is atomic, and hence
-			// all transfers in `AllocateConsumerRewards` happen all together or not at all.
-			cachedCtx, writeCache := ctx.CacheContext()
-			consumerRewards, err := k.GetConsumerRewardsAllocationByDenom(cachedCtx, consumerId, denom)
-			if err != nil {
-				k.Logger(ctx).Error(
-					"failed to get the consumer rewards allocation for this denom",
-					"consumer id", consumerId,
-					"denom", denom,
-					"error", err.Error(),
-				)
-				continue
-			}
-			if consumerRewards.Rewards.IsZero() {
-				// note that GetConsumerRewardsAllocationByDenom returns an empty ConsumerRewardsAllocation
-				// when there is no (consumerId, denom) key for consumer rewards allocations
-				continue
-			}
-			remainingRewardAllocation, err := k.AllocateConsumerRewards(cachedCtx, consumerId, consumerRewards)
-			if err != nil {
-				k.Logger(ctx).Error(
-					"fail to allocate rewards for consumer chain",
-					"consumer id", consumerId,
-					"error", err.Error(),
-				)
-				continue
-			}
+			// allocate the rewards in an isolated context to verify that the call to `AllocateConsumerRewards` is atomic,
+			// and hence all transfers in `AllocateConsumerRewards` happen all together or not at all
+			k.ExecuteIsolated(ctx, consumerId, "allocate rewards", func(ctx sdk.Context) error {
+				return k.allocateConsumerRewardsByDenom(ctx, consumerId, denom)
+			})
+		}
+	}
+}
 
-			if remainingRewardAllocation.Rewards.IsZero() {
-				// if there is no remaining consumer rewards allocation, then just delete the (consumerId, denom) key
-				k.DeleteConsumerRewardsAllocationByDenom(cachedCtx, consumerId, denom)
-			} else {
-				// otherwise, update the consumer rewards allocation
-				err = k.SetConsumerRewardsAllocationByDenom(cachedCtx, consumerId, denom, remainingRewardAllocation)
-				if err != nil {
-					k.Logger(ctx).Error(
-						"fail to set rewards for consumer chain",
-						"consumer id", consumerId,
-						"error", err.Error(),
-					)
-					continue
-				}
-			}
+// allocateConsumerRewardsByDenom allocates the rewards of a consumer chain in the given denom
+// and updates the remaining consumer rewards allocation
+func (k Keeper) allocateConsumerRewardsByDenom(ctx sdk.Context, consumerId, denom string) error {
+	consumerRewards, err := k.GetConsumerRewardsAllocationByDenom(ctx, consumerId, denom)
+	if err != nil {
+		return errorsmod.Wrapf(err, "getting the consumer rewards allocation for denom %s", denom)
+	}
+	if consumerRewards.Rewards.IsZero() {
+		// note that GetConsumerRewardsAllocationByDenom returns an empty ConsumerRewardsAllocation
+		// when there is no (consumerId, denom) key for consumer rewards allocations
+		return nil
+	}
+	remainingRewardAllocation, err := k.AllocateConsumerRewards(ctx, consumerId, consumerRewards)
+	if err != nil {
+		return errorsmod.Wrapf(err, "allocating rewards for denom %s", denom)
+	}
 
-			writeCache()
-		}
+	if remainingRewardAllocation.Rewards.IsZero() {
+		// if there is no remaining consumer rewards allocation, then just delete the (consumerId, denom) key
+		k.DeleteConsumerRewardsAllocationByDenom(ctx, consumerId, denom)
+		return nil
+	}
+	// otherwise, update the consumer rewards allocation
+	err = k.SetConsumerRewardsAllocationByDenom(ctx, consumerId, denom, remainingRewardAllocation)
+	if err != nil {
+		return errorsmod.Wrapf(err, "setting rewards for denom %s", denom)
 	}
+	return nil
 }
 
 // IsEligibleForConsumerRewards returns `true` if the validator with `consumerValidatorHeight` has been a consumer
@@ -512,7 +595,7 @@ func (k Keeper) HandleSetConsumerCommissionRate(ctx sdk.Context, consumerId stri
 	}
 
 	// validate against the minimum commission rate
-	minRate, err := k.stakingKeeper.MinCommissionRate(ctx)
+	minRate, err := k.GetMinCommissionRate(ctx, consumerId)
 	if err != nil {
 		return err
 	}
@@ -531,6 +614,61 @@ func (k Keeper) HandleSetConsumerCommissionRate(ctx sdk.Context, consumerId stri
 	)
 }
 
+// GetMinCommissionRate returns the minimum commission rate validators can set on the given consumer chain,
+// i.e., the minimum commission rate of the consumer chain if set, otherwise the `min_consumer_commission_rate`
+// param, but no less than the minimum commission rate of the staking module
+func (k Keeper) GetMinCommissionRate(ctx sdk.Context, consumerId string) (math.LegacyDec, error) {
+	stakingMinRate, err := k.stakingKeeper.MinCommissionRate(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	minRate, found := k.GetConsumerMinCommissionRate(ctx, consumerId)
+	if !found {
+		minRate = k.GetMinConsumerCommissionRate(ctx)
+	}
+	return math.LegacyMaxDec(minRate, stakingMinRate), nil
+}
+
+// EndBlockCommissionRates raises the per-consumer chain commission rates that are below
+// the minimum commission rate of their consumer chain to the minimum commission rate,
+// e.g., after the minimum commission rate is raised by governance
+func (k Keeper) EndBlockCommissionRates(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		minRate, err := k.GetMinCommissionRate(ctx, consumerId)
+		if err != nil {
+			k.Logger(ctx).Error("cannot get min commission rate", "consumerId", consumerId, "error", err)
+			continue
+		}
+
+		for _, providerAddr := range k.GetAllCommissionRateValidators(ctx, consumerId) {
+			rate, found := k.GetConsumerCommissionRate(ctx, consumerId, providerAddr)
+			if !found || rate.GTE(minRate) {
+				continue
+			}
+			if err := k.SetConsumerCommissionRate(ctx, consumerId, providerAddr, minRate); err != nil {
+				continue
+			}
+
+			k.Logger(ctx).Info("consumer commission rate raised to the min commission rate",
+				"consumerId", consumerId,
+				"providerAddr", providerAddr.String(),
+				"previousRate", rate,
+				"rate", minRate,
+			)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeConsumerCommissionRateRaised,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+					sdk.NewAttribute(types.AttributeConsumerCommissionRate, minRate.String()),
+				),
+			)
+		}
+	}
+}
+
 // TODO: this method needs to be tested
 func (k Keeper) ChangeRewardDenoms(ctx sdk.Context, denomsToAdd, denomsToRemove []string) []sdk.Attribute {
 	// initialize an empty slice to store event attributes
@@ -13,6 +13,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	tmtypes "github.com/cometbft/cometbft/types"
 
@@ -342,6 +343,81 @@ func TestHandleSetConsumerCommissionRate(t *testing.T) {
 	require.True(t, found)
 }
 
+// TestGetMinCommissionRate tests that the min commission rate of a consumer chain is the maximum
+// between the staking min commission rate and the per-consumer (or default) min commission rate
+func TestGetMinCommissionRate(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	stakingMinRate := math.LegacyNewDecWithPrec(5, 2)
+	mocks.MockStakingKeeper.EXPECT().MinCommissionRate(gomock.Any()).Return(stakingMinRate, nil).AnyTimes()
+
+	// the default min consumer commission rate is zero
+	minRate, err := providerKeeper.GetMinCommissionRate(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, stakingMinRate, minRate)
+
+	// the min consumer commission rate param applies to consumers without a min commission rate
+	params := providerKeeper.GetParams(ctx)
+	params.MinConsumerCommissionRate = "0.1"
+	providerKeeper.SetParams(ctx, params)
+	minRate, err = providerKeeper.GetMinCommissionRate(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), minRate)
+
+	// the per-consumer min commission rate overrides the param
+	require.NoError(t, providerKeeper.SetConsumerMinCommissionRate(ctx, consumerId, math.LegacyNewDecWithPrec(2, 1)))
+	minRate, err = providerKeeper.GetMinCommissionRate(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(2, 1), minRate)
+
+	// the staking min commission rate is always enforced
+	require.NoError(t, providerKeeper.SetConsumerMinCommissionRate(ctx, consumerId, math.LegacyZeroDec()))
+	minRate, err = providerKeeper.GetMinCommissionRate(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, stakingMinRate, minRate)
+
+	providerKeeper.DeleteConsumerMinCommissionRate(ctx, consumerId)
+	_, found := providerKeeper.GetConsumerMinCommissionRate(ctx, consumerId)
+	require.False(t, found)
+}
+
+// TestEndBlockCommissionRates tests that the commission rates below the min commission rate
+// of a launched consumer chain are raised to the min commission rate
+func TestEndBlockCommissionRates(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockStakingKeeper.EXPECT().MinCommissionRate(gomock.Any()).Return(math.LegacyZeroDec(), nil).AnyTimes()
+
+	consumerId := "0"
+	providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	lowAddr := providertypes.NewProviderConsAddress([]byte("lowAddr"))
+	highAddr := providertypes.NewProviderConsAddress([]byte("highAddr"))
+	require.NoError(t, providerKeeper.SetConsumerCommissionRate(ctx, consumerId, lowAddr, math.LegacyNewDecWithPrec(1, 2)))
+	require.NoError(t, providerKeeper.SetConsumerCommissionRate(ctx, consumerId, highAddr, math.LegacyNewDecWithPrec(5, 1)))
+
+	// raise the min commission rate of the consumer chain
+	minRate := math.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, providerKeeper.SetConsumerMinCommissionRate(ctx, consumerId, minRate))
+
+	providerKeeper.EndBlockCommissionRates(ctx)
+
+	rate, found := providerKeeper.GetConsumerCommissionRate(ctx, consumerId, lowAddr)
+	require.True(t, found)
+	require.Equal(t, minRate, rate)
+
+	rate, found = providerKeeper.GetConsumerCommissionRate(ctx, consumerId, highAddr)
+	require.True(t, found)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), rate)
+
+	// a rate below the min commission rate cannot be set
+	require.Error(t, providerKeeper.HandleSetConsumerCommissionRate(ctx, consumerId, lowAddr, math.LegacyNewDecWithPrec(5, 2)))
+}
+
 // TestAllowlistedRewardDenoms tests the `GetAllowlistedRewardDenoms`, `SetAllowlistedRewardDenom`,
 // `UpdateAllowlistedRewardDenoms` and `DeleteAllowlistedRewardDenoms` methods.
 func TestAllowlistedRewardDenoms(t *testing.T) {
@@ -373,6 +449,40 @@ func TestAllowlistedRewardDenoms(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestRegisterRewardDenomsMetadata tests that the metadata of the allowlisted reward denoms is registered,
+// including the metadata of the denoms that are already registered
+func TestRegisterRewardDenomsMetadata(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chainId")
+	metadata := testkeeper.GetTestConsumerMetadata()
+	metadata.RewardDenomsMetadata = []providertypes.RewardDenomMetadata{
+		{Denom: "ibc/denom1", Name: "Denom1", Symbol: "DEN1", Display: "den1", Exponent: 6},
+		{Denom: "ibc/denom2", Name: "Denom2", Symbol: "DEN2", Display: "den2", Exponent: 6},
+		{Denom: "ibc/denom3", Name: "Denom3", Symbol: "DEN3", Display: "den3", Exponent: 6},
+	}
+	require.NoError(t, providerKeeper.SetConsumerMetadata(ctx, consumerId, metadata))
+	// denom3 is not allowlisted
+	require.NoError(t, providerKeeper.SetAllowlistedRewardDenoms(ctx, consumerId, []string{"ibc/denom1", "ibc/denom2"}))
+
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().SetDenomMetaData(ctx, metadata.RewardDenomsMetadata[0].ToBankMetadata()),
+		mocks.MockBankKeeper.EXPECT().SetDenomMetaData(ctx, metadata.RewardDenomsMetadata[1].ToBankMetadata()),
+	)
+	require.NoError(t, providerKeeper.RegisterRewardDenomsMetadata(ctx, consumerId))
+
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().GetDenomMetaData(ctx, "ibc/denom1").Return(metadata.RewardDenomsMetadata[0].ToBankMetadata(), true),
+		mocks.MockBankKeeper.EXPECT().GetDenomMetaData(ctx, "ibc/denom2").Return(banktypes.Metadata{}, false),
+	)
+	res, err := providerKeeper.QueryConsumerRewardDenomsMetadata(ctx,
+		&providertypes.QueryConsumerRewardDenomsMetadataRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, []banktypes.Metadata{metadata.RewardDenomsMetadata[0].ToBankMetadata()}, res.Metadatas)
+}
+
 // TestConsumerRewardsAllocationByDenom tests the `*ConsumerRewardsAllocationByDenom* methods
 func TestConsumerRewardsAllocationByDenom(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
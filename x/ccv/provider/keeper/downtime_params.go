@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetConsumerDowntimeParams sets the downtime detection parameters of the given consumer chain
+// and marks them as pending, i.e., they are sent to the consumer chain in EndBlock
+func (k Keeper) SetConsumerDowntimeParams(ctx sdk.Context, consumerId string, params ccv.DowntimeParams) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := params.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// params are instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal consumer downtime params: %w", err))
+	}
+	store.Set(types.ConsumerIdToDowntimeParamsKey(consumerId), bz)
+	store.Set(types.PendingDowntimeParamsKey(consumerId), []byte{})
+}
+
+// GetConsumerDowntimeParams returns the downtime detection parameters of the given consumer chain
+func (k Keeper) GetConsumerDowntimeParams(ctx sdk.Context, consumerId string) (ccv.DowntimeParams, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToDowntimeParamsKey(consumerId))
+	if bz == nil {
+		return ccv.DowntimeParams{}, false
+	}
+
+	var params ccv.DowntimeParams
+	if err := params.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the params are assumed to be correctly serialized in SetConsumerDowntimeParams.
+		panic(fmt.Errorf("cannot unmarshal consumer downtime params: %w", err))
+	}
+	return params, true
+}
+
+// DeleteConsumerDowntimeParams deletes the downtime detection parameters of the given consumer chain
+func (k Keeper) DeleteConsumerDowntimeParams(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToDowntimeParamsKey(consumerId))
+	store.Delete(types.PendingDowntimeParamsKey(consumerId))
+}
+
+// HasPendingDowntimeParams returns true if the downtime detection parameters
+// of the given consumer chain still need to be sent to the consumer chain
+func (k Keeper) HasPendingDowntimeParams(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.PendingDowntimeParamsKey(consumerId))
+}
+
+// GetAllConsumersWithPendingDowntimeParams returns the ids of the consumer chains
+// whose downtime detection parameters still need to be sent
+func (k Keeper) GetAllConsumersWithPendingDowntimeParams(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.PendingDowntimeParamsKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetConsumerDowntimeParams.
+			panic(fmt.Errorf("failed to parse pending downtime params key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// SendDowntimeParamsPacket sends a packet pushing the given downtime detection parameters
+// to the given consumer chain
+func (k Keeper) SendDowntimeParamsPacket(ctx sdk.Context, consumerId string, params ccv.DowntimeParams) error {
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"CCV channel not established for consumer chain with consumer id: %s", consumerId)
+	}
+
+	data := ccv.NewDowntimeParamsPacketData(params)
+	_, err := ccv.SendIBCPacket(
+		ctx,
+		k.channelKeeper,
+		channelId, // source channel id
+		k.portID,  // source port id
+		data.GetBytes(),
+		k.GetCCVTimeoutPeriodForConsumer(ctx, consumerId),
+	)
+	return err
+}
+
+// EndBlockDowntimeParams sends the pending downtime detection parameters to the launched
+// consumer chains with an established CCV channel. The parameters of the other consumer
+// chains remain pending, i.e., they are sent once the CCV channel is established.
+// Failing to send the parameters to a consumer chain does not affect the other consumer chains.
+func (k Keeper) EndBlockDowntimeParams(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllConsumersWithPendingDowntimeParams(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		if _, found := k.GetConsumerIdToChannelId(ctx, consumerId); !found {
+			continue
+		}
+
+		k.ExecuteIsolated(ctx, consumerId, "send downtime params", func(ctx sdk.Context) error {
+			params, found := k.GetConsumerDowntimeParams(ctx, consumerId)
+			if found {
+				if err := k.SendDowntimeParamsPacket(ctx, consumerId, params); err != nil {
+					return err
+				}
+				k.Logger(ctx).Info("sent downtime params to consumer chain",
+					"consumerId", consumerId,
+					"signedBlocksWindow", params.SignedBlocksWindow,
+					"minSignedPerWindow", params.MinSignedPerWindow,
+				)
+			}
+			ctx.KVStore(k.storeKey).Delete(types.PendingDowntimeParamsKey(consumerId))
+			return nil
+		})
+	}
+}
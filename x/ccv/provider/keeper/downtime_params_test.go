@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestConsumerDowntimeParams tests the setter, getter and deleter of the downtime params of consumer chains
+func TestConsumerDowntimeParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerDowntimeParams(ctx, CONSUMER_ID)
+	require.False(t, found)
+	require.False(t, providerKeeper.HasPendingDowntimeParams(ctx, CONSUMER_ID))
+
+	params := ccv.DowntimeParams{SignedBlocksWindow: 10000, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2)}
+	providerKeeper.SetConsumerDowntimeParams(ctx, CONSUMER_ID, params)
+	stored, found := providerKeeper.GetConsumerDowntimeParams(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, params, stored)
+	require.True(t, providerKeeper.HasPendingDowntimeParams(ctx, CONSUMER_ID))
+	require.Equal(t, []string{CONSUMER_ID}, providerKeeper.GetAllConsumersWithPendingDowntimeParams(ctx))
+
+	providerKeeper.DeleteConsumerDowntimeParams(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetConsumerDowntimeParams(ctx, CONSUMER_ID)
+	require.False(t, found)
+	require.False(t, providerKeeper.HasPendingDowntimeParams(ctx, CONSUMER_ID))
+	require.Empty(t, providerKeeper.GetAllConsumersWithPendingDowntimeParams(ctx))
+}
+
+// TestEndBlockDowntimeParams tests that the pending downtime params are sent only to
+// the launched consumer chains with an established CCV channel
+func TestEndBlockDowntimeParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	params := ccv.DowntimeParams{SignedBlocksWindow: 10000, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2)}
+
+	// consumer "0" is launched and has an established channel
+	// consumer "1" is launched and has an established channel, but sending the packet fails
+	// consumer "2" is launched, but has no established channel
+	// consumer "3" is not launched
+	for i, consumerId := range []string{"0", "1", "2", "3"} {
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		if i < 2 {
+			providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channelID"+consumerId)
+		}
+		providerKeeper.SetConsumerDowntimeParams(ctx, consumerId, params)
+	}
+	providerKeeper.SetConsumerPhase(ctx, "3", providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	expectedData := ccv.NewDowntimeParamsPacketData(params)
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID0").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID0", gomock.Any(), gomock.Any(),
+			expectedData.GetBytes()).Return(uint64(1), nil).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID1").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID1", gomock.Any(), gomock.Any(),
+			expectedData.GetBytes()).Return(uint64(0), errors.New("send failed")).Times(1),
+	)
+
+	providerKeeper.EndBlockDowntimeParams(ctx)
+
+	// only the params sent successfully are no longer pending
+	require.Equal(t, []string{"1", "2", "3"}, providerKeeper.GetAllConsumersWithPendingDowntimeParams(ctx))
+	_, found := providerKeeper.GetConsumerDowntimeParams(ctx, "0")
+	require.True(t, found)
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// ComputeProjectedDropOffs returns the validators of the current validator set of a consumer chain
+// that are projected to drop out of it at the next epoch, i.e., that are not part of the validator set
+// computed with the current opted-in validators and power-shaping parameters as if the epoch ended now
+func (k Keeper) ComputeProjectedDropOffs(
+	ctx sdk.Context,
+	consumerId string,
+	bondedValidators []stakingtypes.Validator,
+	activeValidators []stakingtypes.Validator,
+) ([]types.ConsensusValidator, error) {
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return nil, fmt.Errorf("getting power shaping parameters, consumerId(%s): %w", consumerId, err)
+	}
+
+	minPower := int64(0)
+	if powerShapingParameters.Top_N > 0 {
+		// the validators in the top N are opted in automatically at the next epoch
+		minPower, err = k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+		if err != nil {
+			return nil, fmt.Errorf("computing min power to opt in, consumerId(%s): %w", consumerId, err)
+		}
+	}
+
+	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+	if err != nil {
+		return nil, fmt.Errorf("computing next validators, consumerId(%s): %w", consumerId, err)
+	}
+
+	currentValidators, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return nil, fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	isNextValidator := make(map[string]bool, len(nextValidators))
+	for _, val := range nextValidators {
+		isNextValidator[string(val.ProviderConsAddr)] = true
+	}
+
+	var dropOffs []types.ConsensusValidator
+	for _, val := range currentValidators {
+		if !isNextValidator[string(val.ProviderConsAddr)] {
+			dropOffs = append(dropOffs, val)
+		}
+	}
+	return dropOffs, nil
+}
+
+// IsProjectedDropOff returns whether the validator was warned to be projected to drop out of
+// the validator set of the consumer chain at the next epoch
+func (k Keeper) IsProjectedDropOff(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(types.ProjectedDropOffKey(consumerId, providerAddr)) != nil
+}
+
+// SetProjectedDropOff records that the validator was warned to be projected to drop out of
+// the validator set of the consumer chain at the next epoch
+func (k Keeper) SetProjectedDropOff(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProjectedDropOffKey(consumerId, providerAddr), []byte{})
+}
+
+// DeleteProjectedDropOff deletes the record that the validator was warned to be projected to drop out of
+// the validator set of the consumer chain at the next epoch
+func (k Keeper) DeleteProjectedDropOff(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ProjectedDropOffKey(consumerId, providerAddr))
+}
+
+// GetAllProjectedDropOffs returns the validators that were warned to be projected to drop out of
+// the validator set of the consumer chain at the next epoch
+func (k Keeper) GetAllProjectedDropOffs(ctx sdk.Context, consumerId string) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.StringIdWithLenKey(types.ProjectedDropOffKeyPrefix(), consumerId)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[len(key):]))
+	}
+	return providerAddrs
+}
+
+// DeleteAllProjectedDropOffs deletes the records of all the validators that were warned to be projected
+// to drop out of the validator set of the consumer chain
+func (k Keeper) DeleteAllProjectedDropOffs(ctx sdk.Context, consumerId string) {
+	for _, providerAddr := range k.GetAllProjectedDropOffs(ctx, consumerId) {
+		k.DeleteProjectedDropOff(ctx, consumerId, providerAddr)
+	}
+}
+
+// EndBlockDropOffWarnings emits a warning event for every validator that is projected to drop out of
+// the validator set of a launched consumer chain at the next epoch, giving its operator a heads-up before
+// its node stops validating the consumer chain. The warning is emitted once, when the validator is first
+// projected to drop off, and again only if the validator stops and then starts again being projected to drop off.
+func (k Keeper) EndBlockDropOffWarnings(ctx sdk.Context) {
+	var bondedValidators, activeValidators []stakingtypes.Validator
+	loaded := false
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+
+		if !loaded {
+			var err error
+			bondedValidators, activeValidators, err = k.GetLastBondedAndActiveValidators(ctx)
+			if err != nil {
+				k.Logger(ctx).Error("cannot get bonded validators", "error", err)
+				return
+			}
+			loaded = true
+		}
+
+		dropOffs, err := k.ComputeProjectedDropOffs(ctx, consumerId, bondedValidators, activeValidators)
+		if err != nil {
+			k.Logger(ctx).Error("cannot compute projected drop-offs", "consumerId", consumerId, "error", err)
+			continue
+		}
+
+		isDropOff := make(map[string]bool, len(dropOffs))
+		for _, val := range dropOffs {
+			isDropOff[string(val.ProviderConsAddr)] = true
+		}
+		// forget the validators that are no longer projected to drop off, e.g., after the epoch ended
+		for _, providerAddr := range k.GetAllProjectedDropOffs(ctx, consumerId) {
+			if !isDropOff[string(providerAddr.ToSdkConsAddr())] {
+				k.DeleteProjectedDropOff(ctx, consumerId, providerAddr)
+			}
+		}
+
+		for _, val := range dropOffs {
+			providerAddr := types.NewProviderConsAddress(val.ProviderConsAddr)
+			if k.IsProjectedDropOff(ctx, consumerId, providerAddr) {
+				continue
+			}
+			k.SetProjectedDropOff(ctx, consumerId, providerAddr)
+
+			blocksUntilNextEpoch := k.BlocksUntilNextEpoch(ctx)
+			k.Logger(ctx).Info("validator projected to drop out of the consumer validator set at the next epoch",
+				"consumerId", consumerId,
+				"providerAddr", providerAddr.String(),
+				"power", val.Power,
+				"blocksUntilNextEpoch", blocksUntilNextEpoch,
+			)
+			attributes := []sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+				sdk.NewAttribute(types.AttributeValidatorPower, strconv.FormatInt(val.Power, 10)),
+				sdk.NewAttribute(types.AttributeBlocksUntilNextEpoch, strconv.FormatInt(blocksUntilNextEpoch, 10)),
+			}
+			if val.PublicKey != nil {
+				if consAddr, err := ccv.TMCryptoPublicKeyToConsAddr(*val.PublicKey); err == nil {
+					consumerAddr := types.NewConsumerConsAddress(consAddr)
+					attributes = append(attributes, sdk.NewAttribute(types.AttributeConsumerConsAddress, k.ConsAddressToString(consumerAddr.ToSdkConsAddr())))
+				}
+			}
+			ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeValidatorDropOffWarning, attributes...))
+		}
+	}
+}
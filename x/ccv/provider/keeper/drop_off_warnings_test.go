@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestEndBlockDropOffWarnings tests that a warning is emitted once for every validator
+// projected to drop out of the validator set of a launched consumer chain at the next epoch
+func TestEndBlockDropOffWarnings(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MaxProviderConsensusValidators = 2
+	providerKeeper.SetParams(ctx, params)
+
+	consumerId := "0"
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{}))
+
+	valA := createStakingValidator(ctx, mocks, 2, 1)
+	valB := createStakingValidator(ctx, mocks, 1, 2)
+	bondedValidators := []stakingtypes.Validator{valA, valB}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, bondedValidators, -1)
+
+	consAddrA, err := valA.GetConsAddr()
+	require.NoError(t, err)
+	providerAddrA := providertypes.NewProviderConsAddress(consAddrA)
+	consAddrB, err := valB.GetConsAddr()
+	require.NoError(t, err)
+	providerAddrB := providertypes.NewProviderConsAddress(consAddrB)
+
+	// both validators are opted in and validate the consumer chain
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddrA)
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddrB)
+	currentValidators, err := providerKeeper.ComputeNextValidators(ctx, consumerId, bondedValidators, providertypes.PowerShapingParameters{}, 0)
+	require.NoError(t, err)
+	require.Len(t, currentValidators, 2)
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, currentValidators))
+
+	dropOffs, err := providerKeeper.ComputeProjectedDropOffs(ctx, consumerId, bondedValidators, bondedValidators)
+	require.NoError(t, err)
+	require.Empty(t, dropOffs)
+
+	countWarnings := func(ctx sdk.Context) int {
+		count := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == providertypes.EventTypeValidatorDropOffWarning {
+				count++
+			}
+		}
+		return count
+	}
+
+	// validator B opts out and is projected to drop out of the consumer validator set
+	providerKeeper.DeleteOptedIn(ctx, consumerId, providerAddrB)
+	dropOffs, err = providerKeeper.ComputeProjectedDropOffs(ctx, consumerId, bondedValidators, bondedValidators)
+	require.NoError(t, err)
+	require.Len(t, dropOffs, 1)
+	require.Equal(t, providerAddrB.ToSdkConsAddr(), sdk.ConsAddress(dropOffs[0].ProviderConsAddr))
+
+	blockCtx := ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.EndBlockDropOffWarnings(blockCtx)
+	require.Equal(t, 1, countWarnings(blockCtx))
+	require.True(t, providerKeeper.IsProjectedDropOff(ctx, consumerId, providerAddrB))
+	require.False(t, providerKeeper.IsProjectedDropOff(ctx, consumerId, providerAddrA))
+
+	// the warning is not emitted again in the following blocks
+	blockCtx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.EndBlockDropOffWarnings(blockCtx)
+	require.Equal(t, 0, countWarnings(blockCtx))
+
+	// the query returns the projected drop-offs
+	res, err := providerKeeper.QueryConsumerProjectedDropOffs(ctx, &providertypes.QueryConsumerProjectedDropOffsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, providerAddrB.String(), res.Validators[0].ProviderAddress)
+	require.Equal(t, int64(1), res.Validators[0].Power)
+
+	// validator B opts in again and is no longer projected to drop off
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddrB)
+	blockCtx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.EndBlockDropOffWarnings(blockCtx)
+	require.Equal(t, 0, countWarnings(blockCtx))
+	require.Empty(t, providerKeeper.GetAllProjectedDropOffs(ctx, consumerId))
+
+	// the query fails for consumer chains that are not launched
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_STOPPED)
+	_, err = providerKeeper.QueryConsumerProjectedDropOffs(ctx, &providertypes.QueryConsumerProjectedDropOffsRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetEpochStart returns the first blocks of the last two epochs
+func (k Keeper) GetEpochStart(ctx sdk.Context) (types.EpochStart, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochStartKey())
+	if bz == nil {
+		return types.EpochStart{}, false
+	}
+
+	var epochStart types.EpochStart
+	if err := epochStart.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the epoch start is assumed to be correctly serialized in SetEpochStart.
+		panic(fmt.Errorf("failed to unmarshal epoch start: %w", err))
+	}
+	return epochStart, true
+}
+
+// SetEpochStart sets the first blocks of the last two epochs
+func (k Keeper) SetEpochStart(ctx sdk.Context, epochStart types.EpochStart) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := epochStart.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// epochStart is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("failed to marshal epoch start: %w", err))
+	}
+	store.Set(types.EpochStartKey(), bz)
+}
+
+// RecordEpochStart records the current block as the first block of a new epoch
+func (k Keeper) RecordEpochStart(ctx sdk.Context) {
+	epochStart, _ := k.GetEpochStart(ctx)
+	k.SetEpochStart(ctx, types.EpochStart{
+		Height:         ctx.BlockHeight(),
+		Time:           ctx.BlockTime(),
+		PreviousHeight: epochStart.Height,
+		PreviousTime:   epochStart.Time,
+	})
+}
+
+// NextEpochHeight returns the height of the first block of the epoch following the current block
+func (k Keeper) NextEpochHeight(ctx sdk.Context) int64 {
+	blocksPerEpoch := k.GetBlocksPerEpoch(ctx)
+	return (ctx.BlockHeight()/blocksPerEpoch + 1) * blocksPerEpoch
+}
+
+// EstimateAverageBlockTime returns the average block time since the start of the previous epoch
+// (or of the current one, if the previous epoch is not recorded) and whether it could be estimated
+func (k Keeper) EstimateAverageBlockTime(ctx sdk.Context) (time.Duration, bool) {
+	epochStart, found := k.GetEpochStart(ctx)
+	if !found {
+		return 0, false
+	}
+
+	height, startTime := epochStart.Height, epochStart.Time
+	if epochStart.PreviousHeight > 0 {
+		height, startTime = epochStart.PreviousHeight, epochStart.PreviousTime
+	}
+	blocks := ctx.BlockHeight() - height
+	if blocks <= 0 || !ctx.BlockTime().After(startTime) {
+		return 0, false
+	}
+	return ctx.BlockTime().Sub(startTime) / time.Duration(blocks), true
+}
@@ -0,0 +1,62 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+)
+
+// TestEpochStart tests that the first blocks of the epochs are recorded
+// and used to estimate the time until the next epoch
+func TestEpochStart(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providerKeeper.GetParams(ctx)
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	start := time.Now().UTC()
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(start)
+	require.Equal(t, int64(30), providerKeeper.NextEpochHeight(ctx))
+
+	// nothing can be estimated before the first epoch start is recorded
+	_, found := providerKeeper.EstimateAverageBlockTime(ctx)
+	require.False(t, found)
+
+	providerKeeper.RecordEpochStart(ctx)
+	epochStart, found := providerKeeper.GetEpochStart(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(20), epochStart.Height)
+	require.Equal(t, start, epochStart.Time)
+	require.Zero(t, epochStart.PreviousHeight)
+
+	// no block passed since the epoch start
+	_, found = providerKeeper.EstimateAverageBlockTime(ctx)
+	require.False(t, found)
+
+	// the average block time is estimated since the start of the current epoch
+	ctx = ctx.WithBlockHeight(25).WithBlockTime(start.Add(10 * time.Second))
+	require.Equal(t, int64(30), providerKeeper.NextEpochHeight(ctx))
+	avg, found := providerKeeper.EstimateAverageBlockTime(ctx)
+	require.True(t, found)
+	require.Equal(t, 2*time.Second, avg)
+
+	// once a new epoch starts, the average block time is estimated since the start of the previous epoch
+	ctx = ctx.WithBlockHeight(30).WithBlockTime(start.Add(40 * time.Second))
+	require.Equal(t, int64(40), providerKeeper.NextEpochHeight(ctx))
+	providerKeeper.RecordEpochStart(ctx)
+	epochStart, found = providerKeeper.GetEpochStart(ctx)
+	require.True(t, found)
+	require.Equal(t, int64(30), epochStart.Height)
+	require.Equal(t, int64(20), epochStart.PreviousHeight)
+	require.Equal(t, start, epochStart.PreviousTime)
+
+	ctx = ctx.WithBlockHeight(35).WithBlockTime(start.Add(45 * time.Second))
+	avg, found = providerKeeper.EstimateAverageBlockTime(ctx)
+	require.True(t, found)
+	require.Equal(t, 3*time.Second, avg)
+}
@@ -8,12 +8,11 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
-	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // InitGenesis initializes the CCV provider state and binds to PortID.
 func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abci.ValidatorUpdate {
-	k.SetPort(ctx, ccv.ProviderPortID)
+	k.SetPort(ctx, k.portID)
 
 	k.SetValidatorSetUpdateId(ctx, genState.ValsetUpdateId)
 	for _, v2h := range genState.ValsetUpdateIdToHeight {
@@ -60,8 +59,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		}
 	}
 
+	for _, item := range genState.ConsumerRewardsAllocations {
+		if err := k.SetConsumerRewardsAllocationByDenom(ctx, item.ConsumerId, item.Denom, item.Allocation); err != nil {
+			// An error here would indicate something is very wrong,
+			// the allocation is validated in GenesisState.Validate().
+			panic(fmt.Errorf("consumer rewards allocation could not be persisted: %w", err))
+		}
+	}
+
+	for _, item := range genState.ValsetUpdateIdToBlockTime {
+		k.SetValsetUpdateBlockTime(ctx, item.ValsetUpdateId, item.BlockTime)
+	}
+
 	k.SetParams(ctx, genState.Params)
-	k.InitializeSlashMeter(ctx)
+	if genState.SlashMeterState != nil {
+		// restore the slash meter of an exported chain
+		k.SetSlashMeter(ctx, genState.SlashMeterState.SlashMeter)
+		k.SetSlashMeterReplenishTimeCandidateAt(ctx, genState.SlashMeterState.ReplenishTimeCandidate)
+	} else {
+		k.InitializeSlashMeter(ctx)
+	}
 
 	return k.InitGenesisValUpdates(ctx)
 }
@@ -147,16 +164,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		consumerStates = append(consumerStates, cs)
 	}
 
-	// ConsumerAddrsToPrune are added only for registered consumer chains
-	consumerAddrsToPrune := []types.ConsumerAddrsToPruneV2{}
-	for _, chainID := range launchedConsumerIds {
-		consumerAddrsToPrune = append(consumerAddrsToPrune, k.GetAllConsumerAddrsToPrune(ctx, chainID)...)
-	}
+	// ConsumerAddrsToPrune are added for all consumer chains, as the replaced consumer keys
+	// of stopped consumer chains are also pruned only once their prune time elapsed
+	consumerAddrsToPrune := append([]types.ConsumerAddrsToPruneV2{}, k.GetConsumerAddrsToPruneOfAllConsumers(ctx)...)
 
 	params := k.GetParams(ctx)
 
 	// TODO (PERMISSIONLESS)
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -165,4 +180,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	genState.SlashMeterState = &types.SlashMeterState{
+		SlashMeter:             k.GetSlashMeter(ctx),
+		ReplenishTimeCandidate: k.GetSlashMeterReplenishTimeCandidate(ctx),
+	}
+	genState.ConsumerRewardsAllocations = k.GetAllConsumerRewardsAllocations(ctx)
+	genState.ValsetUpdateIdToBlockTime = k.GetAllValsetUpdateBlockTimes(ctx)
+	return genState
 }
@@ -159,7 +159,84 @@ func TestInitAndExportGenesis(t *testing.T) {
 	// check provider chain's consumer chain states
 	assertConsumerChainStates(t, ctx, pk, provGenesis.ConsumerStates...)
 
-	// check the exported genesis
+	// check the exported genesis, which includes the initialized slash meter
+	provGenesis.SlashMeterState = &providertypes.SlashMeterState{
+		SlashMeter:             expectedSlashMeterValue,
+		ReplenishTimeCandidate: expectedCandidate,
+	}
+	require.Equal(t, provGenesis, pk.ExportGenesis(ctx))
+}
+
+// TestInitAndExportGenesisThrottleAndPruneState tests that the slash meter, the consumer rewards allocations,
+// and the pruning state are restored from an exported provider chain genesis
+func TestInitAndExportGenesisThrottleAndPruneState(t *testing.T) {
+	consumerId := "0"
+	stoppedConsumerId := "1"
+	now := time.Now().UTC()
+	consumerConsAddr := crypto.NewCryptoIdentityFromIntSeed(7897).ConsumerConsAddress()
+
+	provGenesis := providertypes.NewGenesisState(3,
+		[]providertypes.ValsetUpdateIdToHeight{{ValsetUpdateId: 1, Height: 5}, {ValsetUpdateId: 2, Height: 10}},
+		nil,
+		providertypes.DefaultParams(),
+		nil,
+		nil,
+		[]providertypes.ConsumerAddrsToPruneV2{
+			{
+				ChainId:       consumerId,
+				PruneTs:       now.Add(time.Hour),
+				ConsumerAddrs: &providertypes.AddressList{Addresses: [][]byte{consumerConsAddr.ToSdkConsAddr()}},
+			},
+			{
+				ChainId:       stoppedConsumerId,
+				PruneTs:       now.Add(2 * time.Hour),
+				ConsumerAddrs: &providertypes.AddressList{Addresses: [][]byte{consumerConsAddr.ToSdkConsAddr()}},
+			},
+		},
+	)
+	provGenesis.SlashMeterState = &providertypes.SlashMeterState{
+		SlashMeter:             math.NewInt(-7),
+		ReplenishTimeCandidate: now.Add(30 * time.Minute),
+	}
+	provGenesis.ConsumerRewardsAllocations = []providertypes.ConsumerRewardsAllocationByDenom{
+		{
+			ConsumerId: consumerId,
+			Denom:      "stake",
+			Allocation: providertypes.ConsumerRewardsAllocation{
+				Rewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.5"))),
+			},
+		},
+		{
+			ConsumerId: stoppedConsumerId,
+			Denom:      "uatom",
+			Allocation: providertypes.ConsumerRewardsAllocation{
+				Rewards: sdk.NewDecCoins(sdk.NewDecCoin("uatom", math.NewInt(12))),
+			},
+		},
+	}
+	provGenesis.ValsetUpdateIdToBlockTime = []providertypes.ValsetUpdateIdToBlockTime{
+		{ValsetUpdateId: 2, BlockTime: now.Add(-time.Hour)},
+	}
+	require.NoError(t, provGenesis.Validate())
+
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	// the slash meter is restored, i.e., the total voting power is not needed to initialize it
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Times(0)
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(nil, nil).AnyTimes()
+
+	pk.InitGenesis(ctx, provGenesis)
+
+	require.Equal(t, math.NewInt(-7), pk.GetSlashMeter(ctx))
+	require.Equal(t, now.Add(30*time.Minute), pk.GetSlashMeterReplenishTimeCandidate(ctx))
+	allocation, err := pk.GetConsumerRewardsAllocationByDenom(ctx, stoppedConsumerId, "uatom")
+	require.NoError(t, err)
+	require.Equal(t, provGenesis.ConsumerRewardsAllocations[1].Allocation, allocation)
+	blockTime, found := pk.GetValsetUpdateBlockTime(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, now.Add(-time.Hour), blockTime)
+	require.Len(t, pk.GetAllConsumerAddrsToPrune(ctx, stoppedConsumerId), 1)
+
 	require.Equal(t, provGenesis, pk.ExportGenesis(ctx))
 }
 
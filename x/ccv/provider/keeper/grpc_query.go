@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -16,6 +17,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -79,7 +83,7 @@ func (k Keeper) QueryConsumerChains(goCtx context.Context, req *types.QueryConsu
 		return true, nil
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, paginationError(err)
 	}
 
 	return &types.QueryConsumerChainsResponse{Chains: chains, Pagination: pageRes}, nil
@@ -113,19 +117,19 @@ func (k Keeper) GetConsumerChain(ctx sdk.Context, consumerId string) (types.Chai
 	allowlist := k.GetAllowList(ctx, consumerId)
 	strAllowlist := make([]string, len(allowlist))
 	for i, addr := range allowlist {
-		strAllowlist[i] = addr.String()
+		strAllowlist[i] = k.ConsAddressToString(addr.ToSdkConsAddr())
 	}
 
 	denylist := k.GetDenyList(ctx, consumerId)
 	strDenylist := make([]string, len(denylist))
 	for i, addr := range denylist {
-		strDenylist[i] = addr.String()
+		strDenylist[i] = k.ConsAddressToString(addr.ToSdkConsAddr())
 	}
 
 	prioritylist := k.GetPriorityList(ctx, consumerId)
 	strPrioritylist := make([]string, len(prioritylist))
 	for i, addr := range prioritylist {
-		strPrioritylist[i] = addr.String()
+		strPrioritylist[i] = k.ConsAddressToString(addr.ToSdkConsAddr())
 	}
 
 	metadata, err := k.GetConsumerMetadata(ctx, consumerId)
@@ -170,9 +174,9 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	providerAddrTmp, err := k.consensusAddressCodec.StringToBytes(req.ProviderAddress)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
 	}
 	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
 
@@ -187,7 +191,7 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 	}
 
 	return &types.QueryValidatorConsumerAddrResponse{
-		ConsumerAddress: consumerAddr.String(),
+		ConsumerAddress: k.ConsAddressToString(consumerAddr),
 	}, nil
 }
 
@@ -198,9 +202,13 @@ func (k Keeper) QueryValidatorProviderAddr(goCtx context.Context, req *types.Que
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	consumerAddrTmp, err := sdk.ConsAddressFromBech32(req.ConsumerAddress)
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consumerAddrTmp, err := k.consensusAddressCodec.StringToBytes(req.ConsumerAddress)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "invalid consumer address")
 	}
 	consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
 
@@ -210,7 +218,7 @@ func (k Keeper) QueryValidatorProviderAddr(goCtx context.Context, req *types.Que
 	}
 
 	return &types.QueryValidatorProviderAddrResponse{
-		ProviderAddress: providerAddr.String(),
+		ProviderAddress: k.ConsAddressToString(providerAddr.ToSdkConsAddr()),
 	}, nil
 }
 
@@ -263,21 +271,31 @@ func (k Keeper) QueryAllPairsValConsAddrByConsumer(
 	pairValConAddrs := []*types.PairValConAddrProviderAndConsumer{}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	validatorConsumerPubKeys := k.GetAllValidatorConsumerPubKeys(ctx, &consumerId)
-	for _, data := range validatorConsumerPubKeys {
-		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*data.ConsumerKey)
+	store := ctx.KVStore(k.storeKey)
+	consumerPubKeyStore := prefix.NewStore(store, types.StringIdWithLenKey(types.ConsumerValidatorsKeyPrefix(), consumerId))
+	pageRes, err := query.Paginate(consumerPubKeyStore, req.Pagination, func(key, value []byte) error {
+		var consumerKey tmprotocrypto.PublicKey
+		if err := consumerKey.Unmarshal(value); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
 		if err != nil {
-			return nil, err
+			return status.Error(codes.Internal, err.Error())
 		}
 		pairValConAddrs = append(pairValConAddrs, &types.PairValConAddrProviderAndConsumer{
-			ProviderAddress: sdk.ConsAddress(data.ProviderAddr).String(),
-			ConsumerAddress: consumerAddr.String(),
-			ConsumerKey:     data.ConsumerKey,
+			ProviderAddress: k.ConsAddressToString(key),
+			ConsumerAddress: k.ConsAddressToString(consumerAddr),
+			ConsumerKey:     &consumerKey,
 		})
+		return nil
+	})
+	if err != nil {
+		return nil, paginationError(err)
 	}
 
 	return &types.QueryAllPairsValConsAddrByConsumerResponse{
 		PairValConAddr: pairValConAddrs,
+		Pagination:     pageRes,
 	}, nil
 }
 
@@ -311,12 +329,19 @@ func (k Keeper) QueryConsumerChainOptedInValidators(goCtx context.Context, req *
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
 	}
 
-	for _, v := range k.GetAllOptedIn(ctx, consumerId) {
-		optedInVals = append(optedInVals, v.ToSdkConsAddr().String())
+	store := ctx.KVStore(k.storeKey)
+	optedInStore := prefix.NewStore(store, types.StringIdWithLenKey(types.OptedInKeyPrefix(), consumerId))
+	pageRes, err := query.Paginate(optedInStore, req.Pagination, func(key, _ []byte) error {
+		optedInVals = append(optedInVals, k.ConsAddressToString(key))
+		return nil
+	})
+	if err != nil {
+		return nil, paginationError(err)
 	}
 
 	return &types.QueryConsumerChainOptedInValidatorsResponse{
 		ValidatorsProviderAddresses: optedInVals,
+		Pagination:                  pageRes,
 	}, nil
 }
 
@@ -388,8 +413,17 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 		})
 	}
 
+	// the consumer validators are sorted by their provider addresses, which are used as pagination keys
+	start, end, pageRes, err := paginateSorted(len(consumerValSet), func(i int) []byte {
+		return consumerValSet[i].ProviderConsAddr
+	}, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	lastValidators := &lastValidatorsCache{}
 	var validators []*types.QueryConsumerValidatorsValidator
-	for _, consumerVal := range consumerValSet {
+	for _, consumerVal := range consumerValSet[start:end] {
 		provAddr := types.ProviderConsAddress{Address: consumerVal.ProviderConsAddr}
 		consAddr := provAddr.ToSdkConsAddr()
 
@@ -399,7 +433,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 			continue
 		}
 
-		hasToValidate, err := k.hasToValidate(ctx, provAddr, consumerId)
+		hasToValidate, err := k.hasToValidate(ctx, provAddr, consumerId, lastValidators)
 		if err != nil {
 			k.Logger(ctx).Error("cannot define if validator %s has to validate for consumer %s for current epoch",
 				provAddr.String(), consumerId)
@@ -412,7 +446,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 		}
 
 		validators = append(validators, &types.QueryConsumerValidatorsValidator{
-			ProviderAddress:         sdk.ConsAddress(consumerVal.ProviderConsAddr).String(),
+			ProviderAddress:         k.ConsAddressToString(consumerVal.ProviderConsAddr),
 			ConsumerKey:             consumerVal.PublicKey,
 			ConsumerPower:           consumerVal.Power,
 			ConsumerCommissionRate:  consumerRate,
@@ -428,9 +462,56 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 	}
 	return &types.QueryConsumerValidatorsResponse{
 		Validators: validators,
+		Pagination: pageRes,
 	}, nil
 }
 
+// paginationError returns the gRPC error of a failed pagination. The errors returned by
+// the pagination callbacks are gRPC errors, while the others are due to an invalid page request.
+func paginationError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// paginateSorted returns the bounds [start, end) of the page requested by pageReq
+// in a list of n items sorted by the given keys, as well as the page response.
+// As query.Paginate, it supports either key-based or offset-based pagination,
+// and returns query.DefaultLimit items and the total count if no limit is given.
+func paginateSorted(n int, key func(i int) []byte, pageReq *query.PageRequest) (start, end int, pageRes *query.PageResponse, err error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if pageReq.Offset > 0 && pageReq.Key != nil {
+		return 0, 0, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if pageReq.Reverse {
+		return 0, 0, nil, fmt.Errorf("invalid request, reverse pagination is not supported")
+	}
+
+	limit, countTotal := pageReq.Limit, pageReq.CountTotal
+	if limit == 0 {
+		limit, countTotal = query.DefaultLimit, true
+	}
+
+	if pageReq.Key != nil {
+		start = sort.Search(n, func(i int) bool { return bytes.Compare(key(i), pageReq.Key) >= 0 })
+	} else {
+		start = int(min(pageReq.Offset, uint64(n)))
+	}
+	end = int(min(uint64(start)+limit, uint64(n)))
+
+	pageRes = &query.PageResponse{}
+	if end < n {
+		pageRes.NextKey = key(end)
+	}
+	if countTotal && pageReq.Key == nil {
+		pageRes.Total = uint64(n)
+	}
+	return start, end, pageRes, nil
+}
+
 // QueryConsumerChainsValidatorHasToValidate returns all consumer chains that the given validator has to validate now
 // or in the next epoch if nothing changes.
 func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context, req *types.QueryConsumerChainsValidatorHasToValidateRequest) (*types.QueryConsumerChainsValidatorHasToValidateResponse, error) {
@@ -442,7 +523,7 @@ func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "empty provider address")
 	}
 
-	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	consAddr, err := k.consensusAddressCodec.StringToBytes(req.ProviderAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
 	}
@@ -454,10 +535,11 @@ func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context,
 	// get all the consumer chains for which the validator is either already
 	// opted-in, currently a consumer validator or if its voting power is within the TopN validators
 	consumersToValidate := []string{}
+	lastValidators := &lastValidatorsCache{}
 	// To avoid large iterations over all the consumer IDs, iterate only over
 	// chains with an IBC client created.
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
-		if hasToValidate, err := k.hasToValidate(ctx, provAddr, consumerId); err == nil && hasToValidate {
+		if hasToValidate, err := k.hasToValidate(ctx, provAddr, consumerId, lastValidators); err == nil && hasToValidate {
 			consumersToValidate = append(consumersToValidate, consumerId)
 		}
 	}
@@ -467,11 +549,30 @@ func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context,
 	}, nil
 }
 
+// lastValidatorsCache holds the last bonded and provider active validators,
+// such that they are read from the staking module at most once per query
+type lastValidatorsCache struct {
+	bondedValidators []stakingtypes.Validator
+	activeValidators []stakingtypes.Validator
+	err              error
+	fetched          bool
+}
+
+// get returns the last bonded and provider active validators, reading them on the first call
+func (c *lastValidatorsCache) get(ctx sdk.Context, k Keeper) ([]stakingtypes.Validator, []stakingtypes.Validator, error) {
+	if !c.fetched {
+		c.bondedValidators, c.activeValidators, c.err = k.GetLastBondedAndActiveValidators(ctx)
+		c.fetched = true
+	}
+	return c.bondedValidators, c.activeValidators, c.err
+}
+
 // hasToValidate checks if a validator needs to validate on a consumer chain
 func (k Keeper) hasToValidate(
 	ctx sdk.Context,
 	provAddr types.ProviderConsAddress,
 	consumerId string,
+	lastValidators *lastValidatorsCache,
 ) (bool, error) {
 	// only ask validators to validate active chains
 	if !k.IsConsumerActive(ctx, consumerId) {
@@ -484,7 +585,7 @@ func (k Keeper) hasToValidate(
 	}
 
 	// if the validator was not part of the last epoch, check if the validator is going to be part of te next epoch
-	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
+	lastVals, activeValidators, err := lastValidators.get(ctx, k)
 	if err != nil {
 		return false, nil
 	}
@@ -506,10 +607,6 @@ func (k Keeper) hasToValidate(
 
 	// if the validator belongs to the validators of the next epoch, then if nothing changes
 	// the validator would have to validate in the next epoch
-	lastVals, err := k.GetLastBondedValidators(ctx)
-	if err != nil {
-		return false, err
-	}
 	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, lastVals, powerShapingParameters, minPowerToOptIn)
 	if err != nil {
 		return false, err
@@ -536,7 +633,7 @@ func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	consAddr, err := k.consensusAddressCodec.StringToBytes(req.ProviderAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
 	}
@@ -557,7 +654,7 @@ func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req
 	} else {
 		v, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown validator: %s", consAddr.String()))
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown validator: %s", req.ProviderAddress))
 		}
 		res.Rate = v.Commission.Rate
 	}
@@ -567,6 +664,10 @@ func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req
 
 // QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
 func (k Keeper) QueryBlocksUntilNextEpoch(goCtx context.Context, req *types.QueryBlocksUntilNextEpochRequest) (*types.QueryBlocksUntilNextEpochResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// Calculate the blocks until the next epoch
@@ -577,6 +678,10 @@ func (k Keeper) QueryBlocksUntilNextEpoch(goCtx context.Context, req *types.Quer
 
 // QueryConsumerIdFromClientId returns the consumer id of the chain associated with this client id
 func (k Keeper) QueryConsumerIdFromClientId(goCtx context.Context, req *types.QueryConsumerIdFromClientIdRequest) (*types.QueryConsumerIdFromClientIdResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	consumerId, found := k.GetClientIdToConsumerId(ctx, req.ClientId)
@@ -632,16 +737,47 @@ func (k Keeper) QueryConsumerChain(goCtx context.Context, req *types.QueryConsum
 	// That's why we do not check if the client id is found.
 	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
 
+	minCommissionRate, err := k.GetMinCommissionRate(ctx, consumerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "cannot retrieve min commission rate for consumer id: %s", consumerId)
+	}
+
+	var downtimeParams *ccvtypes.DowntimeParams
+	if params, found := k.GetConsumerDowntimeParams(ctx, consumerId); found {
+		downtimeParams = &params
+	}
+
+	var committee []string
+	for _, member := range k.GetCommittee(ctx, consumerId) {
+		committee = append(committee, k.ConsAddressToString(member.ToSdkConsAddr()))
+	}
+
+	// the pending update of the infraction parameters, if any
+	var queuedInfractionParams *types.InfractionParameters
+	var infractionParamsUpdateTime *time.Time
+	if params, err := k.GetQueuedInfractionParameters(ctx, consumerId); err == nil {
+		queuedInfractionParams = &params
+		if updateTime, err := k.GetConsumerInfractionUpdateTime(ctx, consumerId); err == nil {
+			infractionParamsUpdateTime = &updateTime
+		}
+	}
+
 	return &types.QueryConsumerChainResponse{
-		ChainId:              chainId,
-		ConsumerId:           consumerId,
-		OwnerAddress:         ownerAddress,
-		Phase:                phase.String(),
-		Metadata:             metadata,
-		InitParams:           &initParams,
-		PowerShapingParams:   &powerParams,
-		InfractionParameters: &infractionParams,
-		ClientId:             clientId,
+		ChainId:                        chainId,
+		ConsumerId:                     consumerId,
+		OwnerAddress:                   ownerAddress,
+		Phase:                          phase.String(),
+		Metadata:                       metadata,
+		InitParams:                     &initParams,
+		PowerShapingParams:             &powerParams,
+		InfractionParameters:           &infractionParams,
+		ClientId:                       clientId,
+		MinCommissionRate:              minCommissionRate,
+		DowntimeParams:                 downtimeParams,
+		ProviderCcvTimeoutPeriod:       k.GetCCVTimeoutPeriodForConsumer(ctx, consumerId),
+		Committee:                      committee,
+		QueuedInfractionParameters:     queuedInfractionParams,
+		InfractionParametersUpdateTime: infractionParamsUpdateTime,
 	}, nil
 }
 
@@ -703,3 +839,1069 @@ func (k Keeper) QueryConsumerGenesisTime(goCtx context.Context, req *types.Query
 		GenesisTime: time.Unix(0, int64(cs.GetTimestamp())), // nolint:staticcheck
 	}, nil
 }
+
+// QueryConsumerVscConfirmations returns the unconfirmed and the mismatched VSC packets
+// of the consumer chain associated with the provided consumer id
+func (k Keeper) QueryConsumerVscConfirmations(goCtx context.Context, req *types.QueryConsumerVscConfirmationsRequest) (*types.QueryConsumerVscConfirmationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unconfirmed := []types.VscConfirmation{}
+	mismatched := []types.VscConfirmation{}
+	for _, confirmation := range k.GetAllVscConfirmations(ctx, consumerId) {
+		if len(confirmation.AppliedValsetHash) == 0 {
+			unconfirmed = append(unconfirmed, confirmation)
+		} else {
+			mismatched = append(mismatched, confirmation)
+		}
+	}
+
+	return &types.QueryConsumerVscConfirmationsResponse{
+		Unconfirmed: unconfirmed,
+		Mismatched:  mismatched,
+	}, nil
+}
+
+// QueryRecentPacketErrors returns the most recent packets received from consumer chains
+// that resulted in error acknowledgements, optionally filtered by consumer id
+func (k Keeper) QueryRecentPacketErrors(goCtx context.Context, req *types.QueryRecentPacketErrorsRequest) (*types.QueryRecentPacketErrorsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsumerId != "" {
+		if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packetErrors := []types.PacketError{}
+	for _, packetError := range k.GetRecentPacketErrors(ctx) {
+		if req.ConsumerId == "" || packetError.ConsumerId == req.ConsumerId {
+			packetErrors = append(packetErrors, packetError)
+		}
+	}
+
+	return &types.QueryRecentPacketErrorsResponse{PacketErrors: packetErrors}, nil
+}
+
+// QueryConsumerRelayerLiveness returns the last packet received from and the last packet acknowledged by
+// the consumer chain associated with the provided consumer id, as well as whether its relayers are stale
+func (k Keeper) QueryConsumerRelayerLiveness(goCtx context.Context, req *types.QueryConsumerRelayerLivenessRequest) (*types.QueryConsumerRelayerLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetConsumerIdToChannelId(ctx, consumerId); !found {
+		return nil, status.Errorf(codes.NotFound, "no CCV channel established for consumer id: %s", consumerId)
+	}
+
+	liveness, _ := k.GetRelayerLiveness(ctx, consumerId)
+	pendingVSCPackets := k.GetPendingVSCPacketCount(ctx, consumerId)
+	staleness, stale := k.GetRelayerStaleness(ctx, consumerId, pendingVSCPackets)
+
+	return &types.QueryConsumerRelayerLivenessResponse{
+		Liveness:          liveness,
+		PendingVscPackets: pendingVSCPackets,
+		Staleness:         staleness,
+		Stale:             stale,
+	}, nil
+}
+
+// QueryConsumerClientExpiry returns when the client of the consumer chain
+// associated with the provided consumer id expires unless it is updated
+func (k Keeper) QueryConsumerClientExpiry(goCtx context.Context, req *types.QueryConsumerClientExpiryRequest) (*types.QueryConsumerClientExpiryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	expiry, err := k.GetConsumerClientExpiry(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryConsumerClientExpiryResponse{ClientExpiry: expiry}, nil
+}
+
+// QueryValidatorConsumerStatus returns, for the validator with the provided operator address,
+// the status on every consumer chain it is assigned to, i.e., every active consumer chain
+// it opted in to or whose validator set of the last epoch contains it (e.g., after it opted out).
+// Note that the validators of Top N consumer chains are opted in automatically.
+func (k Keeper) QueryValidatorConsumerStatus(goCtx context.Context, req *types.QueryValidatorConsumerStatusRequest) (*types.QueryValidatorConsumerStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown validator: %s", req.ValidatorAddress))
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	providerKey, err := validator.CmtConsPublicKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	provAddr := types.NewProviderConsAddress(consAddr)
+
+	// the validator is assigned to the consumer chains it opted in to and to the consumer chains
+	// it still validates, i.e., whose validator set of the last epoch contains it
+	optedIn := map[string]bool{}
+	for _, consumerId := range k.GetOptedInConsumerIds(ctx, provAddr) {
+		optedIn[consumerId] = true
+	}
+
+	consumers := []types.ValidatorConsumerStatus{}
+	lastValidators := &lastValidatorsCache{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		_, inValset := k.GetConsumerValidator(ctx, consumerId, provAddr)
+		if !optedIn[consumerId] && !inValset {
+			continue
+		}
+		hasToValidate, err := k.hasToValidate(ctx, provAddr, consumerId, lastValidators)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		consumerStatus, err := k.getValidatorConsumerStatus(ctx, consumerId, validator, provAddr, providerKey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		consumerStatus.OptedIn = optedIn[consumerId]
+		consumerStatus.HasToValidate = hasToValidate
+		consumers = append(consumers, consumerStatus)
+	}
+
+	return &types.QueryValidatorConsumerStatusResponse{
+		ProviderAddress: k.ConsAddressToString(consAddr),
+		Jailed:          validator.Jailed,
+		Tombstoned:      k.slashingKeeper.IsTombstoned(ctx, consAddr),
+		Consumers:       consumers,
+	}, nil
+}
+
+// getValidatorConsumerStatus returns the status of a validator on a consumer chain,
+// except for whether the validator opted in to or has to validate the consumer chain
+func (k Keeper) getValidatorConsumerStatus(
+	ctx sdk.Context,
+	consumerId string,
+	validator stakingtypes.Validator,
+	provAddr types.ProviderConsAddress,
+	providerKey tmprotocrypto.PublicKey,
+) (types.ValidatorConsumerStatus, error) {
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return types.ValidatorConsumerStatus{}, err
+	}
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return types.ValidatorConsumerStatus{}, err
+	}
+
+	consumerKey, assigned := k.GetValidatorConsumerPubKey(ctx, consumerId, provAddr)
+	if !assigned {
+		consumerKey = providerKey
+	}
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	if err != nil {
+		return types.ValidatorConsumerStatus{}, err
+	}
+
+	commissionRate, found := k.GetConsumerCommissionRate(ctx, consumerId, provAddr)
+	if !found {
+		commissionRate = validator.Commission.Rate
+	}
+
+	consumerValidator, inValset := k.GetConsumerValidator(ctx, consumerId, provAddr)
+
+	// the slash acks contain the consumer addresses of the validators whose downtime
+	// infractions were handled, but not yet acknowledged to the consumer chain
+	outstandingDowntime := false
+	for _, ack := range k.GetSlashAcks(ctx, consumerId) {
+		ackAddr, err := ccvtypes.GetConsAddrFromBech32(ack)
+		if err != nil {
+			continue
+		}
+		ackProvAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, types.NewConsumerConsAddress(ackAddr))
+		if ackProvAddr.ToSdkConsAddr().Equals(provAddr.ToSdkConsAddr()) {
+			outstandingDowntime = true
+			break
+		}
+	}
+
+	return types.ValidatorConsumerStatus{
+		ConsumerId:             consumerId,
+		ChainId:                chainId,
+		Phase:                  k.GetConsumerPhase(ctx, consumerId),
+		Top_N:                  powerShapingParameters.Top_N,
+		ConsumerKey:            &consumerKey,
+		ConsumerKeyAssigned:    assigned,
+		ConsumerAddress:        k.ConsAddressToString(consumerAddr),
+		ConsumerCommissionRate: commissionRate,
+		InValset:               inValset,
+		ConsumerPower:          consumerValidator.Power,
+		OutstandingDowntime:    outstandingDowntime,
+	}, nil
+}
+
+// QueryValidatorConsumerChains returns, for the validator with the provided operator address,
+// every launched consumer chain it has to run a node for, together with the IBC identifiers
+// of the chain and the consensus key to use on it
+func (k Keeper) QueryValidatorConsumerChains(goCtx context.Context, req *types.QueryValidatorConsumerChainsRequest) (*types.QueryValidatorConsumerChainsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown validator: %s", req.ValidatorAddress))
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	providerKey, err := validator.CmtConsPublicKey()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	provAddr := types.NewProviderConsAddress(consAddr)
+
+	chains := []types.ValidatorConsumerChain{}
+	lastValidators := &lastValidatorsCache{}
+	// only consumer chains with a client were launched and can have nodes
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		inValset := k.IsConsumerValidator(ctx, consumerId, provAddr)
+		if !inValset {
+			hasToValidate, err := k.hasToValidate(ctx, provAddr, consumerId, lastValidators)
+			if err != nil || !hasToValidate {
+				continue
+			}
+		}
+
+		chain, err := k.getValidatorConsumerChain(ctx, consumerId, provAddr, providerKey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		chain.InValset = inValset
+		chains = append(chains, chain)
+	}
+
+	return &types.QueryValidatorConsumerChainsResponse{
+		ProviderAddress: k.ConsAddressToString(consAddr),
+		Chains:          chains,
+	}, nil
+}
+
+// getValidatorConsumerChain returns the information a validator needs to run a node
+// for a consumer chain, except for whether the validator is in the consumer validator set
+func (k Keeper) getValidatorConsumerChain(
+	ctx sdk.Context,
+	consumerId string,
+	provAddr types.ProviderConsAddress,
+	providerKey tmprotocrypto.PublicKey,
+) (types.ValidatorConsumerChain, error) {
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return types.ValidatorConsumerChain{}, err
+	}
+	metadata, err := k.GetConsumerMetadata(ctx, consumerId)
+	if err != nil {
+		return types.ValidatorConsumerChain{}, err
+	}
+	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+	channelId, _ := k.GetConsumerIdToChannelId(ctx, consumerId)
+
+	consumerKey, assigned := k.GetValidatorConsumerPubKey(ctx, consumerId, provAddr)
+	if !assigned {
+		consumerKey = providerKey
+	}
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	if err != nil {
+		return types.ValidatorConsumerChain{}, err
+	}
+
+	return types.ValidatorConsumerChain{
+		ConsumerId:          consumerId,
+		ChainId:             chainId,
+		Phase:               k.GetConsumerPhase(ctx, consumerId),
+		Metadata:            metadata,
+		ClientId:            clientId,
+		ChannelId:           channelId,
+		ConsumerKey:         &consumerKey,
+		ConsumerKeyAssigned: assigned,
+		ConsumerAddress:     k.ConsAddressToString(consumerAddr),
+	}, nil
+}
+
+// QueryConsumerValidatorsAtVSC returns the validator set that the provider sent to the consumer chain
+// with the provided consumer id and that the consumer chain used at the provided VSC id
+func (k Keeper) QueryConsumerValidatorsAtVSC(goCtx context.Context, req *types.QueryConsumerValidatorsAtVSCRequest) (*types.QueryConsumerValidatorsAtVSCResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the current valset update ID is not yet sent to any consumer chain
+	if req.VscId >= k.GetValidatorSetUpdateId(ctx) {
+		return nil, status.Errorf(codes.InvalidArgument, "VSC id %d was not yet sent to any consumer chain", req.VscId)
+	}
+
+	snapshot, found := k.GetValsetSnapshotAtVsc(ctx, consumerId, req.VscId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no validator set retained for consumer id %s at VSC id %d", consumerId, req.VscId)
+	}
+
+	validators := make([]types.QueryConsumerValidatorsAtVSCValidator, 0, len(snapshot.Validators))
+	for _, v := range snapshot.Validators {
+		consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*v.PublicKey)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		validators = append(validators, types.QueryConsumerValidatorsAtVSCValidator{
+			ProviderAddress: k.ConsAddressToString(v.ProviderConsAddr),
+			ConsumerKey:     v.PublicKey,
+			ConsumerAddress: k.ConsAddressToString(consumerAddr),
+			Power:           v.Power,
+		})
+	}
+
+	return &types.QueryConsumerValidatorsAtVSCResponse{
+		ValsetUpdateId: snapshot.ValsetUpdateId,
+		ProviderHeight: snapshot.ProviderHeight,
+		Validators:     validators,
+	}, nil
+}
+
+// QuerySlashPacketTrace returns how the slash packet with the provided sequence received from the consumer chain
+// with the provided consumer id was handled, as well as the other attempts to send the same slash packet
+func (k Keeper) QuerySlashPacketTrace(goCtx context.Context, req *types.QuerySlashPacketTraceRequest) (*types.QuerySlashPacketTraceResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if trace, found := k.GetSlashPacketTrace(ctx, consumerId, req.Sequence); found {
+		return &types.QuerySlashPacketTraceResponse{
+			Trace:    &trace,
+			Attempts: k.GetSlashPacketAttempts(ctx, consumerId, trace),
+		}, nil
+	}
+
+	// the state changes of packets resulting in error acknowledgements are discarded,
+	// hence such packets can only be found among the recent packet errors
+	for _, packetError := range k.GetRecentPacketErrors(ctx) {
+		if packetError.ConsumerId == consumerId && packetError.Sequence == req.Sequence {
+			return &types.QuerySlashPacketTraceResponse{
+				Attempts:    []types.SlashPacketTrace{},
+				PacketError: &packetError,
+			}, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "no slash packet with sequence %d retained for consumer id %s", req.Sequence, consumerId)
+}
+
+// QueryNextEpoch returns the number of blocks and the estimated time until the next epoch starts,
+// as well as the VSC packets to be sent to the launched consumer chains at the start of the next epoch
+func (k Keeper) QueryNextEpoch(goCtx context.Context, req *types.QueryNextEpochRequest) (*types.QueryNextEpochResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	nextEpochHeight := k.NextEpochHeight(ctx)
+	blocksUntilNextEpoch := nextEpochHeight - ctx.BlockHeight()
+	res := &types.QueryNextEpochResponse{
+		NextEpochHeight:      nextEpochHeight,
+		BlocksUntilNextEpoch: uint64(blocksUntilNextEpoch),
+		Consumers:            []types.ConsumerNextVSC{},
+	}
+	if avgBlockTime, ok := k.EstimateAverageBlockTime(ctx); ok {
+		res.AverageBlockTime = avgBlockTime
+		res.EstimatedTimeUntilNextEpoch = avgBlockTime * time.Duration(blocksUntilNextEpoch)
+	}
+	if period := k.GetValsetCheckpointPeriod(ctx); period > 0 {
+		res.ValsetCheckpoint = (nextEpochHeight/k.GetBlocksPerEpoch(ctx))%period == 0
+	}
+
+	bondedValidators, activeValidators, err := k.GetLastBondedAndActiveValidators(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+
+		// compute the validator updates on a branch of the state,
+		// as the computation also stores the next consumer validator set
+		cachedCtx, _ := ctx.CacheContext()
+		currentValSet, err := k.GetConsumerValSet(cachedCtx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		valUpdates, err := k.ComputeConsumerNextValSet(cachedCtx, bondedValidators, activeValidators, consumerId, currentValSet)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+		res.Consumers = append(res.Consumers, types.ConsumerNextVSC{
+			ConsumerId:        consumerId,
+			ChainId:           chainId,
+			VscPacket:         len(valUpdates) > 0,
+			ValidatorUpdates:  uint32(len(valUpdates)),
+			PendingVscPackets: uint32(len(k.GetPendingVSCPackets(ctx, consumerId))),
+		})
+	}
+
+	return res, nil
+}
+
+// QueryConsumerIdFromChannelId returns the consumer id and the client id of the chain associated with this CCV channel id
+func (k Keeper) QueryConsumerIdFromChannelId(goCtx context.Context, req *types.QueryConsumerIdFromChannelIdRequest) (*types.QueryConsumerIdFromChannelIdResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerId, found := k.GetChannelIdToConsumerId(ctx, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no known consumer chain for this channel id: %s", req.ChannelId)
+	}
+	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+
+	return &types.QueryConsumerIdFromChannelIdResponse{ConsumerId: consumerId, ClientId: clientId}, nil
+}
+
+// QueryConsumerIbcIds returns the client id and the CCV channel id of the consumer chain associated with the consumer id
+func (k Keeper) QueryConsumerIbcIds(goCtx context.Context, req *types.QueryConsumerIbcIdsRequest) (*types.QueryConsumerIbcIdsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no client for consumer chain: %s", consumerId)
+	}
+	chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+	channelId, _ := k.GetConsumerIdToChannelId(ctx, consumerId)
+
+	return &types.QueryConsumerIbcIdsResponse{ChainId: chainId, ClientId: clientId, ChannelId: channelId}, nil
+}
+
+// QueryUpcomingConsumerLaunches returns the consumer chains in the registered and initialized phases ordered by spawn time,
+// together with whether they could launch given the current validator set
+func (k Keeper) QueryUpcomingConsumerLaunches(goCtx context.Context, req *types.QueryUpcomingConsumerLaunchesRequest) (*types.QueryUpcomingConsumerLaunchesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerIds := []string{}
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.IsConsumerPrelaunched(ctx, consumerId) {
+			consumerIds = append(consumerIds, consumerId)
+		}
+	}
+	launches := []types.UpcomingConsumerLaunch{}
+	if len(consumerIds) == 0 {
+		return &types.QueryUpcomingConsumerLaunchesResponse{Launches: launches}, nil
+	}
+
+	bondedValidators, activeValidators, err := k.GetLastBondedAndActiveValidators(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, consumerId := range consumerIds {
+		launch := types.UpcomingConsumerLaunch{
+			ConsumerId: consumerId,
+			Phase:      k.GetConsumerPhase(ctx, consumerId),
+		}
+		launch.ChainId, _ = k.GetConsumerChainId(ctx, consumerId)
+		if initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId); err == nil {
+			launch.SpawnTime = initializationParameters.SpawnTime
+		}
+		if !launch.SpawnTime.IsZero() && launch.SpawnTime.After(ctx.BlockTime()) {
+			launch.TimeUntilSpawn = launch.SpawnTime.Sub(ctx.BlockTime())
+		}
+
+		// compute the initial validator set on a branch of the state,
+		// as the computation also stores the consumer validator set
+		cachedCtx, _ := ctx.CacheContext()
+		initialValUpdates, err := k.ComputeConsumerNextValSet(cachedCtx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		launch.Validators = uint32(len(initialValUpdates))
+		launch.HasActiveValidator, err = k.HasActiveConsumerValidator(cachedCtx, consumerId, activeValidators)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		launch.Ready = launch.Phase == types.CONSUMER_PHASE_INITIALIZED && launch.HasActiveValidator
+
+		launches = append(launches, launch)
+	}
+
+	// order by spawn time, with the consumer chains without spawn time last;
+	// the consumer ids are already in ascending order
+	sort.SliceStable(launches, func(i, j int) bool {
+		if launches[i].SpawnTime.IsZero() || launches[j].SpawnTime.IsZero() {
+			return !launches[i].SpawnTime.IsZero() && launches[j].SpawnTime.IsZero()
+		}
+		return launches[i].SpawnTime.Before(launches[j].SpawnTime)
+	})
+
+	return &types.QueryUpcomingConsumerLaunchesResponse{Launches: launches}, nil
+}
+
+// QueryConsumerUpdateHistory returns the retained updates applied with MsgUpdateConsumer
+// to the consumer chain with the provided consumer id
+func (k Keeper) QueryConsumerUpdateHistory(goCtx context.Context, req *types.QueryConsumerUpdateHistoryRequest) (*types.QueryConsumerUpdateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer id: %s", consumerId)
+	}
+
+	records := k.GetAllConsumerUpdateRecords(ctx, consumerId)
+	if records == nil {
+		records = []types.ConsumerUpdateRecord{}
+	}
+	return &types.QueryConsumerUpdateHistoryResponse{Records: records}, nil
+}
+
+// QuerySimulateConsumerLaunch computes, against the current provider state, the initial validator set
+// of a consumer chain with the provided parameters as if it launched in the current block
+func (k Keeper) QuerySimulateConsumerLaunch(goCtx context.Context, req *types.QuerySimulateConsumerLaunchRequest) (*types.QuerySimulateConsumerLaunchResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the simulation runs on a branch of the state, as launching
+	// a consumer chain stores its initial validator set
+	cachedCtx, _ := ctx.CacheContext()
+
+	consumerId := req.ConsumerId
+	initializationParameters := req.InitializationParameters
+	powerShapingParameters := req.PowerShapingParameters
+	if consumerId != "" {
+		if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !k.IsConsumerPrelaunched(cachedCtx, consumerId) {
+			return nil, status.Errorf(codes.FailedPrecondition,
+				"cannot simulate the launch of consumer chain %s in phase %s", consumerId, k.GetConsumerPhase(cachedCtx, consumerId))
+		}
+	} else {
+		// simulate the creation of the consumer chain, as done by MsgCreateConsumer
+		if req.ChainId == "" {
+			return nil, status.Error(codes.InvalidArgument, "empty chain id")
+		}
+		consumerId = k.FetchAndIncrementConsumerId(cachedCtx)
+		k.SetConsumerPhase(cachedCtx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+		if initializationParameters == nil {
+			defaultParameters := types.DefaultConsumerInitializationParameters()
+			initializationParameters = &defaultParameters
+		}
+		if powerShapingParameters == nil {
+			powerShapingParameters = &types.PowerShapingParameters{}
+		}
+	}
+
+	if req.ChainId != "" {
+		if err := types.ValidateChainId("ChainId", req.ChainId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		k.SetConsumerChainId(cachedCtx, consumerId, req.ChainId)
+	}
+	if initializationParameters != nil {
+		if err := types.ValidateInitializationParameters(*initializationParameters); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := k.SetConsumerInitializationParameters(cachedCtx, consumerId, *initializationParameters); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if powerShapingParameters != nil {
+		if err := types.ValidatePowerShapingParameters(*powerShapingParameters); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if err := k.SetConsumerPowerShapingParameters(cachedCtx, consumerId, *powerShapingParameters); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	bondedValidators, activeValidators, err := k.GetLastBondedAndActiveValidators(cachedCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// compute the initial validator set as done when the consumer chain launches
+	initialValUpdates, err := k.ComputeConsumerNextValSet(cachedCtx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp := &types.QuerySimulateConsumerLaunchResponse{
+		ConsumerId:            consumerId,
+		Validators:            []types.SimulatedConsumerValidator{},
+		MissingKeyAssignments: []string{},
+	}
+	if err := k.ValidateInitialValSet(cachedCtx, consumerId, initialValUpdates, activeValidators); err != nil {
+		resp.LaunchError = err.Error()
+	}
+
+	topNValidators := map[string]bool{}
+	if minPower, found := k.GetMinimumPowerInTopN(cachedCtx, consumerId); found {
+		resp.MinPowerInTop_N = minPower
+		for _, val := range activeValidators {
+			valAddr, err := k.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			power, err := k.stakingKeeper.GetLastValidatorPower(cachedCtx, valAddr)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if power < minPower {
+				continue
+			}
+			consAddr, err := val.GetConsAddr()
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			topNValidators[sdk.ConsAddress(consAddr).String()] = true
+		}
+	}
+
+	consumerValSet, err := k.GetConsumerValSet(cachedCtx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, v := range consumerValSet {
+		provAddr := types.NewProviderConsAddress(v.ProviderConsAddr)
+		_, keyAssigned := k.GetValidatorConsumerPubKey(cachedCtx, consumerId, provAddr)
+		inTopN := topNValidators[provAddr.ToSdkConsAddr().String()]
+		resp.Validators = append(resp.Validators, types.SimulatedConsumerValidator{
+			ProviderAddress:     k.ConsAddressToString(v.ProviderConsAddr),
+			ConsumerKey:         v.PublicKey,
+			Power:               v.Power,
+			ConsumerKeyAssigned: keyAssigned,
+			InTop_N:             inTopN,
+		})
+		if inTopN && !keyAssigned {
+			resp.MissingKeyAssignments = append(resp.MissingKeyAssignments, k.ConsAddressToString(v.ProviderConsAddr))
+		}
+	}
+
+	return resp, nil
+}
+
+// QueryPowerShapingTemplates returns the power-shaping templates, optionally filtered by owner
+func (k Keeper) QueryPowerShapingTemplates(goCtx context.Context, req *types.QueryPowerShapingTemplatesRequest) (*types.QueryPowerShapingTemplatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	templates := []types.PowerShapingTemplate{}
+
+	store := ctx.KVStore(k.storeKey)
+	templateStore := prefix.NewStore(store, []byte{types.PowerShapingTemplateKeyPrefix()})
+	pageRes, err := query.FilteredPaginate(templateStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var template types.PowerShapingTemplate
+		if err := template.Unmarshal(value); err != nil {
+			return false, status.Error(codes.Internal, err.Error())
+		}
+
+		if req.Owner != "" && req.Owner != template.Owner {
+			return false, nil
+		}
+
+		if accumulate {
+			templates = append(templates, template)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, paginationError(err)
+	}
+
+	return &types.QueryPowerShapingTemplatesResponse{Templates: templates, Pagination: pageRes}, nil
+}
+
+// QueryPowerShapingTemplate returns the power-shaping template with the given id
+func (k Keeper) QueryPowerShapingTemplate(goCtx context.Context, req *types.QueryPowerShapingTemplateRequest) (*types.QueryPowerShapingTemplateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if strings.TrimSpace(req.TemplateId) == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty template id")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	template, found := k.GetPowerShapingTemplate(ctx, req.TemplateId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "cannot find power-shaping template with id (%s)", req.TemplateId)
+	}
+
+	return &types.QueryPowerShapingTemplateResponse{Template: template}, nil
+}
+
+// QueryConsumerUpgradePlan returns the upgrade planned by the owner of the consumer chain with the given consumer id
+func (k Keeper) QueryConsumerUpgradePlan(goCtx context.Context, req *types.QueryConsumerUpgradePlanRequest) (*types.QueryConsumerUpgradePlanResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	plan, found := k.GetConsumerUpgradePlan(ctx, req.ConsumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "cannot find upgrade plan for consumer id (%s)", req.ConsumerId)
+	}
+
+	return &types.QueryConsumerUpgradePlanResponse{UpgradePlan: plan}, nil
+}
+
+// QueryConsumerProjectedDropOffs returns the validators of the launched consumer chain with the given consumer id
+// that are projected to drop out of its validator set at the next epoch
+func (k Keeper) QueryConsumerProjectedDropOffs(goCtx context.Context, req *types.QueryConsumerProjectedDropOffsRequest) (*types.QueryConsumerProjectedDropOffsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if phase := k.GetConsumerPhase(ctx, req.ConsumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+		return nil, status.Errorf(codes.FailedPrecondition, "consumer chain %s is not launched: %s", req.ConsumerId, phase)
+	}
+
+	bondedValidators, activeValidators, err := k.GetLastBondedAndActiveValidators(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get last validators: %s", err)
+	}
+
+	dropOffs, err := k.ComputeProjectedDropOffs(ctx, req.ConsumerId, bondedValidators, activeValidators)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	validators := make([]types.ProjectedDropOff, 0, len(dropOffs))
+	for _, val := range dropOffs {
+		validators = append(validators, types.ProjectedDropOff{
+			ProviderAddress: k.ConsAddressToString(val.ProviderConsAddr),
+			ConsumerKey:     val.PublicKey,
+			Power:           val.Power,
+		})
+	}
+
+	return &types.QueryConsumerProjectedDropOffsResponse{
+		Validators:           validators,
+		BlocksUntilNextEpoch: k.BlocksUntilNextEpoch(ctx),
+	}, nil
+}
+
+// QueryVSCHistory returns the retained VSC packets sent to the consumer chain with the provided consumer id,
+// together with their acknowledgement status
+func (k Keeper) QueryVSCHistory(goCtx context.Context, req *types.QueryVSCHistoryRequest) (*types.QueryVSCHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer id: %s", consumerId)
+	}
+
+	packets := k.GetAllVSCPacketRecords(ctx, consumerId)
+	if packets == nil {
+		packets = []types.VSCPacketRecord{}
+	}
+	return &types.QueryVSCHistoryResponse{Packets: packets}, nil
+}
+
+// QueryPacketCommitmentReconciliation cross-references the packet commitments on the CCV channel of the consumer chain
+// with the provided consumer id against the VSC packets recorded as sent and returns the discrepancies
+func (k Keeper) QueryPacketCommitmentReconciliation(goCtx context.Context, req *types.QueryPacketCommitmentReconciliationRequest) (*types.QueryPacketCommitmentReconciliationResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer id: %s", consumerId)
+	}
+
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no CCV channel for consumer id: %s", consumerId)
+	}
+
+	numCommitments, numRecords, discrepancies := k.ReconcilePacketCommitments(ctx, consumerId, channelId)
+	if discrepancies == nil {
+		discrepancies = []types.PacketCommitmentDiscrepancy{}
+	}
+	return &types.QueryPacketCommitmentReconciliationResponse{
+		ChannelId:      channelId,
+		NumCommitments: uint64(numCommitments),
+		NumRecords:     uint64(numRecords),
+		Discrepancies:  discrepancies,
+	}, nil
+}
+
+// QueryConsumerRewardDenomsMetadata returns the bank metadata of the reward denoms allowlisted by the consumer chain
+// with the provided consumer id
+func (k Keeper) QueryConsumerRewardDenomsMetadata(goCtx context.Context, req *types.QueryConsumerRewardDenomsMetadataRequest) (*types.QueryConsumerRewardDenomsMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer id: %s", consumerId)
+	}
+
+	metadatas, err := k.GetRewardDenomsMetadata(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerRewardDenomsMetadataResponse{Metadatas: metadatas}, nil
+}
+
+// QueryOldestValsetUpdateHeight returns the oldest retained mapping from a valset update ID to a block height
+func (k Keeper) QueryOldestValsetUpdateHeight(goCtx context.Context, req *types.QueryOldestValsetUpdateHeightRequest) (*types.QueryOldestValsetUpdateHeightResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	retentionPeriod, err := k.GetValsetUpdateHeightRetentionPeriod(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	oldest, found := k.GetOldestValsetUpdateBlockHeight(ctx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no valset update ID is mapped to a block height")
+	}
+
+	res := &types.QueryOldestValsetUpdateHeightResponse{
+		ValsetUpdateId:  oldest.ValsetUpdateId,
+		Height:          oldest.Height,
+		RetentionPeriod: retentionPeriod,
+	}
+	if blockTime, found := k.GetValsetUpdateBlockTime(ctx, oldest.ValsetUpdateId); found {
+		res.BlockTime = &blockTime
+	}
+	return res, nil
+}
+
+// QueryKeyAssignmentReplacements returns the pending key-assignment replacements of the consumer chain
+// with the provided consumer id, i.e., the consumer addresses of replaced consumer keys that are kept
+// until they can be pruned
+func (k Keeper) QueryKeyAssignmentReplacements(goCtx context.Context, req *types.QueryKeyAssignmentReplacementsRequest) (*types.QueryKeyAssignmentReplacementsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	replacements := []types.KeyAssignmentReplacementInfo{}
+	for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
+		// the consumer addresses are pruned in EndBlock once their prune time elapsed,
+		// i.e., the addresses whose prune time elapsed in the queried state are stuck
+		stuck := !consumerAddrsToPrune.PruneTs.After(ctx.BlockTime())
+		for _, addrBz := range consumerAddrsToPrune.ConsumerAddrs.Addresses {
+			consumerAddr := types.NewConsumerConsAddress(addrBz)
+			providerAddr, _ := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+			replacements = append(replacements, types.KeyAssignmentReplacementInfo{
+				ConsumerAddress: k.ConsAddressToString(addrBz),
+				ProviderAddress: k.ConsAddressToString(providerAddr.ToSdkConsAddr()),
+				PruneTime:       consumerAddrsToPrune.PruneTs,
+				Stuck:           stuck,
+			})
+		}
+	}
+
+	return &types.QueryKeyAssignmentReplacementsResponse{Replacements: replacements}, nil
+}
+
+// QueryConsumerKeyUsage returns the consumer chains on which the consumer key
+// with the provided consensus address is assigned
+func (k Keeper) QueryConsumerKeyUsage(goCtx context.Context, req *types.QueryConsumerKeyUsageRequest) (*types.QueryConsumerKeyUsageResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerAddrTmp, err := k.consensusAddressCodec.StringToBytes(req.ConsumerAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consumer address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	usages := k.GetConsumerKeyUsages(ctx, types.NewConsumerConsAddress(consumerAddrTmp))
+
+	return &types.QueryConsumerKeyUsageResponse{Usages: usages}, nil
+}
+
+// QueryValidatorTopNObligations returns, for the validator with the provided provider consensus address,
+// every registered, initialized, or launched Top N consumer chain together with whether the validator is
+// in the top N and how far its power is from the minimum power in the top N
+func (k Keeper) QueryValidatorTopNObligations(goCtx context.Context, req *types.QueryValidatorTopNObligationsRequest) (*types.QueryValidatorTopNObligationsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consAddr, err := k.consensusAddressCodec.StringToBytes(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown validator: %s", req.ProviderAddress))
+	}
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get active validators: %s", err))
+	}
+	active := false
+	for _, v := range activeValidators {
+		if v.GetOperator() == validator.GetOperator() {
+			active = true
+			break
+		}
+	}
+
+	obligations := []types.ValidatorTopNObligation{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get power shaping params: %s", err))
+		}
+		if powerShapingParameters.Top_N == 0 {
+			continue
+		}
+
+		// compute the minimum power in the top N since the one in the state is only updated at the end of an epoch
+		minPower, err := k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("failed to compute min power to opt in for chain %s: %s", consumerId, err))
+		}
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		obligations = append(obligations, types.ValidatorTopNObligation{
+			ConsumerId:      consumerId,
+			ChainId:         chainId,
+			Top_N:           powerShapingParameters.Top_N,
+			MinPowerInTop_N: minPower,
+			InTop_N:         active && power >= minPower,
+			PowerMargin:     power - minPower,
+		})
+	}
+
+	return &types.QueryValidatorTopNObligationsResponse{
+		Power:       power,
+		Active:      active,
+		Obligations: obligations,
+	}, nil
+}
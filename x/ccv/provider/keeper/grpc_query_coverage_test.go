@@ -0,0 +1,342 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"cosmossdk.io/math"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestQueryCoverage exercises every query of the provider gRPC service with valid, empty and malformed requests
+// against a populated keeper. A new query fails this test until a case is added for it.
+func TestQueryCoverage(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks, stakingKeepers := testkeeper.GetProviderKeeperAndCtxWithStakingKeepers(t)
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(now)
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// the consumer clients are alive
+	clientState := ibctm.NewClientState("consumer-1", ibctm.DefaultTrustLevel, 14*24*time.Hour, 21*24*time.Hour,
+		time.Minute, clienttypes.NewHeight(0, 1), nil, nil)
+	consensusState := &ibctm.ConsensusState{Timestamp: now}
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), gomock.Any()).Return(clientState, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetLatestClientConsensusState(gomock.Any(), gomock.Any()).Return(consensusState, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), gomock.Any(), gomock.Any()).Return(consensusState, true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(2), true).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetAllPacketCommitmentsAtChannel(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// bond three validators
+	validators := []stakingtypes.Validator{}
+	providerAddrs := []types.ProviderConsAddress{}
+	for _, power := range []int64{30, 20, 10} {
+		validator := stakingKeepers.CreateValidator(t, ctx, power)
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(t, err)
+		validators = append(validators, validator)
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(consAddr))
+	}
+	stakingKeepers.EndBlock(t, ctx)
+	providerKeeper.InitializeSlashMeter(ctx)
+
+	// consumers "0" and "1" are launched and consumer "2" is initialized
+	msgServer := keeper.NewMsgServerImpl(&providerKeeper)
+	for i, chainId := range []string{"consumer-1", "consumer-2", "consumer-3"} {
+		initializationParameters := types.DefaultConsumerInitializationParameters()
+		initializationParameters.InitialHeight.RevisionNumber = uint64(i + 1)
+		initializationParameters.SpawnTime = now.Add(time.Hour)
+		res, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			ChainId:                  chainId,
+			Metadata:                 types.ConsumerMetadata{Name: chainId},
+			InitializationParameters: &initializationParameters,
+			InfractionParameters:     getTestInfractionParameters(),
+		})
+		require.NoError(t, err)
+		if i == 2 {
+			providerKeeper.SetConsumerPhase(ctx, res.ConsumerId, types.CONSUMER_PHASE_INITIALIZED)
+			continue
+		}
+		providerKeeper.SetConsumerPhase(ctx, res.ConsumerId, types.CONSUMER_PHASE_LAUNCHED)
+		clientId := "07-tendermint-" + res.ConsumerId
+		providerKeeper.SetConsumerClientId(ctx, res.ConsumerId, clientId)
+		providerKeeper.SetConsumerIdToChannelId(ctx, res.ConsumerId, "channel-"+res.ConsumerId)
+		providerKeeper.SetChannelToConsumerId(ctx, "channel-"+res.ConsumerId, res.ConsumerId)
+		require.NoError(t, providerKeeper.SetConsumerGenesis(ctx, res.ConsumerId, *ccvtypes.DefaultConsumerGenesisState()))
+	}
+
+	// the validators are opted in on consumer "0", with assigned consumer keys and a commission rate
+	consumerValidators := []types.ConsensusValidator{}
+	consumerAddrs := []types.ConsumerConsAddress{}
+	for i, providerAddr := range providerAddrs {
+		providerKeeper.SetOptedIn(ctx, "0", providerAddr)
+		identity := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		consumerKey := identity.TMProtoCryptoPublicKey()
+		providerKeeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr, consumerKey)
+		providerKeeper.SetValidatorByConsumerAddr(ctx, "0", identity.ConsumerConsAddress(), providerAddr)
+		consumerAddrs = append(consumerAddrs, identity.ConsumerConsAddress())
+		consumerValidators = append(consumerValidators, types.ConsensusValidator{
+			ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+			Power:            validators[i].ConsensusPower(stakingKeepers.StakingKeeper.PowerReduction(ctx)),
+			PublicKey:        &consumerKey,
+		})
+	}
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, "0", consumerValidators))
+	require.NoError(t, providerKeeper.SetConsumerCommissionRate(ctx, "0", providerAddrs[0], math.LegacyNewDecWithPrec(1, 1)))
+
+	// consumer "0" received a VSC packet and sent a slash packet
+	providerKeeper.SetValidatorSetUpdateId(ctx, 2)
+	providerKeeper.SetValsetSnapshot(ctx, "0", types.ValsetSnapshot{ValsetUpdateId: 1, ProviderHeight: 5, Validators: consumerValidators})
+	providerKeeper.SetVSCPacketRecord(ctx, "0", types.VSCPacketRecord{
+		ValsetUpdateId: 1, Sequence: 1, AckStatus: types.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED, SendTime: now,
+	})
+	providerKeeper.SetVscConfirmation(ctx, "0", types.VscConfirmation{ValsetUpdateId: 1, ExpectedValsetHash: []byte{0x01}})
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 1, 5)
+	providerKeeper.SetSlashPacketTrace(ctx, "0", types.SlashPacketTrace{
+		Sequence:         1,
+		ConsumerConsAddr: consumerAddrs[0].ToSdkConsAddr(),
+		ValsetUpdateId:   1,
+		Infraction:       stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		SlashMeter:       math.NewInt(10),
+		Outcome:          types.SLASH_PACKET_OUTCOME_HANDLED,
+	})
+	providerKeeper.AppendPacketError(ctx, types.PacketError{ConsumerId: "0", Sequence: 2, Code: 2})
+	providerKeeper.SetConsumerUpgradePlan(ctx, "0", ccvtypes.ConsumerUpgradePlan{Name: "v2", HaltHeight: 100})
+	providerKeeper.SetConsumerRewardDenom(ctx, "ibc/rewards")
+
+	// two power-shaping templates
+	for _, name := range []string{"template0", "template1"} {
+		providerKeeper.SetPowerShapingTemplate(ctx, types.PowerShapingTemplate{
+			TemplateId: providerKeeper.FetchAndIncrementPowerShapingTemplateId(ctx),
+			Owner:      "owner",
+			Name:       name,
+		})
+	}
+
+	providerAddr := providerKeeper.ConsAddressToString(providerAddrs[0].ToSdkConsAddr())
+	consumerAddr := providerKeeper.ConsAddressToString(consumerAddrs[0].ToSdkConsAddr())
+	invalidConsumerId := "invalid"
+	invalidAddr := "invalid"
+
+	testkeeper.RunQueryCoverage(t, ctx, func(s grpc.ServiceRegistrar) { types.RegisterQueryServer(s, providerKeeper) }, map[string]testkeeper.QueryCase{
+		"QueryConsumerGenesis": {
+			Valid:     &types.QueryConsumerGenesisRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerGenesisRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerChains": {
+			Valid:     &types.QueryConsumerChainsRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryValidatorConsumerAddr": {
+			Valid:     &types.QueryValidatorConsumerAddrRequest{ConsumerId: "0", ProviderAddress: providerAddr},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{
+				&types.QueryValidatorConsumerAddrRequest{ConsumerId: invalidConsumerId, ProviderAddress: providerAddr},
+				&types.QueryValidatorConsumerAddrRequest{ConsumerId: "0", ProviderAddress: invalidAddr},
+			},
+		},
+		"QueryValidatorProviderAddr": {
+			Valid:     &types.QueryValidatorProviderAddrRequest{ConsumerId: "0", ConsumerAddress: consumerAddr},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{
+				&types.QueryValidatorProviderAddrRequest{ConsumerId: invalidConsumerId, ConsumerAddress: consumerAddr},
+				&types.QueryValidatorProviderAddrRequest{ConsumerId: "0", ConsumerAddress: invalidAddr},
+			},
+		},
+		"QueryThrottleState": {
+			Valid:     &types.QueryThrottleStateRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryRegisteredConsumerRewardDenoms": {
+			Valid:     &types.QueryRegisteredConsumerRewardDenomsRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryAllPairsValConsAddrByConsumer": {
+			Valid:     &types.QueryAllPairsValConsAddrByConsumerRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryAllPairsValConsAddrByConsumerRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryParams": {
+			Valid:     &types.QueryParamsRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryConsumerChainOptedInValidators": {
+			Valid:     &types.QueryConsumerChainOptedInValidatorsRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerChainOptedInValidatorsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerChainsValidatorHasToValidate": {
+			Valid:     &types.QueryConsumerChainsValidatorHasToValidateRequest{ProviderAddress: providerAddr},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerChainsValidatorHasToValidateRequest{ProviderAddress: invalidAddr}},
+		},
+		"QueryValidatorConsumerCommissionRate": {
+			Valid:     &types.QueryValidatorConsumerCommissionRateRequest{ConsumerId: "0", ProviderAddress: providerAddr},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{
+				&types.QueryValidatorConsumerCommissionRateRequest{ConsumerId: invalidConsumerId, ProviderAddress: providerAddr},
+				&types.QueryValidatorConsumerCommissionRateRequest{ConsumerId: "0", ProviderAddress: invalidAddr},
+			},
+		},
+		"QueryConsumerValidators": {
+			Valid:     &types.QueryConsumerValidatorsRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerValidatorsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryBlocksUntilNextEpoch": {
+			Valid:     &types.QueryBlocksUntilNextEpochRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryConsumerIdFromClientId": {
+			Valid:     &types.QueryConsumerIdFromClientIdRequest{ClientId: "07-tendermint-0"},
+			EmptyCode: codes.InvalidArgument,
+		},
+		"QueryConsumerChain": {
+			Valid:     &types.QueryConsumerChainRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerChainRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerGenesisTime": {
+			Valid:     &types.QueryConsumerGenesisTimeRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerGenesisTimeRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerVscConfirmations": {
+			Valid:     &types.QueryConsumerVscConfirmationsRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerVscConfirmationsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryRecentPacketErrors": {
+			Valid:     &types.QueryRecentPacketErrorsRequest{ConsumerId: "0"},
+			EmptyCode: codes.OK,
+			Malformed: []proto.Message{&types.QueryRecentPacketErrorsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerRelayerLiveness": {
+			Valid:     &types.QueryConsumerRelayerLivenessRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerRelayerLivenessRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerClientExpiry": {
+			Valid:     &types.QueryConsumerClientExpiryRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerClientExpiryRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryValidatorConsumerStatus": {
+			Valid:     &types.QueryValidatorConsumerStatusRequest{ValidatorAddress: validators[0].GetOperator()},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryValidatorConsumerStatusRequest{ValidatorAddress: invalidAddr}},
+		},
+		"QueryConsumerValidatorsAtVSC": {
+			Valid:     &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: "0", VscId: 1},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: invalidConsumerId, VscId: 1}},
+		},
+		"QuerySlashPacketTrace": {
+			Valid:     &types.QuerySlashPacketTraceRequest{ConsumerId: "0", Sequence: 1},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QuerySlashPacketTraceRequest{ConsumerId: invalidConsumerId, Sequence: 1}},
+		},
+		"QueryNextEpoch": {
+			Valid:     &types.QueryNextEpochRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryConsumerIdFromChannelId": {
+			Valid:     &types.QueryConsumerIdFromChannelIdRequest{ChannelId: "channel-0"},
+			EmptyCode: codes.NotFound,
+		},
+		"QueryConsumerIbcIds": {
+			Valid:     &types.QueryConsumerIbcIdsRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerIbcIdsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryUpcomingConsumerLaunches": {
+			Valid:     &types.QueryUpcomingConsumerLaunchesRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryConsumerUpdateHistory": {
+			Valid:     &types.QueryConsumerUpdateHistoryRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerUpdateHistoryRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QuerySimulateConsumerLaunch": {
+			Valid:     &types.QuerySimulateConsumerLaunchRequest{ConsumerId: "2"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QuerySimulateConsumerLaunchRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryPowerShapingTemplates": {
+			Valid:     &types.QueryPowerShapingTemplatesRequest{Owner: "owner"},
+			EmptyCode: codes.OK,
+		},
+		"QueryPowerShapingTemplate": {
+			Valid:     &types.QueryPowerShapingTemplateRequest{TemplateId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryPowerShapingTemplateRequest{TemplateId: " "}},
+		},
+		"QueryConsumerUpgradePlan": {
+			Valid:     &types.QueryConsumerUpgradePlanRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerUpgradePlanRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerProjectedDropOffs": {
+			Valid:     &types.QueryConsumerProjectedDropOffsRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerProjectedDropOffsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryVSCHistory": {
+			Valid:     &types.QueryVSCHistoryRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryVSCHistoryRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryPacketCommitmentReconciliation": {
+			Valid:     &types.QueryPacketCommitmentReconciliationRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryPacketCommitmentReconciliationRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerRewardDenomsMetadata": {
+			Valid:     &types.QueryConsumerRewardDenomsMetadataRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerRewardDenomsMetadataRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryOldestValsetUpdateHeight": {
+			Valid:     &types.QueryOldestValsetUpdateHeightRequest{},
+			EmptyCode: codes.OK,
+		},
+		"QueryValidatorConsumerChains": {
+			Valid:     &types.QueryValidatorConsumerChainsRequest{ValidatorAddress: validators[0].GetOperator()},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryValidatorConsumerChainsRequest{ValidatorAddress: invalidAddr}},
+		},
+		"QueryKeyAssignmentReplacements": {
+			Valid:     &types.QueryKeyAssignmentReplacementsRequest{ConsumerId: "0"},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryKeyAssignmentReplacementsRequest{ConsumerId: invalidConsumerId}},
+		},
+		"QueryConsumerKeyUsage": {
+			Valid:     &types.QueryConsumerKeyUsageRequest{ConsumerAddress: consumerAddr},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryConsumerKeyUsageRequest{ConsumerAddress: invalidAddr}},
+		},
+		"QueryValidatorTopNObligations": {
+			Valid:     &types.QueryValidatorTopNObligationsRequest{ProviderAddress: providerAddr},
+			EmptyCode: codes.InvalidArgument,
+			Malformed: []proto.Message{&types.QueryValidatorTopNObligationsRequest{ProviderAddress: invalidAddr}},
+		},
+	})
+}
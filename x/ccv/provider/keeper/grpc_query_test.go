@@ -9,13 +9,16 @@ import (
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -73,6 +76,52 @@ func TestQueryAllPairsValConsAddrByConsumer(t *testing.T) {
 	}
 	require.Equal(t, &consumerKey, response.PairValConAddr[0].ConsumerKey)
 	require.Equal(t, &expectedResult, response.PairValConAddr[0])
+	require.Equal(t, uint64(1), response.Pagination.Total)
+}
+
+// TestQueryAddressCodec tests that the queries parse and render the consensus addresses
+// with the consensus address codec of the keeper instead of the global SDK config
+func TestQueryAddressCodec(t *testing.T) {
+	consumerId := "0"
+	consAddressCodec := address.NewBech32Codec("neutronvalcons")
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ConsensusAddressCodec = consAddressCodec
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerAddr := consumerIdentity.ConsumerConsAddress()
+	pk.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerIdentity.TMProtoCryptoPublicKey())
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providerAddr)
+
+	providerAddrStr, err := consAddressCodec.BytesToString(providerAddr.ToSdkConsAddr())
+	require.NoError(t, err)
+	consumerAddrStr, err := consAddressCodec.BytesToString(consumerAddr.ToSdkConsAddr())
+	require.NoError(t, err)
+	require.Equal(t, providerAddrStr, pk.ConsAddressToString(providerAddr.ToSdkConsAddr()))
+
+	consumerAddrRes, err := pk.QueryValidatorConsumerAddr(ctx, &types.QueryValidatorConsumerAddrRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: providerAddrStr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, consumerAddrStr, consumerAddrRes.ConsumerAddress)
+
+	providerAddrRes, err := pk.QueryValidatorProviderAddr(ctx, &types.QueryValidatorProviderAddrRequest{
+		ConsumerId:      consumerId,
+		ConsumerAddress: consumerAddrStr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, providerAddrStr, providerAddrRes.ProviderAddress)
+
+	// addresses with the prefix of the global SDK config are rejected
+	_, err = pk.QueryValidatorConsumerAddr(ctx, &types.QueryValidatorConsumerAddrRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: providerAddr.ToSdkConsAddr().String(),
+	})
+	require.Error(t, err)
 }
 
 func TestQueryConsumerChainOptedInValidators(t *testing.T) {
@@ -96,6 +145,7 @@ func TestQueryConsumerChainOptedInValidators(t *testing.T) {
 	providerAddr2 := types.NewProviderConsAddress([]byte("providerAddr2"))
 	expectedResponse := types.QueryConsumerChainOptedInValidatorsResponse{
 		ValidatorsProviderAddresses: []string{providerAddr1.String(), providerAddr2.String()},
+		Pagination:                  &sdkquery.PageResponse{Total: 2},
 	}
 
 	pk.SetOptedIn(ctx, consumerId, providerAddr1)
@@ -103,6 +153,19 @@ func TestQueryConsumerChainOptedInValidators(t *testing.T) {
 	res, err := pk.QueryConsumerChainOptedInValidators(ctx, &req)
 	require.NoError(t, err)
 	require.Equal(t, &expectedResponse, res)
+
+	// paginated request
+	req.Pagination = &sdkquery.PageRequest{Limit: 1}
+	res, err = pk.QueryConsumerChainOptedInValidators(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, []string{providerAddr1.String()}, res.ValidatorsProviderAddresses)
+	require.Equal(t, providerAddr2.ToSdkConsAddr().Bytes(), res.Pagination.NextKey)
+
+	req.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	res, err = pk.QueryConsumerChainOptedInValidators(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, []string{providerAddr2.String()}, res.ValidatorsProviderAddresses)
+	require.Nil(t, res.Pagination.NextKey)
 }
 
 func TestQueryConsumerValidators(t *testing.T) {
@@ -223,6 +286,7 @@ func TestQueryConsumerValidators(t *testing.T) {
 				ValidatesCurrentEpoch:   true,
 			},
 		},
+		Pagination: &sdkquery.PageResponse{Total: 2},
 	}
 
 	// sort the address of the validators by ascending lexical order as they were persisted to the store
@@ -250,7 +314,7 @@ func TestQueryConsumerValidators(t *testing.T) {
 	// since neither QueueVSCPackets or MakeConsumerGenesis was called at this point
 	res, err = pk.QueryConsumerValidators(ctx, &req)
 	require.NoError(t, err)
-	require.Empty(t, res)
+	require.Empty(t, res.Validators)
 
 	// set consumer valset
 	err = pk.SetConsumerValSet(ctx, consumerId, []types.ConsensusValidator{
@@ -274,6 +338,7 @@ func TestQueryConsumerValidators(t *testing.T) {
 		ProviderPower:           3,
 		ValidatesCurrentEpoch:   true,
 	})
+	express.Pagination.Total = 3
 
 	// sort the address of the validators by ascending lexical order as they were persisted to the store
 	sort.Slice(express.Validators, func(i, j int) bool {
@@ -293,6 +358,33 @@ func TestQueryConsumerValidators(t *testing.T) {
 	res, err = pk.QueryConsumerValidators(ctx, &req)
 	require.NoError(t, err)
 	require.Equal(t, val1.Commission.Rate, res.Validators[0].ConsumerCommissionRate)
+
+	// paginated requests
+	req.Pagination = &sdkquery.PageRequest{Limit: 2}
+	res, err = pk.QueryConsumerValidators(ctx, &req)
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 2)
+	require.Equal(t, express.Validators[0].ProviderAddress, res.Validators[0].ProviderAddress)
+	require.Equal(t, express.Validators[1].ProviderAddress, res.Validators[1].ProviderAddress)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	req.Pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+	res, err = pk.QueryConsumerValidators(ctx, &req)
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, express.Validators[2].ProviderAddress, res.Validators[0].ProviderAddress)
+	require.Nil(t, res.Pagination.NextKey)
+
+	req.Pagination = &sdkquery.PageRequest{Offset: 1, Limit: 1, CountTotal: true}
+	res, err = pk.QueryConsumerValidators(ctx, &req)
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, express.Validators[1].ProviderAddress, res.Validators[0].ProviderAddress)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	req.Pagination = &sdkquery.PageRequest{Offset: 1, Key: []byte{1}}
+	_, err = pk.QueryConsumerValidators(ctx, &req)
+	require.Error(t, err)
 }
 
 func TestQueryConsumerChainsValidatorHasToValidate(t *testing.T) {
@@ -370,6 +462,401 @@ func TestQueryConsumerChainsValidatorHasToValidate(t *testing.T) {
 	require.Equal(t, expectedChains, res.ConsumerIds)
 }
 
+func TestQueryValidatorConsumerStatus(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	val := createStakingValidator(ctx, mocks, 1, 1)
+	val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyOneDec(), math.LegacyZeroDec())
+	consAddr, _ := val.GetConsAddr()
+	valConsAddr := sdk.ConsAddress(consAddr)
+	valAddr, _ := sdk.ValAddressFromBech32(val.GetOperator())
+	providerAddr := types.NewProviderConsAddress(valConsAddr)
+	providerKey, _ := val.CmtConsPublicKey()
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, valAddr).Return(val, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valConsAddr).Return(val, nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{val}, -1) // -1 to allow the calls "AnyTimes"
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, valConsAddr).Return(false).AnyTimes()
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	pk.SetParams(ctx, params)
+
+	consumerIds := make([]string, 4)
+	for i := range consumerIds {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerChainId(ctx, consumerId, "consumer-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+		require.NoError(t, err)
+		consumerIds[i] = consumerId
+	}
+
+	// the validator opted in and is a consumer validator on the first consumer chain,
+	// with an outstanding downtime infraction
+	pk.SetOptedIn(ctx, consumerIds[0], providerAddr)
+	err := pk.SetConsumerValidator(ctx, consumerIds[0], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            1,
+		PublicKey:        &providerKey,
+	})
+	require.NoError(t, err)
+	pk.SetSlashAcks(ctx, consumerIds[0], []string{valConsAddr.String()})
+
+	// the validator opted in on the second consumer chain, which is stopped
+	pk.SetOptedIn(ctx, consumerIds[1], providerAddr)
+	pk.SetConsumerPhase(ctx, consumerIds[1], types.CONSUMER_PHASE_STOPPED)
+
+	// the validator opted in on the third consumer chain, with an assigned key and a consumer commission rate
+	pk.SetOptedIn(ctx, consumerIds[2], providerAddr)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+	pk.SetValidatorConsumerPubKey(ctx, consumerIds[2], providerAddr, consumerKey)
+	consumerRate := math.LegacyNewDecWithPrec(5, 2)
+	err = pk.SetConsumerCommissionRate(ctx, consumerIds[2], providerAddr, consumerRate)
+	require.NoError(t, err)
+
+	// the validator opted out from the fourth consumer chain, but is still a consumer validator
+	err = pk.SetConsumerValidator(ctx, consumerIds[3], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            1,
+		PublicKey:        &providerKey,
+	})
+	require.NoError(t, err)
+
+	res, err := pk.QueryValidatorConsumerStatus(ctx, &types.QueryValidatorConsumerStatusRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, providerAddr.String(), res.ProviderAddress)
+	require.False(t, res.Jailed)
+	require.False(t, res.Tombstoned)
+	require.Len(t, res.Consumers, 3)
+
+	require.Equal(t, types.ValidatorConsumerStatus{
+		ConsumerId:             consumerIds[0],
+		ChainId:                "consumer-0",
+		Phase:                  types.CONSUMER_PHASE_LAUNCHED,
+		OptedIn:                true,
+		HasToValidate:          true,
+		ConsumerKey:            &providerKey,
+		ConsumerAddress:        valConsAddr.String(),
+		ConsumerCommissionRate: val.Commission.Rate,
+		InValset:               true,
+		ConsumerPower:          1,
+		OutstandingDowntime:    true,
+	}, res.Consumers[0])
+
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorConsumerStatus{
+		ConsumerId:             consumerIds[2],
+		ChainId:                "consumer-2",
+		Phase:                  types.CONSUMER_PHASE_LAUNCHED,
+		OptedIn:                true,
+		HasToValidate:          true,
+		ConsumerKey:            &consumerKey,
+		ConsumerKeyAssigned:    true,
+		ConsumerAddress:        consumerAddr.String(),
+		ConsumerCommissionRate: consumerRate,
+	}, res.Consumers[1])
+
+	require.Equal(t, types.ValidatorConsumerStatus{
+		ConsumerId:             consumerIds[3],
+		ChainId:                "consumer-3",
+		Phase:                  types.CONSUMER_PHASE_LAUNCHED,
+		OptedIn:                false,
+		HasToValidate:          true,
+		ConsumerKey:            &providerKey,
+		ConsumerAddress:        valConsAddr.String(),
+		ConsumerCommissionRate: val.Commission.Rate,
+		InValset:               true,
+		ConsumerPower:          1,
+	}, res.Consumers[2])
+
+	// the query fails if it cannot determine whether the validator has to validate a consumer chain
+	err = pk.SetConsumerPowerShapingParameters(ctx, consumerIds[2], types.PowerShapingParameters{Top_N: 101})
+	require.NoError(t, err)
+	_, err = pk.QueryValidatorConsumerStatus(ctx, &types.QueryValidatorConsumerStatusRequest{ValidatorAddress: valAddr.String()})
+	require.Error(t, err)
+
+	_, err = pk.QueryValidatorConsumerStatus(ctx, &types.QueryValidatorConsumerStatusRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryValidatorConsumerStatus(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryValidatorConsumerChains(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	val := createStakingValidator(ctx, mocks, 1, 1)
+	consAddr, _ := val.GetConsAddr()
+	valConsAddr := sdk.ConsAddress(consAddr)
+	valAddr, _ := sdk.ValAddressFromBech32(val.GetOperator())
+	providerAddr := types.NewProviderConsAddress(valConsAddr)
+	providerKey, _ := val.CmtConsPublicKey()
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, valAddr).Return(val, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valConsAddr).Return(val, nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{val}, -1) // -1 to allow the calls "AnyTimes"
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	pk.SetParams(ctx, params)
+
+	consumerIds := make([]string, 4)
+	for i := range consumerIds {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerChainId(ctx, consumerId, "consumer-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerMetadata(ctx, consumerId, types.ConsumerMetadata{Name: "name-" + consumerId})
+		require.NoError(t, err)
+		err = pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+		require.NoError(t, err)
+		consumerIds[i] = consumerId
+	}
+
+	// the first three consumer chains were launched, but only the first one has a CCV channel
+	for _, consumerId := range consumerIds[:3] {
+		pk.SetConsumerClientId(ctx, consumerId, "07-tendermint-"+consumerId)
+	}
+	pk.SetConsumerIdToChannelId(ctx, consumerIds[0], "channel-0")
+
+	// the validator is a consumer validator on the first consumer chain
+	err := pk.SetConsumerValidator(ctx, consumerIds[0], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            1,
+		PublicKey:        &providerKey,
+	})
+	require.NoError(t, err)
+
+	// the validator opted in on the third consumer chain, with an assigned key,
+	// and on the fourth consumer chain, which has no client
+	pk.SetOptedIn(ctx, consumerIds[2], providerAddr)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+	pk.SetValidatorConsumerPubKey(ctx, consumerIds[2], providerAddr, consumerKey)
+	pk.SetOptedIn(ctx, consumerIds[3], providerAddr)
+
+	res, err := pk.QueryValidatorConsumerChains(ctx, &types.QueryValidatorConsumerChainsRequest{ValidatorAddress: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, providerAddr.String(), res.ProviderAddress)
+	require.Len(t, res.Chains, 2)
+
+	require.Equal(t, types.ValidatorConsumerChain{
+		ConsumerId:      consumerIds[0],
+		ChainId:         "consumer-0",
+		Phase:           types.CONSUMER_PHASE_LAUNCHED,
+		Metadata:        types.ConsumerMetadata{Name: "name-0"},
+		ClientId:        "07-tendermint-0",
+		ChannelId:       "channel-0",
+		ConsumerKey:     &providerKey,
+		ConsumerAddress: valConsAddr.String(),
+		InValset:        true,
+	}, res.Chains[0])
+
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	require.NoError(t, err)
+	require.Equal(t, types.ValidatorConsumerChain{
+		ConsumerId:          consumerIds[2],
+		ChainId:             "consumer-2",
+		Phase:               types.CONSUMER_PHASE_LAUNCHED,
+		Metadata:            types.ConsumerMetadata{Name: "name-2"},
+		ClientId:            "07-tendermint-2",
+		ConsumerKey:         &consumerKey,
+		ConsumerKeyAssigned: true,
+		ConsumerAddress:     consumerAddr.String(),
+	}, res.Chains[1])
+
+	_, err = pk.QueryValidatorConsumerChains(ctx, &types.QueryValidatorConsumerChainsRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryValidatorConsumerChains(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryKeyAssignmentReplacements(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	consumerAddr1 := types.NewConsumerConsAddress([]byte("consumerAddr1"))
+	consumerAddr2 := types.NewConsumerConsAddress([]byte("consumerAddr2"))
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr1, providerAddr)
+	pk.AppendConsumerAddrsToPrune(ctx, consumerId, now.Add(-time.Hour), consumerAddr1)
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr2, providerAddr)
+	pk.AppendConsumerAddrsToPrune(ctx, consumerId, now.Add(time.Hour), consumerAddr2)
+
+	res, err := pk.QueryKeyAssignmentReplacements(ctx, &types.QueryKeyAssignmentReplacementsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, []types.KeyAssignmentReplacementInfo{
+		{
+			ConsumerAddress: consumerAddr1.String(),
+			ProviderAddress: providerAddr.String(),
+			PruneTime:       now.Add(-time.Hour),
+			Stuck:           true,
+		},
+		{
+			ConsumerAddress: consumerAddr2.String(),
+			ProviderAddress: providerAddr.String(),
+			PruneTime:       now.Add(time.Hour),
+		},
+	}, res.Replacements)
+
+	res, err = pk.QueryKeyAssignmentReplacements(ctx, &types.QueryKeyAssignmentReplacementsRequest{ConsumerId: "1"})
+	require.NoError(t, err)
+	require.Empty(t, res.Replacements)
+
+	_, err = pk.QueryKeyAssignmentReplacements(ctx, &types.QueryKeyAssignmentReplacementsRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryKeyAssignmentReplacements(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryConsumerKeyUsage(t *testing.T) {
+	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	consumerAddr := types.NewConsumerConsAddress([]byte("consumerAddr"))
+
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+	pk.SetConsumerChainId(ctx, consumerId, "chain-0")
+	pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	pk.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providerAddr)
+
+	res, err := pk.QueryConsumerKeyUsage(ctx, &types.QueryConsumerKeyUsageRequest{ConsumerAddress: consumerAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, []types.ConsumerKeyUsage{
+		{
+			ConsumerId:      consumerId,
+			ChainId:         "chain-0",
+			ProviderAddress: providerAddr.String(),
+		},
+	}, res.Usages)
+
+	res, err = pk.QueryConsumerKeyUsage(ctx, &types.QueryConsumerKeyUsageRequest{
+		ConsumerAddress: sdk.ConsAddress([]byte("otherConsumerAddr")).String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Usages)
+
+	_, err = pk.QueryConsumerKeyUsage(ctx, &types.QueryConsumerKeyUsageRequest{ConsumerAddress: "invalid"})
+	require.Error(t, err)
+	_, err = pk.QueryConsumerKeyUsage(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryValidatorTopNObligations(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// only the first three validators are active, with a total power of 90
+	validators, providerAddrs := createStakingValidatorsAndMocks(ctx, mocks, 40, 30, 20, 10)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 4, validators, -1) // -1 to allow the calls "AnyTimes"
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	pk.SetParams(ctx, params)
+
+	// a Top 80% chain, whose min power is 20, a Top 50% chain, whose min power is 30,
+	// an opt-in chain, and a stopped Top 80% chain
+	consumerIds := make([]string, 4)
+	for i, topN := range []uint32{80, 50, 0, 80} {
+		consumerId := pk.FetchAndIncrementConsumerId(ctx)
+		pk.SetConsumerChainId(ctx, consumerId, "consumer-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{Top_N: topN})
+		require.NoError(t, err)
+		consumerIds[i] = consumerId
+	}
+	pk.SetConsumerPhase(ctx, consumerIds[3], types.CONSUMER_PHASE_STOPPED)
+
+	res, err := pk.QueryValidatorTopNObligations(ctx, &types.QueryValidatorTopNObligationsRequest{
+		ProviderAddress: providerAddrs[2].String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryValidatorTopNObligationsResponse{
+		Power:  20,
+		Active: true,
+		Obligations: []types.ValidatorTopNObligation{
+			{ConsumerId: consumerIds[0], ChainId: "consumer-0", Top_N: 80, MinPowerInTop_N: 20, InTop_N: true, PowerMargin: 0},
+			{ConsumerId: consumerIds[1], ChainId: "consumer-1", Top_N: 50, MinPowerInTop_N: 30, InTop_N: false, PowerMargin: -10},
+		},
+	}, res)
+
+	// an inactive validator is not in the top N, regardless of its power
+	res, err = pk.QueryValidatorTopNObligations(ctx, &types.QueryValidatorTopNObligationsRequest{
+		ProviderAddress: providerAddrs[3].String(),
+	})
+	require.NoError(t, err)
+	require.False(t, res.Active)
+	require.Len(t, res.Obligations, 2)
+	require.False(t, res.Obligations[0].InTop_N)
+	require.Equal(t, int64(-10), res.Obligations[0].PowerMargin)
+
+	// an unknown validator
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, gomock.Any()).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound)
+	_, err = pk.QueryValidatorTopNObligations(ctx, &types.QueryValidatorTopNObligationsRequest{
+		ProviderAddress: sdk.ConsAddress([]byte("unknownAddr")).String(),
+	})
+	require.Error(t, err)
+}
+
+// BenchmarkQueryConsumerChainsValidatorHasToValidate benchmarks the query for 500 bonded
+// validators that opted in on each of 20 consumer chains, where the queried validator
+// is not yet a consumer validator, i.e., the next consumer validator sets are computed
+func BenchmarkQueryConsumerChainsValidatorHasToValidate(b *testing.B) {
+	numValidators, numConsumers := 500, 20
+
+	keeperParams := testkeeper.NewInMemKeeperParams(b)
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	pk := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+
+	params := types.DefaultParams()
+	params.MaxProviderConsensusValidators = int64(numValidators)
+	pk.SetParams(ctx, params)
+
+	bondedValidators := make([]stakingtypes.Validator, numValidators)
+	providerAddrs := make([]types.ProviderConsAddress, numValidators)
+	powers := make(map[string]int64, numValidators)
+	for i := range bondedValidators {
+		bondedValidators[i] = newBondedStakingValidator(i)
+		consAddr, err := bondedValidators[i].GetConsAddr()
+		require.NoError(b, err)
+		providerAddrs[i] = types.NewProviderConsAddress(consAddr)
+		powers[bondedValidators[i].OperatorAddress] = int64(numValidators - i)
+	}
+	mockMutableBondedValidators(mocks, &bondedValidators, powers)
+
+	for c := 0; c < numConsumers; c++ {
+		consumerId := strconv.Itoa(c)
+		pk.SetConsumerClientId(ctx, consumerId, "client-"+consumerId)
+		pk.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{})
+		require.NoError(b, err)
+		for _, providerAddr := range providerAddrs {
+			pk.SetOptedIn(ctx, consumerId, providerAddr)
+		}
+	}
+
+	// commit the state, as iterating over uncommitted writes of the in-memory store does not scale
+	ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+
+	req := types.QueryConsumerChainsValidatorHasToValidateRequest{
+		ProviderAddress: providerAddrs[0].String(),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		res, err := pk.QueryConsumerChainsValidatorHasToValidate(ctx, &req)
+		require.NoError(b, err)
+		require.Len(b, res.ConsumerIds, numConsumers)
+	}
+}
+
 func TestQueryValidatorConsumerCommissionRate(t *testing.T) {
 	consumerId := "0"
 
@@ -559,9 +1046,12 @@ func TestGetConsumerChain(t *testing.T) {
 }
 
 func TestQueryConsumerChain(t *testing.T) {
-	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
 
+	mocks.MockStakingKeeper.EXPECT().MinCommissionRate(gomock.Any()).Return(math.LegacyZeroDec(), nil).AnyTimes()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
 	consumerId := "0"
 	chainId := "consumer"
 	clientId := "client-0"
@@ -600,15 +1090,17 @@ func TestQueryConsumerChain(t *testing.T) {
 	providerKeeper.SetConsumerClientId(ctx, consumerId, clientId)
 
 	express := types.QueryConsumerChainResponse{
-		ChainId:              chainId,
-		ConsumerId:           consumerId,
-		OwnerAddress:         providerKeeper.GetAuthority(),
-		Metadata:             types.ConsumerMetadata{Name: chainId},
-		Phase:                types.CONSUMER_PHASE_REGISTERED.String(),
-		InitParams:           &types.ConsumerInitializationParameters{},
-		PowerShapingParams:   &types.PowerShapingParameters{},
-		InfractionParameters: getTestInfractionParameters(),
-		ClientId:             clientId,
+		ChainId:                  chainId,
+		ConsumerId:               consumerId,
+		OwnerAddress:             providerKeeper.GetAuthority(),
+		Metadata:                 types.ConsumerMetadata{Name: chainId},
+		Phase:                    types.CONSUMER_PHASE_REGISTERED.String(),
+		InitParams:               &types.ConsumerInitializationParameters{},
+		PowerShapingParams:       &types.PowerShapingParameters{},
+		InfractionParameters:     getTestInfractionParameters(),
+		ClientId:                 clientId,
+		MinCommissionRate:        math.LegacyZeroDec(),
+		ProviderCcvTimeoutPeriod: ccvtypes.DefaultCCVTimeoutPeriod,
 	}
 
 	// expect no error when neither the consumer init and power shaping params are set
@@ -630,13 +1122,34 @@ func TestQueryConsumerChain(t *testing.T) {
 	)
 	require.NoError(t, err)
 
+	providerKeeper.SetConsumerCCVTimeoutPeriod(ctx, consumerId, 14*24*time.Hour)
+
+	committeeMember := types.NewProviderConsAddress([]byte("committeeMember"))
+	providerKeeper.SetCommitteeMember(ctx, consumerId, committeeMember)
+
 	express.InitParams = &types.ConsumerInitializationParameters{SpawnTime: ctx.BlockTime()}
 	express.PowerShapingParams = &types.PowerShapingParameters{Top_N: uint32(50)}
+	express.ProviderCcvTimeoutPeriod = 14 * 24 * time.Hour
+	express.Committee = []string{providerKeeper.ConsAddressToString(committeeMember.ToSdkConsAddr())}
 
 	// expect no error
 	res, err = providerKeeper.QueryConsumerChain(ctx, &req)
 	require.NoError(t, err)
 	require.Equal(t, &express, res)
+
+	// expect the pending update of the infraction parameters
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).Times(1)
+	queuedInfractionParams := *getTestInfractionParameters()
+	queuedInfractionParams.Downtime.JailDuration = time.Hour
+	err = providerKeeper.UpdateQueuedInfractionParams(ctx, consumerId, queuedInfractionParams)
+	require.NoError(t, err)
+	updateTime := ctx.BlockTime().Add(time.Hour)
+	express.QueuedInfractionParameters = &queuedInfractionParams
+	express.InfractionParametersUpdateTime = &updateTime
+
+	res, err = providerKeeper.QueryConsumerChain(ctx, &req)
+	require.NoError(t, err)
+	require.Equal(t, &express, res)
 }
 
 func TestQueryConsumerIdFromClientId(t *testing.T) {
@@ -655,6 +1168,52 @@ func TestQueryConsumerIdFromClientId(t *testing.T) {
 	require.Equal(t, expectedConsumerId, res.ConsumerId)
 }
 
+func TestQueryConsumerIdFromChannelId(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerIdFromChannelId(ctx, &types.QueryConsumerIdFromChannelIdRequest{ChannelId: "channelId"})
+	require.Error(t, err)
+	require.ErrorContains(t, err, "no known consumer chain")
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientId")
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channelId")
+	providerKeeper.SetChannelToConsumerId(ctx, "channelId", CONSUMER_ID)
+
+	res, err := providerKeeper.QueryConsumerIdFromChannelId(ctx, &types.QueryConsumerIdFromChannelIdRequest{ChannelId: "channelId"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerIdFromChannelIdResponse{ConsumerId: CONSUMER_ID, ClientId: "clientId"}, res)
+
+	_, err = providerKeeper.QueryConsumerIdFromChannelId(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryConsumerIbcIds(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	req := &types.QueryConsumerIbcIdsRequest{ConsumerId: CONSUMER_ID}
+	_, err := providerKeeper.QueryConsumerIbcIds(ctx, req)
+	require.Error(t, err)
+
+	// the channel id is empty until the CCV channel is established
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "chainId")
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientId")
+	res, err := providerKeeper.QueryConsumerIbcIds(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerIbcIdsResponse{ChainId: "chainId", ClientId: "clientId"}, res)
+
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channelId")
+	res, err = providerKeeper.QueryConsumerIbcIds(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryConsumerIbcIdsResponse{ChainId: "chainId", ClientId: "clientId", ChannelId: "channelId"}, res)
+
+	_, err = providerKeeper.QueryConsumerIbcIds(ctx, &types.QueryConsumerIbcIdsRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerIbcIds(ctx, nil)
+	require.Error(t, err)
+}
+
 func TestQueryConsumerChains(t *testing.T) {
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -931,3 +1490,550 @@ func TestQueryConsumerGenesisTime(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryConsumerVscConfirmations(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unconfirmed := types.VscConfirmation{ValsetUpdateId: 1, ExpectedValsetHash: []byte{0x01}}
+	mismatched := types.VscConfirmation{ValsetUpdateId: 2, ExpectedValsetHash: []byte{0x02}, AppliedValsetHash: []byte{0x03}}
+	providerKeeper.SetVscConfirmation(ctx, "0", unconfirmed)
+	providerKeeper.SetVscConfirmation(ctx, "0", mismatched)
+
+	res, err := providerKeeper.QueryConsumerVscConfirmations(ctx, &types.QueryConsumerVscConfirmationsRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.VscConfirmation{unconfirmed}, res.Unconfirmed)
+	require.Equal(t, []types.VscConfirmation{mismatched}, res.Mismatched)
+
+	res, err = providerKeeper.QueryConsumerVscConfirmations(ctx, &types.QueryConsumerVscConfirmationsRequest{ConsumerId: "1"})
+	require.NoError(t, err)
+	require.Empty(t, res.Unconfirmed)
+	require.Empty(t, res.Mismatched)
+
+	_, err = providerKeeper.QueryConsumerVscConfirmations(ctx, &types.QueryConsumerVscConfirmationsRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerVscConfirmations(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryRecentPacketErrors(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	packetError0 := types.PacketError{ConsumerId: "0", Sequence: 1, Code: 2}
+	packetError1 := types.PacketError{ConsumerId: "1", Sequence: 1, Code: 3}
+	providerKeeper.AppendPacketError(ctx, packetError0)
+	providerKeeper.AppendPacketError(ctx, packetError1)
+
+	res, err := providerKeeper.QueryRecentPacketErrors(ctx, &types.QueryRecentPacketErrorsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketError{packetError1, packetError0}, res.PacketErrors)
+
+	res, err = providerKeeper.QueryRecentPacketErrors(ctx, &types.QueryRecentPacketErrorsRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketError{packetError0}, res.PacketErrors)
+
+	res, err = providerKeeper.QueryRecentPacketErrors(ctx, &types.QueryRecentPacketErrorsRequest{ConsumerId: "2"})
+	require.NoError(t, err)
+	require.Empty(t, res.PacketErrors)
+
+	_, err = providerKeeper.QueryRecentPacketErrors(ctx, &types.QueryRecentPacketErrorsRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryRecentPacketErrors(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryConsumerValidatorsAtVSC(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	pk := identity.TMProtoCryptoPublicKey()
+	validator := types.ConsensusValidator{ProviderConsAddr: identity.SDKValConsAddress(), Power: 3, PublicKey: &pk}
+	providerKeeper.SetValsetSnapshot(ctx, "0", types.ValsetSnapshot{
+		ValsetUpdateId: 2,
+		ProviderHeight: 7,
+		Validators:     []types.ConsensusValidator{validator},
+	})
+	providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+
+	res, err := providerKeeper.QueryConsumerValidatorsAtVSC(ctx, &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: "0", VscId: 4})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.ValsetUpdateId)
+	require.Equal(t, int64(7), res.ProviderHeight)
+	require.Equal(t, []types.QueryConsumerValidatorsAtVSCValidator{{
+		ProviderAddress: identity.SDKValConsAddress().String(),
+		ConsumerKey:     &pk,
+		ConsumerAddress: identity.SDKValConsAddress().String(),
+		Power:           3,
+	}}, res.Validators)
+
+	// no validator set retained
+	_, err = providerKeeper.QueryConsumerValidatorsAtVSC(ctx, &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: "0", VscId: 1})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerValidatorsAtVSC(ctx, &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: "1", VscId: 4})
+	require.Error(t, err)
+	// VSC id not yet sent
+	_, err = providerKeeper.QueryConsumerValidatorsAtVSC(ctx, &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: "0", VscId: 5})
+	require.Error(t, err)
+
+	_, err = providerKeeper.QueryConsumerValidatorsAtVSC(ctx, &types.QueryConsumerValidatorsAtVSCRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerValidatorsAtVSC(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQuerySlashPacketTrace(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerAddr := []byte{0x01}
+	bounced := types.SlashPacketTrace{
+		Sequence:         1,
+		ConsumerConsAddr: consumerAddr,
+		ValsetUpdateId:   3,
+		Infraction:       stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		SlashMeter:       math.NewInt(-1),
+		Outcome:          types.SLASH_PACKET_OUTCOME_BOUNCED,
+	}
+	other := types.SlashPacketTrace{
+		Sequence:         2,
+		ConsumerConsAddr: []byte{0x02},
+		ValsetUpdateId:   3,
+		Infraction:       stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		SlashMeter:       math.NewInt(-1),
+		Outcome:          types.SLASH_PACKET_OUTCOME_BOUNCED,
+	}
+	handled := bounced
+	handled.Sequence = 3
+	handled.SlashMeter = math.NewInt(10)
+	handled.Outcome = types.SLASH_PACKET_OUTCOME_HANDLED
+	for _, trace := range []types.SlashPacketTrace{bounced, other, handled} {
+		providerKeeper.SetSlashPacketTrace(ctx, "0", trace)
+	}
+	packetError := types.PacketError{ConsumerId: "0", Sequence: 4, Code: 2}
+	providerKeeper.AppendPacketError(ctx, packetError)
+
+	res, err := providerKeeper.QuerySlashPacketTrace(ctx, &types.QuerySlashPacketTraceRequest{ConsumerId: "0", Sequence: 1})
+	require.NoError(t, err)
+	require.Equal(t, &bounced, res.Trace)
+	require.Equal(t, []types.SlashPacketTrace{bounced, handled}, res.Attempts)
+	require.Nil(t, res.PacketError)
+
+	res, err = providerKeeper.QuerySlashPacketTrace(ctx, &types.QuerySlashPacketTraceRequest{ConsumerId: "0", Sequence: 4})
+	require.NoError(t, err)
+	require.Nil(t, res.Trace)
+	require.Empty(t, res.Attempts)
+	require.Equal(t, &packetError, res.PacketError)
+
+	_, err = providerKeeper.QuerySlashPacketTrace(ctx, &types.QuerySlashPacketTraceRequest{ConsumerId: "0", Sequence: 5})
+	require.Error(t, err)
+	_, err = providerKeeper.QuerySlashPacketTrace(ctx, &types.QuerySlashPacketTraceRequest{ConsumerId: "1", Sequence: 1})
+	require.Error(t, err)
+	_, err = providerKeeper.QuerySlashPacketTrace(ctx, &types.QuerySlashPacketTraceRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QuerySlashPacketTrace(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryNextEpoch(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providerKeeper.GetParams(ctx)
+	params.BlocksPerEpoch = 10
+	params.ValsetCheckpointPeriod = 3
+	providerKeeper.SetParams(ctx, params)
+
+	// consumer "0" has a validator that is no longer bonded, consumer "1" has pending VSC packets
+	// and consumer "2" is not yet launched
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	pk := identity.TMProtoCryptoPublicKey()
+	for i, consumerId := range []string{"0", "1", "2"} {
+		providerKeeper.SetConsumerClientId(ctx, consumerId, fmt.Sprintf("client-%d", i))
+		providerKeeper.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain-%d", i))
+		require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{}))
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	}
+	providerKeeper.SetConsumerPhase(ctx, "2", types.CONSUMER_PHASE_INITIALIZED)
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, "0", []types.ConsensusValidator{
+		{ProviderConsAddr: identity.SDKValConsAddress(), Power: 1, PublicKey: &pk},
+	}))
+	providerKeeper.AppendPendingVSCPackets(ctx, "1", ccvtypes.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 0, []stakingtypes.Validator{}, -1)
+
+	start := time.Now().UTC()
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(start)
+	providerKeeper.RecordEpochStart(ctx)
+	ctx = ctx.WithBlockHeight(24).WithBlockTime(start.Add(20 * time.Second))
+
+	res, err := providerKeeper.QueryNextEpoch(ctx, &types.QueryNextEpochRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryNextEpochResponse{
+		NextEpochHeight:             30,
+		BlocksUntilNextEpoch:        6,
+		AverageBlockTime:            5 * time.Second,
+		EstimatedTimeUntilNextEpoch: 30 * time.Second,
+		ValsetCheckpoint:            true,
+		Consumers: []types.ConsumerNextVSC{
+			{ConsumerId: "0", ChainId: "chain-0", VscPacket: true, ValidatorUpdates: 1},
+			{ConsumerId: "1", ChainId: "chain-1", PendingVscPackets: 1},
+		},
+	}, res)
+
+	// the consumer validator set is not modified by the query
+	valset, err := providerKeeper.GetConsumerValSet(ctx, "0")
+	require.NoError(t, err)
+	require.Len(t, valset, 1)
+
+	// at the start of an epoch, the block time is estimated over the previous epoch
+	ctx = ctx.WithBlockHeight(30).WithBlockTime(start.Add(50 * time.Second))
+	providerKeeper.RecordEpochStart(ctx)
+	res, err = providerKeeper.QueryNextEpoch(ctx, &types.QueryNextEpochRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), res.NextEpochHeight)
+	require.Equal(t, uint64(10), res.BlocksUntilNextEpoch)
+	require.Equal(t, 5*time.Second, res.AverageBlockTime)
+	require.Equal(t, 50*time.Second, res.EstimatedTimeUntilNextEpoch)
+	require.False(t, res.ValsetCheckpoint)
+
+	_, err = providerKeeper.QueryNextEpoch(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryUpcomingConsumerLaunches(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	res, err := providerKeeper.QueryUpcomingConsumerLaunches(ctx, &types.QueryUpcomingConsumerLaunchesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Launches)
+
+	val := createStakingValidator(ctx, mocks, 1, 1)
+	val.Tokens = sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction)
+	val.Status = stakingtypes.Bonded
+	valConsAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), valConsAddr).Return(val, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{val}, -1)
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 1
+	providerKeeper.SetParams(ctx, params)
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	// consumer "0" is initialized and has an opted-in validator, consumer "1" is initialized without validators,
+	// consumer "2" is only registered, consumer "3" is launched and consumer "4" is initialized past its spawn time
+	spawnTimes := []time.Time{now.Add(2 * time.Hour), now.Add(time.Hour), {}, now, now.Add(-time.Hour)}
+	phases := []types.ConsumerPhase{
+		types.CONSUMER_PHASE_INITIALIZED,
+		types.CONSUMER_PHASE_INITIALIZED,
+		types.CONSUMER_PHASE_REGISTERED,
+		types.CONSUMER_PHASE_LAUNCHED,
+		types.CONSUMER_PHASE_INITIALIZED,
+	}
+	for i := range spawnTimes {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain%d", i))
+		providerKeeper.SetConsumerPhase(ctx, consumerId, phases[i])
+		require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{}))
+		require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, types.ConsumerInitializationParameters{SpawnTime: spawnTimes[i]}))
+	}
+	providerKeeper.SetOptedIn(ctx, "0", types.NewProviderConsAddress(valConsAddr))
+	providerKeeper.SetOptedIn(ctx, "4", types.NewProviderConsAddress(valConsAddr))
+
+	res, err = providerKeeper.QueryUpcomingConsumerLaunches(ctx, &types.QueryUpcomingConsumerLaunchesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.UpcomingConsumerLaunch{
+		{
+			ConsumerId:         "4",
+			ChainId:            "chain4",
+			Phase:              types.CONSUMER_PHASE_INITIALIZED,
+			SpawnTime:          spawnTimes[4],
+			Validators:         1,
+			HasActiveValidator: true,
+			Ready:              true,
+		},
+		{
+			ConsumerId:     "1",
+			ChainId:        "chain1",
+			Phase:          types.CONSUMER_PHASE_INITIALIZED,
+			SpawnTime:      spawnTimes[1],
+			TimeUntilSpawn: time.Hour,
+		},
+		{
+			ConsumerId:         "0",
+			ChainId:            "chain0",
+			Phase:              types.CONSUMER_PHASE_INITIALIZED,
+			SpawnTime:          spawnTimes[0],
+			TimeUntilSpawn:     2 * time.Hour,
+			Validators:         1,
+			HasActiveValidator: true,
+			Ready:              true,
+		},
+		{
+			ConsumerId: "2",
+			ChainId:    "chain2",
+			Phase:      types.CONSUMER_PHASE_REGISTERED,
+		},
+	}, res.Launches)
+
+	// the consumer validator sets are not modified by the query
+	valset, err := providerKeeper.GetConsumerValSet(ctx, "0")
+	require.NoError(t, err)
+	require.Empty(t, valset)
+
+	_, err = providerKeeper.QueryUpcomingConsumerLaunches(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryConsumerUpdateHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerUpdateHistory(ctx, &types.QueryConsumerUpdateHistoryRequest{ConsumerId: "0"})
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain-0")
+	res, err := providerKeeper.QueryConsumerUpdateHistory(ctx, &types.QueryConsumerUpdateHistoryRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+
+	before := providerKeeper.GetConsumerUpdateFields(ctx, "0")
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain-1")
+	providerKeeper.RecordConsumerUpdate(ctx, "0", "owner", before)
+
+	res, err = providerKeeper.QueryConsumerUpdateHistory(ctx, &types.QueryConsumerUpdateHistoryRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Len(t, res.Records, 1)
+	require.Equal(t, "owner", res.Records[0].Submitter)
+	require.Equal(t, "chain-0", res.Records[0].Before.ChainId)
+	require.Equal(t, "chain-1", res.Records[0].After.ChainId)
+}
+
+func TestQuerySimulateConsumerLaunch(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	vals := []stakingtypes.Validator{createStakingValidator(ctx, mocks, 3, 0), createStakingValidator(ctx, mocks, 1, 1)}
+	provAddrs := []types.ProviderConsAddress{}
+	for i := range vals {
+		vals[i].Tokens = sdk.TokensFromConsensusPower(int64(3-2*i), sdk.DefaultPowerReduction)
+		consAddr, err := vals[i].GetConsAddr()
+		require.NoError(t, err)
+		provAddrs = append(provAddrs, types.NewProviderConsAddress(consAddr))
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).Return(vals[i], nil).AnyTimes()
+	}
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 2, vals, -1)
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 2
+	providerKeeper.SetParams(ctx, params)
+
+	// simulate the launch of a new Top N chain, where only the first validator is in the top N
+	res, err := providerKeeper.QuerySimulateConsumerLaunch(ctx, &types.QuerySimulateConsumerLaunchRequest{
+		ChainId:                "chain-1",
+		PowerShapingParameters: &types.PowerShapingParameters{Top_N: 50},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "0", res.ConsumerId)
+	require.Empty(t, res.LaunchError)
+	require.Equal(t, int64(3), res.MinPowerInTop_N)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, provAddrs[0].String(), res.Validators[0].ProviderAddress)
+	require.Equal(t, int64(3), res.Validators[0].Power)
+	require.True(t, res.Validators[0].InTop_N)
+	require.False(t, res.Validators[0].ConsumerKeyAssigned)
+	require.Equal(t, []string{provAddrs[0].String()}, res.MissingKeyAssignments)
+
+	// the simulation does not modify the state
+	require.Equal(t, "0", providerKeeper.FetchAndIncrementConsumerId(ctx))
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain-1")
+	providerKeeper.SetConsumerPhase(ctx, "0", types.CONSUMER_PHASE_REGISTERED)
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, "0", types.PowerShapingParameters{}))
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, "0", types.DefaultConsumerInitializationParameters()))
+
+	// an opt-in chain without opted-in validators cannot launch
+	res, err = providerKeeper.QuerySimulateConsumerLaunch(ctx, &types.QuerySimulateConsumerLaunchRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Empty(t, res.Validators)
+	require.NotEmpty(t, res.LaunchError)
+
+	// simulate the launch of the existing chain as a Top N chain, using its opted-in validators and assigned keys
+	providerKeeper.SetOptedIn(ctx, "0", provAddrs[1])
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(10).TMProtoCryptoPublicKey()
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "0", provAddrs[0], consumerKey)
+	res, err = providerKeeper.QuerySimulateConsumerLaunch(ctx, &types.QuerySimulateConsumerLaunchRequest{
+		ConsumerId:             "0",
+		PowerShapingParameters: &types.PowerShapingParameters{Top_N: 50},
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.LaunchError)
+	require.Len(t, res.Validators, 2)
+	require.Empty(t, res.MissingKeyAssignments)
+	for _, v := range res.Validators {
+		if v.ProviderAddress == provAddrs[0].String() {
+			require.True(t, v.InTop_N)
+			require.True(t, v.ConsumerKeyAssigned)
+			require.Equal(t, consumerKey, *v.ConsumerKey)
+		} else {
+			require.False(t, v.InTop_N)
+			require.False(t, v.ConsumerKeyAssigned)
+		}
+	}
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, "0")
+	require.NoError(t, err)
+	require.Zero(t, powerShapingParameters.Top_N)
+
+	// invalid parameters are rejected
+	_, err = providerKeeper.QuerySimulateConsumerLaunch(ctx, &types.QuerySimulateConsumerLaunchRequest{
+		ConsumerId:             "0",
+		PowerShapingParameters: &types.PowerShapingParameters{Top_N: 10},
+	})
+	require.Error(t, err)
+
+	// launched chains cannot be simulated
+	providerKeeper.SetConsumerPhase(ctx, "0", types.CONSUMER_PHASE_LAUNCHED)
+	_, err = providerKeeper.QuerySimulateConsumerLaunch(ctx, &types.QuerySimulateConsumerLaunchRequest{ConsumerId: "0"})
+	require.Error(t, err)
+}
+
+func TestQueryPowerShapingTemplates(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	templates := []types.PowerShapingTemplate{}
+	for i, owner := range []string{"owner0", "owner1", "owner0"} {
+		template := types.PowerShapingTemplate{
+			TemplateId:             providerKeeper.FetchAndIncrementPowerShapingTemplateId(ctx),
+			Owner:                  owner,
+			Name:                   fmt.Sprintf("template%d", i),
+			PowerShapingParameters: types.PowerShapingParameters{ValidatorsPowerCap: uint32(i)},
+		}
+		providerKeeper.SetPowerShapingTemplate(ctx, template)
+		templates = append(templates, template)
+	}
+
+	res, err := providerKeeper.QueryPowerShapingTemplates(ctx, &types.QueryPowerShapingTemplatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, templates, res.Templates)
+
+	res, err = providerKeeper.QueryPowerShapingTemplates(ctx, &types.QueryPowerShapingTemplatesRequest{Owner: "owner0"})
+	require.NoError(t, err)
+	require.Equal(t, []types.PowerShapingTemplate{templates[0], templates[2]}, res.Templates)
+
+	templateRes, err := providerKeeper.QueryPowerShapingTemplate(ctx, &types.QueryPowerShapingTemplateRequest{TemplateId: "1"})
+	require.NoError(t, err)
+	require.Equal(t, templates[1], templateRes.Template)
+
+	_, err = providerKeeper.QueryPowerShapingTemplate(ctx, &types.QueryPowerShapingTemplateRequest{TemplateId: "3"})
+	require.Error(t, err)
+
+	_, err = providerKeeper.QueryPowerShapingTemplates(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestQueryVSCHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryVSCHistory(ctx, &types.QueryVSCHistoryRequest{ConsumerId: "0"})
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain-0")
+	res, err := providerKeeper.QueryVSCHistory(ctx, &types.QueryVSCHistoryRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Empty(t, res.Packets)
+
+	records := []types.VSCPacketRecord{
+		{ValsetUpdateId: 1, Sequence: 1, AckStatus: types.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED, SendTime: ctx.BlockTime()},
+		{ValsetUpdateId: 3, Sequence: 2, AckStatus: types.VSC_PACKET_ACK_STATUS_PENDING, SendTime: ctx.BlockTime()},
+	}
+	for _, record := range records {
+		providerKeeper.SetVSCPacketRecord(ctx, "0", record)
+	}
+
+	res, err = providerKeeper.QueryVSCHistory(ctx, &types.QueryVSCHistoryRequest{ConsumerId: "0"})
+	require.NoError(t, err)
+	require.Equal(t, records, res.Packets)
+}
+
+func TestQueryPacketCommitmentReconciliation(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	req := &types.QueryPacketCommitmentReconciliationRequest{ConsumerId: "0"}
+	_, err := providerKeeper.QueryPacketCommitmentReconciliation(ctx, req)
+	require.Error(t, err)
+
+	// no CCV channel
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain-0")
+	_, err = providerKeeper.QueryPacketCommitmentReconciliation(ctx, req)
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerIdToChannelId(ctx, "0", "channel-0")
+	records := []types.VSCPacketRecord{
+		{ValsetUpdateId: 1, Sequence: 1, AckStatus: types.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED, SendTime: ctx.BlockTime()},
+		{ValsetUpdateId: 2, Sequence: 2, AckStatus: types.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED, SendTime: ctx.BlockTime()},
+		{ValsetUpdateId: 3, Sequence: 4, AckStatus: types.VSC_PACKET_ACK_STATUS_PENDING, SendTime: ctx.BlockTime()},
+		{ValsetUpdateId: 4, Sequence: 5, AckStatus: types.VSC_PACKET_ACK_STATUS_PENDING, SendTime: ctx.BlockTime()},
+	}
+	for _, record := range records {
+		providerKeeper.SetVSCPacketRecord(ctx, "0", record)
+	}
+
+	// the VSC packet with sequence 2 was acknowledged but is still committed,
+	// the packet with sequence 3 is not recorded and the VSC packet with sequence 5 is pending but not committed
+	mocks.MockChannelKeeper.EXPECT().GetAllPacketCommitmentsAtChannel(gomock.Any(), ccvtypes.ProviderPortID, "channel-0").
+		Return([]channeltypes.PacketState{
+			channeltypes.NewPacketState(ccvtypes.ProviderPortID, "channel-0", 2, []byte{0x02}),
+			channeltypes.NewPacketState(ccvtypes.ProviderPortID, "channel-0", 3, []byte{0x03}),
+			channeltypes.NewPacketState(ccvtypes.ProviderPortID, "channel-0", 4, []byte{0x04}),
+		})
+
+	res, err := providerKeeper.QueryPacketCommitmentReconciliation(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryPacketCommitmentReconciliationResponse{
+		ChannelId:      "channel-0",
+		NumCommitments: 3,
+		NumRecords:     4,
+		Discrepancies: []types.PacketCommitmentDiscrepancy{
+			{Sequence: 2, Type: types.PACKET_COMMITMENT_DISCREPANCY_TYPE_COMMITTED_COMPLETED, Record: &records[1]},
+			{Sequence: 3, Type: types.PACKET_COMMITMENT_DISCREPANCY_TYPE_COMMITTED_UNKNOWN},
+			{Sequence: 5, Type: types.PACKET_COMMITMENT_DISCREPANCY_TYPE_RECORDED_UNCOMMITTED, Record: &records[3]},
+		},
+	}, res)
+}
+
+func TestQueryOldestValsetUpdateHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(21*24*time.Hour, nil).AnyTimes()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	_, err := providerKeeper.QueryOldestValsetUpdateHeight(ctx, &types.QueryOldestValsetUpdateHeightRequest{})
+	require.Error(t, err)
+
+	// mapping without a block time
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 3, 30)
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 4, 40)
+	providerKeeper.SetValsetUpdateBlockTime(ctx, 4, ctx.BlockTime())
+
+	res, err := providerKeeper.QueryOldestValsetUpdateHeight(ctx, &types.QueryOldestValsetUpdateHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryOldestValsetUpdateHeightResponse{
+		ValsetUpdateId:  3,
+		Height:          30,
+		RetentionPeriod: 21*24*time.Hour + types.DefaultValsetUpdateHeightRetentionMargin,
+	}, res)
+
+	providerKeeper.DeleteValsetUpdateBlockHeight(ctx, 3)
+	res, err = providerKeeper.QueryOldestValsetUpdateHeight(ctx, &types.QueryOldestValsetUpdateHeightRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.ValsetUpdateId)
+	require.NotNil(t, res.BlockTime)
+	require.Equal(t, ctx.BlockTime(), *res.BlockTime)
+}
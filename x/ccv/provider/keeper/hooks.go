@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/math"
 
@@ -49,6 +50,8 @@ func (h Hooks) AfterValidatorCreated(goCtx context.Context, valAddr sdk.ValAddre
 func (h Hooks) AfterValidatorRemoved(goCtx context.Context, valConsAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	h.k.SetModifiedValidator(ctx, providertypes.NewProviderConsAddress(valConsAddr))
+
 	for _, validatorConsumerPubKey := range h.k.GetAllValidatorConsumerPubKeys(ctx, nil) {
 		if sdk.ConsAddress(validatorConsumerPubKey.ProviderAddr).Equals(valConsAddr) {
 			consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*validatorConsumerPubKey.ConsumerKey)
@@ -74,27 +77,47 @@ func (h Hooks) BeforeDelegationSharesModified(_ context.Context, _ sdk.AccAddres
 	return nil
 }
 
-func (h Hooks) AfterDelegationModified(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
-	return nil
+func (h Hooks) AfterDelegationModified(goCtx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.setModifiedValidator(sdk.UnwrapSDKContext(goCtx), valAddr)
 }
 
-func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
-	return nil
+func (h Hooks) BeforeValidatorSlashed(goCtx context.Context, valAddr sdk.ValAddress, _ math.LegacyDec) error {
+	return h.setModifiedValidator(sdk.UnwrapSDKContext(goCtx), valAddr)
 }
 
 func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) error {
 	return nil
 }
 
-func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBonded(goCtx context.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	h.k.SetModifiedValidator(sdk.UnwrapSDKContext(goCtx), providertypes.NewProviderConsAddress(valConsAddr))
 	return nil
 }
 
-func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBeginUnbonding(goCtx context.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	h.k.SetModifiedValidator(sdk.UnwrapSDKContext(goCtx), providertypes.NewProviderConsAddress(valConsAddr))
 	return nil
 }
 
-func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+func (h Hooks) BeforeDelegationRemoved(goCtx context.Context, _ sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.setModifiedValidator(sdk.UnwrapSDKContext(goCtx), valAddr)
+}
+
+// setModifiedValidator records that the voting power of the validator with operator address `valAddr`
+// might change in the current epoch, so that the consumer validator sets can be updated incrementally
+func (h Hooks) setModifiedValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, err := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		// a validator that does not exist cannot validate consumer chains
+		return nil
+	} else if err != nil {
+		return err
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return err
+	}
+	h.k.SetModifiedValidator(ctx, providertypes.NewProviderConsAddress(consAddr))
 	return nil
 }
 
@@ -4,8 +4,10 @@ import (
 	"testing"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
@@ -90,3 +92,36 @@ func TestValidatorConsensusKeyInUse(t *testing.T) {
 		})
 	}
 }
+
+// TestHooksSetModifiedValidator tests that the staking hooks record the validators
+// whose voting power might change in the current epoch
+func TestHooksSetModifiedValidator(t *testing.T) {
+	k, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	validator0 := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	validator1 := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	validator2 := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	unknownValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, validator0.SDKValOpAddress()).
+		Return(validator0.SDKStakingValidator(), nil).Times(1)
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, unknownValidator.SDKValOpAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).Times(1)
+
+	hooks := k.Hooks()
+	require.NoError(t, hooks.AfterDelegationModified(ctx, sdk.AccAddress{}, validator0.SDKValOpAddress()))
+	require.NoError(t, hooks.AfterValidatorBonded(ctx, validator1.SDKValConsAddress(), validator1.SDKValOpAddress()))
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator2.SDKValConsAddress(), validator2.SDKValOpAddress()))
+	// validators that do not exist are ignored
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, sdk.AccAddress{}, unknownValidator.SDKValOpAddress()))
+
+	require.ElementsMatch(t, []types.ProviderConsAddress{
+		validator0.ProviderConsAddress(),
+		validator1.ProviderConsAddress(),
+		validator2.ProviderConsAddress(),
+	}, k.GetAllModifiedValidators(ctx))
+
+	k.DeleteAllModifiedValidators(ctx)
+	require.Empty(t, k.GetAllModifiedValidators(ctx))
+}
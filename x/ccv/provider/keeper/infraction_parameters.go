@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
@@ -40,9 +41,29 @@ func (k Keeper) SetInfractionParameters(ctx sdk.Context, consumerId string, para
 
 	store.Set(types.ConsumerIdToInfractionParametersKey(consumerId), bz)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInfractionParametersUpdated,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			}, infractionParametersAttributes(parameters)...)...,
+		),
+	)
+
 	return nil
 }
 
+// SetDefaultInfractionParameters sets the infraction parameters associated with this consumer id
+// to the slashing and jailing parameters of the provider
+func (k Keeper) SetDefaultInfractionParameters(ctx sdk.Context, consumerId string) error {
+	parameters, err := types.DefaultConsumerInfractionParameters(ctx, k.slashingKeeper)
+	if err != nil {
+		return fmt.Errorf("failed to get default infraction parameters for consumer id (%s): %w", consumerId, err)
+	}
+	return k.SetInfractionParameters(ctx, consumerId, parameters)
+}
+
 // DeleteInfractionParameters deletes the slashing and jailing infraction parameters associated with this consumer id
 func (k Keeper) DeleteInfractionParameters(ctx sdk.Context, consumerId string) {
 	store := ctx.KVStore(k.storeKey)
@@ -105,7 +126,8 @@ func (k Keeper) RemoveFromInfractionUpdateSchedule(ctx sdk.Context, consumerId s
 	return k.removeConsumerIdFromTime(ctx, consumerId, types.InfractionScheduledTimeToConsumerIdsKey, updateTime)
 }
 
-// GetConsumerInfractionUpdateTime gets the time when the consumer's infraction parameters are scheduled for update
+// GetConsumerInfractionUpdateTime gets the time when the consumer's infraction parameters are scheduled for update.
+// Note that the queued parameters are applied at the start of the first epoch after this time.
 func (k Keeper) GetConsumerInfractionUpdateTime(ctx sdk.Context, consumerId string) (time.Time, error) {
 	store := ctx.KVStore(k.storeKey)
 
@@ -128,10 +150,6 @@ func (k Keeper) GetConsumerInfractionUpdateTime(ctx sdk.Context, consumerId stri
 		// check if the target consumer id is in the list
 		for _, id := range consumerIds.Ids {
 			if id == consumerId {
-				// remove the consumer id from the schedule and return
-				if err := k.RemoveFromInfractionUpdateSchedule(ctx, consumerId, ts); err != nil {
-					return time.Time{}, fmt.Errorf("failed to remove consumer id from time queue: %w", err)
-				}
 				return ts, nil
 			}
 		}
@@ -206,11 +224,28 @@ func (k Keeper) UpdateQueuedInfractionParams(ctx sdk.Context, consumerId string,
 		return err
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInfractionParametersQueued,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeInfractionParametersUpdateTime, updateTime.String()),
+			}, infractionParametersAttributes(newInfractionParams)...)...,
+		),
+	)
+
 	return nil
 }
 
-// BeginBlockUpdateInfractionParameters updates infraction parameters for consumer chain for which the update time has passed
+// BeginBlockUpdateInfractionParameters updates infraction parameters for consumer chain for which the update time has passed.
+// The updates are only applied in the first block of an epoch, so that the infraction parameters
+// of a consumer chain do not change in the middle of an epoch.
 func (k Keeper) BeginBlockUpdateInfractionParameters(ctx sdk.Context) error {
+	if k.BlocksUntilNextEpoch(ctx) != 0 {
+		return nil
+	}
+
 	consumerIds, err := k.ConsumeIdsFromTimeQueue(
 		ctx,
 		types.InfractionScheduledTimeToConsumerIdsKeyPrefix(),
@@ -242,6 +277,25 @@ func (k Keeper) BeginBlockUpdateInfractionParameters(ctx sdk.Context) error {
 	return nil
 }
 
+// infractionParametersAttributes returns the event attributes of the given infraction parameters
+func infractionParametersAttributes(parameters types.InfractionParameters) []sdk.Attribute {
+	var attributes []sdk.Attribute
+	if parameters.DoubleSign != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeDoubleSignSlashFraction, parameters.DoubleSign.SlashFraction.String()),
+			sdk.NewAttribute(types.AttributeDoubleSignJailDuration, parameters.DoubleSign.JailDuration.String()),
+			sdk.NewAttribute(types.AttributeDoubleSignTombstone, strconv.FormatBool(parameters.DoubleSign.Tombstone)),
+		)
+	}
+	if parameters.Downtime != nil {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.AttributeDowntimeSlashFraction, parameters.Downtime.SlashFraction.String()),
+			sdk.NewAttribute(types.AttributeDowntimeJailDuration, parameters.Downtime.JailDuration.String()),
+		)
+	}
+	return attributes
+}
+
 func compareInfractionParameters(param1, param2 types.InfractionParameters) bool {
 	// Compare both DoubleSign and Downtime parameters
 	return compareSlashJailParameters(param1.DoubleSign, param2.DoubleSign) &&
@@ -9,6 +9,8 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
@@ -44,7 +46,7 @@ func TestUpdateQueuedInfractionParams_UpdateDifferentItem(t *testing.T) {
 	k, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
 
-	mocks.MockStakingKeeper.EXPECT().UnbondingTime(ctx).Return(time.Second, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Second, nil).AnyTimes()
 
 	consumerID := "consumer2"
 	initialParams := providertypes.InfractionParameters{
@@ -72,12 +74,24 @@ func TestUpdateQueuedInfractionParams_UpdateDifferentItem(t *testing.T) {
 	require.NoError(t, k.SetInfractionParameters(ctx, consumerID, initialParams))
 
 	// Queue different parameters
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	require.NoError(t, k.UpdateQueuedInfractionParams(ctx, consumerID, newParams))
 
 	// Verify queue has updated parameters
 	queuedParams, err := k.GetQueuedInfractionParameters(ctx, consumerID)
 	require.NoError(t, err)
 	require.Equal(t, newParams, queuedParams)
+
+	// Verify the queued update is announced with its update time
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeInfractionParametersQueued, events[0].Type)
+	updateTime, found := events[0].GetAttribute(providertypes.AttributeInfractionParametersUpdateTime)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(time.Second).String(), updateTime.Value)
+	jailDuration, found := events[0].GetAttribute(providertypes.AttributeDowntimeJailDuration)
+	require.True(t, found)
+	require.Equal(t, newParams.Downtime.JailDuration.String(), jailDuration.Value)
 }
 
 func TestUpdateQueuedInfractionParams_OnlyLastItemInQueue(t *testing.T) {
@@ -188,7 +202,19 @@ func TestBeginBlockUpdateInfractionParameters_MixedTiming(t *testing.T) {
 	err = keeper.UpdateQueuedInfractionParams(ctxWithTimeAfter, "consumer4", newInfractionParams)
 	require.NoError(t, err)
 
-	// Call BeginBlockUpdateInfractionParameters with current context
+	// the due updates are not applied in the middle of an epoch
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	keeper.SetParams(ctx, params)
+	err = keeper.BeginBlockUpdateInfractionParameters(ctx.WithBlockHeight(15))
+	require.NoError(t, err)
+	require.True(t, keeper.HasQueuedInfractionParameters(ctx, "consumer1"))
+	params1, err := keeper.GetInfractionParameters(ctx, "consumer1")
+	require.NoError(t, err)
+	require.Equal(t, oldInfractionParams, params1)
+
+	// Call BeginBlockUpdateInfractionParameters in the first block of the next epoch
+	ctx = ctx.WithBlockHeight(20)
 	err = keeper.BeginBlockUpdateInfractionParameters(ctx)
 	require.NoError(t, err)
 
@@ -199,7 +225,7 @@ func TestBeginBlockUpdateInfractionParameters_MixedTiming(t *testing.T) {
 	require.False(t, keeper.HasQueuedInfractionParameters(ctx, "consumer2"))
 
 	// Confirm infraction parameters are updated for consumer1 and consumer2
-	params1, err := keeper.GetInfractionParameters(ctx, "consumer1")
+	params1, err = keeper.GetInfractionParameters(ctx, "consumer1")
 	require.NoError(t, err)
 	require.Equal(t, params1, newInfractionParams)
 
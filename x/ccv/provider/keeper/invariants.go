@@ -3,19 +3,49 @@ package keeper
 import (
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	types "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
-// RegisterInvariants registers all staking invariants
+// RegisterInvariants registers all provider invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(types.ModuleName, "max-provider-validators",
 		MaxProviderConsensusValidatorsInvariant(k))
 
 	ir.RegisterRoute(types.ModuleName, "staking-keeper-equivalence",
 		StakingKeeperEquivalenceInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "consumer-validators",
+		ConsumerValidatorsInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "slash-meter",
+		SlashMeterInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "key-assignments",
+		KeyAssignmentsInvariant(*k))
+}
+
+// AllInvariants runs all provider invariants
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			MaxProviderConsensusValidatorsInvariant(k),
+			StakingKeeperEquivalenceInvariant(*k),
+			ConsumerValidatorsInvariant(*k),
+			SlashMeterInvariant(*k),
+			KeyAssignmentsInvariant(*k),
+		} {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
 }
 
 // MaxProviderConsensusValidatorsInvariant checks that the number of provider consensus validators
@@ -147,3 +177,113 @@ func StakingKeeperEquivalenceInvariant(k Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// ConsumerValidatorsInvariant checks that every consumer validator belongs to
+// a consumer chain that exists, i.e., that has not been deleted
+func ConsumerValidatorsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(k.storeKey)
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ConsumerValidatorKeyPrefix()})
+		defer iterator.Close()
+
+		checked := map[string]bool{}
+		for ; iterator.Valid(); iterator.Next() {
+			consumerId, err := types.ParseStringIdWithLenKey(types.ConsumerValidatorKeyPrefix(), iterator.Key())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "consumer-validators",
+					fmt.Sprintf("cannot parse consumer validator key %X: %v", iterator.Key(), err)), true
+			}
+			if checked[consumerId] {
+				continue
+			}
+			checked[consumerId] = true
+
+			phase := k.GetConsumerPhase(ctx, consumerId)
+			if phase == types.CONSUMER_PHASE_UNSPECIFIED || phase == types.CONSUMER_PHASE_DELETED {
+				return sdk.FormatInvariant(types.ModuleName, "consumer-validators",
+					fmt.Sprintf("consumer %s has validators, but is in phase %s", consumerId, phase)), true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// SlashMeterInvariant checks that the slash meter does not exceed the slash meter allowance.
+// Note that the slash meter is capped to the allowance at the beginning of every block,
+// while the allowance is only updated once the staking module updates the total power,
+// i.e., at the end of the block.
+func SlashMeterInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		meter := k.GetSlashMeter(ctx)
+		allowance := k.GetSlashMeterAllowance(ctx)
+		if meter.GT(allowance) {
+			return sdk.FormatInvariant(types.ModuleName, "slash-meter",
+				fmt.Sprintf("slash meter: %s, exceeds allowance: %s", meter, allowance)), true
+		}
+
+		return "", false
+	}
+}
+
+// KeyAssignmentsInvariant checks that there are no orphaned key assignments, i.e.,
+//   - every assigned consumer key belongs to a consumer chain that exists and
+//     is mapped back to the provider address of the validator that assigned it;
+//   - every consumer address is either the address of a currently assigned consumer key
+//     or it is going to be pruned.
+func KeyAssignmentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		// consumer id -> consumer address -> whether the address belongs to an assigned consumer key
+		assigned := map[string]map[string]bool{}
+		for _, validatorConsumerPubKey := range k.GetAllValidatorConsumerPubKeys(ctx, nil) {
+			consumerId := validatorConsumerPubKey.ChainId
+			phase := k.GetConsumerPhase(ctx, consumerId)
+			if phase == types.CONSUMER_PHASE_UNSPECIFIED || phase == types.CONSUMER_PHASE_DELETED {
+				return sdk.FormatInvariant(types.ModuleName, "key-assignments",
+					fmt.Sprintf("consumer %s has assigned consumer keys, but is in phase %s", consumerId, phase)), true
+			}
+
+			consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(*validatorConsumerPubKey.ConsumerKey)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "key-assignments",
+					fmt.Sprintf("invalid consumer key on consumer %s: %v", consumerId, err)), true
+			}
+			consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+			providerAddr := types.NewProviderConsAddress(validatorConsumerPubKey.ProviderAddr)
+			if mappedAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr); !found || !mappedAddr.ToSdkConsAddr().Equals(providerAddr.ToSdkConsAddr()) {
+				return sdk.FormatInvariant(types.ModuleName, "key-assignments",
+					fmt.Sprintf("consumer address %s on consumer %s is not mapped to provider address %s",
+						consumerAddr.String(), consumerId, providerAddr.String())), true
+			}
+
+			if assigned[consumerId] == nil {
+				assigned[consumerId] = map[string]bool{}
+			}
+			assigned[consumerId][string(consumerAddr.ToSdkConsAddr())] = true
+		}
+
+		// consumer id -> consumer address -> whether the address is going to be pruned
+		toPrune := map[string]map[string]bool{}
+		for _, validatorByConsumerAddr := range k.GetAllValidatorsByConsumerAddr(ctx, nil) {
+			consumerId := validatorByConsumerAddr.ChainId
+			if assigned[consumerId][string(validatorByConsumerAddr.ConsumerAddr)] {
+				continue
+			}
+			if toPrune[consumerId] == nil {
+				toPrune[consumerId] = map[string]bool{}
+				for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
+					for _, addr := range consumerAddrsToPrune.ConsumerAddrs.Addresses {
+						toPrune[consumerId][string(addr)] = true
+					}
+				}
+			}
+			if !toPrune[consumerId][string(validatorByConsumerAddr.ConsumerAddr)] {
+				return sdk.FormatInvariant(types.ModuleName, "key-assignments",
+					fmt.Sprintf("consumer address %s on consumer %s is neither assigned nor going to be pruned",
+						sdk.ConsAddress(validatorByConsumerAddr.ConsumerAddr).String(), consumerId)), true
+			}
+		}
+
+		return "", false
+	}
+}
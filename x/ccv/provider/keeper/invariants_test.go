@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestConsumerValidatorsInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	invariant := keeper.ConsumerValidatorsInvariant(providerKeeper)
+
+	// no consumer validators
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	err := providerKeeper.SetConsumerValidator(ctx, "0", providertypes.ConsensusValidator{
+		ProviderConsAddr: cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKValConsAddress(),
+		Power:            1,
+		PublicKey:        &consumerKey,
+	})
+	require.NoError(t, err)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the consumer chain is stopped, but not yet deleted
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_STOPPED)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the consumer chain is deleted, but its validators are not
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_DELETED)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// the validators belong to an unknown consumer chain
+	providerKeeper.DeleteConsumerValSet(ctx, "0")
+	err = providerKeeper.SetConsumerValidator(ctx, "1", providertypes.ConsensusValidator{
+		ProviderConsAddr: cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKValConsAddress(),
+		Power:            1,
+		PublicKey:        &consumerKey,
+	})
+	require.NoError(t, err)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+func TestSlashMeterInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	invariant := keeper.SlashMeterInvariant(providerKeeper)
+
+	params := providertypes.DefaultParams()
+	params.SlashMeterReplenishFraction = "0.05"
+	providerKeeper.SetParams(ctx, params)
+	// the allowance is 0.05 * 100 = 5
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(100), nil).AnyTimes()
+
+	testCases := []struct {
+		meter  int64
+		broken bool
+	}{
+		{meter: -10, broken: false},
+		{meter: 0, broken: false},
+		{meter: 5, broken: false},
+		{meter: 6, broken: true},
+	}
+	for _, tc := range testCases {
+		providerKeeper.SetSlashMeter(ctx, math.NewInt(tc.meter))
+		_, broken := invariant(ctx)
+		require.Equal(t, tc.broken, broken, "meter: %d", tc.meter)
+	}
+}
+
+func TestKeyAssignmentsInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	invariant := keeper.KeyAssignmentsInvariant(providerKeeper)
+
+	consumerId := "0"
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	oldConsumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ConsumerConsAddress()
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	// a consumer key is assigned
+	providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerIdentity.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerIdentity.ConsumerConsAddress(), providerAddr)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// an old consumer address is neither assigned nor going to be pruned
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, oldConsumerAddr, providerAddr)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// the old consumer address is going to be pruned
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, consumerId, time.Now(), oldConsumerAddr)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the assigned consumer key is not mapped back to the provider address
+	providerKeeper.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerIdentity.ConsumerConsAddress())
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerIdentity.ConsumerConsAddress(), providerAddr)
+
+	// the consumer chain is deleted, but its key assignments are not
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_DELETED)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	providerKeeper.DeleteKeyAssignments(ctx, consumerId)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
@@ -10,6 +10,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
@@ -20,6 +21,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -50,6 +52,24 @@ type Keeper struct {
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+
+	// portID is the port the provider CCV module binds to and
+	// counterpartyPortID is the port consumer CCV modules are expected to bind to.
+	// Both default to the CCV port IDs and can be changed via SetPortIDs.
+	portID             string
+	counterpartyPortID string
+
+	// lastVSCAckTimes tracks the time of the last VSC packet acknowledgement
+	// received from every consumer chain; it is only used for telemetry
+	lastVSCAckTimes *vscAckTimes
+
+	// blockProfiler measures the phases of BeginBlock and EndBlock; its time budget
+	// is disabled by default and can be set via SetBlockTimeBudget
+	blockProfiler *blockProfiler
+
+	// vscStream streams the VSC packets queued in committed blocks
+	// to the subscribers of StreamValidatorSetUpdates
+	vscStream *vscStream
 }
 
 // NewKeeper creates a new provider Keeper instance
@@ -81,6 +101,11 @@ func NewKeeper(
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
 		govKeeper:             govKeeper,
+		portID:                ccv.ProviderPortID,
+		counterpartyPortID:    ccv.ConsumerPortID,
+		lastVSCAckTimes:       newVSCAckTimes(),
+		blockProfiler:         newBlockProfiler(),
+		vscStream:             newVSCStream(),
 	}
 
 	k.mustValidateFields()
@@ -102,41 +127,78 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 	return k.consensusAddressCodec
 }
 
+// ConsAddressToString returns the string representation of the given consensus address,
+// encoded with the app consensus address codec.
+func (k Keeper) ConsAddressToString(addr []byte) string {
+	return ccv.MustAddressToString(k.consensusAddressCodec, addr)
+}
+
 // Validates that the provider keeper is initialized with non-zero and
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 15 {
-		panic(fmt.Sprintf("number of fields in provider keeper is not 15 - have %d", reflect.ValueOf(k).NumField()))
+	if reflect.ValueOf(k).NumField() != 20 {
+		panic(fmt.Sprintf("number of fields in provider keeper is not 20 - have %d", reflect.ValueOf(k).NumField()))
 	}
 
 	if k.validatorAddressCodec == nil || k.consensusAddressCodec == nil {
 		panic("validator and/or consensus address codec are nil")
 	}
 
-	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                                     // 1
+	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 1
 	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                           // 2
+	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                                     // 3
 	ccv.PanicIfZeroOrNil(k.channelKeeper, "channelKeeper")                 // 4
-	ccv.PanicIfZeroOrNil(k.connectionKeeper, "connectionKeeper")           // 6
-	ccv.PanicIfZeroOrNil(k.accountKeeper, "accountKeeper")                 // 7
-	ccv.PanicIfZeroOrNil(k.clientKeeper, "clientKeeper")                   // 8
-	ccv.PanicIfZeroOrNil(k.stakingKeeper, "stakingKeeper")                 // 9
-	ccv.PanicIfZeroOrNil(k.slashingKeeper, "slashingKeeper")               // 10
-	ccv.PanicIfZeroOrNil(k.distributionKeeper, "distributionKeeper")       // 11
-	ccv.PanicIfZeroOrNil(k.bankKeeper, "bankKeeper")                       // 12
+	ccv.PanicIfZeroOrNil(k.connectionKeeper, "connectionKeeper")           // 5
+	ccv.PanicIfZeroOrNil(k.accountKeeper, "accountKeeper")                 // 6
+	ccv.PanicIfZeroOrNil(k.clientKeeper, "clientKeeper")                   // 7
+	ccv.PanicIfZeroOrNil(k.stakingKeeper, "stakingKeeper")                 // 8
+	ccv.PanicIfZeroOrNil(k.slashingKeeper, "slashingKeeper")               // 9
+	ccv.PanicIfZeroOrNil(k.distributionKeeper, "distributionKeeper")       // 10
+	ccv.PanicIfZeroOrNil(k.bankKeeper, "bankKeeper")                       // 11
 	ccv.PanicIfZeroOrNil(k.feeCollectorName, "feeCollectorName")           // 13
-	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 14
-	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 15
-	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 16
+	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 14
+	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 15
+	ccv.PanicIfZeroOrNil(k.portID, "portID")                               // 16
+	ccv.PanicIfZeroOrNil(k.counterpartyPortID, "counterpartyPortID")       // 17
+
+	ccv.PanicIfZeroOrNil(k.lastVSCAckTimes, "lastVSCAckTimes") // 18
+	ccv.PanicIfZeroOrNil(k.blockProfiler, "blockProfiler")     // 19
+	ccv.PanicIfZeroOrNil(k.vscStream, "vscStream")             // 20
 
 	// this can be nil in tests
-	// ccv.PanicIfZeroOrNil(k.govKeeper, "govKeeper")                         // 17
+	// ccv.PanicIfZeroOrNil(k.govKeeper, "govKeeper")                         // 12
 }
 
 func (k *Keeper) SetGovKeeper(govKeeper govkeeper.Keeper) {
 	k.govKeeper = govKeeper
 }
 
+// SetPortIDs sets the port the provider CCV module binds to and the port
+// consumer CCV modules are expected to bind to. This method allows a single binary
+// to host multiple independent CCV instances and must be called in app.go,
+// before the IBC router is sealed. It panics if any of the port IDs is invalid.
+func (k *Keeper) SetPortIDs(portID, counterpartyPortID string) {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		panic(fmt.Errorf("invalid provider port ID: %w", err))
+	}
+	if err := host.PortIdentifierValidator(counterpartyPortID); err != nil {
+		panic(fmt.Errorf("invalid consumer port ID: %w", err))
+	}
+	k.portID = portID
+	k.counterpartyPortID = counterpartyPortID
+}
+
+// PortID returns the port the provider CCV module binds to
+func (k Keeper) PortID() string {
+	return k.portID
+}
+
+// CounterpartyPortID returns the port consumer CCV modules are expected to bind to
+func (k Keeper) CounterpartyPortID() string {
+	return k.counterpartyPortID
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -320,7 +382,7 @@ func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectio
 //
 // SetConsumerChain is called by OnChanOpenConfirm.
 func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
-	channel, ok := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelID)
+	channel, ok := k.channelKeeper.GetChannel(ctx, k.portID, channelID)
 	if !ok {
 		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelID)
 	}
@@ -389,7 +451,7 @@ func (k Keeper) getUnderlyingClient(ctx sdk.Context, connectionID string) (
 
 // chanCloseInit defines a wrapper function for the channel Keeper's function
 func (k Keeper) chanCloseInit(ctx sdk.Context, channelID string) error {
-	return k.channelKeeper.ChanCloseInit(ctx, ccv.ProviderPortID, channelID)
+	return k.channelKeeper.ChanCloseInit(ctx, k.portID, channelID)
 }
 
 func (k Keeper) IncrementValidatorSetUpdateId(ctx sdk.Context) {
@@ -594,6 +656,181 @@ func (k Keeper) DeletePendingVSCPackets(ctx sdk.Context, consumerId string) {
 	store.Delete(types.PendingVSCsKey(consumerId))
 }
 
+// SetVscConfirmation sets the VSC confirmation of a consumer chain for the VSC id of the confirmation
+func (k Keeper) SetVscConfirmation(ctx sdk.Context, consumerId string, confirmation types.VscConfirmation) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := confirmation.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// confirmation is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal VSC confirmation: %w", err))
+	}
+	store.Set(types.ConsumerIdToVscConfirmationKey(consumerId, confirmation.ValsetUpdateId), bz)
+}
+
+// GetVscConfirmation returns the VSC confirmation of a consumer chain for the given VSC id
+func (k Keeper) GetVscConfirmation(ctx sdk.Context, consumerId string, vscId uint64) (types.VscConfirmation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToVscConfirmationKey(consumerId, vscId))
+	if bz == nil {
+		return types.VscConfirmation{}, false
+	}
+	var confirmation types.VscConfirmation
+	if err := confirmation.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the confirmation is assumed to be correctly serialized in SetVscConfirmation.
+		panic(fmt.Errorf("cannot unmarshal VSC confirmation: %w", err))
+	}
+	return confirmation, true
+}
+
+// GetAllVscConfirmations returns all the VSC confirmations of a consumer chain ordered by VSC id
+func (k Keeper) GetAllVscConfirmations(ctx sdk.Context, consumerId string) (confirmations []types.VscConfirmation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToVscConfirmationKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var confirmation types.VscConfirmation
+		if err := confirmation.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the confirmation is assumed to be correctly serialized in SetVscConfirmation.
+			panic(fmt.Errorf("cannot unmarshal VSC confirmation: %w", err))
+		}
+		confirmations = append(confirmations, confirmation)
+	}
+	return confirmations
+}
+
+// DeleteVscConfirmation deletes the VSC confirmation of a consumer chain for the given VSC id
+func (k Keeper) DeleteVscConfirmation(ctx sdk.Context, consumerId string, vscId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToVscConfirmationKey(consumerId, vscId))
+}
+
+// DeleteVscConfirmations deletes all the VSC confirmations of a consumer chain
+func (k Keeper) DeleteVscConfirmations(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToVscConfirmationKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	keysToDel := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// SetConsumerValsetCheckpoint sets the latest valset checkpoint of a consumer chain, i.e., the valset update ID
+// and the hash of the last validator set sent to the consumer chain. The checkpoint is stored under a key
+// known to the consumer module (see ccv.ProviderValsetCheckpointKey), so that the consumer chain can verify
+// proofs of it even if its CCV channel is not relaying packets.
+func (k Keeper) SetConsumerValsetCheckpoint(ctx sdk.Context, consumerId string, checkpoint ccv.ValsetCheckpointPacketData) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := checkpoint.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// checkpoint is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal valset checkpoint: %w", err))
+	}
+	store.Set(types.ConsumerIdToValsetCheckpointKey(consumerId), bz)
+}
+
+// GetConsumerValsetCheckpoint returns the latest valset checkpoint of a consumer chain
+func (k Keeper) GetConsumerValsetCheckpoint(ctx sdk.Context, consumerId string) (ccv.ValsetCheckpointPacketData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToValsetCheckpointKey(consumerId))
+	if bz == nil {
+		return ccv.ValsetCheckpointPacketData{}, false
+	}
+	var checkpoint ccv.ValsetCheckpointPacketData
+	if err := checkpoint.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the checkpoint is assumed to be correctly serialized in SetConsumerValsetCheckpoint.
+		panic(fmt.Errorf("cannot unmarshal valset checkpoint: %w", err))
+	}
+	return checkpoint, true
+}
+
+// DeleteConsumerValsetCheckpoint deletes the latest valset checkpoint of a consumer chain
+func (k Keeper) DeleteConsumerValsetCheckpoint(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToValsetCheckpointKey(consumerId))
+}
+
+// SetConsumerFailureHeight sets the last block height at which the per-block processing of a consumer chain failed
+func (k Keeper) SetConsumerFailureHeight(ctx sdk.Context, consumerId string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToFailureHeightKey(consumerId), sdk.Uint64ToBigEndian(height))
+}
+
+// GetConsumerFailureHeight returns the last block height at which the per-block processing
+// of a consumer chain failed and whether such a failure occurred
+func (k Keeper) GetConsumerFailureHeight(ctx sdk.Context, consumerId string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToFailureHeightKey(consumerId))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteConsumerFailureHeight deletes the last block height at which the per-block processing of a consumer chain failed
+func (k Keeper) DeleteConsumerFailureHeight(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToFailureHeightKey(consumerId))
+}
+
+// ExecuteIsolated executes the given per-block operation of a consumer chain on a branch of the state.
+// The branch is committed only if the operation succeeds. Otherwise, i.e., if the operation returns
+// an error or panics, the failure is handled by HandleConsumerFailure and the provider chain continues.
+// This ensures that a single consumer chain with a bad state cannot halt the provider chain.
+// It returns whether the operation succeeded.
+func (k Keeper) ExecuteIsolated(ctx sdk.Context, consumerId, operation string, fn func(sdk.Context) error) bool {
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := callRecovered(cachedCtx, fn); err != nil {
+		k.HandleConsumerFailure(ctx, consumerId, operation, err)
+		return false
+	}
+	writeCache()
+	return true
+}
+
+// HandleConsumerFailure logs the failure of a per-block operation of a consumer chain,
+// flags the consumer chain by recording the current block height, and emits an event
+func (k Keeper) HandleConsumerFailure(ctx sdk.Context, consumerId, operation string, err error) {
+	k.Logger(ctx).Error("consumer chain operation failed:",
+		"consumerId", consumerId,
+		"operation", operation,
+		"error", err.Error(),
+	)
+	k.SetConsumerFailureHeight(ctx, consumerId, uint64(ctx.BlockHeight()))
+	ccv.IncrConsumerCounter(types.ModuleName, ccv.MetricKeyConsumerFailures, consumerId, 1,
+		telemetry.NewLabel(ccv.MetricLabelOperation, operation))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerFailure,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerOperation, operation),
+			sdk.NewAttribute(types.AttributeConsumerError, err.Error()),
+		),
+	)
+}
+
+// callRecovered calls fn and returns a panic in fn as an error
+func callRecovered(ctx sdk.Context, fn func(sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
+
 // SetConsumerClientId sets the client id for the given consumer id.
 // Note that the method also stores a reverse index that can be accessed
 // by calling GetClientIdToConsumerId.
@@ -771,6 +1008,76 @@ func (k Keeper) DeleteConsumerCommissionRate(
 	store.Delete(types.ConsumerCommissionRateKey(consumerId, providerAddr))
 }
 
+// SetConsumerMinCommissionRate sets the minimum commission rate validators can set on the given consumer chain
+func (k Keeper) SetConsumerMinCommissionRate(ctx sdk.Context, consumerId string, minRate math.LegacyDec) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := minRate.Marshal()
+	if err != nil {
+		return fmt.Errorf("consumer min commission rate marshalling failed: %s", err)
+	}
+
+	store.Set(types.ConsumerIdToMinCommissionRateKey(consumerId), bz)
+	return nil
+}
+
+// GetConsumerMinCommissionRate returns the minimum commission rate set for the given consumer chain
+func (k Keeper) GetConsumerMinCommissionRate(ctx sdk.Context, consumerId string) (math.LegacyDec, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToMinCommissionRateKey(consumerId))
+	if bz == nil {
+		return math.LegacyZeroDec(), false
+	}
+
+	minRate := math.LegacyZeroDec()
+	if err := minRate.Unmarshal(bz); err != nil {
+		k.Logger(ctx).Error("consumer min commission rate unmarshalling failed: %s", err)
+		return minRate, false
+	}
+
+	return minRate, true
+}
+
+// DeleteConsumerMinCommissionRate deletes the minimum commission rate set for the given consumer chain
+func (k Keeper) DeleteConsumerMinCommissionRate(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToMinCommissionRateKey(consumerId))
+}
+
+// SetConsumerCCVTimeoutPeriod sets the timeout period of the CCV packets sent to the given consumer chain
+func (k Keeper) SetConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string, timeoutPeriod time.Duration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToCCVTimeoutPeriodKey(consumerId), sdk.Uint64ToBigEndian(uint64(timeoutPeriod)))
+}
+
+// GetConsumerCCVTimeoutPeriod returns the timeout period set for the CCV packets sent to the given consumer chain
+func (k Keeper) GetConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string) (time.Duration, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToCCVTimeoutPeriodKey(consumerId))
+	if bz == nil {
+		return 0, false
+	}
+	return time.Duration(sdk.BigEndianToUint64(bz)), true
+}
+
+// DeleteConsumerCCVTimeoutPeriod deletes the timeout period set for the CCV packets sent to the given consumer chain
+func (k Keeper) DeleteConsumerCCVTimeoutPeriod(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToCCVTimeoutPeriodKey(consumerId))
+}
+
+// GetCCVTimeoutPeriodForConsumer returns the timeout period of the CCV packets sent to the given consumer chain,
+// i.e., the timeout period set for the consumer chain if any, and the CcvTimeoutPeriod param otherwise.
+// As the CcvTimeoutPeriod param may change, the timeout period set for the consumer chain
+// is clamped to the bounds given by the current param (see types.ProviderCCVTimeoutPeriodBounds).
+func (k Keeper) GetCCVTimeoutPeriodForConsumer(ctx sdk.Context, consumerId string) time.Duration {
+	ccvTimeoutPeriod := k.GetCCVTimeoutPeriod(ctx)
+	if timeoutPeriod, found := k.GetConsumerCCVTimeoutPeriod(ctx, consumerId); found {
+		minPeriod, maxPeriod := types.ProviderCCVTimeoutPeriodBounds(ccvTimeoutPeriod)
+		return min(max(timeoutPeriod, minPeriod), maxPeriod)
+	}
+	return ccvTimeoutPeriod
+}
+
 func (k Keeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
 	return k.stakingKeeper.UnbondingCanComplete(ctx, id)
 }
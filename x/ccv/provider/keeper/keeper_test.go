@@ -11,6 +11,7 @@ import (
 	"cosmossdk.io/math"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -203,6 +204,56 @@ func TestPendingVSCs(t *testing.T) {
 	require.Len(t, pending, 0)
 }
 
+// TestVscConfirmations tests the getter, setter, and deletion methods for VSC confirmations
+func TestVscConfirmations(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetVscConfirmation(ctx, CONSUMER_ID, 1)
+	require.False(t, found)
+
+	confirmations := []providertypes.VscConfirmation{
+		{ValsetUpdateId: 1, ExpectedValsetHash: []byte{0x01}},
+		{ValsetUpdateId: 2, ExpectedValsetHash: []byte{0x02}, AppliedValsetHash: []byte{0x03}},
+		{ValsetUpdateId: 300, ExpectedValsetHash: []byte{0x04}},
+	}
+	// set in reverse order to check that confirmations are returned ordered by VSC id
+	for i := len(confirmations) - 1; i >= 0; i-- {
+		providerKeeper.SetVscConfirmation(ctx, CONSUMER_ID, confirmations[i])
+	}
+	providerKeeper.SetVscConfirmation(ctx, "1", confirmations[0])
+
+	confirmation, found := providerKeeper.GetVscConfirmation(ctx, CONSUMER_ID, 2)
+	require.True(t, found)
+	require.Equal(t, confirmations[1], confirmation)
+	require.Equal(t, confirmations, providerKeeper.GetAllVscConfirmations(ctx, CONSUMER_ID))
+
+	providerKeeper.DeleteVscConfirmation(ctx, CONSUMER_ID, 2)
+	require.Equal(t, []providertypes.VscConfirmation{confirmations[0], confirmations[2]}, providerKeeper.GetAllVscConfirmations(ctx, CONSUMER_ID))
+
+	providerKeeper.DeleteVscConfirmations(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllVscConfirmations(ctx, CONSUMER_ID))
+	require.Len(t, providerKeeper.GetAllVscConfirmations(ctx, "1"), 1)
+}
+
+func TestConsumerValsetCheckpoint(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerValsetCheckpoint(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	checkpoint := ccv.NewValsetCheckpointPacketData(5, []byte{0x01})
+	providerKeeper.SetConsumerValsetCheckpoint(ctx, CONSUMER_ID, checkpoint)
+	actual, found := providerKeeper.GetConsumerValsetCheckpoint(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, checkpoint, actual)
+
+	providerKeeper.DeleteConsumerValsetCheckpoint(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetConsumerValsetCheckpoint(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
 // TestInitHeight tests the getter and setter methods for the stored block heights (on provider) when a given consumer chain was started
 func TestInitHeight(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -328,7 +379,112 @@ func TestConsumerCommissionRate(t *testing.T) {
 	require.False(t, found)
 }
 
+// TestConsumerCCVTimeoutPeriod tests the getter, setter, and deletion of the CCV timeout periods of consumer chains
+// and that the CcvTimeoutPeriod param applies to the consumer chains without a timeout period
+func TestConsumerCCVTimeoutPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	_, found := providerKeeper.GetConsumerCCVTimeoutPeriod(ctx, CONSUMER_ID)
+	require.False(t, found)
+	require.Equal(t, providerKeeper.GetCCVTimeoutPeriod(ctx), providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, CONSUMER_ID))
+
+	minPeriod, _ := providertypes.ProviderCCVTimeoutPeriodBounds(providerKeeper.GetCCVTimeoutPeriod(ctx))
+	providerKeeper.SetConsumerCCVTimeoutPeriod(ctx, CONSUMER_ID, minPeriod)
+	timeoutPeriod, found := providerKeeper.GetConsumerCCVTimeoutPeriod(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, minPeriod, timeoutPeriod)
+	require.Equal(t, minPeriod, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, CONSUMER_ID))
+	require.Equal(t, providerKeeper.GetCCVTimeoutPeriod(ctx), providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, "other"))
+
+	// the timeout period of a consumer chain stays within the bounds when the CcvTimeoutPeriod param changes
+	params := providerKeeper.GetParams(ctx)
+	params.CcvTimeoutPeriod = 4 * minPeriod
+	providerKeeper.SetParams(ctx, params)
+	require.Equal(t, 2*minPeriod, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, CONSUMER_ID))
+	params.CcvTimeoutPeriod = minPeriod / 4
+	providerKeeper.SetParams(ctx, params)
+	require.Equal(t, minPeriod/2, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, CONSUMER_ID))
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	require.Equal(t, minPeriod, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, CONSUMER_ID))
+
+	providerKeeper.DeleteConsumerCCVTimeoutPeriod(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetConsumerCCVTimeoutPeriod(ctx, CONSUMER_ID)
+	require.False(t, found)
+	require.Equal(t, providerKeeper.GetCCVTimeoutPeriod(ctx), providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, CONSUMER_ID))
+}
+
 // TestConsumerClientId tests the getter, setter, and deletion of the client id <> consumer id mappings
+// TestExecuteIsolated tests that failing per-block operations of a consumer chain
+// do not modify the state and flag the consumer chain
+func TestExecuteIsolated(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(10)
+
+	testCases := []struct {
+		name       string
+		fn         func(sdk.Context) error
+		expSuccess bool
+	}{
+		{
+			"success",
+			func(ctx sdk.Context) error {
+				providerKeeper.SetConsumerClientId(ctx, "0", "clientId0")
+				return nil
+			},
+			true,
+		},
+		{
+			"error",
+			func(ctx sdk.Context) error {
+				providerKeeper.SetConsumerClientId(ctx, "1", "clientId1")
+				return fmt.Errorf("error")
+			},
+			false,
+		},
+		{
+			"panic",
+			func(ctx sdk.Context) error {
+				providerKeeper.SetConsumerClientId(ctx, "2", "clientId2")
+				panic("panic")
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		consumerId := fmt.Sprint(i)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		success := providerKeeper.ExecuteIsolated(ctx, consumerId, "operation", tc.fn)
+		require.Equal(t, tc.expSuccess, success, tc.name)
+
+		// the state is only modified if the operation succeeds
+		_, found := providerKeeper.GetConsumerClientId(ctx, consumerId)
+		require.Equal(t, tc.expSuccess, found, tc.name)
+
+		// the consumer chain is only flagged if the operation fails
+		height, found := providerKeeper.GetConsumerFailureHeight(ctx, consumerId)
+		require.Equal(t, !tc.expSuccess, found, tc.name)
+		failureEmitted := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == providertypes.EventTypeConsumerFailure {
+				failureEmitted = true
+			}
+		}
+		require.Equal(t, !tc.expSuccess, failureEmitted, tc.name)
+		if !tc.expSuccess {
+			require.Equal(t, uint64(10), height, tc.name)
+		}
+	}
+
+	providerKeeper.DeleteConsumerFailureHeight(ctx, "1")
+	_, found := providerKeeper.GetConsumerFailureHeight(ctx, "1")
+	require.False(t, found)
+}
+
 func TestConsumerClientId(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
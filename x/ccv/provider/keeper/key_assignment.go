@@ -99,6 +99,7 @@ func (k Keeper) SetValidatorConsumerPubKey(
 		panic(fmt.Sprintf("failed to marshal consumer key: %v", err))
 	}
 	store.Set(types.ConsumerValidatorsKey(consumerId, providerAddr), bz)
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 }
 
 // GetAllValidatorConsumerPubKeys gets all the validators public keys assigned for a consumer chain
@@ -153,6 +154,7 @@ func (k Keeper) GetAllValidatorConsumerPubKeys(ctx sdk.Context, consumerId *stri
 func (k Keeper) DeleteValidatorConsumerPubKey(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerValidatorsKey(consumerId, providerAddr))
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 }
 
 // GetValidatorByConsumerAddr returns a validator's consensus address on the provider
@@ -358,13 +360,24 @@ func (k Keeper) ConsumeConsumerAddrsToPrune(
 // ConsumerAddrsToPruneV2BytePrefix | len(consumerId) | consumerId | timestamp
 // Thus, the returned array is in ascending order of timestamps.
 func (k Keeper) GetAllConsumerAddrsToPrune(ctx sdk.Context, consumerId string) (consumerAddrsToPrune []types.ConsumerAddrsToPruneV2) {
+	return k.getConsumerAddrsToPrune(ctx, types.StringIdWithLenKey(types.ConsumerAddrsToPruneV2KeyPrefix(), consumerId))
+}
+
+// GetConsumerAddrsToPruneOfAllConsumers gets all consumer addresses that can be eventually pruned
+// for all consumer chains, including the ones that were stopped, ordered by consumer id and timestamp
+func (k Keeper) GetConsumerAddrsToPruneOfAllConsumers(ctx sdk.Context) (consumerAddrsToPrune []types.ConsumerAddrsToPruneV2) {
+	return k.getConsumerAddrsToPrune(ctx, []byte{types.ConsumerAddrsToPruneV2KeyPrefix()})
+}
+
+// getConsumerAddrsToPrune gets the consumer addresses that can be eventually pruned
+// stored under keys with the given prefix
+func (k Keeper) getConsumerAddrsToPrune(ctx sdk.Context, iteratorPrefix []byte) (consumerAddrsToPrune []types.ConsumerAddrsToPruneV2) {
 	store := ctx.KVStore(k.storeKey)
 	consumerAddrsToPruneKeyPrefix := types.ConsumerAddrsToPruneV2KeyPrefix()
-	iteratorPrefix := types.StringIdWithLenKey(consumerAddrsToPruneKeyPrefix, consumerId)
 	iterator := storetypes.KVStorePrefixIterator(store, iteratorPrefix)
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		_, ts, err := types.ParseStringIdAndTsKey(consumerAddrsToPruneKeyPrefix, iterator.Key())
+		consumerId, ts, err := types.ParseStringIdAndTsKey(consumerAddrsToPruneKeyPrefix, iterator.Key())
 		if err != nil {
 			// An error here would indicate something is very wrong,
 			// store keys are assumed to be correctly serialized in AppendConsumerAddrsToPrune.
@@ -450,33 +463,25 @@ func (k Keeper) AssignConsumerKey(
 		)
 	}
 
+	if !k.GetAllowConsumerKeyReuse(ctx) {
+		// Reusing a consumer key across consumer chains means that the compromise of the key
+		// affects all these chains. Unless governance allows it, we prevent assigning a consumer
+		// key that is assigned (or to be pruned) on another consumer chain.
+		if usages := k.GetConsumerKeyUsages(ctx, consumerAddr); len(usages) > 0 {
+			return errorsmod.Wrapf(
+				types.ErrConsumerKeyReused, "the consumer key is assigned on consumer chain %s", usages[0].ConsumerId,
+			)
+		}
+	}
+
 	// get the previous key assigned for this validator on this consumer chain
 	if oldConsumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); found {
 		oldConsumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(oldConsumerKey)
 		if err != nil {
 			return err
 		}
-		oldConsumerAddr := types.NewConsumerConsAddress(oldConsumerAddrTmp)
-
-		// check whether the consumer chain has already launched (i.e., a client to the consumer was already created)
-		phase := k.GetConsumerPhase(ctx, consumerId)
-		if phase == types.CONSUMER_PHASE_LAUNCHED {
-			// mark the old consumer address as prunable once UnbondingPeriod elapses;
-			// note: this state is removed on EndBlock
-			unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
-			if err != nil {
-				return err
-			}
-			k.AppendConsumerAddrsToPrune(
-				ctx,
-				consumerId,
-				ctx.BlockTime().Add(unbondingPeriod),
-				oldConsumerAddr,
-			)
-		} else {
-			// if the consumer chain is not registered, then remove the mapping
-			// from the old consumer address to the provider address
-			k.DeleteValidatorByConsumerAddr(ctx, consumerId, oldConsumerAddr)
+		if err := k.scheduleConsumerAddrPruning(ctx, consumerId, types.NewConsumerConsAddress(oldConsumerAddrTmp)); err != nil {
+			return err
 		}
 	}
 
@@ -490,6 +495,43 @@ func (k Keeper) AssignConsumerKey(
 	// note: this state must be deleted through the pruning mechanism
 	k.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, providerAddr)
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerKeyAssigned,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+			sdk.NewAttribute(types.AttributeConsumerConsAddress, k.ConsAddressToString(consumerAddr.ToSdkConsAddr())),
+		),
+	)
+
+	return nil
+}
+
+// scheduleConsumerAddrPruning removes the mapping from a consumer address that is no longer assigned
+// to the provider address, either once UnbondingPeriod elapses if the consumer chain already launched,
+// or immediately otherwise
+func (k Keeper) scheduleConsumerAddrPruning(ctx sdk.Context, consumerId string, consumerAddr types.ConsumerConsAddress) error {
+	// check whether the consumer chain has already launched (i.e., a client to the consumer was already created)
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase == types.CONSUMER_PHASE_LAUNCHED {
+		// mark the consumer address as prunable once UnbondingPeriod elapses;
+		// note: this state is removed on EndBlock
+		unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+		if err != nil {
+			return err
+		}
+		k.AppendConsumerAddrsToPrune(
+			ctx,
+			consumerId,
+			ctx.BlockTime().Add(unbondingPeriod),
+			consumerAddr,
+		)
+	} else {
+		// if the consumer chain is not launched, then remove the mapping
+		// from the consumer address to the provider address
+		k.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+	}
 	return nil
 }
 
@@ -525,6 +567,52 @@ func (k Keeper) PruneKeyAssignments(ctx sdk.Context, consumerId string) {
 	}
 }
 
+// FlushKeyAssignmentReplacement prunes the consumer address of a replaced consumer key whose
+// prune time elapsed, but that was not pruned, e.g., because the consumer chain has no IBC client.
+// It returns the provider address of the validator that assigned the replaced consumer key.
+func (k Keeper) FlushKeyAssignmentReplacement(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+) (types.ProviderConsAddress, error) {
+	for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
+		remainingAddrs := []types.ConsumerConsAddress{}
+		found := false
+		for _, addrBz := range consumerAddrsToPrune.ConsumerAddrs.Addresses {
+			if consumerAddr.ToSdkConsAddr().Equals(sdk.ConsAddress(addrBz)) {
+				found = true
+				continue
+			}
+			remainingAddrs = append(remainingAddrs, types.NewConsumerConsAddress(addrBz))
+		}
+		if !found {
+			continue
+		}
+
+		if consumerAddrsToPrune.PruneTs.After(ctx.BlockTime()) {
+			return types.ProviderConsAddress{}, errorsmod.Wrapf(types.ErrKeyAssignmentReplacementNotStuck,
+				"consumer address %s can be pruned after %s", consumerAddr.String(), consumerAddrsToPrune.PruneTs)
+		}
+
+		// rewrite the list of consumer addresses without the flushed one
+		k.DeleteConsumerAddrsToPrune(ctx, consumerId, consumerAddrsToPrune.PruneTs)
+		for _, addr := range remainingAddrs {
+			k.AppendConsumerAddrsToPrune(ctx, consumerId, consumerAddrsToPrune.PruneTs, addr)
+		}
+
+		providerAddr, _ := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+		k.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+		k.Logger(ctx).Info("consumer address was flushed",
+			"consumer consumerId", consumerId,
+			"consumer consensus addr", consumerAddr.String(),
+		)
+		return providerAddr, nil
+	}
+
+	return types.ProviderConsAddress{}, errorsmod.Wrapf(types.ErrUnknownKeyAssignmentReplacement,
+		"consumer id: %s, consumer address: %s", consumerId, consumerAddr.String())
+}
+
 // DeleteKeyAssignments deletes all the state needed for key assignments on a consumer chain
 func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, consumerId string) {
 	// delete ValidatorConsumerPubKey
@@ -545,6 +633,27 @@ func (k Keeper) DeleteKeyAssignments(ctx sdk.Context, consumerId string) {
 	}
 }
 
+// GetConsumerKeyUsages returns the assignments of the consumer key with the given consensus address
+// on all the registered, initialized, or launched consumer chains, including the assignments of
+// replaced consumer keys that are not yet pruned
+func (k Keeper) GetConsumerKeyUsages(ctx sdk.Context, consumerAddr types.ConsumerConsAddress) []types.ConsumerKeyUsage {
+	usages := []types.ConsumerKeyUsage{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+		if !found {
+			continue
+		}
+		// the chain id is set for every registered consumer chain
+		chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+		usages = append(usages, types.ConsumerKeyUsage{
+			ConsumerId:      consumerId,
+			ChainId:         chainId,
+			ProviderAddress: k.ConsAddressToString(providerAddr.ToSdkConsAddr()),
+		})
+	}
+	return usages
+}
+
 // ValidatorConsensusKeyInUse checks if the given consensus key is already
 // used by validator in a consumer chain.
 // Note that this method is called when a new validator is created in the x/staking module of cosmos-sdk.
@@ -235,6 +235,56 @@ func TestConsumerAddrsToPruneCRUD(t *testing.T) {
 	require.Equal(t, addrsToPrune[0], consumerAddr2.ToSdkConsAddr().Bytes())
 }
 
+func TestFlushKeyAssignmentReplacement(t *testing.T) {
+	chainID := CONSUMER_CHAIN_ID
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	consumerAddr1 := types.NewConsumerConsAddress([]byte("consumerAddr1"))
+	consumerAddr2 := types.NewConsumerConsAddress([]byte("consumerAddr2"))
+	consumerAddr3 := types.NewConsumerConsAddress([]byte("consumerAddr3"))
+
+	keeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ts1 := ctx.BlockTime()
+	ts2 := ts1.Add(time.Hour)
+
+	// the first two consumer addresses are due, the third one is not
+	for _, addr := range []types.ConsumerConsAddress{consumerAddr1, consumerAddr2} {
+		keeper.SetValidatorByConsumerAddr(ctx, chainID, addr, providerAddr)
+		keeper.AppendConsumerAddrsToPrune(ctx, chainID, ts1, addr)
+	}
+	keeper.SetValidatorByConsumerAddr(ctx, chainID, consumerAddr3, providerAddr)
+	keeper.AppendConsumerAddrsToPrune(ctx, chainID, ts2, consumerAddr3)
+
+	addr, err := keeper.FlushKeyAssignmentReplacement(ctx, chainID, consumerAddr1)
+	require.NoError(t, err)
+	require.Equal(t, providerAddr, addr)
+	_, found := keeper.GetValidatorByConsumerAddr(ctx, chainID, consumerAddr1)
+	require.False(t, found)
+	require.Equal(t, [][]byte{consumerAddr2.ToSdkConsAddr()}, keeper.GetConsumerAddrsToPrune(ctx, chainID, ts1).Addresses)
+
+	// flushing the same consumer address again fails
+	_, err = keeper.FlushKeyAssignmentReplacement(ctx, chainID, consumerAddr1)
+	require.ErrorIs(t, err, types.ErrUnknownKeyAssignmentReplacement)
+
+	// the consumer address cannot be flushed before its prune time
+	_, err = keeper.FlushKeyAssignmentReplacement(ctx, chainID, consumerAddr3)
+	require.ErrorIs(t, err, types.ErrKeyAssignmentReplacementNotStuck)
+	_, found = keeper.GetValidatorByConsumerAddr(ctx, chainID, consumerAddr3)
+	require.True(t, found)
+
+	// flushing the last consumer address of a prune time deletes the list
+	_, err = keeper.FlushKeyAssignmentReplacement(ctx, chainID, consumerAddr2)
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetConsumerAddrsToPrune(ctx, chainID, ts1).Addresses)
+	require.Len(t, keeper.GetAllConsumerAddrsToPrune(ctx, chainID), 1)
+
+	ctx = ctx.WithBlockTime(ts2)
+	_, err = keeper.FlushKeyAssignmentReplacement(ctx, chainID, consumerAddr3)
+	require.NoError(t, err)
+	require.Empty(t, keeper.GetAllConsumerAddrsToPrune(ctx, chainID))
+}
+
 func TestGetAllConsumerAddrsToPrune(t *testing.T) {
 	pk, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -627,6 +677,71 @@ func TestCannotReassignDefaultKeyAssignment(t *testing.T) {
 	require.Equal(t, "a validator cannot assign the default key assignment unless its key on that consumer has already been assigned: cannot re-assign default key assignment", err.Error())
 }
 
+// TestAssignConsumerKeyAcrossConsumerChains tests that a consumer key assigned on a consumer chain
+// cannot be assigned on another consumer chain, unless the allow_consumer_key_reuse param is enabled
+func TestAssignConsumerKeyAcrossConsumerChains(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerIdentities := []*cryptotestutil.CryptoIdentity{
+		cryptotestutil.NewCryptoIdentityFromIntSeed(0),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(1),
+	}
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+
+	// the consumer key is not used as a consensus key on the provider
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consumerIdentity.SDKValConsAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	consumerIds := []string{}
+	for _, chainId := range []string{"chain-0", "chain-1"} {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerChainId(ctx, consumerId, chainId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	err := providerKeeper.AssignConsumerKey(ctx, consumerIds[0],
+		providerIdentities[0].SDKStakingValidator(), consumerIdentity.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+	expectedUsages := []types.ConsumerKeyUsage{
+		{
+			ConsumerId:      consumerIds[0],
+			ChainId:         "chain-0",
+			ProviderAddress: providerIdentities[0].SDKValConsAddress().String(),
+		},
+	}
+	require.Equal(t, expectedUsages, providerKeeper.GetConsumerKeyUsages(ctx, consumerIdentity.ConsumerConsAddress()))
+
+	// neither the same nor another validator can assign the consumer key on another consumer chain
+	for _, identity := range providerIdentities {
+		err = providerKeeper.AssignConsumerKey(ctx, consumerIds[1],
+			identity.SDKStakingValidator(), consumerIdentity.TMProtoCryptoPublicKey())
+		require.ErrorIs(t, err, types.ErrConsumerKeyReused)
+	}
+	require.Equal(t, expectedUsages, providerKeeper.GetConsumerKeyUsages(ctx, consumerIdentity.ConsumerConsAddress()))
+
+	// once the consumer chain is stopped, the consumer key can be assigned on another consumer chain
+	providerKeeper.SetConsumerPhase(ctx, consumerIds[0], types.CONSUMER_PHASE_STOPPED)
+	require.Empty(t, providerKeeper.GetConsumerKeyUsages(ctx, consumerIdentity.ConsumerConsAddress()))
+	providerKeeper.SetConsumerPhase(ctx, consumerIds[0], types.CONSUMER_PHASE_LAUNCHED)
+
+	// governance allows the reuse of consumer keys across consumer chains
+	params := providerKeeper.GetParams(ctx)
+	params.AllowConsumerKeyReuse = true
+	providerKeeper.SetParams(ctx, params)
+
+	err = providerKeeper.AssignConsumerKey(ctx, consumerIds[1],
+		providerIdentities[0].SDKStakingValidator(), consumerIdentity.TMProtoCryptoPublicKey())
+	require.NoError(t, err)
+	expectedUsages = append(expectedUsages, types.ConsumerKeyUsage{
+		ConsumerId:      consumerIds[1],
+		ChainId:         "chain-1",
+		ProviderAddress: providerIdentities[0].SDKValConsAddress().String(),
+	})
+	require.Equal(t, expectedUsages, providerKeeper.GetConsumerKeyUsages(ctx, consumerIdentity.ConsumerConsAddress()))
+}
+
 // Represents the validator set of a chain
 type ValSet struct {
 	identities []*cryptotestutil.CryptoIdentity
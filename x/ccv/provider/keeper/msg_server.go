@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -52,7 +53,7 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssignConsumerKey) (*types.MsgAssignConsumerKeyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	providerValidatorAddr, err := k.validatorAddressCodec.StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -74,6 +75,16 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 		return nil, err
 	}
 
+	consAddrTmp, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+	providerConsAddrStr, err := k.consensusAddressCodec.BytesToString(consAddrTmp)
+	if err != nil {
+		return nil, err
+	}
+	ccvtypes.IncrConsumerCounter(types.ModuleName, ccvtypes.MetricKeyKeyAssignments, msg.ConsumerId, 1)
+
 	chainId, err := k.GetConsumerChainId(ctx, msg.ConsumerId)
 	if err != nil {
 		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
@@ -93,6 +104,7 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
 			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
 			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, providerConsAddrStr),
 			sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, msg.ConsumerKey),
 			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
 		),
@@ -198,7 +210,7 @@ func (k msgServer) SubmitConsumerDoubleVoting(goCtx context.Context, msg *types.
 func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.MsgOptInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	valAddress, err := k.validatorAddressCodec.StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -214,6 +226,10 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 		return nil, err
 	}
 	providerConsAddr := types.NewProviderConsAddress(consAddrTmp)
+	providerConsAddrStr, err := k.consensusAddressCodec.BytesToString(consAddrTmp)
+	if err != nil {
+		return nil, err
+	}
 
 	err = k.Keeper.HandleOptIn(ctx, msg.ConsumerId, providerConsAddr, msg.ConsumerKey)
 	if err != nil {
@@ -239,6 +255,7 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
 			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
 			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, providerConsAddrStr),
 			sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, msg.ConsumerKey),
 			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
 		),
@@ -250,7 +267,7 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.MsgOptOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	valAddress, err := k.validatorAddressCodec.StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -266,6 +283,10 @@ func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.M
 		return nil, err
 	}
 	providerConsAddr := types.NewProviderConsAddress(consAddrTmp)
+	providerConsAddrStr, err := k.consensusAddressCodec.BytesToString(consAddrTmp)
+	if err != nil {
+		return nil, err
+	}
 
 	err = k.Keeper.HandleOptOut(ctx, msg.ConsumerId, providerConsAddr)
 	if err != nil {
@@ -290,6 +311,7 @@ func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.M
 			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
 			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
 			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, providerConsAddrStr),
 			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
 		),
 	)
@@ -300,7 +322,7 @@ func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.M
 func (k msgServer) SetConsumerCommissionRate(goCtx context.Context, msg *types.MsgSetConsumerCommissionRate) (*types.MsgSetConsumerCommissionRateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	providerValidatorAddr, err := k.validatorAddressCodec.StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -386,12 +408,28 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
 			"cannot set consumer initialization parameters: %s", err.Error())
 	}
+	if initializationParameters.ClientId != "" {
+		if _, err := k.Keeper.ValidateExistingConsumerClient(ctx, consumerId, msg.ChainId,
+			initializationParameters.ClientId, initializationParameters.UnbondingPeriod); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
+				"cannot use existing client: %s", err.Error())
+		}
+	}
 
 	// power-shaping parameters are optional and hence could be nil;
 	// in that case, set the default
+	msgPowerShapingParameters, err := k.getMsgPowerShapingParameters(ctx, msg.PowerShapingParameters, msg.PowerShapingTemplateId)
+	if err != nil {
+		return &resp, err
+	}
+	if msg.PowerShapingTemplateId != "" {
+		// add PowerShapingTemplateId event attribute
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributePowerShapingTemplateId, msg.PowerShapingTemplateId))
+	}
 	powerShapingParameters := types.PowerShapingParameters{} // default params
-	if msg.PowerShapingParameters != nil {
-		powerShapingParameters = *msg.PowerShapingParameters
+	if msgPowerShapingParameters != nil {
+		powerShapingParameters = *msgPowerShapingParameters
 
 		if powerShapingParameters.Top_N != 0 {
 			return &resp, errorsmod.Wrap(types.ErrCannotCreateTopNChain,
@@ -444,6 +482,26 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		}
 	}
 
+	if msg.MinCommissionRate != nil {
+		if err := k.SetConsumerMinCommissionRate(ctx, consumerId, *msg.MinCommissionRate); err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"cannot set min commission rate: %s", err.Error())
+		}
+	}
+
+	// the downtime params are sent to the consumer chain once it launches
+	if msg.DowntimeParams != nil {
+		k.SetConsumerDowntimeParams(ctx, consumerId, *msg.DowntimeParams)
+	}
+
+	if msg.ProviderCcvTimeoutPeriod != nil {
+		if err := types.ValidateProviderCCVTimeoutPeriod(*msg.ProviderCcvTimeoutPeriod, k.GetCCVTimeoutPeriod(ctx)); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidMsgCreateConsumer,
+				"invalid provider ccv timeout period: %s", err.Error())
+		}
+		k.SetConsumerCCVTimeoutPeriod(ctx, consumerId, *msg.ProviderCcvTimeoutPeriod)
+	}
+
 	// add Phase event attribute
 	phase := k.GetConsumerPhase(ctx, consumerId)
 	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()))
@@ -491,6 +549,9 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
 	}
 
+	// keep the fields of the consumer chain before the update to record it in the update history
+	fieldsBeforeUpdate := k.Keeper.GetConsumerUpdateFields(ctx, consumerId)
+
 	chainId, err := k.GetConsumerChainId(ctx, consumerId)
 	if err != nil {
 		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
@@ -576,13 +637,30 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
 				"cannot set consumer initialization parameters: %s", err.Error())
 		}
+		if msg.InitializationParameters.ClientId != "" {
+			if _, err := k.Keeper.ValidateExistingConsumerClient(ctx, consumerId, chainId,
+				msg.InitializationParameters.ClientId, msg.InitializationParameters.UnbondingPeriod); err != nil {
+				return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
+					"cannot use existing client: %s", err.Error())
+			}
+		}
+	}
+
+	powerShapingParameters, err := k.getMsgPowerShapingParameters(ctx, msg.PowerShapingParameters, msg.PowerShapingTemplateId)
+	if err != nil {
+		return &resp, err
+	}
+	if msg.PowerShapingTemplateId != "" {
+		// add PowerShapingTemplateId event attribute
+		eventAttributes = append(eventAttributes,
+			sdk.NewAttribute(types.AttributePowerShapingTemplateId, msg.PowerShapingTemplateId))
 	}
 
-	if msg.PowerShapingParameters != nil {
+	if powerShapingParameters != nil {
 		// A consumer chain can only become a Top N chain if the owner is the gov module. Because of this, to create a
 		// Top N chain, we need two `MsgUpdateConsumer` messages: i) one that would set the `ownerAddress` to the gov module
 		// and ii) one that would set the `Top_N` to something greater than 0.
-		if msg.PowerShapingParameters.Top_N > 0 && ownerAddress != k.GetAuthority() {
+		if powerShapingParameters.Top_N > 0 && ownerAddress != k.GetAuthority() {
 			return &resp, errorsmod.Wrapf(types.ErrInvalidTransformToTopN,
 				"an update to a Top N chain can only be done if chain is owner is the gov module")
 		}
@@ -594,19 +672,19 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		}
 		oldTopN := oldPowerShapingParameters.Top_N
 
-		if err = k.Keeper.SetConsumerPowerShapingParameters(ctx, consumerId, *msg.PowerShapingParameters); err != nil {
+		if err = k.Keeper.SetConsumerPowerShapingParameters(ctx, consumerId, *powerShapingParameters); err != nil {
 			return &resp, errorsmod.Wrapf(types.ErrInvalidPowerShapingParameters,
 				"cannot set power shaping parameters")
 		}
-		err = k.Keeper.UpdateMinimumPowerInTopN(ctx, consumerId, oldTopN, msg.PowerShapingParameters.Top_N)
+		err = k.Keeper.UpdateMinimumPowerInTopN(ctx, consumerId, oldTopN, powerShapingParameters.Top_N)
 		if err != nil {
 			return &resp, errorsmod.Wrapf(types.ErrCannotUpdateMinimumPowerInTopN,
-				"could not update minimum power in top N, oldTopN: %d, newTopN: %d, error: %s", oldTopN, msg.PowerShapingParameters.Top_N, err.Error())
+				"could not update minimum power in top N, oldTopN: %d, newTopN: %d, error: %s", oldTopN, powerShapingParameters.Top_N, err.Error())
 		}
 
 		// add TopN event attribute
 		eventAttributes = append(eventAttributes,
-			sdk.NewAttribute(types.AttributeConsumerTopN, fmt.Sprintf("%v", msg.PowerShapingParameters.Top_N)))
+			sdk.NewAttribute(types.AttributeConsumerTopN, fmt.Sprintf("%v", powerShapingParameters.Top_N)))
 	}
 
 	if msg.InfractionParameters != nil {
@@ -675,6 +753,40 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		}
 	}
 
+	// the metadata of the allowlisted reward denoms is only registered if approved by governance,
+	// i.e., if the consumer chain is owned by the gov module, as the bank metadata is global
+	if msg.Owner == k.GetAuthority() && (msg.Metadata != nil || msg.AllowlistedRewardDenoms != nil) {
+		if err := k.RegisterRewardDenomsMetadata(ctx, consumerId); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidAllowlistedRewardDenoms,
+				"cannot register reward denoms metadata: %s", err.Error())
+		}
+	}
+
+	// the commission rates below the new min commission rate are raised in EndBlock
+	if msg.MinCommissionRate != nil {
+		if err := k.SetConsumerMinCommissionRate(ctx, consumerId, *msg.MinCommissionRate); err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"cannot set min commission rate: %s", err.Error())
+		}
+	}
+
+	// the downtime params are sent to the consumer chain in EndBlock,
+	// or once it launches if it is not yet launched
+	if msg.DowntimeParams != nil {
+		k.SetConsumerDowntimeParams(ctx, consumerId, *msg.DowntimeParams)
+	}
+
+	// the new timeout period applies to the CCV packets sent from now on
+	if msg.ProviderCcvTimeoutPeriod != nil {
+		if err := types.ValidateProviderCCVTimeoutPeriod(*msg.ProviderCcvTimeoutPeriod, k.GetCCVTimeoutPeriod(ctx)); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidMsgUpdateConsumer,
+				"invalid provider ccv timeout period: %s", err.Error())
+		}
+		k.SetConsumerCCVTimeoutPeriod(ctx, consumerId, *msg.ProviderCcvTimeoutPeriod)
+	}
+
+	k.Keeper.RecordConsumerUpdate(ctx, consumerId, msg.Owner, fieldsBeforeUpdate)
+
 	// add Owner event attribute
 	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerOwner, currentOwnerAddress))
 
@@ -747,3 +859,273 @@ func (k msgServer) RemoveConsumer(goCtx context.Context, msg *types.MsgRemoveCon
 
 	return &resp, err
 }
+
+// StoreShapingTemplate stores a named power-shaping template that can be referenced
+// when creating or updating consumer chains
+func (k msgServer) StoreShapingTemplate(goCtx context.Context, msg *types.MsgStoreShapingTemplate) (*types.MsgStoreShapingTemplateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	templateId := k.Keeper.FetchAndIncrementPowerShapingTemplateId(ctx)
+	k.Keeper.SetPowerShapingTemplate(ctx, types.PowerShapingTemplate{
+		TemplateId:             templateId,
+		Owner:                  msg.Submitter,
+		Name:                   msg.Name,
+		PowerShapingParameters: msg.PowerShapingParameters,
+	})
+
+	k.Logger(ctx).Info("stored power-shaping template",
+		"templateId", templateId,
+		"name", msg.Name,
+		"owner", msg.Submitter,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStoreShapingTemplate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributePowerShapingTemplateId, templateId),
+			sdk.NewAttribute(types.AttributePowerShapingTemplateName, msg.Name),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter),
+		),
+	)
+
+	return &types.MsgStoreShapingTemplateResponse{TemplateId: templateId}, nil
+}
+
+// RegisterConsumerUpgrade registers an upgrade of a launched consumer chain planned by its owner
+// and notifies the consumer chain of the planned upgrade
+func (k msgServer) RegisterConsumerUpgrade(goCtx context.Context, msg *types.MsgRegisterConsumerUpgrade) (*types.MsgRegisterConsumerUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgRegisterConsumerUpgradeResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if k.Keeper.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"chain with consumer id: %s has to be in its launched phase", consumerId)
+	}
+
+	plan := msg.UpgradePlan
+	if !plan.HaltTime.IsZero() && !plan.HaltTime.After(ctx.BlockTime()) {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidMsgRegisterConsumerUpgrade,
+			"halt time (%s) must be after the current block time (%s)", plan.HaltTime, ctx.BlockTime())
+	}
+
+	if err := k.Keeper.SendConsumerUpgradePacket(ctx, consumerId, plan, false); err != nil {
+		return &resp, errorsmod.Wrapf(err, "cannot notify consumer chain with consumer id: %s", consumerId)
+	}
+	k.Keeper.SetConsumerUpgradePlan(ctx, consumerId, plan)
+
+	k.Logger(ctx).Info("registered consumer upgrade",
+		"consumerId", consumerId,
+		"name", plan.Name,
+		"haltHeight", plan.HaltHeight,
+		"haltTime", plan.HaltTime,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterConsumerUpgrade,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+			}, plan.EventAttributes()...)...,
+		),
+	)
+
+	return &resp, nil
+}
+
+// CancelConsumerUpgrade cancels the upgrade planned by the owner of a launched consumer chain
+// and notifies the consumer chain of the cancellation
+func (k msgServer) CancelConsumerUpgrade(goCtx context.Context, msg *types.MsgCancelConsumerUpgrade) (*types.MsgCancelConsumerUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgCancelConsumerUpgradeResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if k.Keeper.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"chain with consumer id: %s has to be in its launched phase", consumerId)
+	}
+
+	plan, found := k.Keeper.GetConsumerUpgradePlan(ctx, consumerId)
+	if !found {
+		return &resp, errorsmod.Wrapf(types.ErrNoConsumerUpgradePlan, "consumer id: %s", consumerId)
+	}
+
+	if err := k.Keeper.SendConsumerUpgradePacket(ctx, consumerId, plan, true); err != nil {
+		return &resp, errorsmod.Wrapf(err, "cannot notify consumer chain with consumer id: %s", consumerId)
+	}
+	k.Keeper.DeleteConsumerUpgradePlan(ctx, consumerId)
+
+	k.Logger(ctx).Info("cancelled consumer upgrade",
+		"consumerId", consumerId,
+		"name", plan.Name,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelConsumerUpgrade,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+			}, plan.EventAttributes()...)...,
+		),
+	)
+
+	return &resp, nil
+}
+
+// FlushKeyAssignmentReplacement prunes the consumer address of a replaced consumer key
+// whose prune time elapsed, but that was not pruned
+func (k msgServer) FlushKeyAssignmentReplacement(goCtx context.Context, msg *types.MsgFlushKeyAssignmentReplacement) (*types.MsgFlushKeyAssignmentReplacementResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgFlushKeyAssignmentReplacementResponse{}
+
+	consumerAddrTmp, err := k.consensusAddressCodec.StringToBytes(msg.ConsumerAddress)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidMsgFlushKeyAssignmentReplacement, "invalid consumer address: %s", err.Error())
+	}
+	consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+
+	providerAddr, err := k.Keeper.FlushKeyAssignmentReplacement(ctx, msg.ConsumerId, consumerAddr)
+	if err != nil {
+		return &resp, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFlushKeyAssignment,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeConsumerConsAddress, msg.ConsumerAddress),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter),
+		),
+	)
+
+	return &resp, nil
+}
+
+// SetConsumerCommittee sets the committee of a consumer chain, i.e., the validators
+// the consumer chain prefers among the validators selected by its power-shaping parameters
+func (k msgServer) SetConsumerCommittee(goCtx context.Context, msg *types.MsgSetConsumerCommittee) (*types.MsgSetConsumerCommitteeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgSetConsumerCommitteeResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if !k.Keeper.IsConsumerActive(ctx, consumerId) {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot set the committee of chain with consumer id: %s in phase %s", consumerId, k.Keeper.GetConsumerPhase(ctx, consumerId))
+	}
+
+	k.Keeper.UpdateCommittee(ctx, consumerId, msg.Committee)
+
+	k.Logger(ctx).Info("set consumer committee",
+		"consumerId", consumerId,
+		"committeeSize", len(msg.Committee),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConsumerCommittee,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeCommittee, strings.Join(msg.Committee, ",")),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// RevertConsumerSlash unjails a validator that was jailed due to a downtime slash packet received
+// from a consumer chain, e.g., when the downtime was caused by a bug on the consumer chain
+func (k msgServer) RevertConsumerSlash(goCtx context.Context, msg *types.MsgRevertConsumerSlash) (*types.MsgRevertConsumerSlashResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consAddr, err := k.consensusAddressCodec.StringToBytes(msg.ProviderConsAddr)
+	if err != nil {
+		return nil, err
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	jail, err := k.Keeper.RevertConsumerSlash(ctx, msg.ConsumerId, providerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("reverted consumer slash",
+		"consumerId", msg.ConsumerId,
+		"providerConsAddr", msg.ProviderConsAddr,
+		"vscId", jail.ValsetUpdateId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevertConsumerSlash,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, msg.ProviderConsAddr),
+			sdk.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(jail.ValsetUpdateId, 10)),
+			sdk.NewAttribute(types.AttributeInfractionHeight, strconv.FormatUint(jail.InfractionHeight, 10)),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Authority),
+		),
+	)
+
+	return &types.MsgRevertConsumerSlashResponse{}, nil
+}
+
+// getMsgPowerShapingParameters returns the power-shaping parameters provided in a MsgCreateConsumer or MsgUpdateConsumer,
+// i.e., either the parameters of the referenced power-shaping template or the parameters provided directly;
+// returns nil if neither are provided
+func (k msgServer) getMsgPowerShapingParameters(ctx sdk.Context, parameters *types.PowerShapingParameters, templateId string) (*types.PowerShapingParameters, error) {
+	if templateId == "" {
+		return parameters, nil
+	}
+	if parameters != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidPowerShapingParameters,
+			"cannot provide both a power-shaping template and power-shaping parameters")
+	}
+	templateParameters, err := k.Keeper.GetPowerShapingParametersFromTemplate(ctx, templateId)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrUnknownPowerShapingTemplate, err.Error())
+	}
+	return &templateParameters, nil
+}
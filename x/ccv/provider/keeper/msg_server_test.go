@@ -1,20 +1,27 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 	"time"
 
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 func TestCreateConsumer(t *testing.T) {
@@ -73,6 +80,15 @@ func TestCreateConsumer(t *testing.T) {
 	require.Equal(t, "submitter2", ownerAddress)
 	phase = providerKeeper.GetConsumerPhase(ctx, "1")
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
+
+	// the existing client referenced by the initialization parameters must exist
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "07-tendermint-0").Return(nil, false)
+	_, err = msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId", Metadata: consumerMetadata,
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{ClientId: "07-tendermint-0"},
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerInitializationParameters)
 }
 
 func TestUpdateConsumer(t *testing.T) {
@@ -211,6 +227,24 @@ func TestUpdateConsumer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedChainId, actualChainId)
 
+	// assert that the successful updates were recorded
+	records := providerKeeper.GetAllConsumerUpdateRecords(ctx, consumerId)
+	require.Len(t, records, 2)
+	require.Equal(t, uint64(0), records[0].Sequence)
+	require.Equal(t, "chainId-1", records[0].Before.ChainId)
+	require.Equal(t, "newChainId-1", records[0].After.ChainId)
+	require.Equal(t, uint64(1), records[1].Sequence)
+	require.Equal(t, "submitter", records[1].Submitter)
+	require.Equal(t, ctx.BlockHeight(), records[1].Height)
+	require.Equal(t, records[0].After, records[1].Before)
+	require.Equal(t, providertypes.ConsumerUpdateFields{
+		ChainId:                  expectedChainId,
+		OwnerAddress:             expectedOwnerAddress,
+		Metadata:                 expectedConsumerMetadata,
+		InitializationParameters: expectedInitializationParameters,
+		PowerShapingParameters:   expectedPowerShapingParameters,
+	}, records[1].After)
+
 	// assert phase
 	phase := providerKeeper.GetConsumerPhase(ctx, consumerId)
 	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, phase)
@@ -336,8 +370,10 @@ func TestUpdateConsumer(t *testing.T) {
 	require.NoError(t, err)
 	// infraction parameters are queued and not updated yet to newExpectedInfractionParameters since the chain is launched
 	require.Equal(t, expectedInfractionParameters, actualInfractionParameters)
-	// trigger update of queud infraction params after unbonding time is passed
-	providerKeeper.BeginBlockUpdateInfractionParameters(ctx.WithBlockTime(ctx.BlockTime().Add(2 * unbondingTime)))
+	// trigger update of queud infraction params after unbonding time is passed, in the first block of an epoch
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.BeginBlockUpdateInfractionParameters(ctx.WithBlockTime(ctx.BlockTime().Add(2 * unbondingTime)).
+		WithBlockHeight(providerKeeper.GetBlocksPerEpoch(ctx)))
 	actualInfractionParameters, err = providerKeeper.GetInfractionParameters(ctx, consumerId)
 	require.NoError(t, err)
 	require.Equal(t, newExpectedInfractionParameters, actualInfractionParameters)
@@ -382,3 +418,351 @@ func TestUpdateConsumer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedInitializationParameters, actualInitializationParameters)
 }
+
+// TestUpdateConsumerRewardDenomsMetadata tests that the metadata of the allowlisted reward denoms
+// is only registered when the consumer chain is updated by governance
+// TestProviderCCVTimeoutPeriod tests that the create and update consumer messages only accept
+// provider CCV timeout periods within the bounds given by the CcvTimeoutPeriod param
+func TestProviderCCVTimeoutPeriod(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	minPeriod, maxPeriod := providertypes.ProviderCCVTimeoutPeriodBounds(providerKeeper.GetCCVTimeoutPeriod(ctx))
+
+	createMsg := func(timeoutPeriod time.Duration) *providertypes.MsgCreateConsumer {
+		return &providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId",
+			Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			PowerShapingParameters:   &providertypes.PowerShapingParameters{},
+			ProviderCcvTimeoutPeriod: &timeoutPeriod,
+		}
+	}
+	_, err := msgServer.CreateConsumer(ctx, createMsg(minPeriod-time.Second))
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgCreateConsumer)
+	_, err = msgServer.CreateConsumer(ctx, createMsg(maxPeriod+time.Second))
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgCreateConsumer)
+	response, err := msgServer.CreateConsumer(ctx, createMsg(minPeriod))
+	require.NoError(t, err)
+	consumerId := response.ConsumerId
+	require.Equal(t, minPeriod, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, consumerId))
+
+	updateMsg := func(timeoutPeriod time.Duration) *providertypes.MsgUpdateConsumer {
+		return &providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			ProviderCcvTimeoutPeriod: &timeoutPeriod,
+		}
+	}
+	_, err = msgServer.UpdateConsumer(ctx, updateMsg(minPeriod-time.Second))
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgUpdateConsumer)
+	_, err = msgServer.UpdateConsumer(ctx, updateMsg(maxPeriod+time.Second))
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgUpdateConsumer)
+	require.Equal(t, minPeriod, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, consumerId))
+	_, err = msgServer.UpdateConsumer(ctx, updateMsg(maxPeriod))
+	require.NoError(t, err)
+	require.Equal(t, maxPeriod, providerKeeper.GetCCVTimeoutPeriodForConsumer(ctx, consumerId))
+}
+
+func TestUpdateConsumerRewardDenomsMetadata(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	metadata := testkeeper.GetTestConsumerMetadata()
+	metadata.RewardDenomsMetadata = []providertypes.RewardDenomMetadata{
+		{Denom: "ibc/denom1", Name: "Denom1", Symbol: "DEN1", Display: "den1", Exponent: 6},
+	}
+	allowlistedRewardDenoms := &providertypes.AllowlistedRewardDenoms{Denoms: []string{"ibc/denom1"}}
+
+	// the metadata is not registered when the consumer chain is created (no bank keeper calls are expected)
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1",
+			Metadata:                metadata,
+			AllowlistedRewardDenoms: allowlistedRewardDenoms,
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	// the metadata is not registered when the consumer chain is updated by its owner
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			Metadata:                &metadata,
+			AllowlistedRewardDenoms: allowlistedRewardDenoms,
+			NewOwnerAddress:         providerKeeper.GetAuthority(),
+		})
+	require.NoError(t, err)
+
+	// the metadata is registered when the consumer chain is updated by governance
+	mocks.MockBankKeeper.EXPECT().SetDenomMetaData(ctx, metadata.RewardDenomsMetadata[0].ToBankMetadata()).Times(1)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: consumerId,
+			Metadata: &metadata,
+		})
+	require.NoError(t, err)
+}
+
+func TestStoreShapingTemplate(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	templateParameters := providertypes.PowerShapingParameters{ValidatorsPowerCap: 10, ValidatorSetCap: 20}
+	response, err := msgServer.StoreShapingTemplate(ctx,
+		&providertypes.MsgStoreShapingTemplate{Submitter: "submitter", Name: "template", PowerShapingParameters: templateParameters})
+	require.NoError(t, err)
+	require.Equal(t, "0", response.TemplateId)
+	template, found := providerKeeper.GetPowerShapingTemplate(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, providertypes.PowerShapingTemplate{
+		TemplateId: "0", Owner: "submitter", Name: "template", PowerShapingParameters: templateParameters,
+	}, template)
+
+	// create a consumer chain using the template
+	metadata := providertypes.ConsumerMetadata{Name: "chain name", Description: "description"}
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1", Metadata: metadata,
+			PowerShapingTemplateId: "0",
+		})
+	require.NoError(t, err)
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, createConsumerResponse.ConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, templateParameters, powerShapingParameters)
+
+	// an unknown template cannot be used
+	_, err = msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1", Metadata: metadata,
+			PowerShapingTemplateId: "1",
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnknownPowerShapingTemplate)
+
+	// update a consumer chain using another template
+	templateParameters = providertypes.PowerShapingParameters{ValidatorsPowerCap: 30}
+	response, err = msgServer.StoreShapingTemplate(ctx,
+		&providertypes.MsgStoreShapingTemplate{Submitter: "other", Name: "other template", PowerShapingParameters: templateParameters})
+	require.NoError(t, err)
+	require.Equal(t, "1", response.TemplateId)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: createConsumerResponse.ConsumerId,
+			PowerShapingTemplateId: "1",
+		})
+	require.NoError(t, err)
+	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, createConsumerResponse.ConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, templateParameters, powerShapingParameters)
+
+	// a template cannot be used together with power-shaping parameters
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: createConsumerResponse.ConsumerId,
+			PowerShapingParameters: &providertypes.PowerShapingParameters{},
+			PowerShapingTemplateId: "0",
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPowerShapingParameters)
+
+	require.Len(t, providerKeeper.GetAllPowerShapingTemplates(ctx), 2)
+}
+
+func TestRegisterAndCancelConsumerUpgrade(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	providerKeeper.SetConsumerOwnerAddress(ctx, CONSUMER_ID, "owner")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	plan := ccvtypes.ConsumerUpgradePlan{
+		Name:       "v2",
+		HaltHeight: 1000,
+		BinaryHash: bytes.Repeat([]byte{0x01}, 32),
+	}
+	registerMsg := &providertypes.MsgRegisterConsumerUpgrade{Owner: "owner", ConsumerId: CONSUMER_ID, UpgradePlan: plan}
+
+	// only the owner can register an upgrade
+	_, err := msgServer.RegisterConsumerUpgrade(ctx,
+		&providertypes.MsgRegisterConsumerUpgrade{Owner: "other", ConsumerId: CONSUMER_ID, UpgradePlan: plan})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// only upgrades of launched consumer chains can be registered
+	_, err = msgServer.RegisterConsumerUpgrade(ctx, registerMsg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	// the consumer chain is launched, but the CCV channel is not established
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.RegisterConsumerUpgrade(ctx, registerMsg)
+	require.ErrorIs(t, err, ccvtypes.ErrInvalidConsumerState)
+	_, found := providerKeeper.GetConsumerUpgradePlan(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	// the halt time must be in the future
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	_, err = msgServer.RegisterConsumerUpgrade(ctx,
+		&providertypes.MsgRegisterConsumerUpgrade{Owner: "owner", ConsumerId: CONSUMER_ID, UpgradePlan: ccvtypes.ConsumerUpgradePlan{
+			Name: "v2", HaltTime: ctx.BlockTime(), BinaryHash: plan.BinaryHash,
+		}})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgRegisterConsumerUpgrade)
+
+	// the planned upgrade is registered and pushed to the consumer chain
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channelID")
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccvtypes.ProviderPortID, "channelID", gomock.Any(), gomock.Any(),
+			ccvtypes.NewConsumerUpgradePacketData(plan, false).GetBytes()).Return(uint64(1), nil).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccvtypes.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccvtypes.ProviderPortID, "channelID", gomock.Any(), gomock.Any(),
+			ccvtypes.NewConsumerUpgradePacketData(plan, true).GetBytes()).Return(uint64(2), nil).Times(1),
+	)
+	_, err = msgServer.RegisterConsumerUpgrade(ctx, registerMsg)
+	require.NoError(t, err)
+	queryResponse, err := providerKeeper.QueryConsumerUpgradePlan(ctx,
+		&providertypes.QueryConsumerUpgradePlanRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, plan, queryResponse.UpgradePlan)
+
+	// only the owner can cancel the planned upgrade
+	_, err = msgServer.CancelConsumerUpgrade(ctx,
+		&providertypes.MsgCancelConsumerUpgrade{Owner: "other", ConsumerId: CONSUMER_ID})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the cancellation is pushed to the consumer chain
+	_, err = msgServer.CancelConsumerUpgrade(ctx,
+		&providertypes.MsgCancelConsumerUpgrade{Owner: "owner", ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	_, found = providerKeeper.GetConsumerUpgradePlan(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	// there is no planned upgrade to cancel anymore
+	_, err = msgServer.CancelConsumerUpgrade(ctx,
+		&providertypes.MsgCancelConsumerUpgrade{Owner: "owner", ConsumerId: CONSUMER_ID})
+	require.ErrorIs(t, err, providertypes.ErrNoConsumerUpgradePlan)
+}
+
+func TestFlushKeyAssignmentReplacementMsg(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	consumerAddr := providertypes.NewConsumerConsAddress([]byte("consumerAddr"))
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerAddr, providerAddr)
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, CONSUMER_ID, ctx.BlockTime(), consumerAddr)
+
+	_, err := msgServer.FlushKeyAssignmentReplacement(ctx, &providertypes.MsgFlushKeyAssignmentReplacement{
+		Submitter: "submitter", ConsumerId: CONSUMER_ID, ConsumerAddress: "invalid",
+	})
+	require.ErrorIs(t, err, providertypes.ErrInvalidMsgFlushKeyAssignmentReplacement)
+
+	_, err = msgServer.FlushKeyAssignmentReplacement(ctx, &providertypes.MsgFlushKeyAssignmentReplacement{
+		Submitter: "submitter", ConsumerId: CONSUMER_ID, ConsumerAddress: consumerAddr.String(),
+	})
+	require.NoError(t, err)
+	_, found := providerKeeper.GetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerAddr)
+	require.False(t, found)
+	require.Empty(t, providerKeeper.GetAllConsumerAddrsToPrune(ctx, CONSUMER_ID))
+}
+
+func TestSetConsumerCommittee(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	providerKeeper.SetConsumerOwnerAddress(ctx, CONSUMER_ID, "owner")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_STOPPED)
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	setMsg := &providertypes.MsgSetConsumerCommittee{
+		Owner:      "owner",
+		ConsumerId: CONSUMER_ID,
+		Committee:  []string{providerKeeper.ConsAddressToString(providerAddr.ToSdkConsAddr())},
+	}
+
+	// only the owner can set the committee
+	_, err := msgServer.SetConsumerCommittee(ctx,
+		&providertypes.MsgSetConsumerCommittee{Owner: "other", ConsumerId: CONSUMER_ID, Committee: setMsg.Committee})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the committee of stopped consumer chains cannot be set
+	_, err = msgServer.SetConsumerCommittee(ctx, setMsg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+	require.True(t, providerKeeper.IsCommitteeEmpty(ctx, CONSUMER_ID))
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.SetConsumerCommittee(ctx, setMsg)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddr}, providerKeeper.GetCommittee(ctx, CONSUMER_ID))
+
+	// an empty committee removes the committee
+	_, err = msgServer.SetConsumerCommittee(ctx,
+		&providertypes.MsgSetConsumerCommittee{Owner: "owner", ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsCommitteeEmpty(ctx, CONSUMER_ID))
+}
+
+func TestMsgRevertConsumerSlash(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+	providerKeeper.SetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddr, providertypes.ConsumerDowntimeJail{ValsetUpdateId: 5})
+	msg := &providertypes.MsgRevertConsumerSlash{
+		Authority:        "other",
+		ConsumerId:       CONSUMER_ID,
+		ProviderConsAddr: providerKeeper.ConsAddressToString(providerAddr.ToSdkConsAddr()),
+	}
+
+	// only governance can revert a consumer slash
+	_, err := msgServer.RevertConsumerSlash(ctx, msg)
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	msg.Authority = providerKeeper.GetAuthority()
+	valAddr := sdk.ValAddress(providerAddr.ToSdkConsAddr())
+	validator := stakingtypes.Validator{
+		OperatorAddress:   valAddr.String(),
+		Jailed:            true,
+		Tokens:            math.NewInt(100),
+		DelegatorShares:   math.LegacyNewDec(100),
+		MinSelfDelegation: math.OneInt(),
+	}
+	gomock.InOrder(
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).Return(validator, nil),
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerAddr.ToSdkConsAddr()).Return(false),
+		mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(ctx, providerAddr.ToSdkConsAddr()).
+			Return(slashingtypes.ValidatorSigningInfo{}, nil),
+		mocks.MockStakingKeeper.EXPECT().Delegation(ctx, sdk.AccAddress(valAddr), valAddr).
+			Return(stakingtypes.Delegation{Shares: math.LegacyNewDec(100)}, nil),
+		mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx, providerAddr.ToSdkConsAddr(), ctx.BlockTime()).Return(nil),
+		mocks.MockStakingKeeper.EXPECT().Unjail(ctx, providerAddr.ToSdkConsAddr()).Return(nil),
+	)
+	_, err = msgServer.RevertConsumerSlash(ctx, msg)
+	require.NoError(t, err)
+	_, found := providerKeeper.GetConsumerDowntimeJail(ctx, CONSUMER_ID, providerAddr)
+	require.False(t, found)
+
+	// the jailing cannot be reverted twice
+	_, err = msgServer.RevertConsumerSlash(ctx, msg)
+	require.ErrorIs(t, err, providertypes.ErrNoConsumerDowntimeJail)
+}
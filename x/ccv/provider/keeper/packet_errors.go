@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MaxPacketErrors is the maximum number of packet errors kept in the store;
+// when exceeded, the oldest packet errors are pruned
+const MaxPacketErrors = 100
+
+// txPacketErrorsKey is the context key of the packet errors recorded during the execution of a transaction
+type txPacketErrorsKey struct{}
+
+// txPacketErrors holds the packet errors recorded during the execution of a transaction.
+// IBC discards the state changes of OnRecvPacket if it returns an error acknowledgement,
+// hence the packet errors are kept in the context of the transaction and stored
+// once the transaction succeeds (see StoreTxPacketErrors).
+type txPacketErrors struct {
+	errors []types.PacketError
+}
+
+// WithTxPacketErrors returns a context in which the packet errors of a transaction can be recorded.
+// It is meant to be called by an ante decorator, before the messages of the transaction are executed.
+func WithTxPacketErrors(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(txPacketErrorsKey{}, &txPacketErrors{})
+}
+
+// RecordPacketError records a packet received from a consumer chain that resulted
+// in an error acknowledgement in the context of the transaction. Note that only the packets
+// received while finalizing a block are recorded, i.e., not the ones received in CheckTx or in simulations,
+// and only if the context was set up with WithTxPacketErrors.
+func (k Keeper) RecordPacketError(ctx sdk.Context, packet channeltypes.Packet, ackErr error) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	txErrors, ok := ctx.Value(txPacketErrorsKey{}).(*txPacketErrors)
+	if !ok {
+		k.Logger(ctx).Error("packet error not recorded, as the transaction context does not record packet errors",
+			"channelID", packet.DestinationChannel,
+			"sequence", packet.Sequence,
+		)
+		return
+	}
+
+	// only the codespace and the code are recorded, as the error message is not deterministic
+	codespace, code, _ := errorsmod.ABCIInfo(ackErr, false)
+	consumerId, _ := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	txErrors.errors = append(txErrors.errors, types.PacketError{
+		ConsumerId:         consumerId,
+		Sequence:           packet.Sequence,
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationChannel: packet.DestinationChannel,
+		Data:               packet.Data,
+		Codespace:          codespace,
+		Code:               code,
+		Height:             ctx.BlockHeight(),
+	})
+}
+
+// StoreTxPacketErrors stores the packet errors recorded during the execution of a transaction
+// and prunes the packet errors exceeding MaxPacketErrors. It is meant to be called by a post decorator
+// once the messages of the transaction succeeded, i.e., the packet errors are stored
+// only if the transaction is committed.
+func (k Keeper) StoreTxPacketErrors(ctx sdk.Context) {
+	txErrors, ok := ctx.Value(txPacketErrorsKey{}).(*txPacketErrors)
+	if !ok {
+		return
+	}
+	for _, packetError := range txErrors.errors {
+		k.AppendPacketError(ctx, packetError)
+		// the packet was relayed, even though it resulted in an error acknowledgement
+		if packetError.ConsumerId != "" {
+			k.recordRelayerPacketReceived(ctx, packetError.ConsumerId)
+		}
+	}
+	txErrors.errors = nil
+}
+
+// AppendPacketError stores a packet error and prunes the oldest packet error
+// if there are more than MaxPacketErrors packet errors
+func (k Keeper) AppendPacketError(ctx sdk.Context, packetError types.PacketError) {
+	store := ctx.KVStore(k.storeKey)
+
+	index := k.getPacketErrorIndex(ctx)
+	bz, err := packetError.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// packetError is assumed to be correctly serialized.
+		panic(err)
+	}
+	store.Set(types.PacketErrorKey(index), bz)
+	if index >= MaxPacketErrors {
+		store.Delete(types.PacketErrorKey(index - MaxPacketErrors))
+	}
+
+	indexBz := make([]byte, 8)
+	binary.BigEndian.PutUint64(indexBz, index+1)
+	store.Set(types.PacketErrorIndexKey(), indexBz)
+}
+
+// getPacketErrorIndex returns the index of the next packet error
+func (k Keeper) getPacketErrorIndex(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PacketErrorIndexKey())
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetRecentPacketErrors returns the stored packet errors, newest first
+func (k Keeper) GetRecentPacketErrors(ctx sdk.Context) (packetErrors []types.PacketError) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, []byte{types.PacketErrorKeyPrefix()})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var packetError types.PacketError
+		if err := packetError.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the packet errors are assumed to be correctly serialized in AppendPacketError.
+			panic(err)
+		}
+		packetErrors = append(packetErrors, packetError)
+	}
+	return packetErrors
+}
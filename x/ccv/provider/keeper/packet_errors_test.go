@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestRecordPacketError tests that the packet errors are recorded in the context of a transaction
+// when finalizing a block and stored once the transaction succeeds
+func TestRecordPacketError(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", CONSUMER_ID)
+	packet := channeltypes.Packet{
+		Sequence:           5,
+		SourcePort:         "consumer",
+		SourceChannel:      "channel-1",
+		DestinationPort:    "provider",
+		DestinationChannel: "channel-0",
+		Data:               []byte("invalid data"),
+	}
+	ackErr := sdkerrors.ErrInvalidType.Wrap("cannot unmarshal ConsumerPacket data")
+
+	// packets received in CheckTx are not recorded
+	checkTxCtx := keeper.WithTxPacketErrors(ctx.WithExecMode(sdk.ExecModeCheck))
+	providerKeeper.RecordPacketError(checkTxCtx, packet, ackErr)
+	providerKeeper.StoreTxPacketErrors(checkTxCtx)
+	require.Empty(t, providerKeeper.GetRecentPacketErrors(ctx))
+
+	// packets received in a context that does not record packet errors are not recorded
+	ctx = ctx.WithExecMode(sdk.ExecModeFinalize).WithBlockHeight(10)
+	providerKeeper.RecordPacketError(ctx, packet, ackErr)
+	providerKeeper.StoreTxPacketErrors(ctx)
+	require.Empty(t, providerKeeper.GetRecentPacketErrors(ctx))
+
+	// the packet errors of a transaction that fails are not stored,
+	// i.e., they are not stored in the context of the next transaction
+	providerKeeper.RecordPacketError(keeper.WithTxPacketErrors(ctx), packet, ackErr)
+	ctx = keeper.WithTxPacketErrors(ctx)
+	providerKeeper.StoreTxPacketErrors(ctx)
+	require.Empty(t, providerKeeper.GetRecentPacketErrors(ctx))
+
+	providerKeeper.RecordPacketError(ctx, packet, ackErr)
+	// packet errors are only stored once the transaction succeeds
+	require.Empty(t, providerKeeper.GetRecentPacketErrors(ctx))
+	providerKeeper.StoreTxPacketErrors(ctx)
+
+	require.Equal(t, []providertypes.PacketError{{
+		ConsumerId:         CONSUMER_ID,
+		Sequence:           5,
+		SourcePort:         "consumer",
+		SourceChannel:      "channel-1",
+		DestinationChannel: "channel-0",
+		Data:               []byte("invalid data"),
+		Codespace:          sdkerrors.ErrInvalidType.Codespace(),
+		Code:               sdkerrors.ErrInvalidType.ABCICode(),
+		Height:             10,
+	}}, providerKeeper.GetRecentPacketErrors(ctx))
+
+	// the packet errors of a transaction are stored only once
+	providerKeeper.StoreTxPacketErrors(ctx)
+	require.Len(t, providerKeeper.GetRecentPacketErrors(ctx), 1)
+
+	// errors that are not registered are recorded with the undefined code,
+	// and packets received on unknown channels without a consumer id
+	packet.DestinationChannel = "channel-7"
+	providerKeeper.RecordPacketError(ctx, packet, errors.New("unregistered error"))
+	providerKeeper.StoreTxPacketErrors(ctx)
+	packetErrors := providerKeeper.GetRecentPacketErrors(ctx)
+	require.Len(t, packetErrors, 2)
+	require.Empty(t, packetErrors[0].ConsumerId)
+	require.Equal(t, errorsmod.UndefinedCodespace, packetErrors[0].Codespace)
+	require.Equal(t, uint32(1), packetErrors[0].Code)
+}
+
+// TestAppendPacketError tests that only the most recent packet errors are kept, newest first
+func TestAppendPacketError(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	for i := uint64(0); i < keeper.MaxPacketErrors+5; i++ {
+		providerKeeper.AppendPacketError(ctx, providertypes.PacketError{ConsumerId: CONSUMER_ID, Sequence: i})
+	}
+
+	packetErrors := providerKeeper.GetRecentPacketErrors(ctx)
+	require.Len(t, packetErrors, keeper.MaxPacketErrors)
+	for i, packetError := range packetErrors {
+		require.Equal(t, uint64(keeper.MaxPacketErrors+4-i), packetError.Sequence)
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -73,6 +75,44 @@ func (k Keeper) GetMaxProviderConsensusValidators(ctx sdk.Context) int64 {
 	return params.MaxProviderConsensusValidators
 }
 
+// GetValsetCheckpointPeriod returns the number of epochs between two consecutive
+// valset checkpoint packets; zero means that checkpoints are disabled
+func (k Keeper) GetValsetCheckpointPeriod(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.ValsetCheckpointPeriod
+}
+
+// GetRelayerStalenessThreshold returns the duration after which the relayers of a consumer chain
+// are considered stale; zero means that the staleness warning is disabled
+func (k Keeper) GetRelayerStalenessThreshold(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.RelayerStalenessThreshold
+}
+
+// GetClientExpiryWarningWindow returns the duration before the expiry of a consumer client
+// from which client expiry warnings are emitted; zero means that the warnings are disabled
+func (k Keeper) GetClientExpiryWarningWindow(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.ClientExpiryWarningWindow
+}
+
+// GetValsetHistorySize returns the maximal number of validator sets retained per consumer chain;
+// zero means that the valset history is disabled
+func (k Keeper) GetValsetHistorySize(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.ValsetHistorySize
+}
+
+// GetMinConsumerCommissionRate returns the default minimum commission rate validators can set on a consumer chain
+func (k Keeper) GetMinConsumerCommissionRate(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	// the param is not set on chains that were not migrated yet
+	if params.MinConsumerCommissionRate == "" {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyMustNewDecFromStr(params.MinConsumerCommissionRate)
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
@@ -90,4 +130,48 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&params)
 	store.Set(types.ParametersKey(), bz)
+	// the provider params (e.g., MaxProviderConsensusValidators) affect the validator sets of all consumer chains
+	k.DeleteAllIncrementalValSetUpdates(ctx)
+}
+
+// GetValsetUpdateHeightRetentionMargin returns the duration for which the mappings from valset update IDs
+// to block heights are retained in addition to the unbonding period
+func (k Keeper) GetValsetUpdateHeightRetentionMargin(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.ValsetUpdateHeightRetentionMargin
+}
+
+// GetRelayerRebateFraction returns the fraction of the consumer rewards sent to the relayer rebate pool
+func (k Keeper) GetRelayerRebateFraction(ctx sdk.Context) math.LegacyDec {
+	params := k.GetParams(ctx)
+	// the param is not set on chains that were not migrated yet
+	if params.RelayerRebateFraction == "" {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyMustNewDecFromStr(params.RelayerRebateFraction)
+}
+
+// GetRelayerRebatePerPacket returns the rebate paid to the relayer of every CCV packet
+// successfully relayed to the provider chain
+func (k Keeper) GetRelayerRebatePerPacket(ctx sdk.Context) sdk.Coin {
+	params := k.GetParams(ctx)
+	// the param is not set on chains that were not migrated yet
+	if params.RelayerRebatePerPacket.Amount.IsNil() {
+		return sdk.Coin{Denom: params.RelayerRebatePerPacket.Denom, Amount: math.ZeroInt()}
+	}
+	return params.RelayerRebatePerPacket
+}
+
+// GetMaxRelayerRebatesPerEpoch returns the maximum number of relayed CCV packets
+// rebated per relayer and per epoch
+func (k Keeper) GetMaxRelayerRebatesPerEpoch(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.MaxRelayerRebatesPerEpoch
+}
+
+// GetAllowConsumerKeyReuse returns whether a consumer key can be assigned
+// while it is assigned on another consumer chain
+func (k Keeper) GetAllowConsumerKeyReuse(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.AllowConsumerKeyReuse
 }
@@ -51,6 +51,19 @@ func TestParams(t *testing.T) {
 		600,
 		24,
 		10,
+		5,
+		12*time.Hour,
+		3*24*time.Hour,
+		50,
+		"0.05",
+		2*7*24*time.Hour,
+		"0.01",
+		sdk.Coin{
+			Denom:  "stake",
+			Amount: math.NewInt(1000),
+		},
+		20,
+		true,
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -74,7 +75,7 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 		if err != nil {
 			return err
 		}
-		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
 		if err != nil {
 			return err
 		}
@@ -116,7 +117,7 @@ func (k Keeper) OptInTopNValidators(
 			"validator", val.GetOperator(),
 		)
 
-		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
 		if err != nil {
 			return fmt.Errorf("converting operator address to validator address, consumerId(%s), validator(%s): %w",
 				consumerId, val.GetOperator(), err)
@@ -146,22 +147,59 @@ func (k Keeper) OptInTopNValidators(
 // Setters and getters
 //
 
+// SetOptedIn opts in the validator to the consumer chain
+// and emits an event if the validator was not already opted in
 func (k Keeper) SetOptedIn(
 	ctx sdk.Context,
 	consumerId string,
 	providerConsAddress types.ProviderConsAddress,
 ) {
+	alreadyOptedIn := k.IsOptedIn(ctx, consumerId, providerConsAddress)
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.OptedInKey(consumerId, providerConsAddress), []byte{})
+	store.Set(types.OptedInByValidatorKey(providerConsAddress, consumerId), []byte{})
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+
+	if !alreadyOptedIn {
+		k.emitOptedInEvent(ctx, types.EventTypeValidatorOptedIn, consumerId, providerConsAddress)
+	}
 }
 
+// DeleteOptedIn opts out the validator from the consumer chain
+// and emits an event if the validator was opted in
 func (k Keeper) DeleteOptedIn(
 	ctx sdk.Context,
 	consumerId string,
 	providerAddr types.ProviderConsAddress,
 ) {
+	wasOptedIn := k.IsOptedIn(ctx, consumerId, providerAddr)
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.OptedInKey(consumerId, providerAddr))
+	store.Delete(types.OptedInByValidatorKey(providerAddr, consumerId))
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+
+	if wasOptedIn {
+		k.emitOptedInEvent(ctx, types.EventTypeValidatorOptedOut, consumerId, providerAddr)
+	}
+}
+
+// emitOptedInEvent emits an event of the given type for a change of the opted-in validators
+func (k Keeper) emitOptedInEvent(
+	ctx sdk.Context,
+	eventType string,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+		),
+	)
 }
 
 func (k Keeper) IsOptedIn(
@@ -202,9 +240,53 @@ func (k Keeper) DeleteAllOptedIn(
 	var keysToDel [][]byte
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		keysToDel = append(keysToDel, iterator.Key())
+		providerAddr := types.NewProviderConsAddress(iterator.Key()[len(key):])
+		keysToDel = append(keysToDel, iterator.Key(), types.OptedInByValidatorKey(providerAddr, consumerId))
 	}
 	for _, delKey := range keysToDel {
 		store.Delete(delKey)
 	}
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+}
+
+// GetOptedInConsumerIds returns the ids of all the consumer chains the validator with
+// `providerAddr` is opted in to, without iterating over all the consumer chains
+func (k Keeper) GetOptedInConsumerIds(
+	ctx sdk.Context,
+	providerAddr types.ProviderConsAddress,
+) (consumerIds []string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ConsAddrWithLenKey(types.OptedInByValidatorKeyPrefix(), providerAddr.ToSdkConsAddr())
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		consumerIds = append(consumerIds, string(iterator.Key()[len(key):]))
+	}
+
+	return consumerIds
+}
+
+// GetAllOptedInValidators returns the provider consensus addresses of all the validators
+// that are opted in to at least one consumer chain
+func (k Keeper) GetAllOptedInValidators(ctx sdk.Context) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.OptedInByValidatorKeyPrefix()})
+	defer iterator.Close()
+
+	var lastAddr []byte
+	for ; iterator.Valid(); iterator.Next() {
+		// the keys are bytePrefix | len(providerAddr) | providerAddr | consumerId,
+		// thus the keys of the same validator are adjacent
+		key := iterator.Key()
+		addrLen := int(key[1])
+		addr := key[2 : 2+addrLen]
+		if bytes.Equal(addr, lastAddr) {
+			continue
+		}
+		lastAddr = addr
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(addr))
+	}
+
+	return providerAddrs
 }
@@ -303,3 +303,53 @@ func TestOptedIn(t *testing.T) {
 	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, optedInValidator1))
 	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, optedInValidator2))
 }
+
+// TestOptedInEvents tests that events are emitted only when a validator opts in or opts out
+func TestOptedInEvents(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
+
+	// opting in an already opted-in validator, e.g., by OptInTopNValidators, does not emit an event
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr)
+	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providerAddr)
+	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providerAddr)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	for i, eventType := range []string{providertypes.EventTypeValidatorOptedIn, providertypes.EventTypeValidatorOptedOut} {
+		require.Equal(t, eventType, events[i].Type)
+		attr, found := events[i].GetAttribute(providertypes.AttributeConsumerId)
+		require.True(t, found)
+		require.Equal(t, CONSUMER_ID, attr.Value)
+		attr, found = events[i].GetAttribute(providertypes.AttributeProviderConsAddress)
+		require.True(t, found)
+		require.Equal(t, providerAddr.String(), attr.Value)
+	}
+}
+
+// TestGetOptedInConsumerIds tests that the opted-in index by provider consensus address is kept in sync
+func TestGetOptedInConsumerIds(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	optedInValidator1 := providertypes.NewProviderConsAddress([]byte("providerAddr1"))
+	optedInValidator2 := providertypes.NewProviderConsAddress([]byte("providerAddr2"))
+	require.Empty(t, providerKeeper.GetOptedInConsumerIds(ctx, optedInValidator1))
+
+	providerKeeper.SetOptedIn(ctx, "0", optedInValidator1)
+	providerKeeper.SetOptedIn(ctx, "1", optedInValidator1)
+	providerKeeper.SetOptedIn(ctx, "2", optedInValidator1)
+	providerKeeper.SetOptedIn(ctx, "1", optedInValidator2)
+	require.Equal(t, []string{"0", "1", "2"}, providerKeeper.GetOptedInConsumerIds(ctx, optedInValidator1))
+	require.Equal(t, []string{"1"}, providerKeeper.GetOptedInConsumerIds(ctx, optedInValidator2))
+
+	providerKeeper.DeleteOptedIn(ctx, "0", optedInValidator1)
+	require.Equal(t, []string{"1", "2"}, providerKeeper.GetOptedInConsumerIds(ctx, optedInValidator1))
+
+	providerKeeper.DeleteAllOptedIn(ctx, "1")
+	require.Equal(t, []string{"2"}, providerKeeper.GetOptedInConsumerIds(ctx, optedInValidator1))
+	require.Empty(t, providerKeeper.GetOptedInConsumerIds(ctx, optedInValidator2))
+}
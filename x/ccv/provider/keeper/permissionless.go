@@ -164,11 +164,26 @@ func (k Keeper) GetConsumerPhase(ctx sdk.Context, consumerId string) types.Consu
 }
 
 // SetConsumerPhase sets the phase associated with this consumer id
+// and emits an event if the phase changed
 func (k Keeper) SetConsumerPhase(ctx sdk.Context, consumerId string, phase types.ConsumerPhase) {
+	previousPhase := k.GetConsumerPhase(ctx, consumerId)
+
 	store := ctx.KVStore(k.storeKey)
 	phaseBytes := make([]byte, 8)
 	binary.BigEndian.PutUint32(phaseBytes, uint32(phase))
 	store.Set(types.ConsumerIdToPhaseKey(consumerId), phaseBytes)
+
+	if previousPhase != phase {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeConsumerPhaseChanged,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributePreviousConsumerPhase, previousPhase.String()),
+				sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()),
+			),
+		)
+	}
 }
 
 // DeleteConsumerPhase deletes the phase associated with this consumer id
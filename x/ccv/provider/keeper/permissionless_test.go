@@ -199,6 +199,35 @@ func TestConsumerPhase(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, phase)
 }
 
+// TestConsumerPhaseChangedEvent tests that an event is emitted only when the phase of a consumer chain changes
+func TestConsumerPhaseChangedEvent(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_REGISTERED)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_REGISTERED)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 2)
+	expectedPhases := [][2]string{
+		{providertypes.CONSUMER_PHASE_UNSPECIFIED.String(), providertypes.CONSUMER_PHASE_REGISTERED.String()},
+		{providertypes.CONSUMER_PHASE_REGISTERED.String(), providertypes.CONSUMER_PHASE_INITIALIZED.String()},
+	}
+	for i, event := range events {
+		require.Equal(t, providertypes.EventTypeConsumerPhaseChanged, event.Type)
+		attr, found := event.GetAttribute(providertypes.AttributeConsumerId)
+		require.True(t, found)
+		require.Equal(t, CONSUMER_ID, attr.Value)
+		attr, found = event.GetAttribute(providertypes.AttributePreviousConsumerPhase)
+		require.True(t, found)
+		require.Equal(t, expectedPhases[i][0], attr.Value)
+		attr, found = event.GetAttribute(providertypes.AttributeConsumerPhase)
+		require.True(t, found)
+		require.Equal(t, expectedPhases[i][1], attr.Value)
+	}
+}
+
 func TestIsConsumerPrelaunched(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -32,7 +34,7 @@ func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakin
 	var powers []int64
 
 	for _, val := range bondedValidators {
-		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
 		if err != nil {
 			return 0, err
 		}
@@ -125,6 +127,34 @@ func (k Keeper) CapValidatorsPower(
 	}
 }
 
+// FloorValidatorsPower raises the power of the validators on chain with `consumerId` that have less than
+// `minValidatorPower` to `minValidatorPower` and returns an updated slice of validators with their new powers.
+func (k Keeper) FloorValidatorsPower(
+	ctx sdk.Context,
+	minValidatorPower uint64,
+	validators []types.ConsensusValidator,
+) []types.ConsensusValidator {
+	if minValidatorPower > 0 {
+		return NoLessThanPower(validators, int64(minValidatorPower))
+	} else {
+		// is a no-op if the min validator power is not set for `consumerId`
+		return validators
+	}
+}
+
+// NoLessThanPower returns a set of validators with updated powers such that no validator has less than `minPower`.
+// The powers of the validators that already have at least `minPower` are not modified.
+func NoLessThanPower(validators []types.ConsensusValidator, minPower int64) []types.ConsensusValidator {
+	updatedValidators := make([]types.ConsensusValidator, len(validators))
+	for i, v := range validators {
+		updatedValidators[i] = v
+		if v.Power < minPower {
+			updatedValidators[i].Power = minPower
+		}
+	}
+	return updatedValidators
+}
+
 // sum is a helper function to sum all the validators' power
 func sum(validators []types.ConsensusValidator) int64 {
 	s := int64(0)
@@ -276,6 +306,42 @@ func (k Keeper) FulfillsMinStake(
 	return validator.GetBondedTokens().GTE(math.NewIntFromUint64(minStake)), nil
 }
 
+// FulfillsMinUptime returns true if the validator `providerAddr` signed at least `minUptime` percent of the blocks
+// it was expected to sign in the last slashing window of the provider chain.
+func (k Keeper) FulfillsMinUptime(
+	ctx sdk.Context,
+	minUptime uint32,
+	providerAddr types.ProviderConsAddress,
+) (bool, error) {
+	if minUptime == 0 {
+		return true, nil
+	}
+
+	signingInfo, err := k.slashingKeeper.GetValidatorSigningInfo(ctx, providerAddr.ToSdkConsAddr())
+	if errors.Is(err, slashingtypes.ErrNoSigningInfoFound) {
+		// the validator was never bonded, hence it has no uptime
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	slashingParams, err := k.slashingKeeper.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// the number of blocks in the window that the validator was expected to sign,
+	// which is less than the window if the validator was recently bonded or unjailed
+	expectedBlocks := min(signingInfo.IndexOffset, slashingParams.SignedBlocksWindow)
+	if expectedBlocks <= 0 {
+		return true, nil
+	}
+	signedBlocks := expectedBlocks - signingInfo.MissedBlocksCounter
+
+	// validator signed enough blocks to validate the chain
+	return signedBlocks*100 >= int64(minUptime)*expectedBlocks, nil
+}
+
 // HasMinPower returns true if the `providerAddr` voting power is GTE than the given minimum power
 func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddress, minPower int64) (bool, error) {
 	val, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.Address)
@@ -283,7 +349,7 @@ func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddr
 		return false, err
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
 	if err != nil {
 		return false, err
 	}
@@ -333,6 +399,7 @@ func (k Keeper) SetConsumerPowerShapingParameters(ctx sdk.Context, consumerId st
 	}
 
 	store.Set(types.ConsumerIdToPowerShapingParametersKey(consumerId), bz)
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 
 	// update allowlist, denylist and prioritylist indexes if needed
 	if !equalStringSlices(oldParameters.Allowlist, parameters.Allowlist) {
@@ -345,6 +412,21 @@ func (k Keeper) SetConsumerPowerShapingParameters(ctx sdk.Context, consumerId st
 		k.UpdatePrioritylist(ctx, consumerId, parameters.Prioritylist)
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePowerShapingParametersUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerTopN, strconv.FormatUint(uint64(parameters.Top_N), 10)),
+			sdk.NewAttribute(types.AttributeValidatorsPowerCap, strconv.FormatUint(uint64(parameters.ValidatorsPowerCap), 10)),
+			sdk.NewAttribute(types.AttributeValidatorSetCap, strconv.FormatUint(uint64(parameters.ValidatorSetCap), 10)),
+			sdk.NewAttribute(types.AttributeMinStake, strconv.FormatUint(parameters.MinStake, 10)),
+			sdk.NewAttribute(types.AttributeMinUptime, strconv.FormatUint(uint64(parameters.MinUptime), 10)),
+			sdk.NewAttribute(types.AttributeMinValidatorPower, strconv.FormatUint(parameters.MinValidatorPower, 10)),
+			sdk.NewAttribute(types.AttributeAllowInactiveVals, strconv.FormatBool(parameters.AllowInactiveVals)),
+		),
+	)
+
 	return nil
 }
 
@@ -369,6 +451,7 @@ func (k Keeper) SetAllowlist(
 ) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.AllowlistKey(consumerId, providerAddr), []byte{})
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 }
 
 // GetAllowList returns all allowlisted validators
@@ -413,6 +496,7 @@ func (k Keeper) DeleteAllowlist(ctx sdk.Context, consumerId string) {
 	for _, key := range keysToDel {
 		store.Delete(key)
 	}
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 }
 
 // IsAllowlistEmpty returns `true` if no validator is allowlisted on chain `consumerId`
@@ -428,7 +512,7 @@ func (k Keeper) IsAllowlistEmpty(ctx sdk.Context, consumerId string) bool {
 func (k Keeper) UpdateAllowlist(ctx sdk.Context, consumerId string, allowlist []string) {
 	k.DeleteAllowlist(ctx, consumerId)
 	for _, address := range allowlist {
-		consAddr, err := sdk.ConsAddressFromBech32(address)
+		consAddr, err := k.consensusAddressCodec.StringToBytes(address)
 		if err != nil {
 			continue
 		}
@@ -445,6 +529,7 @@ func (k Keeper) SetDenylist(
 ) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.DenylistKey(consumerId, providerAddr), []byte{})
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 }
 
 // GetDenyList returns all denylisted validators
@@ -489,6 +574,7 @@ func (k Keeper) DeleteDenylist(ctx sdk.Context, consumerId string) {
 	for _, key := range keysToDel {
 		store.Delete(key)
 	}
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
 }
 
 // IsDenylistEmpty returns `true` if no validator is denylisted on chain `consumerId`
@@ -504,7 +590,7 @@ func (k Keeper) IsDenylistEmpty(ctx sdk.Context, consumerId string) bool {
 func (k Keeper) UpdateDenylist(ctx sdk.Context, consumerId string, denylist []string) {
 	k.DeleteDenylist(ctx, consumerId)
 	for _, address := range denylist {
-		consAddr, err := sdk.ConsAddressFromBech32(address)
+		consAddr, err := k.consensusAddressCodec.StringToBytes(address)
 		if err != nil {
 			continue
 		}
@@ -618,7 +704,7 @@ func (k Keeper) IsPrioritylistEmpty(ctx sdk.Context, consumerId string) bool {
 func (k Keeper) UpdatePrioritylist(ctx sdk.Context, consumerId string, prioritylist []string) {
 	k.DeletePrioritylist(ctx, consumerId)
 	for _, address := range prioritylist {
-		consAddr, err := sdk.ConsAddressFromBech32(address)
+		consAddr, err := k.consensusAddressCodec.StringToBytes(address)
 		if err != nil {
 			continue
 		}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// FetchAndIncrementPowerShapingTemplateId fetches the first power-shaping template id that can be used
+// and increments the underlying template id
+func (k Keeper) FetchAndIncrementPowerShapingTemplateId(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+
+	var templateId uint64
+	if bz := store.Get(types.PowerShapingTemplateIdKey()); bz != nil {
+		templateId = binary.BigEndian.Uint64(bz)
+	}
+
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, templateId+1)
+	store.Set(types.PowerShapingTemplateIdKey(), buf)
+
+	return strconv.FormatUint(templateId, 10)
+}
+
+// SetPowerShapingTemplate sets a power-shaping template
+func (k Keeper) SetPowerShapingTemplate(ctx sdk.Context, template types.PowerShapingTemplate) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := template.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// template is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal power-shaping template: %w", err))
+	}
+	store.Set(types.PowerShapingTemplateKey(template.TemplateId), bz)
+}
+
+// GetPowerShapingTemplate returns the power-shaping template with the given id
+func (k Keeper) GetPowerShapingTemplate(ctx sdk.Context, templateId string) (types.PowerShapingTemplate, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PowerShapingTemplateKey(templateId))
+	if bz == nil {
+		return types.PowerShapingTemplate{}, false
+	}
+
+	var template types.PowerShapingTemplate
+	if err := template.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the template is assumed to be correctly serialized in SetPowerShapingTemplate.
+		panic(fmt.Errorf("cannot unmarshal power-shaping template: %w", err))
+	}
+	return template, true
+}
+
+// GetAllPowerShapingTemplates returns all the stored power-shaping templates
+func (k Keeper) GetAllPowerShapingTemplates(ctx sdk.Context) (templates []types.PowerShapingTemplate) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.PowerShapingTemplateKeyPrefix()})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var template types.PowerShapingTemplate
+		if err := template.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the template is assumed to be correctly serialized in SetPowerShapingTemplate.
+			panic(fmt.Errorf("cannot unmarshal power-shaping template: %w", err))
+		}
+		templates = append(templates, template)
+	}
+	return templates
+}
+
+// GetPowerShapingParametersFromTemplate returns the power-shaping parameters of the template with the given id
+func (k Keeper) GetPowerShapingParametersFromTemplate(ctx sdk.Context, templateId string) (types.PowerShapingParameters, error) {
+	template, found := k.GetPowerShapingTemplate(ctx, templateId)
+	if !found {
+		return types.PowerShapingParameters{}, fmt.Errorf("cannot find power-shaping template with id (%s)", templateId)
+	}
+	return template.PowerShapingParameters, nil
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -483,6 +484,27 @@ func TestNoMoreThanPercentOfTheSum(t *testing.T) {
 	require.True(t, noMoreThanPercent(keeper.NoMoreThanPercentOfTheSum(createConsumerValidators(powers), percent), percent))
 }
 
+func TestFloorValidatorsPower(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	validators := createConsumerValidators([]int64{1, 2, 30, 400})
+
+	// no-op if the min validator power is not set
+	require.Equal(t, validators, providerKeeper.FloorValidatorsPower(ctx, 0, validators))
+
+	flooredValidators := providerKeeper.FloorValidatorsPower(ctx, 10, validators)
+	require.Equal(t, createConsumerValidators([]int64{10, 10, 30, 400}), flooredValidators)
+	// the input validators are not modified
+	require.Equal(t, createConsumerValidators([]int64{1, 2, 30, 400}), validators)
+
+	// the min validator power is applied after the power cap and takes precedence over it
+	cappedValidators := keeper.NoMoreThanPercentOfTheSum(createConsumerValidators([]int64{1, 2, 30, 400}), 50)
+	for _, v := range providerKeeper.FloorValidatorsPower(ctx, 150, cappedValidators) {
+		require.GreaterOrEqual(t, v.Power, int64(150))
+	}
+}
+
 func createConsumerValidators(powers []int64) []providertypes.ConsensusValidator {
 	var validators []providertypes.ConsensusValidator
 	for _, p := range powers {
@@ -712,6 +734,70 @@ func TestFulfillsMinStake(t *testing.T) {
 	}
 }
 
+func TestFulfillsMinUptime(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	slashingParams := slashingtypes.DefaultParams()
+	slashingParams.SignedBlocksWindow = 100
+	mocks.MockSlashingKeeper.EXPECT().GetParams(gomock.Any()).Return(slashingParams, nil).AnyTimes()
+
+	// the first validator missed 5 blocks of the window, the second validator missed 20 blocks of the window,
+	// the third validator was bonded 10 blocks ago and missed 2 blocks, and the fourth validator has no signing info
+	signingInfos := []slashingtypes.ValidatorSigningInfo{
+		{IndexOffset: 250, MissedBlocksCounter: 5},
+		{IndexOffset: 250, MissedBlocksCounter: 20},
+		{IndexOffset: 10, MissedBlocksCounter: 2},
+	}
+	consAddrs := make([]providertypes.ProviderConsAddress, 4)
+	for i := range consAddrs {
+		consAddrs[i] = providertypes.NewProviderConsAddress([]byte(fmt.Sprintf("providerAddr%d", i)))
+		if i < len(signingInfos) {
+			mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddrs[i].ToSdkConsAddr()).Return(signingInfos[i], nil).AnyTimes()
+		} else {
+			mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), consAddrs[i].ToSdkConsAddr()).
+				Return(slashingtypes.ValidatorSigningInfo{}, slashingtypes.ErrNoSigningInfoFound).AnyTimes()
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		minUptime       uint32
+		expectedFulfill []bool
+	}{
+		{
+			name:            "No min uptime",
+			minUptime:       0,
+			expectedFulfill: []bool{true, true, true, true},
+		},
+		{
+			name:            "Min uptime set to 80",
+			minUptime:       80,
+			expectedFulfill: []bool{true, true, true, false},
+		},
+		{
+			name:            "Min uptime set to 95",
+			minUptime:       95,
+			expectedFulfill: []bool{true, false, false, false},
+		},
+		{
+			name:            "Min uptime set to 100",
+			minUptime:       100,
+			expectedFulfill: []bool{false, false, false, false},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for i, consAddr := range consAddrs {
+				result, err := providerKeeper.FulfillsMinUptime(ctx, tc.minUptime, consAddr)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedFulfill[i], result)
+			}
+		})
+	}
+}
+
 // TestIfInactiveValsDisallowedProperty checks that the number of validators in the next validator set is at most
 // the MaxProviderConsensusValidators parameter if the consumer chain does not allow inactive validators to validate.
 func TestIfInactiveValsDisallowedProperty(t *testing.T) {
@@ -811,6 +897,7 @@ func TestConsumerPowerShapingParameters(t *testing.T) {
 		MinStake:           234,
 		AllowInactiveVals:  true,
 		Prioritylist:       []string{consAddrs[1]},
+		MinUptime:          90,
 	}
 	expectedAllowlist := []providertypes.ProviderConsAddress{providerConsAddr[0], providerConsAddr[1]}
 	sortProviderConsAddr(expectedAllowlist)
@@ -837,6 +924,7 @@ func TestConsumerPowerShapingParameters(t *testing.T) {
 		MinStake:           567,
 		AllowInactiveVals:  false,
 		Prioritylist:       []string{consAddrs[4], consAddrs[5]},
+		MinUptime:          0,
 	}
 	expectedAllowlist = []providertypes.ProviderConsAddress{providerConsAddr[4], providerConsAddr[5]}
 	sortProviderConsAddr(expectedAllowlist)
@@ -1039,7 +1127,7 @@ func TestUpdateMinimumPowerInTopN(t *testing.T) {
 		createStakingValidator(ctx, mocks, powers[1], 2), // this validator has ~33% of the total voting gpower
 		createStakingValidator(ctx, mocks, powers[2], 3), // this validator has 50% of the total voting power
 	}
-	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(validators, nil).AnyTimes()
+	testkeeper.MockIterateBondedValidatorsByPower(mocks.MockStakingKeeper, validators).AnyTimes()
 
 	maxProviderConsensusValidators := int64(3)
 	params := providerKeeper.GetParams(ctx)
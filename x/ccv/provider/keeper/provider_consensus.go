@@ -69,7 +69,7 @@ func (k Keeper) CreateProviderConsensusValidator(ctx sdk.Context, val stakingtyp
 	if err != nil {
 		return types.ConsensusValidator{}, fmt.Errorf("getting consensus public key: %w", err)
 	}
-	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	valAddr, err := k.validatorAddressCodec.StringToBytes(val.GetOperator())
 	if err != nil {
 		return types.ConsensusValidator{}, fmt.Errorf("getting validator address: %w", err)
 	}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetProviderFeePoolAddr sets the address of the consumer rewards pool last declared to the consumer chains
+func (k Keeper) SetProviderFeePoolAddr(ctx sdk.Context, addr string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ProviderFeePoolAddrKey(), []byte(addr))
+}
+
+// GetProviderFeePoolAddr returns the address of the consumer rewards pool last declared to the consumer chains
+func (k Keeper) GetProviderFeePoolAddr(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ProviderFeePoolAddrKey())
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SendProviderFeePoolAddrPacket sends a packet declaring the given address of the consumer rewards pool
+// to the given consumer chain
+func (k Keeper) SendProviderFeePoolAddrPacket(ctx sdk.Context, consumerId, addr string) error {
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"CCV channel not established for consumer chain with consumer id: %s", consumerId)
+	}
+
+	data := ccv.NewProviderFeePoolAddrPacketData(addr)
+	_, err := ccv.SendIBCPacket(
+		ctx,
+		k.channelKeeper,
+		channelId, // source channel id
+		k.portID,  // source port id
+		data.GetBytes(),
+		k.GetCCVTimeoutPeriodForConsumer(ctx, consumerId),
+	)
+	return err
+}
+
+// EndBlockProviderFeePoolAddr declares the address of the consumer rewards pool to the launched
+// consumer chains with an established CCV channel whenever it changes, e.g., after a migration
+// of the module account. The consumer chains without a CCV channel receive the current address
+// in the channel handshake. Failing to send the address to a consumer chain does not affect
+// the other consumer chains.
+func (k Keeper) EndBlockProviderFeePoolAddr(ctx sdk.Context) {
+	addr := k.GetConsumerRewardsPoolAddressStr(ctx)
+	prevAddr, found := k.GetProviderFeePoolAddr(ctx)
+	if prevAddr == addr {
+		return
+	}
+	k.SetProviderFeePoolAddr(ctx, addr)
+	if !found {
+		// the address was never recorded, i.e., the consumer chains received it in the channel handshake
+		return
+	}
+
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		if _, found := k.GetConsumerIdToChannelId(ctx, consumerId); !found {
+			continue
+		}
+
+		k.ExecuteIsolated(ctx, consumerId, "send provider fee pool address", func(ctx sdk.Context) error {
+			if err := k.SendProviderFeePoolAddrPacket(ctx, consumerId, addr); err != nil {
+				return err
+			}
+			k.Logger(ctx).Info("sent provider fee pool address to consumer chain",
+				"consumerId", consumerId,
+				"address", addr,
+			)
+			return nil
+		})
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderFeePoolAddrUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeProviderFeePoolAddr, addr),
+		),
+	)
+}
@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestEndBlockProviderFeePoolAddr tests that the address of the consumer rewards pool is sent
+// to the launched consumer chains with an established CCV channel only when it changes
+func TestEndBlockProviderFeePoolAddr(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// consumer "0" is launched and has an established channel
+	// consumer "1" is launched and has an established channel, but sending the packet fails
+	// consumer "2" is launched, but has no established channel
+	// consumer "3" is not launched
+	for i := 0; i < 4; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		if i < 2 {
+			providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channelID"+consumerId)
+		}
+	}
+	providerKeeper.SetConsumerPhase(ctx, "3", providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	oldPool := authtypes.NewEmptyModuleAccount(providertypes.ConsumerRewardsPool)
+	newPool := authtypes.NewEmptyModuleAccount("migrated_" + providertypes.ConsumerRewardsPool)
+
+	// the first address is only recorded, as the consumer chains received it in the channel handshake
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(oldPool).Times(2)
+	providerKeeper.EndBlockProviderFeePoolAddr(ctx)
+	addr, found := providerKeeper.GetProviderFeePoolAddr(ctx)
+	require.True(t, found)
+	require.Equal(t, oldPool.GetAddress().String(), addr)
+
+	// an unchanged address is not sent
+	providerKeeper.EndBlockProviderFeePoolAddr(ctx)
+
+	// a changed address is sent to the launched consumer chains with an established channel
+	expectedData := ccv.NewProviderFeePoolAddrPacketData(newPool.GetAddress().String())
+	gomock.InOrder(
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(newPool).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID0").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID0", gomock.Any(), gomock.Any(),
+			expectedData.GetBytes()).Return(uint64(1), nil).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID1").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID1", gomock.Any(), gomock.Any(),
+			expectedData.GetBytes()).Return(uint64(0), errors.New("send failed")).Times(1),
+	)
+	providerKeeper.EndBlockProviderFeePoolAddr(ctx)
+	addr, found = providerKeeper.GetProviderFeePoolAddr(ctx)
+	require.True(t, found)
+	require.Equal(t, newPool.GetAddress().String(), addr)
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,7 +11,9 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -21,6 +25,11 @@ import (
 
 // OnAcknowledgementPacket handles acknowledgments for sent VSC packets
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel); found {
+		// as the CCV channel is ordered, every acknowledged packet,
+		// regardless of its type and result, is no longer pending
+		k.recordRelayerPacketAck(ctx, consumerId, packet.Sequence)
+	}
 	if err := ack.GetError(); err != "" {
 		// The VSC packet data could not be successfully decoded.
 		// This should never happen.
@@ -29,14 +38,136 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			"channelID", packet.SourceChannel,
 			"error", err,
 		)
+		var upgradeData ccv.ConsumerUpgradePacketData
+		if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &upgradeData); err == nil {
+			// consumer upgrade packets are only informative, e.g., consumer chains
+			// running older versions cannot decode them, so an error must not
+			// result in the consumer being removed
+			return nil
+		}
+		var downtimeData ccv.DowntimeParamsPacketData
+		if ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &downtimeData) == nil {
+			// consumer chains running older versions cannot decode downtime params packets,
+			// in which case they keep their own downtime params
+			return nil
+		}
+		var feePoolAddrData ccv.ProviderFeePoolAddrPacketData
+		if ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &feePoolAddrData) == nil {
+			// consumer chains running older versions cannot decode provider fee pool address packets,
+			// in which case their governance can still update the address through the consumer params
+			return nil
+		}
+		var checkpointData ccv.ValsetCheckpointPacketData
+		if ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &checkpointData) == nil {
+			// consumer chains running older versions cannot decode valset checkpoint packets,
+			// in which case their validator set is not verified against the checkpoint
+			return nil
+		}
 		if consumerId, ok := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
+			k.recordVSCPacketAckStatus(ctx, consumerId, packet, providertypes.VSC_PACKET_ACK_STATUS_ERROR)
+			var vscData ccv.ValidatorSetChangePacketData
+			if ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &vscData) == nil && isInvalidValidatorUpdatesAck(err) {
+				// the consumer chain rejected the validator updates of the VSC packet, which is reported
+				// without removing the consumer chain. As the consumer chain did not apply the updates,
+				// its validator set is resent at the next epoch.
+				k.emitInvalidVSCPacketEvent(ctx, consumerId, vscData.ValsetUpdateId, err)
+				k.dropVSCPackets(ctx, consumerId, []ccv.ValidatorSetChangePacketData{vscData})
+				return nil
+			}
 			return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
 		}
 		return errorsmod.Wrapf(providertypes.ErrUnknownConsumerChannelId, "recv ErrorAcknowledgement on unknown channel %s", packet.SourceChannel)
 	}
+	k.HandleVSCPacketAckResult(ctx, packet, ack.GetResult())
 	return nil
 }
 
+// HandleVSCPacketAckResult verifies the validator set confirmed by the consumer chain
+// in the result of a VSC packet acknowledgement against the validator set sent by the provider.
+// Acknowledgements of other packets are ignored.
+func (k Keeper) HandleVSCPacketAckResult(ctx sdk.Context, packet channeltypes.Packet, result []byte) {
+	var data ccv.ValidatorSetChangePacketData
+	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a VSC packet
+		return
+	}
+	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel)
+	if !found {
+		return
+	}
+	k.recordVSCPacketAck(ctx, consumerId)
+	k.recordVSCPacketAckStatus(ctx, consumerId, packet, providertypes.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED)
+
+	confirmation, found := k.GetVscConfirmation(ctx, consumerId, data.ValsetUpdateId)
+	if !found {
+		return
+	}
+
+	var ackResult ccv.ValidatorSetChangePacketAck
+	if err := ccv.ModuleCdc.UnmarshalJSON(result, &ackResult); err != nil ||
+		ackResult.ValsetUpdateId != data.ValsetUpdateId {
+		// the consumer chain does not confirm the applied validator set
+		// (e.g., it runs an older version), so the VSC cannot be verified
+		k.DeleteVscConfirmation(ctx, consumerId, data.ValsetUpdateId)
+		return
+	}
+
+	if bytes.Equal(ackResult.ValsetHash, confirmation.ExpectedValsetHash) {
+		// the consumer chain applied the expected validator set
+		k.DeleteVscConfirmation(ctx, consumerId, data.ValsetUpdateId)
+		return
+	}
+
+	confirmation.AppliedValsetHash = ackResult.ValsetHash
+	k.SetVscConfirmation(ctx, consumerId, confirmation)
+
+	k.Logger(ctx).Error("consumer chain applied a different validator set",
+		"consumerId", consumerId,
+		"vscid", data.ValsetUpdateId,
+		"expected hash", hex.EncodeToString(confirmation.ExpectedValsetHash),
+		"applied hash", hex.EncodeToString(confirmation.AppliedValsetHash),
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			providertypes.EventTypeVscConfirmationMismatch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(data.ValsetUpdateId, 10)),
+			sdk.NewAttribute(providertypes.AttributeValsetHash, hex.EncodeToString(confirmation.ExpectedValsetHash)),
+			sdk.NewAttribute(providertypes.AttributeAppliedValsetHash, hex.EncodeToString(confirmation.AppliedValsetHash)),
+		),
+	)
+}
+
+// isInvalidValidatorUpdatesAck returns true if the error acknowledgement was returned
+// by a consumer chain that rejected the validator updates of a VSC packet
+func isInvalidValidatorUpdatesAck(ackErr string) bool {
+	for _, err := range []error{
+		ccv.ErrDuplicateValidatorPubKey,
+		ccv.ErrInvalidValidatorPower,
+		ccv.ErrTooManyValidatorUpdates,
+	} {
+		errAck := channeltypes.NewErrorAcknowledgement(err)
+		if ackErr == errAck.GetError() {
+			return true
+		}
+	}
+	return false
+}
+
+// emitInvalidVSCPacketEvent emits an event for a VSC packet with invalid validator updates
+func (k Keeper) emitInvalidVSCPacketEvent(ctx sdk.Context, consumerId string, vscId uint64, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			providertypes.EventTypeInvalidVSCPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(vscId, 10)),
+			sdk.NewAttribute(providertypes.AttributeConsumerError, reason),
+		),
+	)
+}
+
 // OnTimeoutPacket aborts the transaction if no chain exists for the destination channel,
 // otherwise it stops the chain
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
@@ -50,25 +181,39 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) err
 		)
 	}
 	k.Logger(ctx).Info("packet timeout, deleting the consumer:", "consumerId", consumerId)
+	k.recordVSCPacketAckStatus(ctx, consumerId, packet, providertypes.VSC_PACKET_ACK_STATUS_TIMED_OUT)
 	return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
 }
 
 // EndBlockVSU contains the EndBlock logic needed for
 // the Validator Set Update sub-protocol
 func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	timer := k.NewBlockPhaseTimer()
+
 	// logic to update the provider consensus validator set.
 	valUpdates, err := k.ProviderValidatorUpdates(ctx)
 	if err != nil {
 		return []abci.ValidatorUpdate{}, fmt.Errorf("computing the provider consensus validator set: %w", err)
 	}
+	timer.Lap(BlockPhaseProviderValset)
 
 	if k.BlocksUntilNextEpoch(ctx) == 0 {
 		// only queue and send VSCPackets at the boundaries of an epoch
+		k.RecordEpochStart(ctx)
+
+		// reset the per-epoch relayer rebate caps
+		k.DeleteAllRelayerRebateCounts(ctx)
+
+		// opt out the tombstoned validators before computing the consumer validator sets
+		if err := k.CleanupTombstonedValidators(ctx); err != nil {
+			return []abci.ValidatorUpdate{}, fmt.Errorf("cleaning up tombstoned validators: %w", err)
+		}
 
 		// collect validator updates
 		if err := k.QueueVSCPackets(ctx); err != nil {
 			return []abci.ValidatorUpdate{}, fmt.Errorf("queueing consumer validator updates: %w", err)
 		}
+		timer.Lap(BlockPhaseVSCPacketQueueing)
 
 		// try sending VSC packets to all registered consumer chains;
 		// if the CCV channel is not established for a consumer chain,
@@ -76,6 +221,15 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 		if err := k.SendVSCPackets(ctx); err != nil {
 			return []abci.ValidatorUpdate{}, fmt.Errorf("sending consumer validator updates: %w", err)
 		}
+		timer.Lap(BlockPhaseVSCPacketSending)
+
+		// periodically send valset checkpoints to all registered consumer chains
+		if period := k.GetValsetCheckpointPeriod(ctx); period > 0 && (ctx.BlockHeight()/k.GetBlocksPerEpoch(ctx))%period == 0 {
+			if err := k.SendValsetCheckpoints(ctx); err != nil {
+				return []abci.ValidatorUpdate{}, fmt.Errorf("sending consumer valset checkpoints: %w", err)
+			}
+			timer.Lap(BlockPhaseValsetCheckpoints)
+		}
 	}
 
 	return valUpdates, nil
@@ -84,11 +238,11 @@ func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
 // ProviderValidatorUpdates returns changes in the provider consensus validator set
 // from the last block to the current one.
 // It retrieves the bonded validators from the staking module and creates a `ConsumerValidator` object for each validator.
-// The maximum number of validators is determined by the `maxValidators` parameter.
+// The maximum number of validators is determined by the `MaxProviderConsensusValidators` parameter.
 // The function returns the difference between the current validator set and the next validator set as a list of `abci.ValidatorUpdate` objects.
 func (k Keeper) ProviderValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
-	// get the bonded validators from the staking module
-	bondedValidators, err := k.stakingKeeper.GetBondedValidatorsByPower(ctx)
+	// get the first MaxProviderConsensusValidators bonded validators from the staking module
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
 	if err != nil {
 		return []abci.ValidatorUpdate{}, fmt.Errorf("getting bonded validators: %w", err)
 	}
@@ -100,12 +254,7 @@ func (k Keeper) ProviderValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdat
 	}
 
 	nextValidators := []providertypes.ConsensusValidator{}
-	maxValidators := k.GetMaxProviderConsensusValidators(ctx)
-	// avoid out of range errors by bounding the max validators to the number of bonded validators
-	if maxValidators > int64(len(bondedValidators)) {
-		maxValidators = int64(len(bondedValidators))
-	}
-	for _, val := range bondedValidators[:maxValidators] {
+	for _, val := range activeValidators {
 		nextValidator, err := k.CreateProviderConsensusValidator(ctx, val)
 		if err != nil {
 			return []abci.ValidatorUpdate{},
@@ -141,7 +290,8 @@ func (k Keeper) BlocksUntilNextEpoch(ctx sdk.Context) int64 {
 // SendVSCPackets iterates over all consumers chains with created IBC clients
 // and sends pending VSC packets to the chains with established CCV channels.
 // If the CCV channel is not established for a consumer chain,
-// the updates will remain queued until the channel is established
+// the updates will remain queued until the channel is established.
+// Failing to send the packets to a consumer chain does not affect the other consumer chains.
 //
 // TODO (mpoke): iterate only over consumers with established channel -- GetAllChannelToConsumers
 func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
@@ -153,9 +303,9 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
 
 		// check if CCV channel is established and send
 		if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
-			if err := k.SendVSCPacketsToChain(ctx, consumerId, channelID); err != nil {
-				return fmt.Errorf("sending VSCPacket to consumer, consumerId(%s): %w", consumerId, err)
-			}
+			k.ExecuteIsolated(ctx, consumerId, "send VSC packets", func(ctx sdk.Context) error {
+				return k.SendVSCPacketsToChain(ctx, consumerId, channelID)
+			})
 		}
 	}
 	return nil
@@ -164,15 +314,31 @@ func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
 // SendVSCPacketsToChain sends all queued VSC packets to the specified chain
 func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId string) error {
 	pendingPackets := k.GetPendingVSCPackets(ctx, consumerId)
+
+	// validate the updates of all packets before sending any of them,
+	// as the consumer chain would reject an invalid packet with an error acknowledgement.
+	// If a packet is invalid, all the pending packets are reported and dropped, as the following
+	// packets build on the updates of the invalid one, and the validator set of the consumer chain
+	// is resent at the next epoch instead.
+	for _, data := range pendingPackets {
+		if err := data.ValidateValidatorUpdates(); err != nil {
+			k.Logger(ctx).Error("invalid VSC, dropping pending VSC packets:", "consumerId", consumerId, "vscid", data.ValsetUpdateId, "err", err.Error())
+			k.emitInvalidVSCPacketEvent(ctx, consumerId, data.ValsetUpdateId, err.Error())
+			k.dropVSCPackets(ctx, consumerId, pendingPackets)
+			k.DeletePendingVSCPackets(ctx, consumerId)
+			return nil
+		}
+	}
+
 	for _, data := range pendingPackets {
 		// send packet over IBC
-		err := ccv.SendIBCPacket(
+		sequence, err := ccv.SendIBCPacket(
 			ctx,
 			k.channelKeeper,
-			channelId,          // source channel id
-			ccv.ProviderPortID, // source port id
+			channelId, // source channel id
+			k.portID,  // source port id
 			data.GetBytes(),
-			k.GetCCVTimeoutPeriod(ctx),
+			k.GetCCVTimeoutPeriodForConsumer(ctx, consumerId),
 		)
 		if err != nil {
 			if errors.Is(err, clienttypes.ErrClientNotActive) {
@@ -195,30 +361,109 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 			}
 			return nil
 		}
+		k.recordVSCPacketSent(ctx, consumerId, sequence, data)
 	}
 	k.DeletePendingVSCPackets(ctx, consumerId)
+	ccv.IncrConsumerCounter(providertypes.ModuleName, ccv.MetricKeyVSCPacketsSent, consumerId, float32(len(pendingPackets)))
+
+	return nil
+}
+
+// dropVSCPackets drops VSC packets whose validator updates are not applied by the consumer chain.
+// The validator set of the consumer chain is resent at the next epoch (see RequireConsumerValSetResync),
+// the dangling VSC confirmations are deleted, and the slash acks are queued again to be sent with the next VSC packet.
+func (k Keeper) dropVSCPackets(ctx sdk.Context, consumerId string, packets []ccv.ValidatorSetChangePacketData) {
+	for _, data := range packets {
+		k.RequireConsumerValSetResync(ctx, consumerId, data.ValidatorUpdates)
+		k.DeleteVscConfirmation(ctx, consumerId, data.ValsetUpdateId)
+		for _, ack := range data.SlashAcks {
+			k.AppendSlashAck(ctx, consumerId, ack)
+		}
+	}
+}
+
+// SendValsetCheckpoints sends a valset checkpoint packet to every launched consumer chain
+// with an established CCV channel. The checkpoint contains the hash of the consumer validator set
+// corresponding to the last valset update ID. Consumer chains with pending VSC packets are skipped,
+// as they have not received yet all the updates covered by the checkpoint.
+func (k Keeper) SendValsetCheckpoints(ctx sdk.Context) error {
+	// the valset update ID used by the last queued VSC packets
+	valUpdateID := k.GetValidatorSetUpdateId(ctx) - 1
+
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+			// only send checkpoints to launched chains
+			continue
+		}
+
+		channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+		if !found || len(k.GetPendingVSCPackets(ctx, consumerId)) != 0 {
+			continue
+		}
 
+		k.ExecuteIsolated(ctx, consumerId, "send valset checkpoint", func(ctx sdk.Context) error {
+			return k.sendValsetCheckpoint(ctx, consumerId, channelId, valUpdateID)
+		})
+	}
+	return nil
+}
+
+// sendValsetCheckpoint sends a valset checkpoint packet to a consumer chain
+func (k Keeper) sendValsetCheckpoint(ctx sdk.Context, consumerId, channelId string, valUpdateID uint64) error {
+	valsetHash, err := k.GetConsumerValsetHash(ctx, consumerId)
+	if err != nil {
+		return fmt.Errorf("computing consumer valset hash, consumerId(%s): %w", consumerId, err)
+	}
+
+	data := ccv.NewValsetCheckpointPacketData(valUpdateID, valsetHash)
+	_, err = ccv.SendIBCPacket(
+		ctx,
+		k.channelKeeper,
+		channelId, // source channel id
+		k.portID,  // source port id
+		data.GetBytes(),
+		k.GetCCVTimeoutPeriodForConsumer(ctx, consumerId),
+	)
+	if err != nil {
+		// checkpoints are only used for auditing purposes, so failing
+		// to send one must not result in the consumer being removed
+		k.Logger(ctx).Error("cannot send valset checkpoint:",
+			"consumerId", consumerId,
+			"vscid", valUpdateID,
+			"err", err.Error(),
+		)
+		return nil
+	}
+	k.Logger(ctx).Info("valset checkpoint sent:",
+		"consumerId", consumerId,
+		"vscid", valUpdateID,
+	)
 	return nil
 }
 
 // QueueVSCPackets queues latest validator updates for every consumer chain
 // with the IBC client created.
 //
+// The validator set of every consumer chain is computed on its own branch of the state,
+// which is committed only if the computation succeeds. The branch of a consumer chain
+// whose computation fails is discarded (see HandleConsumerFailure), without affecting
+// the other consumer chains. As the validators modified in the current epoch are deleted
+// regardless, the next validator set of a failed consumer chain is fully recomputed.
+//
 // TODO (mpoke): iterate only over consumers with established channel -- GetAllChannelToConsumers
 func (k Keeper) QueueVSCPackets(ctx sdk.Context) error {
 	valUpdateID := k.GetValidatorSetUpdateId(ctx) // current valset update ID
 
-	// get the bonded validators from the staking module
-	bondedValidators, err := k.GetLastBondedValidators(ctx)
+	// get the bonded and the provider active validators from the staking module
+	bondedValidators, activeValidators, err := k.GetLastBondedAndActiveValidators(ctx)
 	if err != nil {
 		return fmt.Errorf("getting bonded validators: %w", err)
 	}
 
-	// get the provider active validators
-	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
-	if err != nil {
-		return fmt.Errorf("getting provider active validators: %w", err)
-	}
+	// the validators whose voting power might have changed since the last epoch
+	modifiedValidators := k.GetAllModifiedValidators(ctx)
+	// the bonded validators indexed by consensus address, only built if needed
+	var bondedValidatorsByConsAddr map[string]stakingtypes.Validator
 
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
 		if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
@@ -226,35 +471,118 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) error {
 			continue
 		}
 
-		currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
-		if err != nil {
-			return fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
-		}
+		var valUpdates []abci.ValidatorUpdate
+		cachedCtx, writeFn := ctx.CacheContext()
+		err := callRecovered(cachedCtx, func(ctx sdk.Context) (err error) {
+			powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+			if err == nil && k.CanUpdateConsumerValSetIncrementally(ctx, consumerId, powerShapingParameters, len(bondedValidators)) {
+				// only re-evaluate the validators modified since the last epoch
+				if bondedValidatorsByConsAddr == nil {
+					bondedValidatorsByConsAddr, err = indexValidatorsByConsAddr(bondedValidators)
+					if err != nil {
+						return fmt.Errorf("indexing bonded validators: %w", err)
+					}
+				}
+				valUpdates, err = k.ComputeConsumerValSetDiff(ctx, consumerId, powerShapingParameters, bondedValidatorsByConsAddr, modifiedValidators)
+				if err != nil {
+					return fmt.Errorf("computing consumer validator set diff, consumerId(%s): %w", consumerId, err)
+				}
+			} else {
+				currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
+				if err != nil {
+					return fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
+				}
+				if resync, found := k.GetConsumerValSetResync(ctx, consumerId); found {
+					// the consumer chain did not apply some validator updates, so its validator set is resent
+					currentValSet = ConsumerValSetResyncBase(currentValSet, resync)
+					k.DeleteConsumerValSetResync(ctx, consumerId)
+				}
+
+				// compute consumer next validator set
+				valUpdates, err = k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet)
+				if err != nil {
+					return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
+				}
+			}
 
-		// compute consumer next validator set
-		valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet)
+			return k.queueVSCPacket(ctx, consumerId, valUpdateID, valUpdates)
+		})
 		if err != nil {
-			return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
+			k.HandleConsumerFailure(ctx, consumerId, "queue VSC packets", err)
+			// the changes of the current epoch are not applied to the consumer validator set,
+			// so it can no longer be updated incrementally
+			k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+			continue
 		}
-
-		// check whether there are changes in the validator set
-		if len(valUpdates) != 0 {
-			// construct validator set change packet data
-			packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
-			k.AppendPendingVSCPackets(ctx, consumerId, packet)
-			k.Logger(ctx).Info("VSCPacket enqueued:",
-				"consumerId", consumerId,
-				"vscID", valUpdateID,
-				"len updates", len(valUpdates),
-			)
+		writeFn()
+		if len(valUpdates) > 0 {
+			ccv.IncrConsumerCounter(providertypes.ModuleName, ccv.MetricKeyVSCPacketsQueued, consumerId, 1)
+			k.recordQueuedVSCPacket(ctx, consumerId, valUpdateID, valUpdates)
 		}
 	}
 
+	k.DeleteAllModifiedValidators(ctx)
 	k.IncrementValidatorSetUpdateId(ctx)
 
 	return nil
 }
 
+// queueVSCPacket queues a VSC packet with the given validator updates to a consumer chain,
+// if there are any updates
+func (k Keeper) queueVSCPacket(ctx sdk.Context, consumerId string, valUpdateID uint64, valUpdates []abci.ValidatorUpdate) error {
+	// check whether there are changes in the validator set
+	if len(valUpdates) == 0 {
+		return nil
+	}
+
+	// construct validator set change packet data
+	packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
+	k.AppendPendingVSCPackets(ctx, consumerId, packet)
+
+	// record the expected consumer validator set to be confirmed by the consumer chain
+	valsetHash, err := k.GetConsumerValsetHash(ctx, consumerId)
+	if err != nil {
+		return fmt.Errorf("computing consumer valset hash, consumerId(%s): %w", consumerId, err)
+	}
+	k.SetVscConfirmation(ctx, consumerId, providertypes.VscConfirmation{
+		ValsetUpdateId:     valUpdateID,
+		ExpectedValsetHash: valsetHash,
+	})
+	k.SetConsumerValsetCheckpoint(ctx, consumerId, ccv.NewValsetCheckpointPacketData(valUpdateID, valsetHash))
+	// record the consumer validator set, so that it can be queried by VSC id
+	if err := k.RecordValsetSnapshot(ctx, consumerId, valUpdateID); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			providertypes.EventTypeVSCPacketQueued,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(valUpdateID, 10)),
+			sdk.NewAttribute(providertypes.AttributeValidatorUpdates, strconv.Itoa(len(valUpdates))),
+		),
+	)
+	k.Logger(ctx).Info("VSCPacket enqueued:",
+		"consumerId", consumerId,
+		"vscID", valUpdateID,
+		"len updates", len(valUpdates),
+	)
+	return nil
+}
+
+// indexValidatorsByConsAddr returns the given validators indexed by their consensus addresses (as raw bytes)
+func indexValidatorsByConsAddr(validators []stakingtypes.Validator) (map[string]stakingtypes.Validator, error) {
+	validatorsByConsAddr := make(map[string]stakingtypes.Validator, len(validators))
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		validatorsByConsAddr[string(consAddr)] = val
+	}
+	return validatorsByConsAddr, nil
+}
+
 // BeginBlockCIS contains the BeginBlock logic needed for the Consumer Initiated Slashing sub-protocol.
 func (k Keeper) BeginBlockCIS(ctx sdk.Context) {
 	// Replenish slash meter if necessary. This ensures the meter value is replenished before handling any slash packets,
@@ -277,11 +605,20 @@ func (k Keeper) EndBlockCIS(ctx sdk.Context) {
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	valUpdateID := k.GetValidatorSetUpdateId(ctx)
 	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
+	k.SetValsetUpdateBlockTime(ctx, valUpdateID, ctx.BlockTime())
 	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
 
+	// prune the vscID to block height mappings that are no longer needed
+	if err := k.pruneValsetUpdateBlockHeights(ctx); err != nil {
+		k.Logger(ctx).Error("cannot prune vscID to block height mappings", "error", err)
+	}
+
 	// prune previous consumer validator addresses that are no longer needed
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
-		k.PruneKeyAssignments(ctx, consumerId)
+		k.ExecuteIsolated(ctx, consumerId, "prune key assignments", func(ctx sdk.Context) error {
+			k.PruneKeyAssignments(ctx, consumerId)
+			return nil
+		})
 	}
 }
 
@@ -302,6 +639,8 @@ func (k Keeper) OnRecvSlashPacket(
 		)
 		panic(fmt.Errorf("SlashPacket received on unknown channel %s", packet.DestinationChannel))
 	}
+	ccv.IncrConsumerCounter(providertypes.ModuleName, ccv.MetricKeySlashPacketsReceived, consumerId, 1,
+		telemetry.NewLabel(ccv.MetricLabelInfraction, data.Infraction.String()))
 
 	// validate packet data upon receiving
 	if err := data.Validate(); err != nil {
@@ -337,6 +676,9 @@ func (k Keeper) OnRecvSlashPacket(
 			"infractionHeight", infractionHeight,
 		)
 
+		k.emitSlashPacketEvent(ctx, providertypes.EventTypeSlashPacketHandled, consumerId, providerConsAddr, data)
+		k.recordSlashPacketTrace(ctx, consumerId, packet, data, providerConsAddr, math.ZeroInt(), providertypes.SLASH_PACKET_OUTCOME_LOGGED, ccv.V1Result)
+
 		// return successful ack, as an error would result
 		// in the consumer closing the CCV channel
 		return ccv.V1Result, nil
@@ -352,6 +694,8 @@ func (k Keeper) OnRecvSlashPacket(
 
 		// drop packet but return a slash ack
 		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+		k.emitSlashPacketEvent(ctx, providertypes.EventTypeSlashPacketHandled, consumerId, providerConsAddr, data)
+		k.recordSlashPacketTrace(ctx, consumerId, packet, data, providerConsAddr, math.ZeroInt(), providertypes.SLASH_PACKET_OUTCOME_DROPPED, ccv.SlashPacketHandledResult)
 
 		return ccv.SlashPacketHandledResult, nil
 	}
@@ -365,6 +709,8 @@ func (k Keeper) OnRecvSlashPacket(
 
 		// drop packet but return a slash ack so that the consumer can send another slash packet
 		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+		k.emitSlashPacketEvent(ctx, providertypes.EventTypeSlashPacketHandled, consumerId, providerConsAddr, data)
+		k.recordSlashPacketTrace(ctx, consumerId, packet, data, providerConsAddr, math.ZeroInt(), providertypes.SLASH_PACKET_OUTCOME_DROPPED, ccv.SlashPacketHandledResult)
 
 		return ccv.SlashPacketHandledResult, nil
 	}
@@ -379,15 +725,20 @@ func (k Keeper) OnRecvSlashPacket(
 			"vscID", data.ValsetUpdateId,
 			"infractionType", data.Infraction,
 		)
+		ccv.IncrConsumerCounter(providertypes.ModuleName, ccv.MetricKeySlashPacketsBounced, consumerId, 1)
+		k.emitSlashPacketEvent(ctx, providertypes.EventTypeSlashPacketBounced, consumerId, providerConsAddr, data)
+		k.recordSlashPacketTrace(ctx, consumerId, packet, data, providerConsAddr, meter, providertypes.SLASH_PACKET_OUTCOME_BOUNCED, ccv.SlashPacketBouncedResult)
 		return ccv.SlashPacketBouncedResult, nil
 	}
 
 	// Subtract voting power that will be jailed/tombstoned from the slash meter,
 	// BEFORE handling slash packet.
-	meter = meter.Sub(k.GetEffectiveValPower(ctx, providerConsAddr))
-	k.SetSlashMeter(ctx, meter)
+	k.SetSlashMeter(ctx, meter.Sub(k.GetEffectiveValPower(ctx, providerConsAddr)))
 
 	k.HandleSlashPacket(ctx, consumerId, data)
+	ccv.IncrConsumerCounter(providertypes.ModuleName, ccv.MetricKeySlashPacketsHandled, consumerId, 1)
+	k.emitSlashPacketEvent(ctx, providertypes.EventTypeSlashPacketHandled, consumerId, providerConsAddr, data)
+	k.recordSlashPacketTrace(ctx, consumerId, packet, data, providerConsAddr, meter, providertypes.SLASH_PACKET_OUTCOME_HANDLED, ccv.SlashPacketHandledResult)
 
 	k.Logger(ctx).Info("slash packet received and handled",
 		"consumerId", consumerId,
@@ -401,6 +752,26 @@ func (k Keeper) OnRecvSlashPacket(
 	return ccv.SlashPacketHandledResult, nil
 }
 
+// emitSlashPacketEvent emits an event of the given type for a slash packet received from a consumer chain
+func (k Keeper) emitSlashPacketEvent(
+	ctx sdk.Context,
+	eventType string,
+	consumerId string,
+	providerConsAddr providertypes.ProviderConsAddress,
+	data ccv.SlashPacketData,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(providertypes.AttributeProviderConsAddress, k.ConsAddressToString(providerConsAddr.ToSdkConsAddr())),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(data.ValsetUpdateId, 10)),
+			sdk.NewAttribute(ccv.AttributeInfractionType, data.Infraction.String()),
+		),
+	)
+}
+
 // ValidateSlashPacket validates a recv slash packet before it is
 // handled or persisted in store. An error is returned if the packet is invalid,
 // and an error ack should be relayed to the sender.
@@ -506,13 +877,23 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 			k.Logger(ctx).Error("failed to set jail duration", "err", err.Error())
 			return
 		}
+
+		// record the jailing, so that it can be reverted by governance (see MsgRevertConsumerSlash)
+		k.SetConsumerDowntimeJail(ctx, consumerId, providerConsAddr, providertypes.ConsumerDowntimeJail{
+			ValsetUpdateId:   data.ValsetUpdateId,
+			InfractionHeight: infractionHeight,
+			JailTime:         ctx.BlockTime(),
+			JailEndTime:      jailEndTime,
+		})
 	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			providertypes.EventTypeExecuteConsumerChainSlash,
 			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
-			sdk.NewAttribute(ccv.AttributeValidatorAddress, providerConsAddr.String()),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(ccv.AttributeValidatorAddress, k.ConsAddressToString(providerConsAddr.ToSdkConsAddr())),
+			sdk.NewAttribute(providertypes.AttributeProviderConsAddress, k.ConsAddressToString(providerConsAddr.ToSdkConsAddr())),
 			sdk.NewAttribute(ccv.AttributeInfractionType, data.Infraction.String()),
 			sdk.NewAttribute(providertypes.AttributeInfractionHeight, strconv.Itoa(int(infractionHeight))),
 			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))),
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -13,12 +16,15 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
@@ -68,9 +74,7 @@ func TestQueueVSCPackets(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 		mocks := testkeeper.NewMockedKeepers(ctrl)
-		testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 0, []stakingtypes.Validator{}, 1)
-
-		mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return([]stakingtypes.Validator{}, nil).AnyTimes()
+		testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{}, 1)
 
 		pk := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
 		// no-op if tc.packets is empty
@@ -144,6 +148,468 @@ func TestQueueVSCPacketsDoesNotResetConsumerValidatorsHeights(t *testing.T) {
 	require.Equal(t, chainHeight, cv.JoinHeight, "the consumer validator's height was not correctly set")
 }
 
+// newBondedStakingValidator returns a bonded staking validator without setting up any mocks
+func newBondedStakingValidator(seed int) stakingtypes.Validator {
+	providerConsPubKey := cryptotestutil.NewCryptoIdentityFromIntSeed(seed).TMProtoCryptoPublicKey()
+	pk, _ := cryptocodec.FromCmtProtoPublicKey(providerConsPubKey)
+	pkAny, _ := codectypes.NewAnyWithValue(pk)
+	return stakingtypes.Validator{
+		OperatorAddress: sdk.ValAddress(pk.Address()).String(),
+		ConsensusPubkey: pkAny,
+		Status:          stakingtypes.Bonded,
+	}
+}
+
+// mockMutableBondedValidators mocks the staking keeper such that the bonded validators
+// and their powers can be modified in between calls
+func mockMutableBondedValidators(mocks testkeeper.MockedKeepers, bondedValidators *[]stakingtypes.Validator, powers map[string]int64) {
+	mocks.MockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(uint32(1000), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().IterateBondedValidatorsByPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) error {
+			for i, val := range *bondedValidators {
+				if fn(int64(i), val) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (int64, error) {
+			return powers[valAddr.String()], nil
+		}).AnyTimes()
+}
+
+// TestQueueVSCPacketsIncremental tests that the consumer validator set is updated incrementally
+// from the validators modified in the current epoch and that the result matches a full recomputation
+func TestQueueVSCPacketsIncremental(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	valA, valB, valC, valD := newBondedStakingValidator(0), newBondedStakingValidator(1), newBondedStakingValidator(2), newBondedStakingValidator(3)
+	bondedValidators := []stakingtypes.Validator{valA, valB, valC, valD}
+	powers := map[string]int64{valA.OperatorAddress: 10, valB.OperatorAddress: 20, valC.OperatorAddress: 30, valD.OperatorAddress: 40}
+	mockMutableBondedValidators(mocks, &bondedValidators, powers)
+
+	providerAddr := func(val stakingtypes.Validator) providertypes.ProviderConsAddress {
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		return providertypes.NewProviderConsAddress(consAddr)
+	}
+	pubKey := func(val stakingtypes.Validator) crypto.PublicKey {
+		pk, err := val.CmtConsPublicKey()
+		require.NoError(t, err)
+		return pk
+	}
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr(valA))
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr(valB))
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr(valC))
+
+	// the first epoch fully computes the consumer validator set
+	require.False(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	pending := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 1)
+	require.Len(t, pending[0].ValidatorUpdates, 3)
+
+	// B changes its power, C leaves the bonded validators, and D (not opted in) changes its power
+	powers[valB.OperatorAddress] = 25
+	powers[valD.OperatorAddress] = 45
+	bondedValidators = []stakingtypes.Validator{valA, valB, valD}
+	providerKeeper.SetModifiedValidator(ctx, providerAddr(valB))
+	providerKeeper.SetModifiedValidator(ctx, providerAddr(valC))
+	providerKeeper.SetModifiedValidator(ctx, providerAddr(valD))
+
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	require.Empty(t, providerKeeper.GetAllModifiedValidators(ctx))
+	pending = providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 2)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: pubKey(valB), Power: 25},
+		{PubKey: pubKey(valC), Power: 0},
+	}, pending[1].ValidatorUpdates)
+
+	// the incrementally updated validator set matches a full recomputation
+	currentValSet, err := providerKeeper.GetConsumerValSet(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	nextValidators, err := providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, bondedValidators, providertypes.PowerShapingParameters{}, 0)
+	require.NoError(t, err)
+	require.Empty(t, keeper.DiffValidators(currentValSet, nextValidators))
+	require.Len(t, currentValSet, 2)
+
+	// no modified validators results in no updates
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 2)
+
+	// opting in D changes the consumer-specific state and forces a full recomputation
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providerAddr(valD))
+	require.False(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	pending = providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 3)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: pubKey(valD), Power: 45}}, pending[2].ValidatorUpdates)
+
+	// the min validator power also applies to incremental updates
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{MinValidatorPower: 30})
+	require.NoError(t, err)
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	pending = providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 4)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: pubKey(valA), Power: 30},
+		{PubKey: pubKey(valB), Power: 30},
+	}, pending[3].ValidatorUpdates)
+
+	powers[valA.OperatorAddress] = 15
+	powers[valB.OperatorAddress] = 35
+	providerKeeper.SetModifiedValidator(ctx, providerAddr(valA))
+	providerKeeper.SetModifiedValidator(ctx, providerAddr(valB))
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.True(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	pending = providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 5)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: pubKey(valB), Power: 35}}, pending[4].ValidatorUpdates)
+
+	// Top N chains are always fully recomputed
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{Top_N: 50})
+	require.NoError(t, err)
+	providerKeeper.SetIncrementalValSetUpdate(ctx, CONSUMER_ID)
+	require.False(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{Top_N: 50}, len(bondedValidators)))
+
+	// as well as chains with a min uptime
+	require.False(t, providerKeeper.CanUpdateConsumerValSetIncrementally(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{MinUptime: 90}, len(bondedValidators)))
+}
+
+// TestQueueVSCPacketsIsolatesFailures tests that failing to compute the validator set of a consumer chain
+// flags the consumer chain without affecting the other consumer chains
+func TestQueueVSCPacketsIsolatesFailures(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	val := newBondedStakingValidator(0)
+	bondedValidators := []stakingtypes.Validator{val}
+	mockMutableBondedValidators(mocks, &bondedValidators, map[string]int64{val.OperatorAddress: 10})
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+
+	for _, consumerId := range []string{"0", "1", "2"} {
+		providerKeeper.SetConsumerClientId(ctx, consumerId, "clientID-"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+		require.NoError(t, err)
+		providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr))
+		providerKeeper.AppendSlashAck(ctx, consumerId, "slashAck")
+	}
+	// corrupt the validator set of consumer 1
+	ctx.KVStore(keeperParams.StoreKey).Set(providertypes.ConsumerValidatorKey("1", consAddr), []byte("corrupted"))
+
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	for _, consumerId := range []string{"0", "2"} {
+		require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, consumerId), 1)
+		require.Empty(t, providerKeeper.GetSlashAcks(ctx, consumerId))
+		_, found := providerKeeper.GetConsumerFailureHeight(ctx, consumerId)
+		require.False(t, found)
+	}
+	// the state of consumer 1 is not modified
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, "1"))
+	require.Equal(t, []string{"slashAck"}, providerKeeper.GetSlashAcks(ctx, "1"))
+	require.False(t, providerKeeper.IsIncrementalValSetUpdate(ctx, "1"))
+	_, found := providerKeeper.GetConsumerFailureHeight(ctx, "1")
+	require.True(t, found)
+}
+
+// TestQueueVSCPacketsFailedConsumerRecovers tests that the validator set of a consumer chain
+// whose computation failed in an epoch is fully recomputed in the next epoch,
+// i.e., the consumer chain receives the validator updates of the failed epoch
+func TestQueueVSCPacketsFailedConsumerRecovers(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	valA, valB := newBondedStakingValidator(0), newBondedStakingValidator(1)
+	bondedValidators := []stakingtypes.Validator{valA, valB}
+	powers := map[string]int64{valA.OperatorAddress: 10, valB.OperatorAddress: 20}
+	mockMutableBondedValidators(mocks, &bondedValidators, powers)
+	consAddrA, err := valA.GetConsAddr()
+	require.NoError(t, err)
+	consAddrB, err := valB.GetConsAddr()
+	require.NoError(t, err)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(consAddrA))
+	providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(consAddrB))
+
+	// the first epoch fully computes the consumer validator set
+	require.NoError(t, providerKeeper.QueueVSCPackets(ctx))
+	require.True(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+
+	// the power of validator A changes, but computing the consumer validator set fails,
+	// as the consumer validator of validator B is corrupted
+	powers[valA.OperatorAddress] = 15
+	providerKeeper.SetModifiedValidator(ctx, providertypes.NewProviderConsAddress(consAddrA))
+	providerKeeper.SetModifiedValidator(ctx, providertypes.NewProviderConsAddress(consAddrB))
+	store := ctx.KVStore(keeperParams.StoreKey)
+	consumerValB := store.Get(providertypes.ConsumerValidatorKey(CONSUMER_ID, consAddrB))
+	store.Set(providertypes.ConsumerValidatorKey(CONSUMER_ID, consAddrB), []byte("corrupted"))
+	require.NoError(t, providerKeeper.QueueVSCPackets(ctx))
+	_, found := providerKeeper.GetConsumerFailureHeight(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.False(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+	require.Empty(t, providerKeeper.GetAllModifiedValidators(ctx))
+
+	// in the next epoch, no validator is modified, but the consumer validator set is fully recomputed
+	store.Set(providertypes.ConsumerValidatorKey(CONSUMER_ID, consAddrB), consumerValB)
+	require.NoError(t, providerKeeper.QueueVSCPackets(ctx))
+	pending := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 2)
+	require.Len(t, pending[1].ValidatorUpdates, 1)
+	require.Equal(t, int64(15), pending[1].ValidatorUpdates[0].Power)
+
+	consumerValA, found := providerKeeper.GetConsumerValidator(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(consAddrA))
+	require.True(t, found)
+	require.Equal(t, int64(15), consumerValA.Power)
+	require.True(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+}
+
+// benchmarkQueueVSCPackets benchmarks queueing the VSC packets of `numConsumers` opt-in consumer chains
+// validated by `numValidators` validators, out of which `numModified` change their power every epoch
+func benchmarkQueueVSCPackets(b *testing.B, numValidators, numConsumers, numModified int, incremental bool) {
+	b.Helper()
+	keeperParams := testkeeper.NewInMemKeeperParams(b)
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+	providerKeeper := testkeeper.NewInMemProviderKeeper(keeperParams, mocks)
+	ctx := keeperParams.Ctx
+
+	params := providertypes.DefaultParams()
+	params.MaxProviderConsensusValidators = int64(numValidators)
+	providerKeeper.SetParams(ctx, params)
+
+	bondedValidators := make([]stakingtypes.Validator, numValidators)
+	providerAddrs := make([]providertypes.ProviderConsAddress, numValidators)
+	powers := make(map[string]int64, numValidators)
+	for i := range bondedValidators {
+		bondedValidators[i] = newBondedStakingValidator(i)
+		consAddr, err := bondedValidators[i].GetConsAddr()
+		require.NoError(b, err)
+		providerAddrs[i] = providertypes.NewProviderConsAddress(consAddr)
+		powers[bondedValidators[i].OperatorAddress] = int64(i + 1)
+	}
+	mockMutableBondedValidators(mocks, &bondedValidators, powers)
+
+	for c := 0; c < numConsumers; c++ {
+		consumerId := strconv.Itoa(c)
+		providerKeeper.SetConsumerClientId(ctx, consumerId, "clientID-"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+		require.NoError(b, err)
+		for _, providerAddr := range providerAddrs {
+			providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+		}
+	}
+	// compute the initial consumer validator sets
+	require.NoError(b, providerKeeper.QueueVSCPackets(ctx))
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		for i := 0; i < numModified; i++ {
+			idx := (n*numModified + i) % numValidators
+			powers[bondedValidators[idx].OperatorAddress]++
+			providerKeeper.SetModifiedValidator(ctx, providerAddrs[idx])
+		}
+		if !incremental {
+			providerKeeper.DeleteAllIncrementalValSetUpdates(ctx)
+		}
+		b.StartTimer()
+
+		require.NoError(b, providerKeeper.QueueVSCPackets(ctx))
+	}
+}
+
+func BenchmarkQueueVSCPacketsFull(b *testing.B) {
+	benchmarkQueueVSCPackets(b, 200, 20, 10, false)
+}
+
+func BenchmarkQueueVSCPacketsIncremental(b *testing.B) {
+	benchmarkQueueVSCPackets(b, 200, 20, 10, true)
+}
+
+// setupSlashPacketBenchmark sets up a provider keeper backed by real staking and slashing keepers,
+// with `numValidators` bonded validators that validate each of `numConsumers` launched consumer chains.
+// Every consumer chain already has MaxSlashPacketTraces slash packet traces recorded and
+// `numValsetUpdates` valset update ids are mapped to block heights, as on a long running provider chain.
+// It returns the committed context, the staking keepers, the validators, and the channel ids of the consumer chains.
+func setupSlashPacketBenchmark(b *testing.B, numValidators, numConsumers, numValsetUpdates int) (
+	keeper.Keeper, sdk.Context, testkeeper.InMemStakingKeepers, []stakingtypes.Validator, []string,
+) {
+	b.Helper()
+	providerKeeper, ctx, ctrl, _, stakingKeepers := testkeeper.GetProviderKeeperAndCtxWithStakingKeepers(b)
+	b.Cleanup(ctrl.Finish)
+	ctx = ctx.WithBlockHeight(int64(numValsetUpdates) + 1).WithBlockTime(time.Now())
+
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	stakingParams, err := stakingKeepers.StakingKeeper.GetParams(ctx)
+	require.NoError(b, err)
+	stakingParams.MaxValidators = uint32(numValidators)
+	require.NoError(b, stakingKeepers.StakingKeeper.SetParams(ctx, stakingParams))
+
+	validators := make([]stakingtypes.Validator, numValidators)
+	for i := range validators {
+		validators[i] = stakingKeepers.CreateValidator(b, ctx, int64(i+1))
+	}
+	require.Len(b, stakingKeepers.EndBlock(b, ctx), numValidators)
+	// reload the validators as they are bonded now
+	for i := range validators {
+		valAddr, err := providerKeeper.ValidatorAddressCodec().StringToBytes(validators[i].GetOperator())
+		require.NoError(b, err)
+		validator, err := stakingKeepers.StakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(b, err)
+		validators[i] = validator
+	}
+
+	for vscID := 1; vscID <= numValsetUpdates; vscID++ {
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, uint64(vscID), uint64(vscID))
+	}
+	// the slash meter allows to jail every validator
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(int64(numValidators*numValidators)))
+
+	channelIDs := make([]string, numConsumers)
+	for c := range channelIDs {
+		consumerId := strconv.Itoa(c)
+		channelIDs[c] = "channel-" + consumerId
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		providerKeeper.SetChannelToConsumerId(ctx, channelIDs[c], consumerId)
+		require.NoError(b, providerKeeper.SetInfractionParameters(ctx, consumerId, *getTestInfractionParameters()))
+		for _, validator := range validators {
+			consAddr, err := validator.GetConsAddr()
+			require.NoError(b, err)
+			publicKey, err := validator.CmtConsPublicKey()
+			require.NoError(b, err)
+			require.NoError(b, providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+				ProviderConsAddr: consAddr,
+				Power:            validator.ConsensusPower(sdk.DefaultPowerReduction),
+				PublicKey:        &publicKey,
+			}))
+		}
+		for seq := uint64(1); seq <= keeper.MaxSlashPacketTraces; seq++ {
+			providerKeeper.SetSlashPacketTrace(ctx, consumerId, providertypes.SlashPacketTrace{Sequence: seq})
+		}
+	}
+	ctx.MultiStore().(storetypes.CommitMultiStore).Commit()
+
+	return providerKeeper, ctx, stakingKeepers, validators, channelIDs
+}
+
+// newBenchmarkSlashPacketData returns the data of a downtime slash packet for the given validator
+func newBenchmarkSlashPacketData(b *testing.B, validator stakingtypes.Validator, vscID uint64) ccv.SlashPacketData {
+	b.Helper()
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(b, err)
+	return *ccv.NewSlashPacketData(
+		abci.Validator{Address: consAddr, Power: validator.ConsensusPower(sdk.DefaultPowerReduction)},
+		vscID,
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	)
+}
+
+// benchmarkOnRecvSlashPacket benchmarks receiving downtime slash packets from `numConsumers` consumer chains
+// validated by `numValidators` validators. Every packet is received on a cached context of the same committed
+// state, so that every packet results in the validator being slashed and jailed.
+func benchmarkOnRecvSlashPacket(b *testing.B, numValidators, numConsumers, numValsetUpdates int) {
+	b.Helper()
+	providerKeeper, ctx, _, validators, channelIDs := setupSlashPacketBenchmark(b, numValidators, numConsumers, numValsetUpdates)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		cacheCtx, _ := ctx.CacheContext()
+		data := newBenchmarkSlashPacketData(b, validators[n%numValidators], uint64(n%numValsetUpdates+1))
+		dataBz, err := data.Marshal()
+		require.NoError(b, err)
+		packet := channeltypes.NewPacket(dataBz, keeper.MaxSlashPacketTraces+uint64(n)+1, "srcPort", "srcChan",
+			"provider-port", channelIDs[n%numConsumers], clienttypes.Height{}, 1)
+		b.StartTimer()
+
+		ackResult, err := providerKeeper.OnRecvSlashPacket(cacheCtx, packet, data)
+		require.NoError(b, err)
+		require.Equal(b, ccv.SlashPacketHandledResult, ackResult)
+	}
+}
+
+func BenchmarkOnRecvSlashPacket(b *testing.B) {
+	benchmarkOnRecvSlashPacket(b, 200, 20, 10000)
+}
+
+func BenchmarkOnRecvSlashPacketManyConsumers(b *testing.B) {
+	benchmarkOnRecvSlashPacket(b, 200, 100, 10000)
+}
+
+func BenchmarkOnRecvSlashPacketManyValidators(b *testing.B) {
+	benchmarkOnRecvSlashPacket(b, 500, 20, 10000)
+}
+
+// benchmarkHandleSlashPacket benchmarks handling downtime slash packets, i.e., slashing and jailing validators,
+// on a provider chain with `numValidators` validators and `numConsumers` consumer chains
+func benchmarkHandleSlashPacket(b *testing.B, numValidators, numConsumers, numValsetUpdates int) {
+	b.Helper()
+	providerKeeper, ctx, stakingKeepers, validators, _ := setupSlashPacketBenchmark(b, numValidators, numConsumers, numValsetUpdates)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		b.StopTimer()
+		cacheCtx, _ := ctx.CacheContext()
+		validator := validators[n%numValidators]
+		data := newBenchmarkSlashPacketData(b, validator, uint64(n%numValsetUpdates+1))
+		b.StartTimer()
+
+		providerKeeper.HandleSlashPacket(cacheCtx, strconv.Itoa(n%numConsumers), data)
+
+		b.StopTimer()
+		consAddr, err := validator.GetConsAddr()
+		require.NoError(b, err)
+		jailedValidator, err := stakingKeepers.StakingKeeper.GetValidatorByConsAddr(cacheCtx, consAddr)
+		require.NoError(b, err)
+		require.True(b, jailedValidator.IsJailed())
+		b.StartTimer()
+	}
+}
+
+func BenchmarkHandleSlashPacket(b *testing.B) {
+	benchmarkHandleSlashPacket(b, 200, 20, 10000)
+}
+
+func BenchmarkHandleSlashPacketManyValidators(b *testing.B) {
+	benchmarkHandleSlashPacket(b, 500, 20, 10000)
+}
+
 // TestOnRecvDowntimeSlashPacket tests the OnRecvSlashPacket method specifically for downtime slash packets.
 func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -175,9 +641,11 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 
 	// Set slash meter to negative value and assert a bounce ack is returned
 	providerKeeper.SetSlashMeter(ctx, math.NewInt(-5))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, channelId0, 1, packetData)
 	require.Equal(t, ccv.SlashPacketBouncedResult, ackResult)
 	require.NoError(t, err)
+	requireSlashPacketEvent(t, ctx, providertypes.EventTypeSlashPacketBounced, consumerId0, packetData)
 
 	// Set consumer validator
 	err = providerKeeper.SetConsumerValidator(ctx, consumerId1, providertypes.ConsensusValidator{
@@ -198,6 +666,7 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 
 	// Now set slash meter to positive value and assert slash packet handled result is returned
 	providerKeeper.SetSlashMeter(ctx, math.NewInt(5))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	// Set the consumer validator
 	err = providerKeeper.SetConsumerValidator(ctx, consumerId0, providertypes.ConsensusValidator{ProviderConsAddr: packetData.Validator.Address})
@@ -224,13 +693,28 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	)
 	gomock.InOrder(calls...)
 
-	// Execute on recv and confirm slash packet handled result is returned
-	ackResult, err = executeOnRecvSlashPacket(t, &providerKeeper, ctx, channelId0, 1, packetData)
+	// Execute on recv of the retried packet and confirm slash packet handled result is returned
+	ackResult, err = executeOnRecvSlashPacket(t, &providerKeeper, ctx, channelId0, 3, packetData)
 	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
 	require.NoError(t, err)
+	requireSlashPacketEvent(t, ctx, providertypes.EventTypeSlashPacketHandled, consumerId0, packetData)
 
 	// Require slash meter was decremented appropriately, 5-2=3
 	require.Equal(t, int64(3), providerKeeper.GetSlashMeter(ctx).Int64())
+
+	// Require both attempts were traced with the slash meter at the time they were received
+	traces := providerKeeper.GetAllSlashPacketTraces(ctx, consumerId0)
+	require.Len(t, traces, 2)
+	require.Equal(t, uint64(1), traces[0].Sequence)
+	require.Equal(t, providertypes.SLASH_PACKET_OUTCOME_BOUNCED, traces[0].Outcome)
+	require.Equal(t, int64(-5), traces[0].SlashMeter.Int64())
+	require.Equal(t, []byte(ccv.SlashPacketBouncedResult), traces[0].AckResult)
+	require.Equal(t, uint64(3), traces[1].Sequence)
+	require.Equal(t, providertypes.SLASH_PACKET_OUTCOME_HANDLED, traces[1].Outcome)
+	require.Equal(t, int64(5), traces[1].SlashMeter.Int64())
+	require.Equal(t, []byte(ccv.SlashPacketHandledResult), traces[1].AckResult)
+	require.Equal(t, traces, providerKeeper.GetSlashPacketAttempts(ctx, consumerId0, traces[1]))
+	require.Len(t, providerKeeper.GetAllSlashPacketTraces(ctx, consumerId1), 1)
 }
 
 // TestOnRecvDoubleSignSlashPacket tests the OnRecvSlashPacket method specifically for double-sign slash packets.
@@ -254,6 +738,9 @@ func TestOnRecvDoubleSignSlashPacket(t *testing.T) {
 	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", 1, packetData)
 	require.Equal(t, ccv.V1Result, ackResult)
 	require.NoError(t, err)
+	trace, found := providerKeeper.GetSlashPacketTrace(ctx, "chain-1", 1)
+	require.True(t, found)
+	require.Equal(t, providertypes.SLASH_PACKET_OUTCOME_LOGGED, trace.Outcome)
 
 	require.True(t, providerKeeper.GetSlashLog(ctx,
 		providertypes.NewProviderConsAddress(packetData.Validator.Address)))
@@ -263,6 +750,33 @@ func TestOnRecvDoubleSignSlashPacket(t *testing.T) {
 	require.False(t, providerKeeper.GetSlashLog(ctx, randomAddress))
 }
 
+// requireSlashPacketEvent checks that exactly one slash packet event of the given type
+// was emitted for the given consumer chain and packet data
+func requireSlashPacketEvent(t *testing.T, ctx sdk.Context, eventType, consumerId string, packetData ccv.SlashPacketData) {
+	t.Helper()
+	var events []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeSlashPacketHandled || event.Type == providertypes.EventTypeSlashPacketBounced {
+			events = append(events, event)
+		}
+	}
+	require.Len(t, events, 1)
+	require.Equal(t, eventType, events[0].Type)
+
+	providerAddr := providertypes.NewProviderConsAddress(packetData.Validator.Address)
+	expectedAttributes := map[string]string{
+		providertypes.AttributeConsumerId:          consumerId,
+		providertypes.AttributeProviderConsAddress: providerAddr.String(),
+		ccv.AttributeValSetUpdateID:                strconv.FormatUint(packetData.ValsetUpdateId, 10),
+		ccv.AttributeInfractionType:                packetData.Infraction.String(),
+	}
+	for key, value := range expectedAttributes {
+		attr, found := events[0].GetAttribute(key)
+		require.True(t, found, key)
+		require.Equal(t, value, attr.Value, key)
+	}
+}
+
 func executeOnRecvSlashPacket(t *testing.T, providerKeeper *keeper.Keeper, ctx sdk.Context,
 	channelID string, ibcSeqNum uint64, packetData ccv.SlashPacketData,
 ) (ccv.PacketAckResult, error) {
@@ -358,6 +872,7 @@ func TestHandleSlashPacket(t *testing.T) {
 		expectedCalls                       func(sdk.Context, testkeeper.MockedKeepers, ccv.SlashPacketData) []*gomock.Call
 		expectedSlashAcksLen                int
 		expectedSlashAckConsumerConsAddress providertypes.ConsumerConsAddress
+		expectedDowntimeJail                bool
 	}{
 		{
 			"unfound validator",
@@ -380,6 +895,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			0,
 			consumerConsAddr,
+			false,
 		},
 		{
 			"found, but tombstoned validator",
@@ -403,6 +919,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			0,
 			consumerConsAddr,
+			false,
 		},
 		{
 			"drop packet when infraction height not found",
@@ -427,6 +944,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			0,
 			consumerConsAddr,
+			false,
 		},
 		{
 			"full downtime packet handling, uses init chain height and non-jailed validator",
@@ -446,6 +964,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			1,
 			consumerConsAddr,
+			true,
 		},
 		{
 			"full downtime packet handling, uses valid vscID and jailed validator",
@@ -465,6 +984,7 @@ func TestHandleSlashPacket(t *testing.T) {
 			},
 			1,
 			consumerConsAddr,
+			false,
 		},
 		// Note: double-sign slash packet handling should not occur, see OnRecvSlashPacket.
 	}
@@ -503,6 +1023,10 @@ func TestHandleSlashPacket(t *testing.T) {
 				require.NotEqual(t, providerConsAddr.String(), consumerConsAddr.String())
 			}
 
+			// only the validators jailed by the slash packet are recorded
+			_, found := providerKeeper.GetConsumerDowntimeJail(ctx, chainId, providerConsAddr)
+			require.Equal(t, tc.expectedDowntimeJail, found)
+
 			ctrl.Finish()
 		})
 	}
@@ -519,7 +1043,12 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	mockCalls := testkeeper.GetMocksForSetConsumerChain(ctx, &mocks, CONSUMER_ID)
 
 	// Set 3 pending vsc packets
-	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, []ccv.ValidatorSetChangePacketData{{}, {}, {}}...)
+	valUpdates := []abci.ValidatorUpdate{{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 1}}
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID,
+		ccv.NewValidatorSetChangePacketData(valUpdates, 1, nil),
+		ccv.NewValidatorSetChangePacketData(valUpdates, 2, nil),
+		ccv.NewValidatorSetChangePacketData(valUpdates, 3, nil),
+	)
 
 	// append mocks for the channel keeper to return an error
 	mockCalls = append(mockCalls,
@@ -560,6 +1089,191 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
 }
 
+// TestSendVSCPacketsToChainInvalidPacket tests that invalid pending VSC packets are dropped, i.e.,
+// the queue of pending VSC packets does not grow over epochs, and that the consumer validator set is resent
+func TestSendVSCPacketsToChainInvalidPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+	var expResyncKeys []crypto.PublicKey
+	for epoch := 1; epoch <= 3; epoch++ {
+		cId := cryptotestutil.NewCryptoIdentityFromIntSeed(epoch)
+		vscId := uint64(2 * epoch)
+		providerKeeper.SetIncrementalValSetUpdate(ctx, CONSUMER_ID)
+		providerKeeper.AppendSlashAck(ctx, CONSUMER_ID, fmt.Sprintf("ack%d", epoch))
+		providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID,
+			ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: cId.TMProtoCryptoPublicKey(), Power: 0}}, vscId-1, providerKeeper.ConsumeSlashAcks(ctx, CONSUMER_ID)),
+			ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: cId.TMProtoCryptoPublicKey(), Power: -1}}, vscId, nil),
+		)
+		providerKeeper.SetVscConfirmation(ctx, CONSUMER_ID, providertypes.VscConfirmation{ValsetUpdateId: vscId - 1})
+		providerKeeper.SetVscConfirmation(ctx, CONSUMER_ID, providertypes.VscConfirmation{ValsetUpdateId: vscId})
+		expResyncKeys = append(expResyncKeys, cId.TMProtoCryptoPublicKey())
+
+		// no packet is sent, i.e., no calls to the channel keeper are expected
+		err := providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID")
+		require.NoError(t, err)
+		require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+		require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
+		require.Empty(t, providerKeeper.GetAllVscConfirmations(ctx, CONSUMER_ID))
+		require.False(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID))
+		require.Equal(t, []string{fmt.Sprintf("ack%d", epoch)}, providerKeeper.GetSlashAcks(ctx, CONSUMER_ID))
+		providerKeeper.DeleteSlashAcks(ctx, CONSUMER_ID)
+
+		resync, found := providerKeeper.GetConsumerValSetResync(ctx, CONSUMER_ID)
+		require.True(t, found)
+		require.Equal(t, expResyncKeys, resync.PublicKeys)
+
+		events := ctx.EventManager().Events()
+		require.Equal(t, providertypes.EventTypeInvalidVSCPacket, events[len(events)-1].Type)
+	}
+}
+
+// TestConsumerValSetResyncBase tests that diffing against the resync base resends the whole
+// consumer validator set and removes the validators that the consumer chain may still have
+func TestConsumerValSetResyncBase(t *testing.T) {
+	valA := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	valB := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	valC := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	pkA, pkB, pkC := valA.TMProtoCryptoPublicKey(), valB.TMProtoCryptoPublicKey(), valC.TMProtoCryptoPublicKey()
+
+	currentValSet := []providertypes.ConsensusValidator{
+		{ProviderConsAddr: valA.SDKValConsAddress(), Power: 1, PublicKey: &pkA},
+		{ProviderConsAddr: valB.SDKValConsAddress(), Power: 2, PublicKey: &pkB},
+	}
+	// valB is still in the consumer validator set, while valC was removed by a dropped VSC packet
+	resync := providertypes.ConsumerValSetResync{PublicKeys: []crypto.PublicKey{pkB, pkC}}
+
+	base := keeper.ConsumerValSetResyncBase(currentValSet, resync)
+	require.Len(t, base, 3)
+	for _, val := range base {
+		require.Zero(t, val.Power)
+	}
+	// the base does not modify the current validator set
+	require.Equal(t, int64(1), currentValSet[0].Power)
+
+	nextValSet := []providertypes.ConsensusValidator{
+		{ProviderConsAddr: valA.SDKValConsAddress(), Power: 1, PublicKey: &pkA},
+		{ProviderConsAddr: valB.SDKValConsAddress(), Power: 3, PublicKey: &pkB},
+	}
+	updates := keeper.DiffValidators(base, nextValSet)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: pkA, Power: 1},
+		{PubKey: pkB, Power: 3},
+		{PubKey: pkC, Power: 0},
+	}, updates)
+}
+
+// TestSendValsetCheckpoints tests that valset checkpoints are sent only to launched consumer chains
+// with an established CCV channel and without pending VSC packets
+func TestSendValsetCheckpoints(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetValidatorSetUpdateId(ctx, 10)
+
+	valA := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	valB := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	pkA, pkB := valA.TMProtoCryptoPublicKey(), valB.TMProtoCryptoPublicKey()
+	valSet := []providertypes.ConsensusValidator{
+		{ProviderConsAddr: valA.SDKValConsAddress(), Power: 1, PublicKey: &pkA},
+		{ProviderConsAddr: valB.SDKValConsAddress(), Power: 2, PublicKey: &pkB},
+	}
+
+	// consumer "0" is launched and has an established channel
+	// consumer "1" is launched, but has pending VSC packets
+	// consumer "2" is launched, but has no established channel
+	// consumer "3" is not launched
+	for i, consumerId := range []string{"0", "1", "2", "3"} {
+		providerKeeper.SetConsumerClientId(ctx, consumerId, "clientID"+consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		if i < 2 {
+			providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channelID"+consumerId)
+		}
+		err := providerKeeper.SetConsumerValSet(ctx, consumerId, valSet)
+		require.NoError(t, err)
+	}
+	providerKeeper.AppendPendingVSCPackets(ctx, "1", ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 9, nil))
+	providerKeeper.SetConsumerPhase(ctx, "3", providertypes.CONSUMER_PHASE_STOPPED)
+
+	expectedHash, err := providerKeeper.GetConsumerValsetHash(ctx, "0")
+	require.NoError(t, err)
+	expectedData := ccv.NewValsetCheckpointPacketData(9, expectedHash)
+
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID0").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID0", gomock.Any(), gomock.Any(),
+			expectedData.GetBytes()).Return(uint64(1), nil).Times(1),
+	)
+
+	err = providerKeeper.SendValsetCheckpoints(ctx)
+	require.NoError(t, err)
+}
+
+// TestHandleVSCPacketAckResult tests the verification of the validator sets confirmed by consumer chains
+func TestHandleVSCPacketAckResult(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+
+	expectedHash := []byte{0x01, 0x02}
+	vscData := ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 5, nil)
+	packet := channeltypes.Packet{SourceChannel: "channelID", Data: vscData.GetBytes()}
+
+	testCases := []struct {
+		name               string
+		result             []byte
+		expConfirmation    bool
+		expAppliedHash     []byte
+		expMismatchEmitted bool
+	}{
+		{
+			"matching valset hash", ccv.NewValidatorSetChangePacketAck(5, expectedHash).GetBytes(), false, nil, false,
+		},
+		{
+			"mismatching valset hash", ccv.NewValidatorSetChangePacketAck(5, []byte{0x03}).GetBytes(), true, []byte{0x03}, true,
+		},
+		{
+			"legacy result ack", ccv.V1Result, false, nil, false,
+		},
+		{
+			"ack for another vsc id", ccv.NewValidatorSetChangePacketAck(6, []byte{0x03}).GetBytes(), false, nil, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		providerKeeper.SetVscConfirmation(ctx, CONSUMER_ID, providertypes.VscConfirmation{
+			ValsetUpdateId:     5,
+			ExpectedValsetHash: expectedHash,
+		})
+
+		providerKeeper.HandleVSCPacketAckResult(ctx, packet, tc.result)
+
+		confirmation, found := providerKeeper.GetVscConfirmation(ctx, CONSUMER_ID, 5)
+		require.Equal(t, tc.expConfirmation, found, tc.name)
+		require.Equal(t, tc.expAppliedHash, confirmation.AppliedValsetHash, tc.name)
+
+		mismatchEmitted := false
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == providertypes.EventTypeVscConfirmationMismatch {
+				mismatchEmitted = true
+			}
+		}
+		require.Equal(t, tc.expMismatchEmitted, mismatchEmitted, tc.name)
+	}
+
+	// acknowledgements of other packets are ignored
+	providerKeeper.SetVscConfirmation(ctx, CONSUMER_ID, providertypes.VscConfirmation{ValsetUpdateId: 5, ExpectedValsetHash: expectedHash})
+	checkpointPacket := channeltypes.Packet{SourceChannel: "channelID", Data: ccv.NewValsetCheckpointPacketData(5, expectedHash).GetBytes()}
+	providerKeeper.HandleVSCPacketAckResult(ctx, checkpointPacket, ccv.V1Result)
+	_, found := providerKeeper.GetVscConfirmation(ctx, CONSUMER_ID, 5)
+	require.True(t, found)
+}
+
 // TestOnTimeoutPacketWithNoChainFound tests the `OnTimeoutPacket` method fails when no chain is found
 func TestOnTimeoutPacketWithNoChainFound(t *testing.T) {
 	// Keeper setup
@@ -659,6 +1373,68 @@ func TestOnAcknowledgementPacketWithAckError(t *testing.T) {
 	testkeeper.TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t, ctx, providerKeeper, CONSUMER_ID, "channelID", false)
 }
 
+// TestOnAcknowledgementPacketWithAckErrorOfCheckpoint tests that an error acknowledgement
+// of a valset checkpoint packet, e.g., from a consumer chain running an older version, does not stop the chain
+func TestOnAcknowledgementPacketWithAckErrorOfCheckpoint(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+
+	packet := channeltypes.Packet{
+		SourceChannel: "channelID",
+		Data:          ccv.NewValsetCheckpointPacketData(1, []byte{0x01}).GetBytes(),
+	}
+	ackError := channeltypes.Acknowledgement{Response: &channeltypes.Acknowledgement_Error{Error: "some error"}}
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ackError))
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+}
+
+// TestOnAcknowledgementPacketWithAckErrorOfInvalidVSC tests that an error acknowledgement
+// of a VSC packet with invalid validator updates does not remove the consumer chain,
+// while any other error acknowledgement of a VSC packet does
+func TestOnAcknowledgementPacketWithAckErrorOfInvalidVSC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ackErr   error
+		expPhase providertypes.ConsumerPhase
+	}{
+		{"duplicate pubkeys", ccv.ErrDuplicateValidatorPubKey, providertypes.CONSUMER_PHASE_LAUNCHED},
+		{"negative power", ccv.ErrInvalidValidatorPower, providertypes.CONSUMER_PHASE_LAUNCHED},
+		{"too many updates", ccv.ErrTooManyValidatorUpdates, providertypes.CONSUMER_PHASE_LAUNCHED},
+		{"invalid packet data", ccv.ErrInvalidPacketData, providertypes.CONSUMER_PHASE_STOPPED},
+	}
+
+	for _, tc := range testCases {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+		mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+		providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+		providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+
+		packet := channeltypes.Packet{
+			SourceChannel: "channelID",
+			Data:          ccv.NewValidatorSetChangePacketData(nil, 1, nil).GetBytes(),
+		}
+		providerKeeper.SetIncrementalValSetUpdate(ctx, CONSUMER_ID)
+		providerKeeper.SetVscConfirmation(ctx, CONSUMER_ID, providertypes.VscConfirmation{ValsetUpdateId: 1})
+		err := providerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewErrorAcknowledgement(tc.ackErr))
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expPhase, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID), tc.name)
+		if tc.expPhase == providertypes.CONSUMER_PHASE_LAUNCHED {
+			// the consumer validator set is resent at the next epoch
+			_, found := providerKeeper.GetConsumerValSetResync(ctx, CONSUMER_ID)
+			require.True(t, found, tc.name)
+			require.False(t, providerKeeper.IsIncrementalValSetUpdate(ctx, CONSUMER_ID), tc.name)
+			_, found = providerKeeper.GetVscConfirmation(ctx, CONSUMER_ID, 1)
+			require.False(t, found, tc.name)
+		}
+		ctrl.Finish()
+	}
+}
+
 // TestEndBlockVSU tests that during `EndBlockVSU`, we only queue VSC packets at the boundaries of an epoch
 func TestEndBlockVSU(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -692,7 +1468,6 @@ func TestEndBlockVSU(t *testing.T) {
 		return lastValidators[i].GetConsensusPower(sdk.DefaultPowerReduction) >
 			lastValidators[j].GetConsensusPower(sdk.DefaultPowerReduction)
 	})
-	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(lastValidators, nil).AnyTimes()
 
 	// set a sample client for a launched consumer chain so that `GetAllConsumersWithIBCClients` in `QueueVSCPackets` iterates at least once
 	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
@@ -739,7 +1514,7 @@ func TestProviderValidatorUpdates(t *testing.T) {
 		createStakingValidator(ctx, mocks, 20, 2),
 		createStakingValidator(ctx, mocks, 10, 1),
 	}
-	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(ctx).Return(validators, nil).Times(1)
+	testkeeper.MockIterateBondedValidatorsByPower(mocks.MockStakingKeeper, validators).Times(1)
 
 	// set up a validator that we will only use for the last provider consensus validator set
 	removedValidator := createStakingValidator(ctx, mocks, 40, 4)
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetRelayerLiveness returns the last packet received from and the last packet
+// acknowledged by the consumer chain with the given consumer id
+func (k Keeper) GetRelayerLiveness(ctx sdk.Context, consumerId string) (types.RelayerLiveness, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToRelayerLivenessKey(consumerId))
+	if bz == nil {
+		return types.RelayerLiveness{}, false
+	}
+
+	var liveness types.RelayerLiveness
+	if err := liveness.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the relayer liveness is assumed to be correctly serialized in SetRelayerLiveness.
+		panic(fmt.Errorf("failed to unmarshal relayer liveness for consumer id (%s): %w", consumerId, err))
+	}
+	return liveness, true
+}
+
+// SetRelayerLiveness sets the relayer liveness of the consumer chain with the given consumer id
+func (k Keeper) SetRelayerLiveness(ctx sdk.Context, consumerId string, liveness types.RelayerLiveness) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := liveness.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// liveness is assumed to be correctly serialized.
+		panic(fmt.Errorf("failed to marshal relayer liveness for consumer id (%s): %w", consumerId, err))
+	}
+	store.Set(types.ConsumerIdToRelayerLivenessKey(consumerId), bz)
+}
+
+// DeleteRelayerLiveness deletes the relayer liveness of the consumer chain with the given consumer id
+func (k Keeper) DeleteRelayerLiveness(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToRelayerLivenessKey(consumerId))
+}
+
+// RecordPacketReceived records that a packet from a consumer chain was received in the current block.
+// Note that the state changes of OnRecvPacket are discarded on error acknowledgements,
+// hence the packets resulting in error acknowledgements are recorded in StoreTxPacketErrors.
+func (k Keeper) RecordPacketReceived(ctx sdk.Context, packet channeltypes.Packet) {
+	if consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel); found {
+		k.recordRelayerPacketReceived(ctx, consumerId)
+	}
+}
+
+func (k Keeper) recordRelayerPacketReceived(ctx sdk.Context, consumerId string) {
+	liveness, _ := k.GetRelayerLiveness(ctx, consumerId)
+	liveness.LastRecvHeight = ctx.BlockHeight()
+	liveness.LastRecvTime = ctx.BlockTime()
+	k.SetRelayerLiveness(ctx, consumerId, liveness)
+}
+
+// recordRelayerPacketAck records the acknowledgement of a packet by a consumer chain
+func (k Keeper) recordRelayerPacketAck(ctx sdk.Context, consumerId string, sequence uint64) {
+	liveness, _ := k.GetRelayerLiveness(ctx, consumerId)
+	liveness.LastAckHeight = ctx.BlockHeight()
+	liveness.LastAckTime = ctx.BlockTime()
+	liveness.LastAckSequence = sequence
+	k.SetRelayerLiveness(ctx, consumerId, liveness)
+}
+
+// GetPendingVSCPacketCount returns the number of VSC packets sent to the consumer chain
+// with the given consumer id that are pending acknowledgement. As the CCV channel is ordered,
+// these are the packets sent after the last acknowledged packet.
+func (k Keeper) GetPendingVSCPacketCount(ctx sdk.Context, consumerId string) uint64 {
+	channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+	if !found {
+		return 0
+	}
+	nextSequence, found := k.channelKeeper.GetNextSequenceSend(ctx, k.portID, channelId)
+	if !found || nextSequence == 0 {
+		return 0
+	}
+
+	liveness, _ := k.GetRelayerLiveness(ctx, consumerId)
+	if lastSent := nextSequence - 1; lastSent > liveness.LastAckSequence {
+		return lastSent - liveness.LastAckSequence
+	}
+	return 0
+}
+
+// GetRelayerStaleness returns the duration since the last packet received from or acknowledged by
+// the consumer chain with the given consumer id, and whether the relayers of the consumer chain are stale,
+// i.e., whether the staleness exceeds the relayer staleness threshold while VSC packets are pending acknowledgement.
+// The staleness is only tracked once a first packet was received from or acknowledged by the consumer chain.
+func (k Keeper) GetRelayerStaleness(ctx sdk.Context, consumerId string, pendingVSCPackets uint64) (staleness time.Duration, stale bool) {
+	liveness, found := k.GetRelayerLiveness(ctx, consumerId)
+	if !found {
+		return 0, false
+	}
+
+	lastActivity := liveness.LastRecvTime
+	if liveness.LastAckTime.After(lastActivity) {
+		lastActivity = liveness.LastAckTime
+	}
+	staleness = ctx.BlockTime().Sub(lastActivity)
+
+	threshold := k.GetRelayerStalenessThreshold(ctx)
+	return staleness, threshold > 0 && pendingVSCPackets > 0 && staleness > threshold
+}
+
+// EndBlockRelayerLiveness emits a warning event for every launched consumer chain with stale relayers,
+// giving early warning before the CCV channel times out. It is called at the boundaries of an epoch,
+// before the VSC packets of the epoch are sent, i.e., the pending VSC packets were sent at least an epoch ago.
+func (k Keeper) EndBlockRelayerLiveness(ctx sdk.Context) {
+	if k.BlocksUntilNextEpoch(ctx) != 0 || k.GetRelayerStalenessThreshold(ctx) == 0 {
+		return
+	}
+
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+
+		pendingVSCPackets := k.GetPendingVSCPacketCount(ctx, consumerId)
+		staleness, stale := k.GetRelayerStaleness(ctx, consumerId, pendingVSCPackets)
+		if !stale {
+			continue
+		}
+
+		liveness, _ := k.GetRelayerLiveness(ctx, consumerId)
+		k.Logger(ctx).Error("relayers of consumer chain are stale",
+			"consumerId", consumerId,
+			"staleness", staleness,
+			"pendingVSCPackets", pendingVSCPackets,
+			"lastRecvHeight", liveness.LastRecvHeight,
+			"lastAckHeight", liveness.LastAckHeight,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRelayerLivenessWarning,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeRelayerStaleness, staleness.String()),
+				sdk.NewAttribute(types.AttributePendingVSCPackets, strconv.FormatUint(pendingVSCPackets, 10)),
+				sdk.NewAttribute(types.AttributeLastRecvHeight, strconv.FormatInt(liveness.LastRecvHeight, 10)),
+				sdk.NewAttribute(types.AttributeLastAckHeight, strconv.FormatInt(liveness.LastAckHeight, 10)),
+			),
+		)
+	}
+}
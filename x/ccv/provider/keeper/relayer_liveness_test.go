@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestRelayerLiveness tests that the received and acknowledged packets are recorded
+func TestRelayerLiveness(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	// packets received on unknown channels are ignored
+	providerKeeper.RecordPacketReceived(ctx, channeltypes.Packet{DestinationChannel: "channel-0"})
+	_, found = providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", CONSUMER_ID)
+	recvTime := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(recvTime)
+	providerKeeper.RecordPacketReceived(ctx, channeltypes.Packet{DestinationChannel: "channel-0"})
+
+	ackTime := recvTime.Add(time.Hour)
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(ackTime)
+	vscPacketData := ccv.NewValidatorSetChangePacketData(nil, 1, nil)
+	err := providerKeeper.OnAcknowledgementPacket(ctx,
+		channeltypes.Packet{Sequence: 3, SourceChannel: "channel-0", Data: vscPacketData.GetBytes()},
+		channeltypes.NewResultAcknowledgement([]byte{1}),
+	)
+	require.NoError(t, err)
+
+	liveness, found := providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, providertypes.RelayerLiveness{
+		LastRecvHeight:  10,
+		LastRecvTime:    recvTime,
+		LastAckHeight:   20,
+		LastAckTime:     ackTime,
+		LastAckSequence: 3,
+	}, liveness)
+
+	// the acknowledgements of other packets are recorded as well, including error acknowledgements
+	ctx = ctx.WithBlockHeight(21)
+	upgradePacketData := ccv.NewConsumerUpgradePacketData(ccv.ConsumerUpgradePlan{Name: "v2", HaltHeight: 100}, false)
+	err = providerKeeper.OnAcknowledgementPacket(ctx,
+		channeltypes.Packet{Sequence: 4, SourceChannel: "channel-0", Data: upgradePacketData.GetBytes()},
+		channeltypes.NewResultAcknowledgement([]byte{1}),
+	)
+	require.NoError(t, err)
+	liveness, _ = providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.Equal(t, int64(21), liveness.LastAckHeight)
+	require.Equal(t, uint64(4), liveness.LastAckSequence)
+
+	ctx = ctx.WithBlockHeight(22)
+	errAck := channeltypes.NewErrorAcknowledgement(ccv.ErrInvalidPacketData)
+	err = providerKeeper.OnAcknowledgementPacket(ctx,
+		channeltypes.Packet{Sequence: 5, SourceChannel: "channel-0", Data: upgradePacketData.GetBytes()},
+		errAck,
+	)
+	require.NoError(t, err)
+	liveness, _ = providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.Equal(t, int64(22), liveness.LastAckHeight)
+	require.Equal(t, uint64(5), liveness.LastAckSequence)
+
+	// packets resulting in error acknowledgements are recorded once their transaction succeeds
+	txCtx := keeper.WithTxPacketErrors(ctx.WithBlockHeight(30).WithExecMode(sdk.ExecModeFinalize))
+	providerKeeper.RecordPacketError(txCtx, channeltypes.Packet{DestinationChannel: "channel-0"}, ccv.ErrInvalidPacketData)
+	providerKeeper.StoreTxPacketErrors(txCtx)
+	liveness, _ = providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.Equal(t, int64(30), liveness.LastRecvHeight)
+
+	providerKeeper.DeleteRelayerLiveness(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetRelayerLiveness(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
+// TestEndBlockRelayerLiveness tests that a warning event is emitted at the boundaries of an epoch
+// if VSC packets are pending acknowledgement and the staleness exceeds the threshold
+func TestEndBlockRelayerLiveness(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.RelayerStalenessThreshold = time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientId")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channel-0")
+
+	lastAckTime := time.Unix(1000, 0).UTC()
+	providerKeeper.SetRelayerLiveness(ctx, CONSUMER_ID, providertypes.RelayerLiveness{
+		LastAckHeight:   5,
+		LastAckTime:     lastAckTime,
+		LastAckSequence: 3,
+	})
+
+	testCases := []struct {
+		name         string
+		height       int64
+		blockTime    time.Time
+		nextSequence uint64
+		expStale     bool
+		expEvent     bool
+	}{
+		{"not stale", 20, lastAckTime.Add(time.Hour), 6, false, false},
+		{"no pending VSC packets", 20, lastAckTime.Add(3 * time.Hour), 4, false, false},
+		{"stale", 20, lastAckTime.Add(2 * time.Hour), 6, true, true},
+		{"stale, but not at the boundary of an epoch", 21, lastAckTime.Add(2 * time.Hour), 6, true, false},
+	}
+
+	for _, tc := range testCases {
+		ctx := ctx.WithBlockHeight(tc.height).WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(ctx, ccv.ProviderPortID, "channel-0").
+			Return(tc.nextSequence, true).AnyTimes()
+
+		res, err := providerKeeper.QueryConsumerRelayerLiveness(ctx, &providertypes.QueryConsumerRelayerLivenessRequest{ConsumerId: CONSUMER_ID})
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.nextSequence-4, res.PendingVscPackets, tc.name)
+		require.Equal(t, tc.blockTime.Sub(lastAckTime), res.Staleness, tc.name)
+		require.Equal(t, tc.expStale, res.Stale, tc.name)
+
+		providerKeeper.EndBlockRelayerLiveness(ctx)
+		events := ctx.EventManager().Events()
+		if !tc.expEvent {
+			require.Empty(t, events, tc.name)
+			continue
+		}
+		require.Len(t, events, 1, tc.name)
+		require.Equal(t, providertypes.EventTypeRelayerLivenessWarning, events[0].Type)
+		attr, found := events[0].GetAttribute(providertypes.AttributePendingVSCPackets)
+		require.True(t, found)
+		require.Equal(t, "2", attr.Value)
+	}
+
+	_, err := providerKeeper.QueryConsumerRelayerLiveness(ctx, &providertypes.QueryConsumerRelayerLivenessRequest{ConsumerId: "1"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerRelayerLiveness(ctx, &providertypes.QueryConsumerRelayerLivenessRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+	_, err = providerKeeper.QueryConsumerRelayerLiveness(ctx, nil)
+	require.Error(t, err)
+}
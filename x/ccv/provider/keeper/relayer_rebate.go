@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetRelayerRebateCount returns the number of relayed CCV packets of the given consumer chain
+// rebated in the current epoch
+func (k Keeper) GetRelayerRebateCount(ctx sdk.Context, consumerId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RelayerRebateCountKey(consumerId))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetRelayerRebateCount sets the number of relayed CCV packets of the given consumer chain
+// rebated in the current epoch
+func (k Keeper) SetRelayerRebateCount(ctx sdk.Context, consumerId string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RelayerRebateCountKey(consumerId), sdk.Uint64ToBigEndian(count))
+}
+
+// DeleteAllRelayerRebateCounts deletes the numbers of rebated packets of all the consumer chains,
+// i.e., it resets the per-epoch rebate caps
+func (k Keeper) DeleteAllRelayerRebateCounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.RelayerRebateCountKeyPrefix())
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetRelayerRebatePool returns the balance of the relayer rebate pool
+func (k Keeper) GetRelayerRebatePool(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(
+		ctx,
+		k.accountKeeper.GetModuleAccount(ctx, types.RelayerRebatePool).GetAddress(),
+	)
+}
+
+// FundRelayerRebatePool sends the RelayerRebateFraction of the given consumer rewards in the denom
+// of the relayer rebates from the consumer rewards pool to the relayer rebate pool.
+// It returns the remaining rewards.
+func (k Keeper) FundRelayerRebatePool(ctx sdk.Context, rewards sdk.DecCoins) (sdk.DecCoins, error) {
+	fraction := k.GetRelayerRebateFraction(ctx)
+	denom := k.GetRelayerRebatePerPacket(ctx).Denom
+	if fraction.IsZero() || denom == "" {
+		return rewards, nil
+	}
+
+	amount := rewards.AmountOf(denom).Mul(fraction).TruncateInt()
+	if !amount.IsPositive() {
+		return rewards, nil
+	}
+
+	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ConsumerRewardsPool, types.RelayerRebatePool, funds); err != nil {
+		return nil, fmt.Errorf("cannot fund relayer rebate pool: %w", err)
+	}
+	return rewards.Sub(sdk.NewDecCoinsFromCoins(funds...)), nil
+}
+
+// RebateRelayer pays the RelayerRebatePerPacket from the relayer rebate pool to the relayer
+// of a CCV packet of the given consumer chain successfully relayed to the provider chain, i.e.,
+// a consumer packet successfully handled or an acknowledgement of a provider packet.
+// The rebates are capped by MaxRelayerRebatesPerEpoch per consumer chain and per epoch,
+// regardless of the relayers, so that the cap cannot be bypassed by relaying from many accounts.
+// Failing to rebate the relayer, e.g., because the pool is depleted, does not affect the relayed packet.
+func (k Keeper) RebateRelayer(ctx sdk.Context, consumerId string, relayer sdk.AccAddress) {
+	rebate := k.GetRelayerRebatePerPacket(ctx)
+	if relayer.Empty() || !rebate.IsPositive() {
+		return
+	}
+
+	count := k.GetRelayerRebateCount(ctx, consumerId)
+	if count >= uint64(k.GetMaxRelayerRebatesPerEpoch(ctx)) {
+		return
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAccount(ctx, types.RelayerRebatePool).GetAddress()
+	if k.bankKeeper.GetBalance(ctx, poolAddr, rebate.Denom).IsLT(rebate) {
+		k.Logger(ctx).Debug("relayer rebate pool is depleted", "consumerId", consumerId, "relayer", relayer.String())
+		return
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RelayerRebatePool, relayer, sdk.NewCoins(rebate)); err != nil {
+		k.Logger(ctx).Error("cannot rebate relayer",
+			"consumerId", consumerId,
+			"relayer", relayer.String(),
+			"error", err.Error(),
+		)
+		return
+	}
+	k.SetRelayerRebateCount(ctx, consumerId, count+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRelayerRebate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeRelayerAddress, relayer.String()),
+			sdk.NewAttribute(types.AttributeRebateAmount, rebate.String()),
+		),
+	)
+}
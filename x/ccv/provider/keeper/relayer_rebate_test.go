@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestRelayerRebateCounts tests the setter, getter and reset of the relayer rebate counts
+func TestRelayerRebateCounts(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Zero(t, providerKeeper.GetRelayerRebateCount(ctx, "0"))
+
+	providerKeeper.SetRelayerRebateCount(ctx, "0", 3)
+	providerKeeper.SetRelayerRebateCount(ctx, "1", 5)
+	require.Equal(t, uint64(3), providerKeeper.GetRelayerRebateCount(ctx, "0"))
+	require.Equal(t, uint64(5), providerKeeper.GetRelayerRebateCount(ctx, "1"))
+
+	providerKeeper.DeleteAllRelayerRebateCounts(ctx)
+	require.Zero(t, providerKeeper.GetRelayerRebateCount(ctx, "0"))
+	require.Zero(t, providerKeeper.GetRelayerRebateCount(ctx, "1"))
+}
+
+// TestRebateRelayer tests that relayers are rebated from the relayer rebate pool
+// up to MaxRelayerRebatesPerEpoch times per consumer chain and per epoch
+func TestRebateRelayer(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	rebate := sdk.NewInt64Coin("stake", 1000)
+	params := providertypes.DefaultParams()
+	params.RelayerRebatePerPacket = rebate
+	params.MaxRelayerRebatesPerEpoch = 2
+	providerKeeper.SetParams(ctx, params)
+
+	relayer := sdk.AccAddress([]byte("relayer"))
+	otherRelayer := sdk.AccAddress([]byte("otherRelayer"))
+	poolAcc := authtypes.NewEmptyModuleAccount(providertypes.RelayerRebatePool)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.RelayerRebatePool).Return(poolAcc).AnyTimes()
+
+	// the pool is depleted, the relayer is not rebated
+	mocks.MockBankKeeper.EXPECT().GetBalance(ctx, poolAcc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 999))
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, relayer)
+	require.Zero(t, providerKeeper.GetRelayerRebateCount(ctx, CONSUMER_ID))
+
+	// sending the rebate fails, the relayer is not rebated
+	mocks.MockBankKeeper.EXPECT().GetBalance(ctx, poolAcc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 10000))
+	mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, providertypes.RelayerRebatePool, relayer, sdk.NewCoins(rebate)).
+		Return(errors.New("send failed"))
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, relayer)
+	require.Zero(t, providerKeeper.GetRelayerRebateCount(ctx, CONSUMER_ID))
+
+	// two relayers of the consumer chain are rebated
+	mocks.MockBankKeeper.EXPECT().GetBalance(ctx, poolAcc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 10000)).Times(2)
+	mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, providertypes.RelayerRebatePool, relayer, sdk.NewCoins(rebate)).
+		Return(nil)
+	mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, providertypes.RelayerRebatePool, otherRelayer, sdk.NewCoins(rebate)).
+		Return(nil)
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, relayer)
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, otherRelayer)
+	require.Equal(t, uint64(2), providerKeeper.GetRelayerRebateCount(ctx, CONSUMER_ID))
+
+	// the cap of the consumer chain is reached, none of its relayers is rebated anymore in this epoch,
+	// including relayers that were not rebated yet
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, relayer)
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, sdk.AccAddress([]byte("newRelayer")))
+	require.Equal(t, uint64(2), providerKeeper.GetRelayerRebateCount(ctx, CONSUMER_ID))
+
+	// the relayers of other consumer chains are still rebated
+	mocks.MockBankKeeper.EXPECT().GetBalance(ctx, poolAcc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 10000))
+	mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, providertypes.RelayerRebatePool, relayer, sdk.NewCoins(rebate)).
+		Return(nil)
+	providerKeeper.RebateRelayer(ctx, "1", relayer)
+	require.Equal(t, uint64(1), providerKeeper.GetRelayerRebateCount(ctx, "1"))
+
+	// a zero rebate is never paid
+	params.RelayerRebatePerPacket = sdk.NewInt64Coin("stake", 0)
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.DeleteAllRelayerRebateCounts(ctx)
+	providerKeeper.RebateRelayer(ctx, CONSUMER_ID, relayer)
+	require.Zero(t, providerKeeper.GetRelayerRebateCount(ctx, CONSUMER_ID))
+}
+
+// TestFundRelayerRebatePool tests that a fraction of the consumer rewards in the denom
+// of the relayer rebates is sent to the relayer rebate pool
+func TestFundRelayerRebatePool(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	rewards := sdk.NewDecCoins(
+		sdk.NewDecCoin("stake", math.NewInt(1005)),
+		sdk.NewDecCoin("uatom", math.NewInt(500)),
+	)
+
+	// the default fraction is zero, the rewards are unchanged
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	remaining, err := providerKeeper.FundRelayerRebatePool(ctx, rewards)
+	require.NoError(t, err)
+	require.Equal(t, rewards, remaining)
+
+	params := providertypes.DefaultParams()
+	params.RelayerRebateFraction = "0.1"
+	params.RelayerRebatePerPacket = sdk.NewInt64Coin("stake", 10)
+	providerKeeper.SetParams(ctx, params)
+
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, providertypes.ConsumerRewardsPool,
+			providertypes.RelayerRebatePool, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(nil),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, providertypes.ConsumerRewardsPool,
+			providertypes.RelayerRebatePool, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))).Return(errors.New("send failed")),
+	)
+
+	remaining, err = providerKeeper.FundRelayerRebatePool(ctx, rewards)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(
+		sdk.NewDecCoin("stake", math.NewInt(905)),
+		sdk.NewDecCoin("uatom", math.NewInt(500)),
+	), remaining)
+
+	_, err = providerKeeper.FundRelayerRebatePool(ctx, rewards)
+	require.Error(t, err)
+}
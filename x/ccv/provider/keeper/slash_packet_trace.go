@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// MaxSlashPacketTraces is the maximum number of slash packet traces kept in the store
+// per consumer chain; when exceeded, the traces of the oldest slash packets are pruned
+const MaxSlashPacketTraces = 100
+
+// SetSlashPacketTrace sets the trace of a slash packet received from a consumer chain
+func (k Keeper) SetSlashPacketTrace(ctx sdk.Context, consumerId string, trace types.SlashPacketTrace) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := trace.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// trace is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal slash packet trace: %w", err))
+	}
+	key := types.ConsumerIdToSlashPacketTraceKey(consumerId, trace.Sequence)
+	if !store.Has(key) {
+		k.setSlashPacketTraceCount(ctx, consumerId, k.getSlashPacketTraceCount(ctx, consumerId)+1)
+	}
+	store.Set(key, bz)
+}
+
+// getSlashPacketTraceCount returns the number of slash packet traces stored for a consumer chain
+func (k Keeper) getSlashPacketTraceCount(ctx sdk.Context, consumerId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToSlashPacketTraceCountKey(consumerId))
+	if bz != nil {
+		return binary.BigEndian.Uint64(bz)
+	}
+
+	// the count is not stored if there are no traces or if the traces
+	// were recorded before the count was stored, so the traces are counted
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToSlashPacketTraceKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// setSlashPacketTraceCount sets the number of slash packet traces stored for a consumer chain
+func (k Keeper) setSlashPacketTraceCount(ctx sdk.Context, consumerId string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if count == 0 {
+		store.Delete(types.ConsumerIdToSlashPacketTraceCountKey(consumerId))
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.ConsumerIdToSlashPacketTraceCountKey(consumerId), bz)
+}
+
+// GetSlashPacketTrace returns the trace of the slash packet with the given sequence received from a consumer chain
+func (k Keeper) GetSlashPacketTrace(ctx sdk.Context, consumerId string, sequence uint64) (types.SlashPacketTrace, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToSlashPacketTraceKey(consumerId, sequence))
+	if bz == nil {
+		return types.SlashPacketTrace{}, false
+	}
+	var trace types.SlashPacketTrace
+	if err := trace.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the trace is assumed to be correctly serialized in SetSlashPacketTrace.
+		panic(fmt.Errorf("cannot unmarshal slash packet trace: %w", err))
+	}
+	return trace, true
+}
+
+// GetAllSlashPacketTraces returns the traces of the slash packets received from a consumer chain ordered by sequence
+func (k Keeper) GetAllSlashPacketTraces(ctx sdk.Context, consumerId string) (traces []types.SlashPacketTrace) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToSlashPacketTraceKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var trace types.SlashPacketTrace
+		if err := trace.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the trace is assumed to be correctly serialized in SetSlashPacketTrace.
+			panic(fmt.Errorf("cannot unmarshal slash packet trace: %w", err))
+		}
+		traces = append(traces, trace)
+	}
+	return traces
+}
+
+// GetSlashPacketAttempts returns the traces of all the slash packets received from a consumer chain
+// with the same data as the given trace, i.e., every attempt of the consumer chain to send the slash packet
+func (k Keeper) GetSlashPacketAttempts(ctx sdk.Context, consumerId string, trace types.SlashPacketTrace) (attempts []types.SlashPacketTrace) {
+	for _, t := range k.GetAllSlashPacketTraces(ctx, consumerId) {
+		if bytes.Equal(t.ConsumerConsAddr, trace.ConsumerConsAddr) &&
+			t.ValsetUpdateId == trace.ValsetUpdateId &&
+			t.Infraction == trace.Infraction {
+			attempts = append(attempts, t)
+		}
+	}
+	return attempts
+}
+
+// DeleteSlashPacketTraces deletes the traces of all the slash packets received from a consumer chain
+func (k Keeper) DeleteSlashPacketTraces(ctx sdk.Context, consumerId string) {
+	k.pruneSlashPacketTraces(ctx, consumerId, 0)
+}
+
+// recordSlashPacketTrace records how a slash packet received from a consumer chain was handled
+// and prunes the oldest traces exceeding MaxSlashPacketTraces
+func (k Keeper) recordSlashPacketTrace(
+	ctx sdk.Context,
+	consumerId string,
+	packet channeltypes.Packet,
+	data ccv.SlashPacketData,
+	providerConsAddr types.ProviderConsAddress,
+	slashMeter math.Int,
+	outcome types.SlashPacketOutcome,
+	ackResult ccv.PacketAckResult,
+) {
+	k.SetSlashPacketTrace(ctx, consumerId, types.SlashPacketTrace{
+		Sequence:         packet.Sequence,
+		ConsumerConsAddr: data.Validator.Address,
+		ProviderConsAddr: providerConsAddr.ToSdkConsAddr(),
+		ValsetUpdateId:   data.ValsetUpdateId,
+		Infraction:       data.Infraction,
+		TimeoutTimestamp: packet.TimeoutTimestamp,
+		RecvHeight:       ctx.BlockHeight(),
+		RecvTime:         ctx.BlockTime(),
+		SlashMeter:       slashMeter,
+		Outcome:          outcome,
+		AckResult:        ackResult,
+	})
+	k.pruneSlashPacketTraces(ctx, consumerId, MaxSlashPacketTraces)
+}
+
+// pruneSlashPacketTraces deletes the traces of the oldest slash packets received from a consumer chain,
+// so that at most the given number of traces are retained.
+// As it is called for every received slash packet, only the traces to be deleted are iterated over.
+func (k Keeper) pruneSlashPacketTraces(ctx sdk.Context, consumerId string, retained int) {
+	count := k.getSlashPacketTraceCount(ctx, consumerId)
+	if count <= uint64(retained) {
+		return
+	}
+	pruned := count - uint64(retained)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToSlashPacketTraceKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < pruned; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setSlashPacketTraceCount(ctx, consumerId, count-uint64(len(keys)))
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestSlashPacketTracePruning tests that only the last MaxSlashPacketTraces traces are retained per consumer chain
+func TestSlashPacketTracePruning(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-1", CONSUMER_ID)
+
+	// double-signing slash packets are traced without requiring any mocks
+	packetData := testkeeper.GetNewSlashPacketData()
+	packetData.Infraction = stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, packetData.ValsetUpdateId, uint64(15))
+	for seq := uint64(1); seq <= keeper.MaxSlashPacketTraces+5; seq++ {
+		_, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", seq, packetData)
+		require.NoError(t, err)
+	}
+
+	traces := providerKeeper.GetAllSlashPacketTraces(ctx, CONSUMER_ID)
+	require.Len(t, traces, keeper.MaxSlashPacketTraces)
+	require.Equal(t, uint64(6), traces[0].Sequence)
+	_, found := providerKeeper.GetSlashPacketTrace(ctx, CONSUMER_ID, 5)
+	require.False(t, found)
+	require.Len(t, providerKeeper.GetSlashPacketAttempts(ctx, CONSUMER_ID, traces[0]), keeper.MaxSlashPacketTraces)
+
+	providerKeeper.DeleteSlashPacketTraces(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllSlashPacketTraces(ctx, CONSUMER_ID))
+
+	// overwriting a trace does not count as an additional trace
+	providerKeeper.SetSlashPacketTrace(ctx, CONSUMER_ID, providertypes.SlashPacketTrace{Sequence: 1})
+	for seq := uint64(1); seq <= keeper.MaxSlashPacketTraces; seq++ {
+		_, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", seq, packetData)
+		require.NoError(t, err)
+	}
+	traces = providerKeeper.GetAllSlashPacketTraces(ctx, CONSUMER_ID)
+	require.Len(t, traces, keeper.MaxSlashPacketTraces)
+	require.Equal(t, uint64(1), traces[0].Sequence)
+}
+
+// TestSlashPacketTracePruningWithoutCount tests that the traces recorded
+// before the number of traces was stored are pruned
+func TestSlashPacketTracePruningWithoutCount(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-1", CONSUMER_ID)
+
+	// store the traces without their count
+	store := ctx.KVStore(keeperParams.StoreKey)
+	for seq := uint64(1); seq <= keeper.MaxSlashPacketTraces+3; seq++ {
+		bz, err := (&providertypes.SlashPacketTrace{Sequence: seq}).Marshal()
+		require.NoError(t, err)
+		store.Set(providertypes.ConsumerIdToSlashPacketTraceKey(CONSUMER_ID, seq), bz)
+	}
+
+	packetData := testkeeper.GetNewSlashPacketData()
+	packetData.Infraction = stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, packetData.ValsetUpdateId, uint64(15))
+	_, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", keeper.MaxSlashPacketTraces+4, packetData)
+	require.NoError(t, err)
+
+	traces := providerKeeper.GetAllSlashPacketTraces(ctx, CONSUMER_ID)
+	require.Len(t, traces, keeper.MaxSlashPacketTraces)
+	require.Equal(t, uint64(5), traces[0].Sequence)
+	require.True(t, store.Has(providertypes.ConsumerIdToSlashPacketTraceCountKey(CONSUMER_ID)))
+
+	providerKeeper.DeleteSlashPacketTraces(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllSlashPacketTraces(ctx, CONSUMER_ID))
+	require.False(t, store.Has(providertypes.ConsumerIdToSlashPacketTraceCountKey(CONSUMER_ID)))
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// vscAckTimes holds the block time of the last VSC packet acknowledgement received
+// from every consumer chain. It is not part of the state, i.e., it is empty after
+// a node restart until the consumer chains acknowledge new VSC packets.
+type vscAckTimes struct {
+	mu    sync.Mutex
+	times map[string]time.Time
+}
+
+func newVSCAckTimes() *vscAckTimes {
+	return &vscAckTimes{times: map[string]time.Time{}}
+}
+
+func (t *vscAckTimes) set(consumerId string, ackTime time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.times[consumerId] = ackTime
+}
+
+func (t *vscAckTimes) get(consumerId string) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ackTime, found := t.times[consumerId]
+	return ackTime, found
+}
+
+// recordVSCPacketAck records the acknowledgement of a VSC packet by a consumer chain
+func (k Keeper) recordVSCPacketAck(ctx sdk.Context, consumerId string) {
+	// acknowledgements are also executed in CheckTx by the IBC ante handler
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		return
+	}
+	ccv.IncrConsumerCounter(types.ModuleName, ccv.MetricKeyVSCPacketsAcked, consumerId, 1)
+	k.lastVSCAckTimes.set(consumerId, ctx.BlockTime())
+}
+
+// EndBlockTelemetry sets the provider telemetry gauges, i.e.,
+// the slash meter value and, for every launched consumer chain,
+// the number of seconds since the last VSC packet acknowledgement
+func (k Keeper) EndBlockTelemetry(ctx sdk.Context) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	if meter := k.GetSlashMeter(ctx); meter.IsInt64() {
+		telemetry.SetGauge(float32(meter.Int64()), types.ModuleName, ccv.MetricKeySlashMeter)
+	}
+
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		if ackTime, found := k.lastVSCAckTimes.get(consumerId); found {
+			ccv.SetConsumerGauge(types.ModuleName, ccv.MetricKeySecondsSinceLastVSCAck, consumerId,
+				float32(ctx.BlockTime().Sub(ackTime).Seconds()))
+		}
+	}
+}
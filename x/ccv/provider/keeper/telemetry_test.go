@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// telemetryMetrics is the subset of the in-memory telemetry sink output used in tests
+type telemetryMetrics struct {
+	Gauges []struct {
+		Name   string
+		Value  float32
+		Labels map[string]string
+	}
+	Counters []struct {
+		Name   string
+		Count  int
+		Labels map[string]string
+	}
+}
+
+func TestEndBlockTelemetry(t *testing.T) {
+	m, err := telemetry.New(telemetry.Config{Enabled: true, ServiceName: "test"})
+	require.NoError(t, err)
+
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(100))
+
+	vscData := ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 5, nil)
+	packet := channeltypes.Packet{SourceChannel: "channelID", Data: vscData.GetBytes()}
+	ackTime := time.Now().UTC()
+
+	// the ack is recorded in DeliverTx, but not in CheckTx
+	providerKeeper.HandleVSCPacketAckResult(ctx.WithBlockTime(ackTime), packet, ccv.V1Result)
+	providerKeeper.HandleVSCPacketAckResult(ctx.WithBlockTime(ackTime.Add(5*time.Second)).WithIsCheckTx(true), packet, ccv.V1Result)
+
+	providerKeeper.EndBlockTelemetry(ctx.WithBlockTime(ackTime.Add(10 * time.Second)))
+
+	res, err := m.Gather(telemetry.FormatDefault)
+	require.NoError(t, err)
+	var metrics telemetryMetrics
+	require.NoError(t, json.Unmarshal(res.Metrics, &metrics))
+
+	gauges := map[string]float32{}
+	for _, gauge := range metrics.Gauges {
+		gauges[gauge.Name+"/"+gauge.Labels[ccv.MetricLabelConsumerId]] = gauge.Value
+	}
+	require.Equal(t, float32(100), gauges["test.provider.slash_meter/"])
+	require.Equal(t, float32(10), gauges["test.provider.seconds_since_last_vsc_ack/"+CONSUMER_ID])
+
+	counters := map[string]int{}
+	for _, counter := range metrics.Counters {
+		counters[counter.Name+"/"+counter.Labels[ccv.MetricLabelConsumerId]] = counter.Count
+	}
+	require.Equal(t, 1, counters["test.provider.vsc_packets_acked/"+CONSUMER_ID])
+}
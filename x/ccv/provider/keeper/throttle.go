@@ -202,7 +202,12 @@ func (k Keeper) GetSlashMeterReplenishTimeCandidate(ctx sdktypes.Context) time.T
 // Note: this value is the next time the slash meter will be replenished IFF the slash meter is NOT full.
 // Otherwise this value will be updated in every future block until the slash meter becomes NOT full.
 func (k Keeper) SetSlashMeterReplenishTimeCandidate(ctx sdktypes.Context) {
+	k.SetSlashMeterReplenishTimeCandidateAt(ctx, ctx.BlockTime().Add(k.GetSlashMeterReplenishPeriod(ctx)))
+}
+
+// SetSlashMeterReplenishTimeCandidateAt sets the next time the slash meter may be replenished
+// to the given time, e.g., when restoring the slash meter state from genesis
+func (k Keeper) SetSlashMeterReplenishTimeCandidateAt(ctx sdktypes.Context, candidate time.Time) {
 	store := ctx.KVStore(k.storeKey)
-	timeToStore := ctx.BlockTime().UTC().Add(k.GetSlashMeterReplenishPeriod(ctx))
-	store.Set(providertypes.SlashMeterReplenishTimeCandidateKey(), sdktypes.FormatTimeBytes(timeToStore))
+	store.Set(providertypes.SlashMeterReplenishTimeCandidateKey(), sdktypes.FormatTimeBytes(candidate.UTC()))
 }
@@ -1,18 +1,27 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"pgregory.net/rapid"
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // TestSlashMeterReplenishment tests the CheckForSlashMeterReplenishment, ReplenishSlashMeter,
@@ -534,5 +543,293 @@ func TestSlashMeterReplenishTimeCandidate(t *testing.T) {
 
 		// Time should be returned in UTC
 		require.Equal(t, tc.blockTime.Add(tc.replenishPeriod).UTC(), gotTime)
+
+		// An explicit time is stored regardless of the block time and the replenish period
+		explicitTime := tc.blockTime.Add(-tc.replenishPeriod)
+		providerKeeper.SetSlashMeterReplenishTimeCandidateAt(ctx, explicitTime)
+		require.Equal(t, explicitTime.UTC(), providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx))
+	}
+}
+
+// throttleModelVal is a validator of the throttleModel
+type throttleModelVal struct {
+	identity *cryptotestutil.CryptoIdentity
+	// power is the power of the validator, unless it is jailed
+	power int64
+	// lastPower is the power of the validator as of the last EndBlock
+	lastPower int64
+	jailed    bool
+}
+
+// throttleModel is a minimal model of the provider staking and slashing modules,
+// backing the mocked keepers used by the throttle property tests
+type throttleModel struct {
+	vals []*throttleModelVal
+}
+
+func (m *throttleModel) getVal(consAddr sdk.ConsAddress) *throttleModelVal {
+	for _, val := range m.vals {
+		if val.identity.SDKValConsAddress().Equals(consAddr) {
+			return val
+		}
+	}
+	return nil
+}
+
+// endBlock updates the last powers, as the staking module does in its EndBlock
+func (m *throttleModel) endBlock() {
+	for _, val := range m.vals {
+		val.lastPower = val.power
+		if val.jailed {
+			val.lastPower = 0
+		}
 	}
 }
+
+// effectivePower returns the power subtracted from the slash meter when the validator is slashed,
+// i.e., its last power, unless it is jailed
+func (m *throttleModel) effectivePower(val *throttleModelVal) int64 {
+	if val.jailed {
+		return 0
+	}
+	return val.lastPower
+}
+
+// expectCalls sets up the mocked staking and slashing keepers to be backed by the model
+func (m *throttleModel) expectCalls(mocks testkeeper.MockedKeepers) {
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).DoAndReturn(
+		func(context.Context) (math.Int, error) {
+			total := int64(0)
+			for _, val := range m.vals {
+				total += val.lastPower
+			}
+			return math.NewInt(total), nil
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+			val := m.getVal(consAddr)
+			if val == nil {
+				return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+			}
+			return stakingtypes.Validator{
+				OperatorAddress: val.identity.SDKValOpAddressString(),
+				Jailed:          val.jailed,
+				Status:          stakingtypes.Bonded,
+			}, nil
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, valAddr sdk.ValAddress) (int64, error) {
+			for _, val := range m.vals {
+				if val.identity.SDKValOpAddress().Equals(valAddr) {
+					return val.lastPower, nil
+				}
+			}
+			return 0, stakingtypes.ErrNoValidatorFound
+		}).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(math.ZeroInt(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().Jail(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, consAddr sdk.ConsAddress) error {
+			m.getVal(consAddr).jailed = true
+			return nil
+		}).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().JailUntil(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+}
+
+// throttleConsumer is a consumer chain sending downtime slash packets in the throttle property tests
+type throttleConsumer struct {
+	consumerId string
+	channelId  string
+	// pending are the slash packets not yet handled by the provider, in the order they were sent;
+	// the consumer chain retries the first one until it is handled
+	pending []ccv.SlashPacketData
+	seq     uint64
+}
+
+// maxThrottlePeriod is the maximum slash meter replenish period drawn by the throttle property tests
+const maxThrottlePeriod = 2 * time.Hour
+
+func drawReplenishFraction(r *rapid.T) string {
+	// the fraction is in (0, 1], with a precision of 4 decimals
+	return math.LegacyNewDecWithPrec(rapid.Int64Range(1, 10000).Draw(r, "replenishFraction"), 4).String()
+}
+
+func drawReplenishPeriod(r *rapid.T) time.Duration {
+	return time.Duration(rapid.Int64Range(1, int64(maxThrottlePeriod/time.Minute)).Draw(r, "replenishPeriod")) * time.Minute
+}
+
+// TestThrottleProperties checks the invariants of the slash packet throttling across random sequences of
+// blocks, slash packets, jailing and unjailing of validators, changes of validator powers, and changes of
+// the slash meter replenish params:
+//   - after every BeginBlock, the slash meter does not exceed the allowance, and as the meter only decreases
+//     within a block, it does not exceed the allowance of the block when slash packets are handled;
+//   - the slash meter is only replenished once the replenish time candidate is reached, and the power
+//     jailed since the start of a replenish window before a slash packet is handled is at most the slash meter
+//     at the start of the window, i.e., the power jailed in a window exceeds the allowance by at most
+//     the power of a single validator;
+//   - once no more slash packets are sent, all the bounced slash packets are eventually handled
+//     as the slash meter replenishes.
+func TestThrottleProperties(t *testing.T) {
+	rapid.Check(t, func(r *rapid.T) {
+		providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		defer ctrl.Finish()
+
+		model := &throttleModel{}
+		for i, power := range rapid.SliceOfN(rapid.Int64Range(1, 100), 1, 10).Draw(r, "powers") {
+			model.vals = append(model.vals, &throttleModelVal{
+				identity:  cryptotestutil.NewCryptoIdentityFromIntSeed(i),
+				power:     power,
+				lastPower: power,
+			})
+		}
+		model.expectCalls(mocks)
+
+		ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+		params := providertypes.DefaultParams()
+		params.SlashMeterReplenishFraction = drawReplenishFraction(r)
+		params.SlashMeterReplenishPeriod = drawReplenishPeriod(r)
+		providerKeeper.SetParams(ctx, params)
+		providerKeeper.InitializeSlashMeter(ctx)
+
+		consumers := make([]*throttleConsumer, rapid.IntRange(1, 3).Draw(r, "numConsumers"))
+		for i := range consumers {
+			consumers[i] = &throttleConsumer{consumerId: fmt.Sprint(i), channelId: fmt.Sprintf("channel-%d", i)}
+			providerKeeper.SetChannelToConsumerId(ctx, consumers[i].channelId, consumers[i].consumerId)
+			providerKeeper.SetConsumerPhase(ctx, consumers[i].consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+			providerKeeper.SetInitChainHeight(ctx, consumers[i].consumerId, 1)
+			require.NoError(r, providerKeeper.SetInfractionParameters(ctx, consumers[i].consumerId, *getTestInfractionParameters()))
+			for _, val := range model.vals {
+				require.NoError(r, providerKeeper.SetConsumerValidator(ctx, consumers[i].consumerId,
+					providertypes.ConsensusValidator{ProviderConsAddr: val.identity.SDKValConsAddress()}))
+			}
+		}
+
+		// the allowance as of the last BeginBlock
+		blockAllowance := providerKeeper.GetSlashMeterAllowance(ctx)
+		// the slash meter at the start of the current replenish window and the power jailed since then
+		windowMeter := providerKeeper.GetSlashMeter(ctx)
+		windowJailed := int64(0)
+
+		beginBlock := func() {
+			meterBefore := providerKeeper.GetSlashMeter(ctx)
+			candidateBefore := providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx)
+
+			providerKeeper.BeginBlockCIS(ctx)
+
+			meter := providerKeeper.GetSlashMeter(ctx)
+			blockAllowance = providerKeeper.GetSlashMeterAllowance(ctx)
+			require.True(r, meter.LTE(blockAllowance), "slash meter %s exceeds allowance %s", meter, blockAllowance)
+
+			candidate := providerKeeper.GetSlashMeterReplenishTimeCandidate(ctx)
+			if meter.GT(meterBefore) {
+				require.False(r, ctx.BlockTime().Before(candidateBefore),
+					"slash meter replenished at %s before replenish time candidate %s", ctx.BlockTime(), candidateBefore)
+			}
+			if meter.GT(meterBefore) || !candidate.Equal(candidateBefore) {
+				windowMeter = meter
+				windowJailed = 0
+			}
+		}
+
+		endBlock := func(elapsed time.Duration) {
+			model.endBlock()
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(elapsed)).WithBlockHeight(ctx.BlockHeight() + 1)
+		}
+
+		// relay relays the first pending slash packet of the consumer chain,
+		// and returns true if the slash packet was handled
+		relay := func(consumer *throttleConsumer) bool {
+			data := consumer.pending[0]
+			val := model.getVal(data.Validator.Address)
+			power := model.effectivePower(val)
+			meter := providerKeeper.GetSlashMeter(ctx)
+
+			consumer.seq++
+			ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, consumer.channelId, consumer.seq, data)
+			require.NoError(r, err)
+
+			if meter.IsNegative() {
+				require.Equal(r, ccv.SlashPacketBouncedResult, ackResult)
+				require.Equal(r, meter.Int64(), providerKeeper.GetSlashMeter(ctx).Int64())
+				return false
+			}
+			require.Equal(r, ccv.SlashPacketHandledResult, ackResult)
+			require.True(r, val.jailed)
+			require.Equal(r, meter.Int64()-power, providerKeeper.GetSlashMeter(ctx).Int64())
+
+			require.LessOrEqual(r, windowJailed, windowMeter.Int64(),
+				"power jailed in the replenish window exceeds the slash meter at the start of the window")
+			windowJailed += power
+
+			consumer.pending = consumer.pending[1:]
+			return true
+		}
+
+		r.Repeat(map[string]func(*rapid.T){
+			"": func(r *rapid.T) {
+				meter := providerKeeper.GetSlashMeter(ctx)
+				require.True(r, meter.LTE(blockAllowance), "slash meter %s exceeds allowance %s", meter, blockAllowance)
+			},
+			"nextBlock": func(r *rapid.T) {
+				period := providerKeeper.GetSlashMeterReplenishPeriod(ctx)
+				endBlock(time.Duration(rapid.Int64Range(1, int64(2*period/time.Second)).Draw(r, "elapsed")) * time.Second)
+				beginBlock()
+			},
+			"sendSlashPacket": func(r *rapid.T) {
+				consumer := rapid.SampledFrom(consumers).Draw(r, "consumer")
+				val := rapid.SampledFrom(model.vals).Draw(r, "val")
+				consumer.pending = append(consumer.pending, *ccv.NewSlashPacketData(
+					abci.Validator{Address: val.identity.SDKValConsAddress(), Power: max(val.lastPower, 1)},
+					0, // uses the init chain height
+					stakingtypes.Infraction_INFRACTION_DOWNTIME,
+				))
+			},
+			"relaySlashPacket": func(r *rapid.T) {
+				consumer := rapid.SampledFrom(consumers).Draw(r, "consumer")
+				if len(consumer.pending) == 0 {
+					r.Skip("no pending slash packets")
+				}
+				relay(consumer)
+			},
+			"unjail": func(r *rapid.T) {
+				val := rapid.SampledFrom(model.vals).Draw(r, "val")
+				if !val.jailed {
+					r.Skip("validator not jailed")
+				}
+				val.jailed = false
+			},
+			"changePower": func(r *rapid.T) {
+				val := rapid.SampledFrom(model.vals).Draw(r, "val")
+				val.power = rapid.Int64Range(1, 100).Draw(r, "power")
+			},
+			"changeParams": func(r *rapid.T) {
+				params := providerKeeper.GetParams(ctx)
+				params.SlashMeterReplenishFraction = drawReplenishFraction(r)
+				params.SlashMeterReplenishPeriod = drawReplenishPeriod(r)
+				providerKeeper.SetParams(ctx, params)
+			},
+		})
+
+		// Once no more slash packets are sent, the slash meter is replenished every block. Starting from a slash meter
+		// of at least minus the maximum validator power, i.e., -100, it becomes non-negative within 101 replenishments,
+		// since the allowance is at least 1, and then the next pending slash packet is handled. As every handled
+		// slash packet that decrements the meter jails a validator, all the pending slash packets must be handled
+		// within (len(model.vals)+1)*101 + len(pending) blocks.
+		numPending := 0
+		for _, consumer := range consumers {
+			numPending += len(consumer.pending)
+		}
+		for i := 0; numPending > 0 && i < (len(model.vals)+1)*101+numPending; i++ {
+			endBlock(maxThrottlePeriod)
+			beginBlock()
+			for _, consumer := range consumers {
+				for len(consumer.pending) > 0 && relay(consumer) {
+					numPending--
+				}
+			}
+		}
+		require.Zero(r, numPending, "bounced slash packets not handled once the slash meter replenishes")
+	})
+}
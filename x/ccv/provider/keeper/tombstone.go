@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// CleanupTombstonedValidators removes the consumer state of the validators that are tombstoned
+// on the provider chain, i.e., of the opted-in validators and of the validators that assigned
+// consumer keys to active consumer chains without opting in (e.g., before a Top N chain launches).
+// It is called at the beginning of every epoch, so that the tombstoned validators are removed from
+// the consumer validator sets computed in this epoch, regardless of whether they were tombstoned
+// by the provider or due to a consumer infraction.
+func (k Keeper) CleanupTombstonedValidators(ctx sdk.Context) error {
+	providerAddrs := k.GetAllOptedInValidators(ctx)
+	seen := map[string]bool{}
+	for _, providerAddr := range providerAddrs {
+		seen[providerAddr.String()] = true
+	}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		for _, assignment := range k.GetAllValidatorConsumerPubKeys(ctx, &consumerId) {
+			providerAddr := types.NewProviderConsAddress(assignment.ProviderAddr)
+			if !seen[providerAddr.String()] {
+				seen[providerAddr.String()] = true
+				providerAddrs = append(providerAddrs, providerAddr)
+			}
+		}
+	}
+
+	for _, providerAddr := range providerAddrs {
+		if !k.slashingKeeper.IsTombstoned(ctx, providerAddr.ToSdkConsAddr()) {
+			continue
+		}
+		if err := k.CleanupTombstonedValidator(ctx, providerAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CleanupTombstonedValidator opts out the tombstoned validator with `providerAddr` from all the
+// consumer chains and removes the consumer keys it assigned, whose consumer addresses are pruned
+// once the unbonding period elapses on launched consumer chains. A `tombstoned_validator_removed`
+// event is emitted for every consumer chain the validator is removed from.
+func (k Keeper) CleanupTombstonedValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress) error {
+	consumerIds := k.GetOptedInConsumerIds(ctx, providerAddr)
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		if _, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); found && !slices.Contains(consumerIds, consumerId) {
+			consumerIds = append(consumerIds, consumerId)
+		}
+	}
+
+	for _, consumerId := range consumerIds {
+		k.DeleteOptedIn(ctx, consumerId, providerAddr)
+
+		attributes := []sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderConsAddress, k.ConsAddressToString(providerAddr.ToSdkConsAddr())),
+		}
+
+		if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); found {
+			consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+			if err != nil {
+				return err
+			}
+			consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+			// the consumer address is kept until it is pruned,
+			// as it can still be referenced by the consumer chain
+			if err := k.scheduleConsumerAddrPruning(ctx, consumerId, consumerAddr); err != nil {
+				return err
+			}
+			k.DeleteValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+			attributes = append(attributes,
+				sdk.NewAttribute(types.AttributeConsumerConsAddress, k.ConsAddressToString(consumerAddr.ToSdkConsAddr())))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeTombstonedValidatorRemoved, attributes...))
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestGetAllOptedInValidators(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Empty(t, providerKeeper.GetAllOptedInValidators(ctx))
+
+	providerAddr1 := types.NewProviderConsAddress([]byte("providerAddr1"))
+	providerAddr2 := types.NewProviderConsAddress([]byte("providerAddr2"))
+	providerKeeper.SetOptedIn(ctx, "0", providerAddr1)
+	providerKeeper.SetOptedIn(ctx, "1", providerAddr1)
+	providerKeeper.SetOptedIn(ctx, "1", providerAddr2)
+
+	require.Equal(t,
+		[]types.ProviderConsAddress{providerAddr1, providerAddr2},
+		providerKeeper.GetAllOptedInValidators(ctx))
+}
+
+func TestCleanupTombstonedValidators(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+
+	tombstonedAddr := types.NewProviderConsAddress([]byte("tombstonedAddr"))
+	keyOnlyAddr := types.NewProviderConsAddress([]byte("keyOnlyAddr"))
+	activeAddr := types.NewProviderConsAddress([]byte("activeAddr"))
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), tombstonedAddr.ToSdkConsAddr()).Return(true).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), keyOnlyAddr.ToSdkConsAddr()).Return(true).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), activeAddr.ToSdkConsAddr()).Return(false).AnyTimes()
+
+	// consumer "0" is launched, consumer "1" is initialized, the tombstoned validator
+	// only assigned a key (without opting in) to consumer "1", and another tombstoned
+	// validator only assigned a key to consumer "0", without opting in to any consumer chain
+	launchedId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, launchedId, types.CONSUMER_PHASE_LAUNCHED)
+	initializedId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, initializedId, types.CONSUMER_PHASE_INITIALIZED)
+
+	for _, providerAddr := range []types.ProviderConsAddress{tombstonedAddr, activeAddr} {
+		providerKeeper.SetOptedIn(ctx, launchedId, providerAddr)
+	}
+
+	launchedKey := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerKeeper.SetValidatorConsumerPubKey(ctx, launchedId, tombstonedAddr, launchedKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, launchedId, launchedKey.ConsumerConsAddress(), tombstonedAddr)
+	initializedKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	providerKeeper.SetValidatorConsumerPubKey(ctx, initializedId, tombstonedAddr, initializedKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, initializedId, initializedKey.ConsumerConsAddress(), tombstonedAddr)
+	keyOnlyKey := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	providerKeeper.SetValidatorConsumerPubKey(ctx, launchedId, keyOnlyAddr, keyOnlyKey.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, launchedId, keyOnlyKey.ConsumerConsAddress(), keyOnlyAddr)
+
+	require.NoError(t, providerKeeper.CleanupTombstonedValidators(ctx))
+
+	// only the tombstoned validator is opted out
+	require.False(t, providerKeeper.IsOptedIn(ctx, launchedId, tombstonedAddr))
+	require.True(t, providerKeeper.IsOptedIn(ctx, launchedId, activeAddr))
+	require.Equal(t, []types.ProviderConsAddress{activeAddr}, providerKeeper.GetAllOptedInValidators(ctx))
+
+	// the assigned keys are removed
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, launchedId, tombstonedAddr)
+	require.False(t, found)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, initializedId, tombstonedAddr)
+	require.False(t, found)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, launchedId, keyOnlyAddr)
+	require.False(t, found)
+
+	// the consumer addresses on the launched chain are pruned after the unbonding period
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, launchedId, launchedKey.ConsumerConsAddress())
+	require.True(t, found)
+	require.ElementsMatch(t,
+		[][]byte{launchedKey.SDKValConsAddress(), keyOnlyKey.SDKValConsAddress()},
+		providerKeeper.GetConsumerAddrsToPrune(ctx, launchedId, ctx.BlockTime().Add(unbondingTime)).Addresses)
+
+	// the consumer address on the non-launched chain is removed immediately
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, initializedId, initializedKey.ConsumerConsAddress())
+	require.False(t, found)
+
+	// an event is emitted for every consumer chain a validator is removed from
+	removed := map[string]map[string]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeTombstonedValidatorRemoved {
+			continue
+		}
+		consumerId, ok := event.GetAttribute(types.AttributeConsumerId)
+		require.True(t, ok)
+		providerAttr, ok := event.GetAttribute(types.AttributeProviderConsAddress)
+		require.True(t, ok)
+		consumerAttr, ok := event.GetAttribute(types.AttributeConsumerConsAddress)
+		require.True(t, ok)
+		if removed[providerAttr.Value] == nil {
+			removed[providerAttr.Value] = map[string]string{}
+		}
+		removed[providerAttr.Value][consumerId.Value] = consumerAttr.Value
+	}
+	require.Equal(t, map[string]map[string]string{
+		tombstonedAddr.String(): {
+			launchedId:    launchedKey.SDKValConsAddress().String(),
+			initializedId: initializedKey.SDKValConsAddress().String(),
+		},
+		keyOnlyAddr.String(): {
+			launchedId: keyOnlyKey.SDKValConsAddress().String(),
+		},
+	}, removed)
+
+	// cleaning up again is a no-op
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.CleanupTombstonedValidators(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+}
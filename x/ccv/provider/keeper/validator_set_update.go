@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -83,6 +84,22 @@ func (k Keeper) GetConsumerValSet(
 	return k.getValSet(ctx, k.GetConsumerChainConsensusValidatorsKey(ctx, consumerId))
 }
 
+// GetConsumerValsetHash returns the CometBFT hash of the current validator set of the consumer chain
+func (k Keeper) GetConsumerValsetHash(
+	ctx sdk.Context,
+	consumerId string,
+) ([]byte, error) {
+	valSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return nil, err
+	}
+	updates := make([]abci.ValidatorUpdate, 0, len(valSet))
+	for _, val := range valSet {
+		updates = append(updates, abci.ValidatorUpdate{PubKey: *val.PublicKey, Power: val.Power})
+	}
+	return ccv.ComputeValsetHash(updates)
+}
+
 // DiffValidators compares the current and the next epoch's consumer validators and returns the `ValidatorUpdate` diff
 // needed by CometBFT to update the validator set on a chain.
 func DiffValidators(
@@ -126,7 +143,7 @@ func DiffValidators(
 
 // CreateConsumerValidator creates a consumer validator for `consumerId` from the given staking `validator`
 func (k Keeper) CreateConsumerValidator(ctx sdk.Context, consumerId string, validator stakingtypes.Validator) (types.ConsensusValidator, error) {
-	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
 	if err != nil {
 		return types.ConsensusValidator{}, err
 	}
@@ -228,7 +245,11 @@ func (k Keeper) ComputeNextValidators(
 			if err != nil {
 				return false, err
 			}
-			return canValidateChain && fulfillsMinStake, nil
+			fulfillsMinUptime, err := k.FulfillsMinUptime(ctx, powerShapingParameters.MinUptime, providerAddr)
+			if err != nil {
+				return false, err
+			}
+			return canValidateChain && fulfillsMinStake && fulfillsMinUptime, nil
 		})
 	if err != nil {
 		return []types.ConsensusValidator{}, err
@@ -238,8 +259,15 @@ func (k Keeper) ComputeNextValidators(
 
 	nextValidators = k.CapValidatorSet(ctx, powerShapingParameters, append(priorityValidators, nonPriorityValidators...))
 
+	// the committee can only narrow down the validators selected by the power-shaping parameters,
+	// while the power cap applies to the resulting validator set
+	nextValidators = k.IntersectWithCommittee(ctx, consumerId, nextValidators)
+
 	nextValidators = k.CapValidatorsPower(ctx, powerShapingParameters.ValidatorsPowerCap, nextValidators)
 
+	// the min validator power is applied last, so that no validator ends up with a smaller power due to the power cap
+	nextValidators = k.FloorValidatorsPower(ctx, powerShapingParameters.MinValidatorPower, nextValidators)
+
 	return nextValidators, nil
 }
 
@@ -260,12 +288,27 @@ func (k Keeper) GetLastProviderConsensusActiveValidators(ctx sdk.Context) ([]sta
 	return ccv.GetLastBondedValidatorsUtil(ctx, k.stakingKeeper, uint32(maxVals))
 }
 
+// GetLastBondedAndActiveValidators returns both the last bonded validators (see GetLastBondedValidators)
+// and the provider active validators (see GetLastProviderConsensusActiveValidators), while iterating
+// the validators in the staking module only once. As both are sorted by descending power,
+// the active validators are a prefix of the bonded validators and share the same underlying array.
+func (k Keeper) GetLastBondedAndActiveValidators(ctx sdk.Context) (bondedValidators, activeValidators []stakingtypes.Validator, err error) {
+	bondedValidators, err = k.GetLastBondedValidators(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	numActive := len(bondedValidators)
+	if maxVals := k.GetMaxProviderConsensusValidators(ctx); maxVals < int64(numActive) {
+		numActive = int(maxVals)
+	}
+	// limit the capacity such that appending to the active validators does not overwrite the bonded validators
+	return bondedValidators, bondedValidators[:numActive:numActive], nil
+}
+
 // ComputeConsumerNextValSet computes the consumer next validator set and returns
 // the validator updates to be sent to the consumer chain.
 // For TopN consumer chains, it automatically opts in all validators that
 // belong to the top N of the active validators.
-//
-// TODO add unit test for ComputeConsumerNextValSet
 func (k Keeper) ComputeConsumerNextValSet(
 	ctx sdk.Context,
 	bondedValidators []stakingtypes.Validator,
@@ -315,5 +358,251 @@ func (k Keeper) ComputeConsumerNextValSet(
 	// get the initial updates with the latest set consumer public keys
 	valUpdates := DiffValidators(currentConsumerValSet, nextValidators)
 
+	// the consumer validator set is in sync with the provider state and
+	// can be updated incrementally from now on (if eligible)
+	k.SetIncrementalValSetUpdate(ctx, consumerId)
+
 	return valUpdates, nil
 }
+
+// CanUpdateConsumerValSetIncrementally returns true if the next validator set of the consumer chain
+// can be computed by only re-evaluating the validators modified in the current epoch.
+// This is the case if no consumer-specific state that affects the validator set has changed since
+// the validator set was last computed, and if whether a validator validates the consumer chain
+// does not depend on the other validators, i.e., the chain is not Top N, has no validator set cap
+// or validators power cap, and the consumer validators are not limited to the provider active validators.
+func (k Keeper) CanUpdateConsumerValSetIncrementally(
+	ctx sdk.Context,
+	consumerId string,
+	powerShapingParameters types.PowerShapingParameters,
+	numBondedValidators int,
+) bool {
+	if !k.IsIncrementalValSetUpdate(ctx, consumerId) {
+		return false
+	}
+	// the uptime of a validator changes every block, not only when the validator is modified
+	if powerShapingParameters.Top_N > 0 ||
+		powerShapingParameters.ValidatorSetCap > 0 ||
+		powerShapingParameters.ValidatorsPowerCap > 0 ||
+		powerShapingParameters.MinUptime > 0 {
+		return false
+	}
+	return powerShapingParameters.AllowInactiveVals ||
+		int64(numBondedValidators) <= k.GetMaxProviderConsensusValidators(ctx)
+}
+
+// ComputeConsumerValSetDiff re-evaluates the `modifiedValidators` against the stored consumer validator set
+// and returns the validator updates to be sent to the consumer chain. The consumer validator set is updated
+// accordingly. The `bondedValidators` are indexed by their consensus addresses (as raw bytes).
+// Note that this method should only be called if `CanUpdateConsumerValSetIncrementally` returns true.
+func (k Keeper) ComputeConsumerValSetDiff(
+	ctx sdk.Context,
+	consumerId string,
+	powerShapingParameters types.PowerShapingParameters,
+	bondedValidators map[string]stakingtypes.Validator,
+	modifiedValidators []types.ProviderConsAddress,
+) ([]abci.ValidatorUpdate, error) {
+	var updates []abci.ValidatorUpdate
+	for _, providerAddr := range modifiedValidators {
+		currentVal, isCurrentValidator := k.GetConsumerValidator(ctx, consumerId, providerAddr)
+
+		isNextValidator := false
+		validator, isBonded := bondedValidators[string(providerAddr.ToSdkConsAddr())]
+		if isBonded {
+			canValidateChain, err := k.CanValidateChain(ctx, consumerId, providerAddr, 0, 0)
+			if err != nil {
+				return nil, err
+			}
+			fulfillsMinStake, err := k.FulfillsMinStake(ctx, powerShapingParameters.MinStake, providerAddr)
+			if err != nil {
+				return nil, err
+			}
+			isNextValidator = canValidateChain && fulfillsMinStake &&
+				(k.IsCommitteeEmpty(ctx, consumerId) || k.IsCommitteeMember(ctx, consumerId, providerAddr))
+		}
+
+		if !isNextValidator {
+			if isCurrentValidator {
+				// the validator leaves the consumer validator set
+				updates = append(updates, abci.ValidatorUpdate{PubKey: *currentVal.PublicKey, Power: 0})
+				k.DeleteConsumerValidator(ctx, consumerId, providerAddr)
+			}
+			continue
+		}
+
+		nextVal, err := k.CreateConsumerValidator(ctx, consumerId, validator)
+		if err != nil {
+			return nil, err
+		}
+		// the min validator power does not depend on the other validators
+		if minPower := int64(powerShapingParameters.MinValidatorPower); nextVal.Power < minPower {
+			nextVal.Power = minPower
+		}
+		if isCurrentValidator && currentVal.PublicKey.Equal(nextVal.PublicKey) && currentVal.Power == nextVal.Power {
+			// neither the consumer public key, nor the power of the validator changed
+			continue
+		}
+		if isCurrentValidator && !currentVal.PublicKey.Equal(nextVal.PublicKey) {
+			// the validator changed its consumer public key, so the old one is removed
+			updates = append(updates, abci.ValidatorUpdate{PubKey: *currentVal.PublicKey, Power: 0})
+		}
+		updates = append(updates, abci.ValidatorUpdate{PubKey: *nextVal.PublicKey, Power: nextVal.Power})
+		if err := k.SetConsumerValidator(ctx, consumerId, nextVal); err != nil {
+			return nil, err
+		}
+	}
+
+	return updates, nil
+}
+
+// SetIncrementalValSetUpdate records that the validator set of the consumer chain is in sync
+// with the provider state and hence, it can be updated incrementally
+func (k Keeper) SetIncrementalValSetUpdate(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.IncrementalValSetUpdateKey(consumerId), []byte{})
+}
+
+// IsIncrementalValSetUpdate returns true if the validator set of the consumer chain can be updated incrementally
+func (k Keeper) IsIncrementalValSetUpdate(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.IncrementalValSetUpdateKey(consumerId))
+}
+
+// DeleteIncrementalValSetUpdate forces the next validator set of the consumer chain to be fully recomputed.
+// It must be called whenever a consumer-specific state that affects the validator set changes.
+func (k Keeper) DeleteIncrementalValSetUpdate(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.IncrementalValSetUpdateKey(consumerId))
+}
+
+// DeleteAllIncrementalValSetUpdates forces the next validator sets of all consumer chains to be fully recomputed
+func (k Keeper) DeleteAllIncrementalValSetUpdates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.IncrementalValSetUpdateKeyPrefix()})
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// RequireConsumerValSetResync forces the next validator set of the consumer chain to be fully recomputed and
+// resent, as the consumer chain did not apply the given validator updates, e.g., the updates of a VSC packet
+// that was dropped or rejected. As a result, the next VSC packet sent to the consumer chain contains the power
+// of every validator in the consumer validator set and removes every validator the consumer chain may still have
+// due to the removals in the given validator updates not being applied.
+func (k Keeper) RequireConsumerValSetResync(ctx sdk.Context, consumerId string, valUpdates []abci.ValidatorUpdate) {
+	resync, _ := k.GetConsumerValSetResync(ctx, consumerId)
+	seen := make(map[string]struct{}, len(resync.PublicKeys))
+	for _, pubKey := range resync.PublicKeys {
+		seen[pubKey.String()] = struct{}{}
+	}
+	for _, update := range valUpdates {
+		if update.Power > 0 || update.PubKey.Sum == nil {
+			// only the removed validators might still be in the validator set of the consumer chain
+			continue
+		}
+		if _, found := seen[update.PubKey.String()]; found {
+			continue
+		}
+		seen[update.PubKey.String()] = struct{}{}
+		resync.PublicKeys = append(resync.PublicKeys, update.PubKey)
+	}
+	k.SetConsumerValSetResync(ctx, consumerId, resync)
+	k.DeleteIncrementalValSetUpdate(ctx, consumerId)
+}
+
+// SetConsumerValSetResync sets the resync of the validator set of the consumer chain
+func (k Keeper) SetConsumerValSetResync(ctx sdk.Context, consumerId string, resync types.ConsumerValSetResync) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := resync.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// resync is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal consumer validator set resync: %w", err))
+	}
+	store.Set(types.ConsumerIdToValSetResyncKey(consumerId), bz)
+}
+
+// GetConsumerValSetResync returns the resync of the validator set of the consumer chain, if any
+func (k Keeper) GetConsumerValSetResync(ctx sdk.Context, consumerId string) (types.ConsumerValSetResync, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToValSetResyncKey(consumerId))
+	if bz == nil {
+		return types.ConsumerValSetResync{}, false
+	}
+	var resync types.ConsumerValSetResync
+	if err := resync.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the resync is assumed to be correctly serialized in SetConsumerValSetResync.
+		panic(fmt.Errorf("cannot unmarshal consumer validator set resync: %w", err))
+	}
+	return resync, true
+}
+
+// DeleteConsumerValSetResync deletes the resync of the validator set of the consumer chain
+func (k Keeper) DeleteConsumerValSetResync(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToValSetResyncKey(consumerId))
+}
+
+// ConsumerValSetResyncBase returns the validator set to diff the next validator set of a consumer chain against
+// when it is resent, i.e., the current consumer validator set and the validators of the resync, all with zero power.
+// Diffing against it results in the power of every validator in the next validator set and in the removal of
+// every other validator the consumer chain may have (removals of unknown validators are ignored by consumer chains).
+func ConsumerValSetResyncBase(currentValSet []types.ConsensusValidator, resync types.ConsumerValSetResync) []types.ConsensusValidator {
+	base := make([]types.ConsensusValidator, 0, len(currentValSet)+len(resync.PublicKeys))
+	seen := make(map[string]struct{}, len(currentValSet))
+	for _, val := range currentValSet {
+		val.Power = 0
+		base = append(base, val)
+		seen[val.PublicKey.String()] = struct{}{}
+	}
+	for _, pubKey := range resync.PublicKeys {
+		if _, found := seen[pubKey.String()]; found {
+			continue
+		}
+		base = append(base, types.ConsensusValidator{PublicKey: &pubKey, Power: 0})
+	}
+	return base
+}
+
+// SetModifiedValidator records that the voting power of the validator with `providerAddr`
+// might have changed in the current epoch
+func (k Keeper) SetModifiedValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ModifiedValidatorKey(providerAddr), []byte{})
+}
+
+// GetAllModifiedValidators returns the validators modified in the current epoch
+// in ascending order of their provider consensus addresses
+func (k Keeper) GetAllModifiedValidators(ctx sdk.Context) (providerAddrs []types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ModifiedValidatorKeyPrefix()})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[1:]))
+	}
+
+	return providerAddrs
+}
+
+// DeleteAllModifiedValidators deletes all the validators modified in the current epoch
+func (k Keeper) DeleteAllModifiedValidators(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ModifiedValidatorKeyPrefix()})
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -123,7 +124,7 @@ func createStakingValidator(ctx sdk.Context, mocks testkeeper.MockedKeepers, pow
 	providerValidatorAddr := sdk.ValAddress(providerAddr.Address.Bytes())
 
 	mocks.MockStakingKeeper.EXPECT().
-		GetLastValidatorPower(ctx, providerValidatorAddr).Return(power, nil).AnyTimes()
+		GetLastValidatorPower(gomock.Any(), providerValidatorAddr).Return(power, nil).AnyTimes()
 
 	return stakingtypes.Validator{
 		OperatorAddress: providerValidatorAddr.String(),
@@ -495,3 +496,67 @@ func TestCreateConsumerValidator(t *testing.T) {
 	require.Equal(t, expectedConsumerValidatorB, actualConsumerValidatorB)
 	require.NoError(t, err)
 }
+
+// TestComputeConsumerNextValSetWithStakingKeepers tests the computation of the next validator set
+// of a Top N consumer chain against real in-memory staking and slashing keepers
+func TestComputeConsumerNextValSetWithStakingKeepers(t *testing.T) {
+	providerKeeper, ctx, ctrl, _, stakingKeepers := testkeeper.GetProviderKeeperAndCtxWithStakingKeepers(t)
+	defer ctrl.Finish()
+
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	stakingParams, err := stakingKeepers.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	stakingParams.MaxValidators = 2
+	require.NoError(t, stakingKeepers.StakingKeeper.SetParams(ctx, stakingParams))
+
+	consumerID := CONSUMER_ID
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerID, types.PowerShapingParameters{Top_N: 100})
+	require.NoError(t, err)
+
+	// only the two validators with the largest powers are bonded
+	valA := stakingKeepers.CreateValidator(t, ctx, 30)
+	valB := stakingKeepers.CreateValidator(t, ctx, 20)
+	stakingKeepers.CreateValidator(t, ctx, 10)
+	require.Len(t, stakingKeepers.EndBlock(t, ctx), 2)
+
+	bondedValidators, activeValidators, err := providerKeeper.GetLastBondedAndActiveValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, bondedValidators, 2)
+	require.Equal(t, valA.OperatorAddress, bondedValidators[0].OperatorAddress)
+	require.Equal(t, valB.OperatorAddress, bondedValidators[1].OperatorAddress)
+
+	valUpdates, err := providerKeeper.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerID, nil)
+	require.NoError(t, err)
+	require.Len(t, valUpdates, 2)
+	minPower, found := providerKeeper.GetMinimumPowerInTopN(ctx, consumerID)
+	require.True(t, found)
+	require.Equal(t, int64(20), minPower)
+
+	currentValSet, err := providerKeeper.GetConsumerValSet(ctx, consumerID)
+	require.NoError(t, err)
+
+	// a new validator with a larger power than the second one replaces it in the bonded validators
+	valD := stakingKeepers.CreateValidator(t, ctx, 25)
+	require.Len(t, stakingKeepers.EndBlock(t, ctx), 2)
+
+	bondedValidators, activeValidators, err = providerKeeper.GetLastBondedAndActiveValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, bondedValidators, 2)
+	require.Equal(t, valA.OperatorAddress, bondedValidators[0].OperatorAddress)
+	require.Equal(t, valD.OperatorAddress, bondedValidators[1].OperatorAddress)
+
+	valUpdates, err = providerKeeper.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerID, currentValSet)
+	require.NoError(t, err)
+	valDConsAddr, err := valD.GetConsAddr()
+	require.NoError(t, err)
+	valBPublicKey, err := valB.CmtConsPublicKey()
+	require.NoError(t, err)
+	valDPublicKey, err := valD.CmtConsPublicKey()
+	require.NoError(t, err)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		{PubKey: valBPublicKey, Power: 0},
+		{PubKey: valDPublicKey, Power: 25},
+	}, valUpdates)
+	// the new validator is automatically opted in as it belongs to the top N
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerID, types.NewProviderConsAddress(valDConsAddr)))
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// SetValsetSnapshot sets the validator set sent to a consumer chain for the VSC id of the snapshot
+func (k Keeper) SetValsetSnapshot(ctx sdk.Context, consumerId string, snapshot types.ValsetSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := snapshot.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// snapshot is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal valset snapshot: %w", err))
+	}
+	store.Set(types.ConsumerIdToValsetHistoryKey(consumerId, snapshot.ValsetUpdateId), bz)
+}
+
+// GetValsetSnapshotAtVsc returns the validator set used by a consumer chain at the given VSC id,
+// i.e., the retained validator set sent with the largest VSC id not larger than the given one
+func (k Keeper) GetValsetSnapshotAtVsc(ctx sdk.Context, consumerId string, vscId uint64) (types.ValsetSnapshot, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.ReverseIterator(
+		types.StringIdWithLenKey(types.ConsumerIdToValsetHistoryKeyPrefix(), consumerId),
+		storetypes.InclusiveEndBytes(types.ConsumerIdToValsetHistoryKey(consumerId, vscId)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ValsetSnapshot{}, false
+	}
+	var snapshot types.ValsetSnapshot
+	if err := snapshot.Unmarshal(iterator.Value()); err != nil {
+		// An error here would indicate something is very wrong,
+		// the snapshot is assumed to be correctly serialized in SetValsetSnapshot.
+		panic(fmt.Errorf("cannot unmarshal valset snapshot: %w", err))
+	}
+	return snapshot, true
+}
+
+// GetAllValsetSnapshots returns all the retained validator sets sent to a consumer chain ordered by VSC id
+func (k Keeper) GetAllValsetSnapshots(ctx sdk.Context, consumerId string) (snapshots []types.ValsetSnapshot) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToValsetHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.ValsetSnapshot
+		if err := snapshot.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the snapshot is assumed to be correctly serialized in SetValsetSnapshot.
+			panic(fmt.Errorf("cannot unmarshal valset snapshot: %w", err))
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots
+}
+
+// DeleteValsetHistory deletes all the retained validator sets sent to a consumer chain
+func (k Keeper) DeleteValsetHistory(ctx sdk.Context, consumerId string) {
+	k.pruneValsetHistory(ctx, consumerId, 0)
+}
+
+// RecordValsetSnapshot records the current validator set of a consumer chain as the one sent
+// with the given VSC id and prunes the oldest snapshots beyond the valset history size
+func (k Keeper) RecordValsetSnapshot(ctx sdk.Context, consumerId string, vscId uint64) error {
+	historySize := k.GetValsetHistorySize(ctx)
+	if historySize > 0 {
+		valset, err := k.GetConsumerValSet(ctx, consumerId)
+		if err != nil {
+			return fmt.Errorf("getting consumer validator set, consumerId(%s): %w", consumerId, err)
+		}
+		k.SetValsetSnapshot(ctx, consumerId, types.ValsetSnapshot{
+			ValsetUpdateId: vscId,
+			ProviderHeight: ctx.BlockHeight(),
+			Validators:     valset,
+		})
+	}
+	k.pruneValsetHistory(ctx, consumerId, historySize)
+	return nil
+}
+
+// pruneValsetHistory deletes the oldest validator sets sent to a consumer chain,
+// so that at most the given number of validator sets are retained
+func (k Keeper) pruneValsetHistory(ctx sdk.Context, consumerId string, retained int64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToValsetHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for i := 0; i < len(keys)-int(retained); i++ {
+		store.Delete(keys[i])
+	}
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestValsetHistory tests that the consumer validator sets are recorded by VSC id and pruned
+func TestValsetHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providerKeeper.GetParams(ctx)
+	params.ValsetHistorySize = 2
+	providerKeeper.SetParams(ctx, params)
+
+	consensusValidator := func(seed int, power int64) providertypes.ConsensusValidator {
+		identity := cryptotestutil.NewCryptoIdentityFromIntSeed(seed)
+		pk := identity.TMProtoCryptoPublicKey()
+		return providertypes.ConsensusValidator{
+			ProviderConsAddr: identity.SDKValConsAddress(),
+			Power:            power,
+			PublicKey:        &pk,
+		}
+	}
+	valsets := [][]providertypes.ConsensusValidator{
+		{consensusValidator(0, 1)},
+		{consensusValidator(0, 1), consensusValidator(1, 2)},
+		{consensusValidator(1, 3)},
+	}
+	vscIds := []uint64{0, 5, 8}
+
+	for i, valset := range valsets {
+		require.NoError(t, providerKeeper.SetConsumerValSet(ctx, CONSUMER_ID, valset))
+		ctx = ctx.WithBlockHeight(int64(10 * (i + 1)))
+		require.NoError(t, providerKeeper.RecordValsetSnapshot(ctx, CONSUMER_ID, vscIds[i]))
+	}
+
+	// only the last two validator sets are retained
+	snapshots := providerKeeper.GetAllValsetSnapshots(ctx, CONSUMER_ID)
+	require.Len(t, snapshots, 2)
+	require.Equal(t, uint64(5), snapshots[0].ValsetUpdateId)
+	require.Equal(t, int64(20), snapshots[0].ProviderHeight)
+	require.Equal(t, valsets[1], snapshots[0].Validators)
+	require.Equal(t, uint64(8), snapshots[1].ValsetUpdateId)
+	require.Equal(t, valsets[2], snapshots[1].Validators)
+
+	// the validator set used at a VSC id is the last one sent up to that VSC id
+	_, found := providerKeeper.GetValsetSnapshotAtVsc(ctx, CONSUMER_ID, 4)
+	require.False(t, found)
+	for vscId, expected := range map[uint64]uint64{5: 5, 7: 5, 8: 8, 100: 8} {
+		snapshot, found := providerKeeper.GetValsetSnapshotAtVsc(ctx, CONSUMER_ID, vscId)
+		require.True(t, found)
+		require.Equal(t, expected, snapshot.ValsetUpdateId)
+	}
+
+	// the validator sets of other consumer chains are not affected
+	_, found = providerKeeper.GetValsetSnapshotAtVsc(ctx, "1", 100)
+	require.False(t, found)
+
+	// disabling the history prunes the retained validator sets on the next record
+	params.ValsetHistorySize = 0
+	providerKeeper.SetParams(ctx, params)
+	require.NoError(t, providerKeeper.RecordValsetSnapshot(ctx, CONSUMER_ID, 9))
+	require.Empty(t, providerKeeper.GetAllValsetSnapshots(ctx, CONSUMER_ID))
+
+	params.ValsetHistorySize = 2
+	providerKeeper.SetParams(ctx, params)
+	require.NoError(t, providerKeeper.RecordValsetSnapshot(ctx, CONSUMER_ID, 10))
+	require.Len(t, providerKeeper.GetAllValsetSnapshots(ctx, CONSUMER_ID), 1)
+	providerKeeper.DeleteValsetHistory(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllValsetSnapshots(ctx, CONSUMER_ID))
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MaxValsetUpdateHeightPrunesPerBlock is the maximum number of mappings from valset update IDs
+// to block heights pruned in a single block, so that a large number of expired mappings,
+// e.g., the ones accumulated before the retention was introduced, is pruned over several blocks
+const MaxValsetUpdateHeightPrunesPerBlock = 100
+
+// SetValsetUpdateBlockTime sets the block time of the block height a given valset update id is mapped to
+func (k Keeper) SetValsetUpdateBlockTime(ctx sdk.Context, valsetUpdateId uint64, blockTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValsetUpdateBlockTimeKey(valsetUpdateId), sdk.FormatTimeBytes(blockTime))
+}
+
+// GetValsetUpdateBlockTime gets the block time of the block height a given valset update id is mapped to
+func (k Keeper) GetValsetUpdateBlockTime(ctx sdk.Context, valsetUpdateId uint64) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValsetUpdateBlockTimeKey(valsetUpdateId))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	blockTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the block time is assumed to be correctly serialized in SetValsetUpdateBlockTime.
+		panic(fmt.Errorf("cannot parse valset update block time: %w", err))
+	}
+	return blockTime, true
+}
+
+// DeleteValsetUpdateBlockTime deletes the block time of the block height a given valset update id is mapped to
+func (k Keeper) DeleteValsetUpdateBlockTime(ctx sdk.Context, valsetUpdateId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValsetUpdateBlockTimeKey(valsetUpdateId))
+}
+
+// GetAllValsetUpdateBlockTimes returns the block times of all the mappings from valset update IDs
+// to block heights, ordered by valset update ID
+func (k Keeper) GetAllValsetUpdateBlockTimes(ctx sdk.Context) (blockTimes []types.ValsetUpdateIdToBlockTime) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValsetUpdateBlockTimeKeyPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valsetUpdateId := binary.BigEndian.Uint64(iterator.Key()[1:])
+		blockTime, err := sdk.ParseTimeBytes(iterator.Value())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the block time is assumed to be correctly serialized in SetValsetUpdateBlockTime.
+			panic(fmt.Errorf("cannot parse valset update block time: %w", err))
+		}
+		blockTimes = append(blockTimes, types.ValsetUpdateIdToBlockTime{
+			ValsetUpdateId: valsetUpdateId,
+			BlockTime:      blockTime,
+		})
+	}
+	return blockTimes
+}
+
+// GetValsetUpdateHeightRetentionPeriod returns the duration for which the mappings from valset update IDs
+// to block heights are retained, i.e., the unbonding period plus the retention margin
+func (k Keeper) GetValsetUpdateHeightRetentionPeriod(ctx sdk.Context) (time.Duration, error) {
+	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrNoUnbondingTime, "unbonding time not found: %s", err)
+	}
+	return unbondingPeriod + k.GetValsetUpdateHeightRetentionMargin(ctx), nil
+}
+
+// GetOldestValsetUpdateBlockHeight returns the retained mapping from valset update ID to block height
+// with the lowest valset update ID, and false if no mapping is retained
+func (k Keeper) GetOldestValsetUpdateBlockHeight(ctx sdk.Context) (types.ValsetUpdateIdToHeight, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValsetUpdateBlockHeightKeyPrefix())
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.ValsetUpdateIdToHeight{}, false
+	}
+	return types.ValsetUpdateIdToHeight{
+		ValsetUpdateId: binary.BigEndian.Uint64(iterator.Key()[1:]),
+		Height:         binary.BigEndian.Uint64(iterator.Value()),
+	}, true
+}
+
+// getOldestValsetUpdateBlockTime returns the block time recorded for the lowest valset update ID
+func (k Keeper) getOldestValsetUpdateBlockTime(ctx sdk.Context) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValsetUpdateBlockTimeKeyPrefix())
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return time.Time{}, false
+	}
+	return k.GetValsetUpdateBlockTime(ctx, binary.BigEndian.Uint64(iterator.Key()[1:]))
+}
+
+// getExpiredValsetUpdateIds returns, in ascending order, up to MaxValsetUpdateHeightPrunesPerBlock
+// valset update IDs whose mappings to block heights are older than the given cutoff.
+//
+// The mappings without a block time, i.e., the ones created before the block times were recorded
+// or imported from genesis, precede all the others. They expire once the oldest mapping with
+// a block time expires, as their block heights are even lower.
+func (k Keeper) getExpiredValsetUpdateIds(ctx sdk.Context, cutoff time.Time) (expired []uint64) {
+	oldestBlockTime, found := k.getOldestValsetUpdateBlockTime(ctx)
+	untimedExpired := found && !oldestBlockTime.After(cutoff)
+	currentValsetUpdateId := k.GetValidatorSetUpdateId(ctx)
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValsetUpdateBlockHeightKeyPrefix())
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(expired) < MaxValsetUpdateHeightPrunesPerBlock; iterator.Next() {
+		valsetUpdateId := binary.BigEndian.Uint64(iterator.Key()[1:])
+		// the mapping of the current valset update ID is still being updated
+		if valsetUpdateId >= currentValsetUpdateId {
+			break
+		}
+		blockTime, found := k.GetValsetUpdateBlockTime(ctx, valsetUpdateId)
+		if (found && blockTime.After(cutoff)) || (!found && !untimedExpired) {
+			break
+		}
+		expired = append(expired, valsetUpdateId)
+	}
+	return expired
+}
+
+// pruneValsetUpdateBlockHeights deletes the mappings from valset update IDs to block heights
+// that are older than the retention period. At most MaxValsetUpdateHeightPrunesPerBlock
+// mappings are deleted, the remaining expired mappings are pruned in the next blocks.
+func (k Keeper) pruneValsetUpdateBlockHeights(ctx sdk.Context) error {
+	retentionPeriod, err := k.GetValsetUpdateHeightRetentionPeriod(ctx)
+	if err != nil {
+		return err
+	}
+	cutoff := ctx.BlockTime().Add(-retentionPeriod)
+
+	expired := k.getExpiredValsetUpdateIds(ctx, cutoff)
+	for _, valsetUpdateId := range expired {
+		k.DeleteValsetUpdateBlockHeight(ctx, valsetUpdateId)
+		k.DeleteValsetUpdateBlockTime(ctx, valsetUpdateId)
+	}
+	if len(expired) > 0 {
+		k.Logger(ctx).Debug("pruned expired vscID to block height mappings",
+			"count", len(expired),
+			"cutoff", cutoff,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestValsetUpdateBlockHeightPruning tests that the mappings from valset update IDs to block heights
+// are pruned once they are older than the unbonding period plus the retention margin
+func TestValsetUpdateBlockHeightPruning(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingPeriod := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingPeriod, nil).AnyTimes()
+	params := providertypes.DefaultParams()
+	params.ValsetUpdateHeightRetentionMargin = 7 * 24 * time.Hour
+	providerKeeper.SetParams(ctx, params)
+	retentionPeriod, err := providerKeeper.GetValsetUpdateHeightRetentionPeriod(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 28*24*time.Hour, retentionPeriod)
+
+	// mappings without a block time, e.g., created before the block times were recorded
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 1, 10)
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 2, 20)
+	// mappings with a block time
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for vscId, blockTime := range map[uint64]time.Time{
+		3: start,
+		4: start.Add(24 * time.Hour),
+		5: start.Add(30 * 24 * time.Hour),
+	} {
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscId, vscId*10)
+		providerKeeper.SetValsetUpdateBlockTime(ctx, vscId, blockTime)
+	}
+	providerKeeper.SetValidatorSetUpdateId(ctx, 6)
+
+	// no mapping is older than the retention period
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(start.Add(retentionPeriod).Add(-time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	oldest, found := providerKeeper.GetOldestValsetUpdateBlockHeight(ctx)
+	require.True(t, found)
+	require.Equal(t, providertypes.ValsetUpdateIdToHeight{ValsetUpdateId: 1, Height: 10}, oldest)
+
+	// the oldest mapping with a block time expired, and so did the ones without a block time
+	ctx = ctx.WithBlockHeight(101).WithBlockTime(start.Add(retentionPeriod).Add(time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	oldest, found = providerKeeper.GetOldestValsetUpdateBlockHeight(ctx)
+	require.True(t, found)
+	require.Equal(t, providertypes.ValsetUpdateIdToHeight{ValsetUpdateId: 4, Height: 40}, oldest)
+	_, found = providerKeeper.GetValsetUpdateBlockTime(ctx, 3)
+	require.False(t, found)
+
+	// the mapping of the current valset update ID is never pruned
+	ctx = ctx.WithBlockHeight(102).WithBlockTime(start.Add(100 * retentionPeriod))
+	providerKeeper.EndBlockCIS(ctx)
+	require.Equal(t, []providertypes.ValsetUpdateIdToHeight{{ValsetUpdateId: 6, Height: 103}},
+		providerKeeper.GetAllValsetUpdateBlockHeights(ctx))
+	blockTime, found := providerKeeper.GetValsetUpdateBlockTime(ctx, 6)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), blockTime)
+}
+
+// TestValsetUpdateBlockHeightPruningLimit tests that at most MaxValsetUpdateHeightPrunesPerBlock
+// mappings from valset update IDs to block heights are pruned per block
+func TestValsetUpdateBlockHeightPruningLimit(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	numMappings := uint64(keeper.MaxValsetUpdateHeightPrunesPerBlock + 50)
+	for vscId := uint64(1); vscId <= numMappings; vscId++ {
+		providerKeeper.SetValsetUpdateBlockHeight(ctx, vscId, vscId)
+		providerKeeper.SetValsetUpdateBlockTime(ctx, vscId, ctx.BlockTime())
+	}
+	providerKeeper.SetValidatorSetUpdateId(ctx, numMappings+1)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(providertypes.DefaultValsetUpdateHeightRetentionMargin).Add(2 * time.Hour))
+	providerKeeper.EndBlockCIS(ctx)
+	// the remaining expired mappings and the mapping of the current valset update ID
+	require.Len(t, providerKeeper.GetAllValsetUpdateBlockHeights(ctx), 51)
+
+	providerKeeper.EndBlockCIS(ctx)
+	require.Len(t, providerKeeper.GetAllValsetUpdateBlockHeights(ctx), 1)
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// MaxVSCPacketRecords is the maximum number of VSC packets recorded in the store per consumer chain;
+// when exceeded, the records of the oldest VSC packets are pruned
+const MaxVSCPacketRecords = 100
+
+// SetVSCPacketRecord sets the record of a VSC packet sent to a consumer chain
+func (k Keeper) SetVSCPacketRecord(ctx sdk.Context, consumerId string, record types.VSCPacketRecord) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// record is instantiated by the caller and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal VSC packet record: %w", err))
+	}
+	store.Set(types.ConsumerIdToVSCHistoryKey(consumerId, record.ValsetUpdateId), bz)
+}
+
+// GetVSCPacketRecord returns the record of the VSC packet with the given valset update id sent to a consumer chain
+func (k Keeper) GetVSCPacketRecord(ctx sdk.Context, consumerId string, vscId uint64) (types.VSCPacketRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToVSCHistoryKey(consumerId, vscId))
+	if bz == nil {
+		return types.VSCPacketRecord{}, false
+	}
+	var record types.VSCPacketRecord
+	if err := record.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly serialized in SetVSCPacketRecord.
+		panic(fmt.Errorf("cannot unmarshal VSC packet record: %w", err))
+	}
+	return record, true
+}
+
+// GetAllVSCPacketRecords returns the records of the VSC packets sent to a consumer chain ordered by valset update id
+func (k Keeper) GetAllVSCPacketRecords(ctx sdk.Context, consumerId string) (records []types.VSCPacketRecord) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToVSCHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.VSCPacketRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in SetVSCPacketRecord.
+			panic(fmt.Errorf("cannot unmarshal VSC packet record: %w", err))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// DeleteVSCHistory deletes the records of all the VSC packets sent to a consumer chain
+func (k Keeper) DeleteVSCHistory(ctx sdk.Context, consumerId string) {
+	k.pruneVSCHistory(ctx, consumerId, 0)
+}
+
+// recordVSCPacketSent records a VSC packet sent to a consumer chain with the given sequence
+// and prunes the oldest records exceeding MaxVSCPacketRecords
+func (k Keeper) recordVSCPacketSent(ctx sdk.Context, consumerId string, sequence uint64, data ccv.ValidatorSetChangePacketData) {
+	k.SetVSCPacketRecord(ctx, consumerId, types.VSCPacketRecord{
+		ValsetUpdateId: data.ValsetUpdateId,
+		Sequence:       sequence,
+		NumUpdates:     uint64(len(data.ValidatorUpdates)),
+		SendHeight:     ctx.BlockHeight(),
+		SendTime:       ctx.BlockTime(),
+		AckStatus:      types.VSC_PACKET_ACK_STATUS_PENDING,
+	})
+	k.pruneVSCHistory(ctx, consumerId, MaxVSCPacketRecords)
+}
+
+// recordVSCPacketAckStatus records the acknowledgement status of a packet sent to a consumer chain.
+// Packets other than VSC packets and VSC packets that are no longer recorded are ignored.
+func (k Keeper) recordVSCPacketAckStatus(ctx sdk.Context, consumerId string, packet channeltypes.Packet, status types.VSCPacketAckStatus) {
+	var data ccv.ValidatorSetChangePacketData
+	if err := ccv.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not a VSC packet
+		return
+	}
+	record, found := k.GetVSCPacketRecord(ctx, consumerId, data.ValsetUpdateId)
+	if !found || record.Sequence != packet.Sequence {
+		return
+	}
+	record.AckStatus = status
+	record.AckHeight = ctx.BlockHeight()
+	k.SetVSCPacketRecord(ctx, consumerId, record)
+}
+
+// pruneVSCHistory deletes the records of the oldest VSC packets sent to a consumer chain,
+// so that at most the given number of records are retained
+func (k Keeper) pruneVSCHistory(ctx sdk.Context, consumerId string, retained int) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(types.ConsumerIdToVSCHistoryKeyPrefix(), consumerId))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for i := 0; i < len(keys)-retained; i++ {
+		store.Delete(keys[i])
+	}
+}
+
+// ReconcilePacketCommitments cross-references the packet commitments on the CCV channel `channelId`
+// of chain `consumerId` against the retained VSC packet records and returns the number of packet commitments,
+// the number of records, and the discrepancies ordered by sequence. Note that packets other than VSC packets
+// are not recorded and hence, they are reported as COMMITTED_UNKNOWN until they are acknowledged or time out.
+func (k Keeper) ReconcilePacketCommitments(
+	ctx sdk.Context,
+	consumerId, channelId string,
+) (numCommitments, numRecords int, discrepancies []types.PacketCommitmentDiscrepancy) {
+	commitments := k.channelKeeper.GetAllPacketCommitmentsAtChannel(ctx, k.portID, channelId)
+	records := k.GetAllVSCPacketRecords(ctx, consumerId)
+
+	committed := make(map[uint64]bool, len(commitments))
+	for _, commitment := range commitments {
+		committed[commitment.Sequence] = true
+	}
+	recorded := make(map[uint64]types.VSCPacketRecord, len(records))
+	for _, record := range records {
+		recorded[record.Sequence] = record
+	}
+
+	for _, commitment := range commitments {
+		if _, found := recorded[commitment.Sequence]; !found {
+			discrepancies = append(discrepancies, types.PacketCommitmentDiscrepancy{
+				Sequence: commitment.Sequence,
+				Type:     types.PACKET_COMMITMENT_DISCREPANCY_TYPE_COMMITTED_UNKNOWN,
+			})
+		}
+	}
+	for _, record := range records {
+		pending := record.AckStatus == types.VSC_PACKET_ACK_STATUS_PENDING
+		if pending == committed[record.Sequence] {
+			continue
+		}
+		discrepancyType := types.PACKET_COMMITMENT_DISCREPANCY_TYPE_RECORDED_UNCOMMITTED
+		if !pending {
+			discrepancyType = types.PACKET_COMMITMENT_DISCREPANCY_TYPE_COMMITTED_COMPLETED
+		}
+		discrepancies = append(discrepancies, types.PacketCommitmentDiscrepancy{
+			Sequence: record.Sequence,
+			Type:     discrepancyType,
+			Record:   &record,
+		})
+	}
+
+	sort.Slice(discrepancies, func(i, j int) bool {
+		return discrepancies[i].Sequence < discrepancies[j].Sequence
+	})
+	return len(commitments), len(records), discrepancies
+}
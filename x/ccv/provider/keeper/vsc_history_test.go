@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestVSCHistory tests that the VSC packets sent to a consumer chain are recorded
+// together with their acknowledgement status
+func TestVSCHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+	ctx = ctx.WithBlockHeight(10)
+
+	valUpdates := []abci.ValidatorUpdate{
+		{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 1},
+		{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey(), Power: 2},
+	}
+	packets := []ccv.ValidatorSetChangePacketData{
+		ccv.NewValidatorSetChangePacketData(valUpdates, 1, nil),
+		ccv.NewValidatorSetChangePacketData(valUpdates[:1], 2, nil),
+	}
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, packets...)
+
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID", gomock.Any(), gomock.Any(),
+			packets[0].GetBytes()).Return(uint64(7), nil).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID", gomock.Any(), gomock.Any(),
+			packets[1].GetBytes()).Return(uint64(8), nil).Times(1),
+	)
+	err := providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "channelID")
+	require.NoError(t, err)
+
+	records := providerKeeper.GetAllVSCPacketRecords(ctx, CONSUMER_ID)
+	require.Len(t, records, 2)
+	require.Equal(t, providertypes.VSCPacketRecord{
+		ValsetUpdateId: 1,
+		Sequence:       7,
+		NumUpdates:     2,
+		SendHeight:     10,
+		SendTime:       ctx.BlockTime(),
+		AckStatus:      providertypes.VSC_PACKET_ACK_STATUS_PENDING,
+	}, records[0])
+	require.Equal(t, uint64(8), records[1].Sequence)
+	require.Equal(t, uint64(1), records[1].NumUpdates)
+
+	// acknowledge the first packet
+	ctx = ctx.WithBlockHeight(12)
+	providerKeeper.HandleVSCPacketAckResult(ctx,
+		channeltypes.Packet{Sequence: 7, SourceChannel: "channelID", Data: packets[0].GetBytes()},
+		ccv.NewValidatorSetChangePacketAck(1, nil).GetBytes(),
+	)
+	record, found := providerKeeper.GetVSCPacketRecord(ctx, CONSUMER_ID, 1)
+	require.True(t, found)
+	require.Equal(t, providertypes.VSC_PACKET_ACK_STATUS_ACKNOWLEDGED, record.AckStatus)
+	require.Equal(t, int64(12), record.AckHeight)
+
+	// an acknowledgement with a sequence different from the recorded one is ignored
+	providerKeeper.HandleVSCPacketAckResult(ctx,
+		channeltypes.Packet{Sequence: 3, SourceChannel: "channelID", Data: packets[1].GetBytes()},
+		ccv.NewValidatorSetChangePacketAck(2, nil).GetBytes(),
+	)
+	record, found = providerKeeper.GetVSCPacketRecord(ctx, CONSUMER_ID, 2)
+	require.True(t, found)
+	require.Equal(t, providertypes.VSC_PACKET_ACK_STATUS_PENDING, record.AckStatus)
+	require.Zero(t, record.AckHeight)
+
+	providerKeeper.DeleteVSCHistory(ctx, CONSUMER_ID)
+	require.Empty(t, providerKeeper.GetAllVSCPacketRecords(ctx, CONSUMER_ID))
+}
+
+// TestVSCHistoryPruning tests that only the last MaxVSCPacketRecords VSC packets are recorded per consumer chain
+func TestVSCHistoryPruning(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	for vscId := uint64(1); vscId <= keeper.MaxVSCPacketRecords+5; vscId++ {
+		providerKeeper.SetVSCPacketRecord(ctx, CONSUMER_ID, providertypes.VSCPacketRecord{ValsetUpdateId: vscId})
+	}
+	// records of other consumer chains are not pruned
+	providerKeeper.SetVSCPacketRecord(ctx, "1", providertypes.VSCPacketRecord{ValsetUpdateId: 1})
+
+	// recording a sent packet prunes the oldest records
+	vscData := ccv.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, keeper.MaxVSCPacketRecords+6, nil)
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, vscData)
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), ccv.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(gomock.Any(), ccv.ProviderPortID, "channelID", gomock.Any(), gomock.Any(),
+			vscData.GetBytes()).Return(uint64(1), nil).Times(1),
+	)
+	err := providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "channelID")
+	require.NoError(t, err)
+
+	records := providerKeeper.GetAllVSCPacketRecords(ctx, CONSUMER_ID)
+	require.Len(t, records, keeper.MaxVSCPacketRecords)
+	require.Equal(t, uint64(7), records[0].ValsetUpdateId)
+	require.Equal(t, vscData.ValsetUpdateId, records[len(records)-1].ValsetUpdateId)
+	_, found := providerKeeper.GetVSCPacketRecord(ctx, CONSUMER_ID, 6)
+	require.False(t, found)
+	require.Len(t, providerKeeper.GetAllVSCPacketRecords(ctx, "1"), 1)
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const (
+	// MaxVSCStreamSubscribers is the maximum number of concurrent subscribers of StreamValidatorSetUpdates
+	MaxVSCStreamSubscribers = 100
+	// VSCStreamBufferSize is the number of VSC packets buffered per subscriber;
+	// subscribers that fall further behind are disconnected
+	VSCStreamBufferSize = 100
+)
+
+// vscStreamSubscriber is a subscriber of the VSC packets queued for a consumer chain
+type vscStreamSubscriber struct {
+	consumerId string
+	updates    chan *types.StreamValidatorSetUpdatesResponse
+}
+
+// vscStream publishes the VSC packets queued in a block to the subscribers of StreamValidatorSetUpdates
+// once the block is committed. It is local to the node and does not affect consensus.
+type vscStream struct {
+	mu          sync.Mutex
+	subscribers map[*vscStreamSubscriber]struct{}
+
+	// height and VSC packets queued in the block being finalized,
+	// only recorded if there are subscribers
+	height int64
+	queued []*vscStreamSubscriberUpdate
+}
+
+type vscStreamSubscriberUpdate struct {
+	consumerId string
+	update     *types.StreamValidatorSetUpdatesResponse
+}
+
+func newVSCStream() *vscStream {
+	return &vscStream{subscribers: map[*vscStreamSubscriber]struct{}{}}
+}
+
+func (s *vscStream) subscribe(consumerId string) (*vscStreamSubscriber, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.subscribers) >= MaxVSCStreamSubscribers {
+		return nil, false
+	}
+	sub := &vscStreamSubscriber{
+		consumerId: consumerId,
+		updates:    make(chan *types.StreamValidatorSetUpdatesResponse, VSCStreamBufferSize),
+	}
+	s.subscribers[sub] = struct{}{}
+	return sub, true
+}
+
+func (s *vscStream) unsubscribe(sub *vscStreamSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, found := s.subscribers[sub]; found {
+		delete(s.subscribers, sub)
+		close(sub.updates)
+	}
+}
+
+func (s *vscStream) record(height int64, consumerId string, valUpdateID uint64, valUpdates []abci.ValidatorUpdate) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.height != height {
+		// discard the VSC packets recorded for a block that was not committed
+		s.height = height
+		s.queued = nil
+	}
+	if len(s.subscribers) == 0 {
+		return
+	}
+	s.queued = append(s.queued, &vscStreamSubscriberUpdate{
+		consumerId: consumerId,
+		update: &types.StreamValidatorSetUpdatesResponse{
+			Height:           height,
+			ValsetUpdateId:   valUpdateID,
+			ValidatorUpdates: valUpdates,
+		},
+	})
+}
+
+// publish sends the VSC packets recorded for the block at `height` to the subscribers.
+// Subscribers whose buffer is full are disconnected.
+func (s *vscStream) publish(height int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	queued := s.queued
+	s.queued = nil
+	if s.height != height {
+		return
+	}
+
+	for _, q := range queued {
+		for sub := range s.subscribers {
+			if sub.consumerId != q.consumerId {
+				continue
+			}
+			select {
+			case sub.updates <- q.update:
+			default:
+				delete(s.subscribers, sub)
+				close(sub.updates)
+			}
+		}
+	}
+}
+
+// recordQueuedVSCPacket records a VSC packet queued for chain `consumerId`, so that it is
+// streamed to the subscribers of StreamValidatorSetUpdates once the block is committed
+func (k Keeper) recordQueuedVSCPacket(ctx sdk.Context, consumerId string, valUpdateID uint64, valUpdates []abci.ValidatorUpdate) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	k.vscStream.record(ctx.BlockHeight(), consumerId, valUpdateID, valUpdates)
+}
+
+// PublishQueuedVSCPackets streams the VSC packets queued in the last committed block
+// to the subscribers of StreamValidatorSetUpdates. It must be called once the block is committed,
+// i.e., in PrepareCheckState.
+func (k Keeper) PublishQueuedVSCPackets(ctx sdk.Context) {
+	k.vscStream.publish(ctx.BlockHeight())
+}
+
+// StreamValidatorSetUpdates streams the VSC packets queued for the consumer chain with the provided consumer id
+// as soon as the blocks in which they are queued are committed
+func (k Keeper) StreamValidatorSetUpdates(req *types.StreamValidatorSetUpdatesRequest, stream types.Query_StreamValidatorSetUpdatesServer) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if err := ccv.ValidateConsumerId(req.ConsumerId); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sub, ok := k.vscStream.subscribe(req.ConsumerId)
+	if !ok {
+		return status.Errorf(codes.ResourceExhausted, "too many subscribers, the maximum is %d", MaxVSCStreamSubscribers)
+	}
+	defer k.vscStream.unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case update, ok := <-sub.updates:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "subscriber fell behind by more than %d VSC packets", VSCStreamBufferSize)
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// fakeVSCStream is a server stream of StreamValidatorSetUpdates that forwards the sent responses to a channel
+type fakeVSCStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	subscribed chan struct{}
+	once       sync.Once
+	responses  chan *providertypes.StreamValidatorSetUpdatesResponse
+}
+
+func newFakeVSCStream(ctx context.Context) *fakeVSCStream {
+	return &fakeVSCStream{
+		ctx:        ctx,
+		subscribed: make(chan struct{}),
+		responses:  make(chan *providertypes.StreamValidatorSetUpdatesResponse, 10),
+	}
+}
+
+// Context is first called once the stream subscribed to the VSC packets
+func (s *fakeVSCStream) Context() context.Context {
+	s.once.Do(func() { close(s.subscribed) })
+	return s.ctx
+}
+
+func (s *fakeVSCStream) Send(resp *providertypes.StreamValidatorSetUpdatesResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+// TestStreamValidatorSetUpdates tests that the VSC packets queued for a consumer chain
+// are streamed once the block is committed
+func TestStreamValidatorSetUpdates(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// invalid requests
+	err := providerKeeper.StreamValidatorSetUpdates(nil, newFakeVSCStream(context.Background()))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = providerKeeper.StreamValidatorSetUpdates(&providertypes.StreamValidatorSetUpdatesRequest{}, newFakeVSCStream(context.Background()))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	valA, valB := newBondedStakingValidator(0), newBondedStakingValidator(1)
+	bondedValidators := []stakingtypes.Validator{valA, valB}
+	powers := map[string]int64{valA.OperatorAddress: 10, valB.OperatorAddress: 20}
+	mockMutableBondedValidators(mocks, &bondedValidators, powers)
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	for _, val := range bondedValidators {
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(consAddr))
+	}
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	stream := newFakeVSCStream(streamCtx)
+	streamErr := make(chan error)
+	go func() {
+		streamErr <- providerKeeper.StreamValidatorSetUpdates(
+			&providertypes.StreamValidatorSetUpdatesRequest{ConsumerId: CONSUMER_ID}, stream)
+	}()
+	<-stream.subscribed
+
+	// VSC packets queued while checking transactions are not streamed
+	ctx = ctx.WithBlockHeight(10)
+	checkCtx, _ := ctx.WithExecMode(sdk.ExecModeCheck).CacheContext()
+	err = providerKeeper.QueueVSCPackets(checkCtx)
+	require.NoError(t, err)
+	require.Len(t, providerKeeper.GetPendingVSCPackets(checkCtx, CONSUMER_ID), 1)
+	providerKeeper.PublishQueuedVSCPackets(ctx)
+	require.Empty(t, stream.responses)
+
+	// VSC packets queued while finalizing a block are streamed once the block is committed
+	ctx = ctx.WithBlockHeight(11).WithExecMode(sdk.ExecModeFinalize)
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+	require.Empty(t, stream.responses)
+	providerKeeper.PublishQueuedVSCPackets(ctx)
+
+	var resp *providertypes.StreamValidatorSetUpdatesResponse
+	select {
+	case resp = <-stream.responses:
+	case <-time.After(time.Second):
+		t.Fatal("VSC packet was not streamed")
+	}
+	require.Equal(t, int64(11), resp.Height)
+	require.Equal(t, valUpdateID, resp.ValsetUpdateId)
+	require.Len(t, resp.ValidatorUpdates, 2)
+
+	// VSC packets queued in a block that is not committed are discarded
+	powers[valA.OperatorAddress] = 15
+	consAddr, err := valA.GetConsAddr()
+	require.NoError(t, err)
+	providerKeeper.SetModifiedValidator(ctx, providertypes.NewProviderConsAddress(consAddr))
+	err = providerKeeper.QueueVSCPackets(ctx.WithBlockHeight(12))
+	require.NoError(t, err)
+	providerKeeper.PublishQueuedVSCPackets(ctx.WithBlockHeight(13))
+	require.Empty(t, stream.responses)
+
+	// the stream ends once its context is canceled
+	cancel()
+	require.NoError(t, <-streamErr)
+}
@@ -11,6 +11,7 @@ import (
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	v7 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v7"
 	v8 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v8"
+	v9 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -82,6 +83,7 @@ func (m Migrator) Migrate6to7(ctx sdktypes.Context) error {
 // The migration consists of the following actions:
 // - complete the outstanding paused unbonding ops waiting for VSCMaturedPackets from consumer chains
 // - migrate the ConsumerAddrsToPrune index to ConsumerAddrsToPruneV2
+// - migrate the launched consumer chains and the pending legacy proposals to consumer records
 // - cleanup deprecated state
 func (m Migrator) Migrate7to8(ctx sdktypes.Context) error {
 	store := ctx.KVStore(m.storeKey)
@@ -92,7 +94,17 @@ func (m Migrator) Migrate7to8(ctx sdktypes.Context) error {
 	if err := v8.MigrateLaunchedConsumerChains(ctx, store, m.providerKeeper); err != nil {
 		return err
 	}
+	if err := v8.MigrateLegacyProposals(ctx, store, m.providerKeeper); err != nil {
+		return err
+	}
 	v8.CleanupState(store)
 
 	return nil
 }
+
+// Migrate8to9 migrates x/ccvprovider state from consensus version 8 to 9.
+// The migration consists of indexing the opted-in validators by their provider consensus address.
+func (m Migrator) Migrate8to9(ctx sdktypes.Context) error {
+	store := ctx.KVStore(m.storeKey)
+	return v9.MigrateOptedInIndex(ctx, store, m.providerKeeper)
+}
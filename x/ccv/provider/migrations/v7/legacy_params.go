@@ -82,5 +82,15 @@ func GetParamsLegacy(ctx sdk.Context, paramspace ccvtypes.LegacyParamSubspace) t
 		getNumberOfEpochsToStartReceivingRewards(ctx, paramspace),
 		// this parameter is new so it doesn't need to be migrated, just initialized
 		types.DefaultMaxProviderConsensusValidators,
+		types.DefaultValsetCheckpointPeriod,
+		types.DefaultRelayerStalenessThreshold,
+		ccvtypes.DefaultClientExpiryWarningWindow,
+		types.DefaultValsetHistorySize,
+		types.DefaultMinConsumerCommissionRate,
+		types.DefaultValsetUpdateHeightRetentionMargin,
+		types.DefaultRelayerRebateFraction,
+		types.DefaultParams().RelayerRebatePerPacket,
+		types.DefaultMaxRelayerRebatesPerEpoch,
+		types.DefaultAllowConsumerKeyReuse,
 	)
 }
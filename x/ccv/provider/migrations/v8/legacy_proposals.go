@@ -0,0 +1,179 @@
+package v8
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateLegacyProposals converts the pending consumer addition and removal proposals,
+// i.e., proposals that passed but whose spawn or stop time has not yet been reached,
+// into message-based consumer records. Consumer chains are added as if created with `MsgCreateConsumer`
+// and owned by gov, while launched consumer chains are stopped as if removed with `MsgRemoveConsumer`,
+// since a consumer chain cannot be scheduled to stop at a later time.
+//
+// Note that consumer modification and equivocation proposals were executed when they passed
+// and have hence no pending state on the provider.
+// Note that it must be executed after MigrateLaunchedConsumerChains and before CleanupState.
+func MigrateLegacyProposals(ctx sdk.Context, store storetypes.KVStore, pk providerkeeper.Keeper) error {
+	// the consumer ids of the chains migrated by MigrateLaunchedConsumerChains
+	launchedConsumerIds := map[string]string{}
+	for _, consumerId := range pk.GetAllConsumerIds(ctx) {
+		if pk.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		chainId, err := pk.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return err
+		}
+		launchedConsumerIds[chainId] = consumerId
+	}
+
+	additionProposals, err := getLegacyPendingProposals(store, LegacyPendingCAPKeyPrefix,
+		func(bz []byte) (providertypes.ConsumerAdditionProposal, error) {
+			var prop providertypes.ConsumerAdditionProposal
+			err := prop.Unmarshal(bz)
+			return prop, err
+		})
+	if err != nil {
+		return err
+	}
+	for _, prop := range additionProposals {
+		if _, found := launchedConsumerIds[prop.ChainId]; found {
+			pk.Logger(ctx).Error("skipping pending consumer addition proposal of launched consumer chain",
+				"chainId", prop.ChainId,
+			)
+			continue
+		}
+		consumerId, err := migrateConsumerAdditionProposal(ctx, pk, prop)
+		if err != nil {
+			return err
+		}
+		pk.Logger(ctx).Info("migrated pending consumer addition proposal",
+			"chainId", prop.ChainId,
+			"consumerId", consumerId,
+			"spawnTime", prop.SpawnTime,
+		)
+	}
+
+	removalProposals, err := getLegacyPendingProposals(store, LegacyPendingCRPKeyPrefix,
+		func(bz []byte) (providertypes.ConsumerRemovalProposal, error) {
+			var prop providertypes.ConsumerRemovalProposal
+			err := prop.Unmarshal(bz)
+			return prop, err
+		})
+	if err != nil {
+		return err
+	}
+	for _, prop := range removalProposals {
+		consumerId, found := launchedConsumerIds[prop.ChainId]
+		if !found {
+			pk.Logger(ctx).Error("skipping pending consumer removal proposal of consumer chain that is not launched",
+				"chainId", prop.ChainId,
+			)
+			continue
+		}
+		if err := pk.StopAndPrepareForConsumerRemoval(ctx, consumerId); err != nil {
+			return err
+		}
+		delete(launchedConsumerIds, prop.ChainId)
+		pk.Logger(ctx).Info("migrated pending consumer removal proposal",
+			"chainId", prop.ChainId,
+			"consumerId", consumerId,
+			"stopTime", prop.StopTime,
+		)
+	}
+
+	return nil
+}
+
+// migrateConsumerAdditionProposal creates the consumer record of a pending consumer addition proposal
+// and schedules the launch of the consumer chain at the spawn time of the proposal
+func migrateConsumerAdditionProposal(
+	ctx sdk.Context,
+	pk providerkeeper.Keeper,
+	prop providertypes.ConsumerAdditionProposal,
+) (string, error) {
+	consumerId := pk.FetchAndIncrementConsumerId(ctx)
+
+	// the proposal passed, so the consumer chain is owned by gov
+	pk.SetConsumerOwnerAddress(ctx, consumerId, pk.GetAuthority())
+	pk.SetConsumerChainId(ctx, consumerId, prop.ChainId)
+	pk.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_REGISTERED)
+
+	if err := pk.SetConsumerMetadata(ctx, consumerId, providertypes.ConsumerMetadata{
+		Name:        prop.ChainId,
+		Description: prop.Description,
+		Metadata:    prop.Title,
+	}); err != nil {
+		return consumerId, err
+	}
+
+	if err := pk.SetConsumerInitializationParameters(ctx, consumerId, providertypes.ConsumerInitializationParameters{
+		InitialHeight:                     prop.InitialHeight,
+		GenesisHash:                       prop.GenesisHash,
+		BinaryHash:                        prop.BinaryHash,
+		SpawnTime:                         prop.SpawnTime,
+		UnbondingPeriod:                   prop.UnbondingPeriod,
+		CcvTimeoutPeriod:                  prop.CcvTimeoutPeriod,
+		TransferTimeoutPeriod:             prop.TransferTimeoutPeriod,
+		ConsumerRedistributionFraction:    prop.ConsumerRedistributionFraction,
+		BlocksPerDistributionTransmission: prop.BlocksPerDistributionTransmission,
+		HistoricalEntries:                 prop.HistoricalEntries,
+		DistributionTransmissionChannel:   prop.DistributionTransmissionChannel,
+	}); err != nil {
+		return consumerId, err
+	}
+
+	if err := pk.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{
+		Top_N:              prop.Top_N,
+		ValidatorsPowerCap: prop.ValidatorsPowerCap,
+		ValidatorSetCap:    prop.ValidatorSetCap,
+		Allowlist:          prop.Allowlist,
+		Denylist:           prop.Denylist,
+		MinStake:           prop.MinStake,
+		AllowInactiveVals:  prop.AllowInactiveVals,
+	}); err != nil {
+		return consumerId, err
+	}
+
+	if err := pk.SetDefaultInfractionParameters(ctx, consumerId); err != nil {
+		return consumerId, err
+	}
+
+	// a spawn time that already passed results in the consumer chain being launched in the next block
+	if spawnTime, initialized := pk.InitializeConsumer(ctx, consumerId); initialized {
+		if err := pk.PrepareConsumerForLaunch(ctx, consumerId, time.Time{}, spawnTime); err != nil {
+			return consumerId, err
+		}
+	}
+
+	return consumerId, nil
+}
+
+// getLegacyPendingProposals returns the pending proposals stored under
+// `keyPrefix | timestamp | chainId`, ordered by timestamp
+func getLegacyPendingProposals[P any](
+	store storetypes.KVStore,
+	keyPrefix byte,
+	unmarshal func([]byte) (P, error),
+) ([]P, error) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{keyPrefix})
+	defer iterator.Close()
+
+	proposals := []P{}
+	for ; iterator.Valid(); iterator.Next() {
+		prop, err := unmarshal(iterator.Value())
+		if err != nil {
+			return nil, err
+		}
+		proposals = append(proposals, prop)
+	}
+
+	return proposals, nil
+}
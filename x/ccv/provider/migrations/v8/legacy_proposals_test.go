@@ -0,0 +1,137 @@
+package v8
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func legacySetPendingProposal(
+	store storetypes.KVStore,
+	keyPrefix byte,
+	timestamp time.Time,
+	chainId string,
+	prop interface{ Marshal() ([]byte, error) },
+) {
+	bz, err := prop.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	key := append([]byte{keyPrefix}, sdk.Uint64ToBigEndian(uint64(timestamp.UTC().UnixNano()))...)
+	store.Set(append(key, []byte(chainId)...), bz)
+}
+
+// TestMigrateLegacyProposals tests that the pending consumer addition and removal proposals
+// are converted into consumer records
+func TestMigrateLegacyProposals(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+	store := ctx.KVStore(inMemParams.StoreKey)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+
+	// a launched chain, which is migrated to consumer id "0"
+	launchedChainId := "chainId1"
+	StoreChainDataUsingChainIdAsKey(ctx, store, providerKeeper, CreateTestChainData(launchedChainId, "clientId1", "channelId1"))
+	err := MigrateLaunchedConsumerChains(ctx, store, providerKeeper)
+	require.NoError(t, err)
+
+	spawnTime := ctx.BlockTime().Add(time.Hour)
+	addition := providertypes.ConsumerAdditionProposal{
+		Title:                             "title",
+		Description:                       "description",
+		ChainId:                           "newchain-1",
+		InitialHeight:                     clienttypes.NewHeight(1, 1),
+		GenesisHash:                       []byte("gen_hash"),
+		BinaryHash:                        []byte("bin_hash"),
+		SpawnTime:                         spawnTime,
+		UnbondingPeriod:                   time.Hour,
+		CcvTimeoutPeriod:                  2 * time.Hour,
+		TransferTimeoutPeriod:             3 * time.Hour,
+		ConsumerRedistributionFraction:    "0.75",
+		BlocksPerDistributionTransmission: 10,
+		HistoricalEntries:                 100,
+		Top_N:                             95,
+		ValidatorsPowerCap:                10,
+		MinStake:                          1000,
+	}
+	legacySetPendingProposal(store, LegacyPendingCAPKeyPrefix, spawnTime, addition.ChainId, &addition)
+
+	// pending addition proposals of launched chains are skipped
+	legacySetPendingProposal(store, LegacyPendingCAPKeyPrefix, spawnTime, launchedChainId,
+		&providertypes.ConsumerAdditionProposal{ChainId: launchedChainId, SpawnTime: spawnTime})
+
+	removal := providertypes.ConsumerRemovalProposal{ChainId: launchedChainId, StopTime: spawnTime}
+	legacySetPendingProposal(store, LegacyPendingCRPKeyPrefix, spawnTime, removal.ChainId, &removal)
+
+	// pending removal proposals of chains that are not launched are skipped
+	legacySetPendingProposal(store, LegacyPendingCRPKeyPrefix, spawnTime, "unknown",
+		&providertypes.ConsumerRemovalProposal{ChainId: "unknown", StopTime: spawnTime})
+
+	err = MigrateLegacyProposals(ctx, store, providerKeeper)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0", "1"}, providerKeeper.GetAllConsumerIds(ctx))
+
+	// the launched chain is stopped and removed after the unbonding period
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, "0"))
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), removalTime)
+
+	// the added chain is owned by gov and launched at the spawn time
+	consumerId := "1"
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	chainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, addition.ChainId, chainId)
+	owner, err := providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, providerKeeper.GetAuthority(), owner)
+
+	metadata, err := providerKeeper.GetConsumerMetadata(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerMetadata{
+		Name:        addition.ChainId,
+		Description: addition.Description,
+		Metadata:    addition.Title,
+	}, metadata)
+
+	initializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, addition.InitialHeight, initializationParameters.InitialHeight)
+	require.Equal(t, addition.GenesisHash, initializationParameters.GenesisHash)
+	require.Equal(t, addition.BinaryHash, initializationParameters.BinaryHash)
+	require.Equal(t, addition.SpawnTime, initializationParameters.SpawnTime)
+	require.Equal(t, addition.UnbondingPeriod, initializationParameters.UnbondingPeriod)
+	require.Equal(t, addition.ConsumerRedistributionFraction, initializationParameters.ConsumerRedistributionFraction)
+	require.Equal(t, addition.BlocksPerDistributionTransmission, initializationParameters.BlocksPerDistributionTransmission)
+
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, addition.Top_N, powerShapingParameters.Top_N)
+	require.Equal(t, addition.ValidatorsPowerCap, powerShapingParameters.ValidatorsPowerCap)
+	require.Equal(t, addition.MinStake, powerShapingParameters.MinStake)
+
+	_, err = providerKeeper.GetInfractionParameters(ctx, consumerId)
+	require.NoError(t, err)
+
+	consumersToBeLaunched, err := providerKeeper.GetConsumersToBeLaunched(ctx, spawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerId}, consumersToBeLaunched.Ids)
+}
@@ -0,0 +1,31 @@
+package v9
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateOptedInIndex builds the index from the provider consensus addresses
+// of the opted-in validators to the consumer chains they are opted in to
+func MigrateOptedInIndex(ctx sdk.Context, store storetypes.KVStore, pk providerkeeper.Keeper) error {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.OptedInKeyPrefix()})
+	defer iterator.Close()
+
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, addr, err := providertypes.ParseStringIdAndConsAddrKey(providertypes.OptedInKeyPrefix(), iterator.Key())
+		if err != nil {
+			return err
+		}
+		store.Set(providertypes.OptedInByValidatorKey(providertypes.NewProviderConsAddress(addr), consumerId), []byte{})
+		count++
+	}
+
+	pk.Logger(ctx).Info("Opted-in validators indexed by provider consensus address", "count", count)
+
+	return nil
+}
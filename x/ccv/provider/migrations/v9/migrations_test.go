@@ -0,0 +1,39 @@
+package v9
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestMigrateOptedInIndex(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	store := ctx.KVStore(inMemParams.StoreKey)
+
+	providerAddr1 := providertypes.NewProviderConsAddress([]byte("providerAddr1"))
+	providerAddr2 := providertypes.NewProviderConsAddress([]byte("providerAddr2"))
+
+	// set the opted-in validators without the index, as before the migration
+	store.Set(providertypes.OptedInKey("0", providerAddr1), []byte{})
+	store.Set(providertypes.OptedInKey("1", providerAddr1), []byte{})
+	store.Set(providertypes.OptedInKey("1", providerAddr2), []byte{})
+	require.Empty(t, pk.GetOptedInConsumerIds(ctx, providerAddr1))
+	require.Empty(t, pk.GetOptedInConsumerIds(ctx, providerAddr2))
+
+	err := MigrateOptedInIndex(ctx, store, pk)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"0", "1"}, pk.GetOptedInConsumerIds(ctx, providerAddr1))
+	require.Equal(t, []string{"1"}, pk.GetOptedInConsumerIds(ctx, providerAddr2))
+
+	// the index is kept in sync after the migration
+	pk.DeleteAllOptedIn(ctx, "1")
+	require.Equal(t, []string{"0"}, pk.GetOptedInConsumerIds(ctx, providerAddr1))
+	require.Empty(t, pk.GetOptedInConsumerIds(ctx, providerAddr2))
+}
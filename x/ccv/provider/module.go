@@ -26,6 +26,7 @@ import (
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/simulation"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 var (
@@ -40,8 +41,18 @@ var (
 	_ module.HasABCIEndBlock     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
 	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
+
+	_ appmodule.HasPrepareCheckState = (*AppModule)(nil)
 )
 
+// FlagBlockTimeBudget is the start flag setting the duration above which
+// the provider BeginBlock or EndBlock is reported as slow
+const FlagBlockTimeBudget = "x-provider-block-time-budget"
+
+// FlagBlockProfileDir is the start flag setting the directory the CPU profiles
+// of the provider BeginBlocks and EndBlocks that exceed the time budget are written to
+const FlagBlockProfileDir = "x-provider-block-profile-dir"
+
 // AppModuleBasic is the IBC Provider AppModuleBasic
 type AppModuleBasic struct{}
 
@@ -101,6 +112,13 @@ func (AppModuleBasic) GetQueryCmd() *cobra.Command {
 	return cli.NewQueryCmd()
 }
 
+// AddModuleInitFlags implements servertypes.ModuleInitFlags interface.
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().Duration(FlagBlockTimeBudget, 0, "Duration above which the provider BeginBlock or EndBlock is reported as slow (0 to disable)")
+	startCmd.Flags().String(FlagBlockProfileDir, "", "Directory the CPU profiles of the provider BeginBlocks and EndBlocks exceeding the time budget are written to (empty to disable)")
+	ccv.AddStreamingFlags(startCmd)
+}
+
 // AppModule represents the AppModule for this module
 type AppModule struct {
 	AppModuleBasic
@@ -147,6 +165,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(providertypes.ModuleName, 7, migrator.Migrate7to8); err != nil {
 		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 7 -> 8", providertypes.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 8, migrator.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 8 -> 9", providertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the provider module. It returns validator updates
@@ -168,28 +189,35 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 8 }
+func (AppModule) ConsensusVersion() uint64 { return 9 }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer am.keeper.StartBlockHook(sdkCtx, keeper.BlockHookBeginBlock)()
+	timer := am.keeper.NewBlockPhaseTimer()
 
 	// Create clients to consumer chains that are due to be spawned
 	if err := am.keeper.BeginBlockLaunchConsumers(sdkCtx); err != nil {
 		return err
 	}
+	timer.Lap(keeper.BlockPhaseLaunchConsumers)
 	// Stop and remove state for any consumer chains that are due to be stopped
 	if err := am.keeper.BeginBlockRemoveConsumers(sdkCtx); err != nil {
 		return err
 	}
+	timer.Lap(keeper.BlockPhaseRemoveConsumers)
 	// Update the infraction parameters for consumer chains that are scheduled for an update
 	if err := am.keeper.BeginBlockUpdateInfractionParameters(sdkCtx); err != nil {
 		return err
 	}
+	timer.Lap(keeper.BlockPhaseInfractionParameters)
 	// Check for replenishing slash meter before any slash packets are processed for this block
 	am.keeper.BeginBlockCIS(sdkCtx)
+	timer.Lap(keeper.BlockPhaseSlashMeter)
 	// BeginBlock logic needed for the  Reward Distribution sub-protocol
 	am.keeper.BeginBlockRD(sdkCtx)
+	timer.Lap(keeper.BlockPhaseRewardDistribution)
 
 	return nil
 }
@@ -197,12 +225,45 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 // EndBlock implements the AppModule interface
 func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	defer am.keeper.StartBlockHook(sdkCtx, keeper.BlockHookEndBlock)()
+	timer := am.keeper.NewBlockPhaseTimer()
 
 	// EndBlock logic needed for the Consumer Initiated Slashing sub-protocol.
 	// Important: EndBlockCIS must be called before EndBlockVSU
 	am.keeper.EndBlockCIS(sdkCtx)
-	// EndBlock logic needed for the Validator Set Update sub-protocol
-	return am.keeper.EndBlockVSU(sdkCtx)
+	timer.Lap(keeper.BlockPhasePruning)
+	// warn about stale relayers before the VSC packets of this epoch are sent
+	am.keeper.EndBlockRelayerLiveness(sdkCtx)
+	timer.Lap(keeper.BlockPhaseRelayerLiveness)
+	// EndBlock logic needed for the Validator Set Update sub-protocol;
+	// its phases are measured by EndBlockVSU
+	valUpdates, err := am.keeper.EndBlockVSU(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+	timer.Skip()
+	// push the pending downtime params after the VSC packets of this block
+	am.keeper.EndBlockDowntimeParams(sdkCtx)
+	timer.Lap(keeper.BlockPhaseDowntimeParams)
+	// push the consumer rewards pool address if it changed, e.g., after a module account migration
+	am.keeper.EndBlockProviderFeePoolAddr(sdkCtx)
+	timer.Lap(keeper.BlockPhaseProviderFeePoolAddr)
+	am.keeper.EndBlockClientExpiry(sdkCtx)
+	timer.Lap(keeper.BlockPhaseClientExpiry)
+	am.keeper.EndBlockDropOffWarnings(sdkCtx)
+	timer.Lap(keeper.BlockPhaseDropOffWarnings)
+	am.keeper.EndBlockCommissionRates(sdkCtx)
+	timer.Lap(keeper.BlockPhaseCommissionRates)
+	am.keeper.EndBlockTelemetry(sdkCtx)
+	timer.Lap(keeper.BlockPhaseTelemetry)
+	return valUpdates, nil
+}
+
+// PrepareCheckState implements the appmodule.HasPrepareCheckState interface
+func (am AppModule) PrepareCheckState(ctx context.Context) error {
+	// stream the VSC packets queued in the committed block
+	am.keeper.PublishQueuedVSCPackets(sdk.UnwrapSDKContext(ctx))
+	return nil
 }
 
 // AppModuleSimulation functions
@@ -217,6 +278,6 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 }
 
 // WeightedOperations returns the all the provider module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, am.keeper)
 }
@@ -0,0 +1,430 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgCreateConsumer            int = 20
+	DefaultWeightMsgUpdateConsumer            int = 20
+	DefaultWeightMsgRemoveConsumer            int = 5
+	DefaultWeightMsgOptIn                     int = 100
+	DefaultWeightMsgOptOut                    int = 30
+	DefaultWeightMsgAssignConsumerKey         int = 50
+	DefaultWeightMsgSetConsumerCommissionRate int = 50
+
+	OpWeightMsgCreateConsumer            = "op_weight_msg_create_consumer"
+	OpWeightMsgUpdateConsumer            = "op_weight_msg_update_consumer"
+	OpWeightMsgRemoveConsumer            = "op_weight_msg_remove_consumer"
+	OpWeightMsgOptIn                     = "op_weight_msg_opt_in"
+	OpWeightMsgOptOut                    = "op_weight_msg_opt_out"
+	OpWeightMsgAssignConsumerKey         = "op_weight_msg_assign_consumer_key"
+	OpWeightMsgSetConsumerCommissionRate = "op_weight_msg_set_consumer_commission_rate"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+// The operations create, update and remove consumer chains, and let the validators opt in and out,
+// assign consumer keys and set consumer commission rates. Note that the simulation runs a single
+// provider chain, i.e., the launched consumer chains never establish a CCV channel and
+// the packets to the consumer chains are not relayed.
+func WeightedOperations(appParams simtypes.AppParams, k *keeper.Keeper) simulation.WeightedOperations {
+	var (
+		weightMsgCreateConsumer            int
+		weightMsgUpdateConsumer            int
+		weightMsgRemoveConsumer            int
+		weightMsgOptIn                     int
+		weightMsgOptOut                    int
+		weightMsgAssignConsumerKey         int
+		weightMsgSetConsumerCommissionRate int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateConsumer, &weightMsgCreateConsumer, nil, func(_ *rand.Rand) {
+		weightMsgCreateConsumer = DefaultWeightMsgCreateConsumer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgUpdateConsumer, &weightMsgUpdateConsumer, nil, func(_ *rand.Rand) {
+		weightMsgUpdateConsumer = DefaultWeightMsgUpdateConsumer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgRemoveConsumer, &weightMsgRemoveConsumer, nil, func(_ *rand.Rand) {
+		weightMsgRemoveConsumer = DefaultWeightMsgRemoveConsumer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgOptIn, &weightMsgOptIn, nil, func(_ *rand.Rand) {
+		weightMsgOptIn = DefaultWeightMsgOptIn
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgOptOut, &weightMsgOptOut, nil, func(_ *rand.Rand) {
+		weightMsgOptOut = DefaultWeightMsgOptOut
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgAssignConsumerKey, &weightMsgAssignConsumerKey, nil, func(_ *rand.Rand) {
+		weightMsgAssignConsumerKey = DefaultWeightMsgAssignConsumerKey
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgSetConsumerCommissionRate, &weightMsgSetConsumerCommissionRate, nil, func(_ *rand.Rand) {
+		weightMsgSetConsumerCommissionRate = DefaultWeightMsgSetConsumerCommissionRate
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateConsumer,
+			SimulateMsgCreateConsumer(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateConsumer,
+			SimulateMsgUpdateConsumer(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveConsumer,
+			SimulateMsgRemoveConsumer(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOptIn,
+			SimulateMsgOptIn(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOptOut,
+			SimulateMsgOptOut(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAssignConsumerKey,
+			SimulateMsgAssignConsumerKey(k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetConsumerCommissionRate,
+			SimulateMsgSetConsumerCommissionRate(k),
+		),
+	}
+}
+
+// SimulateMsgCreateConsumer generates a MsgCreateConsumer with random values,
+// owned by a random account; with probability 1/2, the consumer chain is set to launch
+// within the next minutes
+func SimulateMsgCreateConsumer(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		// the revision number of the initial height has to match the one of the chain ID
+		revisionNumber := simtypes.RandIntBetween(r, 1, 10)
+		initializationParameters := types.DefaultConsumerInitializationParameters()
+		initializationParameters.InitialHeight = clienttypes.NewHeight(uint64(revisionNumber), 1)
+		if r.Intn(2) == 0 {
+			initializationParameters.SpawnTime = ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 600)) * time.Second)
+		}
+		powerShapingParameters := randomPowerShapingParameters(r)
+
+		msg, err := types.NewMsgCreateConsumer(
+			simAccount.Address.String(),
+			fmt.Sprintf("%s-%d", simtypes.RandStringOfLength(r, 8), revisionNumber),
+			types.ConsumerMetadata{
+				Name:        simtypes.RandStringOfLength(r, 10),
+				Description: simtypes.RandStringOfLength(r, 50),
+				Metadata:    simtypes.RandStringOfLength(r, 20),
+			},
+			&initializationParameters,
+			&powerShapingParameters,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgCreateConsumer{}), err.Error()), nil, nil
+		}
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.CreateConsumer(ctx, msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgUpdateConsumer generates a MsgUpdateConsumer with random power-shaping parameters
+// for a random active consumer chain owned by one of the simulation accounts
+func SimulateMsgUpdateConsumer(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgUpdateConsumer{})
+
+		consumerId, owner, found := randomOwnedConsumer(r, ctx, k, accs, types.CONSUMER_PHASE_REGISTERED,
+			types.CONSUMER_PHASE_INITIALIZED, types.CONSUMER_PHASE_LAUNCHED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no consumer chain owned by a simulation account"), nil, nil
+		}
+
+		powerShapingParameters := randomPowerShapingParameters(r)
+		msg, err := types.NewMsgUpdateConsumer(owner.Address.String(), consumerId, owner.Address.String(),
+			nil, nil, &powerShapingParameters, nil, "", nil)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.UpdateConsumer(ctx, msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgRemoveConsumer generates a MsgRemoveConsumer for a random launched consumer chain
+// owned by one of the simulation accounts
+func SimulateMsgRemoveConsumer(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRemoveConsumer{})
+
+		consumerId, owner, found := randomOwnedConsumer(r, ctx, k, accs, types.CONSUMER_PHASE_LAUNCHED)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no launched consumer chain owned by a simulation account"), nil, nil
+		}
+
+		msg, err := types.NewMsgRemoveConsumer(owner.Address.String(), consumerId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.RemoveConsumer(ctx, msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgOptIn generates a MsgOptIn of a random bonded validator to a random active consumer chain;
+// with probability 1/2, the validator assigns a random consumer key when opting in
+func SimulateMsgOptIn(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOptIn{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer chain"), nil, nil
+		}
+		valAddr, found := randomBondedValidator(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator"), nil, nil
+		}
+
+		consumerKey := ""
+		if r.Intn(2) == 0 {
+			consumerKey = randomConsumerKey(r)
+		}
+		msg, err := types.NewMsgOptIn(consumerId, valAddr, consumerKey, sdk.AccAddress(valAddr).String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.OptIn(ctx, msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgOptOut generates a MsgOptOut of a random bonded validator from a random active consumer chain
+func SimulateMsgOptOut(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOptOut{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer chain"), nil, nil
+		}
+		valAddr, found := randomBondedValidator(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator"), nil, nil
+		}
+
+		msg, err := types.NewMsgOptOut(consumerId, valAddr, sdk.AccAddress(valAddr).String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.OptOut(ctx, msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgAssignConsumerKey generates a MsgAssignConsumerKey of a random consumer key
+// by a random bonded validator on a random active consumer chain
+func SimulateMsgAssignConsumerKey(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAssignConsumerKey{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer chain"), nil, nil
+		}
+		valAddr, found := randomBondedValidator(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator"), nil, nil
+		}
+
+		msg, err := types.NewMsgAssignConsumerKey(consumerId, valAddr, randomConsumerKey(r), sdk.AccAddress(valAddr).String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.AssignConsumerKey(ctx, msg)
+			return err
+		})
+	}
+}
+
+// SimulateMsgSetConsumerCommissionRate generates a MsgSetConsumerCommissionRate of a random commission rate
+// by a random bonded validator on a random active consumer chain
+func SimulateMsgSetConsumerCommissionRate(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetConsumerCommissionRate{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer chain"), nil, nil
+		}
+		valAddr, found := randomBondedValidator(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator"), nil, nil
+		}
+
+		commissionRate := math.LegacyNewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 100)), 2)
+		msg := types.NewMsgSetConsumerCommissionRate(consumerId, commissionRate, valAddr, sdk.AccAddress(valAddr).String())
+
+		return deliverMsg(ctx, k, msg, func(ctx sdk.Context, msgServer types.MsgServer) error {
+			_, err := msgServer.SetConsumerCommissionRate(ctx, msg)
+			return err
+		})
+	}
+}
+
+// deliverMsg validates the message and handles it with the provider msg server in a cached context,
+// which is only written if the message is handled successfully, i.e., as if the message was delivered in a tx.
+// Messages that fail are reported as no-op, as the random messages are not guaranteed to be valid.
+func deliverMsg(ctx sdk.Context, k *keeper.Keeper, msg sdk.Msg, handle func(sdk.Context, types.MsgServer) error) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+		}
+	}
+
+	cachedCtx, writeCache := ctx.CacheContext()
+	if err := handle(cachedCtx, keeper.NewMsgServerImpl(k)); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, err.Error()), nil, nil
+	}
+	writeCache()
+
+	return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+}
+
+// randomPowerShapingParameters returns random power-shaping parameters of an opt-in chain,
+// as only the governance can create or update Top N chains
+func randomPowerShapingParameters(r *rand.Rand) types.PowerShapingParameters {
+	powerShapingParameters := types.PowerShapingParameters{
+		AllowInactiveVals: r.Intn(2) == 0,
+	}
+	if r.Intn(3) == 0 {
+		powerShapingParameters.ValidatorSetCap = uint32(simtypes.RandIntBetween(r, 1, 50))
+	}
+	if r.Intn(3) == 0 {
+		powerShapingParameters.ValidatorsPowerCap = uint32(simtypes.RandIntBetween(r, 1, 100))
+	}
+	return powerShapingParameters
+}
+
+// randomConsumerKey returns the JSON encoding of a random ed25519 consumer public key
+func randomConsumerKey(r *rand.Rand) string {
+	pubKey := ed25519.GenPrivKeyFromSecret([]byte(simtypes.RandStringOfLength(r, 32))).PubKey()
+	return fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`,
+		base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+}
+
+// randomActiveConsumer returns a random consumer chain that is registered, initialized or launched
+func randomActiveConsumer(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) (string, bool) {
+	consumerIds := k.GetAllActiveConsumerIds(ctx)
+	if len(consumerIds) == 0 {
+		return "", false
+	}
+	return consumerIds[r.Intn(len(consumerIds))], true
+}
+
+// randomOwnedConsumer returns a random consumer chain in one of the given phases,
+// together with the simulation account that owns it
+func randomOwnedConsumer(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account,
+	phases ...types.ConsumerPhase,
+) (string, simtypes.Account, bool) {
+	consumerIds := k.GetAllConsumerIds(ctx)
+	r.Shuffle(len(consumerIds), func(i, j int) {
+		consumerIds[i], consumerIds[j] = consumerIds[j], consumerIds[i]
+	})
+
+	for _, consumerId := range consumerIds {
+		phase := k.GetConsumerPhase(ctx, consumerId)
+		for _, p := range phases {
+			if phase != p {
+				continue
+			}
+			ownerAddr, err := k.GetConsumerOwnerAddress(ctx, consumerId)
+			if err != nil {
+				break
+			}
+			addr, err := sdk.AccAddressFromBech32(ownerAddr)
+			if err != nil {
+				break
+			}
+			if owner, found := simtypes.FindAccount(accs, addr); found {
+				return consumerId, owner, true
+			}
+			break
+		}
+	}
+	return "", simtypes.Account{}, false
+}
+
+// randomBondedValidator returns the operator address of a random bonded validator
+func randomBondedValidator(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) (sdk.ValAddress, bool) {
+	validators, err := k.GetLastBondedValidators(ctx)
+	if err != nil || len(validators) == 0 {
+		return nil, false
+	}
+	validator := validators[r.Intn(len(validators))]
+	if validator.GetStatus() != stakingtypes.Bonded {
+		return nil, false
+	}
+	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	if err != nil {
+		return nil, false
+	}
+	return valAddr, true
+}
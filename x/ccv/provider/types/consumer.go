@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -25,3 +29,19 @@ func NewConsumerStates(
 		Phase:                phase,
 	}
 }
+
+// ToBankMetadata returns the bank metadata of the reward denom,
+// i.e., with the reward denom as base unit and the display denom as display unit
+func (m RewardDenomMetadata) ToBankMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("%s reward denom of a consumer chain", m.Symbol),
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: m.Denom, Exponent: 0},
+			{Denom: m.Display, Exponent: m.Exponent},
+		},
+		Base:    m.Denom,
+		Display: m.Display,
+		Name:    m.Name,
+		Symbol:  m.Symbol,
+	}
+}
@@ -41,4 +41,16 @@ var (
 	ErrInvalidMsgChangeRewardDenoms            = errorsmod.Register(ModuleName, 52, "invalid change reward denoms message")
 	ErrInvalidAllowlistedRewardDenoms          = errorsmod.Register(ModuleName, 53, "invalid allowlisted reward denoms")
 	ErrInvalidConsumerInfractionParameters     = errorsmod.Register(ModuleName, 54, "invalid consumer infraction parameters")
+	ErrInvalidMsgStoreShapingTemplate          = errorsmod.Register(ModuleName, 55, "invalid store shaping template message")
+	ErrUnknownPowerShapingTemplate             = errorsmod.Register(ModuleName, 56, "unknown power-shaping template")
+	ErrInvalidMsgRegisterConsumerUpgrade       = errorsmod.Register(ModuleName, 57, "invalid register consumer upgrade message")
+	ErrInvalidMsgCancelConsumerUpgrade         = errorsmod.Register(ModuleName, 58, "invalid cancel consumer upgrade message")
+	ErrNoConsumerUpgradePlan                   = errorsmod.Register(ModuleName, 59, "consumer upgrade plan not found")
+	ErrInvalidMsgFlushKeyAssignmentReplacement = errorsmod.Register(ModuleName, 60, "invalid flush key assignment replacement message")
+	ErrUnknownKeyAssignmentReplacement         = errorsmod.Register(ModuleName, 61, "key assignment replacement not found")
+	ErrKeyAssignmentReplacementNotStuck        = errorsmod.Register(ModuleName, 62, "key assignment replacement can not be pruned yet")
+	ErrConsumerKeyReused                       = errorsmod.Register(ModuleName, 63, "consumer key assigned on another consumer chain")
+	ErrInvalidMsgSetConsumerCommittee          = errorsmod.Register(ModuleName, 64, "invalid set consumer committee message")
+	ErrInvalidMsgRevertConsumerSlash           = errorsmod.Register(ModuleName, 65, "invalid revert consumer slash message")
+	ErrNoConsumerDowntimeJail                  = errorsmod.Register(ModuleName, 66, "validator not jailed due to consumer downtime")
 )
@@ -14,12 +14,45 @@ const (
 	EventTypeRemoveConsumer            = "remove_consumer"
 	EventTypeReceivedRewards           = "received_ics_rewards"
 	EventTypeDistributedRewards        = "distributed_ics_rewards"
+	EventTypeVscConfirmationMismatch   = "vsc_confirmation_mismatch"
+	EventTypeConsumerFailure           = "consumer_failure"
+	EventTypeRelayerLivenessWarning    = "relayer_liveness_warning"
+	EventTypeStoreShapingTemplate      = "store_shaping_template"
+	EventTypeRegisterConsumerUpgrade   = "register_consumer_upgrade"
+	EventTypeCancelConsumerUpgrade     = "cancel_consumer_upgrade"
+	EventTypeFlushKeyAssignment        = "flush_key_assignment_replacement"
+	EventTypeSetConsumerCommittee      = "set_consumer_committee"
+	EventTypeRevertConsumerSlash       = "revert_consumer_slash"
+	EventTypeValidatorDropOffWarning   = "validator_drop_off_warning"
+	EventTypeInvalidVSCPacket          = "invalid_vsc_packet"
+
+	// Provider state transition events. Unlike the message events above, they are
+	// emitted by the keeper whenever the corresponding state changes, independently
+	// of what triggered the change, and they use consistent attribute keys, i.e.,
+	// consumer_id, provider_cons_address and valset_update_id.
+	EventTypeConsumerPhaseChanged          = "consumer_phase_changed"
+	EventTypeValidatorOptedIn              = "validator_opted_in"
+	EventTypeValidatorOptedOut             = "validator_opted_out"
+	EventTypeConsumerKeyAssigned           = "consumer_key_assigned"
+	EventTypePowerShapingParametersUpdated = "power_shaping_parameters_updated"
+	EventTypeSlashPacketHandled            = "slash_packet_handled"
+	EventTypeSlashPacketBounced            = "slash_packet_bounced"
+	EventTypeVSCPacketQueued               = "vsc_packet_queued"
+	EventTypeConsumerCommissionRateRaised  = "consumer_commission_rate_raised"
+	EventTypeRelayerRebate                 = "relayer_rebate"
+	EventTypeTombstonedValidatorRemoved    = "tombstoned_validator_removed"
+	EventTypeProviderFeePoolAddrUpdated    = "provider_fee_pool_addr_updated"
+	EventTypeInfractionParametersQueued    = "infraction_parameters_queued"
+	EventTypeInfractionParametersUpdated   = "infraction_parameters_updated"
+	EventTypeConsumerDowntimeJailRecorded  = "consumer_downtime_jail_recorded"
+	EventTypeConsumerDowntimeJailRemoved   = "consumer_downtime_jail_removed"
 
 	AttributeInfractionHeight          = "infraction_height"
 	AttributeInitialHeight             = "initial_height"
 	AttributeTrustingPeriod            = "trusting_period"
 	AttributeUnbondingPeriod           = "unbonding_period"
 	AttributeValsetHash                = "valset_hash"
+	AttributeAppliedValsetHash         = "applied_valset_hash"
 	AttributeProviderValidatorAddress  = "provider_validator_address"
 	AttributeConsumerConsensusPubKey   = "consumer_consensus_pub_key"
 	AttributeAddConsumerRewardDenom    = "add_consumer_reward_denom"
@@ -39,4 +72,35 @@ const (
 	AttributeRewardTotal               = "total_rewards"
 	AttributeRewardDistributed         = "distributed_rewards"
 	AttributeRewardCommunityPool       = "community_pool_rewards"
+	AttributeConsumerOperation         = "consumer_operation"
+	AttributeConsumerError             = "consumer_error"
+	AttributeProviderConsAddress       = "provider_cons_address"
+	AttributeConsumerConsAddress       = "consumer_cons_address"
+	AttributePreviousConsumerPhase     = "previous_consumer_phase"
+	AttributeValidatorsPowerCap        = "validators_power_cap"
+	AttributeValidatorSetCap           = "validator_set_cap"
+	AttributeMinStake                  = "min_stake"
+	AttributeMinUptime                 = "min_uptime"
+	AttributeMinValidatorPower         = "min_validator_power"
+	AttributeAllowInactiveVals         = "allow_inactive_vals"
+	AttributeValidatorUpdates          = "validator_updates"
+	AttributeRelayerStaleness          = "relayer_staleness"
+	AttributePendingVSCPackets         = "pending_vsc_packets"
+	AttributeLastRecvHeight            = "last_recv_height"
+	AttributeLastAckHeight             = "last_ack_height"
+	AttributePowerShapingTemplateId    = "power_shaping_template_id"
+	AttributePowerShapingTemplateName  = "power_shaping_template_name"
+	AttributeValidatorPower            = "validator_power"
+	AttributeBlocksUntilNextEpoch      = "blocks_until_next_epoch"
+	AttributeRelayerAddress            = "relayer_address"
+	AttributeRebateAmount              = "rebate_amount"
+	AttributeCommittee                 = "committee"
+	AttributeDoubleSignSlashFraction   = "double_sign_slash_fraction"
+	AttributeDoubleSignJailDuration    = "double_sign_jail_duration"
+	AttributeDoubleSignTombstone       = "double_sign_tombstone"
+	AttributeDowntimeSlashFraction     = "downtime_slash_fraction"
+	AttributeDowntimeJailDuration      = "downtime_jail_duration"
+	AttributeJailEndTime               = "jail_end_time"
+
+	AttributeInfractionParametersUpdateTime = "infraction_parameters_update_time"
 )
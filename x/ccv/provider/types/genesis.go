@@ -7,9 +7,12 @@ import (
 	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	tmtypes "github.com/cometbft/cometbft/types"
+
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -70,6 +73,41 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if gs.SlashMeterState != nil {
+		if err := gs.SlashMeterState.Validate(); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+		}
+	}
+
+	for _, item := range gs.ConsumerRewardsAllocations {
+		if err := ccv.ValidateConsumerId(item.ConsumerId); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid consumer rewards allocation: %s", err))
+		}
+		if err := sdk.ValidateDenom(item.Denom); err != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("invalid consumer rewards allocation denom: %s", err))
+		}
+		if item.Allocation.Rewards.IsAnyNegative() {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, fmt.Sprintf("negative consumer rewards allocation for consumer chain %s", item.ConsumerId))
+		}
+	}
+
+	for _, item := range gs.ValsetUpdateIdToBlockTime {
+		if item.ValsetUpdateId == 0 {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "valset update ID cannot be equal to zero")
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the slash meter is within the range of tendermint's [-MaxTotalVotingPower, MaxTotalVotingPower]
+func (s SlashMeterState) Validate() error {
+	if s.SlashMeter.IsNil() {
+		return errors.New("slash meter cannot be nil")
+	}
+	if s.SlashMeter.GT(math.NewInt(tmtypes.MaxTotalVotingPower)) || s.SlashMeter.LT(math.NewInt(-tmtypes.MaxTotalVotingPower)) {
+		return fmt.Errorf("slash meter %s is out of bounds", s.SlashMeter)
+	}
 	return nil
 }
 
@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -39,6 +45,15 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPruneV2 []ConsumerAddrsToPruneV2 `protobuf:"bytes,14,rep,name=consumer_addrs_to_prune_v2,json=consumerAddrsToPruneV2,proto3" json:"consumer_addrs_to_prune_v2"`
+	// the slash meter and its replenish time candidate;
+	// empty for a new chain, in which case the slash meter is initialized
+	SlashMeterState *SlashMeterState `protobuf:"bytes,15,opt,name=slash_meter_state,json=slashMeterState,proto3" json:"slash_meter_state,omitempty"`
+	// the consumer rewards that are not yet allocated to the validators;
+	// empty for a new chain
+	ConsumerRewardsAllocations []ConsumerRewardsAllocationByDenom `protobuf:"bytes,16,rep,name=consumer_rewards_allocations,json=consumerRewardsAllocations,proto3" json:"consumer_rewards_allocations"`
+	// the block times of the mappings from valset update IDs to block heights,
+	// used to prune the expired mappings; empty for a new chain
+	ValsetUpdateIdToBlockTime []ValsetUpdateIdToBlockTime `protobuf:"bytes,17,rep,name=valset_update_id_to_block_time,json=valsetUpdateIdToBlockTime,proto3" json:"valset_update_id_to_block_time"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +138,191 @@ func (m *GenesisState) GetConsumerAddrsToPruneV2() []ConsumerAddrsToPruneV2 {
 	return nil
 }
 
+func (m *GenesisState) GetSlashMeterState() *SlashMeterState {
+	if m != nil {
+		return m.SlashMeterState
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerRewardsAllocations() []ConsumerRewardsAllocationByDenom {
+	if m != nil {
+		return m.ConsumerRewardsAllocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValsetUpdateIdToBlockTime() []ValsetUpdateIdToBlockTime {
+	if m != nil {
+		return m.ValsetUpdateIdToBlockTime
+	}
+	return nil
+}
+
+// SlashMeterState defines the genesis information of the slash meter
+type SlashMeterState struct {
+	// the value of the slash meter
+	SlashMeter cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=slash_meter,json=slashMeter,proto3,customtype=cosmossdk.io/math.Int" json:"slash_meter"`
+	// the next time the slash meter could be replenished
+	ReplenishTimeCandidate time.Time `protobuf:"bytes,2,opt,name=replenish_time_candidate,json=replenishTimeCandidate,proto3,stdtime" json:"replenish_time_candidate"`
+}
+
+func (m *SlashMeterState) Reset()         { *m = SlashMeterState{} }
+func (m *SlashMeterState) String() string { return proto.CompactTextString(m) }
+func (*SlashMeterState) ProtoMessage()    {}
+func (*SlashMeterState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{1}
+}
+func (m *SlashMeterState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashMeterState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashMeterState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashMeterState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashMeterState.Merge(m, src)
+}
+func (m *SlashMeterState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashMeterState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashMeterState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashMeterState proto.InternalMessageInfo
+
+func (m *SlashMeterState) GetReplenishTimeCandidate() time.Time {
+	if m != nil {
+		return m.ReplenishTimeCandidate
+	}
+	return time.Time{}
+}
+
+// ConsumerRewardsAllocationByDenom defines the genesis information of
+// the rewards of a consumer chain in a given denom that are not yet allocated
+type ConsumerRewardsAllocationByDenom struct {
+	ConsumerId string                    `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	Denom      string                    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Allocation ConsumerRewardsAllocation `protobuf:"bytes,3,opt,name=allocation,proto3" json:"allocation"`
+}
+
+func (m *ConsumerRewardsAllocationByDenom) Reset()         { *m = ConsumerRewardsAllocationByDenom{} }
+func (m *ConsumerRewardsAllocationByDenom) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRewardsAllocationByDenom) ProtoMessage()    {}
+func (*ConsumerRewardsAllocationByDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{2}
+}
+func (m *ConsumerRewardsAllocationByDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRewardsAllocationByDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRewardsAllocationByDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerRewardsAllocationByDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRewardsAllocationByDenom.Merge(m, src)
+}
+func (m *ConsumerRewardsAllocationByDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRewardsAllocationByDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRewardsAllocationByDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRewardsAllocationByDenom proto.InternalMessageInfo
+
+func (m *ConsumerRewardsAllocationByDenom) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *ConsumerRewardsAllocationByDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConsumerRewardsAllocationByDenom) GetAllocation() ConsumerRewardsAllocation {
+	if m != nil {
+		return m.Allocation
+	}
+	return ConsumerRewardsAllocation{}
+}
+
+// ValsetUpdateIdToBlockTime defines the genesis information for the mapping
+// of each valset update id to the time of the block it is mapped to
+type ValsetUpdateIdToBlockTime struct {
+	ValsetUpdateId uint64    `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	BlockTime      time.Time `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *ValsetUpdateIdToBlockTime) Reset()         { *m = ValsetUpdateIdToBlockTime{} }
+func (m *ValsetUpdateIdToBlockTime) String() string { return proto.CompactTextString(m) }
+func (*ValsetUpdateIdToBlockTime) ProtoMessage()    {}
+func (*ValsetUpdateIdToBlockTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48411d9c7900d48e, []int{3}
+}
+func (m *ValsetUpdateIdToBlockTime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValsetUpdateIdToBlockTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValsetUpdateIdToBlockTime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValsetUpdateIdToBlockTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValsetUpdateIdToBlockTime.Merge(m, src)
+}
+func (m *ValsetUpdateIdToBlockTime) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValsetUpdateIdToBlockTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValsetUpdateIdToBlockTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValsetUpdateIdToBlockTime proto.InternalMessageInfo
+
+func (m *ValsetUpdateIdToBlockTime) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ValsetUpdateIdToBlockTime) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
 // The provider CCV module's knowledge of consumer state.
 //
 // Note this type is only used internally to the provider CCV module.
@@ -149,7 +349,7 @@ func (m *ConsumerState) Reset()         { *m = ConsumerState{} }
 func (m *ConsumerState) String() string { return proto.CompactTextString(m) }
 func (*ConsumerState) ProtoMessage()    {}
 func (*ConsumerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48411d9c7900d48e, []int{1}
+	return fileDescriptor_48411d9c7900d48e, []int{4}
 }
 func (m *ConsumerState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -245,7 +445,7 @@ func (m *ValsetUpdateIdToHeight) Reset()         { *m = ValsetUpdateIdToHeight{}
 func (m *ValsetUpdateIdToHeight) String() string { return proto.CompactTextString(m) }
 func (*ValsetUpdateIdToHeight) ProtoMessage()    {}
 func (*ValsetUpdateIdToHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48411d9c7900d48e, []int{2}
+	return fileDescriptor_48411d9c7900d48e, []int{5}
 }
 func (m *ValsetUpdateIdToHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,6 +490,9 @@ func (m *ValsetUpdateIdToHeight) GetHeight() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "interchain_security.ccv.provider.v1.GenesisState")
+	proto.RegisterType((*SlashMeterState)(nil), "interchain_security.ccv.provider.v1.SlashMeterState")
+	proto.RegisterType((*ConsumerRewardsAllocationByDenom)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocationByDenom")
+	proto.RegisterType((*ValsetUpdateIdToBlockTime)(nil), "interchain_security.ccv.provider.v1.ValsetUpdateIdToBlockTime")
 	proto.RegisterType((*ConsumerState)(nil), "interchain_security.ccv.provider.v1.ConsumerState")
 	proto.RegisterType((*ValsetUpdateIdToHeight)(nil), "interchain_security.ccv.provider.v1.ValsetUpdateIdToHeight")
 }
@@ -299,54 +502,74 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0xc6, 0xc1, 0x80, 0x99, 0x04, 0xae, 0x65, 0x45, 0xc8, 0x37, 0xd1, 0x25, 0x88, 0xab, 0x48,
-	0x48, 0xf7, 0x5e, 0x1c, 0xb8, 0x8b, 0x56, 0xfd, 0x59, 0x84, 0x44, 0x6a, 0x70, 0x37, 0x88, 0xa4,
-	0xa9, 0x94, 0x8d, 0x35, 0xcc, 0x8c, 0xf0, 0x08, 0xb0, 0x2d, 0xcf, 0xe0, 0x14, 0x55, 0x95, 0xda,
-	0x37, 0xe8, 0x9b, 0xf4, 0x35, 0xb2, 0xcc, 0xb2, 0xab, 0xa8, 0x4a, 0xfa, 0x04, 0x7d, 0x82, 0xca,
-	0xe3, 0x81, 0x40, 0x4a, 0x22, 0xd2, 0x9d, 0x7d, 0xbe, 0xf9, 0xce, 0xf9, 0xce, 0x2f, 0x68, 0x50,
-	0x8f, 0x93, 0x10, 0xb9, 0x90, 0x7a, 0x0e, 0x23, 0x68, 0x1c, 0x52, 0x3e, 0xb1, 0x10, 0x8a, 0xac,
-	0x20, 0xf4, 0x23, 0x8a, 0x49, 0x68, 0x45, 0x0d, 0xab, 0x4f, 0x3c, 0xc2, 0x28, 0xab, 0x07, 0xa1,
-	0xcf, 0x7d, 0xe3, 0xef, 0x25, 0x94, 0x3a, 0x42, 0x51, 0x7d, 0x4a, 0xa9, 0x47, 0x8d, 0xad, 0xcd,
-	0xbe, 0xdf, 0xf7, 0xc5, 0x7b, 0x2b, 0xfe, 0x4a, 0xa8, 0x5b, 0x7b, 0xf7, 0x45, 0x8b, 0x1a, 0x16,
-	0x73, 0x61, 0x48, 0xb0, 0x83, 0x7c, 0x8f, 0x8d, 0x47, 0x24, 0x94, 0x8c, 0xdd, 0x07, 0x18, 0xe7,
-	0x34, 0x24, 0xf2, 0x59, 0x73, 0x95, 0x34, 0x66, 0xfa, 0x04, 0xa7, 0xfa, 0x25, 0x0b, 0x36, 0x5e,
-	0x25, 0x99, 0x1d, 0x73, 0xc8, 0x89, 0x51, 0x03, 0x7a, 0x04, 0x87, 0x8c, 0x70, 0x67, 0x1c, 0x60,
-	0xc8, 0x89, 0x43, 0xb1, 0xa9, 0x54, 0x94, 0x9a, 0xda, 0x2d, 0x26, 0xf6, 0x37, 0xc2, 0xdc, 0xc6,
-	0xc6, 0x7b, 0xf0, 0xc7, 0x54, 0xa7, 0xc3, 0x62, 0x2e, 0x33, 0xd7, 0x2a, 0xe9, 0xda, 0x7a, 0xb3,
-	0x59, 0x5f, 0xa1, 0x38, 0xf5, 0x03, 0xc9, 0x15, 0x61, 0x5b, 0xe5, 0x8b, 0xab, 0x9d, 0xd4, 0x8f,
-	0xab, 0x9d, 0xd2, 0x04, 0x8e, 0x86, 0xcf, 0xaa, 0x77, 0x1c, 0x57, 0xbb, 0x45, 0x34, 0xff, 0x9c,
-	0x19, 0x1f, 0xc0, 0xd6, 0x5d, 0x99, 0x0e, 0xf7, 0x1d, 0x97, 0xd0, 0xbe, 0xcb, 0xcd, 0x8c, 0xd0,
-	0xf1, 0x7c, 0x25, 0x1d, 0xa7, 0x0b, 0x59, 0x9d, 0xf8, 0x47, 0xc2, 0x45, 0x4b, 0x8d, 0x05, 0x75,
-	0x4b, 0xd1, 0x52, 0xd4, 0x68, 0x83, 0x6c, 0x00, 0x43, 0x38, 0x62, 0xa6, 0x56, 0x51, 0x6a, 0xeb,
-	0xcd, 0x7f, 0x56, 0x0a, 0xd5, 0x11, 0x14, 0xe9, 0x5a, 0x3a, 0x30, 0x3e, 0x2a, 0x22, 0x15, 0x8a,
-	0x21, 0xf7, 0xc3, 0x59, 0xe7, 0x9d, 0x60, 0xdc, 0x1b, 0x90, 0x09, 0x33, 0xf3, 0x22, 0x95, 0x17,
-	0xab, 0xa6, 0x92, 0xb8, 0x99, 0xd6, 0xb6, 0x33, 0xee, 0xbd, 0x26, 0x13, 0x19, 0xd0, 0x8c, 0x96,
-	0xc0, 0x71, 0x0c, 0xe3, 0x93, 0x02, 0xb6, 0x67, 0x20, 0x73, 0x7a, 0x93, 0x5b, 0x19, 0x10, 0xe3,
-	0xd0, 0x04, 0xbf, 0xa3, 0xa1, 0x35, 0x99, 0x86, 0xd9, 0xc7, 0x38, 0xfc, 0x45, 0x03, 0x5b, 0xc4,
-	0xe3, 0x86, 0x2e, 0x04, 0x65, 0x71, 0x3b, 0x83, 0x70, 0xec, 0x11, 0x27, 0x6a, 0x9a, 0xc5, 0x47,
-	0x34, 0x74, 0xde, 0x2d, 0x3b, 0xf1, 0x3b, 0xb1, 0x8f, 0xd3, 0xe6, 0xb4, 0xa1, 0x68, 0x29, 0x6a,
-	0xab, 0x5a, 0x5a, 0x57, 0x6d, 0x55, 0x53, 0xf5, 0x8c, 0xad, 0x6a, 0x59, 0x3d, 0x67, 0xab, 0x5a,
-	0x4e, 0xd7, 0x6c, 0x55, 0x5b, 0xd7, 0x37, 0x6c, 0x55, 0xdb, 0xd0, 0x0b, 0xb6, 0xaa, 0x15, 0xf4,
-	0x62, 0xf5, 0x7b, 0x1a, 0x14, 0x16, 0x66, 0xd7, 0xf8, 0x13, 0x68, 0x89, 0x24, 0xb9, 0x2a, 0xf9,
-	0x6e, 0x4e, 0xfc, 0xb7, 0xb1, 0xf1, 0x17, 0x00, 0xc8, 0x85, 0x9e, 0x47, 0x86, 0x31, 0xb8, 0x26,
-	0xc0, 0xbc, 0xb4, 0xb4, 0xb1, 0xb1, 0x0d, 0xf2, 0x68, 0x48, 0x89, 0xc7, 0x63, 0x34, 0x2d, 0x50,
-	0x2d, 0x31, 0xb4, 0xb1, 0xb1, 0x0b, 0x8a, 0xd4, 0xa3, 0x9c, 0xc2, 0xe1, 0x74, 0xac, 0x55, 0xb1,
-	0x87, 0x05, 0x69, 0x95, 0xa3, 0x08, 0x81, 0x3e, 0x2b, 0x9c, 0xbc, 0x51, 0x66, 0x46, 0x0c, 0xe5,
-	0xde, 0xbd, 0xe5, 0x9a, 0xab, 0xd2, 0xfc, 0xf2, 0xcb, 0x1a, 0xcd, 0xd6, 0x5a, 0x62, 0x06, 0x07,
-	0xa5, 0x80, 0x78, 0x98, 0x7a, 0x7d, 0x47, 0x2e, 0x5d, 0x9c, 0x42, 0x9f, 0x30, 0x33, 0x2b, 0xfa,
-	0xf2, 0xf4, 0xa1, 0x40, 0xb3, 0x81, 0x38, 0x26, 0xfc, 0x40, 0xd0, 0x3a, 0x10, 0x0d, 0x08, 0x3f,
-	0x84, 0x1c, 0xca, 0x80, 0x9b, 0xd2, 0x7b, 0xb2, 0x8a, 0xc9, 0x23, 0x66, 0xfc, 0x0b, 0x0c, 0x36,
-	0x84, 0xcc, 0x75, 0xb0, 0x7f, 0xee, 0x71, 0x3a, 0x22, 0x0e, 0x44, 0x03, 0x33, 0x57, 0x49, 0xd7,
-	0xf2, 0x5d, 0x5d, 0x20, 0x87, 0x12, 0xd8, 0x47, 0x03, 0xe3, 0x08, 0x64, 0x02, 0x17, 0x32, 0x62,
-	0xe6, 0x2b, 0x4a, 0xad, 0xf8, 0xc8, 0x1b, 0xd4, 0x89, 0x99, 0xdd, 0xc4, 0x81, 0xad, 0x6a, 0x9a,
-	0x9e, 0xaf, 0x9e, 0x81, 0xd2, 0xf2, 0xcb, 0xf0, 0x88, 0x0b, 0x59, 0x02, 0x59, 0xd9, 0xb9, 0x35,
-	0x81, 0xcb, 0xbf, 0xd6, 0xdb, 0x8b, 0xeb, 0xb2, 0x72, 0x79, 0x5d, 0x56, 0xbe, 0x5d, 0x97, 0x95,
-	0xcf, 0x37, 0xe5, 0xd4, 0xe5, 0x4d, 0x39, 0xf5, 0xf5, 0xa6, 0x9c, 0x3a, 0x7b, 0xd9, 0xa7, 0xdc,
-	0x1d, 0xf7, 0xea, 0xc8, 0x1f, 0x59, 0xc8, 0x67, 0x23, 0x9f, 0x59, 0xb7, 0x79, 0xfc, 0x37, 0x3b,
-	0xea, 0xd1, 0x13, 0xeb, 0xdd, 0xe2, 0x65, 0xe7, 0x93, 0x80, 0xb0, 0x5e, 0x56, 0x1c, 0xf5, 0xff,
-	0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xd9, 0x94, 0x40, 0xd1, 0x06, 0x00, 0x00,
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4d, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xaf, 0x1b, 0xb7, 0xb5, 0xa7, 0x6d, 0xea, 0x1d, 0xf5, 0x1f, 0xb9, 0xdd, 0x3f, 0x49, 0x14,
+	0xb4, 0x52, 0xa4, 0x65, 0xed, 0x6d, 0x40, 0x02, 0xf1, 0x26, 0x35, 0x2d, 0x62, 0x13, 0x40, 0x8a,
+	0xdc, 0xb2, 0x48, 0x7b, 0xc0, 0x4c, 0xec, 0x21, 0xb1, 0xe2, 0x37, 0x79, 0x26, 0x2e, 0x11, 0x42,
+	0x82, 0x13, 0x12, 0x12, 0xd2, 0x7e, 0x0f, 0xae, 0xdc, 0xb9, 0xee, 0x71, 0xc5, 0x09, 0x71, 0x28,
+	0xa8, 0xe5, 0x13, 0xf0, 0x09, 0xd0, 0x8c, 0xc7, 0x6e, 0x52, 0x92, 0x25, 0xd9, 0x9b, 0x67, 0x7e,
+	0xf3, 0xbc, 0xfc, 0x9e, 0xdf, 0xe3, 0x67, 0x06, 0x1c, 0x79, 0x21, 0xc5, 0x89, 0x33, 0x44, 0x5e,
+	0x68, 0x13, 0xec, 0x8c, 0x13, 0x8f, 0x4e, 0x4c, 0xc7, 0x49, 0xcd, 0x38, 0x89, 0x52, 0xcf, 0xc5,
+	0x89, 0x99, 0x1e, 0x99, 0x03, 0x1c, 0x62, 0xe2, 0x11, 0x23, 0x4e, 0x22, 0x1a, 0xc1, 0x57, 0xe7,
+	0x98, 0x18, 0x8e, 0x93, 0x1a, 0xb9, 0x89, 0x91, 0x1e, 0x1d, 0xee, 0x0f, 0xa2, 0x41, 0xc4, 0xcf,
+	0x9b, 0xec, 0x2b, 0x33, 0x3d, 0x3c, 0x70, 0x22, 0x12, 0x44, 0xc4, 0xce, 0x80, 0x6c, 0x21, 0xa0,
+	0xda, 0x20, 0x8a, 0x06, 0x3e, 0x36, 0xf9, 0xaa, 0x3f, 0xfe, 0xd2, 0xa4, 0x5e, 0x80, 0x09, 0x45,
+	0x41, 0x2c, 0x0e, 0x3c, 0x5c, 0x94, 0x69, 0x7a, 0x64, 0x92, 0x21, 0x4a, 0xb0, 0x6b, 0x3b, 0x51,
+	0x48, 0xc6, 0x01, 0x4e, 0x84, 0xc5, 0xbd, 0x17, 0x58, 0x5c, 0x78, 0x09, 0x16, 0xc7, 0x5a, 0xcb,
+	0x94, 0xa0, 0xe0, 0xc6, 0x6d, 0x1a, 0x3f, 0xa9, 0x60, 0xe7, 0xc3, 0xac, 0x2a, 0x67, 0x14, 0x51,
+	0x0c, 0x9b, 0x40, 0x4b, 0x91, 0x4f, 0x30, 0xb5, 0xc7, 0xb1, 0x8b, 0x28, 0xb6, 0x3d, 0x57, 0x97,
+	0xea, 0x52, 0x53, 0xb6, 0xca, 0xd9, 0xfe, 0xa7, 0x7c, 0xbb, 0xe3, 0xc2, 0xaf, 0xc1, 0x5e, 0x9e,
+	0xa7, 0x4d, 0x98, 0x2d, 0xd1, 0xd7, 0xeb, 0xa5, 0xe6, 0x76, 0xab, 0x65, 0x2c, 0x51, 0x58, 0xe3,
+	0x44, 0xd8, 0xf2, 0xb0, 0xed, 0xea, 0xb3, 0xcb, 0xda, 0xda, 0xdf, 0x97, 0xb5, 0xca, 0x04, 0x05,
+	0xfe, 0xdb, 0x8d, 0x5b, 0x8e, 0x1b, 0x56, 0xd9, 0x99, 0x3e, 0x4e, 0xe0, 0x37, 0xe0, 0xf0, 0x76,
+	0x9a, 0x36, 0x8d, 0xec, 0x21, 0xf6, 0x06, 0x43, 0xaa, 0x6f, 0xf0, 0x3c, 0xde, 0x59, 0x2a, 0x8f,
+	0xc7, 0x33, 0xac, 0xce, 0xa3, 0x47, 0xdc, 0x45, 0x5b, 0x66, 0x09, 0x59, 0x95, 0x74, 0x2e, 0x0a,
+	0x3b, 0x60, 0x33, 0x46, 0x09, 0x0a, 0x88, 0xae, 0xd4, 0xa5, 0xe6, 0x76, 0xeb, 0xfe, 0x52, 0xa1,
+	0x7a, 0xdc, 0x44, 0xb8, 0x16, 0x0e, 0xe0, 0xb7, 0x12, 0xa7, 0xe2, 0xb9, 0x88, 0x46, 0x49, 0xa1,
+	0xbc, 0x1d, 0x8f, 0xfb, 0x23, 0x3c, 0x21, 0xba, 0xca, 0xa9, 0xbc, 0xbb, 0x2c, 0x95, 0xcc, 0x4d,
+	0x5e, 0xdb, 0xde, 0xb8, 0xff, 0x11, 0x9e, 0x88, 0x80, 0x7a, 0x3a, 0x07, 0x66, 0x31, 0xe0, 0x77,
+	0x12, 0xb8, 0x5b, 0x80, 0xc4, 0xee, 0x4f, 0x6e, 0xd2, 0x40, 0xae, 0x9b, 0xe8, 0xe0, 0x65, 0x72,
+	0x68, 0x4f, 0xf2, 0x30, 0xc7, 0xae, 0x9b, 0xfc, 0x2b, 0x07, 0x32, 0x8b, 0x33, 0x41, 0x67, 0x82,
+	0x12, 0x26, 0x67, 0x9c, 0x8c, 0x43, 0x6c, 0xa7, 0x2d, 0xbd, 0xbc, 0x82, 0xa0, 0xd3, 0x6e, 0xc9,
+	0x79, 0xd4, 0x63, 0x3e, 0x1e, 0xb7, 0x72, 0x41, 0x9d, 0xb9, 0x28, 0xfc, 0x02, 0xdc, 0x21, 0x3e,
+	0x22, 0x43, 0x3b, 0xc0, 0x34, 0x6f, 0x3b, 0x7d, 0x8f, 0x6b, 0xfb, 0xc6, 0x52, 0x51, 0xcf, 0x98,
+	0xf5, 0x27, 0x98, 0x8a, 0x0e, 0xb5, 0xf6, 0xc8, 0xec, 0x06, 0xfc, 0x51, 0x02, 0xff, 0x2f, 0x18,
+	0x26, 0xf8, 0x02, 0x25, 0x2e, 0xb1, 0x91, 0xef, 0x47, 0x0e, 0xa2, 0x5e, 0x14, 0x12, 0x5d, 0xe3,
+	0x1c, 0x3f, 0x58, 0x89, 0xa3, 0x95, 0xf9, 0x39, 0x2e, 0xdc, 0xb4, 0x27, 0xa7, 0x38, 0x8c, 0x02,
+	0xc1, 0xf6, 0xd0, 0x59, 0x74, 0x8e, 0xc0, 0xef, 0x25, 0x50, 0x9d, 0xf7, 0x0b, 0xf5, 0xfd, 0xc8,
+	0x19, 0xd9, 0x6c, 0x68, 0xe9, 0x77, 0x78, 0x46, 0xef, 0xbf, 0xd4, 0x6f, 0xd4, 0x66, 0x6e, 0xce,
+	0xbd, 0x00, 0x8b, 0x54, 0x0e, 0xd2, 0x45, 0x07, 0xba, 0xb2, 0x52, 0xd2, 0xe4, 0xae, 0xac, 0xc8,
+	0xda, 0x46, 0x57, 0x56, 0x36, 0xb5, 0xad, 0xae, 0xac, 0x6c, 0x69, 0x4a, 0x57, 0x56, 0xb6, 0xb5,
+	0x9d, 0xae, 0xac, 0xec, 0x68, 0xbb, 0x5d, 0x59, 0xd9, 0xd5, 0xca, 0x8d, 0x5f, 0x24, 0xb0, 0x77,
+	0xab, 0xd0, 0xf0, 0x63, 0xb0, 0x3d, 0xa5, 0x1c, 0x9f, 0x55, 0x6a, 0xfb, 0x3e, 0x8b, 0xf9, 0xfb,
+	0x65, 0xed, 0x7f, 0xd9, 0x68, 0x26, 0xee, 0xc8, 0xf0, 0x22, 0x33, 0x40, 0x74, 0x68, 0x74, 0x42,
+	0xfa, 0xeb, 0xcf, 0x0f, 0x40, 0x06, 0xb0, 0x95, 0x05, 0x6e, 0xa4, 0x82, 0x9f, 0x03, 0x3d, 0xc1,
+	0xb1, 0x8f, 0x43, 0x8f, 0x0c, 0x79, 0x11, 0x6c, 0x07, 0x85, 0x2e, 0x6b, 0x59, 0xac, 0xaf, 0xf3,
+	0x76, 0x38, 0x34, 0xb2, 0x01, 0x6f, 0xe4, 0x03, 0xde, 0x38, 0xcf, 0x07, 0x7c, 0x5b, 0x61, 0x61,
+	0x9f, 0xfe, 0x51, 0x93, 0xac, 0x4a, 0xe1, 0x85, 0xa1, 0x27, 0xb9, 0x0f, 0xc6, 0xa0, 0xfe, 0x5f,
+	0xe2, 0xc1, 0x1a, 0xd8, 0x2e, 0x3a, 0x45, 0x8c, 0x5f, 0xd5, 0x02, 0xf9, 0x56, 0xc7, 0x85, 0xfb,
+	0x60, 0xc3, 0x65, 0x27, 0x79, 0x4a, 0xaa, 0x95, 0x2d, 0xa0, 0x0b, 0xc0, 0x4d, 0x3f, 0xe9, 0xa5,
+	0xba, 0xb4, 0xb4, 0x78, 0x8b, 0x33, 0xca, 0xc4, 0x9b, 0xf2, 0xdb, 0xf8, 0x41, 0x02, 0x07, 0x0b,
+	0xc5, 0x5e, 0xe1, 0xfa, 0x38, 0x01, 0x60, 0xaa, 0xd5, 0x56, 0xa9, 0xad, 0xda, 0xcf, 0xc3, 0x35,
+	0xfe, 0x2a, 0x81, 0xdd, 0x99, 0x8b, 0x04, 0x1e, 0x00, 0x25, 0x23, 0x5b, 0x14, 0x6e, 0x8b, 0xaf,
+	0x3b, 0x2e, 0x7c, 0x05, 0x00, 0x67, 0x88, 0xc2, 0x10, 0xfb, 0x0c, 0xcc, 0x4a, 0xa7, 0x8a, 0x9d,
+	0x8e, 0x0b, 0xef, 0x02, 0xd5, 0xf1, 0x3d, 0x1c, 0x52, 0x86, 0x96, 0x38, 0xaa, 0x64, 0x1b, 0x1d,
+	0x17, 0xde, 0x03, 0x65, 0x2f, 0xf4, 0xa8, 0x87, 0xfc, 0xfc, 0x8e, 0x91, 0x39, 0xab, 0x5d, 0xb1,
+	0x2b, 0xee, 0x05, 0x04, 0xb4, 0x42, 0x39, 0xf1, 0xd8, 0xd0, 0x37, 0x38, 0xb5, 0x87, 0x0b, 0x85,
+	0x98, 0xaa, 0xff, 0xf4, 0x4d, 0x2c, 0x4a, 0xbf, 0xe7, 0xcc, 0x62, 0x90, 0x82, 0x4a, 0x8c, 0x43,
+	0xd7, 0x0b, 0x07, 0xb6, 0xa8, 0x34, 0xa3, 0x30, 0xc0, 0x44, 0xdf, 0xe4, 0xbf, 0xeb, 0x5b, 0x2f,
+	0x0a, 0x54, 0x4c, 0xe7, 0x33, 0x4c, 0x4f, 0xb8, 0x59, 0x0f, 0x39, 0x23, 0x4c, 0x4f, 0x11, 0x45,
+	0x22, 0xe0, 0xbe, 0xf0, 0x9e, 0x69, 0x9c, 0x1d, 0x22, 0xf0, 0x35, 0x00, 0xb3, 0xbf, 0xcc, 0x8d,
+	0x2e, 0x42, 0xfe, 0x5f, 0x20, 0x67, 0xa4, 0x6f, 0xd5, 0x4b, 0x4d, 0xd5, 0xd2, 0x38, 0x72, 0x2a,
+	0x80, 0x63, 0x67, 0x04, 0x1f, 0x81, 0x8d, 0x78, 0x88, 0x08, 0xd6, 0xd5, 0xba, 0xd4, 0x2c, 0xaf,
+	0xf8, 0x20, 0xe8, 0x31, 0x4b, 0x2b, 0x73, 0xd0, 0x95, 0x15, 0x45, 0x53, 0x1b, 0x4f, 0x40, 0x65,
+	0xfe, 0x35, 0xbd, 0x42, 0xbf, 0x55, 0xc0, 0xa6, 0x50, 0x6e, 0x9d, 0xe3, 0x62, 0xd5, 0xfe, 0xec,
+	0xd9, 0x55, 0x55, 0x7a, 0x7e, 0x55, 0x95, 0xfe, 0xbc, 0xaa, 0x4a, 0x4f, 0xaf, 0xab, 0x6b, 0xcf,
+	0xaf, 0xab, 0x6b, 0xbf, 0x5d, 0x57, 0xd7, 0x9e, 0xbc, 0x37, 0xf0, 0xe8, 0x70, 0xdc, 0x37, 0x9c,
+	0x28, 0x10, 0x4f, 0x3c, 0xf3, 0x86, 0xc7, 0x83, 0xe2, 0x85, 0x95, 0xbe, 0x69, 0x7e, 0x35, 0xfb,
+	0xcc, 0xa2, 0x93, 0x18, 0x93, 0xfe, 0x26, 0x6f, 0xe2, 0xd7, 0xff, 0x19, 0x00, 0xad, 0xf3, 0xdd,
+	0x50, 0x9a, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +592,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValsetUpdateIdToBlockTime) > 0 {
+		for iNdEx := len(m.ValsetUpdateIdToBlockTime) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetUpdateIdToBlockTime[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ConsumerRewardsAllocations) > 0 {
+		for iNdEx := len(m.ConsumerRewardsAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerRewardsAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.SlashMeterState != nil {
+		{
+			size, err := m.SlashMeterState.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if len(m.ConsumerAddrsToPruneV2) > 0 {
 		for iNdEx := len(m.ConsumerAddrsToPruneV2) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -457,6 +724,130 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SlashMeterState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashMeterState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashMeterState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReplenishTimeCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReplenishTimeCandidate):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashMeter.Size()
+		i -= size
+		if _, err := m.SlashMeter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRewardsAllocationByDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardsAllocationByDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardsAllocationByDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValsetUpdateIdToBlockTime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValsetUpdateIdToBlockTime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValsetUpdateIdToBlockTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintGenesis(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsumerState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -629,33 +1020,95 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.SlashMeterState != nil {
+		l = m.SlashMeterState.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.ConsumerRewardsAllocations) > 0 {
+		for _, e := range m.ConsumerRewardsAllocations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetUpdateIdToBlockTime) > 0 {
+		for _, e := range m.ValsetUpdateIdToBlockTime {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ConsumerState) Size() (n int) {
+func (m *SlashMeterState) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashMeter.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReplenishTimeCandidate)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ConsumerRewardsAllocationByDenom) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.ChannelId)
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	l = len(m.ClientId)
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
-	if m.InitialHeight != 0 {
-		n += 1 + sovGenesis(uint64(m.InitialHeight))
-	}
-	l = m.ConsumerGenesis.Size()
+	l = m.Allocation.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.PendingValsetChanges) > 0 {
+	return n
+}
+
+func (m *ValsetUpdateIdToBlockTime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovGenesis(uint64(m.ValsetUpdateId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ConsumerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InitialHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.InitialHeight))
+	}
+	l = m.ConsumerGenesis.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingValsetChanges) > 0 {
 		for _, e := range m.PendingValsetChanges {
 			l = e.Size()
 			n += 1 + l + sovGenesis(uint64(l))
@@ -945,6 +1398,476 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashMeterState == nil {
+				m.SlashMeterState = &SlashMeterState{}
+			}
+			if err := m.SlashMeterState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardsAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRewardsAllocations = append(m.ConsumerRewardsAllocations, ConsumerRewardsAllocationByDenom{})
+			if err := m.ConsumerRewardsAllocations[len(m.ConsumerRewardsAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateIdToBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetUpdateIdToBlockTime = append(m.ValsetUpdateIdToBlockTime, ValsetUpdateIdToBlockTime{})
+			if err := m.ValsetUpdateIdToBlockTime[len(m.ValsetUpdateIdToBlockTime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashMeterState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashMeterState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashMeterState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashMeter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplenishTimeCandidate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReplenishTimeCandidate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRewardsAllocationByDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRewardsAllocationByDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRewardsAllocationByDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetUpdateIdToBlockTime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetUpdateIdToBlockTime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetUpdateIdToBlockTime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -66,7 +66,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -110,7 +110,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -128,7 +128,7 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 ccv timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -146,7 +146,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -164,7 +164,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -272,7 +272,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -286,7 +286,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180, 0, types.DefaultRelayerStalenessThreshold, ccv.DefaultClientExpiryWarningWindow, types.DefaultValsetHistorySize, types.DefaultMinConsumerCommissionRate, types.DefaultValsetUpdateHeightRetentionMargin, types.DefaultRelayerRebateFraction, sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}, types.DefaultMaxRelayerRebatesPerEpoch, types.DefaultAllowConsumerKeyReuse),
 				nil,
 				nil,
 				nil,
@@ -308,6 +308,90 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+// Tests validation of the slash meter, the consumer rewards allocations and the pruning state within a provider genesis state
+func TestValidateGenesisThrottleAndPruneState(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(*types.GenesisState)
+		expPass  bool
+	}{
+		{
+			"valid slash meter",
+			func(gs *types.GenesisState) {
+				gs.SlashMeterState = &types.SlashMeterState{SlashMeter: math.NewInt(-10), ReplenishTimeCandidate: time.Now()}
+			},
+			true,
+		},
+		{
+			"invalid slash meter - nil",
+			func(gs *types.GenesisState) {
+				gs.SlashMeterState = &types.SlashMeterState{}
+			},
+			false,
+		},
+		{
+			"invalid slash meter - out of bounds",
+			func(gs *types.GenesisState) {
+				gs.SlashMeterState = &types.SlashMeterState{SlashMeter: math.NewInt(tmtypes.MaxTotalVotingPower + 1)}
+			},
+			false,
+		},
+		{
+			"valid consumer rewards allocation",
+			func(gs *types.GenesisState) {
+				gs.ConsumerRewardsAllocations = []types.ConsumerRewardsAllocationByDenom{
+					{ConsumerId: "0", Denom: "stake", Allocation: types.ConsumerRewardsAllocation{Rewards: sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(1)))}},
+				}
+			},
+			true,
+		},
+		{
+			"invalid consumer rewards allocation - invalid consumer id",
+			func(gs *types.GenesisState) {
+				gs.ConsumerRewardsAllocations = []types.ConsumerRewardsAllocationByDenom{{ConsumerId: "chain", Denom: "stake"}}
+			},
+			false,
+		},
+		{
+			"invalid consumer rewards allocation - invalid denom",
+			func(gs *types.GenesisState) {
+				gs.ConsumerRewardsAllocations = []types.ConsumerRewardsAllocationByDenom{{ConsumerId: "0", Denom: "!"}}
+			},
+			false,
+		},
+		{
+			"invalid consumer rewards allocation - negative rewards",
+			func(gs *types.GenesisState) {
+				gs.ConsumerRewardsAllocations = []types.ConsumerRewardsAllocationByDenom{
+					{ConsumerId: "0", Denom: "stake", Allocation: types.ConsumerRewardsAllocation{Rewards: sdk.DecCoins{{Denom: "stake", Amount: math.LegacyNewDec(-1)}}}},
+				}
+			},
+			false,
+		},
+		{
+			"invalid valset update block time - zero valset update id",
+			func(gs *types.GenesisState) {
+				gs.ValsetUpdateIdToBlockTime = []types.ValsetUpdateIdToBlockTime{{ValsetUpdateId: 0, BlockTime: time.Now()}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			tc.malleate(genState)
+			err := genState.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err, "test case: %s must pass", tc.name)
+			} else {
+				require.Error(t, err, "test case: %s must fail", tc.name)
+			}
+		})
+	}
+}
+
 func getInitialConsumerGenesis(t *testing.T, chainID string, preCCV bool) ccv.ConsumerGenesisState {
 	t.Helper()
 	// generate validator public key
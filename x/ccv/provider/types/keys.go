@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -33,6 +34,9 @@ const (
 	// This address receives rewards from consumer chains
 	ConsumerRewardsPool = "consumer_rewards_pool"
 
+	// This address pays the rebates to the relayers of CCV packets
+	RelayerRebatePool = "relayer_rebate_pool"
+
 	// MaxAllowlistedRewardDenomsPerChain corresponds to the maximum number of reward denoms
 	// a consumer chain can allowlist
 	MaxAllowlistedRewardDenomsPerChain = 3
@@ -162,8 +166,72 @@ const (
 	ConsumerIdToQueuedInfractionParametersKeyName = "ConsumerIdToQueuedInfractionParametersKeyName"
 
 	InfractionScheduledTimeToConsumerIdsKeyName = "InfractionScheduledTimeToConsumerIdsKeyName"
+
+	ConsumerIdToVscConfirmationKeyName = "ConsumerIdToVscConfirmationKey"
+
+	OptedInByValidatorKeyName = "OptedInByValidatorKey"
+
+	IncrementalValSetUpdateKeyName = "IncrementalValSetUpdateKey"
+
+	ModifiedValidatorKeyName = "ModifiedValidatorKey"
+
+	ConsumerIdToFailureHeightKeyName = "ConsumerIdToFailureHeightKey"
+
+	PacketErrorKeyName = "PacketErrorKey"
+
+	PacketErrorIndexKeyName = "PacketErrorIndexKey"
+
+	ConsumerIdToRelayerLivenessKeyName = "ConsumerIdToRelayerLivenessKey"
+
+	ConsumerIdToClientExpirySeverityKeyName = "ConsumerIdToClientExpirySeverityKey"
+
+	ConsumerIdToValsetHistoryKeyName = "ConsumerIdToValsetHistoryKey"
+
+	ConsumerIdToSlashPacketTraceKeyName = "ConsumerIdToSlashPacketTraceKey"
+
+	EpochStartKeyName = "EpochStartKey"
+
+	ConsumerIdToUpdateHistoryKeyName = "ConsumerIdToUpdateHistoryKey"
+
+	PowerShapingTemplateIdKeyName = "PowerShapingTemplateIdKey"
+
+	PowerShapingTemplateKeyName = "PowerShapingTemplateKey"
+
+	ConsumerIdToUpgradePlanKeyName = "ConsumerIdToUpgradePlanKey"
+
+	ConsumerIdToMinCommissionRateKeyName = "ConsumerIdToMinCommissionRateKey"
+
+	ProjectedDropOffKeyName = "ProjectedDropOffKey"
+
+	ConsumerIdToValsetCheckpointKeyName = "ConsumerIdToValsetCheckpointKey"
+
+	ConsumerIdToVSCHistoryKeyName = "ConsumerIdToVSCHistoryKey"
+
+	ValsetUpdateBlockTimeKeyName = "ValsetUpdateBlockTimeKey"
+
+	ConsumerIdToDowntimeParamsKeyName = "ConsumerIdToDowntimeParamsKey"
+
+	PendingDowntimeParamsKeyName = "PendingDowntimeParamsKey"
+
+	RelayerRebateCountKeyName = "RelayerRebateCountKey"
+
+	ConsumerIdToCCVTimeoutPeriodKeyName = "ConsumerIdToCCVTimeoutPeriodKey"
+
+	ConsumerIdToSlashPacketTraceCountKeyName = "ConsumerIdToSlashPacketTraceCountKey"
+
+	CommitteeKeyName = "CommitteeKey"
+
+	ProviderFeePoolAddrKeyName = "ProviderFeePoolAddrKey"
+
+	ConsumerDowntimeJailKeyName = "ConsumerDowntimeJailKey"
+
+	ConsumerIdToValSetResyncKeyName = "ConsumerIdToValSetResyncKey"
 )
 
+// keyPrefixes is the map of all the byte prefixes for existing keys. It is built once,
+// as the keys are constructed on hot paths such as the handling of slash packets.
+var keyPrefixes = getKeyPrefixes()
+
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
 func getKeyPrefixes() map[string]byte {
 	return map[string]byte{
@@ -412,6 +480,120 @@ func getKeyPrefixes() map[string]byte {
 		// InfractionScheduledTimeToConsumerIdsKeyName is the key for storing time when the infraction parameters will be updated for the specific consumer
 		InfractionScheduledTimeToConsumerIdsKeyName: 59,
 
+		// ConsumerIdToVscConfirmationKeyName is the key for storing the VSC confirmations of a specific consumer chain
+		ConsumerIdToVscConfirmationKeyName: 60,
+
+		// OptedInByValidatorKeyName is the key for storing the secondary index from a validator's provider
+		// consensus address to the consumer chains the validator is opted in to
+		OptedInByValidatorKeyName: 61,
+
+		// IncrementalValSetUpdateKeyName is the key for storing whether the validator set of a consumer chain
+		// can be updated incrementally from the validators modified in the current epoch
+		IncrementalValSetUpdateKeyName: 62,
+
+		// ModifiedValidatorKeyName is the key for storing the validators whose voting power might have changed
+		// in the current epoch
+		ModifiedValidatorKeyName: 63,
+
+		// ConsumerIdToFailureHeightKeyName is the key for storing the last block height at which
+		// the per-block processing of a consumer chain failed
+		ConsumerIdToFailureHeightKeyName: 64,
+
+		// PacketErrorKeyName is the key for storing the most recent packets received from consumer chains
+		// that resulted in error acknowledgements
+		PacketErrorKeyName: 65,
+
+		// PacketErrorIndexKeyName is the key for storing the index of the next packet error
+		PacketErrorIndexKeyName: 66,
+
+		// ConsumerIdToRelayerLivenessKeyName is the key for storing the last packet received from
+		// and the last packet acknowledged by a specific consumer chain
+		ConsumerIdToRelayerLivenessKeyName: 67,
+
+		// ConsumerIdToClientExpirySeverityKeyName is the key for storing the severity of the last
+		// client expiry warning of a specific consumer chain
+		ConsumerIdToClientExpirySeverityKeyName: 68,
+
+		// ConsumerIdToValsetHistoryKeyName is the key for storing the validator sets sent to
+		// a specific consumer chain, indexed by the VSC ids of the packets that carried them
+		ConsumerIdToValsetHistoryKeyName: 69,
+
+		// ConsumerIdToSlashPacketTraceKeyName is the key for storing how the slash packets
+		// received from a specific consumer chain were handled, indexed by packet sequence
+		ConsumerIdToSlashPacketTraceKeyName: 70,
+
+		// EpochStartKeyName is the key for storing the first blocks of the last two epochs
+		EpochStartKeyName: 71,
+
+		// ConsumerIdToUpdateHistoryKeyName is the key for storing the updates applied with
+		// MsgUpdateConsumer to a specific consumer chain, indexed by update sequence
+		ConsumerIdToUpdateHistoryKeyName: 72,
+
+		// PowerShapingTemplateIdKeyName is the key for storing the id of the next stored power-shaping template
+		PowerShapingTemplateIdKeyName: 73,
+
+		// PowerShapingTemplateKeyName is the key for storing the power-shaping templates by template id
+		PowerShapingTemplateKeyName: 74,
+
+		// ConsumerIdToUpgradePlanKeyName is the key for storing the upgrade planned by the owner of a consumer chain
+		ConsumerIdToUpgradePlanKeyName: 75,
+
+		// ConsumerIdToMinCommissionRateKeyName is the key for storing the minimum commission rate of a consumer chain
+		ConsumerIdToMinCommissionRateKeyName: 76,
+
+		// ProjectedDropOffKeyName is the key for storing the validators of a consumer chain that were
+		// warned to be projected to drop out of its validator set at the next epoch
+		ProjectedDropOffKeyName: 77,
+
+		// ConsumerIdToValsetCheckpointKeyName is the key for storing the latest valset checkpoint of a consumer chain,
+		// i.e., the valset update ID and the hash of the last validator set sent to the consumer chain.
+		// NOTE: the prefix is shared with the consumer module, which verifies proofs of the checkpoints
+		ConsumerIdToValsetCheckpointKeyName: ccvtypes.ProviderValsetCheckpointKeyPrefix,
+
+		// ConsumerIdToVSCHistoryKeyName is the key for storing the VSC packets sent to
+		// a specific consumer chain, indexed by valset update id
+		ConsumerIdToVSCHistoryKeyName: 79,
+
+		// ValsetUpdateBlockTimeKeyName is the key for storing the mapping from vscIDs to the block times
+		// of the block heights they are mapped to, used to prune the mapping from vscIDs to block heights
+		ValsetUpdateBlockTimeKeyName: 80,
+
+		// ConsumerIdToDowntimeParamsKeyName is the key for storing the downtime detection parameters
+		// the provider pushes to a consumer chain
+		ConsumerIdToDowntimeParamsKeyName: 81,
+
+		// PendingDowntimeParamsKeyName is the key for storing whether the downtime detection parameters
+		// of a consumer chain still need to be sent to the consumer chain
+		PendingDowntimeParamsKeyName: 82,
+
+		// RelayerRebateCountKeyName is the key for storing the number of relayed CCV packets
+		// of a consumer chain rebated in the current epoch
+		RelayerRebateCountKeyName: 83,
+
+		// ConsumerIdToCCVTimeoutPeriodKeyName is the key for storing the timeout period
+		// of the CCV packets sent to a consumer chain, overriding the CcvTimeoutPeriod param
+		ConsumerIdToCCVTimeoutPeriodKeyName: 84,
+
+		// ConsumerIdToSlashPacketTraceCountKeyName is the key for storing the number of
+		// slash packet traces stored for a specific consumer chain
+		ConsumerIdToSlashPacketTraceCountKeyName: 85,
+
+		// CommitteeKey is the key for storing the mapping from a consumer chain to the set of validators
+		// that are members of the committee of the consumer chain
+		CommitteeKeyName: 86,
+
+		// ProviderFeePoolAddrKeyName is the key for storing the address of the consumer rewards pool
+		// last declared to the consumer chains
+		ProviderFeePoolAddrKeyName: 87,
+
+		// ConsumerDowntimeJailKeyName is the key for storing the validators jailed on the provider chain
+		// due to downtime slash packets received from a consumer chain
+		ConsumerDowntimeJailKeyName: 88,
+
+		// ConsumerIdToValSetResyncKeyName is the key for storing the consumer public keys that must be removed
+		// when the validator set of a consumer chain is resent after VSC packets were dropped or rejected
+		ConsumerIdToValSetResyncKeyName: 89,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -419,7 +601,6 @@ func getKeyPrefixes() map[string]byte {
 // mustGetKeyPrefix returns the key prefix for a given key.
 // It panics if there is not byte prefix for the index.
 func mustGetKeyPrefix(key string) byte {
-	keyPrefixes := getKeyPrefixes()
 	if prefix, found := keyPrefixes[key]; !found {
 		panic(fmt.Sprintf("could not find key prefix for index %s", key))
 	} else {
@@ -850,6 +1031,60 @@ func InfractionScheduledTimeToConsumerIdsKey(updateTime time.Time) []byte {
 	)
 }
 
+// ConsumerIdToVscConfirmationKeyPrefix returns the key prefix for storing the VSC confirmations of consumer chains
+func ConsumerIdToVscConfirmationKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToVscConfirmationKeyName)
+}
+
+// ConsumerIdToVscConfirmationKey returns the key used to store the VSC confirmation of a consumer chain for a given VSC id
+func ConsumerIdToVscConfirmationKey(consumerId string, vscId uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerIdToVscConfirmationKeyPrefix(), consumerId, vscId)
+}
+
+// OptedInByValidatorKeyPrefix returns the key prefix for storing the consumer chains a validator is opted in to
+func OptedInByValidatorKeyPrefix() byte {
+	return mustGetKeyPrefix(OptedInByValidatorKeyName)
+}
+
+// OptedInByValidatorKey returns the key used to index an opted-in validator by its provider consensus address
+func OptedInByValidatorKey(providerAddr ProviderConsAddress, consumerId string) []byte {
+	return ConsAddrAndStringIdKey(OptedInByValidatorKeyPrefix(), providerAddr.ToSdkConsAddr(), consumerId)
+}
+
+// IncrementalValSetUpdateKeyPrefix returns the key prefix for storing whether the validator set
+// of a consumer chain can be updated incrementally
+func IncrementalValSetUpdateKeyPrefix() byte {
+	return mustGetKeyPrefix(IncrementalValSetUpdateKeyName)
+}
+
+// IncrementalValSetUpdateKey returns the key used to store whether the validator set
+// of a consumer chain can be updated incrementally
+func IncrementalValSetUpdateKey(consumerId string) []byte {
+	return StringIdWithLenKey(IncrementalValSetUpdateKeyPrefix(), consumerId)
+}
+
+// ModifiedValidatorKeyPrefix returns the key prefix for storing the validators modified in the current epoch
+func ModifiedValidatorKeyPrefix() byte {
+	return mustGetKeyPrefix(ModifiedValidatorKeyName)
+}
+
+// ModifiedValidatorKey returns the key used to store a validator modified in the current epoch
+func ModifiedValidatorKey(providerAddr ProviderConsAddress) []byte {
+	return append([]byte{ModifiedValidatorKeyPrefix()}, providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// ConsumerIdToFailureHeightKeyPrefix returns the key prefix for storing the last block height
+// at which the per-block processing of a consumer chain failed
+func ConsumerIdToFailureHeightKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToFailureHeightKeyName)
+}
+
+// ConsumerIdToFailureHeightKey returns the key used to store the last block height
+// at which the per-block processing of a consumer chain failed
+func ConsumerIdToFailureHeightKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToFailureHeightKeyPrefix(), consumerId)
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
@@ -954,6 +1189,286 @@ func ParseStringIdAndConsAddrKey(prefix byte, bz []byte) (string, sdk.ConsAddres
 	return stringId, addr, nil
 }
 
+// ConsAddrWithLenKey returns the key with the following format:
+// bytePrefix | len(ConsAddress) | ConsAddress
+func ConsAddrWithLenKey(prefix byte, addr sdk.ConsAddress) []byte {
+	return ccvtypes.AppendMany(
+		[]byte{prefix},
+		address.MustLengthPrefix(addr),
+	)
+}
+
+// ConsAddrAndStringIdKey returns the key with the following format:
+// bytePrefix | len(ConsAddress) | ConsAddress | stringId
+func ConsAddrAndStringIdKey(prefix byte, addr sdk.ConsAddress, stringId string) []byte {
+	return ccvtypes.AppendMany(
+		ConsAddrWithLenKey(prefix, addr),
+		[]byte(stringId),
+	)
+}
+
+// ParseConsAddrAndStringIdKey returns the ConsAddress and string ID for a ConsAddrAndStringId key
+func ParseConsAddrAndStringIdKey(prefix byte, bz []byte) (sdk.ConsAddress, string, error) {
+	expectedPrefix := []byte{prefix}
+	prefixL := len(expectedPrefix)
+	if prefix := bz[:prefixL]; !bytes.Equal(prefix, expectedPrefix) {
+		return nil, "", fmt.Errorf("invalid prefix; expected: %X, got: %X", expectedPrefix, prefix)
+	}
+	addrL := int(bz[prefixL])
+	addr := bz[prefixL+1 : prefixL+1+addrL]
+	stringId := string(bz[prefixL+1+addrL:])
+	return addr, stringId, nil
+}
+
 //
 // End of generic helpers section
 //
+
+// PacketErrorKeyPrefix returns the key prefix for storing the most recent packets
+// received from consumer chains that resulted in error acknowledgements
+func PacketErrorKeyPrefix() byte {
+	return mustGetKeyPrefix(PacketErrorKeyName)
+}
+
+// PacketErrorKey returns the key used to store the packet error with the given index
+func PacketErrorKey(index uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{PacketErrorKeyPrefix()}, index)
+}
+
+// PacketErrorIndexKey returns the key used to store the index of the next packet error
+func PacketErrorIndexKey() []byte {
+	return []byte{mustGetKeyPrefix(PacketErrorIndexKeyName)}
+}
+
+// ConsumerIdToRelayerLivenessKeyPrefix returns the key prefix for storing the relayer liveness of consumer chains
+func ConsumerIdToRelayerLivenessKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToRelayerLivenessKeyName)
+}
+
+// ConsumerIdToRelayerLivenessKey returns the key used to store the relayer liveness of a consumer chain
+func ConsumerIdToRelayerLivenessKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToRelayerLivenessKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToClientExpirySeverityKeyPrefix returns the key prefix for storing the severity
+// of the last client expiry warning of consumer chains
+func ConsumerIdToClientExpirySeverityKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToClientExpirySeverityKeyName)
+}
+
+// ConsumerIdToClientExpirySeverityKey returns the key used to store the severity
+// of the last client expiry warning of a consumer chain
+func ConsumerIdToClientExpirySeverityKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToClientExpirySeverityKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToValsetHistoryKeyPrefix returns the key prefix for storing the history
+// of the validator sets sent to consumer chains
+func ConsumerIdToValsetHistoryKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToValsetHistoryKeyName)
+}
+
+// ConsumerIdToValsetHistoryKey returns the key used to store the validator set
+// sent to a consumer chain with the given VSC id
+func ConsumerIdToValsetHistoryKey(consumerId string, vscId uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerIdToValsetHistoryKeyPrefix(), consumerId, vscId)
+}
+
+// ConsumerIdToSlashPacketTraceKeyPrefix returns the key prefix for storing the traces
+// of the slash packets received from consumer chains
+func ConsumerIdToSlashPacketTraceKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToSlashPacketTraceKeyName)
+}
+
+// ConsumerIdToSlashPacketTraceKey returns the key used to store the trace
+// of the slash packet with the given sequence received from a consumer chain
+func ConsumerIdToSlashPacketTraceKey(consumerId string, sequence uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerIdToSlashPacketTraceKeyPrefix(), consumerId, sequence)
+}
+
+// EpochStartKey returns the key used to store the first blocks of the last two epochs
+func EpochStartKey() []byte {
+	return []byte{mustGetKeyPrefix(EpochStartKeyName)}
+}
+
+// ConsumerIdToUpdateHistoryKeyPrefix returns the key prefix for storing the updates
+// applied to consumer chains
+func ConsumerIdToUpdateHistoryKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToUpdateHistoryKeyName)
+}
+
+// ConsumerIdToUpdateHistoryKey returns the key used to store the update
+// with the given sequence applied to a consumer chain
+func ConsumerIdToUpdateHistoryKey(consumerId string, sequence uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerIdToUpdateHistoryKeyPrefix(), consumerId, sequence)
+}
+
+// PowerShapingTemplateIdKey returns the key used to store the id of the next stored power-shaping template
+func PowerShapingTemplateIdKey() []byte {
+	return []byte{mustGetKeyPrefix(PowerShapingTemplateIdKeyName)}
+}
+
+// PowerShapingTemplateKeyPrefix returns the key prefix for storing the power-shaping templates
+func PowerShapingTemplateKeyPrefix() byte {
+	return mustGetKeyPrefix(PowerShapingTemplateKeyName)
+}
+
+// PowerShapingTemplateKey returns the key used to store the power-shaping template with the given id
+func PowerShapingTemplateKey(templateId string) []byte {
+	return StringIdWithLenKey(PowerShapingTemplateKeyPrefix(), templateId)
+}
+
+// ConsumerIdToUpgradePlanKeyPrefix returns the key prefix for storing the upgrades planned for consumer chains
+func ConsumerIdToUpgradePlanKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToUpgradePlanKeyName)
+}
+
+// ConsumerIdToUpgradePlanKey returns the key used to store the upgrade planned for the given consumer chain
+func ConsumerIdToUpgradePlanKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToUpgradePlanKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToMinCommissionRateKeyPrefix returns the key prefix for storing the minimum commission rates of consumer chains
+func ConsumerIdToMinCommissionRateKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToMinCommissionRateKeyName)
+}
+
+// ConsumerIdToMinCommissionRateKey returns the key used to store the minimum commission rate of the given consumer chain
+func ConsumerIdToMinCommissionRateKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToMinCommissionRateKeyPrefix(), consumerId)
+}
+
+// ProjectedDropOffKeyPrefix returns the key prefix for storing the validators projected to drop out of
+// the validator sets of consumer chains
+func ProjectedDropOffKeyPrefix() byte {
+	return mustGetKeyPrefix(ProjectedDropOffKeyName)
+}
+
+// ProjectedDropOffKey returns the key used to store that a validator was warned to be projected
+// to drop out of the validator set of the given consumer chain
+func ProjectedDropOffKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(ProjectedDropOffKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerIdToValsetCheckpointKeyPrefix returns the key prefix for storing the latest valset checkpoints
+func ConsumerIdToValsetCheckpointKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToValsetCheckpointKeyName)
+}
+
+// ConsumerIdToValsetCheckpointKey returns the key used to store the latest valset checkpoint of the consumer chain
+func ConsumerIdToValsetCheckpointKey(consumerId string) []byte {
+	return append([]byte{ConsumerIdToValsetCheckpointKeyPrefix()}, []byte(consumerId)...)
+}
+
+// ConsumerIdToVSCHistoryKeyPrefix returns the key prefix for storing the VSC packets sent to consumer chains
+func ConsumerIdToVSCHistoryKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToVSCHistoryKeyName)
+}
+
+// ConsumerIdToVSCHistoryKey returns the key used to store the record
+// of the VSC packet with the given valset update id sent to a consumer chain
+func ConsumerIdToVSCHistoryKey(consumerId string, vscId uint64) []byte {
+	return StringIdAndUintIdKey(ConsumerIdToVSCHistoryKeyPrefix(), consumerId, vscId)
+}
+
+// ValsetUpdateBlockTimeKeyPrefix returns the key prefix for storing the mapping from valset update ID to block time
+func ValsetUpdateBlockTimeKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(ValsetUpdateBlockTimeKeyName)}
+}
+
+// ValsetUpdateBlockTimeKey returns the key for storing the block time of the block height a valset update ID is mapped to
+func ValsetUpdateBlockTimeKey(valsetUpdateId uint64) []byte {
+	return append(ValsetUpdateBlockTimeKeyPrefix(), sdk.Uint64ToBigEndian(valsetUpdateId)...)
+}
+
+// ConsumerIdToDowntimeParamsKeyPrefix returns the key prefix for storing the downtime detection parameters of consumer chains
+func ConsumerIdToDowntimeParamsKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToDowntimeParamsKeyName)
+}
+
+// ConsumerIdToDowntimeParamsKey returns the key used to store the downtime detection parameters of the given consumer chain
+func ConsumerIdToDowntimeParamsKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToDowntimeParamsKeyPrefix(), consumerId)
+}
+
+// PendingDowntimeParamsKeyPrefix returns the key prefix for storing whether
+// the downtime detection parameters of consumer chains still need to be sent
+func PendingDowntimeParamsKeyPrefix() byte {
+	return mustGetKeyPrefix(PendingDowntimeParamsKeyName)
+}
+
+// PendingDowntimeParamsKey returns the key used to store whether
+// the downtime detection parameters of the given consumer chain still need to be sent
+func PendingDowntimeParamsKey(consumerId string) []byte {
+	return StringIdWithLenKey(PendingDowntimeParamsKeyPrefix(), consumerId)
+}
+
+// RelayerRebateCountKeyPrefix returns the key prefix for storing the number of rebated packets per consumer chain
+func RelayerRebateCountKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(RelayerRebateCountKeyName)}
+}
+
+// RelayerRebateCountKey returns the key used to store the number of relayed CCV packets
+// of the given consumer chain rebated in the current epoch
+func RelayerRebateCountKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(RelayerRebateCountKeyName), consumerId)
+}
+
+// ConsumerIdToCCVTimeoutPeriodKeyPrefix returns the key prefix for storing the CCV timeout periods of consumer chains
+func ConsumerIdToCCVTimeoutPeriodKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToCCVTimeoutPeriodKeyName)
+}
+
+// ConsumerIdToCCVTimeoutPeriodKey returns the key used to store the timeout period
+// of the CCV packets sent to the given consumer chain
+func ConsumerIdToCCVTimeoutPeriodKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToCCVTimeoutPeriodKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToSlashPacketTraceCountKeyPrefix returns the key prefix for storing the number of
+// slash packet traces stored for consumer chains
+func ConsumerIdToSlashPacketTraceCountKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToSlashPacketTraceCountKeyName)
+}
+
+// ConsumerIdToSlashPacketTraceCountKey returns the key used to store the number of
+// slash packet traces stored for the given consumer chain
+func ConsumerIdToSlashPacketTraceCountKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToSlashPacketTraceCountKeyPrefix(), consumerId)
+}
+
+// CommitteeKeyPrefix returns the key prefix for storing consumer chains committees
+func CommitteeKeyPrefix() byte {
+	return mustGetKeyPrefix(CommitteeKeyName)
+}
+
+// CommitteeKey returns the key for storing consumer chains committees
+func CommitteeKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(CommitteeKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// ProviderFeePoolAddrKey returns the key for storing the address of the consumer rewards pool
+// last declared to the consumer chains
+func ProviderFeePoolAddrKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderFeePoolAddrKeyName)}
+}
+
+// ConsumerDowntimeJailKeyPrefix returns the key prefix for storing the validators jailed due to consumer downtime
+func ConsumerDowntimeJailKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerDowntimeJailKeyName)
+}
+
+// ConsumerDowntimeJailKey returns the key for storing the jailing of a validator due to consumer downtime
+func ConsumerDowntimeJailKey(consumerId string, providerAddr ProviderConsAddress) []byte {
+	return StringIdAndConsAddrKey(ConsumerDowntimeJailKeyPrefix(), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// ConsumerIdToValSetResyncKeyPrefix returns the key prefix for storing the consumer validator set resyncs
+func ConsumerIdToValSetResyncKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToValSetResyncKeyName)
+}
+
+// ConsumerIdToValSetResyncKey returns the key for storing the resync of the validator set of a consumer chain
+func ConsumerIdToValSetResyncKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToValSetResyncKeyPrefix(), consumerId)
+}
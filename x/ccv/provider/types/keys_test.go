@@ -12,6 +12,7 @@ import (
 	cryptoutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // Tests that all singular keys, or prefixes to fully resolves keys are non duplicate byte values.
@@ -155,6 +156,66 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(59), providertypes.InfractionScheduledTimeToConsumerIdsKeyPrefix())
 	i++
+	require.Equal(t, byte(60), providertypes.ConsumerIdToVscConfirmationKeyPrefix())
+	i++
+	require.Equal(t, byte(61), providertypes.OptedInByValidatorKeyPrefix())
+	i++
+	require.Equal(t, byte(62), providertypes.IncrementalValSetUpdateKeyPrefix())
+	i++
+	require.Equal(t, byte(63), providertypes.ModifiedValidatorKeyPrefix())
+	i++
+	require.Equal(t, byte(64), providertypes.ConsumerIdToFailureHeightKeyPrefix())
+	i++
+	require.Equal(t, byte(65), providertypes.PacketErrorKeyPrefix())
+	i++
+	require.Equal(t, byte(66), providertypes.PacketErrorIndexKey()[0])
+	i++
+	require.Equal(t, byte(67), providertypes.ConsumerIdToRelayerLivenessKeyPrefix())
+	i++
+	require.Equal(t, byte(68), providertypes.ConsumerIdToClientExpirySeverityKeyPrefix())
+	i++
+	require.Equal(t, byte(69), providertypes.ConsumerIdToValsetHistoryKeyPrefix())
+	i++
+	require.Equal(t, byte(70), providertypes.ConsumerIdToSlashPacketTraceKeyPrefix())
+	i++
+	require.Equal(t, byte(71), providertypes.EpochStartKey()[0])
+	i++
+	require.Equal(t, byte(72), providertypes.ConsumerIdToUpdateHistoryKeyPrefix())
+	i++
+	require.Equal(t, byte(73), providertypes.PowerShapingTemplateIdKey()[0])
+	i++
+	require.Equal(t, byte(74), providertypes.PowerShapingTemplateKeyPrefix())
+	i++
+	require.Equal(t, byte(75), providertypes.ConsumerIdToUpgradePlanKeyPrefix())
+	i++
+	require.Equal(t, byte(76), providertypes.ConsumerIdToMinCommissionRateKeyPrefix())
+	i++
+	require.Equal(t, byte(77), providertypes.ProjectedDropOffKeyPrefix())
+	i++
+	require.Equal(t, byte(78), providertypes.ConsumerIdToValsetCheckpointKeyPrefix())
+	i++
+	require.Equal(t, byte(79), providertypes.ConsumerIdToVSCHistoryKeyPrefix())
+	i++
+	require.Equal(t, byte(80), providertypes.ValsetUpdateBlockTimeKeyPrefix()[0])
+	i++
+	require.Equal(t, byte(81), providertypes.ConsumerIdToDowntimeParamsKeyPrefix())
+	i++
+	require.Equal(t, byte(82), providertypes.PendingDowntimeParamsKeyPrefix())
+	i++
+	require.Equal(t, byte(83), providertypes.RelayerRebateCountKeyPrefix()[0])
+	i++
+	require.Equal(t, byte(84), providertypes.ConsumerIdToCCVTimeoutPeriodKeyPrefix())
+	i++
+	require.Equal(t, byte(85), providertypes.ConsumerIdToSlashPacketTraceCountKeyPrefix())
+	i++
+	require.Equal(t, byte(86), providertypes.CommitteeKeyPrefix())
+	i++
+	require.Equal(t, byte(87), providertypes.ProviderFeePoolAddrKey()[0])
+	i++
+	require.Equal(t, byte(88), providertypes.ConsumerDowntimeJailKeyPrefix())
+	i++
+	require.Equal(t, byte(89), providertypes.ConsumerIdToValSetResyncKeyPrefix())
+	i++
 
 	prefixes := providertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -228,9 +289,46 @@ func getAllFullyDefinedKeys() [][]byte {
 		providertypes.ConsumerIdToInfractionParametersKey("13"),
 		providertypes.ConsumerIdToQueuedInfractionParametersKey("13"),
 		providertypes.InfractionScheduledTimeToConsumerIdsKey(time.Time{}),
+		providertypes.ConsumerIdToVscConfirmationKey("13", 7),
+		providertypes.OptedInByValidatorKey(providertypes.NewProviderConsAddress([]byte{0x05}), "13"),
+		providertypes.IncrementalValSetUpdateKey("13"),
+		providertypes.ModifiedValidatorKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToFailureHeightKey("13"),
+		providertypes.PacketErrorKey(7),
+		providertypes.PacketErrorIndexKey(),
+		providertypes.ConsumerIdToRelayerLivenessKey("13"),
+		providertypes.ConsumerIdToClientExpirySeverityKey("13"),
+		providertypes.ConsumerIdToValsetHistoryKey("13", 7),
+		providertypes.ConsumerIdToSlashPacketTraceKey("13", 7),
+		providertypes.EpochStartKey(),
+		providertypes.ConsumerIdToUpdateHistoryKey("13", 7),
+		providertypes.PowerShapingTemplateIdKey(),
+		providertypes.PowerShapingTemplateKey("13"),
+		providertypes.ConsumerIdToUpgradePlanKey("13"),
+		providertypes.ConsumerIdToMinCommissionRateKey("13"),
+		providertypes.ProjectedDropOffKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToValsetCheckpointKey("13"),
+		providertypes.ConsumerIdToVSCHistoryKey("13", 7),
+		providertypes.ValsetUpdateBlockTimeKey(7),
+		providertypes.ConsumerIdToDowntimeParamsKey("13"),
+		providertypes.PendingDowntimeParamsKey("13"),
+		providertypes.RelayerRebateCountKey("13"),
+		providertypes.ConsumerIdToCCVTimeoutPeriodKey("13"),
+		providertypes.ConsumerIdToSlashPacketTraceCountKey("13"),
+		providertypes.CommitteeKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ProviderFeePoolAddrKey(),
+		providertypes.ConsumerDowntimeJailKey("13", providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToValSetResyncKey("13"),
 	}
 }
 
+// TestValsetCheckpointKey tests that the key of the valset checkpoints matches
+// the key used by the consumer module to verify proofs of the checkpoints
+func TestValsetCheckpointKey(t *testing.T) {
+	require.Equal(t, ccvtypes.ProviderValsetCheckpointKey("13"), providertypes.ConsumerIdToValsetCheckpointKey("13"))
+	require.Equal(t, ccvtypes.ProviderStoreKey, providertypes.StoreKey)
+}
+
 // Tests the construction and parsing of StringIdAndTs keys
 func TestStringIdAndTsKeyAndParse(t *testing.T) {
 	tests := []struct {
@@ -316,6 +414,39 @@ func TestStringIdAndConsAddrAndParse(t *testing.T) {
 	}
 }
 
+func TestConsAddrAndStringIdAndParse(t *testing.T) {
+	cIds := []*cryptoutil.CryptoIdentity{
+		cryptoutil.NewCryptoIdentityFromIntSeed(99998),
+		cryptoutil.NewCryptoIdentityFromIntSeed(99999),
+		cryptoutil.NewCryptoIdentityFromIntSeed(100000),
+	}
+	pubKey1 := cIds[0].TMCryptoPubKey()
+	pubKey2 := cIds[1].TMCryptoPubKey()
+	pubKey3 := cIds[2].TMCryptoPubKey()
+
+	tests := []struct {
+		prefix     byte
+		addr       sdk.ConsAddress
+		consumerId string
+	}{
+		{prefix: 0x01, addr: sdk.ConsAddress(pubKey1.Address()), consumerId: "1"},
+		{prefix: 0x02, addr: sdk.ConsAddress(pubKey2.Address()), consumerId: "23"},
+		{prefix: 0x03, addr: sdk.ConsAddress(pubKey3.Address()), consumerId: "456"},
+	}
+
+	for _, test := range tests {
+		key := providertypes.ConsAddrAndStringIdKey(test.prefix, test.addr, test.consumerId)
+		require.NotEmpty(t, key)
+		// Expected bytes = prefix + consAddr length + consAddr bytes + consumerID
+		expectedLen := 1 + 1 + len(test.addr) + len(test.consumerId)
+		require.Equal(t, expectedLen, len(key))
+		parsedConsAddr, parsedID, err := providertypes.ParseConsAddrAndStringIdKey(test.prefix, key)
+		require.Equal(t, test.addr, parsedConsAddr)
+		require.Equal(t, test.consumerId, parsedID)
+		require.NoError(t, err)
+	}
+}
+
 // Test key packing functions with the format <prefix><stringID>
 func TestKeysWithPrefixAndId(t *testing.T) {
 	funcs := []func(string) []byte{
@@ -347,6 +478,7 @@ func TestKeysWithPrefixAndId(t *testing.T) {
 func TestKeysWithUint64Payload(t *testing.T) {
 	funcs := []func(uint64) []byte{
 		providertypes.ValsetUpdateBlockHeightKey,
+		providertypes.ValsetUpdateBlockTimeKey,
 	}
 
 	tests := []struct {
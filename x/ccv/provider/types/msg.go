@@ -1,10 +1,16 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
@@ -14,6 +20,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -31,6 +38,16 @@ const (
 	MaxHashLength = 64
 	// MaxValidatorCount defines the maximum number of validators
 	MaxValidatorCount = 1000
+	// MaxURLLength defines the maximum length of the URLs in the consumer metadata
+	MaxURLLength = 255
+	// MaxSeedCount defines the maximum number of seed nodes in the consumer metadata
+	MaxSeedCount = 20
+	// MaxRewardDenomsMetadataCount defines the maximum number of reward denoms metadata in the consumer metadata
+	MaxRewardDenomsMetadataCount = 20
+	// ProviderCCVTimeoutPeriodFactor bounds the timeout period of the CCV packets sent to a consumer chain
+	// set by its owner, i.e., the timeout period must be within
+	// [CcvTimeoutPeriod / ProviderCCVTimeoutPeriodFactor, CcvTimeoutPeriod * ProviderCCVTimeoutPeriodFactor]
+	ProviderCCVTimeoutPeriodFactor = 2
 )
 
 var (
@@ -44,6 +61,12 @@ var (
 	_ sdk.Msg = (*MsgOptIn)(nil)
 	_ sdk.Msg = (*MsgOptOut)(nil)
 	_ sdk.Msg = (*MsgSetConsumerCommissionRate)(nil)
+	_ sdk.Msg = (*MsgStoreShapingTemplate)(nil)
+	_ sdk.Msg = (*MsgRegisterConsumerUpgrade)(nil)
+	_ sdk.Msg = (*MsgCancelConsumerUpgrade)(nil)
+	_ sdk.Msg = (*MsgFlushKeyAssignmentReplacement)(nil)
+	_ sdk.Msg = (*MsgSetConsumerCommittee)(nil)
+	_ sdk.Msg = (*MsgRevertConsumerSlash)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgAssignConsumerKey)(nil)
 	_ sdk.HasValidateBasic = (*MsgChangeRewardDenoms)(nil)
@@ -55,6 +78,12 @@ var (
 	_ sdk.HasValidateBasic = (*MsgOptIn)(nil)
 	_ sdk.HasValidateBasic = (*MsgOptOut)(nil)
 	_ sdk.HasValidateBasic = (*MsgSetConsumerCommissionRate)(nil)
+	_ sdk.HasValidateBasic = (*MsgStoreShapingTemplate)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterConsumerUpgrade)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelConsumerUpgrade)(nil)
+	_ sdk.HasValidateBasic = (*MsgFlushKeyAssignmentReplacement)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetConsumerCommittee)(nil)
+	_ sdk.HasValidateBasic = (*MsgRevertConsumerSlash)(nil)
 )
 
 // NewMsgAssignConsumerKey creates a new MsgAssignConsumerKey instance.
@@ -336,6 +365,10 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if err := validatePowerShapingTemplateId(msg.PowerShapingTemplateId, msg.PowerShapingParameters); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "PowerShapingTemplateId: %s", err.Error())
+	}
+
 	if msg.PowerShapingParameters != nil {
 		if msg.PowerShapingParameters.Top_N != 0 {
 			return errors.New("cannot create a Top N chain through `MsgCreateConsumer`; " +
@@ -358,6 +391,24 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.MinCommissionRate != nil {
+		if err := ValidateMinCommissionRate(*msg.MinCommissionRate); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "MinCommissionRate: %s", err.Error())
+		}
+	}
+
+	if msg.DowntimeParams != nil {
+		if err := msg.DowntimeParams.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "DowntimeParams: %s", err.Error())
+		}
+	}
+
+	if msg.ProviderCcvTimeoutPeriod != nil {
+		if err := ccvtypes.ValidateDuration(*msg.ProviderCcvTimeoutPeriod); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "ProviderCcvTimeoutPeriod: %s", err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -399,6 +450,10 @@ func (msg MsgUpdateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if err := validatePowerShapingTemplateId(msg.PowerShapingTemplateId, msg.PowerShapingParameters); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "PowerShapingTemplateId: %s", err.Error())
+	}
+
 	if msg.PowerShapingParameters != nil {
 		if err := ValidatePowerShapingParameters(*msg.PowerShapingParameters); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "PowerShapingParameters: %s", err.Error())
@@ -417,6 +472,24 @@ func (msg MsgUpdateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.MinCommissionRate != nil {
+		if err := ValidateMinCommissionRate(*msg.MinCommissionRate); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "MinCommissionRate: %s", err.Error())
+		}
+	}
+
+	if msg.DowntimeParams != nil {
+		if err := msg.DowntimeParams.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "DowntimeParams: %s", err.Error())
+		}
+	}
+
+	if msg.ProviderCcvTimeoutPeriod != nil {
+		if err := ccvtypes.ValidateDuration(*msg.ProviderCcvTimeoutPeriod); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "ProviderCcvTimeoutPeriod: %s", err.Error())
+		}
+	}
+
 	return nil
 }
 
@@ -436,6 +509,132 @@ func (msg MsgRemoveConsumer) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgStoreShapingTemplate creates a new MsgStoreShapingTemplate instance
+func NewMsgStoreShapingTemplate(submitter, name string, powerShapingParameters PowerShapingParameters) (*MsgStoreShapingTemplate, error) {
+	return &MsgStoreShapingTemplate{
+		Submitter:              submitter,
+		Name:                   name,
+		PowerShapingParameters: powerShapingParameters,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgStoreShapingTemplate) ValidateBasic() error {
+	if err := ValidateStringField("Name", msg.Name, MaxNameLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgStoreShapingTemplate, "Name: %s", err.Error())
+	}
+
+	if err := ValidatePowerShapingParameters(msg.PowerShapingParameters); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgStoreShapingTemplate, "PowerShapingParameters: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRegisterConsumerUpgrade creates a new MsgRegisterConsumerUpgrade instance
+func NewMsgRegisterConsumerUpgrade(owner, consumerId string, upgradePlan ccvtypes.ConsumerUpgradePlan) (*MsgRegisterConsumerUpgrade, error) {
+	return &MsgRegisterConsumerUpgrade{
+		Owner:       owner,
+		ConsumerId:  consumerId,
+		UpgradePlan: upgradePlan,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgRegisterConsumerUpgrade) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRegisterConsumerUpgrade, "ConsumerId: %s", err.Error())
+	}
+
+	if err := msg.UpgradePlan.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRegisterConsumerUpgrade, "UpgradePlan: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgCancelConsumerUpgrade creates a new MsgCancelConsumerUpgrade instance
+func NewMsgCancelConsumerUpgrade(owner, consumerId string) (*MsgCancelConsumerUpgrade, error) {
+	return &MsgCancelConsumerUpgrade{
+		Owner:      owner,
+		ConsumerId: consumerId,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgCancelConsumerUpgrade) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgCancelConsumerUpgrade, "ConsumerId: %s", err.Error())
+	}
+	return nil
+}
+
+// NewMsgFlushKeyAssignmentReplacement creates a new MsgFlushKeyAssignmentReplacement instance
+func NewMsgFlushKeyAssignmentReplacement(submitter, consumerId, consumerAddress string) (*MsgFlushKeyAssignmentReplacement, error) {
+	return &MsgFlushKeyAssignmentReplacement{
+		Submitter:       submitter,
+		ConsumerId:      consumerId,
+		ConsumerAddress: consumerAddress,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgFlushKeyAssignmentReplacement) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgFlushKeyAssignmentReplacement, "ConsumerId: %s", err.Error())
+	}
+
+	if strings.TrimSpace(msg.ConsumerAddress) == "" {
+		return errorsmod.Wrapf(ErrInvalidMsgFlushKeyAssignmentReplacement, "ConsumerAddress cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgSetConsumerCommittee creates a new MsgSetConsumerCommittee instance
+func NewMsgSetConsumerCommittee(owner, consumerId string, committee []string) (*MsgSetConsumerCommittee, error) {
+	return &MsgSetConsumerCommittee{
+		Owner:      owner,
+		ConsumerId: consumerId,
+		Committee:  committee,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgSetConsumerCommittee) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerCommittee, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateConsAddressList(msg.Committee, MaxValidatorCount); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerCommittee, "Committee: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRevertConsumerSlash creates a new MsgRevertConsumerSlash instance
+func NewMsgRevertConsumerSlash(authority, consumerId, providerConsAddr string) (*MsgRevertConsumerSlash, error) {
+	return &MsgRevertConsumerSlash{
+		Authority:        authority,
+		ConsumerId:       consumerId,
+		ProviderConsAddr: providerConsAddr,
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgRevertConsumerSlash) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRevertConsumerSlash, "ConsumerId: %s", err.Error())
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(msg.ProviderConsAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRevertConsumerSlash, "ProviderConsAddr: %s", err.Error())
+	}
+
+	return nil
+}
+
 //
 // Validation methods
 //
@@ -523,6 +722,122 @@ func ValidateConsumerMetadata(metadata ConsumerMetadata) error {
 		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "Metadata: %s", err.Error())
 	}
 
+	if err := validateOptionalURL(metadata.RepoUrl); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "RepoUrl: %s", err.Error())
+	}
+
+	if err := validateOptionalSha256Hash(metadata.BinaryChecksum); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "BinaryChecksum: %s", err.Error())
+	}
+
+	if err := validateOptionalSha256Hash(metadata.GenesisHash); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "GenesisHash: %s", err.Error())
+	}
+
+	if err := validateSeeds(metadata.Seeds); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "Seeds: %s", err.Error())
+	}
+
+	if err := validateOptionalURL(metadata.DocsUrl); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "DocsUrl: %s", err.Error())
+	}
+
+	if err := validateRewardDenomsMetadata(metadata.RewardDenomsMetadata); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerMetadata, "RewardDenomsMetadata: %s", err.Error())
+	}
+
+	return nil
+}
+
+// validateRewardDenomsMetadata validates that the provided reward denoms metadata
+// are valid bank metadata and that there is at most one metadata per denom
+func validateRewardDenomsMetadata(rewardDenomsMetadata []RewardDenomMetadata) error {
+	if len(rewardDenomsMetadata) > MaxRewardDenomsMetadataCount {
+		return fmt.Errorf("too many reward denoms metadata; got: %d, max: %d",
+			len(rewardDenomsMetadata), MaxRewardDenomsMetadataCount)
+	}
+	denoms := map[string]struct{}{}
+	for _, m := range rewardDenomsMetadata {
+		if _, found := denoms[m.Denom]; found {
+			return fmt.Errorf("duplicate metadata for denom %s", m.Denom)
+		}
+		denoms[m.Denom] = struct{}{}
+
+		if len(m.Name) > MaxNameLength || len(m.Symbol) > MaxNameLength {
+			return fmt.Errorf("name or symbol of denom %s is too long; max: %d", m.Denom, MaxNameLength)
+		}
+		if m.Exponent == 0 {
+			return fmt.Errorf("exponent of denom %s cannot be zero", m.Denom)
+		}
+		if err := m.ToBankMetadata().Validate(); err != nil {
+			return fmt.Errorf("invalid metadata for denom %s: %w", m.Denom, err)
+		}
+	}
+	return nil
+}
+
+// validateOptionalURL validates that the provided URL is either empty or an absolute http(s) URL
+func validateOptionalURL(rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	if len(rawURL) > MaxURLLength {
+		return fmt.Errorf("URL is too long; got: %d, max: %d", len(rawURL), MaxURLLength)
+	}
+	u, err := url.ParseRequestURI(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid URL (%s): expected an absolute http or https URL", rawURL)
+	}
+	return nil
+}
+
+// validateOptionalSha256Hash validates that the provided hash is either empty or a hex-encoded SHA-256 hash
+func validateOptionalSha256Hash(hash string) error {
+	if hash == "" {
+		return nil
+	}
+	bz, err := hex.DecodeString(hash)
+	if err != nil {
+		return fmt.Errorf("hash (%s) is not hex-encoded: %w", hash, err)
+	}
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("hash (%s) has invalid length; got: %d bytes, expected: %d", hash, len(bz), sha256.Size)
+	}
+	return nil
+}
+
+// validateSeeds validates that the provided seed nodes are in the `node_id@host:port` format,
+// with node_id the hex-encoded ID of the node
+func validateSeeds(seeds []string) error {
+	if len(seeds) > MaxSeedCount {
+		return fmt.Errorf("too many seeds; got: %d, max: %d", len(seeds), MaxSeedCount)
+	}
+	for _, seed := range seeds {
+		if len(seed) > MaxURLLength {
+			return fmt.Errorf("seed is too long; got: %d, max: %d", len(seed), MaxURLLength)
+		}
+		nodeId, hostPort, found := strings.Cut(seed, "@")
+		if !found {
+			return fmt.Errorf("invalid seed (%s): expected the node_id@host:port format", seed)
+		}
+		// the node id is the address of the node key
+		if bz, err := hex.DecodeString(nodeId); err != nil || len(bz) != cmtcrypto.AddressSize {
+			return fmt.Errorf("invalid seed (%s): node id should be %d hex-encoded bytes", seed, cmtcrypto.AddressSize)
+		}
+		host, port, err := net.SplitHostPort(hostPort)
+		if err != nil {
+			return fmt.Errorf("invalid seed (%s): %w", seed, err)
+		}
+		if host == "" {
+			return fmt.Errorf("invalid seed (%s): empty host", seed)
+		}
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			return fmt.Errorf("invalid seed (%s): invalid port: %w", seed, err)
+		}
+	}
 	return nil
 }
 
@@ -552,6 +867,17 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "ValidatorsPowerCap has to be in the range [0, 100]")
 	}
 
+	if powerShapingParameters.MinUptime > 100 {
+		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "MinUptime has to be in the range [0, 100]")
+	}
+
+	// the total voting power of the consumer chain cannot exceed the maximum total voting power
+	// even if all the validators have their power raised to `MinValidatorPower`
+	if powerShapingParameters.MinValidatorPower > uint64(cmttypes.MaxTotalVotingPower/MaxValidatorCount) {
+		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "MinValidatorPower has to be in the range [0, %d]",
+			cmttypes.MaxTotalVotingPower/MaxValidatorCount)
+	}
+
 	if err := ValidateConsAddressList(powerShapingParameters.Allowlist, MaxValidatorCount); err != nil {
 		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Allowlist: %s", err.Error())
 	}
@@ -565,6 +891,21 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 	return nil
 }
 
+// validatePowerShapingTemplateId validates the optional id of a power-shaping template referenced in a message,
+// i.e., it must correspond to a uint64 and cannot be provided together with power-shaping parameters
+func validatePowerShapingTemplateId(templateId string, powerShapingParameters *PowerShapingParameters) error {
+	if templateId == "" {
+		return nil
+	}
+	if _, err := strconv.ParseUint(templateId, 10, 64); err != nil {
+		return fmt.Errorf("template id (%s) cannot be parsed: %s", templateId, err.Error())
+	}
+	if powerShapingParameters != nil {
+		return errors.New("cannot provide both a power-shaping template and power-shaping parameters")
+	}
+	return nil
+}
+
 // ValidateAllowlistedRewardDenoms validates the provided allowlisted reward denoms
 func ValidateAllowlistedRewardDenoms(allowlistedRewardDenoms AllowlistedRewardDenoms) error {
 	if len(allowlistedRewardDenoms.Denoms) > MaxAllowlistedRewardDenomsPerChain {
@@ -589,6 +930,11 @@ func ValidateInitializationParameters(initializationParameters ConsumerInitializ
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "GenesisHash: %s", err.Error())
 	}
 
+	if initializationParameters.VerifyGenesisHash && len(initializationParameters.GenesisHash) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters,
+			"GenesisHash must be a SHA256 hash when VerifyGenesisHash is set; got: %d bytes", len(initializationParameters.GenesisHash))
+	}
+
 	if err := ValidateByteSlice(initializationParameters.BinaryHash, MaxHashLength); err != nil {
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "BinaryHash: %s", err.Error())
 	}
@@ -625,9 +971,41 @@ func ValidateInitializationParameters(initializationParameters ConsumerInitializ
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "ConnectionId: %s", err.Error())
 	}
 
+	if err := ccvtypes.ValidateClientIdentifier(initializationParameters.ClientId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "ClientId: %s", err.Error())
+	}
+
+	if strings.TrimSpace(initializationParameters.ClientId) != "" && strings.TrimSpace(initializationParameters.ConnectionId) != "" {
+		return errorsmod.Wrap(ErrInvalidConsumerInitializationParameters, "ClientId and ConnectionId cannot be both set")
+	}
+
+	return nil
+}
+
+// ProviderCCVTimeoutPeriodBounds returns the minimum and maximum timeout periods of the CCV packets
+// sent to a consumer chain that can be set by its owner, given the CcvTimeoutPeriod param
+func ProviderCCVTimeoutPeriodBounds(ccvTimeoutPeriod time.Duration) (time.Duration, time.Duration) {
+	return ccvTimeoutPeriod / ProviderCCVTimeoutPeriodFactor, ccvTimeoutPeriod * ProviderCCVTimeoutPeriodFactor
+}
+
+// ValidateProviderCCVTimeoutPeriod validates that the timeout period of the CCV packets sent to a consumer chain
+// is within the bounds given by the CcvTimeoutPeriod param (see ProviderCCVTimeoutPeriodBounds)
+func ValidateProviderCCVTimeoutPeriod(timeoutPeriod, ccvTimeoutPeriod time.Duration) error {
+	minPeriod, maxPeriod := ProviderCCVTimeoutPeriodBounds(ccvTimeoutPeriod)
+	if timeoutPeriod < minPeriod || timeoutPeriod > maxPeriod {
+		return fmt.Errorf("timeout period (%s) must be within [%s, %s]", timeoutPeriod, minPeriod, maxPeriod)
+	}
 	return nil
 }
 
+// ValidateMinCommissionRate validates that the minimum commission rate of a consumer chain is in [0, 1]
+func ValidateMinCommissionRate(minRate math.LegacyDec) error {
+	if minRate.IsNil() {
+		return fmt.Errorf("min commission rate cannot be nil")
+	}
+	return ccvtypes.ValidateFraction(minRate)
+}
+
 // ValidateInfractionParameters validates that all the provided infraction parameters are in the expected range
 func ValidateInfractionParameters(initializationParameters InfractionParameters) error {
 	if initializationParameters.DoubleSign != nil {
@@ -14,6 +14,7 @@ import (
 
 	cryptoutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 func TestValidateStringField(t *testing.T) {
@@ -133,6 +134,164 @@ func TestValidateConsumerMetadata(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid with node bootstrapping fields",
+			metadata: types.ConsumerMetadata{
+				Name:           "name",
+				Description:    "description",
+				Metadata:       "metadata",
+				RepoUrl:        "https://github.com/cosmos/interchain-security",
+				BinaryChecksum: strings.Repeat("ab", 32),
+				GenesisHash:    strings.Repeat("CD", 32),
+				Seeds: []string{
+					"3f472746f46493309650e5a033076689996c8881@seed.consumer.network:26656",
+					"3f472746f46493309650e5a033076689996c8882@10.0.0.1:26656",
+				},
+				DocsUrl: "http://docs.consumer.network/validators",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid repo URL",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				RepoUrl:     "github.com/cosmos/interchain-security",
+			},
+			valid: false,
+		},
+		{
+			name: "invalid docs URL scheme",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				DocsUrl:     "ftp://docs.consumer.network",
+			},
+			valid: false,
+		},
+		{
+			name: "too long docs URL",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				DocsUrl:     "https://" + generateLongString(types.MaxURLLength),
+			},
+			valid: false,
+		},
+		{
+			name: "valid reward denoms metadata",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				RewardDenomsMetadata: []types.RewardDenomMetadata{
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Name: "Consumer", Symbol: "CON", Display: "con", Exponent: 6},
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "duplicate reward denom metadata",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				RewardDenomsMetadata: []types.RewardDenomMetadata{
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Name: "Consumer", Symbol: "CON", Display: "con", Exponent: 6},
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Name: "Consumer", Symbol: "CON", Display: "ucon", Exponent: 3},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "reward denom metadata with zero exponent",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				RewardDenomsMetadata: []types.RewardDenomMetadata{
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Name: "Consumer", Symbol: "CON", Display: "con", Exponent: 0},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "reward denom metadata without symbol",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				RewardDenomsMetadata: []types.RewardDenomMetadata{
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Name: "Consumer", Display: "con", Exponent: 6},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "reward denom metadata with invalid display denom",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				RewardDenomsMetadata: []types.RewardDenomMetadata{
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Name: "Consumer", Symbol: "CON", Display: "!", Exponent: 6},
+				},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid binary checksum",
+			metadata: types.ConsumerMetadata{
+				Name:           "name",
+				Description:    "description",
+				Metadata:       "metadata",
+				BinaryChecksum: "checksum",
+			},
+			valid: false,
+		},
+		{
+			name: "invalid genesis hash length",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				GenesisHash: strings.Repeat("ab", 20),
+			},
+			valid: false,
+		},
+		{
+			name: "invalid seed without node id",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				Seeds:       []string{"seed.consumer.network:26656"},
+			},
+			valid: false,
+		},
+		{
+			name: "invalid seed without port",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				Seeds:       []string{"3f472746f46493309650e5a033076689996c8881@seed.consumer.network"},
+			},
+			valid: false,
+		},
+		{
+			name: "too many seeds",
+			metadata: types.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+				Seeds:       make([]string, types.MaxSeedCount+1),
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -209,6 +368,44 @@ func TestValidateInitializationParameters(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid - verified genesis hash",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       make([]byte, 32),
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				VerifyGenesisHash:                 true,
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - verified genesis hash is not a SHA256 hash",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				VerifyGenesisHash:                 true,
+			},
+			valid: false,
+		},
 		{
 			name: "invalid - zero spawn time",
 			params: types.ConsumerInitializationParameters{
@@ -353,6 +550,63 @@ func TestValidateInitializationParameters(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid - existing client",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				ClientId:                          "07-tendermint-0",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - ClientId too long",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+				ClientId:                          coolStr,
+			},
+			valid: false,
+		},
+		{
+			name: "invalid - both ClientId and ConnectionId",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "connection-0",
+				ClientId:                          "07-tendermint-0",
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -625,6 +879,38 @@ func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 			"validchainid-0",
 			false,
 		},
+		{
+			"min uptime is invalid",
+			types.PowerShapingParameters{
+				Top_N:              50,
+				ValidatorsPowerCap: 0,
+				ValidatorSetCap:    0,
+				Allowlist:          nil,
+				Denylist:           nil,
+				MinStake:           0,
+				AllowInactiveVals:  false,
+				Prioritylist:       nil,
+				MinUptime:          101,
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"min validator power is invalid",
+			types.PowerShapingParameters{
+				Top_N:              50,
+				ValidatorsPowerCap: 0,
+				ValidatorSetCap:    0,
+				Allowlist:          nil,
+				Denylist:           nil,
+				MinStake:           0,
+				AllowInactiveVals:  false,
+				Prioritylist:       nil,
+				MinValidatorPower:  1 << 62,
+			},
+			"validchainid-0",
+			false,
+		},
 		{
 			"valid proposal",
 			types.PowerShapingParameters{
@@ -654,6 +940,336 @@ func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgStoreShapingTemplateValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		templateName           string
+		powerShapingParameters types.PowerShapingParameters
+		expPass                bool
+	}{
+		{
+			"success",
+			"template",
+			types.PowerShapingParameters{Top_N: 50, ValidatorsPowerCap: 10},
+			true,
+		},
+		{
+			"empty name",
+			"  ",
+			types.PowerShapingParameters{},
+			false,
+		},
+		{
+			"too long name",
+			strings.Repeat("a", types.MaxNameLength+1),
+			types.PowerShapingParameters{},
+			false,
+		},
+		{
+			"invalid power-shaping parameters",
+			"template",
+			types.PowerShapingParameters{Top_N: 10},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgStoreShapingTemplate("submitter", tc.templateName, tc.powerShapingParameters)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+		} else {
+			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+		}
+	}
+}
+
+func TestMsgPowerShapingTemplateIdValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		templateId             string
+		powerShapingParameters *types.PowerShapingParameters
+		expPass                bool
+	}{
+		{
+			"no template",
+			"",
+			&types.PowerShapingParameters{},
+			true,
+		},
+		{
+			"template",
+			"3",
+			nil,
+			true,
+		},
+		{
+			"invalid template id",
+			"template",
+			nil,
+			false,
+		},
+		{
+			"both template and power-shaping parameters",
+			"3",
+			&types.PowerShapingParameters{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		validConsumerMetadata := types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}
+		createMsg, err := types.NewMsgCreateConsumer("submitter", "somechain-1", validConsumerMetadata, nil, tc.powerShapingParameters, nil, nil)
+		require.NoError(t, err)
+		createMsg.PowerShapingTemplateId = tc.templateId
+		updateMsg, err := types.NewMsgUpdateConsumer("owner", "0", "", nil, nil, tc.powerShapingParameters, nil, "", nil)
+		require.NoError(t, err)
+		updateMsg.PowerShapingTemplateId = tc.templateId
+
+		for _, err := range []error{createMsg.ValidateBasic(), updateMsg.ValidateBasic()} {
+			if tc.expPass {
+				require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+			} else {
+				require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+			}
+		}
+	}
+}
+
+func TestMsgMinCommissionRateValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		minRate *math.LegacyDec
+		expPass bool
+	}{
+		{
+			"no min commission rate",
+			nil,
+			true,
+		},
+		{
+			"valid min commission rate",
+			&[]math.LegacyDec{math.LegacyNewDecWithPrec(5, 2)}[0],
+			true,
+		},
+		{
+			"nil min commission rate",
+			&math.LegacyDec{},
+			false,
+		},
+		{
+			"negative min commission rate",
+			&[]math.LegacyDec{math.LegacyNewDec(-1)}[0],
+			false,
+		},
+		{
+			"min commission rate over 1",
+			&[]math.LegacyDec{math.LegacyNewDecWithPrec(11, 1)}[0],
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		validConsumerMetadata := types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}
+		createMsg, err := types.NewMsgCreateConsumer("submitter", "somechain-1", validConsumerMetadata, nil, nil, nil, nil)
+		require.NoError(t, err)
+		createMsg.MinCommissionRate = tc.minRate
+		updateMsg, err := types.NewMsgUpdateConsumer("owner", "0", "", nil, nil, nil, nil, "", nil)
+		require.NoError(t, err)
+		updateMsg.MinCommissionRate = tc.minRate
+
+		for _, err := range []error{createMsg.ValidateBasic(), updateMsg.ValidateBasic()} {
+			if tc.expPass {
+				require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+			} else {
+				require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+			}
+		}
+	}
+}
+
+func TestMsgDowntimeParamsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name           string
+		downtimeParams *ccvtypes.DowntimeParams
+		expPass        bool
+	}{
+		{
+			"no downtime params",
+			nil,
+			true,
+		},
+		{
+			"valid downtime params",
+			&ccvtypes.DowntimeParams{SignedBlocksWindow: 10000, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2)},
+			true,
+		},
+		{
+			"zero signed blocks window",
+			&ccvtypes.DowntimeParams{SignedBlocksWindow: 0, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2)},
+			false,
+		},
+		{
+			"min signed per window over 1",
+			&ccvtypes.DowntimeParams{SignedBlocksWindow: 10000, MinSignedPerWindow: math.LegacyNewDecWithPrec(11, 1)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		validConsumerMetadata := types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}
+		createMsg, err := types.NewMsgCreateConsumer("submitter", "somechain-1", validConsumerMetadata, nil, nil, nil, nil)
+		require.NoError(t, err)
+		createMsg.DowntimeParams = tc.downtimeParams
+		updateMsg, err := types.NewMsgUpdateConsumer("owner", "0", "", nil, nil, nil, nil, "", nil)
+		require.NoError(t, err)
+		updateMsg.DowntimeParams = tc.downtimeParams
+
+		for _, err := range []error{createMsg.ValidateBasic(), updateMsg.ValidateBasic()} {
+			if tc.expPass {
+				require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+			} else {
+				require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+			}
+		}
+	}
+}
+
+func TestMsgCCVTimeoutPeriodValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		timeoutPeriod *time.Duration
+		expPass       bool
+	}{
+		{
+			"no ccv timeout period",
+			nil,
+			true,
+		},
+		{
+			"valid ccv timeout period",
+			&[]time.Duration{14 * 24 * time.Hour}[0],
+			true,
+		},
+		{
+			"zero ccv timeout period",
+			&[]time.Duration{0}[0],
+			false,
+		},
+		{
+			"negative ccv timeout period",
+			&[]time.Duration{-time.Hour}[0],
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		validConsumerMetadata := types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}
+		createMsg, err := types.NewMsgCreateConsumer("submitter", "somechain-1", validConsumerMetadata, nil, nil, nil, nil)
+		require.NoError(t, err)
+		createMsg.ProviderCcvTimeoutPeriod = tc.timeoutPeriod
+		updateMsg, err := types.NewMsgUpdateConsumer("owner", "0", "", nil, nil, nil, nil, "", nil)
+		require.NoError(t, err)
+		updateMsg.ProviderCcvTimeoutPeriod = tc.timeoutPeriod
+
+		for _, err := range []error{createMsg.ValidateBasic(), updateMsg.ValidateBasic()} {
+			if tc.expPass {
+				require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+			} else {
+				require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+			}
+		}
+	}
+}
+
+func TestMsgSetConsumerCommitteeValidateBasic(t *testing.T) {
+	consAddr1 := "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
+	consAddr2 := "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39"
+
+	testCases := []struct {
+		name       string
+		consumerId string
+		committee  []string
+		expPass    bool
+	}{
+		{
+			"empty committee",
+			"0",
+			nil,
+			true,
+		},
+		{
+			"valid committee",
+			"0",
+			[]string{consAddr1, consAddr2},
+			true,
+		},
+		{
+			"invalid consumer id",
+			"chain-1",
+			[]string{consAddr1},
+			false,
+		},
+		{
+			"invalid committee member",
+			"0",
+			[]string{consAddr1, "cosmosvalcons1nx7n5uh0ztxsynn4sje6ey"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgSetConsumerCommittee("owner", tc.consumerId, tc.committee)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+		} else {
+			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+		}
+	}
+}
+
+func TestMsgRevertConsumerSlashValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name             string
+		consumerId       string
+		providerConsAddr string
+		expPass          bool
+	}{
+		{
+			"valid message",
+			"0",
+			"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq",
+			true,
+		},
+		{
+			"invalid consumer id",
+			"chain-1",
+			"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq",
+			false,
+		},
+		{
+			"invalid provider consensus address",
+			"0",
+			"cosmosvalcons1nx7n5uh0ztxsynn4sje6ey",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgRevertConsumerSlash("authority", tc.consumerId, tc.providerConsAddr)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+		} else {
+			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+		}
+	}
+}
+
 func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 	cId1 := cryptoutil.NewCryptoIdentityFromIntSeed(35443543534)
 	cId2 := cryptoutil.NewCryptoIdentityFromIntSeed(65465464564)
@@ -52,6 +52,42 @@ const (
 	// DefaultMaxProviderConsensusValidators is the default maximum number of validators that will
 	// be passed on from the staking module to the consensus engine on the provider.
 	DefaultMaxProviderConsensusValidators = 180
+
+	// DefaultValsetCheckpointPeriod is the default number of epochs between two
+	// consecutive valset checkpoint packets. By default, checkpoints are disabled.
+	DefaultValsetCheckpointPeriod = int64(0)
+
+	// DefaultRelayerStalenessThreshold is the default duration without any packet received from
+	// or acknowledged by a consumer chain, while VSC packets are pending acknowledgement,
+	// after which a warning event is emitted. It is well below the default CCV timeout period,
+	// giving the operators time to restore the relaying before the CCV channel times out.
+	DefaultRelayerStalenessThreshold = 24 * time.Hour
+
+	// DefaultValsetHistorySize is the default maximal number of validator sets retained
+	// per consumer chain. With the default epoch length of about one hour, it covers the
+	// three weeks of a typical unbonding period even if the valset changes every epoch.
+	DefaultValsetHistorySize = int64(504)
+
+	// DefaultMinConsumerCommissionRate is the default minimum commission rate validators can set
+	// on a consumer chain. By default, only the minimum commission rate of the staking module applies.
+	DefaultMinConsumerCommissionRate = "0"
+
+	// DefaultValsetUpdateHeightRetentionMargin is the default duration for which the mappings from
+	// valset update IDs to block heights are retained in addition to the unbonding period.
+	// It matches the default CCV timeout period, i.e., the longest a slash packet can be in flight.
+	DefaultValsetUpdateHeightRetentionMargin = ccvtypes.DefaultCCVTimeoutPeriod
+
+	// DefaultRelayerRebateFraction is the default fraction of the consumer rewards
+	// sent to the relayer rebate pool. By default, the pool is not funded from the rewards.
+	DefaultRelayerRebateFraction = "0"
+
+	// DefaultMaxRelayerRebatesPerEpoch is the default maximum number of relayed CCV packets
+	// rebated per consumer chain and per epoch
+	DefaultMaxRelayerRebatesPerEpoch = int64(100)
+
+	// DefaultAllowConsumerKeyReuse defines whether, by default, a consumer key can be assigned
+	// while it is assigned on another consumer chain. By default, keys cannot be reused across chains.
+	DefaultAllowConsumerKeyReuse = false
 )
 
 // Reflection based keys for params subspace
@@ -85,6 +121,16 @@ func NewParams(
 	blocksPerEpoch int64,
 	numberOfEpochsToStartReceivingRewards int64,
 	maxProviderConsensusValidators int64,
+	valsetCheckpointPeriod int64,
+	relayerStalenessThreshold time.Duration,
+	clientExpiryWarningWindow time.Duration,
+	valsetHistorySize int64,
+	minConsumerCommissionRate string,
+	valsetUpdateHeightRetentionMargin time.Duration,
+	relayerRebateFraction string,
+	relayerRebatePerPacket sdk.Coin,
+	maxRelayerRebatesPerEpoch int64,
+	allowConsumerKeyReuse bool,
 ) Params {
 	return Params{
 		TemplateClient:                        cs,
@@ -96,6 +142,16 @@ func NewParams(
 		BlocksPerEpoch:                        blocksPerEpoch,
 		NumberOfEpochsToStartReceivingRewards: numberOfEpochsToStartReceivingRewards,
 		MaxProviderConsensusValidators:        maxProviderConsensusValidators,
+		ValsetCheckpointPeriod:                valsetCheckpointPeriod,
+		RelayerStalenessThreshold:             relayerStalenessThreshold,
+		ClientExpiryWarningWindow:             clientExpiryWarningWindow,
+		ValsetHistorySize:                     valsetHistorySize,
+		MinConsumerCommissionRate:             minConsumerCommissionRate,
+		ValsetUpdateHeightRetentionMargin:     valsetUpdateHeightRetentionMargin,
+		RelayerRebateFraction:                 relayerRebateFraction,
+		RelayerRebatePerPacket:                relayerRebatePerPacket,
+		MaxRelayerRebatesPerEpoch:             maxRelayerRebatesPerEpoch,
+		AllowConsumerKeyReuse:                 allowConsumerKeyReuse,
 	}
 }
 
@@ -132,6 +188,20 @@ func DefaultParams() Params {
 		DefaultBlocksPerEpoch,
 		DefaultNumberOfEpochsToStartReceivingRewards,
 		DefaultMaxProviderConsensusValidators,
+		DefaultValsetCheckpointPeriod,
+		DefaultRelayerStalenessThreshold,
+		ccvtypes.DefaultClientExpiryWarningWindow,
+		DefaultValsetHistorySize,
+		DefaultMinConsumerCommissionRate,
+		DefaultValsetUpdateHeightRetentionMargin,
+		DefaultRelayerRebateFraction,
+		// by default, relayers are not rebated
+		sdk.Coin{
+			Denom:  sdk.DefaultBondDenom,
+			Amount: math.ZeroInt(),
+		},
+		DefaultMaxRelayerRebatesPerEpoch,
+		DefaultAllowConsumerKeyReuse,
 	)
 }
 
@@ -168,6 +238,33 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.MaxProviderConsensusValidators); err != nil {
 		return fmt.Errorf("max provider consensus validators is invalid: %s", err)
 	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.ValsetCheckpointPeriod); err != nil {
+		return fmt.Errorf("valset checkpoint period is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.RelayerStalenessThreshold); err != nil {
+		return fmt.Errorf("relayer staleness threshold is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.ClientExpiryWarningWindow); err != nil {
+		return fmt.Errorf("client expiry warning window is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.ValsetHistorySize); err != nil {
+		return fmt.Errorf("valset history size is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateStringFraction(p.MinConsumerCommissionRate); err != nil {
+		return fmt.Errorf("min consumer commission rate is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.ValsetUpdateHeightRetentionMargin); err != nil {
+		return fmt.Errorf("valset update height retention margin is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateStringFraction(p.RelayerRebateFraction); err != nil {
+		return fmt.Errorf("relayer rebate fraction is invalid: %s", err)
+	}
+	if err := p.RelayerRebatePerPacket.Validate(); err != nil {
+		return fmt.Errorf("relayer rebate per packet is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.MaxRelayerRebatesPerEpoch); err != nil {
+		return fmt.Errorf("max relayer rebates per epoch is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -0,0 +1,196 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// StorePrefixDecoders returns the decoders of all the provider key prefixes,
+// used to dump the provider store in a human-readable form.
+// Deprecated prefixes only have a name, i.e., their values are hex encoded.
+func StorePrefixDecoders() map[byte]ccvtypes.StorePrefixDecoder {
+	// consumer id extractors for the different key formats
+	consumerIdSuffix := func(key, _ []byte) (string, error) {
+		return string(key[1:]), nil
+	}
+	stringIdWithLen := func(key, _ []byte) (string, error) {
+		return ParseStringIdWithLenKey(key[0], key)
+	}
+	stringIdAndConsAddr := func(key, _ []byte) (string, error) {
+		consumerId, _, err := ParseStringIdAndConsAddrKey(key[0], key)
+		return consumerId, err
+	}
+	stringIdAndTs := func(key, _ []byte) (string, error) {
+		consumerId, _, err := ParseStringIdAndTsKey(key[0], key)
+		return consumerId, err
+	}
+	stringIdAndUintId := func(key, _ []byte) (string, error) {
+		consumerId, _, err := ParseStringIdAndUintIdKey(key[0], key)
+		return consumerId, err
+	}
+	consAddrAndStringId := func(key, _ []byte) (string, error) {
+		_, consumerId, err := ParseConsAddrAndStringIdKey(key[0], key)
+		return consumerId, err
+	}
+	consumerIdValue := func(_, value []byte) (string, error) {
+		return string(value), nil
+	}
+
+	decoders := map[string]ccvtypes.StorePrefixDecoder{
+		ParametersKeyName:                       {Value: ccvtypes.ProtoStoreValue[Params]()},
+		PortKeyName:                             {Value: ccvtypes.StringStoreValue},
+		ValidatorSetUpdateIdKeyName:             {Value: ccvtypes.Uint64StoreValue},
+		SlashMeterKeyName:                       {Value: intStoreValue},
+		SlashMeterReplenishTimeCandidateKeyName: {Value: timeBytesStoreValue},
+		ConsumerIdToChannelIdKeyName:            {ConsumerId: consumerIdSuffix, Value: ccvtypes.StringStoreValue},
+		ChannelIdToConsumerIdKeyName:            {ConsumerId: consumerIdValue, Value: ccvtypes.StringStoreValue},
+		ConsumerIdToClientIdKeyName:             {ConsumerId: consumerIdSuffix, Value: ccvtypes.StringStoreValue},
+		ValsetUpdateBlockHeightKeyName:          {Value: ccvtypes.Uint64StoreValue},
+		ConsumerGenesisKeyName:                  {ConsumerId: consumerIdSuffix, Value: ccvtypes.ProtoStoreValue[ccvtypes.ConsumerGenesisState]()},
+		SlashAcksKeyName:                        {ConsumerId: consumerIdSuffix, Value: ccvtypes.ProtoStoreValue[SlashAcks]()},
+		InitChainHeightKeyName:                  {ConsumerId: consumerIdSuffix, Value: ccvtypes.Uint64StoreValue},
+		PendingVSCsKeyName:                      {ConsumerId: consumerIdSuffix, Value: ccvtypes.ProtoStoreValue[ValidatorSetChangePackets]()},
+		ConsumerValidatorsKeyName:               {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.ProtoStoreValue[tmprotocrypto.PublicKey]()},
+		ValidatorsByConsumerAddrKeyName:         {ConsumerId: stringIdAndConsAddr, Value: consAddrStoreValue},
+		SlashLogKeyName:                         {Value: ccvtypes.EmptyStoreValue},
+		ConsumerRewardDenomsKeyName:             {Value: ccvtypes.EmptyStoreValue},
+		EquivocationEvidenceMinHeightKeyName:    {ConsumerId: consumerIdSuffix, Value: ccvtypes.Uint64StoreValue},
+		ConsumerValidatorKeyName:                {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.ProtoStoreValue[ConsensusValidator]()},
+		OptedInKeyName:                          {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.EmptyStoreValue},
+		AllowlistKeyName:                        {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.EmptyStoreValue},
+		DenylistKeyName:                         {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.EmptyStoreValue},
+		ConsumerCommissionRateKeyName:           {ConsumerId: stringIdAndConsAddr, Value: legacyDecStoreValue},
+		MinimumPowerInTopNKeyName:               {ConsumerId: stringIdWithLen, Value: ccvtypes.Uint64StoreValue},
+		ConsumerAddrsToPruneV2KeyName:           {ConsumerId: stringIdAndTs, Value: ccvtypes.ProtoStoreValue[AddressList]()},
+		LastProviderConsensusValsKeyName:        {Value: ccvtypes.ProtoStoreValue[ConsensusValidator]()},
+		ConsumerIdKeyName:                       {Value: ccvtypes.Uint64StoreValue},
+		ConsumerIdToChainIdKeyName:              {ConsumerId: stringIdWithLen, Value: ccvtypes.StringStoreValue},
+		ConsumerIdToOwnerAddressKeyName:         {ConsumerId: stringIdWithLen, Value: ccvtypes.StringStoreValue},
+		ConsumerIdToConsumerMetadataKeyName:     {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[ConsumerMetadata]()},
+		ConsumerIdToInitializationParametersKeyName: {
+			ConsumerId: stringIdWithLen,
+			Value:      ccvtypes.ProtoStoreValue[ConsumerInitializationParameters](),
+		},
+		ConsumerIdToPowerShapingParameters:        {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[PowerShapingParameters]()},
+		ConsumerIdToPhaseKeyName:                  {ConsumerId: stringIdWithLen, Value: phaseStoreValue},
+		ConsumerIdToRemovalTimeKeyName:            {ConsumerId: stringIdWithLen, Value: timeBinaryStoreValue},
+		SpawnTimeToConsumerIdsKeyName:             {Value: ccvtypes.ProtoStoreValue[ConsumerIds]()},
+		RemovalTimeToConsumerIdsKeyName:           {Value: ccvtypes.ProtoStoreValue[ConsumerIds]()},
+		ClientIdToConsumerIdKeyName:               {ConsumerId: consumerIdValue, Value: ccvtypes.StringStoreValue},
+		ConsumerIdToAllowlistedRewardDenomKeyName: {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[AllowlistedRewardDenoms]()},
+		ConsumerRewardsAllocationByDenomKeyName:   {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[ConsumerRewardsAllocation]()},
+		PrioritylistKeyName:                       {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.EmptyStoreValue},
+		ConsumerIdToInfractionParametersKeyName:   {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[InfractionParameters]()},
+		ConsumerIdToQueuedInfractionParametersKeyName: {
+			ConsumerId: stringIdWithLen,
+			Value:      ccvtypes.ProtoStoreValue[InfractionParameters](),
+		},
+		InfractionScheduledTimeToConsumerIdsKeyName: {Value: ccvtypes.ProtoStoreValue[ConsumerIds]()},
+		ConsumerIdToVscConfirmationKeyName:          {ConsumerId: stringIdAndUintId, Value: ccvtypes.ProtoStoreValue[VscConfirmation]()},
+		OptedInByValidatorKeyName:                   {ConsumerId: consAddrAndStringId, Value: ccvtypes.EmptyStoreValue},
+		IncrementalValSetUpdateKeyName:              {ConsumerId: stringIdWithLen, Value: ccvtypes.EmptyStoreValue},
+		ModifiedValidatorKeyName:                    {Value: ccvtypes.EmptyStoreValue},
+		ConsumerIdToFailureHeightKeyName:            {ConsumerId: stringIdWithLen, Value: ccvtypes.Uint64StoreValue},
+		PacketErrorKeyName:                          {Value: ccvtypes.ProtoStoreValue[PacketError]()},
+		PacketErrorIndexKeyName:                     {Value: ccvtypes.Uint64StoreValue},
+		ConsumerIdToRelayerLivenessKeyName:          {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[RelayerLiveness]()},
+		ConsumerIdToClientExpirySeverityKeyName:     {ConsumerId: stringIdWithLen, Value: ccvtypes.Uint64StoreValue},
+		ConsumerIdToValsetHistoryKeyName:            {ConsumerId: stringIdAndUintId, Value: ccvtypes.ProtoStoreValue[ValsetSnapshot]()},
+		ConsumerIdToSlashPacketTraceKeyName:         {ConsumerId: stringIdAndUintId, Value: ccvtypes.ProtoStoreValue[SlashPacketTrace]()},
+		EpochStartKeyName:                           {Value: ccvtypes.ProtoStoreValue[EpochStart]()},
+		ConsumerIdToUpdateHistoryKeyName:            {ConsumerId: stringIdAndUintId, Value: ccvtypes.ProtoStoreValue[ConsumerUpdateRecord]()},
+		PowerShapingTemplateIdKeyName:               {Value: ccvtypes.Uint64StoreValue},
+		PowerShapingTemplateKeyName:                 {Value: ccvtypes.ProtoStoreValue[PowerShapingTemplate]()},
+		ConsumerIdToUpgradePlanKeyName:              {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[ccvtypes.ConsumerUpgradePlan]()},
+		ConsumerIdToMinCommissionRateKeyName:        {ConsumerId: stringIdWithLen, Value: legacyDecStoreValue},
+		ProjectedDropOffKeyName:                     {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.EmptyStoreValue},
+		ConsumerIdToValsetCheckpointKeyName:         {ConsumerId: consumerIdSuffix, Value: ccvtypes.ProtoStoreValue[ccvtypes.ValsetCheckpointPacketData]()},
+		ConsumerIdToVSCHistoryKeyName:               {ConsumerId: stringIdAndUintId, Value: ccvtypes.ProtoStoreValue[VSCPacketRecord]()},
+		ValsetUpdateBlockTimeKeyName:                {Value: timeBytesStoreValue},
+		ConsumerIdToDowntimeParamsKeyName:           {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[ccvtypes.DowntimeParams]()},
+		PendingDowntimeParamsKeyName:                {ConsumerId: stringIdWithLen, Value: ccvtypes.EmptyStoreValue},
+		RelayerRebateCountKeyName:                   {Value: ccvtypes.Uint64StoreValue},
+		ConsumerIdToCCVTimeoutPeriodKeyName:         {ConsumerId: stringIdWithLen, Value: durationStoreValue},
+		ConsumerIdToSlashPacketTraceCountKeyName:    {ConsumerId: stringIdWithLen, Value: ccvtypes.Uint64StoreValue},
+		CommitteeKeyName:                            {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.EmptyStoreValue},
+		ProviderFeePoolAddrKeyName:                  {Value: ccvtypes.StringStoreValue},
+		ConsumerDowntimeJailKeyName:                 {ConsumerId: stringIdAndConsAddr, Value: ccvtypes.ProtoStoreValue[ConsumerDowntimeJail]()},
+		ConsumerIdToValSetResyncKeyName:             {ConsumerId: stringIdWithLen, Value: ccvtypes.ProtoStoreValue[ConsumerValSetResync]()},
+	}
+
+	prefixDecoders := make(map[byte]ccvtypes.StorePrefixDecoder, len(getKeyPrefixes()))
+	for name, prefix := range getKeyPrefixes() {
+		decoder := decoders[name]
+		decoder.Name = name
+		prefixDecoders[prefix] = decoder
+	}
+	return prefixDecoders
+}
+
+// intStoreValue decodes a math.Int value, e.g., the slash meter
+func intStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	var value math.Int
+	if err := value.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value.String())
+}
+
+// legacyDecStoreValue decodes a math.LegacyDec value, e.g., a commission rate
+func legacyDecStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	var value math.LegacyDec
+	if err := value.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value.String())
+}
+
+// timeBytesStoreValue decodes a timestamp encoded with sdk.FormatTimeBytes
+func timeBytesStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	value, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// timeBinaryStoreValue decodes a timestamp encoded with time.Time.MarshalBinary
+func timeBinaryStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	var value time.Time
+	if err := value.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// durationStoreValue decodes a duration stored as big endian nanoseconds
+func durationStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("invalid duration length: %d", len(bz))
+	}
+	return json.Marshal(time.Duration(sdk.BigEndianToUint64(bz)).String())
+}
+
+// consAddrStoreValue decodes a consensus address stored as raw bytes
+func consAddrStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	return json.Marshal(sdk.ConsAddress(bz).String())
+}
+
+// phaseStoreValue decodes a consumer phase
+func phaseStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	if len(bz) != 4 {
+		return nil, fmt.Errorf("invalid consumer phase length: %d", len(bz))
+	}
+	return json.Marshal(ConsumerPhase(binary.BigEndian.Uint32(bz)).String())
+}
@@ -0,0 +1,129 @@
+package types_test
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	cryptoutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Tests that every provider key prefix has a decoder and that
+// only the deprecated prefixes are missing a value decoder.
+func TestStorePrefixDecoders(t *testing.T) {
+	decoders := providertypes.StorePrefixDecoders()
+	require.Len(t, decoders, len(providertypes.GetAllKeyPrefixes()))
+
+	for _, name := range providertypes.GetAllKeyNames() {
+		found := false
+		for _, decoder := range decoders {
+			if decoder.Name != name {
+				continue
+			}
+			found = true
+			require.Equal(t, strings.HasPrefix(name, "Deprecated"), decoder.Value == nil, name)
+		}
+		require.True(t, found, name)
+	}
+}
+
+func TestDecodeStoreEntry(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	decoders := providertypes.StorePrefixDecoders()
+
+	providerAddr := cryptoutil.NewCryptoIdentityFromIntSeed(0).ProviderConsAddress()
+	consumerKey := cryptoutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	validator, err := (&providertypes.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            10,
+		PublicKey:        &consumerKey,
+	}).Marshal()
+	require.NoError(t, err)
+	phase := make([]byte, 4)
+	binary.BigEndian.PutUint32(phase, uint32(providertypes.CONSUMER_PHASE_LAUNCHED))
+
+	testCases := []struct {
+		name               string
+		key                []byte
+		value              []byte
+		expectedPrefix     string
+		expectedConsumerId string
+		expectedValue      string
+	}{
+		{
+			name:               "consumer phase",
+			key:                providertypes.ConsumerIdToPhaseKey("3"),
+			value:              phase,
+			expectedPrefix:     providertypes.ConsumerIdToPhaseKeyName,
+			expectedConsumerId: "3",
+			expectedValue:      `"CONSUMER_PHASE_LAUNCHED"`,
+		},
+		{
+			name:               "consumer chain id",
+			key:                providertypes.ConsumerIdToChainIdKey("3"),
+			value:              []byte("consumer-3"),
+			expectedPrefix:     providertypes.ConsumerIdToChainIdKeyName,
+			expectedConsumerId: "3",
+			expectedValue:      `"consumer-3"`,
+		},
+		{
+			name:               "consumer id stored as value",
+			key:                providertypes.ChannelToConsumerIdKey("channel-0"),
+			value:              []byte("3"),
+			expectedPrefix:     providertypes.ChannelIdToConsumerIdKeyName,
+			expectedConsumerId: "3",
+			expectedValue:      `"3"`,
+		},
+		{
+			name:               "proto value",
+			key:                providertypes.ConsumerValidatorKey("3", providerAddr.ToSdkConsAddr()),
+			value:              validator,
+			expectedPrefix:     providertypes.ConsumerValidatorKeyName,
+			expectedConsumerId: "3",
+			expectedValue:      `"power":"10"`,
+		},
+		{
+			name:               "empty value",
+			key:                providertypes.OptedInKey("3", providerAddr),
+			value:              []byte{},
+			expectedPrefix:     providertypes.OptedInKeyName,
+			expectedConsumerId: "3",
+			expectedValue:      "null",
+		},
+		{
+			name:           "value that cannot be decoded",
+			key:            providertypes.ValidatorSetUpdateIdKey(),
+			value:          []byte{0xAB},
+			expectedPrefix: providertypes.ValidatorSetUpdateIdKeyName,
+			expectedValue:  `"AB"`,
+		},
+		{
+			name:           "deprecated prefix",
+			key:            []byte{9, 1},
+			value:          []byte{0xCD},
+			expectedPrefix: providertypes.DeprecatedPendingCAPKeyName,
+			expectedValue:  `"CD"`,
+		},
+		{
+			name:           "unknown prefix",
+			key:            []byte{0xAB, 1},
+			value:          []byte{0xCD},
+			expectedPrefix: "0xAB",
+			expectedValue:  `"CD"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		entry := ccvtypes.DecodeStoreEntry(cdc, decoders, tc.key, tc.value)
+		require.Equal(t, tc.expectedPrefix, entry.Prefix, tc.name)
+		require.Equal(t, tc.expectedConsumerId, entry.ConsumerId, tc.name)
+		require.Contains(t, string(entry.Value), tc.expectedValue, tc.name)
+	}
+}
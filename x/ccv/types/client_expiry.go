@@ -0,0 +1,76 @@
+package types
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetClientExpiry returns when the client with the given id expires unless it is updated,
+// i.e., when the trusting period elapses after the timestamp of its latest consensus state,
+// and how close it is to expiry given the warning window
+func GetClientExpiry(ctx sdk.Context, clientKeeper ClientKeeper, clientID string, warningWindow time.Duration) (ClientExpiry, error) {
+	clientState, found := clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return ClientExpiry{}, errorsmod.Wrapf(clienttypes.ErrClientNotFound, "client not found for client ID: %s", clientID)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return ClientExpiry{}, errorsmod.Wrapf(clienttypes.ErrInvalidClientType, "unexpected client state type: %T", clientState)
+	}
+	consensusState, found := clientKeeper.GetLatestClientConsensusState(ctx, clientID)
+	if !found {
+		return ClientExpiry{}, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for client ID: %s", clientID)
+	}
+
+	latestConsensusTime := time.Unix(0, int64(consensusState.GetTimestamp())).UTC()
+	expiryTime := latestConsensusTime.Add(tmClientState.TrustingPeriod)
+	remaining := expiryTime.Sub(ctx.BlockTime())
+	return ClientExpiry{
+		ClientId:            clientID,
+		LatestConsensusTime: latestConsensusTime,
+		TrustingPeriod:      tmClientState.TrustingPeriod,
+		ExpiryTime:          expiryTime,
+		Remaining:           remaining,
+		Severity:            GetClientExpirySeverity(remaining, warningWindow),
+	}, nil
+}
+
+// GetClientExpirySeverity returns the severity of a client that expires in the given remaining time.
+// The severity escalates from warning, once the remaining time is within the warning window,
+// to critical, once it is within a quarter of the warning window, to expired.
+// A zero warning window disables the warning and critical severities.
+func GetClientExpirySeverity(remaining, warningWindow time.Duration) ClientExpirySeverity {
+	switch {
+	case remaining <= 0:
+		return CLIENT_EXPIRY_SEVERITY_EXPIRED
+	case remaining <= warningWindow/4:
+		return CLIENT_EXPIRY_SEVERITY_CRITICAL
+	case remaining <= warningWindow:
+		return CLIENT_EXPIRY_SEVERITY_WARNING
+	default:
+		return CLIENT_EXPIRY_SEVERITY_NONE
+	}
+}
+
+// EmitClientExpiryWarningEvent emits a client_expiry_warning event of the given module
+// with the given extra attributes
+func EmitClientExpiryWarningEvent(ctx sdk.Context, module string, expiry ClientExpiry, attributes ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeClientExpiryWarning,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, module),
+				sdk.NewAttribute(AttributeClientId, expiry.ClientId),
+				sdk.NewAttribute(AttributeClientExpirySeverity, expiry.Severity.String()),
+				sdk.NewAttribute(AttributeClientExpiryTime, expiry.ExpiryTime.String()),
+				sdk.NewAttribute(AttributeClientExpiryRemaining, expiry.Remaining.String()),
+			}, attributes...)...,
+		),
+	)
+}
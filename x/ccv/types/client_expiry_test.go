@@ -0,0 +1,33 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestGetClientExpirySeverity(t *testing.T) {
+	window := 4 * time.Hour
+
+	testCases := []struct {
+		name        string
+		remaining   time.Duration
+		window      time.Duration
+		expSeverity types.ClientExpirySeverity
+	}{
+		{"outside of the warning window", 5 * time.Hour, window, types.CLIENT_EXPIRY_SEVERITY_NONE},
+		{"within the warning window", 4 * time.Hour, window, types.CLIENT_EXPIRY_SEVERITY_WARNING},
+		{"within a quarter of the warning window", time.Hour, window, types.CLIENT_EXPIRY_SEVERITY_CRITICAL},
+		{"expired", 0, window, types.CLIENT_EXPIRY_SEVERITY_EXPIRED},
+		{"expired a while ago", -time.Hour, window, types.CLIENT_EXPIRY_SEVERITY_EXPIRED},
+		{"zero warning window", time.Second, 0, types.CLIENT_EXPIRY_SEVERITY_NONE},
+		{"zero warning window and expired", -time.Second, 0, types.CLIENT_EXPIRY_SEVERITY_EXPIRED},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expSeverity, types.GetClientExpirySeverity(tc.remaining, tc.window), tc.name)
+	}
+}
@@ -13,4 +13,16 @@ import (
 var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 
 func init() {
+	RegisterInterfaces(ModuleCdc.InterfaceRegistry())
+}
+
+// RegisterInterfaces registers the consumer packet payloads
+// that can be sent in a ConsumerPacketDataV2 envelope
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"interchain_security.ccv.v1.ConsumerPacketPayload",
+		(*ConsumerPacketPayload)(nil),
+		&SlashPacketData{},
+		&VSCMaturedPacketData{},
+	)
 }
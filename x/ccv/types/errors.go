@@ -25,4 +25,10 @@ var (
 	ErrStoreKeyNotFound            = errorsmod.Register(ModuleName, 17, "store key not found")
 	ErrStoreUnmarshal              = errorsmod.Register(ModuleName, 18, "cannot unmarshal value from store")
 	ErrInvalidConsumerId           = errorsmod.Register(ModuleName, 19, "invalid consumer id")
+	ErrDuplicateValidatorPubKey    = errorsmod.Register(ModuleName, 20, "duplicate validator public key in validator updates")
+	ErrInvalidValidatorPower       = errorsmod.Register(ModuleName, 21, "invalid validator power in validator updates")
+	ErrTooManyValidatorUpdates     = errorsmod.Register(ModuleName, 22, "too many validator updates")
+	ErrUnsupportedFeature          = errorsmod.Register(ModuleName, 23, "unsupported CCV feature")
+	ErrInvalidConsumerUpgradePlan  = errorsmod.Register(ModuleName, 24, "invalid consumer upgrade plan")
+	ErrInvalidDowntimeParams       = errorsmod.Register(ModuleName, 25, "invalid downtime params")
 )
@@ -14,6 +14,7 @@ const (
 	EventTypeSubmitConsumerDoubleVoting = "submit_consumer_double_voting"
 	EventTypeExecuteConsumerChainSlash  = "execute_consumer_chain_slash"
 	EventTypeConsumerSlashRequest       = "consumer_slash_request"
+	EventTypeClientExpiryWarning        = "client_expiry_warning"
 
 	AttributeKeyAckSuccess            = "success"
 	AttributeKeyAck                   = "acknowledgement"
@@ -36,4 +37,16 @@ const (
 	AttributeValidatorAddress         = "validator_address"
 	AttributeInfractionType           = "infraction_type"
 	AttributeValSetUpdateID           = "valset_update_id"
+	AttributeClientId                 = "client_id"
+	AttributeClientExpirySeverity     = "client_expiry_severity"
+	AttributeClientExpiryTime         = "client_expiry_time"
+	AttributeClientExpiryRemaining    = "client_expiry_remaining"
+	AttributeUpgradeName              = "upgrade_name"
+	AttributeUpgradeHaltHeight        = "upgrade_halt_height"
+	AttributeUpgradeHaltTime          = "upgrade_halt_time"
+	AttributeUpgradeBinaryHash        = "upgrade_binary_hash"
+	AttributeUpgradeRemaining         = "upgrade_remaining"
+	AttributeSignedBlocksWindow       = "signed_blocks_window"
+	AttributeMinSignedPerWindow       = "min_signed_per_window"
+	AttributeProviderFeePoolAddr      = "provider_fee_pool_addr"
 )
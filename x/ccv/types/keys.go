@@ -21,4 +21,18 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_ccv"
+
+	// ProviderStoreKey is the key of the provider module store
+	ProviderStoreKey = "provider"
+
+	// ProviderValsetCheckpointKeyPrefix is the prefix of the key under which the provider module
+	// stores the latest valset checkpoint of every consumer chain. It is shared with the consumer module,
+	// which verifies proofs of the checkpoints against the provider client.
+	ProviderValsetCheckpointKeyPrefix = byte(78)
 )
+
+// ProviderValsetCheckpointKey returns the key (in the provider store) of the latest valset checkpoint
+// of the consumer chain with the given consumer ID
+func ProviderValsetCheckpointKey(consumerId string) []byte {
+	return append([]byte{ProviderValsetCheckpointKeyPrefix}, []byte(consumerId)...)
+}
@@ -41,6 +41,10 @@ const (
 
 	// Default retry delay period is 1 hour.
 	DefaultRetryDelayPeriod = time.Hour
+
+	// Default client expiry warning window is 7 days, i.e., half of the trusting period
+	// of clients created with the default unbonding period and trusting period fraction.
+	DefaultClientExpiryWarningWindow = 7 * 24 * time.Hour
 )
 
 // Reflection based keys for params subspace
@@ -78,7 +82,7 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 	consumerRedistributionFraction string, historicalEntries int64,
 	consumerUnbondingPeriod time.Duration,
 	rewardDenoms, providerRewardDenoms []string, retryDelayPeriod time.Duration,
-	consumerId string,
+	consumerId string, clientExpiryWarningWindow time.Duration,
 ) ConsumerParams {
 	return ConsumerParams{
 		Enabled:                           enabled,
@@ -91,11 +95,12 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 		HistoricalEntries:                 historicalEntries,
 		UnbondingPeriod:                   consumerUnbondingPeriod,
 		// DEPRECATED but setting here to 0 (i.e., disabled) for older versions of interchain-security
-		SoftOptOutThreshold:  "0",
-		RewardDenoms:         rewardDenoms,
-		ProviderRewardDenoms: providerRewardDenoms,
-		RetryDelayPeriod:     retryDelayPeriod,
-		ConsumerId:           consumerId,
+		SoftOptOutThreshold:       "0",
+		RewardDenoms:              rewardDenoms,
+		ProviderRewardDenoms:      providerRewardDenoms,
+		RetryDelayPeriod:          retryDelayPeriod,
+		ConsumerId:                consumerId,
+		ClientExpiryWarningWindow: clientExpiryWarningWindow,
 	}
 }
 
@@ -117,6 +122,7 @@ func DefaultParams() ConsumerParams {
 		provideRewardDenoms,
 		DefaultRetryDelayPeriod,
 		"0",
+		DefaultClientExpiryWarningWindow,
 	)
 }
 
@@ -161,6 +167,12 @@ func (p ConsumerParams) Validate() error {
 	if err := ValidateConsumerId(p.ConsumerId); err != nil {
 		return err
 	}
+	if err := ValidateNonNegativeDuration(p.ClientExpiryWarningWindow); err != nil {
+		return err
+	}
+	if err := ValidateRedistributionFractionOverrides(p.ConsumerRedistributionFractionOverrides); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -222,3 +234,28 @@ func ValidateDenoms(i interface{}) error {
 
 	return nil
 }
+
+// ValidateRedistributionFractionOverrides validates that the overrides are for distinct valid denoms
+// and that the override fractions are valid fractions
+func ValidateRedistributionFractionOverrides(i interface{}) error {
+	v, ok := i.([]RedistributionFractionOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(v))
+	for _, override := range v {
+		if err := sdktypes.ValidateDenom(override.Denom); err != nil {
+			return err
+		}
+		if seen[override.Denom] {
+			return fmt.Errorf("duplicate redistribution fraction override for denom %s", override.Denom)
+		}
+		seen[override.Denom] = true
+		if err := ValidateStringFraction(override.Fraction); err != nil {
+			return fmt.Errorf("invalid redistribution fraction override for denom %s: %w", override.Denom, err)
+		}
+	}
+
+	return nil
+}
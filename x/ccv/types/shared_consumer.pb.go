@@ -5,12 +5,14 @@ package types
 
 import (
 	fmt "fmt"
-	types "github.com/cometbft/cometbft/abci/types"
+	types1 "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	_07_tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +31,43 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ClientExpirySeverity defines how close a counterparty client is to expiry
+type ClientExpirySeverity int32
+
+const (
+	// NONE defines a client that is not within the warning window.
+	CLIENT_EXPIRY_SEVERITY_NONE ClientExpirySeverity = 0
+	// WARNING defines a client that expires within the warning window.
+	CLIENT_EXPIRY_SEVERITY_WARNING ClientExpirySeverity = 1
+	// CRITICAL defines a client that expires within a quarter of the warning window.
+	CLIENT_EXPIRY_SEVERITY_CRITICAL ClientExpirySeverity = 2
+	// EXPIRED defines a client whose trusting period elapsed, i.e., it can no
+	// longer be updated without governance intervention.
+	CLIENT_EXPIRY_SEVERITY_EXPIRED ClientExpirySeverity = 3
+)
+
+var ClientExpirySeverity_name = map[int32]string{
+	0: "CLIENT_EXPIRY_SEVERITY_NONE",
+	1: "CLIENT_EXPIRY_SEVERITY_WARNING",
+	2: "CLIENT_EXPIRY_SEVERITY_CRITICAL",
+	3: "CLIENT_EXPIRY_SEVERITY_EXPIRED",
+}
+
+var ClientExpirySeverity_value = map[string]int32{
+	"CLIENT_EXPIRY_SEVERITY_NONE":     0,
+	"CLIENT_EXPIRY_SEVERITY_WARNING":  1,
+	"CLIENT_EXPIRY_SEVERITY_CRITICAL": 2,
+	"CLIENT_EXPIRY_SEVERITY_EXPIRED":  3,
+}
+
+func (x ClientExpirySeverity) String() string {
+	return proto.EnumName(ClientExpirySeverity_name, int32(x))
+}
+
+func (ClientExpirySeverity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d0a8be0efc64dfbc, []int{0}
+}
+
 // ConsumerParams defines the parameters for CCV consumer module.
 //
 // Note this type is referenced in both the consumer and provider CCV modules,
@@ -78,6 +117,19 @@ type ConsumerParams struct {
 	// The consumer ID of this consumer chain. Used by the consumer module to send
 	// ICS rewards.
 	ConsumerId string `protobuf:"bytes,14,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The duration before the expiry of the provider client (i.e., the latest
+	// consensus state of the client plus its trusting period) from which
+	// client_expiry_warning events are emitted. Zero disables the warning.
+	ClientExpiryWarningWindow time.Duration `protobuf:"bytes,15,opt,name=client_expiry_warning_window,json=clientExpiryWarningWindow,proto3,stdduration" json:"client_expiry_warning_window"`
+	// Per-denom overrides of consumer_redistribution_fraction. The fees of a
+	// denom with an override are split between the consumer redistribution
+	// address and the provider according to the override fraction instead.
+	ConsumerRedistributionFractionOverrides []RedistributionFractionOverride `protobuf:"bytes,16,rep,name=consumer_redistribution_fraction_overrides,json=consumerRedistributionFractionOverrides,proto3" json:"consumer_redistribution_fraction_overrides"`
+	// If true, the periodic transmission of rewards to the provider chain is
+	// paused, e.g., while the transfer channel or the provider client is being
+	// repaired. The rewards are still split and the provider's share accumulates
+	// on the consumer chain until the transmission is resumed.
+	RewardTransmissionPaused bool `protobuf:"varint,17,opt,name=reward_transmission_paused,json=rewardTransmissionPaused,proto3" json:"reward_transmission_paused,omitempty"`
 }
 
 func (m *ConsumerParams) Reset()         { *m = ConsumerParams{} }
@@ -212,6 +264,83 @@ func (m *ConsumerParams) GetConsumerId() string {
 	return ""
 }
 
+func (m *ConsumerParams) GetClientExpiryWarningWindow() time.Duration {
+	if m != nil {
+		return m.ClientExpiryWarningWindow
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetConsumerRedistributionFractionOverrides() []RedistributionFractionOverride {
+	if m != nil {
+		return m.ConsumerRedistributionFractionOverrides
+	}
+	return nil
+}
+
+func (m *ConsumerParams) GetRewardTransmissionPaused() bool {
+	if m != nil {
+		return m.RewardTransmissionPaused
+	}
+	return false
+}
+
+// RedistributionFractionOverride defines the fraction of the fees of a denom
+// that is allocated to the consumer redistribution address.
+type RedistributionFractionOverride struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The fraction is a string representing a decimal number,
+	// e.g., "0.75" would represent 75%.
+	Fraction string `protobuf:"bytes,2,opt,name=fraction,proto3" json:"fraction,omitempty"`
+}
+
+func (m *RedistributionFractionOverride) Reset()         { *m = RedistributionFractionOverride{} }
+func (m *RedistributionFractionOverride) String() string { return proto.CompactTextString(m) }
+func (*RedistributionFractionOverride) ProtoMessage()    {}
+func (*RedistributionFractionOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a8be0efc64dfbc, []int{1}
+}
+func (m *RedistributionFractionOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedistributionFractionOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedistributionFractionOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedistributionFractionOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedistributionFractionOverride.Merge(m, src)
+}
+func (m *RedistributionFractionOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedistributionFractionOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedistributionFractionOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedistributionFractionOverride proto.InternalMessageInfo
+
+func (m *RedistributionFractionOverride) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RedistributionFractionOverride) GetFraction() string {
+	if m != nil {
+		return m.Fraction
+	}
+	return ""
+}
+
 // ConsumerGenesisState defines shared genesis information between provider and
 // consumer
 type ConsumerGenesisState struct {
@@ -228,13 +357,22 @@ type ConsumerGenesisState struct {
 	// the provider chain and a new connection on top of this client are created.
 	// The new client is initialized using client_state and consensus_state.
 	ConnectionId string `protobuf:"bytes,5,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The genesis hash committed to in the initialization parameters of the
+	// consumer chain.
+	GenesisHash []byte `protobuf:"bytes,6,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// The initial height committed to in the initialization parameters of the
+	// consumer chain.
+	InitialHeight types.Height `protobuf:"bytes,7,opt,name=initial_height,json=initialHeight,proto3" json:"initial_height"`
+	// If true, the consumer CCV module refuses to start unless genesis_hash
+	// matches the hash of the consumer genesis.
+	VerifyGenesisHash bool `protobuf:"varint,8,opt,name=verify_genesis_hash,json=verifyGenesisHash,proto3" json:"verify_genesis_hash,omitempty"`
 }
 
 func (m *ConsumerGenesisState) Reset()         { *m = ConsumerGenesisState{} }
 func (m *ConsumerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ConsumerGenesisState) ProtoMessage()    {}
 func (*ConsumerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a8be0efc64dfbc, []int{1}
+	return fileDescriptor_d0a8be0efc64dfbc, []int{2}
 }
 func (m *ConsumerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,6 +436,27 @@ func (m *ConsumerGenesisState) GetConnectionId() string {
 	return ""
 }
 
+func (m *ConsumerGenesisState) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
+	}
+	return nil
+}
+
+func (m *ConsumerGenesisState) GetInitialHeight() types.Height {
+	if m != nil {
+		return m.InitialHeight
+	}
+	return types.Height{}
+}
+
+func (m *ConsumerGenesisState) GetVerifyGenesisHash() bool {
+	if m != nil {
+		return m.VerifyGenesisHash
+	}
+	return false
+}
+
 // ProviderInfo defines all information a consumer needs from a provider
 // Shared data type between provider and consumer
 type ProviderInfo struct {
@@ -308,14 +467,14 @@ type ProviderInfo struct {
 	// If connection_id != "", then consensus_state is ignored.
 	ConsensusState *_07_tendermint.ConsensusState `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
 	// InitialValset filled in on new chain and on restart.
-	InitialValSet []types.ValidatorUpdate `protobuf:"bytes,3,rep,name=initial_val_set,json=initialValSet,proto3" json:"initial_val_set"`
+	InitialValSet []types1.ValidatorUpdate `protobuf:"bytes,3,rep,name=initial_val_set,json=initialValSet,proto3" json:"initial_val_set"`
 }
 
 func (m *ProviderInfo) Reset()         { *m = ProviderInfo{} }
 func (m *ProviderInfo) String() string { return proto.CompactTextString(m) }
 func (*ProviderInfo) ProtoMessage()    {}
 func (*ProviderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a8be0efc64dfbc, []int{2}
+	return fileDescriptor_d0a8be0efc64dfbc, []int{3}
 }
 func (m *ProviderInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,17 +517,108 @@ func (m *ProviderInfo) GetConsensusState() *_07_tendermint.ConsensusState {
 	return nil
 }
 
-func (m *ProviderInfo) GetInitialValSet() []types.ValidatorUpdate {
+func (m *ProviderInfo) GetInitialValSet() []types1.ValidatorUpdate {
 	if m != nil {
 		return m.InitialValSet
 	}
 	return nil
 }
 
+// ClientExpiry describes when the client of the counterparty chain expires
+type ClientExpiry struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the timestamp of the latest consensus state of the client
+	LatestConsensusTime time.Time     `protobuf:"bytes,2,opt,name=latest_consensus_time,json=latestConsensusTime,proto3,stdtime" json:"latest_consensus_time"`
+	TrustingPeriod      time.Duration `protobuf:"bytes,3,opt,name=trusting_period,json=trustingPeriod,proto3,stdduration" json:"trusting_period"`
+	// the time at which the client expires unless it is updated
+	ExpiryTime time.Time `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+	// the remaining time until expiry, negative if the client expired
+	Remaining time.Duration        `protobuf:"bytes,5,opt,name=remaining,proto3,stdduration" json:"remaining"`
+	Severity  ClientExpirySeverity `protobuf:"varint,6,opt,name=severity,proto3,enum=interchain_security.ccv.v1.ClientExpirySeverity" json:"severity,omitempty"`
+}
+
+func (m *ClientExpiry) Reset()         { *m = ClientExpiry{} }
+func (m *ClientExpiry) String() string { return proto.CompactTextString(m) }
+func (*ClientExpiry) ProtoMessage()    {}
+func (*ClientExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a8be0efc64dfbc, []int{4}
+}
+func (m *ClientExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientExpiry.Merge(m, src)
+}
+func (m *ClientExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientExpiry proto.InternalMessageInfo
+
+func (m *ClientExpiry) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientExpiry) GetLatestConsensusTime() time.Time {
+	if m != nil {
+		return m.LatestConsensusTime
+	}
+	return time.Time{}
+}
+
+func (m *ClientExpiry) GetTrustingPeriod() time.Duration {
+	if m != nil {
+		return m.TrustingPeriod
+	}
+	return 0
+}
+
+func (m *ClientExpiry) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return time.Time{}
+}
+
+func (m *ClientExpiry) GetRemaining() time.Duration {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *ClientExpiry) GetSeverity() ClientExpirySeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return CLIENT_EXPIRY_SEVERITY_NONE
+}
+
 func init() {
+	proto.RegisterEnum("interchain_security.ccv.v1.ClientExpirySeverity", ClientExpirySeverity_name, ClientExpirySeverity_value)
 	proto.RegisterType((*ConsumerParams)(nil), "interchain_security.ccv.v1.ConsumerParams")
+	proto.RegisterType((*RedistributionFractionOverride)(nil), "interchain_security.ccv.v1.RedistributionFractionOverride")
 	proto.RegisterType((*ConsumerGenesisState)(nil), "interchain_security.ccv.v1.ConsumerGenesisState")
 	proto.RegisterType((*ProviderInfo)(nil), "interchain_security.ccv.v1.ProviderInfo")
+	proto.RegisterType((*ClientExpiry)(nil), "interchain_security.ccv.v1.ClientExpiry")
 }
 
 func init() {
@@ -376,61 +626,88 @@ func init() {
 }
 
 var fileDescriptor_d0a8be0efc64dfbc = []byte{
-	// 864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0xe4, 0x34,
-	0x14, 0x6e, 0x3a, 0xbb, 0xed, 0xd4, 0x33, 0x6d, 0x17, 0x53, 0x4a, 0xe8, 0x4a, 0xd3, 0xd9, 0xc2,
-	0x61, 0x04, 0xda, 0x84, 0x96, 0x95, 0x56, 0xe2, 0x46, 0xa7, 0x2c, 0xdb, 0x3d, 0xb4, 0xb3, 0x69,
-	0x29, 0x12, 0x1c, 0x2c, 0xc7, 0x7e, 0x33, 0x63, 0x91, 0xb1, 0x23, 0xdb, 0x49, 0xe9, 0x1d, 0xc1,
-	0x95, 0x23, 0x3f, 0x69, 0x8f, 0x7b, 0xe4, 0x04, 0xa8, 0xfd, 0x23, 0x28, 0x4e, 0x32, 0xcd, 0x20,
-	0x0a, 0xe5, 0x96, 0xf7, 0xfc, 0x7d, 0x5f, 0xfc, 0xbe, 0xe7, 0x67, 0xa3, 0x4f, 0x85, 0xb4, 0xa0,
-	0xd9, 0x94, 0x0a, 0x49, 0x0c, 0xb0, 0x4c, 0x0b, 0x7b, 0x15, 0x32, 0x96, 0x87, 0xf9, 0x7e, 0x68,
-	0xa6, 0x54, 0x03, 0x27, 0x4c, 0x49, 0x93, 0xcd, 0x40, 0x07, 0xa9, 0x56, 0x56, 0xe1, 0x9d, 0x7f,
-	0x60, 0x04, 0x8c, 0xe5, 0x41, 0xbe, 0xbf, 0xf3, 0xd8, 0x82, 0xe4, 0xa0, 0x67, 0x42, 0xda, 0x90,
-	0xc6, 0x4c, 0x84, 0xf6, 0x2a, 0x05, 0x53, 0x12, 0x77, 0x42, 0x11, 0xb3, 0x30, 0x11, 0x93, 0xa9,
-	0x65, 0x89, 0x00, 0x69, 0x4d, 0xd8, 0x40, 0xe7, 0xfb, 0x8d, 0xa8, 0x22, 0xf4, 0x26, 0x4a, 0x4d,
-	0x12, 0x08, 0x5d, 0x14, 0x67, 0xe3, 0x90, 0x67, 0x9a, 0x5a, 0xa1, 0x64, 0xb5, 0xbe, 0x35, 0x51,
-	0x13, 0xe5, 0x3e, 0xc3, 0xe2, 0xab, 0xcc, 0xee, 0xfd, 0xb8, 0x8a, 0x36, 0x86, 0xd5, 0x96, 0x47,
-	0x54, 0xd3, 0x99, 0xc1, 0x3e, 0x5a, 0x05, 0x49, 0xe3, 0x04, 0xb8, 0xef, 0xf5, 0xbd, 0x41, 0x3b,
-	0xaa, 0x43, 0x7c, 0x8a, 0x3e, 0x8a, 0x13, 0xc5, 0xbe, 0x37, 0x24, 0x05, 0x4d, 0xb8, 0x30, 0x56,
-	0x8b, 0x38, 0x2b, 0xfe, 0x41, 0xac, 0xa6, 0xd2, 0xcc, 0x84, 0x31, 0x42, 0x49, 0x7f, 0xb9, 0xef,
-	0x0d, 0x5a, 0xd1, 0x93, 0x12, 0x3b, 0x02, 0x7d, 0xd4, 0x40, 0x9e, 0x37, 0x80, 0xf8, 0x15, 0x7a,
-	0x72, 0xa7, 0x0a, 0x61, 0x53, 0x2a, 0x25, 0x24, 0x7e, 0xab, 0xef, 0x0d, 0xd6, 0xa2, 0x5d, 0x7e,
-	0x87, 0xc8, 0xb0, 0x84, 0xe1, 0xcf, 0xd1, 0x4e, 0xaa, 0x55, 0x2e, 0x38, 0x68, 0x32, 0x06, 0x20,
-	0xa9, 0x52, 0x09, 0xa1, 0x9c, 0x6b, 0x62, 0xac, 0xf6, 0x1f, 0x38, 0x91, 0xed, 0x1a, 0xf1, 0x02,
-	0x60, 0xa4, 0x54, 0xf2, 0x05, 0xe7, 0xfa, 0xcc, 0x6a, 0xfc, 0x1a, 0x61, 0xc6, 0x72, 0x62, 0xc5,
-	0x0c, 0x54, 0x66, 0x8b, 0xea, 0x84, 0xe2, 0xfe, 0xc3, 0xbe, 0x37, 0xe8, 0x1c, 0x7c, 0x10, 0x94,
-	0xc6, 0x06, 0xb5, 0xb1, 0xc1, 0x51, 0x65, 0xec, 0x61, 0xfb, 0xcd, 0xef, 0xbb, 0x4b, 0xbf, 0xfe,
-	0xb1, 0xeb, 0x45, 0x8f, 0x18, 0xcb, 0xcf, 0x4b, 0xf6, 0xc8, 0x91, 0xf1, 0x77, 0xe8, 0x7d, 0x57,
-	0xcd, 0x18, 0xf4, 0xdf, 0x75, 0x57, 0xee, 0xaf, 0xfb, 0x5e, 0xad, 0xb1, 0x28, 0xfe, 0x12, 0xf5,
-	0xeb, 0x73, 0x46, 0x34, 0x2c, 0x58, 0x38, 0xd6, 0x94, 0x15, 0x1f, 0xfe, 0xaa, 0xab, 0xb8, 0x57,
-	0xe3, 0xa2, 0x05, 0xd8, 0x8b, 0x0a, 0x85, 0x9f, 0x22, 0x3c, 0x15, 0xc6, 0x2a, 0x2d, 0x18, 0x4d,
-	0x08, 0x48, 0xab, 0x05, 0x18, 0xbf, 0xed, 0x1a, 0xf8, 0xce, 0xed, 0xca, 0x97, 0xe5, 0x02, 0x3e,
-	0x41, 0x8f, 0x32, 0x19, 0x2b, 0xc9, 0x85, 0x9c, 0xd4, 0xe5, 0xac, 0xdd, 0xbf, 0x9c, 0xcd, 0x39,
-	0xb9, 0x2a, 0xe4, 0x39, 0xda, 0x36, 0x6a, 0x6c, 0x89, 0x4a, 0x2d, 0x29, 0x1c, 0xb2, 0x53, 0x0d,
-	0x66, 0xaa, 0x12, 0xee, 0xa3, 0x62, 0xfb, 0x87, 0xcb, 0xbe, 0x17, 0xbd, 0x5b, 0x20, 0x4e, 0x53,
-	0x7b, 0x9a, 0xd9, 0xf3, 0x7a, 0x19, 0x7f, 0x88, 0xd6, 0x35, 0x5c, 0x52, 0xcd, 0x09, 0x07, 0xa9,
-	0x66, 0xc6, 0xef, 0xf4, 0x5b, 0x83, 0xb5, 0xa8, 0x5b, 0x26, 0x8f, 0x5c, 0x0e, 0x3f, 0x43, 0xf3,
-	0x86, 0x93, 0x45, 0x74, 0xd7, 0xa1, 0xb7, 0xea, 0xd5, 0xa8, 0xc9, 0x7a, 0x8d, 0xb0, 0x06, 0xab,
-	0xaf, 0x08, 0x87, 0x84, 0x5e, 0xd5, 0x55, 0xae, 0xff, 0x8f, 0xc3, 0xe0, 0xe8, 0x47, 0x05, 0xbb,
-	0x2a, 0x73, 0x17, 0x75, 0xe6, 0xfd, 0x12, 0xdc, 0xdf, 0x70, 0xad, 0x41, 0x75, 0xea, 0x98, 0xef,
-	0xfd, 0xb4, 0x8c, 0xb6, 0xea, 0x31, 0xfc, 0x0a, 0x24, 0x18, 0x61, 0xce, 0x2c, 0xb5, 0x80, 0x5f,
-	0xa2, 0x95, 0xd4, 0x8d, 0xa5, 0x9b, 0xc5, 0xce, 0xc1, 0xc7, 0xc1, 0xdd, 0x17, 0x4a, 0xb0, 0x38,
-	0xc8, 0x87, 0x0f, 0x8a, 0x1d, 0x45, 0x15, 0x1f, 0xbf, 0x42, 0xed, 0xba, 0x5c, 0x37, 0xa0, 0x9d,
-	0x83, 0xc1, 0xbf, 0x69, 0x8d, 0x2a, 0xec, 0xb1, 0x1c, 0xab, 0x4a, 0x69, 0xce, 0xc7, 0x8f, 0xd1,
-	0x9a, 0x84, 0x4b, 0xe2, 0x98, 0x6e, 0x3e, 0xdb, 0x51, 0x5b, 0xc2, 0xe5, 0xb0, 0x88, 0xf1, 0x36,
-	0x5a, 0x49, 0x35, 0x0c, 0x87, 0x17, 0x6e, 0xe8, 0xda, 0x51, 0x15, 0x15, 0x2d, 0x63, 0x4a, 0x4a,
-	0x70, 0x07, 0xaf, 0xb0, 0xe1, 0xa1, 0xb3, 0xa1, 0x7b, 0x9b, 0x3c, 0xe6, 0x7b, 0x3f, 0x2f, 0xa3,
-	0x6e, 0xf3, 0xd7, 0xf8, 0x04, 0x75, 0xcb, 0x0b, 0x90, 0x98, 0xc2, 0x90, 0xca, 0x86, 0x4f, 0x02,
-	0x11, 0xb3, 0xa0, 0x79, 0x3d, 0x06, 0x8d, 0x0b, 0xb1, 0xb0, 0xc2, 0x65, 0x9d, 0x87, 0x51, 0x87,
-	0xdd, 0x06, 0xf8, 0x1b, 0xb4, 0x59, 0xf8, 0x0e, 0xd2, 0x64, 0xa6, 0x92, 0x2c, 0xdd, 0x08, 0xfe,
-	0x53, 0xb2, 0xa6, 0x95, 0xaa, 0x1b, 0x6c, 0x21, 0xc6, 0x27, 0x68, 0x53, 0x48, 0x61, 0x05, 0x4d,
-	0x48, 0x4e, 0x13, 0x62, 0xc0, 0xfa, 0xad, 0x7e, 0x6b, 0xd0, 0x39, 0xe8, 0x37, 0x75, 0x8a, 0x7b,
-	0x3e, 0xb8, 0xa0, 0x89, 0xe0, 0xd4, 0x2a, 0xfd, 0x75, 0xca, 0xa9, 0x85, 0xca, 0xde, 0xf5, 0x8a,
-	0x7e, 0x41, 0x93, 0x33, 0xb0, 0x87, 0x27, 0x6f, 0xae, 0x7b, 0xde, 0xdb, 0xeb, 0x9e, 0xf7, 0xe7,
-	0x75, 0xcf, 0xfb, 0xe5, 0xa6, 0xb7, 0xf4, 0xf6, 0xa6, 0xb7, 0xf4, 0xdb, 0x4d, 0x6f, 0xe9, 0xdb,
-	0x67, 0x13, 0x61, 0xa7, 0x59, 0x1c, 0x30, 0x35, 0x0b, 0x99, 0x32, 0x33, 0x65, 0xc2, 0xdb, 0x46,
-	0x3e, 0x9d, 0xbf, 0x4b, 0xf9, 0xf3, 0xf0, 0x07, 0xf7, 0x38, 0xb9, 0x67, 0x25, 0x5e, 0x71, 0x47,
-	0xf6, 0xb3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x42, 0x46, 0x65, 0xc4, 0x06, 0x00, 0x00,
+	// 1290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x73, 0x13, 0xb7,
+	0x1f, 0x8e, 0xe3, 0x10, 0x6c, 0xd9, 0x24, 0x46, 0x04, 0xfe, 0x8b, 0xf9, 0x8f, 0x6d, 0x42, 0x67,
+	0xea, 0xa1, 0xc3, 0x2e, 0x49, 0x99, 0x61, 0x86, 0xe9, 0x25, 0x71, 0x4c, 0x62, 0x26, 0xe3, 0x98,
+	0x8d, 0x9b, 0x40, 0x7b, 0xd0, 0xc8, 0x2b, 0xd9, 0xd6, 0x74, 0xbd, 0xf2, 0x48, 0xb2, 0x83, 0x3f,
+	0x41, 0x7b, 0xe4, 0xd8, 0x4b, 0x2f, 0xed, 0xad, 0xfd, 0x22, 0x1c, 0x39, 0xf6, 0xd4, 0x76, 0xe0,
+	0x8b, 0x74, 0xa4, 0xd5, 0xfa, 0x85, 0x92, 0x10, 0x6e, 0x2b, 0xe9, 0x79, 0x9e, 0xd5, 0xef, 0x45,
+	0x8f, 0x04, 0x1e, 0xb2, 0x48, 0x51, 0x11, 0xf4, 0x31, 0x8b, 0x90, 0xa4, 0xc1, 0x48, 0x30, 0x35,
+	0xf1, 0x82, 0x60, 0xec, 0x8d, 0xb7, 0x3c, 0xd9, 0xc7, 0x82, 0x12, 0x14, 0xf0, 0x48, 0x8e, 0x06,
+	0x54, 0xb8, 0x43, 0xc1, 0x15, 0x87, 0xc5, 0x8f, 0x30, 0xdc, 0x20, 0x18, 0xbb, 0xe3, 0xad, 0xe2,
+	0x1d, 0x45, 0x23, 0x42, 0xc5, 0x80, 0x45, 0xca, 0xc3, 0x9d, 0x80, 0x79, 0x6a, 0x32, 0xa4, 0x32,
+	0x26, 0x16, 0x3d, 0xd6, 0x09, 0xbc, 0x90, 0xf5, 0xfa, 0x2a, 0x08, 0x19, 0x8d, 0x94, 0xf4, 0xe6,
+	0xd0, 0xe3, 0xad, 0xb9, 0x91, 0x25, 0x94, 0x35, 0x21, 0xe0, 0x82, 0x7a, 0x31, 0x41, 0x83, 0xe2,
+	0x2f, 0x0b, 0x28, 0xf5, 0x38, 0xef, 0x85, 0xd4, 0x33, 0xa3, 0xce, 0xa8, 0xeb, 0x91, 0x91, 0xc0,
+	0x8a, 0xf1, 0x28, 0x11, 0xf8, 0x70, 0x5d, 0xb1, 0x01, 0x95, 0x0a, 0x0f, 0x86, 0x16, 0xb0, 0xd1,
+	0xe3, 0x3d, 0x6e, 0x3e, 0x3d, 0xfd, 0x15, 0xcf, 0x6e, 0xbe, 0xc9, 0x82, 0xb5, 0x9a, 0x0d, 0xba,
+	0x85, 0x05, 0x1e, 0x48, 0xe8, 0x80, 0xab, 0x34, 0xc2, 0x9d, 0x90, 0x12, 0x27, 0x55, 0x49, 0x55,
+	0x33, 0x7e, 0x32, 0x84, 0x47, 0xe0, 0x8b, 0x4e, 0xc8, 0x83, 0x1f, 0x24, 0x1a, 0x52, 0x81, 0x08,
+	0x93, 0x4a, 0xb0, 0xce, 0x48, 0x6f, 0x02, 0x29, 0x81, 0x23, 0x39, 0x60, 0x52, 0x32, 0x1e, 0x39,
+	0xcb, 0x95, 0x54, 0x35, 0xed, 0xdf, 0x8d, 0xb1, 0x2d, 0x2a, 0xf6, 0xe6, 0x90, 0xed, 0x39, 0x20,
+	0x7c, 0x06, 0xee, 0x9e, 0xab, 0x82, 0x82, 0x3e, 0x8e, 0x22, 0x1a, 0x3a, 0xe9, 0x4a, 0xaa, 0x9a,
+	0xf5, 0xcb, 0xe4, 0x1c, 0x91, 0x5a, 0x0c, 0x83, 0x4f, 0x40, 0x71, 0x28, 0xf8, 0x98, 0x11, 0x2a,
+	0x50, 0x97, 0x52, 0x34, 0xe4, 0x3c, 0x44, 0x98, 0x10, 0x81, 0xa4, 0x12, 0xce, 0x8a, 0x11, 0xb9,
+	0x95, 0x20, 0x9e, 0x52, 0xda, 0xe2, 0x3c, 0xdc, 0x21, 0x44, 0x1c, 0x2b, 0x01, 0x9f, 0x03, 0x18,
+	0x04, 0x63, 0xa4, 0x53, 0xc6, 0x47, 0x4a, 0x47, 0xc7, 0x38, 0x71, 0xae, 0x54, 0x52, 0xd5, 0xdc,
+	0xf6, 0x6d, 0x37, 0xce, 0xac, 0x9b, 0x64, 0xd6, 0xdd, 0xb3, 0x99, 0xdf, 0xcd, 0xbc, 0xf9, 0xab,
+	0xbc, 0xf4, 0xf3, 0xdf, 0xe5, 0x94, 0x5f, 0x08, 0x82, 0x71, 0x3b, 0x66, 0xb7, 0x0c, 0x19, 0x7e,
+	0x0f, 0xfe, 0x67, 0xa2, 0xe9, 0x52, 0xf1, 0xa1, 0xee, 0xea, 0xe5, 0x75, 0x6f, 0x26, 0x1a, 0x8b,
+	0xe2, 0x07, 0xa0, 0x92, 0x74, 0x2a, 0x12, 0x74, 0x21, 0x85, 0x5d, 0x81, 0x03, 0xfd, 0xe1, 0x5c,
+	0x35, 0x11, 0x97, 0x12, 0x9c, 0xbf, 0x00, 0x7b, 0x6a, 0x51, 0xf0, 0x01, 0x80, 0x7d, 0x26, 0x15,
+	0x17, 0x2c, 0xc0, 0x21, 0xa2, 0x91, 0x12, 0x8c, 0x4a, 0x27, 0x63, 0x0a, 0x78, 0x7d, 0xb6, 0x52,
+	0x8f, 0x17, 0x60, 0x13, 0x14, 0x46, 0x51, 0x87, 0x47, 0x84, 0x45, 0xbd, 0x24, 0x9c, 0xec, 0xe5,
+	0xc3, 0x59, 0x9f, 0x92, 0x6d, 0x20, 0x8f, 0xc1, 0x2d, 0xc9, 0xbb, 0x0a, 0xf1, 0xa1, 0x42, 0x3a,
+	0x43, 0xaa, 0x2f, 0xa8, 0xec, 0xf3, 0x90, 0x38, 0x40, 0x6f, 0x7f, 0x77, 0xd9, 0x49, 0xf9, 0x37,
+	0x34, 0xe2, 0x68, 0xa8, 0x8e, 0x46, 0xaa, 0x9d, 0x2c, 0xc3, 0x7b, 0xe0, 0x9a, 0xa0, 0x67, 0x58,
+	0x10, 0x44, 0x68, 0xc4, 0x07, 0xd2, 0xc9, 0x55, 0xd2, 0xd5, 0xac, 0x9f, 0x8f, 0x27, 0xf7, 0xcc,
+	0x1c, 0x7c, 0x04, 0xa6, 0x05, 0x47, 0x8b, 0xe8, 0xbc, 0x41, 0x6f, 0x24, 0xab, 0xfe, 0x3c, 0xeb,
+	0x39, 0x80, 0x82, 0x2a, 0x31, 0x41, 0x84, 0x86, 0x78, 0x92, 0x44, 0x79, 0xed, 0x33, 0x9a, 0xc1,
+	0xd0, 0xf7, 0x34, 0xdb, 0x86, 0x59, 0x06, 0xb9, 0x69, 0xbd, 0x18, 0x71, 0xd6, 0x4c, 0x69, 0x40,
+	0x32, 0xd5, 0x20, 0x90, 0x80, 0xff, 0xc7, 0xa7, 0x1d, 0xd1, 0x57, 0x43, 0x26, 0x26, 0xe8, 0x0c,
+	0x8b, 0x48, 0xe7, 0xf8, 0x8c, 0x45, 0x84, 0x9f, 0x39, 0xeb, 0x97, 0xff, 0xfb, 0xed, 0x58, 0xa8,
+	0x6e, 0x74, 0x4e, 0x63, 0x99, 0x53, 0xa3, 0x02, 0x7f, 0x49, 0x81, 0xfb, 0x9f, 0xea, 0x1b, 0xc4,
+	0xc7, 0x54, 0x08, 0x46, 0xa8, 0x74, 0x0a, 0x95, 0x74, 0x35, 0xb7, 0xfd, 0xc4, 0x3d, 0xdf, 0x04,
+	0xdd, 0x8f, 0x77, 0xd5, 0x91, 0x95, 0xd8, 0x5d, 0xd1, 0xbb, 0xf2, 0xbf, 0xbc, 0xb8, 0x07, 0x13,
+	0xb4, 0x84, 0xdf, 0x80, 0xa2, 0x2d, 0xd3, 0x82, 0x11, 0x0c, 0xf1, 0x48, 0x52, 0xe2, 0x5c, 0x37,
+	0x66, 0xe4, 0xc4, 0x88, 0x79, 0x07, 0x68, 0x99, 0xf5, 0x4d, 0x1f, 0x94, 0x2e, 0xfe, 0x01, 0xdc,
+	0x00, 0x57, 0x4c, 0xfd, 0x8d, 0xaf, 0x65, 0xfd, 0x78, 0x00, 0x8b, 0x20, 0x33, 0x3d, 0x34, 0xcb,
+	0x66, 0x61, 0x3a, 0xde, 0xfc, 0x35, 0x0d, 0x36, 0x12, 0x7b, 0xdc, 0xa7, 0x11, 0x95, 0x4c, 0x1e,
+	0x2b, 0xac, 0x28, 0x3c, 0x00, 0xab, 0x43, 0x63, 0x97, 0x46, 0x2b, 0xb7, 0x7d, 0xff, 0xa2, 0x2c,
+	0x2d, 0x1a, 0xac, 0xcd, 0x8a, 0xe5, 0xc3, 0x67, 0x20, 0x93, 0xb4, 0xa1, 0xf9, 0x7d, 0x6e, 0xbb,
+	0x7a, 0x91, 0x56, 0xcb, 0x62, 0x1b, 0x51, 0x97, 0x5b, 0xa5, 0x29, 0x1f, 0xde, 0x01, 0xd9, 0x88,
+	0x9e, 0x21, 0xc3, 0x34, 0xbe, 0x99, 0xf1, 0x33, 0x11, 0x3d, 0xab, 0xe9, 0x31, 0xbc, 0x05, 0x56,
+	0x87, 0x82, 0xd6, 0x6a, 0x27, 0xc6, 0x0c, 0x33, 0xbe, 0x1d, 0xe9, 0xa3, 0x14, 0xf0, 0x28, 0xa2,
+	0x71, 0xf9, 0x59, 0xec, 0x7b, 0x59, 0x3f, 0x3f, 0x9b, 0x6c, 0x10, 0x78, 0x17, 0xe4, 0x7b, 0x71,
+	0xfc, 0xa8, 0x8f, 0x65, 0xdf, 0x78, 0x58, 0xde, 0xcf, 0xd9, 0xb9, 0x03, 0x2c, 0xfb, 0x70, 0x1f,
+	0xac, 0xb1, 0x88, 0x29, 0x86, 0x43, 0xd4, 0xa7, 0xfa, 0xea, 0x33, 0x16, 0x94, 0xdb, 0x2e, 0xba,
+	0xac, 0x13, 0xb8, 0xfa, 0x6e, 0x73, 0xed, 0x8d, 0x36, 0xde, 0x72, 0x0f, 0x0c, 0xc2, 0x06, 0x70,
+	0xcd, 0xf2, 0xe2, 0x49, 0xe8, 0x82, 0x1b, 0x63, 0x2a, 0x58, 0x77, 0x82, 0x16, 0x7e, 0x99, 0x31,
+	0xbb, 0xbe, 0x1e, 0x2f, 0xed, 0xcf, 0x7e, 0xbc, 0xf9, 0xe3, 0x32, 0xc8, 0xcf, 0xa7, 0x05, 0x36,
+	0x41, 0xde, 0x9e, 0x26, 0xa9, 0x8b, 0x65, 0x4b, 0xf4, 0x95, 0xd9, 0xc7, 0xfc, 0xa5, 0xec, 0xce,
+	0x5d, 0xc3, 0xba, 0x4c, 0x66, 0xd6, 0xd4, 0xd7, 0xcf, 0x05, 0xb3, 0x01, 0x3c, 0x05, 0xeb, 0xba,
+	0x85, 0x69, 0x24, 0x47, 0xd2, 0x4a, 0xc6, 0x95, 0x72, 0x3f, 0x29, 0x99, 0xd0, 0x62, 0xd5, 0xb5,
+	0x60, 0x61, 0x0c, 0x9b, 0x60, 0x3d, 0x49, 0xd9, 0x18, 0x87, 0x48, 0x52, 0xe5, 0xa4, 0xcd, 0xa1,
+	0xab, 0xcc, 0xeb, 0xe8, 0xd7, 0x85, 0x7b, 0x82, 0x43, 0x46, 0xb0, 0xe2, 0xe2, 0xdb, 0x21, 0xc1,
+	0x8a, 0x7e, 0x90, 0xb9, 0x13, 0x1c, 0x1e, 0x53, 0xb5, 0xf9, 0x47, 0x1a, 0xe4, 0x6b, 0x73, 0xc7,
+	0x5f, 0x37, 0x84, 0xcd, 0x04, 0x23, 0xb6, 0xeb, 0x33, 0xf1, 0x44, 0x83, 0xc0, 0x17, 0xe0, 0x66,
+	0x88, 0x15, 0x95, 0x0a, 0xcd, 0xa2, 0xd3, 0x57, 0x95, 0x0d, 0xae, 0xf8, 0x1f, 0xb7, 0x69, 0x27,
+	0x4f, 0x8a, 0xd8, 0x6e, 0x5e, 0x6b, 0xbb, 0xb9, 0x11, 0x4b, 0x4c, 0x03, 0xd5, 0x18, 0x78, 0x08,
+	0xd6, 0x95, 0x18, 0x49, 0x35, 0x77, 0x4b, 0xa4, 0x2f, 0xef, 0x60, 0x6b, 0x09, 0xd7, 0xba, 0x67,
+	0x1d, 0xe4, 0xac, 0x2b, 0x9a, 0xdd, 0xad, 0x7c, 0xc6, 0xee, 0x40, 0x4c, 0x34, 0x9b, 0xda, 0x01,
+	0x59, 0x41, 0x07, 0x98, 0x69, 0x43, 0xfc, 0x9c, 0xbb, 0x7d, 0xc6, 0x82, 0x87, 0x20, 0x23, 0xa9,
+	0x6e, 0x40, 0x35, 0x31, 0x27, 0x60, 0x6d, 0xfb, 0xe1, 0x85, 0xe7, 0x7e, 0xae, 0x14, 0xc7, 0x96,
+	0xe7, 0x4f, 0x15, 0xee, 0xff, 0x9e, 0x02, 0x1b, 0x1f, 0x83, 0xc0, 0x32, 0xb8, 0x53, 0x3b, 0x6c,
+	0xd4, 0x9b, 0x6d, 0x54, 0x7f, 0xd1, 0x6a, 0xf8, 0x2f, 0xd1, 0x71, 0xfd, 0xa4, 0xee, 0x37, 0xda,
+	0x2f, 0x51, 0xf3, 0xa8, 0x59, 0x2f, 0x2c, 0xc1, 0x4d, 0x50, 0x3a, 0x07, 0x70, 0xba, 0xe3, 0x37,
+	0x1b, 0xcd, 0xfd, 0x42, 0x0a, 0xde, 0x03, 0xe5, 0x73, 0x30, 0x35, 0xbf, 0xd1, 0x6e, 0xd4, 0x76,
+	0x0e, 0x0b, 0xcb, 0x17, 0x08, 0x99, 0x71, 0x7d, 0xaf, 0x90, 0x2e, 0xae, 0xfc, 0xf4, 0x5b, 0x69,
+	0x69, 0xb7, 0xf9, 0xe6, 0x5d, 0x29, 0xf5, 0xf6, 0x5d, 0x29, 0xf5, 0xcf, 0xbb, 0x52, 0xea, 0xf5,
+	0xfb, 0xd2, 0xd2, 0xdb, 0xf7, 0xa5, 0xa5, 0x3f, 0xdf, 0x97, 0x96, 0xbe, 0x7b, 0xd4, 0x63, 0xaa,
+	0x3f, 0xea, 0xb8, 0x01, 0x1f, 0x78, 0x01, 0x97, 0x03, 0x2e, 0xbd, 0x59, 0x4e, 0x1e, 0x4c, 0x1f,
+	0xda, 0xe3, 0xc7, 0xde, 0x2b, 0xf3, 0xda, 0x36, 0xef, 0xe4, 0xce, 0xaa, 0x49, 0xf9, 0xd7, 0xff,
+	0x0e, 0x00, 0x0b, 0xa2, 0x6f, 0xec, 0x95, 0x0b, 0x00, 0x00,
 }
 
 func (m *ConsumerParams) Marshal() (dAtA []byte, err error) {
@@ -453,6 +730,42 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RewardTransmissionPaused {
+		i--
+		if m.RewardTransmissionPaused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.ConsumerRedistributionFractionOverrides) > 0 {
+		for iNdEx := len(m.ConsumerRedistributionFractionOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumerRedistributionFractionOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientExpiryWarningWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningWindow):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x7a
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
@@ -460,12 +773,12 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x72
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x6a
 	if len(m.ProviderRewardDenoms) > 0 {
@@ -493,12 +806,12 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x4a
 	if m.HistoricalEntries != 0 {
@@ -513,21 +826,21 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x32
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintSharedConsumer(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x32
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x2a
 	if len(m.ProviderFeePoolAddrStr) > 0 {
 		i -= len(m.ProviderFeePoolAddrStr)
@@ -561,6 +874,43 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RedistributionFractionOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedistributionFractionOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedistributionFractionOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fraction) > 0 {
+		i -= len(m.Fraction)
+		copy(dAtA[i:], m.Fraction)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Fraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsumerGenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -581,6 +931,33 @@ func (m *ConsumerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VerifyGenesisHash {
+		i--
+		if m.VerifyGenesisHash {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -692,6 +1069,73 @@ func (m *ProviderInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x30
+	}
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Remaining, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Remaining):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x2a
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LatestConsensusTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestConsensusTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSharedConsumer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSharedConsumer(v)
 	base := offset
@@ -758,8 +1202,36 @@ func (m *ConsumerParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
-	return n
-}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningWindow)
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	if len(m.ConsumerRedistributionFractionOverrides) > 0 {
+		for _, e := range m.ConsumerRedistributionFractionOverrides {
+			l = e.Size()
+			n += 2 + l + sovSharedConsumer(uint64(l))
+		}
+	}
+	if m.RewardTransmissionPaused {
+		n += 3
+	}
+	return n
+}
+
+func (m *RedistributionFractionOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	l = len(m.Fraction)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	return n
+}
 
 func (m *ConsumerGenesisState) Size() (n int) {
 	if m == nil {
@@ -781,6 +1253,15 @@ func (m *ConsumerGenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	if m.VerifyGenesisHash {
+		n += 2
+	}
 	return n
 }
 
@@ -807,6 +1288,30 @@ func (m *ProviderInfo) Size() (n int) {
 	return n
 }
 
+func (m *ClientExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LatestConsensusTime)
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod)
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Remaining)
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	if m.Severity != 0 {
+		n += 1 + sovSharedConsumer(uint64(m.Severity))
+	}
+	return n
+}
+
 func sovSharedConsumer(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1256,59 +1761,9 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSharedConsumer
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSharedConsumer
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerGenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiryWarningWindow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1335,13 +1790,13 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientExpiryWarningWindow, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFractionOverrides", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1368,13 +1823,14 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConsumerRedistributionFractionOverrides = append(m.ConsumerRedistributionFractionOverrides, RedistributionFractionOverride{})
+			if err := m.ConsumerRedistributionFractionOverrides[len(m.ConsumerRedistributionFractionOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 17:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardTransmissionPaused", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1391,12 +1847,62 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.NewChain = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreCCV", wireType)
+			m.RewardTransmissionPaused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedistributionFractionOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharedConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedistributionFractionOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedistributionFractionOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1406,15 +1912,27 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PreCCV = bool(v != 0)
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1442,7 +1960,7 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Fraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1465,7 +1983,7 @@ func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+func (m *ConsumerGenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1488,15 +2006,15 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerGenesisState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerGenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1523,16 +2041,13 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientState == nil {
-				m.ClientState = &_07_tendermint.ClientState{}
-			}
-			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1559,18 +2074,55 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &_07_tendermint.ConsensusState{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NewChain = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreCCV", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreCCV = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialValSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSharedConsumer
@@ -1580,26 +2132,500 @@ func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthSharedConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InitialValSet = append(m.InitialValSet, types.ValidatorUpdate{})
-			if err := m.InitialValSet[len(m.InitialValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyGenesisHash", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VerifyGenesisHash = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharedConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientState == nil {
+				m.ClientState = &_07_tendermint.ClientState{}
+			}
+			if err := m.ClientState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &_07_tendermint.ConsensusState{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialValSet = append(m.InitialValSet, types1.ValidatorUpdate{})
+			if err := m.InitialValSet[len(m.InitialValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharedConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConsensusTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LatestConsensusTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TrustingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Remaining, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= ClientExpirySeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
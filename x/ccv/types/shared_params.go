@@ -33,6 +33,17 @@ func ValidateDuration(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeDuration(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < time.Duration(0) {
+		return errors.New("duration cannot be negative")
+	}
+	return nil
+}
+
 func ValidateBool(i interface{}) error {
 	if _, ok := i.(bool); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -57,6 +68,16 @@ func ValidatePositiveInt64(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeInt64(i interface{}) error {
+	if err := ValidateInt64(i); err != nil {
+		return err
+	}
+	if i.(int64) < int64(0) {
+		return errors.New("int cannot be negative")
+	}
+	return nil
+}
+
 func ValidateString(i interface{}) error {
 	if _, ok := i.(string); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -90,6 +111,14 @@ func ValidateConnectionIdentifier(connId string) error {
 	return ibchost.ConnectionIdentifierValidator(connId)
 }
 
+func ValidateClientIdentifier(clientId string) error {
+	// accept empty string as valid
+	if strings.TrimSpace(clientId) == "" {
+		return nil
+	}
+	return ibchost.ClientIdentifierValidator(clientId)
+}
+
 func ValidateAccAddress(i interface{}) error {
 	value, ok := i.(string)
 	if !ok {
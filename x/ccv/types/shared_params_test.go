@@ -69,3 +69,16 @@ func TestValidateConnectionIdentifier(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateClientIdentifier(t *testing.T) {
+	// empty client ID
+	require.NoError(t, types.ValidateClientIdentifier(""))
+	require.NoError(t, types.ValidateClientIdentifier("  "))
+
+	// valid client ID
+	require.NoError(t, types.ValidateClientIdentifier("07-tendermint-0"))
+
+	// invalid client ID
+	require.Error(t, types.ValidateClientIdentifier("07-tendermint-0/"))
+	require.Error(t, types.ValidateClientIdentifier("client id"))
+}
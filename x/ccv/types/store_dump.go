@@ -0,0 +1,174 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// StoreEntry is the human-readable representation of a key-value pair of a CCV module store
+type StoreEntry struct {
+	// Prefix is the name of the key prefix, or its hex encoding if the prefix is unknown
+	Prefix string `json:"prefix"`
+	// ConsumerId is the consumer id the entry refers to, if any
+	ConsumerId string `json:"consumer_id,omitempty"`
+	// Key is the hex encoding of the full key
+	Key string `json:"key"`
+	// Value is the decoded value
+	Value json.RawMessage `json:"value"`
+}
+
+// StoreValueDecoder decodes a raw store value into its JSON representation
+type StoreValueDecoder func(cdc codec.Codec, bz []byte) (json.RawMessage, error)
+
+// StorePrefixDecoder describes how the entries stored under a key prefix are decoded
+type StorePrefixDecoder struct {
+	// Name is the name of the key prefix
+	Name string
+	// ConsumerId returns the consumer id an entry refers to;
+	// nil if the entries under this prefix are not associated with a consumer chain
+	ConsumerId func(key, value []byte) (string, error)
+	// Value decodes the values stored under this prefix
+	Value StoreValueDecoder
+}
+
+// ProtoStoreValue returns a StoreValueDecoder for values that are proto messages
+func ProtoStoreValue[T any, PT interface {
+	*T
+	proto.Message
+}]() StoreValueDecoder {
+	return func(cdc codec.Codec, bz []byte) (json.RawMessage, error) {
+		msg := PT(new(T))
+		if err := cdc.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+		return cdc.MarshalJSON(msg)
+	}
+}
+
+// StringStoreValue is a StoreValueDecoder for values that are strings
+func StringStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	return json.Marshal(string(bz))
+}
+
+// Uint64StoreValue is a StoreValueDecoder for values that are big endian encoded uint64s
+func Uint64StoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("invalid uint64 length: %d", len(bz))
+	}
+	return json.Marshal(binary.BigEndian.Uint64(bz))
+}
+
+// EmptyStoreValue is a StoreValueDecoder for keys that are stored without a value
+func EmptyStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	if len(bz) != 0 {
+		return nil, fmt.Errorf("expected empty value, got %d bytes", len(bz))
+	}
+	return json.RawMessage("null"), nil
+}
+
+// HexStoreValue is a StoreValueDecoder that hex encodes the raw value
+func HexStoreValue(_ codec.Codec, bz []byte) (json.RawMessage, error) {
+	return json.Marshal(strings.ToUpper(hex.EncodeToString(bz)))
+}
+
+// DecodeStoreEntry decodes a raw key-value pair using the decoder registered for its key prefix.
+// Entries with an unknown prefix, or that cannot be decoded, have their value hex encoded.
+func DecodeStoreEntry(cdc codec.Codec, decoders map[byte]StorePrefixDecoder, key, value []byte) StoreEntry {
+	entry := StoreEntry{
+		Prefix: fmt.Sprintf("0x%02X", key[0]),
+		Key:    strings.ToUpper(hex.EncodeToString(key)),
+	}
+
+	valueDecoder := HexStoreValue
+	if decoder, found := decoders[key[0]]; found {
+		entry.Prefix = decoder.Name
+		if decoder.ConsumerId != nil {
+			if consumerId, err := decoder.ConsumerId(key, value); err == nil {
+				entry.ConsumerId = consumerId
+			}
+		}
+		if decoder.Value != nil {
+			valueDecoder = decoder.Value
+		}
+	}
+
+	bz, err := valueDecoder(cdc, value)
+	if err != nil {
+		bz, _ = HexStoreValue(cdc, value)
+	}
+	entry.Value = bz
+
+	return entry
+}
+
+// ParseStorePrefix parses a key prefix given either by its name
+// (as registered in decoders) or as a hex encoded byte string
+func ParseStorePrefix(arg string, decoders map[byte]StorePrefixDecoder) ([]byte, error) {
+	for prefix, decoder := range decoders {
+		if decoder.Name == arg {
+			return []byte{prefix}, nil
+		}
+	}
+
+	bz, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(arg), "0x"))
+	if err != nil || len(bz) == 0 {
+		return nil, fmt.Errorf("unknown key prefix %s: expected a key prefix name or a hex encoded prefix", arg)
+	}
+	return bz, nil
+}
+
+// DumpStore writes the decoded entries of the module store with the given name to w,
+// one JSON object per line. It reads the store at the given version of the application
+// database, or at the latest version if version is 0, and only dumps the entries whose
+// keys start with prefix.
+func DumpStore(
+	w io.Writer,
+	db dbm.DB,
+	storeName string,
+	version int64,
+	prefix []byte,
+	cdc codec.Codec,
+	decoders map[byte]StorePrefixDecoder,
+) error {
+	storeKey := storetypes.NewKVStoreKey(storeName)
+
+	// only mount the module store, the other stores of the application are not loaded
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	var err error
+	if version == 0 {
+		err = ms.LoadLatestVersion()
+	} else {
+		err = ms.LoadVersion(version)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load %s store: %w", storeName, err)
+	}
+
+	iterator := storetypes.KVStorePrefixIterator(ms.GetKVStore(storeKey), prefix)
+	defer iterator.Close()
+
+	encoder := json.NewEncoder(w)
+	for ; iterator.Valid(); iterator.Next() {
+		entry := DecodeStoreEntry(cdc, decoders, iterator.Key(), iterator.Value())
+		if err := encoder.Encode(entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestParseStorePrefix(t *testing.T) {
+	decoders := map[byte]types.StorePrefixDecoder{
+		1: {Name: "FooKey"},
+	}
+
+	prefix, err := types.ParseStorePrefix("FooKey", decoders)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, prefix)
+
+	prefix, err = types.ParseStorePrefix("0x0102", decoders)
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2}, prefix)
+
+	prefix, err = types.ParseStorePrefix("ab", decoders)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0xAB}, prefix)
+
+	_, err = types.ParseStorePrefix("BarKey", decoders)
+	require.Error(t, err)
+
+	_, err = types.ParseStorePrefix("0x", decoders)
+	require.Error(t, err)
+}
+
+func TestDumpStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	decoders := map[byte]types.StorePrefixDecoder{
+		1: {
+			Name:       "FooKey",
+			ConsumerId: func(key, _ []byte) (string, error) { return string(key[1:]), nil },
+			Value:      types.StringStoreValue,
+		},
+		2: {Name: "BarKey", Value: types.Uint64StoreValue},
+	}
+
+	// write two versions of a module store, next to another store that is not dumped
+	moduleKey := storetypes.NewKVStoreKey("module")
+	otherKey := storetypes.NewKVStoreKey("other")
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(moduleKey, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ms.GetKVStore(moduleKey).Set([]byte("\x010"), []byte("foo"))
+	ms.GetKVStore(otherKey).Set([]byte("\x010"), []byte("other"))
+	ms.Commit()
+	ms.GetKVStore(moduleKey).Set([]byte("\x011"), []byte("bar"))
+	ms.GetKVStore(moduleKey).Set([]byte{2}, []byte{0, 0, 0, 0, 0, 0, 0, 5})
+	ms.Commit()
+
+	dump := func(version int64, prefix []byte) []types.StoreEntry {
+		var buf bytes.Buffer
+		require.NoError(t, types.DumpStore(&buf, db, "module", version, prefix, cdc, decoders))
+
+		entries := []types.StoreEntry{}
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var entry types.StoreEntry
+			require.NoError(t, json.Unmarshal([]byte(line), &entry))
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	// latest version
+	entries := dump(0, nil)
+	require.Len(t, entries, 3)
+	require.Equal(t, types.StoreEntry{Prefix: "FooKey", ConsumerId: "0", Key: "0130", Value: json.RawMessage(`"foo"`)}, entries[0])
+	require.Equal(t, types.StoreEntry{Prefix: "FooKey", ConsumerId: "1", Key: "0131", Value: json.RawMessage(`"bar"`)}, entries[1])
+	require.Equal(t, types.StoreEntry{Prefix: "BarKey", Key: "02", Value: json.RawMessage(`5`)}, entries[2])
+
+	// filtered by prefix
+	entries = dump(0, []byte{2})
+	require.Len(t, entries, 1)
+	require.Equal(t, "BarKey", entries[0].Prefix)
+
+	// previous version
+	entries = dump(1, nil)
+	require.Len(t, entries, 1)
+	require.Equal(t, "0", entries[0].ConsumerId)
+
+	// a version that does not exist
+	var buf bytes.Buffer
+	require.Error(t, types.DumpStore(&buf, db, "module", 10, nil, cdc, decoders))
+}
@@ -0,0 +1,291 @@
+package types
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// FlagStreamingSink is the start flag setting the sink the CCV module store changes
+// and events are streamed to, e.g., file:///var/log/ccv.jsonl. Streaming is disabled if empty.
+const FlagStreamingSink = "x-ccv-streaming-sink"
+
+// Types of the CCV streaming messages
+const (
+	StreamingMessageTypeEvent       = "event"
+	StreamingMessageTypeStoreChange = "store_change"
+)
+
+// AddStreamingFlags adds the CCV streaming flags to the start command
+func AddStreamingFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagStreamingSink, "",
+		"URL of the sink the CCV store changes and events are streamed to, e.g., file:///path/to/ccv.jsonl (disabled if empty)")
+}
+
+// StreamingMessage is a CCV store change or event of a committed block
+type StreamingMessage struct {
+	Height      int64                 `json:"height"`
+	Type        string                `json:"type"`
+	Event       *StreamingEvent       `json:"event,omitempty"`
+	StoreChange *StreamingStoreChange `json:"store_change,omitempty"`
+}
+
+// StreamingEvent is an event emitted by a CCV module
+type StreamingEvent struct {
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// StreamingStoreChange is a write to or a deletion from a CCV module store.
+// The value of a deleted entry is null.
+type StreamingStoreChange struct {
+	StoreEntry
+	Delete bool `json:"delete"`
+}
+
+// StreamingSink publishes the streaming messages of the committed blocks
+type StreamingSink interface {
+	// Publish publishes the JSON encoded streaming messages of a committed block
+	Publish(ctx context.Context, height int64, msgs [][]byte) error
+	// Close releases the resources of the sink
+	Close() error
+}
+
+// StreamingSinkFactory creates a StreamingSink from its URL
+type StreamingSinkFactory func(target *url.URL) (StreamingSink, error)
+
+var (
+	streamingSinksMu sync.RWMutex
+	streamingSinks   = map[string]StreamingSinkFactory{
+		"file": NewFileStreamingSink,
+	}
+)
+
+// RegisterStreamingSink registers the factory of the streaming sinks with the given URL scheme.
+// It enables applications to stream the CCV messages to external systems, e.g., Kafka or NATS,
+// without the CCV modules depending on their clients.
+func RegisterStreamingSink(scheme string, factory StreamingSinkFactory) {
+	streamingSinksMu.Lock()
+	defer streamingSinksMu.Unlock()
+	streamingSinks[scheme] = factory
+}
+
+// NewStreamingSink creates the streaming sink for the given URL
+func NewStreamingSink(target string) (StreamingSink, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, fmt.Errorf("invalid streaming sink URL %s: %w", target, err)
+	}
+
+	streamingSinksMu.RLock()
+	factory, found := streamingSinks[u.Scheme]
+	streamingSinksMu.RUnlock()
+	if !found {
+		return nil, fmt.Errorf("unknown streaming sink scheme %q in %s", u.Scheme, target)
+	}
+	return factory(u)
+}
+
+// fileStreamingSink appends the streaming messages to a file, one JSON object per line
+type fileStreamingSink struct {
+	file *os.File
+	w    *bufio.Writer
+}
+
+// NewFileStreamingSink creates a sink that appends the streaming messages to the file
+// with the given path, e.g., file:///var/log/ccv.jsonl
+func NewFileStreamingSink(target *url.URL) (StreamingSink, error) {
+	if target.Path == "" {
+		return nil, fmt.Errorf("missing file path in streaming sink URL %s", target)
+	}
+	file, err := os.OpenFile(target.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileStreamingSink{file: file, w: bufio.NewWriter(file)}, nil
+}
+
+// Publish implements StreamingSink
+func (s *fileStreamingSink) Publish(_ context.Context, _ int64, msgs [][]byte) error {
+	for _, msg := range msgs {
+		if _, err := s.w.Write(msg); err != nil {
+			return err
+		}
+		if err := s.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	return s.w.Flush()
+}
+
+// Close implements StreamingSink
+func (s *fileStreamingSink) Close() error {
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	return s.file.Close()
+}
+
+var _ storetypes.ABCIListener = (*StreamingListener)(nil)
+
+// StreamingListener is an ABCI listener that publishes the store changes and the events
+// of a CCV module to a StreamingSink when a block is committed
+type StreamingListener struct {
+	module   string
+	storeKey string
+	cdc      codec.Codec
+	decoders map[byte]StorePrefixDecoder
+	sink     StreamingSink
+
+	// height and events of the block being finalized
+	height int64
+	events []StreamingEvent
+}
+
+// NewStreamingListener creates a StreamingListener for the events emitted by the given module,
+// i.e., with the module attribute set to the module name, and for the changes of the given store.
+// The store entries are decoded with the given decoders.
+func NewStreamingListener(
+	module string,
+	storeKey string,
+	cdc codec.Codec,
+	decoders map[byte]StorePrefixDecoder,
+	sink StreamingSink,
+) *StreamingListener {
+	return &StreamingListener{
+		module:   module,
+		storeKey: storeKey,
+		cdc:      cdc,
+		decoders: decoders,
+		sink:     sink,
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+// It records the module events of the block, which are published on commit.
+func (l *StreamingListener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	l.height = req.Height
+	l.events = l.events[:0]
+
+	l.appendEvents(res.Events)
+	for _, txResult := range res.TxResults {
+		// the state changes of failed transactions are reverted
+		if txResult.Code != abci.CodeTypeOK {
+			continue
+		}
+		l.appendEvents(txResult.Events)
+	}
+	return nil
+}
+
+func (l *StreamingListener) appendEvents(events []abci.Event) {
+	for _, event := range events {
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		if attributes[sdk.AttributeKeyModule] != l.module {
+			continue
+		}
+		l.events = append(l.events, StreamingEvent{Type: event.Type, Attributes: attributes})
+	}
+}
+
+// ListenCommit implements storetypes.ABCIListener.
+// It publishes the module events and store changes of the committed block.
+func (l *StreamingListener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	msgs := make([][]byte, 0, len(l.events)+len(changeSet))
+	for i := range l.events {
+		bz, err := json.Marshal(StreamingMessage{
+			Height: l.height,
+			Type:   StreamingMessageTypeEvent,
+			Event:  &l.events[i],
+		})
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, bz)
+	}
+
+	for _, pair := range changeSet {
+		if pair.StoreKey != l.storeKey || len(pair.Key) == 0 {
+			continue
+		}
+		change := StreamingStoreChange{
+			StoreEntry: DecodeStoreEntry(l.cdc, l.decoders, pair.Key, pair.Value),
+			Delete:     pair.Delete,
+		}
+		if pair.Delete {
+			change.Value = json.RawMessage("null")
+		}
+		bz, err := json.Marshal(StreamingMessage{
+			Height:      l.height,
+			Type:        StreamingMessageTypeStoreChange,
+			StoreChange: &change,
+		})
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, bz)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := l.sink.Publish(ctx, l.height, msgs); err != nil {
+		return fmt.Errorf("failed to publish CCV streaming messages at height %d: %w", l.height, err)
+	}
+	return nil
+}
+
+// RegisterStreamingListener registers a StreamingListener for the given module and store
+// if the FlagStreamingSink option is set. As the BaseApp has a single streaming manager,
+// it cannot be combined with the streaming plugins of the SDK (see the [streaming] section of app.toml).
+func RegisterStreamingListener(
+	app *baseapp.BaseApp,
+	appOpts servertypes.AppOptions,
+	module string,
+	storeKey *storetypes.KVStoreKey,
+	cdc codec.Codec,
+	decoders map[byte]StorePrefixDecoder,
+) error {
+	target := strings.TrimSpace(cast.ToString(appOpts.Get(FlagStreamingSink)))
+	if target == "" {
+		return nil
+	}
+
+	pluginKey := fmt.Sprintf("%s.%s.%s", baseapp.StreamingTomlKey, baseapp.StreamingABCITomlKey, baseapp.StreamingABCIPluginTomlKey)
+	if plugin := strings.TrimSpace(cast.ToString(appOpts.Get(pluginKey))); plugin != "" {
+		return fmt.Errorf("%s cannot be used together with the streaming plugin %s", FlagStreamingSink, plugin)
+	}
+
+	sink, err := NewStreamingSink(target)
+	if err != nil {
+		return err
+	}
+
+	app.CommitMultiStore().AddListeners([]storetypes.StoreKey{storeKey})
+	app.SetStreamingManager(storetypes.StreamingManager{
+		ABCIListeners: []storetypes.ABCIListener{
+			NewStreamingListener(module, storeKey.Name(), cdc, decoders, sink),
+		},
+	})
+	return nil
+}
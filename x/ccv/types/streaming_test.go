@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestNewStreamingSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ccv.jsonl")
+	sink, err := types.NewStreamingSink("file://" + path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+
+	_, err = types.NewStreamingSink("kafka://localhost:9092/ccv")
+	require.Error(t, err)
+
+	// sinks for other schemes can be registered by the application
+	types.RegisterStreamingSink("test", func(target *url.URL) (types.StreamingSink, error) {
+		return types.NewFileStreamingSink(&url.URL{Path: filepath.Join(t.TempDir(), target.Host)})
+	})
+	sink, err = types.NewStreamingSink("test://ccv.jsonl")
+	require.NoError(t, err)
+	require.NoError(t, sink.Close())
+}
+
+func TestStreamingListener(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ccv.jsonl")
+	sink, err := types.NewStreamingSink("file://" + path)
+	require.NoError(t, err)
+	defer sink.Close()
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	decoders := map[byte]types.StorePrefixDecoder{
+		1: {Name: "FooKey", Value: types.StringStoreValue},
+	}
+	listener := types.NewStreamingListener("module", "module", cdc, decoders, sink)
+
+	moduleEvent := func(eventType string) abci.Event {
+		return abci.Event{Type: eventType, Attributes: []abci.EventAttribute{
+			{Key: "module", Value: "module"},
+			{Key: "consumer_id", Value: "0"},
+		}}
+	}
+	otherEvent := abci.Event{Type: "other", Attributes: []abci.EventAttribute{{Key: "module", Value: "other"}}}
+
+	err = listener.ListenFinalizeBlock(context.Background(),
+		abci.RequestFinalizeBlock{Height: 10},
+		abci.ResponseFinalizeBlock{
+			Events: []abci.Event{moduleEvent("end_block"), otherEvent},
+			TxResults: []*abci.ExecTxResult{
+				{Code: abci.CodeTypeOK, Events: []abci.Event{moduleEvent("tx")}},
+				// events of failed transactions are not streamed
+				{Code: 1, Events: []abci.Event{moduleEvent("failed_tx")}},
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	err = listener.ListenCommit(context.Background(), abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{StoreKey: "module", Key: []byte{1, 'a'}, Value: []byte("foo")},
+		{StoreKey: "module", Key: []byte{1, 'b'}, Delete: true},
+		// changes of other stores are not streamed
+		{StoreKey: "other", Key: []byte{1, 'c'}, Value: []byte("bar")},
+	})
+	require.NoError(t, err)
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bz)), "\n")
+	require.Len(t, lines, 4)
+
+	msgs := make([]types.StreamingMessage, len(lines))
+	for i, line := range lines {
+		require.NoError(t, json.Unmarshal([]byte(line), &msgs[i]))
+		require.Equal(t, int64(10), msgs[i].Height)
+	}
+
+	require.Equal(t, types.StreamingMessageTypeEvent, msgs[0].Type)
+	require.Equal(t, "end_block", msgs[0].Event.Type)
+	require.Equal(t, "0", msgs[0].Event.Attributes["consumer_id"])
+	require.Equal(t, types.StreamingMessageTypeEvent, msgs[1].Type)
+	require.Equal(t, "tx", msgs[1].Event.Type)
+
+	require.Equal(t, types.StreamingMessageTypeStoreChange, msgs[2].Type)
+	require.Equal(t, "FooKey", msgs[2].StoreChange.Prefix)
+	require.Equal(t, "0161", msgs[2].StoreChange.Key)
+	require.False(t, msgs[2].StoreChange.Delete)
+	require.JSONEq(t, `"foo"`, string(msgs[2].StoreChange.Value))
+
+	require.Equal(t, types.StreamingMessageTypeStoreChange, msgs[3].Type)
+	require.True(t, msgs[3].StoreChange.Delete)
+	require.JSONEq(t, `null`, string(msgs[3].StoreChange.Value))
+
+	// nothing is published for blocks without module events or store changes
+	require.NoError(t, listener.ListenFinalizeBlock(context.Background(), abci.RequestFinalizeBlock{Height: 11}, abci.ResponseFinalizeBlock{}))
+	require.NoError(t, listener.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+	bz2, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, bz, bz2)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"time"
+
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// CCV telemetry metric keys. When emitted, the keys are prefixed with the module name,
+// e.g., provider_slash_packets_received.
+const (
+	MetricKeySlashPacketsReceived     = "slash_packets_received"
+	MetricKeySlashPacketsHandled      = "slash_packets_handled"
+	MetricKeySlashPacketsBounced      = "slash_packets_bounced"
+	MetricKeySlashPacketsSent         = "slash_packets_sent"
+	MetricKeyVSCPacketsQueued         = "vsc_packets_queued"
+	MetricKeyVSCPacketsSent           = "vsc_packets_sent"
+	MetricKeyVSCPacketsAcked          = "vsc_packets_acked"
+	MetricKeyVSCPacketsReceived       = "vsc_packets_received"
+	MetricKeyKeyAssignments           = "key_assignments"
+	MetricKeySlashMeter               = "slash_meter"
+	MetricKeyPendingPackets           = "pending_packets"
+	MetricKeySecondsSinceLastVSCAck   = "seconds_since_last_vsc_ack"
+	MetricKeySecondsUntilClientExpiry = "seconds_until_client_expiry"
+	MetricKeyBlockPhaseDuration       = "block_phase_duration"
+	MetricKeyBlockHookDuration        = "block_hook_duration"
+	MetricKeyConsumerFailures         = "consumer_failures"
+
+	MetricLabelConsumerId = "consumer_id"
+	MetricLabelInfraction = "infraction"
+	MetricLabelPhase      = "phase"
+	MetricLabelHook       = "hook"
+	MetricLabelOperation  = "operation"
+)
+
+// IncrConsumerCounter increments the counter of a module with the given key
+// and labels it with the consumer id and the optional extra labels.
+// It is a no-op if telemetry is disabled.
+func IncrConsumerCounter(module, key, consumerId string, val float32, labels ...metrics.Label) {
+	telemetry.IncrCounterWithLabels(
+		[]string{module, key},
+		val,
+		append([]metrics.Label{telemetry.NewLabel(MetricLabelConsumerId, consumerId)}, labels...),
+	)
+}
+
+// SetConsumerGauge sets the gauge of a module with the given key and labels it with the consumer id.
+// It is a no-op if telemetry is disabled.
+func SetConsumerGauge(module, key, consumerId string, val float32) {
+	telemetry.SetGaugeWithLabels(
+		[]string{module, key},
+		val,
+		[]metrics.Label{telemetry.NewLabel(MetricLabelConsumerId, consumerId)},
+	)
+}
+
+// MeasureSinceWithLabels records the time elapsed since start in the timer of a module
+// with the given key and labels. It is a no-op if telemetry is disabled.
+func MeasureSinceWithLabels(module, key string, start time.Time, labels ...metrics.Label) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+	metrics.MeasureSinceWithLabels([]string{module, key}, start.UTC(), labels)
+}
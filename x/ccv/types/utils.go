@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"sort"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
+	"cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -19,37 +21,87 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
 )
 
+// AccumulateChanges merges newChanges into currentChanges, keeping only the latest
+// update of every validator. The result is sorted by power (descending) and then by
+// public key (descending), such that it is the same across all consensus nodes.
 func AccumulateChanges(currentChanges, newChanges []abci.ValidatorUpdate) []abci.ValidatorUpdate {
-	m := make(map[string]abci.ValidatorUpdate)
-
-	for i := 0; i < len(currentChanges); i++ {
-		m[currentChanges[i].PubKey.String()] = currentChanges[i]
+	type keyedUpdate struct {
+		key    string
+		update abci.ValidatorUpdate
 	}
 
-	for i := 0; i < len(newChanges); i++ {
-		m[newChanges[i].PubKey.String()] = newChanges[i]
+	numChanges := len(currentChanges) + len(newChanges)
+	if numChanges == 0 {
+		return nil
 	}
-
-	var out []abci.ValidatorUpdate
-
-	for _, update := range m {
-		out = append(out, update)
+	// index of every validator in keyed, such that the order of first appearance is kept
+	indices := make(map[string]int, numChanges)
+	keyed := make([]keyedUpdate, 0, numChanges)
+	for _, changes := range [][]abci.ValidatorUpdate{currentChanges, newChanges} {
+		for _, update := range changes {
+			key := validatorUpdateKey(update.PubKey)
+			if i, found := indices[key]; found {
+				keyed[i].update = update
+				continue
+			}
+			indices[key] = len(keyed)
+			keyed = append(keyed, keyedUpdate{key: key, update: update})
+		}
 	}
 
 	// The list of tendermint updates should hash the same across all consensus nodes
 	// that means it is necessary to sort for determinism.
-	sort.Slice(out, func(i, j int) bool {
-		if out[i].Power != out[j].Power {
-			return out[i].Power > out[j].Power
+	sort.Slice(keyed, func(i, j int) bool {
+		if keyed[i].update.Power != keyed[j].update.Power {
+			return keyed[i].update.Power > keyed[j].update.Power
 		}
-		return out[i].PubKey.String() > out[j].PubKey.String()
+		return keyed[i].key > keyed[j].key
 	})
 
+	out := make([]abci.ValidatorUpdate, len(keyed))
+	for i := range keyed {
+		out[i] = keyed[i].update
+	}
 	return out
 }
 
+// validatorUpdateKey returns the key identifying the validator of an update,
+// i.e., the type of its public key followed by the public key bytes
+func validatorUpdateKey(pubKey tmprotocrypto.PublicKey) string {
+	switch sum := pubKey.Sum.(type) {
+	case *tmprotocrypto.PublicKey_Ed25519:
+		return "\x00" + string(sum.Ed25519)
+	case *tmprotocrypto.PublicKey_Secp256K1:
+		return "\x01" + string(sum.Secp256K1)
+	default:
+		// the public key is empty
+		return ""
+	}
+}
+
+// ComputeValsetHash returns the CometBFT hash of the validator set
+// given by the provided validator updates. Updates with zero power are ignored.
+func ComputeValsetHash(updates []abci.ValidatorUpdate) ([]byte, error) {
+	var nonZero []abci.ValidatorUpdate
+	for _, u := range updates {
+		if u.Power > 0 {
+			nonZero = append(nonZero, u)
+		}
+	}
+	tmUpdates, err := tmtypes.PB2TM.ValidatorUpdates(nonZero)
+	if err != nil {
+		return nil, err
+	}
+	valSet := &tmtypes.ValidatorSet{}
+	if err := valSet.UpdateWithChangeSet(tmUpdates); err != nil {
+		return nil, err
+	}
+	return valSet.Hash(), nil
+}
+
 // TMCryptoPublicKeyToConsAddr converts a TM public key to an SDK public key
 // and returns the associated consensus address
 func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, error) {
@@ -61,7 +113,7 @@ func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, er
 }
 
 // SendIBCPacket sends an IBC packet with packetData
-// over the source channelID and portID
+// over the source channelID and portID and returns the sequence of the packet
 func SendIBCPacket(
 	ctx sdk.Context,
 	channelKeeper ChannelKeeper,
@@ -69,20 +121,19 @@ func SendIBCPacket(
 	sourcePortID string,
 	packetData []byte,
 	timeoutPeriod time.Duration,
-) error {
+) (uint64, error) {
 	_, ok := channelKeeper.GetChannel(ctx, sourcePortID, sourceChannelID)
 	if !ok {
-		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", sourceChannelID)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", sourceChannelID)
 	}
 
-	_, err := channelKeeper.SendPacket(ctx,
+	return channelKeeper.SendPacket(ctx,
 		sourcePortID,
 		sourceChannelID,
 		clienttypes.Height{}, //  timeout height disabled
 		uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano()), // timeout timestamp
 		packetData,
 	)
-	return err
 }
 
 func NewErrorAcknowledgementWithLog(ctx sdk.Context, err error) channeltypes.Acknowledgement {
@@ -104,7 +155,10 @@ func PanicIfZeroOrNil(x interface{}, nameForPanicMsg string) {
 	}
 }
 
-// GetConsAddrFromBech32 returns a ConsAddress from a Bech32 with an arbitrary prefix
+// GetConsAddrFromBech32 returns a ConsAddress from a Bech32 with an arbitrary prefix.
+// It is meant for addresses encoded by the counterparty chain (e.g., the slash acks),
+// whose prefix is unknown; addresses of the local chain are decoded with the app
+// consensus address codec carried by the keepers.
 func GetConsAddrFromBech32(bech32str string) (sdk.ConsAddress, error) {
 	bech32Addr := strings.TrimSpace(bech32str)
 	if len(bech32Addr) == 0 {
@@ -118,21 +172,60 @@ func GetConsAddrFromBech32(bech32str string) (sdk.ConsAddress, error) {
 	return sdk.ConsAddress(addr), nil
 }
 
+// MustAddressToString encodes the given address with the given address codec.
+// It panics if the address cannot be encoded, as sdk.ConsAddress.String() does.
+func MustAddressToString(addressCodec address.Codec, addr []byte) string {
+	addrStr, err := addressCodec.BytesToString(addr)
+	if err != nil {
+		panic(fmt.Errorf("cannot encode address %X: %w", addr, err))
+	}
+	return addrStr
+}
+
 // GetLastBondedValidatorsUtil iterates the last validator powers in the staking module
 // and returns the first maxVals many validators with the largest powers.
 func GetLastBondedValidatorsUtil(ctx sdk.Context, stakingKeeper StakingKeeper, maxVals uint32) ([]stakingtypes.Validator, error) {
-	// get the bonded validators from the staking module, sorted by power
-	bondedValidators, err := stakingKeeper.GetBondedValidatorsByPower(ctx)
+	return GetLastBondedValidatorsPageUtil(ctx, stakingKeeper, maxVals, 0, maxVals)
+}
+
+// GetLastBondedValidatorsPageUtil returns at most limit validators, starting at offset, from
+// the first maxVals many validators with the largest powers in the staking module.
+// The validators are read directly from the staking module's power index, i.e., the iteration
+// stops once the page is complete and only the validators in the page are kept in memory.
+func GetLastBondedValidatorsPageUtil(
+	ctx sdk.Context,
+	stakingKeeper StakingKeeper,
+	maxVals, offset, limit uint32,
+) ([]stakingtypes.Validator, error) {
+	if offset >= maxVals || limit == 0 {
+		return []stakingtypes.Validator{}, nil
+	}
+	// the (exclusive) index of the last validator in the page
+	end := int64(offset) + int64(limit)
+	if end > int64(maxVals) {
+		end = int64(maxVals)
+	}
+
+	validators := make([]stakingtypes.Validator, 0, end-int64(offset))
+	var iterErr error
+	err := stakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if index < int64(offset) {
+			return false
+		}
+		val, ok := validator.(stakingtypes.Validator)
+		if !ok {
+			iterErr = fmt.Errorf("unexpected validator type %T", validator)
+			return true
+		}
+		validators = append(validators, val)
+		return index+1 >= end
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	// get the first maxVals many validators
-	if uint32(len(bondedValidators)) < maxVals {
-		return bondedValidators, nil // no need to truncate
+	if iterErr != nil {
+		return nil, iterErr
 	}
 
-	bondedValidators = bondedValidators[:maxVals]
-
-	return bondedValidators, nil
+	return validators, nil
 }
@@ -1,15 +1,24 @@
 package types_test
 
 import (
+	"fmt"
+	"math/rand"
 	"testing"
 
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -88,3 +97,137 @@ func TestAccumulateChanges(t *testing.T) {
 		})
 	}
 }
+
+// TestAccumulateChangesDeterminism tests that the accumulated changes do not depend
+// on the order of the changes, as long as every validator is updated at most once
+func TestAccumulateChangesDeterminism(t *testing.T) {
+	changes := []abci.ValidatorUpdate{}
+	for i := 0; i < 50; i++ {
+		pubKey := cryptotestutil.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey()
+		// use few distinct powers, so that validators are also sorted by their public keys
+		changes = append(changes, abci.ValidatorUpdate{PubKey: pubKey, Power: int64(i % 3)})
+	}
+	// add a secp256k1 key to the ed25519 keys
+	tmPubKey, err := cryptocodec.ToCmtProtoPublicKey(secp256k1.GenPrivKeyFromSecret([]byte("secp256k1")).PubKey())
+	require.NoError(t, err)
+	changes = append(changes, abci.ValidatorUpdate{PubKey: tmPubKey, Power: 1})
+
+	expected := types.AccumulateChanges(changes[:25], changes[25:])
+	require.Len(t, expected, len(changes))
+	for i := 1; i < len(expected); i++ {
+		require.GreaterOrEqual(t, expected[i-1].Power, expected[i].Power)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10; i++ {
+		shuffled := append([]abci.ValidatorUpdate{}, changes...)
+		r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		split := r.Intn(len(shuffled))
+		require.Equal(t, expected, types.AccumulateChanges(shuffled[:split], shuffled[split:]))
+	}
+}
+
+// BenchmarkAccumulateChanges benchmarks accumulating numChanges changes into numChanges
+// pending changes, where half of the new changes update validators with pending changes
+func BenchmarkAccumulateChanges(b *testing.B) {
+	for _, numChanges := range []int{100, 1000, 5000} {
+		b.Run(fmt.Sprintf("changes=%d", numChanges), func(b *testing.B) {
+			pubKeys := make([]crypto.PublicKey, numChanges+numChanges/2)
+			for i := range pubKeys {
+				pubKeys[i] = cryptotestutil.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey()
+			}
+			currentChanges := make([]abci.ValidatorUpdate, numChanges)
+			for i := range currentChanges {
+				currentChanges[i] = abci.ValidatorUpdate{PubKey: pubKeys[i], Power: int64(i % 100)}
+			}
+			newChanges := make([]abci.ValidatorUpdate, numChanges)
+			for i := range newChanges {
+				newChanges[i] = abci.ValidatorUpdate{PubKey: pubKeys[numChanges/2+i], Power: int64(i % 50)}
+			}
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for n := 0; n < b.N; n++ {
+				types.AccumulateChanges(currentChanges, newChanges)
+			}
+		})
+	}
+}
+
+func TestGetLastBondedValidatorsPageUtil(t *testing.T) {
+	validators := []stakingtypes.Validator{}
+	for i := 0; i < 5; i++ {
+		validators = append(validators, stakingtypes.Validator{OperatorAddress: sdk.ValAddress([]byte{byte(i)}).String()})
+	}
+
+	testCases := []struct {
+		name     string
+		maxVals  uint32
+		offset   uint32
+		limit    uint32
+		expected []stakingtypes.Validator
+	}{
+		{
+			name:     "all validators",
+			maxVals:  5,
+			offset:   0,
+			limit:    5,
+			expected: validators,
+		},
+		{
+			name:     "capped by maxVals",
+			maxVals:  3,
+			offset:   0,
+			limit:    5,
+			expected: validators[:3],
+		},
+		{
+			name:     "page in the middle",
+			maxVals:  5,
+			offset:   1,
+			limit:    2,
+			expected: validators[1:3],
+		},
+		{
+			name:     "page capped by maxVals",
+			maxVals:  4,
+			offset:   2,
+			limit:    10,
+			expected: validators[2:4],
+		},
+		{
+			name:     "more validators requested than bonded",
+			maxVals:  10,
+			offset:   3,
+			limit:    10,
+			expected: validators[3:],
+		},
+		{
+			name:     "offset past maxVals",
+			maxVals:  2,
+			offset:   2,
+			limit:    1,
+			expected: []stakingtypes.Validator{},
+		},
+		{
+			name:     "zero limit",
+			maxVals:  5,
+			offset:   0,
+			limit:    0,
+			expected: []stakingtypes.Validator{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStakingKeeper := testkeeper.NewMockStakingKeeper(ctrl)
+			testkeeper.MockIterateBondedValidatorsByPower(mockStakingKeeper, validators).AnyTimes()
+
+			actual, err := types.GetLastBondedValidatorsPageUtil(sdk.Context{}, mockStakingKeeper, tc.maxVals, tc.offset, tc.limit)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -1,18 +1,36 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmencoding "github.com/cometbft/cometbft/crypto/encoding"
 )
 
+// MaxValidatorUpdatesPerPacket is the maximum number of validator updates
+// a single VSC packet can contain
+const MaxValidatorUpdatesPerPacket = 10000
+
+// MaxConsumerUpgradeNameLength is the maximum length of the name of a consumer upgrade
+const MaxConsumerUpgradeNameLength = 140
+
 func NewValidatorSetChangePacketData(valUpdates []abci.ValidatorUpdate, valUpdateID uint64, slashAcks []string) ValidatorSetChangePacketData {
 	return ValidatorSetChangePacketData{
 		ValidatorUpdates: valUpdates,
@@ -32,6 +50,37 @@ func (vsc ValidatorSetChangePacketData) Validate() error {
 	if vsc.ValsetUpdateId == 0 {
 		return errorsmod.Wrap(ErrInvalidPacketData, "valset update id cannot be equal to zero")
 	}
+	return vsc.ValidateValidatorUpdates()
+}
+
+// ValidateValidatorUpdates validates the validator updates of the CCV packet data, i.e.,
+// it rejects too many updates, negative powers, and empty, malformed or duplicate public keys.
+func (vsc ValidatorSetChangePacketData) ValidateValidatorUpdates() error {
+	if len(vsc.ValidatorUpdates) > MaxValidatorUpdatesPerPacket {
+		return errorsmod.Wrapf(ErrTooManyValidatorUpdates, "got %d, max %d",
+			len(vsc.ValidatorUpdates), MaxValidatorUpdatesPerPacket)
+	}
+	seenPubKeys := make(map[string]struct{}, len(vsc.ValidatorUpdates))
+	for i, update := range vsc.ValidatorUpdates {
+		if update.Power < 0 {
+			return errorsmod.Wrapf(ErrInvalidValidatorPower, "validator update %d has negative power %d", i, update.Power)
+		}
+		if update.PubKey.Sum == nil {
+			return errorsmod.Wrapf(ErrInvalidPacketData, "validator update %d has empty public key", i)
+		}
+		// the consumer chain cannot apply validator updates with malformed public keys, e.g., of invalid sizes
+		if _, err := tmencoding.PubKeyFromProto(update.PubKey); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPacketData, "validator update %d has invalid public key: %v", i, err)
+		}
+		pkBz, err := update.PubKey.Marshal()
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidPacketData, "validator update %d has invalid public key: %v", i, err)
+		}
+		if _, found := seenPubKeys[string(pkBz)]; found {
+			return errorsmod.Wrapf(ErrDuplicateValidatorPubKey, "validator update %d: %s", i, update.PubKey.String())
+		}
+		seenPubKeys[string(pkBz)] = struct{}{}
+	}
 	return nil
 }
 
@@ -42,6 +91,169 @@ func (vsc ValidatorSetChangePacketData) GetBytes() []byte {
 	return valUpdateBytes
 }
 
+func NewValidatorSetChangePacketAck(valUpdateID uint64, valsetHash []byte) ValidatorSetChangePacketAck {
+	return ValidatorSetChangePacketAck{
+		ValsetUpdateId: valUpdateID,
+		ValsetHash:     valsetHash,
+	}
+}
+
+// GetBytes marshals the ValidatorSetChangePacketAck into JSON string bytes
+// to be sent over the wire as the result of a VSC packet acknowledgement.
+func (ack ValidatorSetChangePacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&ack)
+}
+
+func NewValsetCheckpointPacketData(valUpdateID uint64, valsetHash []byte) ValsetCheckpointPacketData {
+	return ValsetCheckpointPacketData{
+		ValsetUpdateId: valUpdateID,
+		ValsetHash:     valsetHash,
+	}
+}
+
+// Validate is used for validating the valset checkpoint packet data.
+func (vc ValsetCheckpointPacketData) Validate() error {
+	// ValsetUpdateId is strictly positive
+	if vc.ValsetUpdateId == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "valset update id cannot be equal to zero")
+	}
+	if len(vc.ValsetHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "valset hash cannot be empty")
+	}
+	return nil
+}
+
+// GetBytes marshals the ValsetCheckpointPacketData into JSON string bytes
+// to be sent over the wire with IBC.
+func (vc ValsetCheckpointPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&vc)
+}
+
+// Validate is used for validating a consumer upgrade plan.
+func (p ConsumerUpgradePlan) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return errorsmod.Wrap(ErrInvalidConsumerUpgradePlan, "name cannot be empty")
+	}
+	if len(p.Name) > MaxConsumerUpgradeNameLength {
+		return errorsmod.Wrapf(ErrInvalidConsumerUpgradePlan, "name cannot exceed %d characters", MaxConsumerUpgradeNameLength)
+	}
+	if p.HaltHeight < 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerUpgradePlan, "halt height cannot be negative")
+	}
+	if (p.HaltHeight == 0) == p.HaltTime.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerUpgradePlan, "exactly one of halt height and halt time must be set")
+	}
+	if len(p.BinaryHash) != sha256.Size {
+		return errorsmod.Wrapf(ErrInvalidConsumerUpgradePlan, "binary hash must be a SHA256 hash of %d bytes", sha256.Size)
+	}
+	return nil
+}
+
+// IsHaltReached returns true if the consumer chain reached the halt height or the halt time of the upgrade plan
+func (p ConsumerUpgradePlan) IsHaltReached(ctx sdk.Context) bool {
+	if p.HaltHeight > 0 {
+		return ctx.BlockHeight() >= p.HaltHeight
+	}
+	return !ctx.BlockTime().Before(p.HaltTime)
+}
+
+// EventAttributes returns the event attributes describing the consumer upgrade plan
+func (p ConsumerUpgradePlan) EventAttributes() []sdk.Attribute {
+	haltTime := ""
+	if !p.HaltTime.IsZero() {
+		haltTime = p.HaltTime.UTC().Format(time.RFC3339)
+	}
+	return []sdk.Attribute{
+		sdk.NewAttribute(AttributeUpgradeName, p.Name),
+		sdk.NewAttribute(AttributeUpgradeHaltHeight, strconv.FormatInt(p.HaltHeight, 10)),
+		sdk.NewAttribute(AttributeUpgradeHaltTime, haltTime),
+		sdk.NewAttribute(AttributeUpgradeBinaryHash, hex.EncodeToString(p.BinaryHash)),
+	}
+}
+
+func NewConsumerUpgradePacketData(plan ConsumerUpgradePlan, cancelled bool) ConsumerUpgradePacketData {
+	return ConsumerUpgradePacketData{
+		UpgradePlan: plan,
+		Cancelled:   cancelled,
+	}
+}
+
+// Validate is used for validating the consumer upgrade packet data.
+func (cu ConsumerUpgradePacketData) Validate() error {
+	if err := cu.UpgradePlan.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPacketData, err.Error())
+	}
+	return nil
+}
+
+// GetBytes marshals the ConsumerUpgradePacketData into JSON string bytes
+// to be sent over the wire with IBC.
+func (cu ConsumerUpgradePacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&cu)
+}
+
+// Validate is used for validating the downtime detection parameters of a consumer chain.
+// The checks match the ones of the slashing module params.
+func (p DowntimeParams) Validate() error {
+	if p.SignedBlocksWindow <= 0 {
+		return errorsmod.Wrapf(ErrInvalidDowntimeParams, "signed blocks window must be positive: %d", p.SignedBlocksWindow)
+	}
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidDowntimeParams, "min signed per window must be in [0, 1]: %s", p.MinSignedPerWindow)
+	}
+	return nil
+}
+
+// EventAttributes returns the event attributes describing the downtime detection parameters
+func (p DowntimeParams) EventAttributes() []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(AttributeSignedBlocksWindow, strconv.FormatInt(p.SignedBlocksWindow, 10)),
+		sdk.NewAttribute(AttributeMinSignedPerWindow, p.MinSignedPerWindow.String()),
+	}
+}
+
+func NewDowntimeParamsPacketData(params DowntimeParams) DowntimeParamsPacketData {
+	return DowntimeParamsPacketData{
+		DowntimeParams: params,
+	}
+}
+
+// Validate is used for validating the downtime params packet data.
+func (dp DowntimeParamsPacketData) Validate() error {
+	if err := dp.DowntimeParams.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPacketData, err.Error())
+	}
+	return nil
+}
+
+// GetBytes marshals the DowntimeParamsPacketData into JSON string bytes
+// to be sent over the wire with IBC.
+func (dp DowntimeParamsPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&dp)
+}
+
+func NewProviderFeePoolAddrPacketData(providerFeePoolAddr string) ProviderFeePoolAddrPacketData {
+	return ProviderFeePoolAddrPacketData{
+		ProviderFeePoolAddr: providerFeePoolAddr,
+	}
+}
+
+// Validate is used for validating the provider fee pool address packet data.
+// As the bech32 prefix of the provider chain is not known on the consumer chain,
+// only the bech32 encoding of the address is checked.
+func (fp ProviderFeePoolAddrPacketData) Validate() error {
+	if _, _, err := bech32.DecodeAndConvert(fp.ProviderFeePoolAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "invalid provider fee pool address %s: %s", fp.ProviderFeePoolAddr, err.Error())
+	}
+	return nil
+}
+
+// GetBytes marshals the ProviderFeePoolAddrPacketData into JSON string bytes
+// to be sent over the wire with IBC.
+func (fp ProviderFeePoolAddrPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&fp)
+}
+
 func NewVSCMaturedPacketData(valUpdateID uint64) *VSCMaturedPacketData {
 	return &VSCMaturedPacketData{
 		ValsetUpdateId: valUpdateID,
@@ -170,6 +382,160 @@ func (vdt1 SlashPacketDataV1) FromV1() *SlashPacketData {
 	}
 }
 
+// FeatureConsumerPacketDataV2 is the feature advertised by providers
+// that can handle consumer packet data sent in a ConsumerPacketDataV2 envelope
+const FeatureConsumerPacketDataV2 = "consumer_packet_data_v2"
+
+// SupportedFeatures returns the features supported by this version of CCV
+func SupportedFeatures() []string {
+	return []string{FeatureConsumerPacketDataV2}
+}
+
+// IsFeatureSupported returns true if the feature is supported by this version of CCV
+func IsFeatureSupported(feature string) bool {
+	for _, f := range SupportedFeatures() {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// ConsumerPacketPayload defines the interface of the payloads
+// that can be sent in a ConsumerPacketDataV2 envelope
+type ConsumerPacketPayload interface {
+	proto.Message
+	Validate() error
+}
+
+// NewConsumerPacketDataV2 creates a new ConsumerPacketDataV2 envelope with the given payload.
+// The features are the features the receiver must support in order to handle the packet.
+func NewConsumerPacketDataV2(payload ConsumerPacketPayload, features ...string) (ConsumerPacketDataV2, error) {
+	anyPayload, err := codectypes.NewAnyWithValue(payload)
+	if err != nil {
+		return ConsumerPacketDataV2{}, err
+	}
+	return ConsumerPacketDataV2{
+		Features: append([]string{FeatureConsumerPacketDataV2}, features...),
+		Payload:  anyPayload,
+	}, nil
+}
+
+// UnpackPayload returns the unpacked payload of the ConsumerPacketDataV2 envelope
+func (cp ConsumerPacketDataV2) UnpackPayload() (ConsumerPacketPayload, error) {
+	if cp.Payload == nil {
+		return nil, errorsmod.Wrap(ErrInvalidPacketData, "payload cannot be empty")
+	}
+	var payload ConsumerPacketPayload
+	if err := ModuleCdc.UnpackAny(cp.Payload, &payload); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidPacketData, "cannot unpack payload: %v", err)
+	}
+	return payload, nil
+}
+
+// ValidateFeatures checks that all the features required by the ConsumerPacketDataV2 envelope are supported
+func (cp ConsumerPacketDataV2) ValidateFeatures() error {
+	for _, feature := range cp.Features {
+		if !IsFeatureSupported(feature) {
+			return errorsmod.Wrapf(ErrUnsupportedFeature, "%s", feature)
+		}
+	}
+	return nil
+}
+
+// Validate is used for validating the ConsumerPacketDataV2 envelope and its payload
+func (cp ConsumerPacketDataV2) Validate() error {
+	if err := cp.ValidateFeatures(); err != nil {
+		return err
+	}
+	payload, err := cp.UnpackPayload()
+	if err != nil {
+		return err
+	}
+	return payload.Validate()
+}
+
+// GetBytes marshals the ConsumerPacketDataV2 into JSON string bytes
+// to be sent over the wire with IBC.
+func (cp ConsumerPacketDataV2) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&cp)
+}
+
+// ToV2 converts the ConsumerPacketData to a ConsumerPacketDataV2 envelope
+func (cp ConsumerPacketData) ToV2() (ConsumerPacketDataV2, error) {
+	switch cp.Type {
+	case SlashPacket:
+		if cp.GetSlashPacketData() == nil {
+			return ConsumerPacketDataV2{}, errors.New("invalid consumer packet data: SlashPacketData data cannot be empty")
+		}
+		return NewConsumerPacketDataV2(cp.GetSlashPacketData())
+	case VscMaturedPacket:
+		if cp.GetVscMaturedPacketData() == nil {
+			return ConsumerPacketDataV2{}, errors.New("invalid consumer packet data: VscMaturePacketData data cannot be empty")
+		}
+		return NewConsumerPacketDataV2(cp.GetVscMaturedPacketData())
+	default:
+		return ConsumerPacketDataV2{}, fmt.Errorf("invalid consumer packet type: %q", cp.Type)
+	}
+}
+
+// ToConsumerPacketData converts the ConsumerPacketDataV2 envelope to a ConsumerPacketData.
+// Only the payloads that can be represented as ConsumerPacketData can be converted.
+func (cp ConsumerPacketDataV2) ToConsumerPacketData() (ConsumerPacketData, error) {
+	payload, err := cp.UnpackPayload()
+	if err != nil {
+		return ConsumerPacketData{}, err
+	}
+	switch data := payload.(type) {
+	case *SlashPacketData:
+		return NewConsumerPacketData(SlashPacket, &ConsumerPacketData_SlashPacketData{SlashPacketData: data}), nil
+	case *VSCMaturedPacketData:
+		return NewConsumerPacketData(VscMaturedPacket, &ConsumerPacketData_VscMaturedPacketData{VscMaturedPacketData: data}), nil
+	default:
+		return ConsumerPacketData{}, errorsmod.Wrapf(ErrInvalidPacketData, "unsupported payload type: %T", payload)
+	}
+}
+
+// UnmarshalConsumerPacketData decodes the data of a packet sent by a consumer chain on the CCV channel.
+// The data is either a ConsumerPacketData, a ConsumerPacketDataV2 envelope, or a ConsumerPacketDataV1
+// sent by consumer chains running ICS versions using cosmos-sdk v45 (ICS v1 and ICS v2).
+// Note that the decoded packet data is not validated.
+func UnmarshalConsumerPacketData(packetData []byte) (consumerPacket ConsumerPacketData, err error) {
+	// First try unmarshaling into ConsumerPacketData type
+	if err := ModuleCdc.UnmarshalJSON(packetData, &consumerPacket); err != nil {
+		// If failed, packet could be a ConsumerPacketDataV2 envelope
+		var v2Packet ConsumerPacketDataV2
+		if errV2 := ModuleCdc.UnmarshalJSON(packetData, &v2Packet); errV2 == nil {
+			if err := v2Packet.ValidateFeatures(); err != nil {
+				return ConsumerPacketData{}, err
+			}
+			return v2Packet.ToConsumerPacketData()
+		}
+
+		// Otherwise, packet should be a v1 slash packet, retry for ConsumerPacketDataV1 packet type
+		var v1Packet ConsumerPacketDataV1
+		errV1 := ModuleCdc.UnmarshalJSON(packetData, &v1Packet)
+		if errV1 != nil {
+			// If neither worked, return error
+			return ConsumerPacketData{}, errV1
+		}
+
+		// VSC matured packets should not be unmarshaled as v1 packets
+		if v1Packet.Type == VscMaturedPacket {
+			return ConsumerPacketData{}, errors.New("VSC matured packets should be correctly unmarshaled")
+		}
+
+		// Convert from v1 packet type
+		consumerPacket = ConsumerPacketData{
+			Type: v1Packet.Type,
+			Data: &ConsumerPacketData_SlashPacketData{
+				SlashPacketData: v1Packet.GetSlashPacketData().FromV1(),
+			},
+		}
+	}
+	return consumerPacket, nil
+}
+
 type PacketAckResult []byte
 
 var ( // slice types can't be const
@@ -4,20 +4,27 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/abci/types"
+	_ "github.com/cosmos/cosmos-proto"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -158,6 +165,349 @@ func (m *ValidatorSetChangePacketData) GetSlashAcks() []string {
 	return nil
 }
 
+// ValidatorSetChangePacketAck is the result of the acknowledgement
+// sent by the consumer chain for a successfully received VSC packet.
+// It confirms the validator set that the consumer chain applies
+// as a result of receiving the VSC packet.
+type ValidatorSetChangePacketAck struct {
+	// the id of the acknowledged VSC packet
+	ValsetUpdateId uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the CometBFT hash of the validator set applied by the consumer chain
+	ValsetHash []byte `protobuf:"bytes,2,opt,name=valset_hash,json=valsetHash,proto3" json:"valset_hash,omitempty"`
+}
+
+func (m *ValidatorSetChangePacketAck) Reset()         { *m = ValidatorSetChangePacketAck{} }
+func (m *ValidatorSetChangePacketAck) String() string { return proto.CompactTextString(m) }
+func (*ValidatorSetChangePacketAck) ProtoMessage()    {}
+func (*ValidatorSetChangePacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{1}
+}
+func (m *ValidatorSetChangePacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorSetChangePacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorSetChangePacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorSetChangePacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorSetChangePacketAck.Merge(m, src)
+}
+func (m *ValidatorSetChangePacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorSetChangePacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorSetChangePacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorSetChangePacketAck proto.InternalMessageInfo
+
+func (m *ValidatorSetChangePacketAck) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ValidatorSetChangePacketAck) GetValsetHash() []byte {
+	if m != nil {
+		return m.ValsetHash
+	}
+	return nil
+}
+
+// This packet is periodically sent from the provider chain to the consumer chain
+// and contains the hash of the validator set the consumer chain is expected to have
+// once it applies all the VSC packets up to (and including) valset_update_id.
+// The consumer chain uses it to detect divergences between its validator set
+// and the one computed by the provider chain.
+type ValsetCheckpointPacketData struct {
+	// the id of the last VSC packet covered by this checkpoint
+	ValsetUpdateId uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the CometBFT hash of the expected consumer validator set
+	ValsetHash []byte `protobuf:"bytes,2,opt,name=valset_hash,json=valsetHash,proto3" json:"valset_hash,omitempty"`
+}
+
+func (m *ValsetCheckpointPacketData) Reset()         { *m = ValsetCheckpointPacketData{} }
+func (m *ValsetCheckpointPacketData) String() string { return proto.CompactTextString(m) }
+func (*ValsetCheckpointPacketData) ProtoMessage()    {}
+func (*ValsetCheckpointPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{2}
+}
+func (m *ValsetCheckpointPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValsetCheckpointPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValsetCheckpointPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValsetCheckpointPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValsetCheckpointPacketData.Merge(m, src)
+}
+func (m *ValsetCheckpointPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValsetCheckpointPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValsetCheckpointPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValsetCheckpointPacketData proto.InternalMessageInfo
+
+func (m *ValsetCheckpointPacketData) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+func (m *ValsetCheckpointPacketData) GetValsetHash() []byte {
+	if m != nil {
+		return m.ValsetHash
+	}
+	return nil
+}
+
+// ConsumerUpgradePlan defines an upgrade of a consumer chain planned
+// by the owner of the consumer chain.
+type ConsumerUpgradePlan struct {
+	// the name of the upgrade
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the consumer chain height at which the consumer chain halts for the upgrade;
+	// zero if the upgrade is planned by time
+	HaltHeight int64 `protobuf:"varint,2,opt,name=halt_height,json=haltHeight,proto3" json:"halt_height,omitempty"`
+	// the time at which the consumer chain halts for the upgrade;
+	// zero if the upgrade is planned by height
+	HaltTime time.Time `protobuf:"bytes,3,opt,name=halt_time,json=haltTime,proto3,stdtime" json:"halt_time"`
+	// the SHA256 hash of the binary the consumer chain is upgraded to
+	BinaryHash []byte `protobuf:"bytes,4,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+}
+
+func (m *ConsumerUpgradePlan) Reset()         { *m = ConsumerUpgradePlan{} }
+func (m *ConsumerUpgradePlan) String() string { return proto.CompactTextString(m) }
+func (*ConsumerUpgradePlan) ProtoMessage()    {}
+func (*ConsumerUpgradePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{3}
+}
+func (m *ConsumerUpgradePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerUpgradePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerUpgradePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerUpgradePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerUpgradePlan.Merge(m, src)
+}
+func (m *ConsumerUpgradePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerUpgradePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerUpgradePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerUpgradePlan proto.InternalMessageInfo
+
+func (m *ConsumerUpgradePlan) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ConsumerUpgradePlan) GetHaltHeight() int64 {
+	if m != nil {
+		return m.HaltHeight
+	}
+	return 0
+}
+
+func (m *ConsumerUpgradePlan) GetHaltTime() time.Time {
+	if m != nil {
+		return m.HaltTime
+	}
+	return time.Time{}
+}
+
+func (m *ConsumerUpgradePlan) GetBinaryHash() []byte {
+	if m != nil {
+		return m.BinaryHash
+	}
+	return nil
+}
+
+// This packet is sent from the provider chain to the consumer chain
+// to notify it of an upgrade planned (or cancelled) by the owner of the consumer chain.
+type ConsumerUpgradePacketData struct {
+	UpgradePlan ConsumerUpgradePlan `protobuf:"bytes,1,opt,name=upgrade_plan,json=upgradePlan,proto3" json:"upgrade_plan"`
+	// true if the planned upgrade was cancelled
+	Cancelled bool `protobuf:"varint,2,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+}
+
+func (m *ConsumerUpgradePacketData) Reset()         { *m = ConsumerUpgradePacketData{} }
+func (m *ConsumerUpgradePacketData) String() string { return proto.CompactTextString(m) }
+func (*ConsumerUpgradePacketData) ProtoMessage()    {}
+func (*ConsumerUpgradePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{4}
+}
+func (m *ConsumerUpgradePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerUpgradePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerUpgradePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerUpgradePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerUpgradePacketData.Merge(m, src)
+}
+func (m *ConsumerUpgradePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerUpgradePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerUpgradePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerUpgradePacketData proto.InternalMessageInfo
+
+func (m *ConsumerUpgradePacketData) GetUpgradePlan() ConsumerUpgradePlan {
+	if m != nil {
+		return m.UpgradePlan
+	}
+	return ConsumerUpgradePlan{}
+}
+
+func (m *ConsumerUpgradePacketData) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+// DowntimeParams defines the downtime detection parameters of a consumer chain,
+// i.e., the parameters of its slashing module that determine when a validator is down.
+type DowntimeParams struct {
+	// the number of blocks over which the signatures of a validator are counted
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// the minimum fraction of blocks in the window that a validator must sign
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+}
+
+func (m *DowntimeParams) Reset()         { *m = DowntimeParams{} }
+func (m *DowntimeParams) String() string { return proto.CompactTextString(m) }
+func (*DowntimeParams) ProtoMessage()    {}
+func (*DowntimeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{5}
+}
+func (m *DowntimeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeParams.Merge(m, src)
+}
+func (m *DowntimeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeParams proto.InternalMessageInfo
+
+func (m *DowntimeParams) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+// This packet is sent from the provider chain to the consumer chain
+// to update the downtime detection parameters of the consumer chain.
+type DowntimeParamsPacketData struct {
+	DowntimeParams DowntimeParams `protobuf:"bytes,1,opt,name=downtime_params,json=downtimeParams,proto3" json:"downtime_params"`
+}
+
+func (m *DowntimeParamsPacketData) Reset()         { *m = DowntimeParamsPacketData{} }
+func (m *DowntimeParamsPacketData) String() string { return proto.CompactTextString(m) }
+func (*DowntimeParamsPacketData) ProtoMessage()    {}
+func (*DowntimeParamsPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+}
+func (m *DowntimeParamsPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowntimeParamsPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowntimeParamsPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowntimeParamsPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowntimeParamsPacketData.Merge(m, src)
+}
+func (m *DowntimeParamsPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowntimeParamsPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowntimeParamsPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowntimeParamsPacketData proto.InternalMessageInfo
+
+func (m *DowntimeParamsPacketData) GetDowntimeParams() DowntimeParams {
+	if m != nil {
+		return m.DowntimeParams
+	}
+	return DowntimeParams{}
+}
+
 // This packet is sent from the consumer chain to the provider chain
 // to notify that a VSC packet reached maturity on the consumer chain.
 type VSCMaturedPacketData struct {
@@ -169,7 +519,7 @@ func (m *VSCMaturedPacketData) Reset()         { *m = VSCMaturedPacketData{} }
 func (m *VSCMaturedPacketData) String() string { return proto.CompactTextString(m) }
 func (*VSCMaturedPacketData) ProtoMessage()    {}
 func (*VSCMaturedPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{1}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{7}
 }
 func (m *VSCMaturedPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -205,6 +555,10 @@ func (m *VSCMaturedPacketData) GetValsetUpdateId() uint64 {
 	return 0
 }
 
+func (*VSCMaturedPacketData) XXX_MessageName() string {
+	return "interchain_security.ccv.v1.VSCMaturedPacketData"
+}
+
 // This packet is sent from the consumer chain to the provider chain
 // to request the slashing of a validator as a result of an infraction
 // committed on the consumer chain.
@@ -220,7 +574,7 @@ func (m *SlashPacketData) Reset()         { *m = SlashPacketData{} }
 func (m *SlashPacketData) String() string { return proto.CompactTextString(m) }
 func (*SlashPacketData) ProtoMessage()    {}
 func (*SlashPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{2}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{8}
 }
 func (m *SlashPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,6 +624,10 @@ func (m *SlashPacketData) GetInfraction() types1.Infraction {
 	return types1.Infraction_INFRACTION_UNSPECIFIED
 }
 
+func (*SlashPacketData) XXX_MessageName() string {
+	return "interchain_security.ccv.v1.SlashPacketData"
+}
+
 // ConsumerPacketData contains a consumer packet data and a type tag
 type ConsumerPacketData struct {
 	Type ConsumerPacketDataType `protobuf:"varint,1,opt,name=type,proto3,enum=interchain_security.ccv.v1.ConsumerPacketDataType" json:"type,omitempty"`
@@ -283,7 +641,7 @@ func (m *ConsumerPacketData) Reset()         { *m = ConsumerPacketData{} }
 func (m *ConsumerPacketData) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketData) ProtoMessage()    {}
 func (*ConsumerPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{3}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{9}
 }
 func (m *ConsumerPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -364,17 +722,77 @@ func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ConsumerPacketDataV2 is an extensible envelope for consumer packet data.
+// The payload is a typed Any, which allows adding new packet types
+// without breaking wire compatibility. It is sent only to providers that
+// support the envelope, as negotiated during the CCV channel handshake.
+type ConsumerPacketDataV2 struct {
+	// the features the receiver must support in order to handle the packet
+	Features []string `protobuf:"bytes,1,rep,name=features,proto3" json:"features,omitempty"`
+	// the packet payload, e.g., SlashPacketData or VSCMaturedPacketData
+	Payload *types2.Any `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *ConsumerPacketDataV2) Reset()         { *m = ConsumerPacketDataV2{} }
+func (m *ConsumerPacketDataV2) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPacketDataV2) ProtoMessage()    {}
+func (*ConsumerPacketDataV2) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{10}
+}
+func (m *ConsumerPacketDataV2) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPacketDataV2) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerPacketDataV2.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerPacketDataV2) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPacketDataV2.Merge(m, src)
+}
+func (m *ConsumerPacketDataV2) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerPacketDataV2) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPacketDataV2.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerPacketDataV2 proto.InternalMessageInfo
+
+func (m *ConsumerPacketDataV2) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *ConsumerPacketDataV2) GetPayload() *types2.Any {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 // Note this type is used during IBC handshake methods for both the consumer and provider
 type HandshakeMetadata struct {
 	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
 	Version             string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// the features supported by the provider chain
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
 }
 
 func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
 func (m *HandshakeMetadata) String() string { return proto.CompactTextString(m) }
 func (*HandshakeMetadata) ProtoMessage()    {}
 func (*HandshakeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{4}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{11}
 }
 func (m *HandshakeMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +835,13 @@ func (m *HandshakeMetadata) GetVersion() string {
 	return ""
 }
 
+func (m *HandshakeMetadata) GetFeatures() []string {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
 // ConsumerPacketData contains a consumer packet data and a type tag
 // that is compatible with ICS v1 and v2 over the wire. It is not used for internal storage.
 type ConsumerPacketDataV1 struct {
@@ -431,7 +856,7 @@ func (m *ConsumerPacketDataV1) Reset()         { *m = ConsumerPacketDataV1{} }
 func (m *ConsumerPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketDataV1) ProtoMessage()    {}
 func (*ConsumerPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{5}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{12}
 }
 func (m *ConsumerPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +951,7 @@ func (m *SlashPacketDataV1) Reset()         { *m = SlashPacketDataV1{} }
 func (m *SlashPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*SlashPacketDataV1) ProtoMessage()    {}
 func (*SlashPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{13}
 }
 func (m *SlashPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -576,82 +1001,165 @@ func (m *SlashPacketDataV1) GetInfraction() InfractionType {
 	return InfractionEmpty
 }
 
-func init() {
-	proto.RegisterEnum("interchain_security.ccv.v1.ConsumerPacketDataType", ConsumerPacketDataType_name, ConsumerPacketDataType_value)
-	proto.RegisterEnum("interchain_security.ccv.v1.InfractionType", InfractionType_name, InfractionType_value)
-	proto.RegisterType((*ValidatorSetChangePacketData)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketData")
-	proto.RegisterType((*VSCMaturedPacketData)(nil), "interchain_security.ccv.v1.VSCMaturedPacketData")
-	proto.RegisterType((*SlashPacketData)(nil), "interchain_security.ccv.v1.SlashPacketData")
-	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
-	proto.RegisterType((*HandshakeMetadata)(nil), "interchain_security.ccv.v1.HandshakeMetadata")
-	proto.RegisterType((*ConsumerPacketDataV1)(nil), "interchain_security.ccv.v1.ConsumerPacketDataV1")
-	proto.RegisterType((*SlashPacketDataV1)(nil), "interchain_security.ccv.v1.SlashPacketDataV1")
+// This packet is sent from the provider chain to the consumer chain
+// to update the address of the provider fee pool, i.e., the account
+// on the provider chain that receives the ICS rewards of the consumer chain.
+type ProviderFeePoolAddrPacketData struct {
+	// the address of the provider fee pool
+	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/v1/wire.proto", fileDescriptor_8fd0dc67df6b10ed)
+func (m *ProviderFeePoolAddrPacketData) Reset()         { *m = ProviderFeePoolAddrPacketData{} }
+func (m *ProviderFeePoolAddrPacketData) String() string { return proto.CompactTextString(m) }
+func (*ProviderFeePoolAddrPacketData) ProtoMessage()    {}
+func (*ProviderFeePoolAddrPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{14}
 }
-
-var fileDescriptor_8fd0dc67df6b10ed = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x26, 0x65, 0x23, 0xad, 0x57, 0x85, 0x4c, 0x33, 0x6a, 0xc0, 0x32, 0xad, 0x42, 0x10, 0x2d,
-	0x20, 0xb8, 0x08, 0x59, 0xc9, 0x01, 0x0a, 0xb4, 0x97, 0xea, 0x87, 0xae, 0xd9, 0xc6, 0xb2, 0x40,
-	0x4a, 0x0a, 0xd2, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0x12, 0x97, 0xe0, 0xae, 0x98, 0xea, 0x0d,
-	0x0a, 0x9d, 0xfa, 0x02, 0x3a, 0x15, 0x3d, 0xe4, 0x31, 0x7a, 0xcb, 0x31, 0x40, 0x2f, 0xb9, 0x34,
-	0x28, 0xec, 0x37, 0xe8, 0x13, 0x14, 0xa4, 0x7e, 0x2d, 0xd1, 0x06, 0x02, 0x14, 0x68, 0x6e, 0xe4,
-	0xec, 0x7c, 0xdf, 0xce, 0x7c, 0xdf, 0x2c, 0x06, 0x7c, 0x81, 0x7d, 0x86, 0x42, 0xb7, 0x0f, 0xb1,
-	0xef, 0x50, 0xe4, 0x8e, 0x43, 0xcc, 0x26, 0xba, 0xeb, 0x46, 0x7a, 0x54, 0xd2, 0x5f, 0xe0, 0x10,
-	0x69, 0x41, 0x48, 0x18, 0x11, 0xe5, 0x94, 0x34, 0xcd, 0x75, 0x23, 0x2d, 0x2a, 0xc9, 0x9f, 0xbb,
-	0x84, 0x8e, 0x08, 0xd5, 0x29, 0x83, 0x03, 0xec, 0xf7, 0xf4, 0xa8, 0xd4, 0x45, 0x0c, 0x96, 0x96,
-	0xff, 0x73, 0x06, 0x39, 0xdf, 0x23, 0x3d, 0x92, 0x7c, 0xea, 0xf1, 0xd7, 0x22, 0xfa, 0x90, 0x21,
-	0xdf, 0x43, 0xe1, 0x08, 0xfb, 0x4c, 0x87, 0x5d, 0x17, 0xeb, 0x6c, 0x12, 0x20, 0x3a, 0x3f, 0x54,
-	0xdf, 0xf0, 0xe0, 0xd3, 0x0e, 0x1c, 0x62, 0x0f, 0x32, 0x12, 0xda, 0x88, 0xd5, 0xfa, 0xd0, 0xef,
-	0xa1, 0x26, 0x74, 0x07, 0x88, 0xd5, 0x21, 0x83, 0x22, 0x01, 0x47, 0xd1, 0xf2, 0xdc, 0x19, 0x07,
-	0x1e, 0x64, 0x88, 0x4a, 0xbc, 0xb2, 0x57, 0xcc, 0x96, 0x15, 0x6d, 0xcd, 0xac, 0xc5, 0xcc, 0xda,
-	0x8a, 0xa9, 0x9d, 0x24, 0x56, 0x95, 0x57, 0x6f, 0x1f, 0x71, 0xff, 0xbc, 0x7d, 0x24, 0x4d, 0xe0,
-	0x68, 0xf8, 0x8d, 0xba, 0x43, 0xa4, 0x5a, 0x42, 0x74, 0x13, 0x42, 0xc5, 0x22, 0x88, 0x63, 0x14,
-	0xb1, 0x45, 0x92, 0x83, 0x3d, 0x29, 0xa3, 0xf0, 0xc5, 0x7d, 0x2b, 0x37, 0x8f, 0xcf, 0x13, 0x4d,
-	0x4f, 0xfc, 0x0c, 0x00, 0x3a, 0x84, 0xb4, 0xef, 0x40, 0x77, 0x40, 0xa5, 0x3d, 0x65, 0xaf, 0x78,
-	0x60, 0x1d, 0x24, 0x91, 0x8a, 0x3b, 0xa0, 0xea, 0x77, 0x20, 0xdf, 0xb1, 0x6b, 0xe7, 0x90, 0x8d,
-	0x43, 0xe4, 0x6d, 0x74, 0x94, 0x76, 0x01, 0x9f, 0x76, 0x81, 0xfa, 0x27, 0x0f, 0x0e, 0xed, 0x98,
-	0x6f, 0x03, 0x6d, 0x81, 0x83, 0x55, 0xc9, 0x09, 0x2c, 0x5b, 0x96, 0x6f, 0xd7, 0xa1, 0x2a, 0x2d,
-	0x14, 0x10, 0xb6, 0x14, 0x50, 0xad, 0x35, 0xcd, 0x3b, 0xb4, 0x5c, 0x05, 0x00, 0xfb, 0x97, 0x21,
-	0x74, 0x19, 0x26, 0xbe, 0xb4, 0xa7, 0xf0, 0xc5, 0x5c, 0x59, 0xd5, 0xe6, 0xc3, 0xa1, 0x2d, 0x87,
-	0x61, 0x31, 0x1c, 0x9a, 0xb9, 0xca, 0xb4, 0x36, 0x50, 0xea, 0xef, 0x19, 0x20, 0xd6, 0x88, 0x4f,
-	0xc7, 0x23, 0x14, 0x6e, 0x34, 0x76, 0x0a, 0xf6, 0xe3, 0xc1, 0x48, 0x7a, 0xca, 0x95, 0xcb, 0xda,
-	0xed, 0xd3, 0xa8, 0xed, 0xa2, 0x5b, 0x93, 0x00, 0x59, 0x09, 0x5e, 0x7c, 0x06, 0x0e, 0xe9, 0x4d,
-	0xcd, 0x92, 0x5e, 0xb2, 0xe5, 0x2f, 0xef, 0xa2, 0xdc, 0x92, 0xf9, 0x8c, 0xb3, 0xb6, 0x59, 0xc4,
-	0x4b, 0x90, 0x8f, 0xa8, 0xbb, 0xe3, 0x67, 0xa2, 0x42, 0xb6, 0xfc, 0xd5, 0x5d, 0xec, 0x69, 0x73,
-	0x70, 0xc6, 0x59, 0xa9, 0x7c, 0xd5, 0x7b, 0x60, 0xdf, 0x83, 0x0c, 0xaa, 0x5d, 0x70, 0x74, 0x06,
-	0x7d, 0x8f, 0xf6, 0xe1, 0x00, 0x9d, 0x23, 0x06, 0xe3, 0xa0, 0x78, 0x02, 0x1e, 0x04, 0x21, 0x89,
-	0xb0, 0x87, 0x42, 0xe7, 0x12, 0x21, 0x27, 0x20, 0x64, 0xe8, 0x40, 0xcf, 0x9b, 0xcf, 0xc2, 0x81,
-	0x75, 0x7f, 0x79, 0x7a, 0x8a, 0x50, 0x93, 0x90, 0x61, 0xc5, 0xf3, 0x42, 0x51, 0x02, 0x1f, 0x44,
-	0x28, 0xa4, 0xb1, 0x65, 0x99, 0x24, 0x6b, 0xf9, 0xab, 0xbe, 0xcc, 0x80, 0xfc, 0xae, 0x9a, 0x9d,
-	0xd2, 0x7f, 0xe6, 0xc6, 0xf3, 0xdb, 0xdc, 0x78, 0xfc, 0x0e, 0x6e, 0x74, 0x4a, 0xef, 0x83, 0x1f,
-	0x7f, 0xf1, 0xe0, 0x68, 0xa7, 0xb0, 0xff, 0xf9, 0x3d, 0xfe, 0x90, 0xf2, 0x1e, 0x8f, 0xef, 0xea,
-	0x7c, 0xfd, 0x26, 0x13, 0x93, 0x36, 0xd0, 0xc7, 0x7f, 0xf0, 0xe0, 0x41, 0xba, 0x97, 0xe2, 0xb7,
-	0x40, 0xa9, 0x5d, 0x34, 0xec, 0xf6, 0xb9, 0x61, 0x39, 0xcd, 0x4a, 0xed, 0x47, 0xa3, 0xe5, 0xb4,
-	0x9e, 0x37, 0x0d, 0xa7, 0xdd, 0xb0, 0x9b, 0x46, 0xcd, 0x3c, 0x35, 0x8d, 0xba, 0xc0, 0xc9, 0x1f,
-	0x4f, 0x67, 0xca, 0x51, 0xdb, 0xa7, 0x01, 0x72, 0xf1, 0x25, 0x5e, 0x6a, 0x28, 0xea, 0x40, 0x4e,
-	0x05, 0xdb, 0x4f, 0x2b, 0xf6, 0x99, 0xc0, 0xcb, 0x87, 0xd3, 0x99, 0x92, 0xdd, 0x10, 0x56, 0x3c,
-	0x01, 0x9f, 0xa4, 0x02, 0x62, 0xd7, 0x84, 0x8c, 0x9c, 0x9f, 0xce, 0x14, 0xa1, 0xb3, 0xe5, 0x94,
-	0xbc, 0xff, 0xcb, 0x6f, 0x05, 0xee, 0xf8, 0x25, 0x0f, 0x72, 0x37, 0x5b, 0x14, 0x9f, 0x80, 0x87,
-	0x66, 0xe3, 0xd4, 0xaa, 0xd4, 0x5a, 0xe6, 0x45, 0x23, 0xad, 0xec, 0xfb, 0xd3, 0x99, 0x72, 0xb8,
-	0x06, 0x19, 0xa3, 0x80, 0x4d, 0x44, 0x7d, 0x17, 0x55, 0xbf, 0x68, 0x57, 0x9f, 0x1a, 0x8e, 0x6d,
-	0x7e, 0xdf, 0x10, 0x78, 0x39, 0x37, 0x9d, 0x29, 0xa0, 0x4e, 0xc6, 0xdd, 0x21, 0xb2, 0x71, 0xcf,
-	0x17, 0x8f, 0x81, 0xb4, 0x0b, 0x78, 0xd6, 0x68, 0x99, 0xe7, 0x86, 0x90, 0x91, 0x3f, 0x9a, 0xce,
-	0x94, 0x0f, 0xeb, 0xe4, 0x85, 0xcf, 0xf0, 0x08, 0xcd, 0x6b, 0xad, 0x36, 0x5e, 0x5d, 0x15, 0xf8,
-	0xd7, 0x57, 0x05, 0xfe, 0xef, 0xab, 0x02, 0xff, 0xeb, 0x75, 0x81, 0x7b, 0x7d, 0x5d, 0xe0, 0xde,
-	0x5c, 0x17, 0xb8, 0x9f, 0x9e, 0xf4, 0x30, 0xeb, 0x8f, 0xbb, 0x9a, 0x4b, 0x46, 0xfa, 0x62, 0xf1,
-	0xae, 0x2d, 0x7d, 0xbc, 0x5a, 0xe1, 0xd1, 0xd7, 0xfa, 0xcf, 0xc9, 0x1e, 0x4f, 0x16, 0x6a, 0xf7,
-	0x5e, 0xb2, 0x51, 0x4f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xac, 0x81, 0x69, 0x45, 0xef, 0x07,
-	0x00, 0x00,
+func (m *ProviderFeePoolAddrPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+func (m *ProviderFeePoolAddrPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderFeePoolAddrPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderFeePoolAddrPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderFeePoolAddrPacketData.Merge(m, src)
+}
+func (m *ProviderFeePoolAddrPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderFeePoolAddrPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderFeePoolAddrPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderFeePoolAddrPacketData proto.InternalMessageInfo
+
+func (m *ProviderFeePoolAddrPacketData) GetProviderFeePoolAddr() string {
+	if m != nil {
+		return m.ProviderFeePoolAddr
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.v1.ConsumerPacketDataType", ConsumerPacketDataType_name, ConsumerPacketDataType_value)
+	proto.RegisterEnum("interchain_security.ccv.v1.InfractionType", InfractionType_name, InfractionType_value)
+	proto.RegisterType((*ValidatorSetChangePacketData)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketData")
+	proto.RegisterType((*ValidatorSetChangePacketAck)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketAck")
+	proto.RegisterType((*ValsetCheckpointPacketData)(nil), "interchain_security.ccv.v1.ValsetCheckpointPacketData")
+	proto.RegisterType((*ConsumerUpgradePlan)(nil), "interchain_security.ccv.v1.ConsumerUpgradePlan")
+	proto.RegisterType((*ConsumerUpgradePacketData)(nil), "interchain_security.ccv.v1.ConsumerUpgradePacketData")
+	proto.RegisterType((*DowntimeParams)(nil), "interchain_security.ccv.v1.DowntimeParams")
+	proto.RegisterType((*DowntimeParamsPacketData)(nil), "interchain_security.ccv.v1.DowntimeParamsPacketData")
+	proto.RegisterType((*VSCMaturedPacketData)(nil), "interchain_security.ccv.v1.VSCMaturedPacketData")
+	proto.RegisterType((*SlashPacketData)(nil), "interchain_security.ccv.v1.SlashPacketData")
+	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
+	proto.RegisterType((*ConsumerPacketDataV2)(nil), "interchain_security.ccv.v1.ConsumerPacketDataV2")
+	proto.RegisterType((*HandshakeMetadata)(nil), "interchain_security.ccv.v1.HandshakeMetadata")
+	proto.RegisterType((*ConsumerPacketDataV1)(nil), "interchain_security.ccv.v1.ConsumerPacketDataV1")
+	proto.RegisterType((*SlashPacketDataV1)(nil), "interchain_security.ccv.v1.SlashPacketDataV1")
+	proto.RegisterType((*ProviderFeePoolAddrPacketData)(nil), "interchain_security.ccv.v1.ProviderFeePoolAddrPacketData")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/v1/wire.proto", fileDescriptor_8fd0dc67df6b10ed)
+}
+
+var fileDescriptor_8fd0dc67df6b10ed = []byte{
+	// 1269 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x3d, 0x6f, 0xdb, 0x56,
+	0x17, 0x16, 0x2d, 0x21, 0xb1, 0x8e, 0x02, 0x59, 0x66, 0x94, 0x40, 0xa1, 0x13, 0x89, 0x20, 0xde,
+	0x17, 0x30, 0x5c, 0x98, 0x8c, 0x94, 0x00, 0x05, 0xd2, 0x49, 0x5f, 0xae, 0xd5, 0xc6, 0x8a, 0x40,
+	0xc9, 0x4e, 0xd3, 0x85, 0xb8, 0x22, 0xaf, 0x45, 0x42, 0xfc, 0x02, 0x79, 0x25, 0x57, 0x4b, 0xe7,
+	0xc0, 0x53, 0x80, 0x2e, 0x5d, 0xbc, 0xb4, 0x28, 0xd0, 0x2c, 0x9d, 0xf2, 0x07, 0xba, 0x05, 0x9d,
+	0x82, 0x4e, 0x69, 0x81, 0xa6, 0x45, 0xf2, 0x0f, 0xfa, 0x0b, 0x0a, 0x5e, 0x92, 0x96, 0x64, 0xc9,
+	0x46, 0x12, 0x04, 0x68, 0x37, 0xde, 0xf3, 0xf1, 0xdc, 0xe7, 0x9c, 0xfb, 0xdc, 0x43, 0x12, 0xfe,
+	0x6f, 0xd8, 0x04, 0x7b, 0xaa, 0x8e, 0x0c, 0x5b, 0xf1, 0xb1, 0x3a, 0xf2, 0x0c, 0x32, 0x91, 0x54,
+	0x75, 0x2c, 0x8d, 0xcb, 0xd2, 0x91, 0xe1, 0x61, 0xd1, 0xf5, 0x1c, 0xe2, 0xb0, 0xdc, 0x92, 0x30,
+	0x51, 0x55, 0xc7, 0xe2, 0xb8, 0xcc, 0xfd, 0x4f, 0x75, 0x7c, 0xcb, 0xf1, 0x25, 0x9f, 0xa0, 0xa1,
+	0x61, 0x0f, 0xa4, 0x71, 0xb9, 0x8f, 0x09, 0x2a, 0xc7, 0xeb, 0x10, 0x81, 0xbb, 0x11, 0x46, 0x29,
+	0x74, 0x25, 0x85, 0x8b, 0xc8, 0x95, 0x1f, 0x38, 0x03, 0x27, 0xb4, 0x07, 0x4f, 0x71, 0xc2, 0xc0,
+	0x71, 0x06, 0x26, 0x96, 0xe8, 0xaa, 0x3f, 0x3a, 0x94, 0x90, 0x3d, 0x89, 0x5c, 0xa5, 0xb3, 0x2e,
+	0x62, 0x58, 0xd8, 0x27, 0xc8, 0x72, 0xa3, 0x80, 0x0d, 0x82, 0x6d, 0x0d, 0x7b, 0x96, 0x61, 0x13,
+	0x09, 0xf5, 0x55, 0x43, 0x22, 0x13, 0x17, 0x47, 0xdb, 0x09, 0x2f, 0x19, 0xb8, 0x79, 0x80, 0x4c,
+	0x43, 0x43, 0xc4, 0xf1, 0xba, 0x98, 0xd4, 0x75, 0x64, 0x0f, 0x70, 0x07, 0xa9, 0x43, 0x4c, 0x1a,
+	0x88, 0x20, 0xd6, 0x81, 0xf5, 0x71, 0xec, 0x57, 0x46, 0xae, 0x86, 0x08, 0xf6, 0x0b, 0x0c, 0x9f,
+	0xdc, 0xcc, 0x54, 0x78, 0x71, 0x8a, 0x2c, 0x06, 0xc8, 0xe2, 0x29, 0xd2, 0x3e, 0x0d, 0xac, 0xf1,
+	0xcf, 0x5f, 0x95, 0x12, 0x7f, 0xbf, 0x2a, 0x15, 0x26, 0xc8, 0x32, 0xef, 0x09, 0x0b, 0x40, 0x82,
+	0x9c, 0x1b, 0xcf, 0xa7, 0xf8, 0xec, 0x26, 0x04, 0x36, 0x1f, 0x93, 0x28, 0x48, 0x31, 0xb4, 0xc2,
+	0x0a, 0xcf, 0x6c, 0xa6, 0xe4, 0x6c, 0x68, 0x0f, 0x03, 0x5b, 0x1a, 0x7b, 0x0b, 0xc0, 0x37, 0x91,
+	0xaf, 0x2b, 0x48, 0x1d, 0xfa, 0x85, 0x24, 0x9f, 0xdc, 0x4c, 0xcb, 0x69, 0x6a, 0xa9, 0xaa, 0x43,
+	0x5f, 0xd0, 0x61, 0xe3, 0xbc, 0xca, 0xaa, 0xea, 0x70, 0xe9, 0x3e, 0xcc, 0xd2, 0x7d, 0x4a, 0x90,
+	0x89, 0x22, 0x75, 0xe4, 0xeb, 0x94, 0xcc, 0x15, 0x19, 0x42, 0xd3, 0x2e, 0xf2, 0x75, 0x61, 0x00,
+	0xdc, 0x01, 0x5d, 0xd5, 0x75, 0xac, 0x0e, 0x5d, 0xc7, 0xb0, 0xc9, 0x4c, 0x07, 0x3f, 0xe0, 0x46,
+	0x3f, 0x31, 0x70, 0xb5, 0xee, 0xd8, 0xfe, 0xc8, 0xc2, 0xde, 0xbe, 0x3b, 0xf0, 0x90, 0x86, 0x3b,
+	0x26, 0xb2, 0x59, 0x16, 0x52, 0x36, 0xb2, 0x30, 0x85, 0x4d, 0xcb, 0xf4, 0x39, 0x00, 0xd3, 0x91,
+	0x49, 0x14, 0x1d, 0x1b, 0x03, 0x9d, 0x50, 0xb0, 0xa4, 0x0c, 0x81, 0x69, 0x97, 0x5a, 0xd8, 0x2a,
+	0xa4, 0x69, 0x40, 0xa0, 0x97, 0x42, 0x92, 0x67, 0x36, 0x33, 0x15, 0x4e, 0x0c, 0xc5, 0x24, 0xc6,
+	0x62, 0x12, 0x7b, 0xb1, 0x98, 0x6a, 0xab, 0xc1, 0x59, 0x3e, 0xf9, 0xb3, 0xc4, 0xc8, 0xab, 0x41,
+	0x5a, 0xe0, 0x08, 0xf6, 0xe8, 0x1b, 0x36, 0xf2, 0x26, 0x21, 0xe1, 0x54, 0x48, 0x38, 0x34, 0x51,
+	0xc2, 0xdf, 0x30, 0x70, 0xe3, 0x2c, 0xe1, 0x69, 0x67, 0xbe, 0x80, 0x2b, 0xa3, 0xd0, 0xa8, 0xb8,
+	0x26, 0xb2, 0x29, 0xfd, 0x4c, 0x45, 0x12, 0xcf, 0xbf, 0x5f, 0xe2, 0x92, 0xea, 0x6b, 0xa9, 0x80,
+	0x99, 0x9c, 0x19, 0xcd, 0x34, 0xe4, 0x26, 0xa4, 0x55, 0x64, 0xab, 0xd8, 0x34, 0x71, 0xa8, 0x9e,
+	0x55, 0x79, 0x6a, 0x10, 0x7e, 0x64, 0x20, 0xdb, 0x70, 0x8e, 0xec, 0xa0, 0xf2, 0x0e, 0xf2, 0x90,
+	0xe5, 0xb3, 0xb7, 0x21, 0xef, 0x1b, 0x03, 0x1b, 0x6b, 0x4a, 0xdf, 0x74, 0xd4, 0xa1, 0xaf, 0x1c,
+	0x19, 0xb6, 0xe6, 0x1c, 0x51, 0x4a, 0x49, 0x99, 0x0d, 0x7d, 0x35, 0xea, 0x7a, 0x48, 0x3d, 0xac,
+	0x06, 0xd7, 0xac, 0x80, 0x60, 0x98, 0xe5, 0x62, 0x2f, 0x4e, 0x09, 0xb6, 0x4b, 0xd7, 0xca, 0x01,
+	0xa9, 0xdf, 0x5f, 0x95, 0x36, 0xc2, 0xdb, 0xed, 0x6b, 0x43, 0xd1, 0x70, 0x24, 0x0b, 0x11, 0x5d,
+	0xbc, 0x8f, 0x07, 0x48, 0x9d, 0x34, 0xb0, 0xfa, 0xeb, 0xb3, 0x6d, 0x88, 0x2e, 0x7f, 0x03, 0xab,
+	0x32, 0x6b, 0x19, 0x76, 0x97, 0xc2, 0x75, 0xb0, 0x17, 0xee, 0x22, 0x8c, 0xa0, 0x30, 0xcf, 0x74,
+	0xa6, 0x7d, 0x8f, 0x60, 0x4d, 0x8b, 0x7c, 0x8a, 0x4b, 0x9d, 0x51, 0x07, 0xb7, 0x2e, 0xea, 0xe0,
+	0x3c, 0x5c, 0xd4, 0xbc, 0xac, 0x36, 0x67, 0x15, 0x76, 0x20, 0x7f, 0xd0, 0xad, 0xef, 0x21, 0x32,
+	0xf2, 0xb0, 0xf6, 0x3e, 0x5a, 0xbe, 0x97, 0x7a, 0xfc, 0x5d, 0x89, 0x11, 0x7e, 0x63, 0x60, 0xad,
+	0x1b, 0xdc, 0xc8, 0x19, 0x0c, 0x19, 0xd2, 0xa7, 0x97, 0x3e, 0x22, 0xcc, 0x9d, 0x3f, 0x49, 0x6a,
+	0x85, 0x68, 0x86, 0xe4, 0xce, 0xcc, 0x10, 0x41, 0x9e, 0xc2, 0xbc, 0xc3, 0xd0, 0xa8, 0x01, 0x18,
+	0xf6, 0xa1, 0x87, 0x54, 0x62, 0x38, 0x36, 0x95, 0x7d, 0xb6, 0x22, 0x88, 0xd1, 0x29, 0xc4, 0x53,
+	0x3a, 0x9a, 0xda, 0x62, 0xeb, 0x34, 0x52, 0x9e, 0xc9, 0x8a, 0x6a, 0xfb, 0x61, 0x05, 0xd8, 0x58,
+	0x8e, 0x33, 0xe5, 0xed, 0x40, 0x2a, 0x18, 0xb0, 0xb4, 0xb2, 0x6c, 0xa5, 0xf2, 0x36, 0x62, 0x9e,
+	0x66, 0xf7, 0x26, 0x2e, 0x96, 0x69, 0x3e, 0xfb, 0x10, 0xd6, 0xfc, 0xf9, 0xce, 0xd1, 0x8a, 0x32,
+	0x95, 0x8f, 0x2e, 0x82, 0x3c, 0xd3, 0xec, 0xdd, 0x84, 0x7c, 0x16, 0x85, 0x3d, 0x84, 0xfc, 0xd8,
+	0x57, 0x17, 0xce, 0x36, 0x1a, 0x01, 0xb7, 0x2f, 0x42, 0x5f, 0xa6, 0x89, 0xdd, 0x84, 0xbc, 0x14,
+	0xaf, 0x76, 0x09, 0x52, 0x1a, 0x22, 0x48, 0xf8, 0x96, 0x81, 0xfc, 0x62, 0xa5, 0x07, 0x15, 0x96,
+	0x83, 0xd5, 0x43, 0x4c, 0xd3, 0xc2, 0x37, 0x4a, 0x5a, 0x3e, 0x5d, 0xb3, 0x1a, 0x5c, 0x76, 0xd1,
+	0xc4, 0x74, 0x90, 0x16, 0x55, 0x9d, 0x5f, 0x18, 0x4d, 0x55, 0x7b, 0x52, 0xbb, 0xfb, 0xcb, 0xb3,
+	0xed, 0xdb, 0x6f, 0xdd, 0xe1, 0x4e, 0x88, 0x28, 0xc7, 0xd0, 0xc2, 0xd7, 0xb0, 0xbe, 0x8b, 0x6c,
+	0xcd, 0xd7, 0xd1, 0x10, 0xef, 0x61, 0x82, 0x02, 0xbe, 0xec, 0x1d, 0xb8, 0xee, 0x7a, 0xce, 0xd8,
+	0xd0, 0xb0, 0xa7, 0x1c, 0x62, 0xac, 0xb8, 0x8e, 0x63, 0x2a, 0x48, 0xd3, 0xbc, 0x68, 0xbc, 0x5e,
+	0x8d, 0xbd, 0x3b, 0x18, 0x77, 0x1c, 0xc7, 0xac, 0x6a, 0x9a, 0xc7, 0x16, 0xe0, 0xf2, 0x18, 0x7b,
+	0x7e, 0xa0, 0x29, 0x7a, 0xff, 0xe5, 0x78, 0x39, 0x57, 0x65, 0x72, 0xbe, 0x4a, 0xe1, 0xe9, 0xca,
+	0xd2, 0xd6, 0x94, 0x3f, 0x98, 0x88, 0x1e, 0x9d, 0x27, 0xa2, 0xed, 0x77, 0x10, 0xd1, 0x41, 0xf9,
+	0xbf, 0x20, 0xa3, 0x3f, 0x18, 0x58, 0x5f, 0x20, 0xf6, 0x2f, 0x0f, 0x93, 0xcf, 0x96, 0x0c, 0x93,
+	0x0b, 0x87, 0xef, 0x74, 0xa0, 0xd0, 0x43, 0x9a, 0xc9, 0x16, 0x7a, 0x70, 0xab, 0xb3, 0x28, 0xac,
+	0x99, 0x86, 0xbf, 0x8f, 0x2e, 0xb7, 0x7e, 0x66, 0xe0, 0xfa, 0x72, 0x85, 0xb0, 0x9f, 0x00, 0x5f,
+	0x7f, 0xd0, 0xee, 0xee, 0xef, 0x35, 0x65, 0xa5, 0x53, 0xad, 0x7f, 0xde, 0xec, 0x29, 0xbd, 0x47,
+	0x9d, 0xa6, 0xb2, 0xdf, 0xee, 0x76, 0x9a, 0xf5, 0xd6, 0x4e, 0xab, 0xd9, 0xc8, 0x25, 0xb8, 0x6b,
+	0xc7, 0x27, 0xfc, 0xfa, 0xbe, 0xed, 0xbb, 0x58, 0x35, 0x0e, 0x8d, 0xf8, 0x64, 0x58, 0x09, 0xb8,
+	0xa5, 0xc9, 0xdd, 0xfb, 0xd5, 0xee, 0x6e, 0x8e, 0xe1, 0xd6, 0x8e, 0x4f, 0xf8, 0xcc, 0xcc, 0x71,
+	0xb1, 0x77, 0xe0, 0xc6, 0xd2, 0x84, 0x40, 0x0b, 0xb9, 0x15, 0x2e, 0x7f, 0x7c, 0xc2, 0xe7, 0x0e,
+	0xce, 0x9c, 0x3f, 0x97, 0x7a, 0xfc, 0x7d, 0x31, 0xb1, 0xf5, 0x94, 0x81, 0xec, 0x7c, 0xe3, 0xd8,
+	0xbb, 0xb0, 0xd1, 0x6a, 0xef, 0xc8, 0xd5, 0x7a, 0xaf, 0xf5, 0xa0, 0xbd, 0x8c, 0xf6, 0xd5, 0xe3,
+	0x13, 0x7e, 0x6d, 0x9a, 0xd4, 0xb4, 0x5c, 0x32, 0x61, 0xa5, 0xc5, 0xac, 0xc6, 0x83, 0xfd, 0xda,
+	0xfd, 0xa6, 0xd2, 0x6d, 0x7d, 0xda, 0xce, 0x31, 0x5c, 0xf6, 0xf8, 0x84, 0x87, 0x86, 0x33, 0xea,
+	0x9b, 0x38, 0x78, 0x11, 0xb3, 0x5b, 0x50, 0x58, 0x4c, 0x78, 0xd8, 0xee, 0xb5, 0xf6, 0x9a, 0xb9,
+	0x15, 0xee, 0xca, 0xf1, 0x09, 0xbf, 0x1a, 0xbf, 0x4e, 0x43, 0xae, 0xb5, 0xf6, 0xf3, 0xd7, 0x45,
+	0xe6, 0xc5, 0xeb, 0x22, 0xf3, 0xd7, 0xeb, 0x22, 0xf3, 0xe4, 0x4d, 0x31, 0xf1, 0xe2, 0x4d, 0x31,
+	0xf1, 0xf2, 0x4d, 0x31, 0xf1, 0xe5, 0xdd, 0x81, 0x41, 0xf4, 0x51, 0x5f, 0x54, 0x1d, 0x2b, 0xfa,
+	0xe2, 0x97, 0xa6, 0x42, 0xd9, 0x3e, 0xfd, 0xdd, 0x18, 0x7f, 0x2c, 0x7d, 0x45, 0xff, 0x39, 0xe8,
+	0x57, 0x7a, 0xff, 0x12, 0x1d, 0x77, 0x77, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x10, 0x0c, 0x65,
+	0xac, 0x9b, 0x0c, 0x00, 0x00,
+}
+
+func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -700,7 +1208,7 @@ func (m *ValidatorSetChangePacketData) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *VSCMaturedPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorSetChangePacketAck) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -710,16 +1218,23 @@ func (m *VSCMaturedPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VSCMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorSetChangePacketAck) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VSCMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValidatorSetChangePacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ValsetHash) > 0 {
+		i -= len(m.ValsetHash)
+		copy(dAtA[i:], m.ValsetHash)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ValsetHash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.ValsetUpdateId != 0 {
 		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
@@ -728,7 +1243,7 @@ func (m *VSCMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SlashPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ValsetCheckpointPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -738,28 +1253,113 @@ func (m *SlashPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValsetCheckpointPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ValsetCheckpointPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Infraction != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.Infraction))
+	if len(m.ValsetHash) > 0 {
+		i -= len(m.ValsetHash)
+		copy(dAtA[i:], m.ValsetHash)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ValsetHash)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
 	if m.ValsetUpdateId != 0 {
 		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerUpgradePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerUpgradePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerUpgradePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.HaltTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.HaltTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintWire(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.HaltHeight != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.HaltHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerUpgradePacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerUpgradePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerUpgradePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cancelled {
+		i--
+		if m.Cancelled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
 		dAtA[i] = 0x10
 	}
 	{
-		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.UpgradePlan.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -771,7 +1371,7 @@ func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
+func (m *DowntimeParams) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -781,76 +1381,96 @@ func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *DowntimeParams) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DowntimeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintWire(dAtA, i, uint64(size))
 	}
-	if m.Type != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+	i--
+	dAtA[i] = 0x12
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.SignedBlocksWindow))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketData_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *DowntimeParamsPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowntimeParamsPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DowntimeParamsPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SlashPacketData != nil {
-		{
-			size, err := m.SlashPacketData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWire(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DowntimeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x12
+		i -= size
+		i = encodeVarintWire(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
-func (m *ConsumerPacketData_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *VSCMaturedPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VSCMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *VSCMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.VscMaturedPacketData != nil {
-		{
-			size, err := m.VscMaturedPacketData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWire(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
+
+func (m *SlashPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -860,34 +1480,40 @@ func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintWire(dAtA, i, uint64(len(m.Version)))
+	if m.Infraction != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Infraction))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.ProviderFeePoolAddr) > 0 {
-		i -= len(m.ProviderFeePoolAddr)
-		copy(dAtA[i:], m.ProviderFeePoolAddr)
-		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Validator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintWire(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketDataV1) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -897,12 +1523,12 @@ func (m *ConsumerPacketDataV1) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -924,12 +1550,12 @@ func (m *ConsumerPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketDataV1_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketDataV1_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.SlashPacketData != nil {
 		{
@@ -945,12 +1571,12 @@ func (m *ConsumerPacketDataV1_SlashPacketData) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.VscMaturedPacketData != nil {
 		{
@@ -966,7 +1592,7 @@ func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SlashPacketDataV1) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerPacketDataV2) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -976,24 +1602,193 @@ func (m *SlashPacketDataV1) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlashPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketDataV2) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlashPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketDataV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Infraction != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.Infraction))
+	if m.Payload != nil {
+		{
+			size, err := m.Payload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.ValsetUpdateId != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
-		i--
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintWire(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for iNdEx := len(m.Features) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Features[iNdEx])
+			copy(dAtA[i:], m.Features[iNdEx])
+			i = encodeVarintWire(dAtA, i, uint64(len(m.Features[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketDataV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketDataV1_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketDataV1_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SlashPacketData != nil {
+		{
+			size, err := m.SlashPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VscMaturedPacketData != nil {
+		{
+			size, err := m.VscMaturedPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SlashPacketDataV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Infraction != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Infraction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
 		dAtA[i] = 0x10
 	}
 	{
@@ -1009,6 +1804,36 @@ func (m *SlashPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProviderFeePoolAddrPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderFeePoolAddrPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderFeePoolAddrPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintWire(dAtA []byte, offset int, v uint64) int {
 	offset -= sovWire(v)
 	base := offset
@@ -1044,7 +1869,7 @@ func (m *ValidatorSetChangePacketData) Size() (n int) {
 	return n
 }
 
-func (m *VSCMaturedPacketData) Size() (n int) {
+func (m *ValidatorSetChangePacketAck) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1053,83 +1878,120 @@ func (m *VSCMaturedPacketData) Size() (n int) {
 	if m.ValsetUpdateId != 0 {
 		n += 1 + sovWire(uint64(m.ValsetUpdateId))
 	}
+	l = len(m.ValsetHash)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
 	return n
 }
 
-func (m *SlashPacketData) Size() (n int) {
+func (m *ValsetCheckpointPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovWire(uint64(l))
 	if m.ValsetUpdateId != 0 {
 		n += 1 + sovWire(uint64(m.ValsetUpdateId))
 	}
-	if m.Infraction != 0 {
-		n += 1 + sovWire(uint64(m.Infraction))
+	l = len(m.ValsetHash)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
 	}
 	return n
 }
 
-func (m *ConsumerPacketData) Size() (n int) {
+func (m *ConsumerUpgradePlan) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovWire(uint64(m.Type))
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
 	}
-	if m.Data != nil {
-		n += m.Data.Size()
+	if m.HaltHeight != 0 {
+		n += 1 + sovWire(uint64(m.HaltHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.HaltTime)
+	n += 1 + l + sovWire(uint64(l))
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
 	}
 	return n
 }
 
-func (m *ConsumerPacketData_SlashPacketData) Size() (n int) {
+func (m *ConsumerUpgradePacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SlashPacketData != nil {
-		l = m.SlashPacketData.Size()
-		n += 1 + l + sovWire(uint64(l))
+	l = m.UpgradePlan.Size()
+	n += 1 + l + sovWire(uint64(l))
+	if m.Cancelled {
+		n += 2
 	}
 	return n
 }
-func (m *ConsumerPacketData_VscMaturedPacketData) Size() (n int) {
+
+func (m *DowntimeParams) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.VscMaturedPacketData != nil {
-		l = m.VscMaturedPacketData.Size()
-		n += 1 + l + sovWire(uint64(l))
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovWire(uint64(m.SignedBlocksWindow))
 	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovWire(uint64(l))
 	return n
 }
-func (m *HandshakeMetadata) Size() (n int) {
+
+func (m *DowntimeParamsPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ProviderFeePoolAddr)
-	if l > 0 {
-		n += 1 + l + sovWire(uint64(l))
+	l = m.DowntimeParams.Size()
+	n += 1 + l + sovWire(uint64(l))
+	return n
+}
+
+func (m *VSCMaturedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Version)
-	if l > 0 {
-		n += 1 + l + sovWire(uint64(l))
+	var l int
+	_ = l
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
 	}
 	return n
 }
 
-func (m *ConsumerPacketDataV1) Size() (n int) {
+func (m *SlashPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovWire(uint64(l))
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovWire(uint64(m.Infraction))
+	}
+	return n
+}
+
+func (m *ConsumerPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1144,7 +2006,7 @@ func (m *ConsumerPacketDataV1) Size() (n int) {
 	return n
 }
 
-func (m *ConsumerPacketDataV1_SlashPacketData) Size() (n int) {
+func (m *ConsumerPacketData_SlashPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1156,7 +2018,88 @@ func (m *ConsumerPacketDataV1_SlashPacketData) Size() (n int) {
 	}
 	return n
 }
-func (m *ConsumerPacketDataV1_VscMaturedPacketData) Size() (n int) {
+func (m *ConsumerPacketData_VscMaturedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscMaturedPacketData != nil {
+		l = m.VscMaturedPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+func (m *ConsumerPacketDataV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	if m.Payload != nil {
+		l = m.Payload.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *HandshakeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		for _, s := range m.Features {
+			l = len(s)
+			n += 1 + l + sovWire(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerPacketDataV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovWire(uint64(m.Type))
+	}
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *ConsumerPacketDataV1_SlashPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashPacketData != nil {
+		l = m.SlashPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+func (m *ConsumerPacketDataV1_VscMaturedPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1185,6 +2128,19 @@ func (m *SlashPacketDataV1) Size() (n int) {
 	return n
 }
 
+func (m *ProviderFeePoolAddrPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
 func sovWire(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1273,11 +2229,673 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChangePacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetHash = append(m.ValsetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValsetHash == nil {
+				m.ValsetHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValsetCheckpointPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValsetCheckpointPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValsetCheckpointPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetHash = append(m.ValsetHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValsetHash == nil {
+				m.ValsetHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerUpgradePlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerUpgradePlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerUpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltHeight", wireType)
+			}
+			m.HaltHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.HaltTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerUpgradePacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerUpgradePacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerUpgradePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpgradePlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cancelled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DowntimeParamsPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DowntimeParamsPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DowntimeParamsPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWire
@@ -1287,23 +2905,24 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWire
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWire
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			if err := m.DowntimeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1655,6 +3274,124 @@ func (m *ConsumerPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsumerPacketDataV2) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerPacketDataV2: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerPacketDataV2: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Payload == nil {
+				m.Payload = &types2.Any{}
+			}
+			if err := m.Payload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1748,6 +3485,38 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Features = append(m.Features, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -2029,6 +3798,88 @@ func (m *SlashPacketDataV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProviderFeePoolAddrPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderFeePoolAddrPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderFeePoolAddrPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderFeePoolAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderFeePoolAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWire(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
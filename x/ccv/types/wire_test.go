@@ -1,16 +1,22 @@
 package types_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
+	"time"
 
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	"github.com/cosmos/interchain-security/v7/testutil/crypto"
 	"github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -54,6 +60,33 @@ func TestPacketDataValidateBasic(t *testing.T) {
 				nil,
 			),
 		},
+		{
+			"invalid: negative power",
+			true,
+			types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk, Power: -1}}, 4, nil),
+		},
+		{
+			"invalid: empty pubkey",
+			true,
+			types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{Power: 1}}, 5, nil),
+		},
+		{
+			"invalid: malformed pubkey",
+			true,
+			types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: tmprotocrypto.PublicKey{
+				Sum: &tmprotocrypto.PublicKey_Ed25519{Ed25519: []byte{0x01, 0x02, 0x03}},
+			}, Power: 1}}, 5, nil),
+		},
+		{
+			"invalid: duplicate pubkeys",
+			true,
+			types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk, Power: 0}, {PubKey: pk, Power: 1}}, 6, nil),
+		},
+		{
+			"invalid: too many validator updates",
+			true,
+			types.NewValidatorSetChangePacketData(make([]abci.ValidatorUpdate, types.MaxValidatorUpdatesPerPacket+1), 7, nil),
+		},
 	}
 
 	for _, c := range cases {
@@ -66,6 +99,286 @@ func TestPacketDataValidateBasic(t *testing.T) {
 	}
 }
 
+// FuzzValidatorSetChangePacketDataValidate checks that VSC packet data decoded from
+// arbitrary bytes either fails validation or satisfies all the validation invariants
+func FuzzValidatorSetChangePacketDataValidate(f *testing.F) {
+	pk1 := crypto.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey()
+	pk2 := crypto.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+	f.Add(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk1, Power: 1}, {PubKey: pk2, Power: 0}}, 1, nil).GetBytes())
+	f.Add(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk1, Power: 1}, {PubKey: pk1, Power: 2}}, 2, nil).GetBytes())
+	f.Add(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk1, Power: -1}}, 3, []string{"addr"}).GetBytes())
+	f.Add([]byte(`{"validator_updates":[],"valset_update_id":"0"}`))
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var data types.ValidatorSetChangePacketData
+		if err := types.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+			return
+		}
+		if err := data.Validate(); err != nil {
+			return
+		}
+		require.NotZero(t, data.ValsetUpdateId)
+		require.LessOrEqual(t, len(data.ValidatorUpdates), types.MaxValidatorUpdatesPerPacket)
+		seen := map[string]bool{}
+		for _, update := range data.ValidatorUpdates {
+			require.GreaterOrEqual(t, update.Power, int64(0))
+			require.NotNil(t, update.PubKey.Sum)
+			require.False(t, seen[update.PubKey.String()])
+			seen[update.PubKey.String()] = true
+		}
+	})
+}
+
+// FuzzValidateValidatorUpdates checks that ValidateValidatorUpdates rejects
+// validator updates with negative powers or duplicate public keys
+func FuzzValidateValidatorUpdates(f *testing.F) {
+	f.Add(uint8(3), int64(10), uint8(0))
+	f.Add(uint8(3), int64(-10), uint8(0))
+	f.Add(uint8(5), int64(0), uint8(2))
+
+	f.Fuzz(func(t *testing.T, numUpdates uint8, power int64, numDuplicates uint8) {
+		updates := []abci.ValidatorUpdate{}
+		for i := 0; i < int(numUpdates); i++ {
+			updates = append(updates, abci.ValidatorUpdate{
+				PubKey: crypto.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey(),
+				Power:  power,
+			})
+		}
+		duplicates := 0
+		for i := 0; i < int(numDuplicates) && i < len(updates); i++ {
+			updates = append(updates, updates[i])
+			duplicates++
+		}
+
+		err := types.NewValidatorSetChangePacketData(updates, 1, nil).ValidateValidatorUpdates()
+		switch {
+		case len(updates) > 0 && power < 0:
+			require.ErrorIs(t, err, types.ErrInvalidValidatorPower)
+		case duplicates > 0:
+			require.ErrorIs(t, err, types.ErrDuplicateValidatorPubKey)
+		default:
+			require.NoError(t, err)
+		}
+	})
+}
+
+// FuzzConsumerPacketData checks that decoding consumer packet data from arbitrary bytes,
+// as the provider does on receiving a packet, never panics and that valid consumer packet data
+// round trips over the wire, both in the v1 format and in a v2 envelope,
+// i.e., decoding and re-encoding it yields the same bytes
+func FuzzConsumerPacketData(f *testing.F) {
+	cId := crypto.NewCryptoIdentityFromIntSeed(4732894342)
+	slashPacketData := types.NewConsumerPacketData(types.SlashPacket, &types.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: types.NewSlashPacketData(
+			abci.Validator{Address: cId.SDKValConsAddress(), Power: 4328},
+			894732, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN),
+	})
+	vscMaturedPacketData := types.NewConsumerPacketData(types.VscMaturedPacket, &types.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: types.NewVSCMaturedPacketData(84923),
+	})
+	for _, packetData := range []types.ConsumerPacketData{slashPacketData, vscMaturedPacketData} {
+		f.Add(packetData.GetBytes())
+		f.Add(types.ModuleCdc.MustMarshalJSON(&packetData))
+		v2PacketData, err := packetData.ToV2()
+		require.NoError(f, err)
+		f.Add(v2PacketData.GetBytes())
+	}
+	// slash packets sent by consumer chains running ICS v1 and v2
+	f.Add([]byte(`{"type":"CONSUMER_PACKET_TYPE_SLASH","slashPacketData":{"validator":{"address":"BP9q4oXCgubvoujOKyxIxd+3IwM=","power":"4328"},"valset_update_id":"894732","infraction":"INFRACTION_TYPE_DOWNTIME"}}`))
+	f.Add([]byte(`{"type":"CONSUMER_PACKET_TYPE_SLASH"}`))
+	f.Add([]byte(`{"features":["consumer_packet_data_v2","unsupported_feature"]}`))
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		packetData, err := types.UnmarshalConsumerPacketData(bz)
+		if err != nil {
+			return
+		}
+		if err := packetData.Validate(); err != nil {
+			return
+		}
+		switch packetData.Type {
+		case types.SlashPacket:
+			require.NotNil(t, packetData.GetSlashPacketData())
+		case types.VscMaturedPacket:
+			require.NotNil(t, packetData.GetVscMaturedPacketData())
+		default:
+			t.Fatalf("valid consumer packet data with invalid type: %s", packetData.Type)
+		}
+
+		decoded, err := types.UnmarshalConsumerPacketData(packetData.GetBytes())
+		require.NoError(t, err)
+		require.Equal(t, packetData.GetBytes(), decoded.GetBytes())
+
+		v2PacketData, err := packetData.ToV2()
+		require.NoError(t, err)
+		require.NoError(t, v2PacketData.Validate())
+		decoded, err = types.UnmarshalConsumerPacketData(v2PacketData.GetBytes())
+		require.NoError(t, err)
+		require.Equal(t, packetData.GetBytes(), decoded.GetBytes())
+	})
+}
+
+// FuzzVSCPacketData checks that decoding the data of the packets sent by the provider from arbitrary bytes,
+// as the consumer does on receiving a packet, never panics and that valid packet data round trips over the wire,
+// i.e., decoding and re-encoding it yields the same bytes
+func FuzzVSCPacketData(f *testing.F) {
+	pk1 := crypto.NewCryptoIdentityFromIntSeed(4732894).TMProtoCryptoPublicKey()
+	pk2 := crypto.NewCryptoIdentityFromIntSeed(4732895).TMProtoCryptoPublicKey()
+	f.Add(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{{PubKey: pk1, Power: 30}, {PubKey: pk2, Power: 20}}, 73,
+		[]string{"slash", "acks", "example"}).GetBytes())
+	f.Add(types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 74, nil).GetBytes())
+	f.Add(types.NewValsetCheckpointPacketData(73, []byte{0x01, 0x02, 0x03}).GetBytes())
+	f.Add(types.NewConsumerUpgradePacketData(types.ConsumerUpgradePlan{
+		Name:       "v2",
+		HaltHeight: 1000,
+		BinaryHash: bytes.Repeat([]byte{0x01}, 32),
+	}, false).GetBytes())
+	f.Add(types.NewDowntimeParamsPacketData(types.DowntimeParams{
+		SignedBlocksWindow: 10000,
+		MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2),
+	}).GetBytes())
+	f.Add(types.NewProviderFeePoolAddrPacketData("cosmos1ap0mh6xzfn8943urr84q6ae7zfnar48am2erhd").GetBytes())
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		// the consumer tries to decode the packet data in this order
+		var (
+			vscData        types.ValidatorSetChangePacketData
+			checkpoint     types.ValsetCheckpointPacketData
+			upgrade        types.ConsumerUpgradePacketData
+			downtimeParams types.DowntimeParamsPacketData
+			feePoolAddr    types.ProviderFeePoolAddrPacketData
+		)
+		switch {
+		case types.ModuleCdc.UnmarshalJSON(bz, &vscData) == nil:
+			if vscData.Validate() != nil {
+				return
+			}
+			// the consumer can apply the validator updates
+			for _, update := range vscData.ValidatorUpdates {
+				pubKey, err := cryptocodec.FromCmtProtoPublicKey(update.PubKey)
+				require.NoError(t, err)
+				require.NotPanics(t, func() { pubKey.Address() })
+			}
+			var decoded types.ValidatorSetChangePacketData
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(vscData.GetBytes(), &decoded))
+			require.Equal(t, vscData.GetBytes(), decoded.GetBytes())
+		case types.ModuleCdc.UnmarshalJSON(bz, &checkpoint) == nil:
+			if checkpoint.Validate() != nil {
+				return
+			}
+			var decoded types.ValsetCheckpointPacketData
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(checkpoint.GetBytes(), &decoded))
+			require.Equal(t, checkpoint.GetBytes(), decoded.GetBytes())
+		case types.ModuleCdc.UnmarshalJSON(bz, &upgrade) == nil:
+			if upgrade.Validate() != nil {
+				return
+			}
+			var decoded types.ConsumerUpgradePacketData
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(upgrade.GetBytes(), &decoded))
+			require.Equal(t, upgrade.GetBytes(), decoded.GetBytes())
+		case types.ModuleCdc.UnmarshalJSON(bz, &downtimeParams) == nil:
+			if downtimeParams.Validate() != nil {
+				return
+			}
+			var decoded types.DowntimeParamsPacketData
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(downtimeParams.GetBytes(), &decoded))
+			require.Equal(t, downtimeParams.GetBytes(), decoded.GetBytes())
+		case types.ModuleCdc.UnmarshalJSON(bz, &feePoolAddr) == nil:
+			if feePoolAddr.Validate() != nil {
+				return
+			}
+			var decoded types.ProviderFeePoolAddrPacketData
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(feePoolAddr.GetBytes(), &decoded))
+			require.Equal(t, feePoolAddr.GetBytes(), decoded.GetBytes())
+		}
+	})
+}
+
+// FuzzPacketAcknowledgement checks that decoding CCV packet acknowledgements from arbitrary bytes
+// never panics and that valid acknowledgements round trip over the wire,
+// i.e., decoding and re-encoding them yields the same bytes
+func FuzzPacketAcknowledgement(f *testing.F) {
+	f.Add(channeltypes.NewResultAcknowledgement(types.V1Result).Acknowledgement())
+	f.Add(channeltypes.NewResultAcknowledgement(types.SlashPacketHandledResult).Acknowledgement())
+	f.Add(channeltypes.NewResultAcknowledgement(types.SlashPacketBouncedResult).Acknowledgement())
+	f.Add(channeltypes.NewResultAcknowledgement(
+		types.NewValidatorSetChangePacketAck(73, bytes.Repeat([]byte{0x01}, 32)).GetBytes()).Acknowledgement())
+	f.Add(channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData).Acknowledgement())
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		var ack channeltypes.Acknowledgement
+		if err := types.ModuleCdc.UnmarshalJSON(bz, &ack); err != nil {
+			return
+		}
+		if err := ack.ValidateBasic(); err != nil {
+			return
+		}
+		require.NotEqual(t, ack.Success(), ack.GetError() != "")
+
+		var decoded channeltypes.Acknowledgement
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(ack.Acknowledgement(), &decoded))
+		require.Equal(t, ack.Acknowledgement(), decoded.Acknowledgement())
+
+		// the provider decodes the result of VSC packet acknowledgements
+		var vscAck types.ValidatorSetChangePacketAck
+		if result := ack.GetResult(); result != nil && types.ModuleCdc.UnmarshalJSON(result, &vscAck) == nil {
+			var decodedVscAck types.ValidatorSetChangePacketAck
+			require.NoError(t, types.ModuleCdc.UnmarshalJSON(vscAck.GetBytes(), &decodedVscAck))
+			require.Equal(t, vscAck.GetBytes(), decodedVscAck.GetBytes())
+		}
+	})
+}
+
+func TestConsumerPacketDataV2(t *testing.T) {
+	cId := crypto.NewCryptoIdentityFromIntSeed(4732)
+	slashPacketData := types.NewConsumerPacketData(types.SlashPacket, &types.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: types.NewSlashPacketData(
+			abci.Validator{Address: cId.SDKValConsAddress(), Power: 10},
+			7, stakingtypes.Infraction_INFRACTION_DOWNTIME),
+	})
+	vscMaturedPacketData := types.NewConsumerPacketData(types.VscMaturedPacket, &types.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: types.NewVSCMaturedPacketData(8),
+	})
+
+	for _, packetData := range []types.ConsumerPacketData{slashPacketData, vscMaturedPacketData} {
+		v2PacketData, err := packetData.ToV2()
+		require.NoError(t, err)
+		require.Equal(t, []string{types.FeatureConsumerPacketDataV2}, v2PacketData.Features)
+		require.NoError(t, v2PacketData.Validate())
+
+		// v2 envelopes round trip over the wire
+		var decoded types.ConsumerPacketDataV2
+		require.NoError(t, types.ModuleCdc.UnmarshalJSON(v2PacketData.GetBytes(), &decoded))
+		converted, err := decoded.ToConsumerPacketData()
+		require.NoError(t, err)
+		require.Equal(t, packetData, converted)
+
+		// v2 envelopes cannot be decoded as ConsumerPacketData
+		var v1 types.ConsumerPacketData
+		require.Error(t, types.ModuleCdc.UnmarshalJSON(v2PacketData.GetBytes(), &v1))
+	}
+
+	// unsupported features
+	v2PacketData, err := slashPacketData.ToV2()
+	require.NoError(t, err)
+	v2PacketData.Features = append(v2PacketData.Features, "unsupported_feature")
+	require.ErrorIs(t, v2PacketData.Validate(), types.ErrUnsupportedFeature)
+
+	// empty payload
+	require.ErrorIs(t, types.ConsumerPacketDataV2{}.Validate(), types.ErrInvalidPacketData)
+	_, err = types.ConsumerPacketDataV2{}.ToConsumerPacketData()
+	require.ErrorIs(t, err, types.ErrInvalidPacketData)
+
+	// invalid payload
+	invalidV2PacketData, err := types.NewConsumerPacketDataV2(types.NewVSCMaturedPacketData(0))
+	require.NoError(t, err)
+	require.ErrorIs(t, invalidV2PacketData.Validate(), types.ErrInvalidPacketData)
+
+	// unknown packet type
+	_, err = types.ConsumerPacketData{Type: types.UnspecifiedPacket}.ToV2()
+	require.Error(t, err)
+}
+
 func TestMarshalPacketData(t *testing.T) {
 	pk1, err := cryptocodec.ToCmtProtoPublicKey(ed25519.GenPrivKey().PubKey())
 	require.NoError(t, err)
@@ -224,6 +537,261 @@ func TestVSCMaturedPacketDataWireBytes(t *testing.T) {
 	require.Equal(t, expectedStr, str)
 }
 
+// TestValsetCheckpointPacketDataWireBytes is a regression test that the JSON schema
+// for ValsetCheckpointPacketData (sent over the wire) does not change.
+func TestValsetCheckpointPacketDataWireBytes(t *testing.T) {
+	data := types.NewValsetCheckpointPacketData(73, []byte{0x01, 0x02, 0x03})
+	require.NoError(t, data.Validate())
+
+	str := string(data.GetBytes())
+
+	// Expected string formatted for human readability
+	expectedStr := `{
+		"valset_update_id": "73",
+		"valset_hash": "AQID"
+	}`
+
+	// Remove newlines, tabs, and spaces for comparison
+	expectedStr = strings.ReplaceAll(expectedStr, "\n", "")
+	expectedStr = strings.ReplaceAll(expectedStr, "\t", "")
+	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
+
+	require.Equal(t, expectedStr, str)
+
+	// a checkpoint must not be decoded as a VSC packet, and vice versa
+	var vscData types.ValidatorSetChangePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &vscData))
+	vscBz := types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 73, nil).GetBytes()
+	var checkpoint types.ValsetCheckpointPacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(vscBz, &checkpoint))
+
+	// invalid checkpoints
+	require.Error(t, types.NewValsetCheckpointPacketData(0, []byte{0x01}).Validate())
+	require.Error(t, types.NewValsetCheckpointPacketData(73, nil).Validate())
+}
+
+// TestConsumerUpgradePacketDataWireBytes is a regression test that the JSON schema
+// for ConsumerUpgradePacketData (sent over the wire) does not change.
+func TestConsumerUpgradePacketDataWireBytes(t *testing.T) {
+	plan := types.ConsumerUpgradePlan{
+		Name:       "v2",
+		HaltHeight: 1000,
+		BinaryHash: bytes.Repeat([]byte{0x01}, 32),
+	}
+	data := types.NewConsumerUpgradePacketData(plan, false)
+	require.NoError(t, data.Validate())
+
+	str := string(data.GetBytes())
+
+	// Expected string formatted for human readability
+	expectedStr := `{
+		"upgrade_plan": {
+			"name": "v2",
+			"halt_height": "1000",
+			"halt_time": "0001-01-01T00:00:00Z",
+			"binary_hash": "AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE="
+		},
+		"cancelled": false
+	}`
+
+	// Remove newlines, tabs, and spaces for comparison
+	expectedStr = strings.ReplaceAll(expectedStr, "\n", "")
+	expectedStr = strings.ReplaceAll(expectedStr, "\t", "")
+	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
+
+	require.Equal(t, expectedStr, str)
+
+	// a consumer upgrade packet must not be decoded as a VSC or a checkpoint packet
+	var vscData types.ValidatorSetChangePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &vscData))
+	var checkpoint types.ValsetCheckpointPacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &checkpoint))
+}
+
+func TestConsumerUpgradePlanValidate(t *testing.T) {
+	binaryHash := bytes.Repeat([]byte{0x01}, 32)
+	haltTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		name  string
+		plan  types.ConsumerUpgradePlan
+		valid bool
+	}{
+		{
+			"valid plan with halt height",
+			types.ConsumerUpgradePlan{Name: "v2", HaltHeight: 1000, BinaryHash: binaryHash},
+			true,
+		},
+		{
+			"valid plan with halt time",
+			types.ConsumerUpgradePlan{Name: "v2", HaltTime: haltTime, BinaryHash: binaryHash},
+			true,
+		},
+		{
+			"empty name",
+			types.ConsumerUpgradePlan{Name: " ", HaltHeight: 1000, BinaryHash: binaryHash},
+			false,
+		},
+		{
+			"too long name",
+			types.ConsumerUpgradePlan{Name: strings.Repeat("a", types.MaxConsumerUpgradeNameLength+1), HaltHeight: 1000, BinaryHash: binaryHash},
+			false,
+		},
+		{
+			"neither halt height nor halt time",
+			types.ConsumerUpgradePlan{Name: "v2", BinaryHash: binaryHash},
+			false,
+		},
+		{
+			"both halt height and halt time",
+			types.ConsumerUpgradePlan{Name: "v2", HaltHeight: 1000, HaltTime: haltTime, BinaryHash: binaryHash},
+			false,
+		},
+		{
+			"negative halt height",
+			types.ConsumerUpgradePlan{Name: "v2", HaltHeight: -1, BinaryHash: binaryHash},
+			false,
+		},
+		{
+			"invalid binary hash",
+			types.ConsumerUpgradePlan{Name: "v2", HaltHeight: 1000, BinaryHash: []byte{0x01}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.plan.Validate()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+// TestDowntimeParamsPacketDataWireBytes is a regression test that the JSON schema
+// for DowntimeParamsPacketData (sent over the wire) does not change.
+func TestDowntimeParamsPacketDataWireBytes(t *testing.T) {
+	data := types.NewDowntimeParamsPacketData(types.DowntimeParams{
+		SignedBlocksWindow: 10000,
+		MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 2),
+	})
+	require.NoError(t, data.Validate())
+
+	str := string(data.GetBytes())
+
+	// Expected string formatted for human readability
+	expectedStr := `{
+		"downtime_params": {
+			"signed_blocks_window": "10000",
+			"min_signed_per_window": "0.050000000000000000"
+		}
+	}`
+
+	// Remove newlines, tabs, and spaces for comparison
+	expectedStr = strings.ReplaceAll(expectedStr, "\n", "")
+	expectedStr = strings.ReplaceAll(expectedStr, "\t", "")
+	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
+
+	require.Equal(t, expectedStr, str)
+
+	// a downtime params packet must not be decoded as any other packet sent by the provider
+	var vscData types.ValidatorSetChangePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &vscData))
+	var checkpoint types.ValsetCheckpointPacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &checkpoint))
+	var upgrade types.ConsumerUpgradePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &upgrade))
+}
+
+// TestProviderFeePoolAddrPacketDataWireBytes is a regression test that the JSON schema
+// for ProviderFeePoolAddrPacketData (sent over the wire) does not change.
+func TestProviderFeePoolAddrPacketDataWireBytes(t *testing.T) {
+	data := types.NewProviderFeePoolAddrPacketData("cosmos1ap0mh6xzfn8943urr84q6ae7zfnar48am2erhd")
+	require.NoError(t, data.Validate())
+
+	str := string(data.GetBytes())
+
+	// Expected string formatted for human readability
+	expectedStr := `{
+		"provider_fee_pool_addr": "cosmos1ap0mh6xzfn8943urr84q6ae7zfnar48am2erhd"
+	}`
+
+	// Remove newlines, tabs, and spaces for comparison
+	expectedStr = strings.ReplaceAll(expectedStr, "\n", "")
+	expectedStr = strings.ReplaceAll(expectedStr, "\t", "")
+	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
+
+	require.Equal(t, expectedStr, str)
+
+	// a provider fee pool address packet must not be decoded as any other packet sent by the provider
+	var vscData types.ValidatorSetChangePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &vscData))
+	var checkpoint types.ValsetCheckpointPacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &checkpoint))
+	var upgrade types.ConsumerUpgradePacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &upgrade))
+	var downtimeParams types.DowntimeParamsPacketData
+	require.Error(t, types.ModuleCdc.UnmarshalJSON(data.GetBytes(), &downtimeParams))
+
+	// the address must be bech32 encoded
+	require.Error(t, types.NewProviderFeePoolAddrPacketData("").Validate())
+	require.Error(t, types.NewProviderFeePoolAddrPacketData("invalid").Validate())
+}
+
+func TestDowntimeParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.DowntimeParams
+		valid  bool
+	}{
+		{
+			"valid params",
+			types.DowntimeParams{SignedBlocksWindow: 100, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+			true,
+		},
+		{
+			"valid params with boundary min signed per window",
+			types.DowntimeParams{SignedBlocksWindow: 1, MinSignedPerWindow: math.LegacyOneDec()},
+			true,
+		},
+		{
+			"zero signed blocks window",
+			types.DowntimeParams{SignedBlocksWindow: 0, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+			false,
+		},
+		{
+			"negative signed blocks window",
+			types.DowntimeParams{SignedBlocksWindow: -1, MinSignedPerWindow: math.LegacyNewDecWithPrec(5, 1)},
+			false,
+		},
+		{
+			"nil min signed per window",
+			types.DowntimeParams{SignedBlocksWindow: 100},
+			false,
+		},
+		{
+			"negative min signed per window",
+			types.DowntimeParams{SignedBlocksWindow: 100, MinSignedPerWindow: math.LegacyNewDec(-1)},
+			false,
+		},
+		{
+			"min signed per window greater than one",
+			types.DowntimeParams{SignedBlocksWindow: 100, MinSignedPerWindow: math.LegacyNewDecWithPrec(11, 1)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 func TestCreateTransferMemo(t *testing.T) {
 	consumerId := "13"
 	chainId := "chain-13"